@@ -0,0 +1,472 @@
+// Package ageplugin implements the age plugin protocol for CTIDH
+// recipients and identities, as spoken by the age-plugin-ctidh binary.
+//
+// Recipients are encoded in Bech32 as age1ctidh1..., and identities as
+// AGE-PLUGIN-CTIDH-1..., each holding the parameter set in bits
+// followed by the key bytes; an identity holds both its private and
+// its public key. age hands the plugin the file key, which the plugin
+// wraps for every recipient into a stanza
+//
+//	-> ctidh CTIDH-1024 <tag> <ephemeral public key>
+//	<ChaCha20-Poly1305 encryption of the file key>
+//
+// where the key of the AEAD is derived with HKDF-SHA256 from
+// DeriveSecret of a fresh ephemeral private key and the recipient's
+// public key, and the tag is the first four bytes of the SHA-256 of
+// the recipient's public key, so that identities only take the costly
+// DeriveSecret for stanzas which are likely theirs.
+//
+// RecipientV1 and IdentityV1 run the two state machines of the
+// protocol, and are what the binary runs for --age-plugin=recipient-v1
+// and --age-plugin=identity-v1.
+package ageplugin
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+
+	"git.xx.network/elixxir/ctidh_cgo/ctidh1024"
+	"git.xx.network/elixxir/ctidh_cgo/ctidh2048"
+	"git.xx.network/elixxir/ctidh_cgo/ctidh511"
+	"git.xx.network/elixxir/ctidh_cgo/ctidh512"
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+)
+
+const (
+	// Name is the name of the plugin.
+	Name = "ctidh"
+
+	// RecipientHRP is the Bech32 human readable part of recipients.
+	RecipientHRP = "age1" + Name
+
+	// IdentityHRP is the Bech32 human readable part of identities.
+	IdentityHRP = "AGE-PLUGIN-CTIDH-"
+
+	// StanzaType is the type of the recipient stanzas.
+	StanzaType = Name
+
+	fileKeySize  = 16
+	tagSize      = 4
+	wrappedSize  = fileKeySize + 16
+	wrapInfo     = "age-plugin-ctidh"
+	bitsSize     = 2
+	protocolDone = "done"
+)
+
+var (
+	// ErrRecipient indicates a malformed or unsupported recipient.
+	ErrRecipient = errors.New("ageplugin: invalid recipient")
+
+	// ErrIdentity indicates a malformed or unsupported identity.
+	ErrIdentity = errors.New("ageplugin: invalid identity")
+
+	// ErrProtocol indicates a violation of the plugin protocol by
+	// the client.
+	ErrProtocol = errors.New("ageplugin: protocol error")
+
+	errStanzaBody = errors.New("ageplugin: malformed ctidh stanza")
+)
+
+// schemes are the supported parameter sets, by their size in bits.
+var schemes = map[uint16]nike.Scheme{
+	511:  ctidh511.Scheme(),
+	512:  ctidh512.Scheme(),
+	1024: ctidh1024.Scheme(),
+	2048: ctidh2048.Scheme(),
+}
+
+// Scheme returns the parameter set of the given size in bits, or nil
+// if there is none.
+func Scheme(bits uint16) nike.Scheme {
+	return schemes[bits]
+}
+
+func schemeBits(scheme nike.Scheme) (uint16, bool) {
+	for bits, s := range schemes {
+		if s.Name() == scheme.Name() {
+			return bits, true
+		}
+	}
+	return 0, false
+}
+
+// Recipient is a CTIDH public key to encrypt to.
+type Recipient struct {
+	scheme    nike.Scheme
+	bits      uint16
+	publicKey nike.PublicKey
+}
+
+// NewRecipient returns the recipient of publicKey.
+func NewRecipient(scheme nike.Scheme, publicKey nike.PublicKey) (*Recipient, error) {
+	bits, ok := schemeBits(scheme)
+	if !ok {
+		return nil, ErrRecipient
+	}
+	return &Recipient{scheme: scheme, bits: bits, publicKey: publicKey}, nil
+}
+
+// ParseRecipient decodes an age1ctidh1... recipient.
+func ParseRecipient(s string) (*Recipient, error) {
+	hrp, data, err := bech32Decode(s)
+	if err != nil || hrp != RecipientHRP || len(data) < bitsSize {
+		return nil, ErrRecipient
+	}
+	bits := binary.BigEndian.Uint16(data)
+	scheme := Scheme(bits)
+	if scheme == nil {
+		return nil, ErrRecipient
+	}
+	publicKey, err := scheme.UnmarshalBinaryPublicKey(data[bitsSize:])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRecipient, err)
+	}
+	return &Recipient{scheme: scheme, bits: bits, publicKey: publicKey}, nil
+}
+
+// String returns the age1ctidh1... encoding of the recipient.
+func (r *Recipient) String() string {
+	data := binary.BigEndian.AppendUint16(nil, r.bits)
+	s, err := bech32Encode(RecipientHRP, append(data, r.publicKey.Bytes()...))
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// tag returns the tag of the recipient's stanzas.
+func (r *Recipient) tag() []byte {
+	h := sha256.Sum256(r.publicKey.Bytes())
+	return h[:tagSize]
+}
+
+// wrapAEAD returns the AEAD which wraps a file key for publicKey with
+// the ephemeral key ephemeralPublicKey and their shared secret.
+func wrapAEAD(secret []byte, ephemeralPublicKey, publicKey nike.PublicKey) (cipher.AEAD, error) {
+	salt := append(ephemeralPublicKey.Bytes(), publicKey.Bytes()...)
+	key := make([]byte, chacha20poly1305.KeySize)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, secret, salt, []byte(wrapInfo)), key); err != nil {
+		return nil, err
+	}
+	return chacha20poly1305.New(key)
+}
+
+// wrap returns the stanza of fileKey for the recipient.
+func (r *Recipient) wrap(rng io.Reader, fileKey []byte) (*stanza, error) {
+	ephemeralPrivate, ephemeralPublic, err := r.scheme.GenerateKeyPair(rng)
+	if err != nil {
+		return nil, err
+	}
+	defer ephemeralPrivate.Reset()
+	secret, err := r.scheme.DeriveSecret(ephemeralPrivate, r.publicKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := wrapAEAD(secret, ephemeralPublic, r.publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return &stanza{
+		Type: StanzaType,
+		Args: []string{r.scheme.Name(), b64.EncodeToString(r.tag()), b64.EncodeToString(ephemeralPublic.Bytes())},
+		// Every wrapping key is used once.
+		Body: aead.Seal(nil, make([]byte, aead.NonceSize()), fileKey, nil),
+	}, nil
+}
+
+// Identity is a CTIDH key pair to decrypt with.
+type Identity struct {
+	recipient  Recipient
+	privateKey nike.PrivateKey
+}
+
+// GenerateIdentity returns a new identity drawn from rng.
+func GenerateIdentity(scheme nike.Scheme, rng io.Reader) (*Identity, error) {
+	privateKey, publicKey, err := scheme.GenerateKeyPair(rng)
+	if err != nil {
+		return nil, err
+	}
+	recipient, err := NewRecipient(scheme, publicKey)
+	if err != nil {
+		return nil, ErrIdentity
+	}
+	return &Identity{recipient: *recipient, privateKey: privateKey}, nil
+}
+
+// ParseIdentity decodes an AGE-PLUGIN-CTIDH-1... identity.
+func ParseIdentity(s string) (*Identity, error) {
+	hrp, data, err := bech32Decode(s)
+	if err != nil || hrp != strings.ToLower(IdentityHRP) || len(data) < bitsSize {
+		return nil, ErrIdentity
+	}
+	bits := binary.BigEndian.Uint16(data)
+	scheme := Scheme(bits)
+	if scheme == nil || len(data) != bitsSize+scheme.PrivateKeySize()+scheme.PublicKeySize() {
+		return nil, ErrIdentity
+	}
+	data = data[bitsSize:]
+	privateKey, err := scheme.UnmarshalBinaryPrivateKey(data[:scheme.PrivateKeySize()])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrIdentity, err)
+	}
+	publicKey, err := scheme.UnmarshalBinaryPublicKey(data[scheme.PrivateKeySize():])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrIdentity, err)
+	}
+	return &Identity{
+		recipient:  Recipient{scheme: scheme, bits: bits, publicKey: publicKey},
+		privateKey: privateKey,
+	}, nil
+}
+
+// String returns the AGE-PLUGIN-CTIDH-1... encoding of the identity.
+func (i *Identity) String() string {
+	data := binary.BigEndian.AppendUint16(nil, i.recipient.bits)
+	data = append(data, i.privateKey.Bytes()...)
+	s, err := bech32Encode(IdentityHRP, append(data, i.recipient.publicKey.Bytes()...))
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Recipient returns the recipient of the identity.
+func (i *Identity) Recipient() *Recipient {
+	return &i.recipient
+}
+
+// unwrap returns the file key of a stanza. It returns nil and no error
+// for stanzas which are not for the identity.
+func (i *Identity) unwrap(s *stanza) ([]byte, error) {
+	if s.Type != StanzaType {
+		return nil, nil
+	}
+	if len(s.Args) != 3 {
+		return nil, errStanzaBody
+	}
+	r := &i.recipient
+	if s.Args[0] != r.scheme.Name() {
+		return nil, nil
+	}
+	tag, err := b64.DecodeString(s.Args[1])
+	if err != nil || len(tag) != tagSize {
+		return nil, errStanzaBody
+	}
+	if !bytes.Equal(tag, r.tag()) {
+		return nil, nil
+	}
+	data, err := b64.DecodeString(s.Args[2])
+	if err != nil || len(s.Body) != wrappedSize {
+		return nil, errStanzaBody
+	}
+	ephemeralPublic, err := r.scheme.UnmarshalBinaryPublicKey(data)
+	if err != nil {
+		return nil, errStanzaBody
+	}
+	secret, err := r.scheme.DeriveSecret(i.privateKey, ephemeralPublic)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := wrapAEAD(secret, ephemeralPublic, r.publicKey)
+	if err != nil {
+		return nil, err
+	}
+	fileKey, err := aead.Open(nil, make([]byte, aead.NonceSize()), s.Body, nil)
+	if err != nil {
+		// The tag matched by chance.
+		return nil, nil
+	}
+	return fileKey, nil
+}
+
+// conn is the plugin's end of the protocol.
+type conn struct {
+	in  *stanzaReader
+	out io.Writer
+}
+
+// command sends a phase 2 command and waits for the client's ok.
+func (c *conn) command(typ string, args []string, body []byte) error {
+	if err := writeStanza(c.out, &stanza{Type: typ, Args: args, Body: body}); err != nil {
+		return err
+	}
+	response, err := c.in.read()
+	if err != nil {
+		return err
+	}
+	if response.Type != "ok" {
+		return fmt.Errorf("%w: %s response to %s", ErrProtocol, response.Type, typ)
+	}
+	return nil
+}
+
+func (c *conn) done() error {
+	return writeStanza(c.out, &stanza{Type: protocolDone})
+}
+
+// RecipientV1 runs the recipient-v1 state machine: it reads the
+// recipients, identities and file keys from in, and writes a stanza
+// for every file key and recipient to out. If a recipient or identity
+// is invalid it reports that instead of any stanza.
+func RecipientV1(rng io.Reader, in io.Reader, out io.Writer) error {
+	c := &conn{in: newStanzaReader(in), out: out}
+	var recipients, identities []string
+	var fileKeys [][]byte
+phase1:
+	for {
+		s, err := c.in.read()
+		if err != nil {
+			return err
+		}
+		switch s.Type {
+		case "add-recipient", "add-identity":
+			if len(s.Args) != 1 {
+				return ErrProtocol
+			}
+			if s.Type == "add-recipient" {
+				recipients = append(recipients, s.Args[0])
+			} else {
+				identities = append(identities, s.Args[0])
+			}
+		case "wrap-file-key":
+			if len(s.Body) != fileKeySize {
+				return ErrProtocol
+			}
+			fileKeys = append(fileKeys, s.Body)
+		case protocolDone:
+			break phase1
+		}
+		// Other commands, such as extension-labels and grease,
+		// are ignored.
+	}
+
+	var parsed []*Recipient
+	failed := false
+	for i, s := range recipients {
+		r, err := ParseRecipient(s)
+		if err != nil {
+			failed = true
+			if err := c.command("error", []string{"recipient", strconv.Itoa(i)}, []byte(err.Error())); err != nil {
+				return err
+			}
+			continue
+		}
+		parsed = append(parsed, r)
+	}
+	for i, s := range identities {
+		identity, err := ParseIdentity(s)
+		if err != nil {
+			failed = true
+			if err := c.command("error", []string{"identity", strconv.Itoa(i)}, []byte(err.Error())); err != nil {
+				return err
+			}
+			continue
+		}
+		parsed = append(parsed, identity.Recipient())
+	}
+	if failed {
+		return c.done()
+	}
+
+	for i, fileKey := range fileKeys {
+		for _, r := range parsed {
+			s, err := r.wrap(rng, fileKey)
+			if err != nil {
+				if err := c.command("error", []string{"internal"}, []byte(err.Error())); err != nil {
+					return err
+				}
+				return c.done()
+			}
+			args := append([]string{strconv.Itoa(i), s.Type}, s.Args...)
+			if err := c.command("recipient-stanza", args, s.Body); err != nil {
+				return err
+			}
+		}
+	}
+	return c.done()
+}
+
+// IdentityV1 runs the identity-v1 state machine: it reads the
+// identities and the stanzas of every file from in, and writes the
+// file key of every file which has a stanza for one of the identities
+// to out.
+func IdentityV1(in io.Reader, out io.Writer) error {
+	c := &conn{in: newStanzaReader(in), out: out}
+	var identities []string
+	stanzas := make(map[int][]*stanza)
+phase1:
+	for {
+		s, err := c.in.read()
+		if err != nil {
+			return err
+		}
+		switch s.Type {
+		case "add-identity":
+			if len(s.Args) != 1 {
+				return ErrProtocol
+			}
+			identities = append(identities, s.Args[0])
+		case "recipient-stanza":
+			if len(s.Args) < 2 {
+				return ErrProtocol
+			}
+			file, err := strconv.Atoi(s.Args[0])
+			if err != nil || file < 0 {
+				return ErrProtocol
+			}
+			stanzas[file] = append(stanzas[file], &stanza{Type: s.Args[1], Args: s.Args[2:], Body: s.Body})
+		case protocolDone:
+			break phase1
+		}
+	}
+
+	var parsed []*Identity
+	for i, s := range identities {
+		identity, err := ParseIdentity(s)
+		if err != nil {
+			if err := c.command("error", []string{"identity", strconv.Itoa(i)}, []byte(err.Error())); err != nil {
+				return err
+			}
+			continue
+		}
+		parsed = append(parsed, identity)
+	}
+
+	files := make([]int, 0, len(stanzas))
+	for file := range stanzas {
+		files = append(files, file)
+	}
+	sort.Ints(files)
+files:
+	for _, file := range files {
+		for _, s := range stanzas[file] {
+			for _, identity := range parsed {
+				fileKey, err := identity.unwrap(s)
+				if err != nil {
+					if err := c.command("error", []string{"stanza", strconv.Itoa(file)}, []byte(err.Error())); err != nil {
+						return err
+					}
+					continue files
+				}
+				if fileKey != nil {
+					if err := c.command("file-key", []string{strconv.Itoa(file)}, fileKey); err != nil {
+						return err
+					}
+					continue files
+				}
+			}
+		}
+	}
+	return c.done()
+}
@@ -0,0 +1,271 @@
+package ageplugin
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// client drives a plugin state machine over pipes as age does: it
+// sends the phase 1 commands, then answers every phase 2 command with
+// ok until the plugin is done, and returns those commands.
+func client(t *testing.T, plugin func(in io.Reader, out io.Writer) error, phase1 []*stanza) []*stanza {
+	toPlugin, pluginIn := io.Pipe()
+	pluginOut, fromPlugin := io.Pipe()
+	errs := make(chan error, 1)
+	go func() {
+		err := plugin(toPlugin, fromPlugin)
+		fromPlugin.CloseWithError(err)
+		errs <- err
+	}()
+
+	go func() {
+		for _, s := range phase1 {
+			if err := writeStanza(pluginIn, s); err != nil {
+				return
+			}
+		}
+	}()
+
+	var commands []*stanza
+	r := newStanzaReader(pluginOut)
+	for {
+		s, err := r.read()
+		require.NoError(t, err)
+		if s.Type == "done" {
+			break
+		}
+		commands = append(commands, s)
+		require.NoError(t, writeStanza(pluginIn, &stanza{Type: "ok"}))
+	}
+	require.NoError(t, <-errs)
+	return commands
+}
+
+func recipientV1(in io.Reader, out io.Writer) error {
+	return RecipientV1(rand.Reader, in, out)
+}
+
+func command(typ string, args []string, body []byte) *stanza {
+	return &stanza{Type: typ, Args: args, Body: body}
+}
+
+func newTestIdentity(t *testing.T, bits uint16) *Identity {
+	identity, err := GenerateIdentity(Scheme(bits), rand.Reader)
+	require.NoError(t, err)
+	return identity
+}
+
+func TestBech32(t *testing.T) {
+	// Test vectors from BIP 173, but for those whose data is not a
+	// whole number of bytes.
+	for _, s := range []string{
+		"A12UEL5L",
+		"a12uel5l",
+		"an83characterlonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1tt5tgs",
+		"abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw",
+		"split1checkupstagehandshakeupstreamerranterredcaperred2y9e3w",
+	} {
+		hrp, data, err := bech32Decode(s)
+		require.NoError(t, err, s)
+		if strings.ToUpper(s) == s {
+			hrp = strings.ToUpper(hrp)
+		}
+		encoded, err := bech32Encode(hrp, data)
+		require.NoError(t, err)
+		require.Equal(t, s, encoded)
+	}
+	for _, s := range []string{
+		"\x201nwldj5",
+		"pzry9x0s0muk",
+		"1pzry9x0s0muk",
+		"x1b4n0q5v",
+		"li1dgmt3",
+		"A1G7SGD8",
+		"10a06t8",
+		"1qzzfhee",
+		"A12uEL5L",
+	} {
+		_, _, err := bech32Decode(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestKeyEncoding(t *testing.T) {
+	for _, bits := range []uint16{511, 512} {
+		identity := newTestIdentity(t, bits)
+		s := identity.String()
+		require.True(t, strings.HasPrefix(s, "AGE-PLUGIN-CTIDH-1"), s)
+		parsed, err := ParseIdentity(s)
+		require.NoError(t, err)
+		require.Equal(t, s, parsed.String())
+
+		r := identity.Recipient().String()
+		require.True(t, strings.HasPrefix(r, "age1ctidh1"), r)
+		recipient, err := ParseRecipient(r)
+		require.NoError(t, err)
+		require.Equal(t, r, recipient.String())
+		require.Equal(t, identity.Recipient().publicKey.Bytes(), recipient.publicKey.Bytes())
+
+		// Identities are not recipients, nor the other way round.
+		_, err = ParseRecipient(s)
+		require.ErrorIs(t, err, ErrRecipient)
+		_, err = ParseIdentity(r)
+		require.ErrorIs(t, err, ErrIdentity)
+
+		// A changed character breaks the checksum.
+		tampered := []byte(r)
+		tampered[len(tampered)-1] ^= 'q' ^ 'p'
+		_, err = ParseRecipient(string(tampered))
+		require.ErrorIs(t, err, ErrRecipient)
+	}
+
+	// The parameter set must be a supported one.
+	unsupported, err := bech32Encode(RecipientHRP, make([]byte, bitsSize+64))
+	require.NoError(t, err)
+	_, err = ParseRecipient(unsupported)
+	require.ErrorIs(t, err, ErrRecipient)
+}
+
+func TestStanza(t *testing.T) {
+	for _, size := range []int{0, 1, 47, 48, 49, 96, 200} {
+		body := make([]byte, size)
+		_, err := rand.Read(body)
+		require.NoError(t, err)
+		var buf bytes.Buffer
+		require.NoError(t, writeStanza(&buf, command("test", []string{"a", "b"}, body)))
+		lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+		require.Less(t, len(lines[len(lines)-1]), columns)
+
+		s, err := newStanzaReader(&buf).read()
+		require.NoError(t, err)
+		require.Equal(t, "test", s.Type)
+		require.Equal(t, []string{"a", "b"}, s.Args)
+		require.Equal(t, body, append([]byte{}, s.Body...))
+	}
+
+	for _, s := range []string{
+		"-> ok\n",
+		"ok\n\n",
+		"->  ok\n\n",
+		"-> ok\nAA=\n",
+		"-> ok\n" + strings.Repeat("A", columns+1) + "\n",
+		"-> ok\n" + strings.Repeat("A", columns) + "\n",
+	} {
+		_, err := newStanzaReader(strings.NewReader(s)).read()
+		require.Error(t, err, s)
+	}
+	require.Error(t, writeStanza(io.Discard, command("a b", nil, nil)))
+}
+
+func TestPlugin(t *testing.T) {
+	alice := newTestIdentity(t, 511)
+	bob := newTestIdentity(t, 512)
+	carol := newTestIdentity(t, 512)
+	fileKeys := [][]byte{make([]byte, fileKeySize), make([]byte, fileKeySize)}
+	for _, fileKey := range fileKeys {
+		_, err := rand.Read(fileKey)
+		require.NoError(t, err)
+	}
+
+	// age encrypts two files to alice's recipient and to bob's
+	// identity, with a grease command thrown in.
+	commands := client(t, recipientV1, []*stanza{
+		command("add-recipient", []string{alice.Recipient().String()}, nil),
+		command("add-identity", []string{bob.String()}, nil),
+		command("grease-x", []string{"y"}, []byte("z")),
+		command("wrap-file-key", nil, fileKeys[0]),
+		command("wrap-file-key", nil, fileKeys[1]),
+		command("done", nil, nil),
+	})
+	require.Len(t, commands, 4)
+	stanzas := make([][]*stanza, 2)
+	for i, c := range commands {
+		require.Equal(t, "recipient-stanza", c.Type)
+		require.Equal(t, []string{"0", "0", "1", "1"}[i], c.Args[0])
+		require.Equal(t, StanzaType, c.Args[1])
+		require.Len(t, c.Args, 5)
+		require.Len(t, c.Body, wrappedSize)
+		file := i / 2
+		stanzas[file] = append(stanzas[file], c)
+	}
+
+	// Each identity finds the file keys of both files, among the
+	// stanzas of other recipient types.
+	for _, identity := range []*Identity{alice, bob} {
+		phase1 := []*stanza{command("add-identity", []string{identity.String()}, nil)}
+		for file := range stanzas {
+			phase1 = append(phase1, command("recipient-stanza", []string{"0", "X25519", "c2hhcmU"}, make([]byte, 32)))
+			for _, s := range stanzas[file] {
+				phase1 = append(phase1, command(s.Type, s.Args, s.Body))
+			}
+		}
+		phase1 = append(phase1, command("done", nil, nil))
+		commands := client(t, IdentityV1, phase1)
+		require.Len(t, commands, 2)
+		for file, c := range commands {
+			require.Equal(t, "file-key", c.Type)
+			require.Equal(t, []string{[]string{"0", "1"}[file]}, c.Args)
+			require.Equal(t, fileKeys[file], c.Body)
+		}
+	}
+
+	// Another identity finds nothing.
+	commands = client(t, IdentityV1, []*stanza{
+		command("add-identity", []string{carol.String()}, nil),
+		command(stanzas[0][1].Type, stanzas[0][1].Args, stanzas[0][1].Body),
+		command("done", nil, nil),
+	})
+	require.Empty(t, commands)
+}
+
+func TestPluginErrors(t *testing.T) {
+	alice := newTestIdentity(t, 511)
+
+	// Invalid recipients and identities are reported instead of
+	// wrapping the file key.
+	commands := client(t, recipientV1, []*stanza{
+		command("add-recipient", []string{alice.Recipient().String()}, nil),
+		command("add-recipient", []string{"age1ctidh1qqqqqqqq"}, nil),
+		command("add-identity", []string{"AGE-PLUGIN-CTIDH-1QQQQQQQQ"}, nil),
+		command("wrap-file-key", nil, make([]byte, fileKeySize)),
+		command("done", nil, nil),
+	})
+	require.Len(t, commands, 2)
+	require.Equal(t, "error", commands[0].Type)
+	require.Equal(t, []string{"recipient", "1"}, commands[0].Args)
+	require.Equal(t, []string{"identity", "0"}, commands[1].Args)
+
+	commands = client(t, IdentityV1, []*stanza{
+		command("add-identity", []string{"AGE-PLUGIN-CTIDH-1QQQQQQQQ"}, nil),
+		command("add-identity", []string{alice.String()}, nil),
+		command("recipient-stanza", []string{"0", StanzaType, alice.recipient.scheme.Name(), "!", "AAAA"}, make([]byte, wrappedSize)),
+		command("done", nil, nil),
+	})
+	require.Len(t, commands, 2)
+	require.Equal(t, []string{"identity", "0"}, commands[0].Args)
+	require.Equal(t, "error", commands[1].Type)
+	require.Equal(t, []string{"stanza", "0"}, commands[1].Args)
+
+	// A client which does not answer ok breaks the protocol.
+	toPlugin, pluginIn := io.Pipe()
+	pluginOut, fromPlugin := io.Pipe()
+	errs := make(chan error, 1)
+	go func() { errs <- RecipientV1(rand.Reader, toPlugin, fromPlugin) }()
+	go func() {
+		for _, s := range []*stanza{
+			command("add-recipient", []string{alice.Recipient().String()}, nil),
+			command("wrap-file-key", nil, make([]byte, fileKeySize)),
+			command("done", nil, nil),
+		} {
+			writeStanza(pluginIn, s)
+		}
+		newStanzaReader(pluginOut).read()
+		writeStanza(pluginIn, command("fail", nil, nil))
+	}()
+	require.ErrorIs(t, <-errs, ErrProtocol)
+}
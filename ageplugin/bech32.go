@@ -0,0 +1,138 @@
+package ageplugin
+
+import (
+	"errors"
+	"strings"
+)
+
+// This is Bech32 as specified by BIP 173, without its 90 character
+// limit, which age keys exceed too.
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+var errBech32 = errors.New("ageplugin: invalid Bech32 string")
+
+var bech32Generator = [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+func bech32Polymod(values []byte) uint32 {
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				chk ^= bech32Generator[i]
+			}
+		}
+	}
+	return chk
+}
+
+func bech32HRPExpand(hrp string) []byte {
+	v := make([]byte, 0, 2*len(hrp)+1)
+	for i := 0; i < len(hrp); i++ {
+		v = append(v, hrp[i]>>5)
+	}
+	v = append(v, 0)
+	for i := 0; i < len(hrp); i++ {
+		v = append(v, hrp[i]&31)
+	}
+	return v
+}
+
+// convertBits regroups data of fromBits bits per byte into toBits bits
+// per byte, padding the last group with zeros when pad is set.
+func convertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, error) {
+	var out []byte
+	acc, bits := uint32(0), uint(0)
+	maxv := byte(1<<toBits - 1)
+	for _, b := range data {
+		if b>>fromBits != 0 {
+			return nil, errBech32
+		}
+		acc = acc<<fromBits | uint32(b)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			out = append(out, byte(acc>>bits)&maxv)
+		}
+	}
+	if pad {
+		if bits > 0 {
+			out = append(out, byte(acc<<(toBits-bits))&maxv)
+		}
+	} else if bits >= fromBits || byte(acc<<(toBits-bits))&maxv != 0 {
+		return nil, errBech32
+	}
+	return out, nil
+}
+
+// bech32Encode encodes data with the human readable part hrp. The
+// result is upper case if hrp is.
+func bech32Encode(hrp string, data []byte) (string, error) {
+	values, err := convertBits(data, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	upper := strings.ToUpper(hrp) == hrp && strings.ToLower(hrp) != hrp
+	hrp = strings.ToLower(hrp)
+	if len(hrp) == 0 {
+		return "", errBech32
+	}
+	for i := 0; i < len(hrp); i++ {
+		if hrp[i] < 33 || hrp[i] > 126 {
+			return "", errBech32
+		}
+	}
+
+	checksumInput := append(bech32HRPExpand(hrp), values...)
+	polymod := bech32Polymod(append(checksumInput, 0, 0, 0, 0, 0, 0)) ^ 1
+	var b strings.Builder
+	b.WriteString(hrp)
+	b.WriteByte('1')
+	for _, v := range values {
+		b.WriteByte(bech32Charset[v])
+	}
+	for i := 0; i < 6; i++ {
+		b.WriteByte(bech32Charset[(polymod>>uint(5*(5-i)))&31])
+	}
+	if upper {
+		return strings.ToUpper(b.String()), nil
+	}
+	return b.String(), nil
+}
+
+// bech32Decode returns the human readable part, in lower case, and the
+// data of s.
+func bech32Decode(s string) (string, []byte, error) {
+	if strings.ToLower(s) != s && strings.ToUpper(s) != s {
+		return "", nil, errBech32
+	}
+	s = strings.ToLower(s)
+	pos := strings.LastIndexByte(s, '1')
+	if pos < 1 || pos+7 > len(s) {
+		return "", nil, errBech32
+	}
+	hrp := s[:pos]
+	for i := 0; i < len(hrp); i++ {
+		if hrp[i] < 33 || hrp[i] > 126 {
+			return "", nil, errBech32
+		}
+	}
+	values := make([]byte, 0, len(s)-pos-1)
+	for i := pos + 1; i < len(s); i++ {
+		v := strings.IndexByte(bech32Charset, s[i])
+		if v < 0 {
+			return "", nil, errBech32
+		}
+		values = append(values, byte(v))
+	}
+	if bech32Polymod(append(bech32HRPExpand(hrp), values...)) != 1 {
+		return "", nil, errBech32
+	}
+	data, err := convertBits(values[:len(values)-6], 5, 8, false)
+	if err != nil {
+		return "", nil, err
+	}
+	return hrp, data, nil
+}
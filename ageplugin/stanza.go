@@ -0,0 +1,123 @@
+package ageplugin
+
+import (
+	"bufio"
+	"encoding/base64"
+	"errors"
+	"io"
+	"strings"
+)
+
+// columns is the length of the base64 lines of a stanza body.
+const columns = 64
+
+var errStanza = errors.New("ageplugin: malformed stanza")
+
+var b64 = base64.RawStdEncoding.Strict()
+
+// stanza is an age stanza, which is also the unit of the plugin
+// protocol:
+//
+//	-> type arg...
+//	base64 body, in lines of 64 columns, ending with a shorter line
+type stanza struct {
+	Type string
+	Args []string
+	Body []byte
+}
+
+// stanzaReader reads stanzas.
+type stanzaReader struct {
+	r *bufio.Reader
+}
+
+func newStanzaReader(r io.Reader) *stanzaReader {
+	return &stanzaReader{r: bufio.NewReader(r)}
+}
+
+func (sr *stanzaReader) readLine() (string, error) {
+	line, err := sr.r.ReadString('\n')
+	if err == io.EOF && line != "" {
+		return "", io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(line, "\n"), nil
+}
+
+// read returns the next stanza.
+func (sr *stanzaReader) read() (*stanza, error) {
+	line, err := sr.readLine()
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(line, "-> ") {
+		return nil, errStanza
+	}
+	fields := strings.Split(strings.TrimPrefix(line, "-> "), " ")
+	for _, f := range fields {
+		if !isArgument(f) {
+			return nil, errStanza
+		}
+	}
+	s := &stanza{Type: fields[0], Args: fields[1:]}
+
+	for {
+		line, err := sr.readLine()
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		if len(line) > columns {
+			return nil, errStanza
+		}
+		b, err := b64.DecodeString(line)
+		if err != nil {
+			return nil, errStanza
+		}
+		s.Body = append(s.Body, b...)
+		if len(line) < columns {
+			return s, nil
+		}
+	}
+}
+
+// isArgument checks that a is a valid type or argument: one or more
+// printable ASCII characters other than space.
+func isArgument(a string) bool {
+	if a == "" {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if a[i] < 33 || a[i] > 126 {
+			return false
+		}
+	}
+	return true
+}
+
+// writeStanza writes s to w.
+func writeStanza(w io.Writer, s *stanza) error {
+	for _, a := range append([]string{s.Type}, s.Args...) {
+		if !isArgument(a) {
+			return errStanza
+		}
+	}
+	var b strings.Builder
+	b.WriteString("-> ")
+	b.WriteString(strings.Join(append([]string{s.Type}, s.Args...), " "))
+	b.WriteByte('\n')
+	body := b64.EncodeToString(s.Body)
+	for len(body) >= columns {
+		b.WriteString(body[:columns])
+		b.WriteByte('\n')
+		body = body[columns:]
+	}
+	b.WriteString(body)
+	b.WriteByte('\n')
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,23 @@
+#ifndef _CTIDH_BINDING_H
+#define _CTIDH_BINDING_H
+
+/*
+ * BITS is set by the #cgo directives in the bits*.go file
+ * selected by the bits511, bits512, bits1024 or bits2048
+ * build tag, or by the subpackage cgo.go.
+ */
+#if !defined(BITS)
+#error "ctidh: no parameter set selected; build with exactly one of -tags bits511, bits512, bits1024 or bits2048"
+#elif BITS == 511
+#include "binding511.h"
+#elif BITS == 512
+#include "binding512.h"
+#elif BITS == 1024
+#include "binding1024.h"
+#elif BITS == 2048
+#include "binding2048.h"
+#else
+#error "ctidh: BITS must be 511 or 512 or 1024 or 2048"
+#endif
+
+#endif
@@ -8,7 +8,7 @@ import (
 
 func BenchmarkPublicKeySerializing(b *testing.B) {
 	for n := 0; n < b.N; n++ {
-		privKey, publicKey := GenerateKeyPair()
+		privKey, publicKey := MustGenerateKeyPair()
 
 		publicKeyBytes := publicKey.Bytes()
 
@@ -17,7 +17,7 @@ func BenchmarkPublicKeySerializing(b *testing.B) {
 		require.NoError(b, err)
 
 		publicKey2Bytes := publicKey2.Bytes()
-		publicKey3 := DerivePublicKey(privKey)
+		publicKey3 := MustDerivePublicKey(privKey)
 		publicKey3Bytes := publicKey3.Bytes()
 
 		require.Equal(b, publicKeyBytes, publicKey2Bytes)
@@ -27,7 +27,7 @@ func BenchmarkPublicKeySerializing(b *testing.B) {
 
 func BenchmarkPrivateKeySerializing(b *testing.B) {
 	for n := 0; n < b.N; n++ {
-		privateKey, _ := GenerateKeyPair()
+		privateKey, _ := MustGenerateKeyPair()
 		privateKeyBytes := privateKey.Bytes()
 
 		privateKey2 := new(PrivateKey)
@@ -40,31 +40,31 @@ func BenchmarkPrivateKeySerializing(b *testing.B) {
 
 func BenchmarkNIKE(b *testing.B) {
 	for n := 0; n < b.N; n++ {
-		alicePrivate, alicePublic := GenerateKeyPair()
-		bobPrivate, bobPublic := GenerateKeyPair()
+		alicePrivate, alicePublic := MustGenerateKeyPair()
+		bobPrivate, bobPublic := MustGenerateKeyPair()
 
-		bobSharedBytes := DeriveSecret(bobPrivate, alicePublic)
-		aliceSharedBytes := DeriveSecret(alicePrivate, bobPublic)
+		bobSharedBytes := MustDeriveSecret(bobPrivate, alicePublic)
+		aliceSharedBytes := MustDeriveSecret(alicePrivate, bobPublic)
 
 		require.Equal(b, bobSharedBytes, aliceSharedBytes)
 	}
 }
 
 func BenchmarkDeriveSecret(b *testing.B) {
-	alicePrivate, alicePublic := GenerateKeyPair()
-	bobPrivate, bobPublic := GenerateKeyPair()
+	alicePrivate, alicePublic := MustGenerateKeyPair()
+	bobPrivate, bobPublic := MustGenerateKeyPair()
 
 	var aliceSharedBytes []byte
 	for n := 0; n < b.N; n++ {
-		aliceSharedBytes = DeriveSecret(alicePrivate, bobPublic)
+		aliceSharedBytes = MustDeriveSecret(alicePrivate, bobPublic)
 	}
 
-	bobSharedBytes := DeriveSecret(bobPrivate, alicePublic)
+	bobSharedBytes := MustDeriveSecret(bobPrivate, alicePublic)
 	require.Equal(b, bobSharedBytes, aliceSharedBytes)
 }
 
 func BenchmarkGenerateKeyPair(b *testing.B) {
 	for n := 0; n < b.N; n++ {
-		_, _ = GenerateKeyPair()
+		_, _ = MustGenerateKeyPair()
 	}
 }
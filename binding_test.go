@@ -1,6 +1,10 @@
 package ctidh
 
 import (
+	"bytes"
+	"encoding/pem"
+	"io"
+	mrand "math/rand"
 	"os"
 	"path/filepath"
 	"testing"
@@ -9,7 +13,7 @@ import (
 )
 
 func TestPrivateKeyPEMSerialization(t *testing.T) {
-	privateKey, _ := GenerateKeyPair()
+	privateKey, _ := MustGenerateKeyPair()
 
 	tmpdir := os.TempDir()
 
@@ -26,7 +30,7 @@ func TestPrivateKeyPEMSerialization(t *testing.T) {
 }
 
 func TestPublicKeyPEMSerialization(t *testing.T) {
-	_, publicKey := GenerateKeyPair()
+	_, publicKey := MustGenerateKeyPair()
 
 	tmpdir := os.TempDir()
 
@@ -44,7 +48,7 @@ func TestPublicKeyPEMSerialization(t *testing.T) {
 
 func TestPublicKeyReset(t *testing.T) {
 	zeros := make([]byte, PublicKeySize)
-	_, publicKey := GenerateKeyPair()
+	_, publicKey := MustGenerateKeyPair()
 	require.NotEqual(t, publicKey.Bytes(), zeros)
 
 	publicKey.Reset()
@@ -53,7 +57,7 @@ func TestPublicKeyReset(t *testing.T) {
 
 func TestPrivateKeyReset(t *testing.T) {
 	zeros := make([]byte, PrivateKeySize)
-	privateKey, _ := GenerateKeyPair()
+	privateKey, _ := MustGenerateKeyPair()
 	require.NotEqual(t, privateKey.Bytes(), zeros)
 
 	privateKey.Reset()
@@ -61,7 +65,7 @@ func TestPrivateKeyReset(t *testing.T) {
 }
 
 func TestPublicKeyMarshaling(t *testing.T) {
-	privKey, publicKey := GenerateKeyPair()
+	privKey, publicKey := MustGenerateKeyPair()
 	publicKeyBytes := publicKey.Bytes()
 
 	publicKey2 := new(PublicKey)
@@ -70,7 +74,7 @@ func TestPublicKeyMarshaling(t *testing.T) {
 
 	publicKey2Bytes := publicKey2.Bytes()
 
-	publicKey3 := DerivePublicKey(privKey)
+	publicKey3 := MustDerivePublicKey(privKey)
 	publicKey3Bytes := publicKey3.Bytes()
 
 	require.Equal(t, publicKeyBytes, publicKey2Bytes)
@@ -78,7 +82,7 @@ func TestPublicKeyMarshaling(t *testing.T) {
 }
 
 func TestPrivateKeyBytesing(t *testing.T) {
-	privateKey, _ := GenerateKeyPair()
+	privateKey, _ := MustGenerateKeyPair()
 	privateKeyBytes := privateKey.Bytes()
 
 	privateKey2 := new(PrivateKey)
@@ -88,10 +92,116 @@ func TestPrivateKeyBytesing(t *testing.T) {
 	require.Equal(t, privateKeyBytes, privateKey2Bytes)
 }
 
+func TestPrivateKeyValidation(t *testing.T) {
+	invalid := bytes.Repeat([]byte{127}, PrivateKeySize)
+
+	privateKey := NewEmptyPrivateKey()
+	err := privateKey.FromBytes(invalid)
+	require.ErrorIs(t, err, ErrPrivateKeyValidation)
+	require.Equal(t, make([]byte, PrivateKeySize), privateKey.Bytes())
+
+	// Legacy keys can still be loaded explicitly.
+	err = privateKey.FromBytesUnchecked(invalid)
+	require.NoError(t, err)
+	require.Equal(t, invalid, privateKey.Bytes())
+
+	blk, err := privateKey.ToPEM()
+	require.NoError(t, err)
+	pemBytes := pem.EncodeToMemory(blk)
+
+	privateKey2 := NewEmptyPrivateKey()
+	err = privateKey2.FromPEM(pemBytes)
+	require.ErrorIs(t, err, ErrPrivateKeyValidation)
+	err = privateKey2.FromPEMUnchecked(pemBytes)
+	require.NoError(t, err)
+	require.Equal(t, invalid, privateKey2.Bytes())
+
+	pemFile := filepath.Join(t.TempDir(), "invalid_private_key.pem")
+	err = privateKey.ToPEMFile(pemFile)
+	require.NoError(t, err)
+	err = privateKey2.FromPEMFile(pemFile)
+	require.ErrorIs(t, err, ErrPrivateKeyValidation)
+
+	// Keys from the sampler and the all zero key are valid.
+	validKey, _ := MustGenerateKeyPair()
+	err = privateKey2.FromBytes(validKey.Bytes())
+	require.NoError(t, err)
+	err = privateKey2.FromBytes(make([]byte, PrivateKeySize))
+	require.NoError(t, err)
+}
+
 func TestNIKE(t *testing.T) {
-	alicePrivate, alicePublic := GenerateKeyPair()
-	bobPrivate, bobPublic := GenerateKeyPair()
-	bobSharedBytes := DeriveSecret(bobPrivate, alicePublic)
-	aliceSharedBytes := DeriveSecret(alicePrivate, bobPublic)
+	alicePrivate, alicePublic, err := GenerateKeyPair()
+	require.NoError(t, err)
+	bobPrivate, bobPublic, err := GenerateKeyPair()
+	require.NoError(t, err)
+	bobSharedBytes, err := DeriveSecret(bobPrivate, alicePublic)
+	require.NoError(t, err)
+	aliceSharedBytes, err := alicePrivate.DeriveSecret(bobPublic)
+	require.NoError(t, err)
 	require.Equal(t, bobSharedBytes, aliceSharedBytes)
 }
+
+// invalidPublicKey returns a PublicKey holding data which
+// failed validation, as left behind by FromBytes.
+func invalidPublicKey(t *testing.T) (*PublicKey, []byte) {
+	_, publicKey := MustGenerateKeyPair()
+	data := publicKey.Bytes()
+	data[0] ^= 1
+
+	invalid := NewEmptyPublicKey()
+	err := invalid.FromBytes(data)
+	require.ErrorIs(t, err, ErrPublicKeyValidation)
+	return invalid, data
+}
+
+func TestInvalidPublicKeyErrors(t *testing.T) {
+	privateKey, _ := MustGenerateKeyPair()
+	invalid, data := invalidPublicKey(t)
+
+	_, err := NewPublicKey(data)
+	require.ErrorIs(t, err, ErrPublicKeyValidation)
+	require.Panics(t, func() { MustNewPublicKey(data) })
+
+	_, err = NewPublicKey(data[1:])
+	require.ErrorIs(t, err, ErrPublicKeySize)
+
+	_, err = DeriveSecret(privateKey, invalid)
+	require.ErrorIs(t, err, ErrCTIDH)
+	require.ErrorIs(t, err, ErrPublicKeyValidation)
+	var ctidhErr *Error
+	require.ErrorAs(t, err, &ctidhErr)
+	require.Equal(t, "DeriveSecret", ctidhErr.Op)
+	require.Panics(t, func() { MustDeriveSecret(privateKey, invalid) })
+
+	blindingFactor := make([]byte, PrivateKeySize)
+	_, err = Blind(blindingFactor, invalid)
+	require.ErrorIs(t, err, ErrCTIDH)
+	err = invalid.Blind(blindingFactor)
+	require.ErrorIs(t, err, ErrPublicKeyValidation)
+	require.Equal(t, data, invalid.Bytes())
+}
+
+func TestGenerateKeyPairWithReader(t *testing.T) {
+	privateKey1, publicKey1, err := GenerateKeyPairWithReader(mrand.New(mrand.NewSource(1)))
+	require.NoError(t, err)
+	privateKey2, publicKey2, err := GenerateKeyPairWithReader(mrand.New(mrand.NewSource(1)))
+	require.NoError(t, err)
+	require.Equal(t, privateKey1.Bytes(), privateKey2.Bytes())
+	require.Equal(t, publicKey1.Bytes(), publicKey2.Bytes())
+
+	privateKey3, _, err := GenerateKeyPairWithReader(mrand.New(mrand.NewSource(2)))
+	require.NoError(t, err)
+	require.NotEqual(t, privateKey1.Bytes(), privateKey3.Bytes())
+}
+
+func TestGenerateKeyPairWithShortReader(t *testing.T) {
+	privateKey, publicKey, err := GenerateKeyPairWithReader(bytes.NewReader(make([]byte, 3)))
+	require.ErrorIs(t, err, ErrKeyGeneration)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	require.Nil(t, privateKey)
+	require.Nil(t, publicKey)
+
+	_, _, err = GenerateKeyPairWithReader(bytes.NewReader(nil))
+	require.ErrorIs(t, err, ErrKeyGeneration)
+}
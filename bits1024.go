@@ -0,0 +1,7 @@
+//go:build bits1024
+// +build bits1024
+
+package ctidh
+
+// bits is the CTIDH parameter set selected by the bits1024 build tag.
+const bits = 1024
@@ -0,0 +1,7 @@
+//go:build bits2048
+// +build bits2048
+
+package ctidh
+
+// bits is the CTIDH parameter set selected by the bits2048 build tag.
+const bits = 2048
@@ -0,0 +1,7 @@
+//go:build bits511
+// +build bits511
+
+package ctidh
+
+// bits is the CTIDH parameter set selected by the bits511 build tag.
+const bits = 511
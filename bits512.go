@@ -0,0 +1,7 @@
+//go:build bits512
+// +build bits512
+
+package ctidh
+
+// bits is the CTIDH parameter set selected by the bits512 build tag.
+const bits = 512
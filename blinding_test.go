@@ -5,10 +5,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"git.xx.network/elixxir/ctidh_cgo/nike"
 )
 
 func TestSimpleBlindingOperation(t *testing.T) {
-	_, alicePublic := GenerateKeyPair()
+	_, alicePublic := MustGenerateKeyPair()
 
 	blindingFactor := make([]byte, PrivateKeySize)
 	_, err := rand.Read(blindingFactor)
@@ -24,18 +26,106 @@ func TestSimpleBlindingOperation(t *testing.T) {
 }
 
 func TestBlindingOperation(t *testing.T) {
-	mixPrivateKey, mixPublicKey := GenerateKeyPair()
-	clientPrivateKey, clientPublicKey := GenerateKeyPair()
+	mixPrivateKey, mixPublicKey := MustGenerateKeyPair()
+	clientPrivateKey, clientPublicKey := MustGenerateKeyPair()
 
 	blindingFactor := make([]byte, PrivateKeySize)
 	_, err := rand.Read(blindingFactor)
 	require.NoError(t, err)
 
-	value1, err := Blind(blindingFactor, NewPublicKey(DeriveSecret(clientPrivateKey, mixPublicKey)))
+	value1, err := Blind(blindingFactor, MustNewPublicKey(MustDeriveSecret(clientPrivateKey, mixPublicKey)))
 	require.NoError(t, err)
 	blinded, err := Blind(blindingFactor, clientPublicKey)
 	require.NoError(t, err)
-	value2 := DeriveSecret(mixPrivateKey, blinded)
+	value2 := MustDeriveSecret(mixPrivateKey, blinded)
 
 	require.Equal(t, value1.Bytes(), value2)
 }
+
+func TestNewPrivateKeyFromSeed(t *testing.T) {
+	seed := make([]byte, MinSeedSize)
+	privateKey1, err := NewPrivateKeyFromSeed(seed)
+	require.NoError(t, err)
+	privateKey2, err := NewPrivateKeyFromSeed(seed)
+	require.NoError(t, err)
+	require.Equal(t, privateKey1.Bytes(), privateKey2.Bytes())
+
+	seed[0] = 1
+	privateKey3, err := NewPrivateKeyFromSeed(seed)
+	require.NoError(t, err)
+	require.NotEqual(t, privateKey1.Bytes(), privateKey3.Bytes())
+
+	_, err = NewPrivateKeyFromSeed(seed[:MinSeedSize-1])
+	require.ErrorIs(t, err, ErrSeedSize)
+}
+
+func TestBlindingFactorSize(t *testing.T) {
+	_, publicKey := MustGenerateKeyPair()
+
+	_, err := Blind(make([]byte, MinSeedSize-1), publicKey)
+	require.ErrorIs(t, err, ErrBlindDataSizeInvalid)
+	_, err = Blind(make([]byte, MinSeedSize), publicKey)
+	require.NoError(t, err)
+
+	_, err = BlindLegacy(make([]byte, MinSeedSize), publicKey)
+	require.ErrorIs(t, err, ErrBlindDataSizeInvalid)
+	_, err = BlindLegacy(make([]byte, PrivateKeySize), publicKey)
+	require.NoError(t, err)
+}
+
+func TestDeterministicPublicKey(t *testing.T) {
+	publicKey1 := DeterministicPublicKey([]byte("domain"), []byte("message"))
+	publicKey2 := DeterministicPublicKey([]byte("domain"), []byte("message"))
+	require.Equal(t, publicKey1.Bytes(), publicKey2.Bytes())
+	require.NotEqual(t, make([]byte, PublicKeySize), publicKey1.Bytes())
+
+	// The output loads as a valid public key.
+	loaded, err := NewPublicKey(publicKey1.Bytes())
+	require.NoError(t, err)
+	require.True(t, loaded.Equal(publicKey1))
+
+	// The domain and the message are separated.
+	for _, other := range []*PublicKey{
+		DeterministicPublicKey([]byte("domain"), []byte("messagf")),
+		DeterministicPublicKey([]byte("domaim"), []byte("message")),
+		DeterministicPublicKey([]byte("domainm"), []byte("essage")),
+		DeterministicPublicKey(nil, []byte("domainmessage")),
+	} {
+		require.NotEqual(t, publicKey1.Bytes(), other.Bytes())
+	}
+
+	// It is usable in a key agreement.
+	privateKey, _ := MustGenerateKeyPair()
+	_, err = DeriveSecret(privateKey, publicKey1)
+	require.NoError(t, err)
+}
+
+func TestTwist(t *testing.T) {
+	alicePrivate, alicePublic := MustGenerateKeyPair()
+	bobPrivate, bobPublic := MustGenerateKeyPair()
+	negate := func(privateKey *PrivateKey) *PrivateKey {
+		e := privateKey.Bytes()
+		for i := range e {
+			e[i] = -e[i]
+		}
+		negated := new(PrivateKey)
+		require.NoError(t, negated.FromBytes(e))
+		return negated
+	}
+
+	require.NotEqual(t, alicePublic.Bytes(), alicePublic.Twist().Bytes())
+	require.Equal(t, alicePublic.Bytes(), alicePublic.Twist().Twist().Bytes())
+	require.Equal(t, MustDerivePublicKey(negate(alicePrivate)).Bytes(), alicePublic.Twist().Bytes())
+	require.Equal(t, new(PublicKey).Bytes(), new(PublicKey).Twist().Bytes())
+
+	// The action on the twist is the twist of the inverse action.
+	onTwist := MustDeriveSecret(bobPrivate, alicePublic.Twist())
+	inverse := MustNewPublicKey(MustDeriveSecret(negate(bobPrivate), alicePublic))
+	require.Equal(t, inverse.Twist().Bytes(), onTwist)
+
+	twist, err := Scheme().(interface {
+		Twist(nike.PublicKey) (nike.PublicKey, error)
+	}).Twist(bobPublic)
+	require.NoError(t, err)
+	require.Equal(t, bobPublic.Twist().Bytes(), twist.Bytes())
+}
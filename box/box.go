@@ -0,0 +1,303 @@
+// Package box implements authenticated public key encryption in the
+// style of NaCl's crypto_box, with a NIKE such as CTIDH in place of
+// Curve25519 and XChaCha20-Poly1305 in place of XSalsa20-Poly1305.
+//
+// Two parties who know each other's public keys share a key without
+// interacting: DeriveSecret of one's private key and the other's
+// public key. A box sealed under it can only have been sealed by one
+// of the two, but either could have, so unlike a signature it does
+// not prove to anyone else who sealed it.
+//
+// A CTIDH DeriveSecret takes milliseconds, so a Box caches the keys
+// it derives for the most recently used key pairs. Precompute returns
+// the shared key itself, for callers which keep it.
+//
+// As with NaCl, the nonce must never be reused for two messages
+// between the same two keys. Nonces of NonceSize bytes are long
+// enough to be drawn at random.
+package box
+
+import (
+	"container/list"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"sync"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+
+	"git.xx.network/elixxir/ctidh_cgo/internal/stream"
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+)
+
+const (
+	// NonceSize is the size in bytes of the nonce of a box.
+	NonceSize = chacha20poly1305.NonceSizeX
+
+	// Overhead is the size in bytes of the authentication tag of
+	// a box.
+	Overhead = 16
+
+	// StreamNonceSize is the size in bytes of the nonce of a
+	// stream.
+	StreamNonceSize = 16
+
+	// DefaultCacheSize is the number of shared keys a Box caches
+	// by default.
+	DefaultCacheSize = 256
+)
+
+var (
+	// ErrDecrypt indicates a box which failed to authenticate.
+	ErrDecrypt = errors.New("box: message authentication failed")
+
+	// ErrTruncated indicates a stream which ends early.
+	ErrTruncated = stream.ErrTruncated
+
+	// ErrTrailingData indicates a stream which goes on after its
+	// end.
+	ErrTrailingData = stream.ErrTrailingData
+
+	// ErrStreamDecrypt indicates a stream chunk which failed to
+	// authenticate, or which is out of place.
+	ErrStreamDecrypt = stream.ErrDecrypt
+)
+
+// SharedKey is the key shared by a pair of keys.
+type SharedKey struct {
+	box    [chacha20poly1305.KeySize]byte
+	stream [chacha20poly1305.KeySize]byte
+}
+
+// Box seals and opens boxes with keys of a NIKE. It is safe for
+// concurrent use.
+type Box struct {
+	scheme nike.Scheme
+
+	mu        sync.Mutex
+	cacheSize int
+	cacheKey  []byte
+	lru       *list.List
+	cache     map[[sha256.Size]byte]*list.Element
+}
+
+type cacheEntry struct {
+	id  [sha256.Size]byte
+	key *SharedKey
+}
+
+// New returns a Box for scheme which caches up to cacheSize shared
+// keys, or DefaultCacheSize if cacheSize is zero. If cacheSize is
+// negative no keys are cached.
+func New(scheme nike.Scheme, cacheSize int) *Box {
+	if cacheSize == 0 {
+		cacheSize = DefaultCacheSize
+	}
+	// The cache is indexed by a keyed hash of the private and
+	// public key, so that the index does not reveal either.
+	cacheKey := make([]byte, sha256.Size)
+	if _, err := rand.Read(cacheKey); err != nil {
+		panic(err)
+	}
+	return &Box{
+		scheme:    scheme,
+		cacheSize: cacheSize,
+		cacheKey:  cacheKey,
+		lru:       list.New(),
+		cache:     make(map[[sha256.Size]byte]*list.Element),
+	}
+}
+
+// Precompute returns the key shared by privateKey and the owner of
+// peerPublicKey. It comes from the cache if it is there.
+func (b *Box) Precompute(peerPublicKey nike.PublicKey, privateKey nike.PrivateKey) (*SharedKey, error) {
+	var id [sha256.Size]byte
+	if b.cacheSize > 0 {
+		h := hmac.New(sha256.New, b.cacheKey)
+		h.Write(privateKey.Bytes())
+		h.Write(peerPublicKey.Bytes())
+		h.Sum(id[:0])
+
+		b.mu.Lock()
+		if e, ok := b.cache[id]; ok {
+			b.lru.MoveToFront(e)
+			b.mu.Unlock()
+			return e.Value.(*cacheEntry).key, nil
+		}
+		b.mu.Unlock()
+	}
+
+	// The lock is not held over DeriveSecret, so that other
+	// keys can be looked up in the meantime.
+	secret, err := b.scheme.DeriveSecret(privateKey, peerPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	key := new(SharedKey)
+	r := hkdf.New(sha256.New, secret, nil, []byte("box "+b.scheme.Name()))
+	if _, err := io.ReadFull(r, key.box[:]); err != nil {
+		return nil, err
+	}
+	if _, err := io.ReadFull(r, key.stream[:]); err != nil {
+		return nil, err
+	}
+	if b.cacheSize <= 0 {
+		return key, nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if e, ok := b.cache[id]; ok {
+		b.lru.MoveToFront(e)
+		return e.Value.(*cacheEntry).key, nil
+	}
+	b.cache[id] = b.lru.PushFront(&cacheEntry{id: id, key: key})
+	for b.lru.Len() > b.cacheSize {
+		e := b.lru.Back()
+		b.lru.Remove(e)
+		delete(b.cache, e.Value.(*cacheEntry).id)
+	}
+	return key, nil
+}
+
+// CacheLen returns the number of cached shared keys.
+func (b *Box) CacheLen() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.lru.Len()
+}
+
+// Forget empties the cache.
+func (b *Box) Forget() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.lru.Init()
+	b.cache = make(map[[sha256.Size]byte]*list.Element)
+}
+
+// Seal appends to out the box of message, sealed with nonce from the
+// owner of privateKey to the owner of peerPublicKey: the encrypted
+// message followed by the authentication tag.
+func (b *Box) Seal(out, message []byte, nonce *[NonceSize]byte, peerPublicKey nike.PublicKey, privateKey nike.PrivateKey) ([]byte, error) {
+	key, err := b.Precompute(peerPublicKey, privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return key.Seal(out, message, nonce), nil
+}
+
+// Open appends to out the message of box, which was sealed with nonce
+// by the owner of peerPublicKey to the owner of privateKey.
+func (b *Box) Open(out, box []byte, nonce *[NonceSize]byte, peerPublicKey nike.PublicKey, privateKey nike.PrivateKey) ([]byte, error) {
+	key, err := b.Precompute(peerPublicKey, privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return key.Open(out, box, nonce)
+}
+
+// SealDetached is Seal with the authentication tag returned apart
+// from the encrypted message.
+func (b *Box) SealDetached(out, message []byte, nonce *[NonceSize]byte, peerPublicKey nike.PublicKey, privateKey nike.PrivateKey) ([]byte, *[Overhead]byte, error) {
+	key, err := b.Precompute(peerPublicKey, privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	ciphertext, tag := key.SealDetached(out, message, nonce)
+	return ciphertext, tag, nil
+}
+
+// OpenDetached is Open for a box sealed by SealDetached.
+func (b *Box) OpenDetached(out, ciphertext []byte, tag *[Overhead]byte, nonce *[NonceSize]byte, peerPublicKey nike.PublicKey, privateKey nike.PrivateKey) ([]byte, error) {
+	key, err := b.Precompute(peerPublicKey, privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return key.OpenDetached(out, ciphertext, tag, nonce)
+}
+
+// NewWriter returns a writer which seals a stream of any length to w,
+// from the owner of privateKey to the owner of peerPublicKey, in
+// chunks, with nonce. The stream is only complete once the writer is
+// closed.
+func (b *Box) NewWriter(w io.Writer, nonce *[StreamNonceSize]byte, peerPublicKey nike.PublicKey, privateKey nike.PrivateKey) (io.WriteCloser, error) {
+	key, err := b.Precompute(peerPublicKey, privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return key.NewWriter(w, nonce), nil
+}
+
+// NewReader returns a reader which opens a stream sealed by a writer
+// of NewWriter.
+func (b *Box) NewReader(r io.Reader, nonce *[StreamNonceSize]byte, peerPublicKey nike.PublicKey, privateKey nike.PrivateKey) (io.Reader, error) {
+	key, err := b.Precompute(peerPublicKey, privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return key.NewReader(r, nonce), nil
+}
+
+func (k *SharedKey) aead() cipher.AEAD {
+	aead, err := chacha20poly1305.NewX(k.box[:])
+	if err != nil {
+		panic(err)
+	}
+	return aead
+}
+
+// Seal appends to out the box of message sealed under k with nonce.
+func (k *SharedKey) Seal(out, message []byte, nonce *[NonceSize]byte) []byte {
+	return k.aead().Seal(out, nonce[:], message, nil)
+}
+
+// Open appends to out the message of box, sealed under k with nonce.
+func (k *SharedKey) Open(out, box []byte, nonce *[NonceSize]byte) ([]byte, error) {
+	message, err := k.aead().Open(out, nonce[:], box, nil)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return message, nil
+}
+
+// SealDetached is Seal with the authentication tag returned apart
+// from the encrypted message.
+func (k *SharedKey) SealDetached(out, message []byte, nonce *[NonceSize]byte) ([]byte, *[Overhead]byte) {
+	box := k.Seal(out, message, nonce)
+	tag := new([Overhead]byte)
+	copy(tag[:], box[len(box)-Overhead:])
+	return box[:len(box)-Overhead], tag
+}
+
+// OpenDetached is Open for a box sealed by SealDetached.
+func (k *SharedKey) OpenDetached(out, ciphertext []byte, tag *[Overhead]byte, nonce *[NonceSize]byte) ([]byte, error) {
+	box := make([]byte, 0, len(ciphertext)+Overhead)
+	box = append(append(box, ciphertext...), tag[:]...)
+	return k.Open(out, box, nonce)
+}
+
+// NewWriter returns a writer which seals a stream under k to w, as
+// Box.NewWriter does.
+func (k *SharedKey) NewWriter(w io.Writer, nonce *[StreamNonceSize]byte) io.WriteCloser {
+	return stream.NewWriter(k.streamAEAD(), nonce[:], w)
+}
+
+// NewReader returns a reader which opens a stream sealed under k, as
+// Box.NewReader does.
+func (k *SharedKey) NewReader(r io.Reader, nonce *[StreamNonceSize]byte) io.Reader {
+	return stream.NewReader(k.streamAEAD(), nonce[:], r)
+}
+
+// streamAEAD returns the AEAD of streams, which has its own key so
+// that the nonces of chunks and of boxes never meet.
+func (k *SharedKey) streamAEAD() cipher.AEAD {
+	aead, err := chacha20poly1305.NewX(k.stream[:])
+	if err != nil {
+		panic(err)
+	}
+	return aead
+}
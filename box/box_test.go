@@ -0,0 +1,214 @@
+package box
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"git.xx.network/elixxir/ctidh_cgo/ctidh511"
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+	"git.xx.network/elixxir/ctidh_cgo/nike/x25519"
+)
+
+type testKeys struct {
+	alicePrivate, bobPrivate nike.PrivateKey
+	alicePublic, bobPublic   nike.PublicKey
+}
+
+func newTestKeys(t *testing.T, scheme nike.Scheme) *testKeys {
+	k := new(testKeys)
+	var err error
+	k.alicePrivate, k.alicePublic, err = scheme.GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+	k.bobPrivate, k.bobPublic, err = scheme.GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+	return k
+}
+
+func newNonce(t *testing.T) *[NonceSize]byte {
+	nonce := new([NonceSize]byte)
+	_, err := rand.Read(nonce[:])
+	require.NoError(t, err)
+	return nonce
+}
+
+func TestSealOpen(t *testing.T) {
+	for _, scheme := range []nike.Scheme{ctidh511.Scheme(), x25519.Scheme()} {
+		t.Run(scheme.Name(), func(t *testing.T) {
+			b := New(scheme, 0)
+			k := newTestKeys(t, scheme)
+			message := []byte("the quick brown fox")
+			nonce := newNonce(t)
+
+			sealed, err := b.Seal([]byte("prefix"), message, nonce, k.bobPublic, k.alicePrivate)
+			require.NoError(t, err)
+			require.Equal(t, "prefix", string(sealed[:6]))
+			sealed = sealed[6:]
+			require.Len(t, sealed, len(message)+Overhead)
+
+			opened, err := b.Open(nil, sealed, nonce, k.alicePublic, k.bobPrivate)
+			require.NoError(t, err)
+			require.Equal(t, message, opened)
+
+			// Bob can seal for Alice under the same key.
+			reply, err := b.Seal(nil, message, nonce, k.alicePublic, k.bobPrivate)
+			require.NoError(t, err)
+			require.Equal(t, sealed, reply)
+
+			// The detached mode gives the same box in two parts.
+			ciphertext, tag, err := b.SealDetached(nil, message, nonce, k.bobPublic, k.alicePrivate)
+			require.NoError(t, err)
+			require.Equal(t, sealed, append(ciphertext, tag[:]...))
+			opened, err = b.OpenDetached(nil, ciphertext, tag, nonce, k.alicePublic, k.bobPrivate)
+			require.NoError(t, err)
+			require.Equal(t, message, opened)
+		})
+	}
+}
+
+func TestOpenFailures(t *testing.T) {
+	scheme := ctidh511.Scheme()
+	b := New(scheme, 0)
+	k := newTestKeys(t, scheme)
+	nonce := newNonce(t)
+	sealed, err := b.Seal(nil, []byte("hello"), nonce, k.bobPublic, k.alicePrivate)
+	require.NoError(t, err)
+
+	for i := range sealed {
+		tampered := append([]byte{}, sealed...)
+		tampered[i] ^= 1
+		_, err := b.Open(nil, tampered, nonce, k.alicePublic, k.bobPrivate)
+		require.ErrorIs(t, err, ErrDecrypt)
+	}
+	_, err = b.Open(nil, sealed, newNonce(t), k.alicePublic, k.bobPrivate)
+	require.ErrorIs(t, err, ErrDecrypt)
+	_, err = b.Open(nil, sealed[:Overhead-1], nonce, k.alicePublic, k.bobPrivate)
+	require.ErrorIs(t, err, ErrDecrypt)
+
+	// A third party cannot open it.
+	eve := newTestKeys(t, scheme)
+	_, err = b.Open(nil, sealed, nonce, k.alicePublic, eve.bobPrivate)
+	require.ErrorIs(t, err, ErrDecrypt)
+
+	// Keys of another scheme are refused.
+	_, err = b.Seal(nil, nil, nonce, newTestKeys(t, x25519.Scheme()).bobPublic, k.alicePrivate)
+	require.ErrorIs(t, err, nike.ErrKeyType)
+}
+
+func TestCache(t *testing.T) {
+	scheme := x25519.Scheme()
+	b := New(scheme, 2)
+	k := newTestKeys(t, scheme)
+	others := []*testKeys{newTestKeys(t, scheme), newTestKeys(t, scheme)}
+
+	key, err := b.Precompute(k.bobPublic, k.alicePrivate)
+	require.NoError(t, err)
+	cached, err := b.Precompute(k.bobPublic, k.alicePrivate)
+	require.NoError(t, err)
+	require.Same(t, key, cached)
+	require.Equal(t, 1, b.CacheLen())
+
+	// Each side caches its own view of the key pair.
+	bobKey, err := b.Precompute(k.alicePublic, k.bobPrivate)
+	require.NoError(t, err)
+	require.NotSame(t, key, bobKey)
+	require.Equal(t, *key, *bobKey)
+	require.Equal(t, 2, b.CacheLen())
+
+	// The least recently used key is evicted.
+	_, err = b.Precompute(k.bobPublic, k.alicePrivate)
+	require.NoError(t, err)
+	_, err = b.Precompute(others[0].bobPublic, others[1].alicePrivate)
+	require.NoError(t, err)
+	require.Equal(t, 2, b.CacheLen())
+	cached, err = b.Precompute(k.bobPublic, k.alicePrivate)
+	require.NoError(t, err)
+	require.Same(t, key, cached)
+	cached, err = b.Precompute(k.alicePublic, k.bobPrivate)
+	require.NoError(t, err)
+	require.NotSame(t, bobKey, cached)
+
+	b.Forget()
+	require.Equal(t, 0, b.CacheLen())
+
+	uncached := New(scheme, -1)
+	key, err = uncached.Precompute(k.bobPublic, k.alicePrivate)
+	require.NoError(t, err)
+	cached, err = uncached.Precompute(k.bobPublic, k.alicePrivate)
+	require.NoError(t, err)
+	require.NotSame(t, key, cached)
+	require.Equal(t, *key, *cached)
+	require.Equal(t, 0, uncached.CacheLen())
+}
+
+func TestConcurrentUse(t *testing.T) {
+	scheme := ctidh511.Scheme()
+	b := New(scheme, 4)
+	keys := []*testKeys{newTestKeys(t, scheme), newTestKeys(t, scheme)}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		k := keys[i%len(keys)]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			nonce := newNonce(t)
+			sealed, err := b.Seal(nil, []byte("hello"), nonce, k.bobPublic, k.alicePrivate)
+			require.NoError(t, err)
+			opened, err := b.Open(nil, sealed, nonce, k.alicePublic, k.bobPrivate)
+			require.NoError(t, err)
+			require.Equal(t, "hello", string(opened))
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, 4, b.CacheLen())
+}
+
+func TestStream(t *testing.T) {
+	scheme := ctidh511.Scheme()
+	b := New(scheme, 0)
+	k := newTestKeys(t, scheme)
+	nonce := new([StreamNonceSize]byte)
+	_, err := rand.Read(nonce[:])
+	require.NoError(t, err)
+
+	plaintext := make([]byte, 1<<20+12345)
+	_, err = rand.Read(plaintext)
+	require.NoError(t, err)
+
+	var sealed bytes.Buffer
+	w, err := b.NewWriter(&sealed, nonce, k.bobPublic, k.alicePrivate)
+	require.NoError(t, err)
+	_, err = io.Copy(w, bytes.NewReader(plaintext))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	r, err := b.NewReader(bytes.NewReader(sealed.Bytes()), nonce, k.alicePublic, k.bobPrivate)
+	require.NoError(t, err)
+	opened, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, plaintext, opened)
+
+	// A stream cut at a chunk boundary is truncated, one cut
+	// elsewhere fails to authenticate.
+	for _, test := range []struct {
+		size int
+		err  error
+	}{
+		{16 * (64*1024 + Overhead), ErrTruncated},
+		{sealed.Len() - 1, ErrStreamDecrypt},
+	} {
+		r, err = b.NewReader(bytes.NewReader(sealed.Bytes()[:test.size]), nonce, k.alicePublic, k.bobPrivate)
+		require.NoError(t, err)
+		_, err = io.ReadAll(r)
+		require.ErrorIs(t, err, test.err)
+	}
+
+	// The stream key is not the box key.
+	key, err := b.Precompute(k.bobPublic, k.alicePrivate)
+	require.NoError(t, err)
+	require.NotEqual(t, key.box, key.stream)
+}
@@ -0,0 +1,15 @@
+//go:build bits1024
+// +build bits1024
+
+package ctidh
+
+// #cgo CFLAGS: -DBITS=1024 -DCGONUTS -I${SRCDIR}/ctidh1024
+// #cgo !amd64 CFLAGS: -DHIGHCTIDH_PORTABLE
+// #cgo ctidh_portable CFLAGS: -DHIGHCTIDH_PORTABLE
+import "C"
+
+import (
+	// The vendored CTIDH-1024 sources are compiled and
+	// linked by the ctidh1024 subpackage.
+	_ "git.xx.network/elixxir/ctidh_cgo/ctidh1024"
+)
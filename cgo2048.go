@@ -0,0 +1,15 @@
+//go:build bits2048
+// +build bits2048
+
+package ctidh
+
+// #cgo CFLAGS: -DBITS=2048 -DCGONUTS -I${SRCDIR}/ctidh2048
+// #cgo !amd64 CFLAGS: -DHIGHCTIDH_PORTABLE
+// #cgo ctidh_portable CFLAGS: -DHIGHCTIDH_PORTABLE
+import "C"
+
+import (
+	// The vendored CTIDH-2048 sources are compiled and
+	// linked by the ctidh2048 subpackage.
+	_ "git.xx.network/elixxir/ctidh_cgo/ctidh2048"
+)
@@ -0,0 +1,15 @@
+//go:build bits511
+// +build bits511
+
+package ctidh
+
+// #cgo CFLAGS: -DBITS=511 -DCGONUTS -I${SRCDIR}/ctidh511
+// #cgo !amd64 CFLAGS: -DHIGHCTIDH_PORTABLE
+// #cgo ctidh_portable CFLAGS: -DHIGHCTIDH_PORTABLE
+import "C"
+
+import (
+	// The vendored CTIDH-511 sources are compiled and
+	// linked by the ctidh511 subpackage.
+	_ "git.xx.network/elixxir/ctidh_cgo/ctidh511"
+)
@@ -0,0 +1,15 @@
+//go:build bits512
+// +build bits512
+
+package ctidh
+
+// #cgo CFLAGS: -DBITS=512 -DCGONUTS -I${SRCDIR}/ctidh512
+// #cgo !amd64 CFLAGS: -DHIGHCTIDH_PORTABLE
+// #cgo ctidh_portable CFLAGS: -DHIGHCTIDH_PORTABLE
+import "C"
+
+import (
+	// The vendored CTIDH-512 sources are compiled and
+	// linked by the ctidh512 subpackage.
+	_ "git.xx.network/elixxir/ctidh_cgo/ctidh512"
+)
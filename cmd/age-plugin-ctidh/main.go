@@ -0,0 +1,75 @@
+// Command age-plugin-ctidh is an age plugin for CTIDH recipients and
+// identities.
+//
+// Generate an identity, which also prints its recipient, with
+//
+//	age-plugin-ctidh -generate [-bits 1024] > key.txt
+//
+// and use them with age:
+//
+//	age -r age1ctidh1... -o backup.age backup.tar
+//	age -d -i key.txt -o backup.tar backup.age
+//
+// age runs the plugin itself, with --age-plugin=recipient-v1 or
+// --age-plugin=identity-v1, when it meets such recipients and
+// identities.
+package main
+
+import (
+	"bufio"
+	"crypto/rand"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"git.xx.network/elixxir/ctidh_cgo/ageplugin"
+)
+
+func main() {
+	plugin := flag.String("age-plugin", "", "run the age plugin `state machine` recipient-v1 or identity-v1")
+	generate := flag.Bool("generate", false, "generate a new identity")
+	bits := flag.Uint("bits", 1024, "CTIDH parameter set of a new identity: 511, 512, 1024 or 2048")
+	flag.Parse()
+
+	var err error
+	switch {
+	case *plugin == "recipient-v1":
+		err = ageplugin.RecipientV1(rand.Reader, os.Stdin, os.Stdout)
+	case *plugin == "identity-v1":
+		err = ageplugin.IdentityV1(os.Stdin, os.Stdout)
+	case *plugin != "":
+		err = fmt.Errorf("unknown state machine %q", *plugin)
+	case *generate:
+		err = generateIdentity(*bits)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "age-plugin-ctidh:", err)
+		os.Exit(1)
+	}
+}
+
+func generateIdentity(bits uint) error {
+	scheme := ageplugin.Scheme(uint16(bits))
+	if bits > 0xffff || scheme == nil {
+		return fmt.Errorf("unsupported parameter set %d", bits)
+	}
+	identity, err := ageplugin.GenerateIdentity(scheme, rand.Reader)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(os.Stdout)
+	fmt.Fprintf(w, "# created: %s\n", time.Now().Format(time.RFC3339))
+	fmt.Fprintf(w, "# recipient: %s\n", identity.Recipient())
+	fmt.Fprintln(w, identity)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if fi, err := os.Stdout.Stat(); err == nil && fi.Mode()&os.ModeCharDevice == 0 {
+		fmt.Fprintf(os.Stderr, "Public key: %s\n", identity.Recipient())
+	}
+	return nil
+}
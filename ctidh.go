@@ -0,0 +1,485 @@
+package ctidh
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+
+	"golang.org/x/crypto/sha3"
+
+	"git.xx.network/elixxir/ctidh_cgo/internal/purego"
+)
+
+// MinSeedSize is the minimum size in bytes of the seeds accepted by
+// NewPrivateKeyFromSeed and of the blinding factors accepted by Blind.
+const MinSeedSize = 32
+
+var (
+	// PublicKeySize is the size in bytes of the public key.
+	PublicKeySize int
+
+	// PrivateKeySize is the size in bytes of the private key.
+	PrivateKeySize int
+
+	// modulus is the prime p of the parameter set.
+	modulus = purego.ParamsForBits(bits).Modulus()
+)
+
+// NewEmptyPublicKey returns an uninitialized
+// PublicKey which is suitable to be loaded
+// via some serialization format via FromBytes
+// or FromPEMFile methods.
+func NewEmptyPublicKey() *PublicKey {
+	return new(PublicKey)
+}
+
+// NewPublicKey creates a new public key from
+// the given key material or returns an error if
+// the key data is not a valid public key.
+func NewPublicKey(key []byte) (*PublicKey, error) {
+	k := new(PublicKey)
+	err := k.FromBytes(key)
+	if err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// MustNewPublicKey is like NewPublicKey
+// but panics if the key data is invalid.
+func MustNewPublicKey(key []byte) *PublicKey {
+	k, err := NewPublicKey(key)
+	if err != nil {
+		panic(err)
+	}
+	return k
+}
+
+// String returns a string identifying
+// this type as a CTIDH public key.
+func (p *PublicKey) String() string {
+	return Name() + "_PublicKey"
+}
+
+// ToPEM writes out the PublicKey to a PEM block and returns it
+func (p *PublicKey) ToPEM() (*pem.Block, error) {
+	keyType := Name() + " PUBLIC KEY"
+
+	zeros := make([]byte, PublicKeySize)
+	if bytes.Equal(p.Bytes(), zeros) {
+		return nil, fmt.Errorf("%s: attemted to serialize scrubbed key",
+			Name())
+	}
+	blk := &pem.Block{
+		Type:  keyType,
+		Bytes: p.Bytes(),
+	}
+	return blk, nil
+}
+
+// ToPEMFile writes out the PublicKey to a PEM file at path f.
+func (p *PublicKey) ToPEMFile(f string) error {
+	blk, err := p.ToPEM()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(f, pem.EncodeToMemory(blk), 0600)
+}
+
+// FromPEM reads the PublicKey from a PEM encoded byte slice.
+func (p *PublicKey) FromPEM(pemBytes []byte) error {
+	keyType := Name() + " PUBLIC KEY"
+
+	blk, _ := pem.Decode(pemBytes)
+	if blk == nil {
+		return fmt.Errorf("%s: failed to decode PEM", Name())
+	}
+	if blk.Type != keyType {
+		return ErrPEMKeyTypeMismatch(blk.Type, keyType)
+	}
+	return p.FromBytes(blk.Bytes)
+}
+
+// FromPEMFile reads the PublicKey from a PEM file at path f.
+func (p *PublicKey) FromPEMFile(f string) error {
+	buf, err := ioutil.ReadFile(f)
+	if err != nil {
+		return err
+	}
+	err = p.FromPEM(buf)
+	if err != nil {
+		return fmt.Errorf("%w in file %s", err, f)
+	}
+	return nil
+}
+
+// Reset resets the PublicKey to all zeros.
+func (p *PublicKey) Reset() {
+	*p = PublicKey{}
+}
+
+// Equal is a constant time comparison of the two public keys.
+func (p *PublicKey) Equal(publicKey *PublicKey) bool {
+	return hmac.Equal(p.Bytes(), publicKey.Bytes())
+}
+
+// MarshalBinary is an implementation of a method on the
+// BinaryMarshaler interface defined in https://golang.org/pkg/encoding/
+func (p *PublicKey) MarshalBinary() ([]byte, error) {
+	return p.Bytes(), nil
+}
+
+// UnmarshalBinary is an implementation of a method on the
+// BinaryUnmarshaler interface defined in https://golang.org/pkg/encoding/
+func (p *PublicKey) UnmarshalBinary(data []byte) error {
+	return p.FromBytes(data)
+}
+
+// Blind performs a blinding operation
+// and mutates the public key.
+// See Blind for the details.
+func (p *PublicKey) Blind(blindingFactor []byte) error {
+	blinded, err := Blind(blindingFactor, p)
+	if err != nil {
+		return err
+	}
+	p.publicKey = blinded.publicKey
+	return nil
+}
+
+// BlindLegacy performs the blinding operation of
+// earlier releases and mutates the public key.
+// See BlindLegacy for the details.
+//
+// Deprecated: Use Blind.
+func (p *PublicKey) BlindLegacy(blindingFactor []byte) error {
+	blinded, err := BlindLegacy(blindingFactor, p)
+	if err != nil {
+		return err
+	}
+	p.publicKey = blinded.publicKey
+	return nil
+}
+
+// Twist returns the quadratic twist of the public key, the curve
+// with the Montgomery coefficient -A. It inverts the class group
+// element of the key: the twist of the public key of a private key is
+// the public key of its negation, and the twist of a group action of
+// a private key is the action of its negation on the twist.
+func (p *PublicKey) Twist() *PublicKey {
+	a := new(big.Int).SetBytes(reverse(p.Bytes()))
+	if a.Sign() != 0 {
+		a.Sub(modulus, a)
+	}
+	twist := new(PublicKey)
+	if err := twist.FromBytes(reverse(a.FillBytes(make([]byte, PublicKeySize)))); err != nil {
+		// The twist of a valid key is valid.
+		panic(err)
+	}
+	return twist
+}
+
+// NewPrivateKeyFromSeed deterministically derives a private key
+// from a seed of at least MinSeedSize bytes.
+//
+// The seed is expanded with SHAKE256 over the ASCII string
+// Name()+" private key seed", a zero byte and then the seed. The
+// output stream drives the same sampler that GenerateKeyPair feeds
+// with fresh randomness, so the exponent vector has the distribution
+// and per batch bounds of a random private key. The cgo and pure Go
+// implementations derive identical keys.
+func NewPrivateKeyFromSeed(seed []byte) (*PrivateKey, error) {
+	if len(seed) < MinSeedSize {
+		return nil, ErrSeedSize
+	}
+	xof := sha3.NewShake256()
+	xof.Write([]byte(Name() + " private key seed\x00"))
+	xof.Write(seed)
+	privKey, err := generatePrivateKey(xof)
+	if err != nil {
+		return nil, &Error{Op: "NewPrivateKeyFromSeed", Kind: ErrKeyGeneration, Err: err}
+	}
+	return privKey, nil
+}
+
+// NewEmptyPrivateKey returns an uninitialized
+// PrivateKey which is suitable to be loaded
+// via some serialization format via FromBytes
+// or FromPEMFile methods.
+func NewEmptyPrivateKey() *PrivateKey {
+	return new(PrivateKey)
+}
+
+// DeriveSecret derives a shared secret.
+func (p *PrivateKey) DeriveSecret(publicKey *PublicKey) ([]byte, error) {
+	return DeriveSecret(p, publicKey)
+}
+
+// String returns a string identifying
+// this type as a CTIDH private key.
+func (p *PrivateKey) String() string {
+	return Name() + "_PrivateKey"
+}
+
+// Reset resets the PrivateKey to all zeros.
+func (p *PrivateKey) Reset() {
+	*p = PrivateKey{}
+}
+
+// Equal is a constant time comparison of the two private keys.
+func (p *PrivateKey) Equal(privateKey *PrivateKey) bool {
+	return hmac.Equal(p.Bytes(), privateKey.Bytes())
+}
+
+// MarshalBinary is an implementation of a method on the
+// BinaryMarshaler interface defined in https://golang.org/pkg/encoding/
+func (p *PrivateKey) MarshalBinary() ([]byte, error) {
+	return p.Bytes(), nil
+}
+
+// UnmarshalBinary is an implementation of a method on the
+// BinaryUnmarshaler interface defined in https://golang.org/pkg/encoding/
+func (p *PrivateKey) UnmarshalBinary(data []byte) error {
+	return p.FromBytes(data)
+}
+
+// ToPEM writes out the PrivateKey to a PEM block.
+func (p *PrivateKey) ToPEM() (*pem.Block, error) {
+	keyType := Name() + " PRIVATE KEY"
+
+	zeros := make([]byte, PrivateKeySize)
+	if bytes.Equal(p.Bytes(), zeros) {
+		return nil, fmt.Errorf("%s: attemted to serialize scrubbed key",
+			Name())
+	}
+	blk := &pem.Block{
+		Type:  keyType,
+		Bytes: p.Bytes(),
+	}
+	return blk, nil
+}
+
+// ToPEMFile writes out the PrivateKey to a PEM file at path f.
+func (p *PrivateKey) ToPEMFile(f string) error {
+	blk, err := p.ToPEM()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(f, pem.EncodeToMemory(blk), 0600)
+}
+
+// FromPEM reads the PrivateKey from a PEM byte slice, and returns
+// ErrPrivateKeyValidation if the exponents are outside of the CTIDH
+// batch bounds.
+func (p *PrivateKey) FromPEM(pemBytes []byte) error {
+	return p.fromPEM(pemBytes, p.FromBytes)
+}
+
+// FromPEMUnchecked reads the PrivateKey from a PEM byte slice without
+// checking the exponent bounds, like FromBytesUnchecked.
+func (p *PrivateKey) FromPEMUnchecked(pemBytes []byte) error {
+	return p.fromPEM(pemBytes, p.FromBytesUnchecked)
+}
+
+func (p *PrivateKey) fromPEM(pemBytes []byte, fromBytes func([]byte) error) error {
+	keyType := Name() + " PRIVATE KEY"
+
+	blk, _ := pem.Decode(pemBytes)
+	if blk == nil {
+		return fmt.Errorf("%s: failed to decode PEM bytes", Name())
+	}
+	if blk.Type != keyType {
+		return ErrPEMKeyTypeMismatch(blk.Type, keyType)
+	}
+	return fromBytes(blk.Bytes)
+}
+
+// FromPEMFile reads the PrivateKey from a PEM file at path f,
+// validating it like FromPEM.
+func (p *PrivateKey) FromPEMFile(f string) error {
+	buf, err := ioutil.ReadFile(f)
+	if err != nil {
+		return err
+	}
+	err = p.FromPEM(buf)
+	if err != nil {
+		return fmt.Errorf("%w in file %s", err, f)
+	}
+	return nil
+}
+
+// PublicKey returns the public key associated
+// with the given private key.
+func (p *PrivateKey) PublicKey() (*PublicKey, error) {
+	return DerivePublicKey(p)
+}
+
+// DerivePublicKey derives a public key given a private key.
+func DerivePublicKey(privKey *PrivateKey) (*PublicKey, error) {
+	// The all zero public key is the base curve.
+	publicKey, err := groupAction(privKey, new(PublicKey))
+	if err != nil {
+		return nil, &Error{Op: "DerivePublicKey", Kind: ErrCTIDH, Err: err}
+	}
+	return publicKey, nil
+}
+
+// MustDerivePublicKey is like DerivePublicKey
+// but panics if the group action fails.
+func MustDerivePublicKey(privKey *PrivateKey) *PublicKey {
+	publicKey, err := DerivePublicKey(privKey)
+	if err != nil {
+		panic(err)
+	}
+	return publicKey
+}
+
+// DeterministicPublicKey derives a public key from domain and msg:
+// the same inputs always give the same key, and different domains
+// give unrelated keys. It is a domain-separated deterministic key
+// derivation, not a hash to the group.
+//
+// SHAKE256 is taken over the ASCII string Name()+" deterministic
+// public key", a zero byte, the length of domain as eight big-endian
+// bytes, domain and then msg. The output stream drives the sampler of
+// GenerateKeyPair, and the result is the public key of the sampled
+// private key. The cgo and pure Go implementations derive identical
+// keys.
+//
+// Anyone who knows domain and msg can compute the private key of the
+// result, so it must not be used where no one may know it.
+func DeterministicPublicKey(domain, msg []byte) *PublicKey {
+	xof := sha3.NewShake256()
+	xof.Write([]byte(Name() + " deterministic public key\x00"))
+	var length [8]byte
+	binary.BigEndian.PutUint64(length[:], uint64(len(domain)))
+	xof.Write(length[:])
+	xof.Write(domain)
+	xof.Write(msg)
+	privKey, err := generatePrivateKey(xof)
+	if err != nil {
+		// SHAKE256 never runs short.
+		panic(err)
+	}
+	defer privKey.Reset()
+	return MustDerivePublicKey(privKey)
+}
+
+// GenerateKeyPair generates a new private and then
+// attempts to compute the public key.
+func GenerateKeyPair() (*PrivateKey, *PublicKey, error) {
+	return generateKeyPair("GenerateKeyPair", rand.Reader)
+}
+
+// GenerateKeyPairWithReader is like GenerateKeyPair
+// but reads the randomness for the private key from
+// rng, so the same stream always yields the same key.
+// It returns an error matching ErrKeyGeneration if
+// rng fails or comes up short.
+func GenerateKeyPairWithReader(rng io.Reader) (*PrivateKey, *PublicKey, error) {
+	return generateKeyPair("GenerateKeyPairWithReader", rng)
+}
+
+func generateKeyPair(op string, rng io.Reader) (*PrivateKey, *PublicKey, error) {
+	privKey, err := generatePrivateKey(rng)
+	if err != nil {
+		return nil, nil, &Error{Op: op, Kind: ErrKeyGeneration, Err: err}
+	}
+	publicKey, err := DerivePublicKey(privKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return privKey, publicKey, nil
+}
+
+// MustGenerateKeyPair is like GenerateKeyPair
+// but panics if key generation fails.
+func MustGenerateKeyPair() (*PrivateKey, *PublicKey) {
+	privKey, publicKey, err := GenerateKeyPair()
+	if err != nil {
+		panic(err)
+	}
+	return privKey, publicKey
+}
+
+// DeriveSecret derives a shared secret. It fails with an
+// error matching ErrCTIDH and ErrPublicKeyValidation if
+// publicKey is not a valid public key.
+func DeriveSecret(privateKey *PrivateKey, publicKey *PublicKey) ([]byte, error) {
+	sharedSecret, err := groupAction(privateKey, publicKey)
+	if err != nil {
+		return nil, &Error{Op: "DeriveSecret", Kind: ErrCTIDH, Err: err}
+	}
+	return sharedSecret.Bytes(), nil
+}
+
+// MustDeriveSecret is like DeriveSecret
+// but panics if the group action fails.
+func MustDeriveSecret(privateKey *PrivateKey, publicKey *PublicKey) []byte {
+	sharedSecret, err := DeriveSecret(privateKey, publicKey)
+	if err != nil {
+		panic(err)
+	}
+	return sharedSecret
+}
+
+// Blind performs a blinding operation returning the blinded public key.
+//
+// The blinding factor is a seed of at least MinSeedSize bytes. It is
+// turned into a private key by NewPrivateKeyFromSeed, whose group
+// action on publicKey is the blinded key.
+func Blind(blindingFactor []byte, publicKey *PublicKey) (*PublicKey, error) {
+	if len(blindingFactor) < MinSeedSize {
+		return nil, ErrBlindDataSizeInvalid
+	}
+
+	privKey, err := NewPrivateKeyFromSeed(blindingFactor)
+	if err != nil {
+		return nil, err
+	}
+	blinded, err := groupAction(privKey, publicKey)
+	if err != nil {
+		return nil, &Error{Op: "Blind", Kind: ErrCTIDH, Err: err}
+	}
+	return blinded, nil
+}
+
+// BlindLegacy performs the blinding operation of earlier releases,
+// which reinterprets the PrivateKeySize bytes of the blinding factor
+// as an exponent vector. Random bytes are not a valid CTIDH private
+// key: the exponents exceed the bounds the group action is computed
+// for, so part of the factor is silently ignored. It is kept only to
+// recompute blinded keys stored by earlier releases.
+//
+// Deprecated: Use Blind.
+func BlindLegacy(blindingFactor []byte, publicKey *PublicKey) (*PublicKey, error) {
+	if len(blindingFactor) != PrivateKeySize {
+		return nil, ErrBlindDataSizeInvalid
+	}
+
+	privKey := new(PrivateKey)
+	err := privKey.FromBytesUnchecked(blindingFactor)
+	if err != nil {
+		return nil, err
+	}
+	blinded, err := groupAction(privKey, publicKey)
+	if err != nil {
+		return nil, &Error{Op: "BlindLegacy", Kind: ErrCTIDH, Err: err}
+	}
+	return blinded, nil
+}
+
+// Name returns the string naming of the current
+// CTIDH that this binding is being used with;
+// Valid values are:
+//
+// CTIDH-511, CTIDH-512, CTIDH-1024 and, CTIDH-2048.
+func Name() string {
+	return fmt.Sprintf("CTIDH-%d", bits)
+}
@@ -0,0 +1,11 @@
+#ifndef crypto_classify_h
+#define crypto_classify_h
+
+#if defined(CGONUTS)
+#include "cgo.h"
+#include "crypto_classify_namespace.h"
+#endif
+
+void crypto_classify(void *,unsigned long long);
+
+#endif
@@ -0,0 +1,11 @@
+#ifndef crypto_declassify_h
+#define crypto_declassify_h
+
+#if defined(CGONUTS)
+#include "cgo.h"
+#include "crypto_declassify_namespace.h"
+#endif
+
+void crypto_declassify(void *,unsigned long long);
+
+#endif
@@ -0,0 +1,20046 @@
+#if defined(HIGHCTIDH_PORTABLE)
+#include "fiat_p1024.h"
+/* Autogenerated: './src/ExtractionOCaml/word_by_word_montgomery' --widen-bytes --internal-static --lang C -o f_1024.c p1024 64 10397125823368453045280646945602587680645373501407971524723936453540081402468763489237207685909470852314300607054838964876369377755966328162572638385477152807267330439309520268717606043270205686916407950751430775965737505772592082230763227336919124289210653129066316362633007647060631513541162047451995268179 */
+/* curve description: p1024 */
+/* machine_wordsize = 64 (from "64") */
+/* requested operations: (all) */
+/* m = 0xece55ed427012a9d89dec879007ebd7216c22bc86f21a080683cf25db31ad5bf06de2471cf9386e4d6c594a8ad82d2df811d9c419ec83297611ad4f90441c800978dbeed90a2b58b97c56d1de81ede56b317c5431541f40642aca4d5a313709c2cab6a0e287f1bd514ba72cb8d89fd3a1d81eebbc3d344ddbe34c5460e36453 (from "10397125823368453045280646945602587680645373501407971524723936453540081402468763489237207685909470852314300607054838964876369377755966328162572638385477152807267330439309520268717606043270205686916407950751430775965737505772592082230763227336919124289210653129066316362633007647060631513541162047451995268179") */
+/*                                                                    */
+/* NOTE: In addition to the bounds specified above each function, all */
+/*   functions synthesized for this Montgomery arithmetic require the */
+/*   input to be strictly less than the prime modulus (m), and also   */
+/*   require the input to be in the unique saturated representation.  */
+/*   All functions also ensure that these two properties are true of  */
+/*   return values.                                                   */
+/*  */
+/* Computed values: */
+/*   eval z = z[0] + (z[1] << 64) + (z[2] << 128) + (z[3] << 192) + (z[4] << 256) + (z[5] << 0x140) + (z[6] << 0x180) + (z[7] << 0x1c0) + (z[8] << 2^9) + (z[9] << 0x240) + (z[10] << 0x280) + (z[11] << 0x2c0) + (z[12] << 0x300) + (z[13] << 0x340) + (z[14] << 0x380) + (z[15] << 0x3c0) */
+/*   bytes_eval z = z[0] + (z[1] << 8) + (z[2] << 16) + (z[3] << 24) + (z[4] << 32) + (z[5] << 40) + (z[6] << 48) + (z[7] << 56) + (z[8] << 64) + (z[9] << 72) + (z[10] << 80) + (z[11] << 88) + (z[12] << 96) + (z[13] << 104) + (z[14] << 112) + (z[15] << 120) + (z[16] << 128) + (z[17] << 136) + (z[18] << 144) + (z[19] << 152) + (z[20] << 160) + (z[21] << 168) + (z[22] << 176) + (z[23] << 184) + (z[24] << 192) + (z[25] << 200) + (z[26] << 208) + (z[27] << 216) + (z[28] << 224) + (z[29] << 232) + (z[30] << 240) + (z[31] << 248) + (z[32] << 256) + (z[33] << 0x108) + (z[34] << 0x110) + (z[35] << 0x118) + (z[36] << 0x120) + (z[37] << 0x128) + (z[38] << 0x130) + (z[39] << 0x138) + (z[40] << 0x140) + (z[41] << 0x148) + (z[42] << 0x150) + (z[43] << 0x158) + (z[44] << 0x160) + (z[45] << 0x168) + (z[46] << 0x170) + (z[47] << 0x178) + (z[48] << 0x180) + (z[49] << 0x188) + (z[50] << 0x190) + (z[51] << 0x198) + (z[52] << 0x1a0) + (z[53] << 0x1a8) + (z[54] << 0x1b0) + (z[55] << 0x1b8) + (z[56] << 0x1c0) + (z[57] << 0x1c8) + (z[58] << 0x1d0) + (z[59] << 0x1d8) + (z[60] << 0x1e0) + (z[61] << 0x1e8) + (z[62] << 0x1f0) + (z[63] << 0x1f8) + (z[64] << 2^9) + (z[65] << 0x208) + (z[66] << 0x210) + (z[67] << 0x218) + (z[68] << 0x220) + (z[69] << 0x228) + (z[70] << 0x230) + (z[71] << 0x238) + (z[72] << 0x240) + (z[73] << 0x248) + (z[74] << 0x250) + (z[75] << 0x258) + (z[76] << 0x260) + (z[77] << 0x268) + (z[78] << 0x270) + (z[79] << 0x278) + (z[80] << 0x280) + (z[81] << 0x288) + (z[82] << 0x290) + (z[83] << 0x298) + (z[84] << 0x2a0) + (z[85] << 0x2a8) + (z[86] << 0x2b0) + (z[87] << 0x2b8) + (z[88] << 0x2c0) + (z[89] << 0x2c8) + (z[90] << 0x2d0) + (z[91] << 0x2d8) + (z[92] << 0x2e0) + (z[93] << 0x2e8) + (z[94] << 0x2f0) + (z[95] << 0x2f8) + (z[96] << 0x300) + (z[97] << 0x308) + (z[98] << 0x310) + (z[99] << 0x318) + (z[100] << 0x320) + (z[101] << 0x328) + (z[102] << 0x330) + (z[103] << 0x338) + (z[104] << 0x340) + (z[105] << 0x348) + (z[106] << 0x350) + (z[107] << 0x358) + (z[108] << 0x360) + (z[109] << 0x368) + (z[110] << 0x370) + (z[111] << 0x378) + (z[112] << 0x380) + (z[113] << 0x388) + (z[114] << 0x390) + (z[115] << 0x398) + (z[116] << 0x3a0) + (z[117] << 0x3a8) + (z[118] << 0x3b0) + (z[119] << 0x3b8) + (z[120] << 0x3c0) + (z[121] << 0x3c8) + (z[122] << 0x3d0) + (z[123] << 0x3d8) + (z[124] << 0x3e0) + (z[125] << 0x3e8) + (z[126] << 0x3f0) + (z[127] << 0x3f8) */
+/*   twos_complement_eval z = let x1 := z[0] + (z[1] << 64) + (z[2] << 128) + (z[3] << 192) + (z[4] << 256) + (z[5] << 0x140) + (z[6] << 0x180) + (z[7] << 0x1c0) + (z[8] << 2^9) + (z[9] << 0x240) + (z[10] << 0x280) + (z[11] << 0x2c0) + (z[12] << 0x300) + (z[13] << 0x340) + (z[14] << 0x380) + (z[15] << 0x3c0) in */
+/*                            if x1 & (2^1024-1) < 2^1023 then x1 & (2^1024-1) else (x1 & (2^1024-1)) - 2^1024 */
+
+/* The type fiat_p1024_montgomery_domain_field_element is a field element in the Montgomery domain. */
+/* Bounds: [[0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff]] */
+
+/* The type fiat_p1024_non_montgomery_domain_field_element is a field element NOT in the Montgomery domain. */
+/* Bounds: [[0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff]] */
+
+#if (-1 & 3) != 3
+#error "This code only works on a two's complement system"
+#endif
+
+
+/*
+ * The function fiat_p1024_addcarryx_u64 is an addition with carry.
+ *
+ * Postconditions:
+ *   out1 = (arg1 + arg2 + arg3) mod 2^64
+ *   out2 = ⌊(arg1 + arg2 + arg3) / 2^64⌋
+ *
+ * Input Bounds:
+ *   arg1: [0x0 ~> 0x1]
+ *   arg2: [0x0 ~> 0xffffffffffffffff]
+ *   arg3: [0x0 ~> 0xffffffffffffffff]
+ * Output Bounds:
+ *   out1: [0x0 ~> 0xffffffffffffffff]
+ *   out2: [0x0 ~> 0x1]
+ */
+
+/*
+ * The function fiat_p1024_subborrowx_u64 is a subtraction with borrow.
+ *
+ * Postconditions:
+ *   out1 = (-arg1 + arg2 + -arg3) mod 2^64
+ *   out2 = -⌊(-arg1 + arg2 + -arg3) / 2^64⌋
+ *
+ * Input Bounds:
+ *   arg1: [0x0 ~> 0x1]
+ *   arg2: [0x0 ~> 0xffffffffffffffff]
+ *   arg3: [0x0 ~> 0xffffffffffffffff]
+ * Output Bounds:
+ *   out1: [0x0 ~> 0xffffffffffffffff]
+ *   out2: [0x0 ~> 0x1]
+ */
+
+/*
+ * The function fiat_p1024_mulx_u64 is a multiplication, returning the full double-width result.
+ *
+ * Postconditions:
+ *   out1 = (arg1 * arg2) mod 2^64
+ *   out2 = ⌊arg1 * arg2 / 2^64⌋
+ *
+ * Input Bounds:
+ *   arg1: [0x0 ~> 0xffffffffffffffff]
+ *   arg2: [0x0 ~> 0xffffffffffffffff]
+ * Output Bounds:
+ *   out1: [0x0 ~> 0xffffffffffffffff]
+ *   out2: [0x0 ~> 0xffffffffffffffff]
+ */
+
+/*
+ * The function fiat_p1024_cmovznz_u64 is a single-word conditional move.
+ *
+ * Postconditions:
+ *   out1 = (if arg1 = 0 then arg2 else arg3)
+ *
+ * Input Bounds:
+ *   arg1: [0x0 ~> 0x1]
+ *   arg2: [0x0 ~> 0xffffffffffffffff]
+ *   arg3: [0x0 ~> 0xffffffffffffffff]
+ * Output Bounds:
+ *   out1: [0x0 ~> 0xffffffffffffffff]
+ */
+
+/*
+ * The function fiat_p1024_mul multiplies two field elements in the Montgomery domain.
+ *
+ * Preconditions:
+ *   0 ≤ eval arg1 < m
+ *   0 ≤ eval arg2 < m
+ * Postconditions:
+ *   eval (from_montgomery out1) mod m = (eval (from_montgomery arg1) * eval (from_montgomery arg2)) mod m
+ *   0 ≤ eval out1 < m
+ *
+ */
+void fiat_p1024_mul(fiat_p1024_montgomery_domain_field_element out1, const fiat_p1024_montgomery_domain_field_element arg1, const fiat_p1024_montgomery_domain_field_element arg2) {
+  uint64_t x1;
+  uint64_t x2;
+  uint64_t x3;
+  uint64_t x4;
+  uint64_t x5;
+  uint64_t x6;
+  uint64_t x7;
+  uint64_t x8;
+  uint64_t x9;
+  uint64_t x10;
+  uint64_t x11;
+  uint64_t x12;
+  uint64_t x13;
+  uint64_t x14;
+  uint64_t x15;
+  uint64_t x16;
+  uint64_t x17;
+  uint64_t x18;
+  uint64_t x19;
+  uint64_t x20;
+  uint64_t x21;
+  uint64_t x22;
+  uint64_t x23;
+  uint64_t x24;
+  uint64_t x25;
+  uint64_t x26;
+  uint64_t x27;
+  uint64_t x28;
+  uint64_t x29;
+  uint64_t x30;
+  uint64_t x31;
+  uint64_t x32;
+  uint64_t x33;
+  uint64_t x34;
+  uint64_t x35;
+  uint64_t x36;
+  uint64_t x37;
+  uint64_t x38;
+  uint64_t x39;
+  uint64_t x40;
+  uint64_t x41;
+  uint64_t x42;
+  uint64_t x43;
+  uint64_t x44;
+  uint64_t x45;
+  uint64_t x46;
+  uint64_t x47;
+  uint64_t x48;
+  uint64_t x49;
+  fiat_p1024_uint1 x50;
+  uint64_t x51;
+  fiat_p1024_uint1 x52;
+  uint64_t x53;
+  fiat_p1024_uint1 x54;
+  uint64_t x55;
+  fiat_p1024_uint1 x56;
+  uint64_t x57;
+  fiat_p1024_uint1 x58;
+  uint64_t x59;
+  fiat_p1024_uint1 x60;
+  uint64_t x61;
+  fiat_p1024_uint1 x62;
+  uint64_t x63;
+  fiat_p1024_uint1 x64;
+  uint64_t x65;
+  fiat_p1024_uint1 x66;
+  uint64_t x67;
+  fiat_p1024_uint1 x68;
+  uint64_t x69;
+  fiat_p1024_uint1 x70;
+  uint64_t x71;
+  fiat_p1024_uint1 x72;
+  uint64_t x73;
+  fiat_p1024_uint1 x74;
+  uint64_t x75;
+  fiat_p1024_uint1 x76;
+  uint64_t x77;
+  fiat_p1024_uint1 x78;
+  uint64_t x79;
+  uint64_t x80;
+  uint64_t x81;
+  uint64_t x82;
+  uint64_t x83;
+  uint64_t x84;
+  uint64_t x85;
+  uint64_t x86;
+  uint64_t x87;
+  uint64_t x88;
+  uint64_t x89;
+  uint64_t x90;
+  uint64_t x91;
+  uint64_t x92;
+  uint64_t x93;
+  uint64_t x94;
+  uint64_t x95;
+  uint64_t x96;
+  uint64_t x97;
+  uint64_t x98;
+  uint64_t x99;
+  uint64_t x100;
+  uint64_t x101;
+  uint64_t x102;
+  uint64_t x103;
+  uint64_t x104;
+  uint64_t x105;
+  uint64_t x106;
+  uint64_t x107;
+  uint64_t x108;
+  uint64_t x109;
+  uint64_t x110;
+  uint64_t x111;
+  uint64_t x112;
+  uint64_t x113;
+  uint64_t x114;
+  fiat_p1024_uint1 x115;
+  uint64_t x116;
+  fiat_p1024_uint1 x117;
+  uint64_t x118;
+  fiat_p1024_uint1 x119;
+  uint64_t x120;
+  fiat_p1024_uint1 x121;
+  uint64_t x122;
+  fiat_p1024_uint1 x123;
+  uint64_t x124;
+  fiat_p1024_uint1 x125;
+  uint64_t x126;
+  fiat_p1024_uint1 x127;
+  uint64_t x128;
+  fiat_p1024_uint1 x129;
+  uint64_t x130;
+  fiat_p1024_uint1 x131;
+  uint64_t x132;
+  fiat_p1024_uint1 x133;
+  uint64_t x134;
+  fiat_p1024_uint1 x135;
+  uint64_t x136;
+  fiat_p1024_uint1 x137;
+  uint64_t x138;
+  fiat_p1024_uint1 x139;
+  uint64_t x140;
+  fiat_p1024_uint1 x141;
+  uint64_t x142;
+  fiat_p1024_uint1 x143;
+  uint64_t x144;
+  uint64_t x145;
+  fiat_p1024_uint1 x146;
+  uint64_t x147;
+  fiat_p1024_uint1 x148;
+  uint64_t x149;
+  fiat_p1024_uint1 x150;
+  uint64_t x151;
+  fiat_p1024_uint1 x152;
+  uint64_t x153;
+  fiat_p1024_uint1 x154;
+  uint64_t x155;
+  fiat_p1024_uint1 x156;
+  uint64_t x157;
+  fiat_p1024_uint1 x158;
+  uint64_t x159;
+  fiat_p1024_uint1 x160;
+  uint64_t x161;
+  fiat_p1024_uint1 x162;
+  uint64_t x163;
+  fiat_p1024_uint1 x164;
+  uint64_t x165;
+  fiat_p1024_uint1 x166;
+  uint64_t x167;
+  fiat_p1024_uint1 x168;
+  uint64_t x169;
+  fiat_p1024_uint1 x170;
+  uint64_t x171;
+  fiat_p1024_uint1 x172;
+  uint64_t x173;
+  fiat_p1024_uint1 x174;
+  uint64_t x175;
+  fiat_p1024_uint1 x176;
+  uint64_t x177;
+  fiat_p1024_uint1 x178;
+  uint64_t x179;
+  uint64_t x180;
+  uint64_t x181;
+  uint64_t x182;
+  uint64_t x183;
+  uint64_t x184;
+  uint64_t x185;
+  uint64_t x186;
+  uint64_t x187;
+  uint64_t x188;
+  uint64_t x189;
+  uint64_t x190;
+  uint64_t x191;
+  uint64_t x192;
+  uint64_t x193;
+  uint64_t x194;
+  uint64_t x195;
+  uint64_t x196;
+  uint64_t x197;
+  uint64_t x198;
+  uint64_t x199;
+  uint64_t x200;
+  uint64_t x201;
+  uint64_t x202;
+  uint64_t x203;
+  uint64_t x204;
+  uint64_t x205;
+  uint64_t x206;
+  uint64_t x207;
+  uint64_t x208;
+  uint64_t x209;
+  uint64_t x210;
+  uint64_t x211;
+  fiat_p1024_uint1 x212;
+  uint64_t x213;
+  fiat_p1024_uint1 x214;
+  uint64_t x215;
+  fiat_p1024_uint1 x216;
+  uint64_t x217;
+  fiat_p1024_uint1 x218;
+  uint64_t x219;
+  fiat_p1024_uint1 x220;
+  uint64_t x221;
+  fiat_p1024_uint1 x222;
+  uint64_t x223;
+  fiat_p1024_uint1 x224;
+  uint64_t x225;
+  fiat_p1024_uint1 x226;
+  uint64_t x227;
+  fiat_p1024_uint1 x228;
+  uint64_t x229;
+  fiat_p1024_uint1 x230;
+  uint64_t x231;
+  fiat_p1024_uint1 x232;
+  uint64_t x233;
+  fiat_p1024_uint1 x234;
+  uint64_t x235;
+  fiat_p1024_uint1 x236;
+  uint64_t x237;
+  fiat_p1024_uint1 x238;
+  uint64_t x239;
+  fiat_p1024_uint1 x240;
+  uint64_t x241;
+  uint64_t x242;
+  fiat_p1024_uint1 x243;
+  uint64_t x244;
+  fiat_p1024_uint1 x245;
+  uint64_t x246;
+  fiat_p1024_uint1 x247;
+  uint64_t x248;
+  fiat_p1024_uint1 x249;
+  uint64_t x250;
+  fiat_p1024_uint1 x251;
+  uint64_t x252;
+  fiat_p1024_uint1 x253;
+  uint64_t x254;
+  fiat_p1024_uint1 x255;
+  uint64_t x256;
+  fiat_p1024_uint1 x257;
+  uint64_t x258;
+  fiat_p1024_uint1 x259;
+  uint64_t x260;
+  fiat_p1024_uint1 x261;
+  uint64_t x262;
+  fiat_p1024_uint1 x263;
+  uint64_t x264;
+  fiat_p1024_uint1 x265;
+  uint64_t x266;
+  fiat_p1024_uint1 x267;
+  uint64_t x268;
+  fiat_p1024_uint1 x269;
+  uint64_t x270;
+  fiat_p1024_uint1 x271;
+  uint64_t x272;
+  fiat_p1024_uint1 x273;
+  uint64_t x274;
+  fiat_p1024_uint1 x275;
+  uint64_t x276;
+  uint64_t x277;
+  uint64_t x278;
+  uint64_t x279;
+  uint64_t x280;
+  uint64_t x281;
+  uint64_t x282;
+  uint64_t x283;
+  uint64_t x284;
+  uint64_t x285;
+  uint64_t x286;
+  uint64_t x287;
+  uint64_t x288;
+  uint64_t x289;
+  uint64_t x290;
+  uint64_t x291;
+  uint64_t x292;
+  uint64_t x293;
+  uint64_t x294;
+  uint64_t x295;
+  uint64_t x296;
+  uint64_t x297;
+  uint64_t x298;
+  uint64_t x299;
+  uint64_t x300;
+  uint64_t x301;
+  uint64_t x302;
+  uint64_t x303;
+  uint64_t x304;
+  uint64_t x305;
+  uint64_t x306;
+  uint64_t x307;
+  uint64_t x308;
+  uint64_t x309;
+  uint64_t x310;
+  fiat_p1024_uint1 x311;
+  uint64_t x312;
+  fiat_p1024_uint1 x313;
+  uint64_t x314;
+  fiat_p1024_uint1 x315;
+  uint64_t x316;
+  fiat_p1024_uint1 x317;
+  uint64_t x318;
+  fiat_p1024_uint1 x319;
+  uint64_t x320;
+  fiat_p1024_uint1 x321;
+  uint64_t x322;
+  fiat_p1024_uint1 x323;
+  uint64_t x324;
+  fiat_p1024_uint1 x325;
+  uint64_t x326;
+  fiat_p1024_uint1 x327;
+  uint64_t x328;
+  fiat_p1024_uint1 x329;
+  uint64_t x330;
+  fiat_p1024_uint1 x331;
+  uint64_t x332;
+  fiat_p1024_uint1 x333;
+  uint64_t x334;
+  fiat_p1024_uint1 x335;
+  uint64_t x336;
+  fiat_p1024_uint1 x337;
+  uint64_t x338;
+  fiat_p1024_uint1 x339;
+  uint64_t x340;
+  uint64_t x341;
+  fiat_p1024_uint1 x342;
+  uint64_t x343;
+  fiat_p1024_uint1 x344;
+  uint64_t x345;
+  fiat_p1024_uint1 x346;
+  uint64_t x347;
+  fiat_p1024_uint1 x348;
+  uint64_t x349;
+  fiat_p1024_uint1 x350;
+  uint64_t x351;
+  fiat_p1024_uint1 x352;
+  uint64_t x353;
+  fiat_p1024_uint1 x354;
+  uint64_t x355;
+  fiat_p1024_uint1 x356;
+  uint64_t x357;
+  fiat_p1024_uint1 x358;
+  uint64_t x359;
+  fiat_p1024_uint1 x360;
+  uint64_t x361;
+  fiat_p1024_uint1 x362;
+  uint64_t x363;
+  fiat_p1024_uint1 x364;
+  uint64_t x365;
+  fiat_p1024_uint1 x366;
+  uint64_t x367;
+  fiat_p1024_uint1 x368;
+  uint64_t x369;
+  fiat_p1024_uint1 x370;
+  uint64_t x371;
+  fiat_p1024_uint1 x372;
+  uint64_t x373;
+  fiat_p1024_uint1 x374;
+  uint64_t x375;
+  uint64_t x376;
+  uint64_t x377;
+  uint64_t x378;
+  uint64_t x379;
+  uint64_t x380;
+  uint64_t x381;
+  uint64_t x382;
+  uint64_t x383;
+  uint64_t x384;
+  uint64_t x385;
+  uint64_t x386;
+  uint64_t x387;
+  uint64_t x388;
+  uint64_t x389;
+  uint64_t x390;
+  uint64_t x391;
+  uint64_t x392;
+  uint64_t x393;
+  uint64_t x394;
+  uint64_t x395;
+  uint64_t x396;
+  uint64_t x397;
+  uint64_t x398;
+  uint64_t x399;
+  uint64_t x400;
+  uint64_t x401;
+  uint64_t x402;
+  uint64_t x403;
+  uint64_t x404;
+  uint64_t x405;
+  uint64_t x406;
+  uint64_t x407;
+  uint64_t x408;
+  fiat_p1024_uint1 x409;
+  uint64_t x410;
+  fiat_p1024_uint1 x411;
+  uint64_t x412;
+  fiat_p1024_uint1 x413;
+  uint64_t x414;
+  fiat_p1024_uint1 x415;
+  uint64_t x416;
+  fiat_p1024_uint1 x417;
+  uint64_t x418;
+  fiat_p1024_uint1 x419;
+  uint64_t x420;
+  fiat_p1024_uint1 x421;
+  uint64_t x422;
+  fiat_p1024_uint1 x423;
+  uint64_t x424;
+  fiat_p1024_uint1 x425;
+  uint64_t x426;
+  fiat_p1024_uint1 x427;
+  uint64_t x428;
+  fiat_p1024_uint1 x429;
+  uint64_t x430;
+  fiat_p1024_uint1 x431;
+  uint64_t x432;
+  fiat_p1024_uint1 x433;
+  uint64_t x434;
+  fiat_p1024_uint1 x435;
+  uint64_t x436;
+  fiat_p1024_uint1 x437;
+  uint64_t x438;
+  uint64_t x439;
+  fiat_p1024_uint1 x440;
+  uint64_t x441;
+  fiat_p1024_uint1 x442;
+  uint64_t x443;
+  fiat_p1024_uint1 x444;
+  uint64_t x445;
+  fiat_p1024_uint1 x446;
+  uint64_t x447;
+  fiat_p1024_uint1 x448;
+  uint64_t x449;
+  fiat_p1024_uint1 x450;
+  uint64_t x451;
+  fiat_p1024_uint1 x452;
+  uint64_t x453;
+  fiat_p1024_uint1 x454;
+  uint64_t x455;
+  fiat_p1024_uint1 x456;
+  uint64_t x457;
+  fiat_p1024_uint1 x458;
+  uint64_t x459;
+  fiat_p1024_uint1 x460;
+  uint64_t x461;
+  fiat_p1024_uint1 x462;
+  uint64_t x463;
+  fiat_p1024_uint1 x464;
+  uint64_t x465;
+  fiat_p1024_uint1 x466;
+  uint64_t x467;
+  fiat_p1024_uint1 x468;
+  uint64_t x469;
+  fiat_p1024_uint1 x470;
+  uint64_t x471;
+  fiat_p1024_uint1 x472;
+  uint64_t x473;
+  uint64_t x474;
+  uint64_t x475;
+  uint64_t x476;
+  uint64_t x477;
+  uint64_t x478;
+  uint64_t x479;
+  uint64_t x480;
+  uint64_t x481;
+  uint64_t x482;
+  uint64_t x483;
+  uint64_t x484;
+  uint64_t x485;
+  uint64_t x486;
+  uint64_t x487;
+  uint64_t x488;
+  uint64_t x489;
+  uint64_t x490;
+  uint64_t x491;
+  uint64_t x492;
+  uint64_t x493;
+  uint64_t x494;
+  uint64_t x495;
+  uint64_t x496;
+  uint64_t x497;
+  uint64_t x498;
+  uint64_t x499;
+  uint64_t x500;
+  uint64_t x501;
+  uint64_t x502;
+  uint64_t x503;
+  uint64_t x504;
+  uint64_t x505;
+  uint64_t x506;
+  uint64_t x507;
+  fiat_p1024_uint1 x508;
+  uint64_t x509;
+  fiat_p1024_uint1 x510;
+  uint64_t x511;
+  fiat_p1024_uint1 x512;
+  uint64_t x513;
+  fiat_p1024_uint1 x514;
+  uint64_t x515;
+  fiat_p1024_uint1 x516;
+  uint64_t x517;
+  fiat_p1024_uint1 x518;
+  uint64_t x519;
+  fiat_p1024_uint1 x520;
+  uint64_t x521;
+  fiat_p1024_uint1 x522;
+  uint64_t x523;
+  fiat_p1024_uint1 x524;
+  uint64_t x525;
+  fiat_p1024_uint1 x526;
+  uint64_t x527;
+  fiat_p1024_uint1 x528;
+  uint64_t x529;
+  fiat_p1024_uint1 x530;
+  uint64_t x531;
+  fiat_p1024_uint1 x532;
+  uint64_t x533;
+  fiat_p1024_uint1 x534;
+  uint64_t x535;
+  fiat_p1024_uint1 x536;
+  uint64_t x537;
+  uint64_t x538;
+  fiat_p1024_uint1 x539;
+  uint64_t x540;
+  fiat_p1024_uint1 x541;
+  uint64_t x542;
+  fiat_p1024_uint1 x543;
+  uint64_t x544;
+  fiat_p1024_uint1 x545;
+  uint64_t x546;
+  fiat_p1024_uint1 x547;
+  uint64_t x548;
+  fiat_p1024_uint1 x549;
+  uint64_t x550;
+  fiat_p1024_uint1 x551;
+  uint64_t x552;
+  fiat_p1024_uint1 x553;
+  uint64_t x554;
+  fiat_p1024_uint1 x555;
+  uint64_t x556;
+  fiat_p1024_uint1 x557;
+  uint64_t x558;
+  fiat_p1024_uint1 x559;
+  uint64_t x560;
+  fiat_p1024_uint1 x561;
+  uint64_t x562;
+  fiat_p1024_uint1 x563;
+  uint64_t x564;
+  fiat_p1024_uint1 x565;
+  uint64_t x566;
+  fiat_p1024_uint1 x567;
+  uint64_t x568;
+  fiat_p1024_uint1 x569;
+  uint64_t x570;
+  fiat_p1024_uint1 x571;
+  uint64_t x572;
+  uint64_t x573;
+  uint64_t x574;
+  uint64_t x575;
+  uint64_t x576;
+  uint64_t x577;
+  uint64_t x578;
+  uint64_t x579;
+  uint64_t x580;
+  uint64_t x581;
+  uint64_t x582;
+  uint64_t x583;
+  uint64_t x584;
+  uint64_t x585;
+  uint64_t x586;
+  uint64_t x587;
+  uint64_t x588;
+  uint64_t x589;
+  uint64_t x590;
+  uint64_t x591;
+  uint64_t x592;
+  uint64_t x593;
+  uint64_t x594;
+  uint64_t x595;
+  uint64_t x596;
+  uint64_t x597;
+  uint64_t x598;
+  uint64_t x599;
+  uint64_t x600;
+  uint64_t x601;
+  uint64_t x602;
+  uint64_t x603;
+  uint64_t x604;
+  uint64_t x605;
+  fiat_p1024_uint1 x606;
+  uint64_t x607;
+  fiat_p1024_uint1 x608;
+  uint64_t x609;
+  fiat_p1024_uint1 x610;
+  uint64_t x611;
+  fiat_p1024_uint1 x612;
+  uint64_t x613;
+  fiat_p1024_uint1 x614;
+  uint64_t x615;
+  fiat_p1024_uint1 x616;
+  uint64_t x617;
+  fiat_p1024_uint1 x618;
+  uint64_t x619;
+  fiat_p1024_uint1 x620;
+  uint64_t x621;
+  fiat_p1024_uint1 x622;
+  uint64_t x623;
+  fiat_p1024_uint1 x624;
+  uint64_t x625;
+  fiat_p1024_uint1 x626;
+  uint64_t x627;
+  fiat_p1024_uint1 x628;
+  uint64_t x629;
+  fiat_p1024_uint1 x630;
+  uint64_t x631;
+  fiat_p1024_uint1 x632;
+  uint64_t x633;
+  fiat_p1024_uint1 x634;
+  uint64_t x635;
+  uint64_t x636;
+  fiat_p1024_uint1 x637;
+  uint64_t x638;
+  fiat_p1024_uint1 x639;
+  uint64_t x640;
+  fiat_p1024_uint1 x641;
+  uint64_t x642;
+  fiat_p1024_uint1 x643;
+  uint64_t x644;
+  fiat_p1024_uint1 x645;
+  uint64_t x646;
+  fiat_p1024_uint1 x647;
+  uint64_t x648;
+  fiat_p1024_uint1 x649;
+  uint64_t x650;
+  fiat_p1024_uint1 x651;
+  uint64_t x652;
+  fiat_p1024_uint1 x653;
+  uint64_t x654;
+  fiat_p1024_uint1 x655;
+  uint64_t x656;
+  fiat_p1024_uint1 x657;
+  uint64_t x658;
+  fiat_p1024_uint1 x659;
+  uint64_t x660;
+  fiat_p1024_uint1 x661;
+  uint64_t x662;
+  fiat_p1024_uint1 x663;
+  uint64_t x664;
+  fiat_p1024_uint1 x665;
+  uint64_t x666;
+  fiat_p1024_uint1 x667;
+  uint64_t x668;
+  fiat_p1024_uint1 x669;
+  uint64_t x670;
+  uint64_t x671;
+  uint64_t x672;
+  uint64_t x673;
+  uint64_t x674;
+  uint64_t x675;
+  uint64_t x676;
+  uint64_t x677;
+  uint64_t x678;
+  uint64_t x679;
+  uint64_t x680;
+  uint64_t x681;
+  uint64_t x682;
+  uint64_t x683;
+  uint64_t x684;
+  uint64_t x685;
+  uint64_t x686;
+  uint64_t x687;
+  uint64_t x688;
+  uint64_t x689;
+  uint64_t x690;
+  uint64_t x691;
+  uint64_t x692;
+  uint64_t x693;
+  uint64_t x694;
+  uint64_t x695;
+  uint64_t x696;
+  uint64_t x697;
+  uint64_t x698;
+  uint64_t x699;
+  uint64_t x700;
+  uint64_t x701;
+  uint64_t x702;
+  uint64_t x703;
+  uint64_t x704;
+  fiat_p1024_uint1 x705;
+  uint64_t x706;
+  fiat_p1024_uint1 x707;
+  uint64_t x708;
+  fiat_p1024_uint1 x709;
+  uint64_t x710;
+  fiat_p1024_uint1 x711;
+  uint64_t x712;
+  fiat_p1024_uint1 x713;
+  uint64_t x714;
+  fiat_p1024_uint1 x715;
+  uint64_t x716;
+  fiat_p1024_uint1 x717;
+  uint64_t x718;
+  fiat_p1024_uint1 x719;
+  uint64_t x720;
+  fiat_p1024_uint1 x721;
+  uint64_t x722;
+  fiat_p1024_uint1 x723;
+  uint64_t x724;
+  fiat_p1024_uint1 x725;
+  uint64_t x726;
+  fiat_p1024_uint1 x727;
+  uint64_t x728;
+  fiat_p1024_uint1 x729;
+  uint64_t x730;
+  fiat_p1024_uint1 x731;
+  uint64_t x732;
+  fiat_p1024_uint1 x733;
+  uint64_t x734;
+  uint64_t x735;
+  fiat_p1024_uint1 x736;
+  uint64_t x737;
+  fiat_p1024_uint1 x738;
+  uint64_t x739;
+  fiat_p1024_uint1 x740;
+  uint64_t x741;
+  fiat_p1024_uint1 x742;
+  uint64_t x743;
+  fiat_p1024_uint1 x744;
+  uint64_t x745;
+  fiat_p1024_uint1 x746;
+  uint64_t x747;
+  fiat_p1024_uint1 x748;
+  uint64_t x749;
+  fiat_p1024_uint1 x750;
+  uint64_t x751;
+  fiat_p1024_uint1 x752;
+  uint64_t x753;
+  fiat_p1024_uint1 x754;
+  uint64_t x755;
+  fiat_p1024_uint1 x756;
+  uint64_t x757;
+  fiat_p1024_uint1 x758;
+  uint64_t x759;
+  fiat_p1024_uint1 x760;
+  uint64_t x761;
+  fiat_p1024_uint1 x762;
+  uint64_t x763;
+  fiat_p1024_uint1 x764;
+  uint64_t x765;
+  fiat_p1024_uint1 x766;
+  uint64_t x767;
+  fiat_p1024_uint1 x768;
+  uint64_t x769;
+  uint64_t x770;
+  uint64_t x771;
+  uint64_t x772;
+  uint64_t x773;
+  uint64_t x774;
+  uint64_t x775;
+  uint64_t x776;
+  uint64_t x777;
+  uint64_t x778;
+  uint64_t x779;
+  uint64_t x780;
+  uint64_t x781;
+  uint64_t x782;
+  uint64_t x783;
+  uint64_t x784;
+  uint64_t x785;
+  uint64_t x786;
+  uint64_t x787;
+  uint64_t x788;
+  uint64_t x789;
+  uint64_t x790;
+  uint64_t x791;
+  uint64_t x792;
+  uint64_t x793;
+  uint64_t x794;
+  uint64_t x795;
+  uint64_t x796;
+  uint64_t x797;
+  uint64_t x798;
+  uint64_t x799;
+  uint64_t x800;
+  uint64_t x801;
+  uint64_t x802;
+  fiat_p1024_uint1 x803;
+  uint64_t x804;
+  fiat_p1024_uint1 x805;
+  uint64_t x806;
+  fiat_p1024_uint1 x807;
+  uint64_t x808;
+  fiat_p1024_uint1 x809;
+  uint64_t x810;
+  fiat_p1024_uint1 x811;
+  uint64_t x812;
+  fiat_p1024_uint1 x813;
+  uint64_t x814;
+  fiat_p1024_uint1 x815;
+  uint64_t x816;
+  fiat_p1024_uint1 x817;
+  uint64_t x818;
+  fiat_p1024_uint1 x819;
+  uint64_t x820;
+  fiat_p1024_uint1 x821;
+  uint64_t x822;
+  fiat_p1024_uint1 x823;
+  uint64_t x824;
+  fiat_p1024_uint1 x825;
+  uint64_t x826;
+  fiat_p1024_uint1 x827;
+  uint64_t x828;
+  fiat_p1024_uint1 x829;
+  uint64_t x830;
+  fiat_p1024_uint1 x831;
+  uint64_t x832;
+  uint64_t x833;
+  fiat_p1024_uint1 x834;
+  uint64_t x835;
+  fiat_p1024_uint1 x836;
+  uint64_t x837;
+  fiat_p1024_uint1 x838;
+  uint64_t x839;
+  fiat_p1024_uint1 x840;
+  uint64_t x841;
+  fiat_p1024_uint1 x842;
+  uint64_t x843;
+  fiat_p1024_uint1 x844;
+  uint64_t x845;
+  fiat_p1024_uint1 x846;
+  uint64_t x847;
+  fiat_p1024_uint1 x848;
+  uint64_t x849;
+  fiat_p1024_uint1 x850;
+  uint64_t x851;
+  fiat_p1024_uint1 x852;
+  uint64_t x853;
+  fiat_p1024_uint1 x854;
+  uint64_t x855;
+  fiat_p1024_uint1 x856;
+  uint64_t x857;
+  fiat_p1024_uint1 x858;
+  uint64_t x859;
+  fiat_p1024_uint1 x860;
+  uint64_t x861;
+  fiat_p1024_uint1 x862;
+  uint64_t x863;
+  fiat_p1024_uint1 x864;
+  uint64_t x865;
+  fiat_p1024_uint1 x866;
+  uint64_t x867;
+  uint64_t x868;
+  uint64_t x869;
+  uint64_t x870;
+  uint64_t x871;
+  uint64_t x872;
+  uint64_t x873;
+  uint64_t x874;
+  uint64_t x875;
+  uint64_t x876;
+  uint64_t x877;
+  uint64_t x878;
+  uint64_t x879;
+  uint64_t x880;
+  uint64_t x881;
+  uint64_t x882;
+  uint64_t x883;
+  uint64_t x884;
+  uint64_t x885;
+  uint64_t x886;
+  uint64_t x887;
+  uint64_t x888;
+  uint64_t x889;
+  uint64_t x890;
+  uint64_t x891;
+  uint64_t x892;
+  uint64_t x893;
+  uint64_t x894;
+  uint64_t x895;
+  uint64_t x896;
+  uint64_t x897;
+  uint64_t x898;
+  uint64_t x899;
+  uint64_t x900;
+  uint64_t x901;
+  fiat_p1024_uint1 x902;
+  uint64_t x903;
+  fiat_p1024_uint1 x904;
+  uint64_t x905;
+  fiat_p1024_uint1 x906;
+  uint64_t x907;
+  fiat_p1024_uint1 x908;
+  uint64_t x909;
+  fiat_p1024_uint1 x910;
+  uint64_t x911;
+  fiat_p1024_uint1 x912;
+  uint64_t x913;
+  fiat_p1024_uint1 x914;
+  uint64_t x915;
+  fiat_p1024_uint1 x916;
+  uint64_t x917;
+  fiat_p1024_uint1 x918;
+  uint64_t x919;
+  fiat_p1024_uint1 x920;
+  uint64_t x921;
+  fiat_p1024_uint1 x922;
+  uint64_t x923;
+  fiat_p1024_uint1 x924;
+  uint64_t x925;
+  fiat_p1024_uint1 x926;
+  uint64_t x927;
+  fiat_p1024_uint1 x928;
+  uint64_t x929;
+  fiat_p1024_uint1 x930;
+  uint64_t x931;
+  uint64_t x932;
+  fiat_p1024_uint1 x933;
+  uint64_t x934;
+  fiat_p1024_uint1 x935;
+  uint64_t x936;
+  fiat_p1024_uint1 x937;
+  uint64_t x938;
+  fiat_p1024_uint1 x939;
+  uint64_t x940;
+  fiat_p1024_uint1 x941;
+  uint64_t x942;
+  fiat_p1024_uint1 x943;
+  uint64_t x944;
+  fiat_p1024_uint1 x945;
+  uint64_t x946;
+  fiat_p1024_uint1 x947;
+  uint64_t x948;
+  fiat_p1024_uint1 x949;
+  uint64_t x950;
+  fiat_p1024_uint1 x951;
+  uint64_t x952;
+  fiat_p1024_uint1 x953;
+  uint64_t x954;
+  fiat_p1024_uint1 x955;
+  uint64_t x956;
+  fiat_p1024_uint1 x957;
+  uint64_t x958;
+  fiat_p1024_uint1 x959;
+  uint64_t x960;
+  fiat_p1024_uint1 x961;
+  uint64_t x962;
+  fiat_p1024_uint1 x963;
+  uint64_t x964;
+  fiat_p1024_uint1 x965;
+  uint64_t x966;
+  uint64_t x967;
+  uint64_t x968;
+  uint64_t x969;
+  uint64_t x970;
+  uint64_t x971;
+  uint64_t x972;
+  uint64_t x973;
+  uint64_t x974;
+  uint64_t x975;
+  uint64_t x976;
+  uint64_t x977;
+  uint64_t x978;
+  uint64_t x979;
+  uint64_t x980;
+  uint64_t x981;
+  uint64_t x982;
+  uint64_t x983;
+  uint64_t x984;
+  uint64_t x985;
+  uint64_t x986;
+  uint64_t x987;
+  uint64_t x988;
+  uint64_t x989;
+  uint64_t x990;
+  uint64_t x991;
+  uint64_t x992;
+  uint64_t x993;
+  uint64_t x994;
+  uint64_t x995;
+  uint64_t x996;
+  uint64_t x997;
+  uint64_t x998;
+  uint64_t x999;
+  fiat_p1024_uint1 x1000;
+  uint64_t x1001;
+  fiat_p1024_uint1 x1002;
+  uint64_t x1003;
+  fiat_p1024_uint1 x1004;
+  uint64_t x1005;
+  fiat_p1024_uint1 x1006;
+  uint64_t x1007;
+  fiat_p1024_uint1 x1008;
+  uint64_t x1009;
+  fiat_p1024_uint1 x1010;
+  uint64_t x1011;
+  fiat_p1024_uint1 x1012;
+  uint64_t x1013;
+  fiat_p1024_uint1 x1014;
+  uint64_t x1015;
+  fiat_p1024_uint1 x1016;
+  uint64_t x1017;
+  fiat_p1024_uint1 x1018;
+  uint64_t x1019;
+  fiat_p1024_uint1 x1020;
+  uint64_t x1021;
+  fiat_p1024_uint1 x1022;
+  uint64_t x1023;
+  fiat_p1024_uint1 x1024;
+  uint64_t x1025;
+  fiat_p1024_uint1 x1026;
+  uint64_t x1027;
+  fiat_p1024_uint1 x1028;
+  uint64_t x1029;
+  uint64_t x1030;
+  fiat_p1024_uint1 x1031;
+  uint64_t x1032;
+  fiat_p1024_uint1 x1033;
+  uint64_t x1034;
+  fiat_p1024_uint1 x1035;
+  uint64_t x1036;
+  fiat_p1024_uint1 x1037;
+  uint64_t x1038;
+  fiat_p1024_uint1 x1039;
+  uint64_t x1040;
+  fiat_p1024_uint1 x1041;
+  uint64_t x1042;
+  fiat_p1024_uint1 x1043;
+  uint64_t x1044;
+  fiat_p1024_uint1 x1045;
+  uint64_t x1046;
+  fiat_p1024_uint1 x1047;
+  uint64_t x1048;
+  fiat_p1024_uint1 x1049;
+  uint64_t x1050;
+  fiat_p1024_uint1 x1051;
+  uint64_t x1052;
+  fiat_p1024_uint1 x1053;
+  uint64_t x1054;
+  fiat_p1024_uint1 x1055;
+  uint64_t x1056;
+  fiat_p1024_uint1 x1057;
+  uint64_t x1058;
+  fiat_p1024_uint1 x1059;
+  uint64_t x1060;
+  fiat_p1024_uint1 x1061;
+  uint64_t x1062;
+  fiat_p1024_uint1 x1063;
+  uint64_t x1064;
+  uint64_t x1065;
+  uint64_t x1066;
+  uint64_t x1067;
+  uint64_t x1068;
+  uint64_t x1069;
+  uint64_t x1070;
+  uint64_t x1071;
+  uint64_t x1072;
+  uint64_t x1073;
+  uint64_t x1074;
+  uint64_t x1075;
+  uint64_t x1076;
+  uint64_t x1077;
+  uint64_t x1078;
+  uint64_t x1079;
+  uint64_t x1080;
+  uint64_t x1081;
+  uint64_t x1082;
+  uint64_t x1083;
+  uint64_t x1084;
+  uint64_t x1085;
+  uint64_t x1086;
+  uint64_t x1087;
+  uint64_t x1088;
+  uint64_t x1089;
+  uint64_t x1090;
+  uint64_t x1091;
+  uint64_t x1092;
+  uint64_t x1093;
+  uint64_t x1094;
+  uint64_t x1095;
+  uint64_t x1096;
+  uint64_t x1097;
+  uint64_t x1098;
+  fiat_p1024_uint1 x1099;
+  uint64_t x1100;
+  fiat_p1024_uint1 x1101;
+  uint64_t x1102;
+  fiat_p1024_uint1 x1103;
+  uint64_t x1104;
+  fiat_p1024_uint1 x1105;
+  uint64_t x1106;
+  fiat_p1024_uint1 x1107;
+  uint64_t x1108;
+  fiat_p1024_uint1 x1109;
+  uint64_t x1110;
+  fiat_p1024_uint1 x1111;
+  uint64_t x1112;
+  fiat_p1024_uint1 x1113;
+  uint64_t x1114;
+  fiat_p1024_uint1 x1115;
+  uint64_t x1116;
+  fiat_p1024_uint1 x1117;
+  uint64_t x1118;
+  fiat_p1024_uint1 x1119;
+  uint64_t x1120;
+  fiat_p1024_uint1 x1121;
+  uint64_t x1122;
+  fiat_p1024_uint1 x1123;
+  uint64_t x1124;
+  fiat_p1024_uint1 x1125;
+  uint64_t x1126;
+  fiat_p1024_uint1 x1127;
+  uint64_t x1128;
+  uint64_t x1129;
+  fiat_p1024_uint1 x1130;
+  uint64_t x1131;
+  fiat_p1024_uint1 x1132;
+  uint64_t x1133;
+  fiat_p1024_uint1 x1134;
+  uint64_t x1135;
+  fiat_p1024_uint1 x1136;
+  uint64_t x1137;
+  fiat_p1024_uint1 x1138;
+  uint64_t x1139;
+  fiat_p1024_uint1 x1140;
+  uint64_t x1141;
+  fiat_p1024_uint1 x1142;
+  uint64_t x1143;
+  fiat_p1024_uint1 x1144;
+  uint64_t x1145;
+  fiat_p1024_uint1 x1146;
+  uint64_t x1147;
+  fiat_p1024_uint1 x1148;
+  uint64_t x1149;
+  fiat_p1024_uint1 x1150;
+  uint64_t x1151;
+  fiat_p1024_uint1 x1152;
+  uint64_t x1153;
+  fiat_p1024_uint1 x1154;
+  uint64_t x1155;
+  fiat_p1024_uint1 x1156;
+  uint64_t x1157;
+  fiat_p1024_uint1 x1158;
+  uint64_t x1159;
+  fiat_p1024_uint1 x1160;
+  uint64_t x1161;
+  fiat_p1024_uint1 x1162;
+  uint64_t x1163;
+  uint64_t x1164;
+  uint64_t x1165;
+  uint64_t x1166;
+  uint64_t x1167;
+  uint64_t x1168;
+  uint64_t x1169;
+  uint64_t x1170;
+  uint64_t x1171;
+  uint64_t x1172;
+  uint64_t x1173;
+  uint64_t x1174;
+  uint64_t x1175;
+  uint64_t x1176;
+  uint64_t x1177;
+  uint64_t x1178;
+  uint64_t x1179;
+  uint64_t x1180;
+  uint64_t x1181;
+  uint64_t x1182;
+  uint64_t x1183;
+  uint64_t x1184;
+  uint64_t x1185;
+  uint64_t x1186;
+  uint64_t x1187;
+  uint64_t x1188;
+  uint64_t x1189;
+  uint64_t x1190;
+  uint64_t x1191;
+  uint64_t x1192;
+  uint64_t x1193;
+  uint64_t x1194;
+  uint64_t x1195;
+  uint64_t x1196;
+  fiat_p1024_uint1 x1197;
+  uint64_t x1198;
+  fiat_p1024_uint1 x1199;
+  uint64_t x1200;
+  fiat_p1024_uint1 x1201;
+  uint64_t x1202;
+  fiat_p1024_uint1 x1203;
+  uint64_t x1204;
+  fiat_p1024_uint1 x1205;
+  uint64_t x1206;
+  fiat_p1024_uint1 x1207;
+  uint64_t x1208;
+  fiat_p1024_uint1 x1209;
+  uint64_t x1210;
+  fiat_p1024_uint1 x1211;
+  uint64_t x1212;
+  fiat_p1024_uint1 x1213;
+  uint64_t x1214;
+  fiat_p1024_uint1 x1215;
+  uint64_t x1216;
+  fiat_p1024_uint1 x1217;
+  uint64_t x1218;
+  fiat_p1024_uint1 x1219;
+  uint64_t x1220;
+  fiat_p1024_uint1 x1221;
+  uint64_t x1222;
+  fiat_p1024_uint1 x1223;
+  uint64_t x1224;
+  fiat_p1024_uint1 x1225;
+  uint64_t x1226;
+  uint64_t x1227;
+  fiat_p1024_uint1 x1228;
+  uint64_t x1229;
+  fiat_p1024_uint1 x1230;
+  uint64_t x1231;
+  fiat_p1024_uint1 x1232;
+  uint64_t x1233;
+  fiat_p1024_uint1 x1234;
+  uint64_t x1235;
+  fiat_p1024_uint1 x1236;
+  uint64_t x1237;
+  fiat_p1024_uint1 x1238;
+  uint64_t x1239;
+  fiat_p1024_uint1 x1240;
+  uint64_t x1241;
+  fiat_p1024_uint1 x1242;
+  uint64_t x1243;
+  fiat_p1024_uint1 x1244;
+  uint64_t x1245;
+  fiat_p1024_uint1 x1246;
+  uint64_t x1247;
+  fiat_p1024_uint1 x1248;
+  uint64_t x1249;
+  fiat_p1024_uint1 x1250;
+  uint64_t x1251;
+  fiat_p1024_uint1 x1252;
+  uint64_t x1253;
+  fiat_p1024_uint1 x1254;
+  uint64_t x1255;
+  fiat_p1024_uint1 x1256;
+  uint64_t x1257;
+  fiat_p1024_uint1 x1258;
+  uint64_t x1259;
+  fiat_p1024_uint1 x1260;
+  uint64_t x1261;
+  uint64_t x1262;
+  uint64_t x1263;
+  uint64_t x1264;
+  uint64_t x1265;
+  uint64_t x1266;
+  uint64_t x1267;
+  uint64_t x1268;
+  uint64_t x1269;
+  uint64_t x1270;
+  uint64_t x1271;
+  uint64_t x1272;
+  uint64_t x1273;
+  uint64_t x1274;
+  uint64_t x1275;
+  uint64_t x1276;
+  uint64_t x1277;
+  uint64_t x1278;
+  uint64_t x1279;
+  uint64_t x1280;
+  uint64_t x1281;
+  uint64_t x1282;
+  uint64_t x1283;
+  uint64_t x1284;
+  uint64_t x1285;
+  uint64_t x1286;
+  uint64_t x1287;
+  uint64_t x1288;
+  uint64_t x1289;
+  uint64_t x1290;
+  uint64_t x1291;
+  uint64_t x1292;
+  uint64_t x1293;
+  uint64_t x1294;
+  uint64_t x1295;
+  fiat_p1024_uint1 x1296;
+  uint64_t x1297;
+  fiat_p1024_uint1 x1298;
+  uint64_t x1299;
+  fiat_p1024_uint1 x1300;
+  uint64_t x1301;
+  fiat_p1024_uint1 x1302;
+  uint64_t x1303;
+  fiat_p1024_uint1 x1304;
+  uint64_t x1305;
+  fiat_p1024_uint1 x1306;
+  uint64_t x1307;
+  fiat_p1024_uint1 x1308;
+  uint64_t x1309;
+  fiat_p1024_uint1 x1310;
+  uint64_t x1311;
+  fiat_p1024_uint1 x1312;
+  uint64_t x1313;
+  fiat_p1024_uint1 x1314;
+  uint64_t x1315;
+  fiat_p1024_uint1 x1316;
+  uint64_t x1317;
+  fiat_p1024_uint1 x1318;
+  uint64_t x1319;
+  fiat_p1024_uint1 x1320;
+  uint64_t x1321;
+  fiat_p1024_uint1 x1322;
+  uint64_t x1323;
+  fiat_p1024_uint1 x1324;
+  uint64_t x1325;
+  uint64_t x1326;
+  fiat_p1024_uint1 x1327;
+  uint64_t x1328;
+  fiat_p1024_uint1 x1329;
+  uint64_t x1330;
+  fiat_p1024_uint1 x1331;
+  uint64_t x1332;
+  fiat_p1024_uint1 x1333;
+  uint64_t x1334;
+  fiat_p1024_uint1 x1335;
+  uint64_t x1336;
+  fiat_p1024_uint1 x1337;
+  uint64_t x1338;
+  fiat_p1024_uint1 x1339;
+  uint64_t x1340;
+  fiat_p1024_uint1 x1341;
+  uint64_t x1342;
+  fiat_p1024_uint1 x1343;
+  uint64_t x1344;
+  fiat_p1024_uint1 x1345;
+  uint64_t x1346;
+  fiat_p1024_uint1 x1347;
+  uint64_t x1348;
+  fiat_p1024_uint1 x1349;
+  uint64_t x1350;
+  fiat_p1024_uint1 x1351;
+  uint64_t x1352;
+  fiat_p1024_uint1 x1353;
+  uint64_t x1354;
+  fiat_p1024_uint1 x1355;
+  uint64_t x1356;
+  fiat_p1024_uint1 x1357;
+  uint64_t x1358;
+  fiat_p1024_uint1 x1359;
+  uint64_t x1360;
+  uint64_t x1361;
+  uint64_t x1362;
+  uint64_t x1363;
+  uint64_t x1364;
+  uint64_t x1365;
+  uint64_t x1366;
+  uint64_t x1367;
+  uint64_t x1368;
+  uint64_t x1369;
+  uint64_t x1370;
+  uint64_t x1371;
+  uint64_t x1372;
+  uint64_t x1373;
+  uint64_t x1374;
+  uint64_t x1375;
+  uint64_t x1376;
+  uint64_t x1377;
+  uint64_t x1378;
+  uint64_t x1379;
+  uint64_t x1380;
+  uint64_t x1381;
+  uint64_t x1382;
+  uint64_t x1383;
+  uint64_t x1384;
+  uint64_t x1385;
+  uint64_t x1386;
+  uint64_t x1387;
+  uint64_t x1388;
+  uint64_t x1389;
+  uint64_t x1390;
+  uint64_t x1391;
+  uint64_t x1392;
+  uint64_t x1393;
+  fiat_p1024_uint1 x1394;
+  uint64_t x1395;
+  fiat_p1024_uint1 x1396;
+  uint64_t x1397;
+  fiat_p1024_uint1 x1398;
+  uint64_t x1399;
+  fiat_p1024_uint1 x1400;
+  uint64_t x1401;
+  fiat_p1024_uint1 x1402;
+  uint64_t x1403;
+  fiat_p1024_uint1 x1404;
+  uint64_t x1405;
+  fiat_p1024_uint1 x1406;
+  uint64_t x1407;
+  fiat_p1024_uint1 x1408;
+  uint64_t x1409;
+  fiat_p1024_uint1 x1410;
+  uint64_t x1411;
+  fiat_p1024_uint1 x1412;
+  uint64_t x1413;
+  fiat_p1024_uint1 x1414;
+  uint64_t x1415;
+  fiat_p1024_uint1 x1416;
+  uint64_t x1417;
+  fiat_p1024_uint1 x1418;
+  uint64_t x1419;
+  fiat_p1024_uint1 x1420;
+  uint64_t x1421;
+  fiat_p1024_uint1 x1422;
+  uint64_t x1423;
+  uint64_t x1424;
+  fiat_p1024_uint1 x1425;
+  uint64_t x1426;
+  fiat_p1024_uint1 x1427;
+  uint64_t x1428;
+  fiat_p1024_uint1 x1429;
+  uint64_t x1430;
+  fiat_p1024_uint1 x1431;
+  uint64_t x1432;
+  fiat_p1024_uint1 x1433;
+  uint64_t x1434;
+  fiat_p1024_uint1 x1435;
+  uint64_t x1436;
+  fiat_p1024_uint1 x1437;
+  uint64_t x1438;
+  fiat_p1024_uint1 x1439;
+  uint64_t x1440;
+  fiat_p1024_uint1 x1441;
+  uint64_t x1442;
+  fiat_p1024_uint1 x1443;
+  uint64_t x1444;
+  fiat_p1024_uint1 x1445;
+  uint64_t x1446;
+  fiat_p1024_uint1 x1447;
+  uint64_t x1448;
+  fiat_p1024_uint1 x1449;
+  uint64_t x1450;
+  fiat_p1024_uint1 x1451;
+  uint64_t x1452;
+  fiat_p1024_uint1 x1453;
+  uint64_t x1454;
+  fiat_p1024_uint1 x1455;
+  uint64_t x1456;
+  fiat_p1024_uint1 x1457;
+  uint64_t x1458;
+  uint64_t x1459;
+  uint64_t x1460;
+  uint64_t x1461;
+  uint64_t x1462;
+  uint64_t x1463;
+  uint64_t x1464;
+  uint64_t x1465;
+  uint64_t x1466;
+  uint64_t x1467;
+  uint64_t x1468;
+  uint64_t x1469;
+  uint64_t x1470;
+  uint64_t x1471;
+  uint64_t x1472;
+  uint64_t x1473;
+  uint64_t x1474;
+  uint64_t x1475;
+  uint64_t x1476;
+  uint64_t x1477;
+  uint64_t x1478;
+  uint64_t x1479;
+  uint64_t x1480;
+  uint64_t x1481;
+  uint64_t x1482;
+  uint64_t x1483;
+  uint64_t x1484;
+  uint64_t x1485;
+  uint64_t x1486;
+  uint64_t x1487;
+  uint64_t x1488;
+  uint64_t x1489;
+  uint64_t x1490;
+  uint64_t x1491;
+  uint64_t x1492;
+  fiat_p1024_uint1 x1493;
+  uint64_t x1494;
+  fiat_p1024_uint1 x1495;
+  uint64_t x1496;
+  fiat_p1024_uint1 x1497;
+  uint64_t x1498;
+  fiat_p1024_uint1 x1499;
+  uint64_t x1500;
+  fiat_p1024_uint1 x1501;
+  uint64_t x1502;
+  fiat_p1024_uint1 x1503;
+  uint64_t x1504;
+  fiat_p1024_uint1 x1505;
+  uint64_t x1506;
+  fiat_p1024_uint1 x1507;
+  uint64_t x1508;
+  fiat_p1024_uint1 x1509;
+  uint64_t x1510;
+  fiat_p1024_uint1 x1511;
+  uint64_t x1512;
+  fiat_p1024_uint1 x1513;
+  uint64_t x1514;
+  fiat_p1024_uint1 x1515;
+  uint64_t x1516;
+  fiat_p1024_uint1 x1517;
+  uint64_t x1518;
+  fiat_p1024_uint1 x1519;
+  uint64_t x1520;
+  fiat_p1024_uint1 x1521;
+  uint64_t x1522;
+  uint64_t x1523;
+  fiat_p1024_uint1 x1524;
+  uint64_t x1525;
+  fiat_p1024_uint1 x1526;
+  uint64_t x1527;
+  fiat_p1024_uint1 x1528;
+  uint64_t x1529;
+  fiat_p1024_uint1 x1530;
+  uint64_t x1531;
+  fiat_p1024_uint1 x1532;
+  uint64_t x1533;
+  fiat_p1024_uint1 x1534;
+  uint64_t x1535;
+  fiat_p1024_uint1 x1536;
+  uint64_t x1537;
+  fiat_p1024_uint1 x1538;
+  uint64_t x1539;
+  fiat_p1024_uint1 x1540;
+  uint64_t x1541;
+  fiat_p1024_uint1 x1542;
+  uint64_t x1543;
+  fiat_p1024_uint1 x1544;
+  uint64_t x1545;
+  fiat_p1024_uint1 x1546;
+  uint64_t x1547;
+  fiat_p1024_uint1 x1548;
+  uint64_t x1549;
+  fiat_p1024_uint1 x1550;
+  uint64_t x1551;
+  fiat_p1024_uint1 x1552;
+  uint64_t x1553;
+  fiat_p1024_uint1 x1554;
+  uint64_t x1555;
+  fiat_p1024_uint1 x1556;
+  uint64_t x1557;
+  uint64_t x1558;
+  uint64_t x1559;
+  uint64_t x1560;
+  uint64_t x1561;
+  uint64_t x1562;
+  uint64_t x1563;
+  uint64_t x1564;
+  uint64_t x1565;
+  uint64_t x1566;
+  uint64_t x1567;
+  uint64_t x1568;
+  uint64_t x1569;
+  uint64_t x1570;
+  uint64_t x1571;
+  uint64_t x1572;
+  uint64_t x1573;
+  uint64_t x1574;
+  uint64_t x1575;
+  uint64_t x1576;
+  uint64_t x1577;
+  uint64_t x1578;
+  uint64_t x1579;
+  uint64_t x1580;
+  uint64_t x1581;
+  uint64_t x1582;
+  uint64_t x1583;
+  uint64_t x1584;
+  uint64_t x1585;
+  uint64_t x1586;
+  uint64_t x1587;
+  uint64_t x1588;
+  uint64_t x1589;
+  uint64_t x1590;
+  fiat_p1024_uint1 x1591;
+  uint64_t x1592;
+  fiat_p1024_uint1 x1593;
+  uint64_t x1594;
+  fiat_p1024_uint1 x1595;
+  uint64_t x1596;
+  fiat_p1024_uint1 x1597;
+  uint64_t x1598;
+  fiat_p1024_uint1 x1599;
+  uint64_t x1600;
+  fiat_p1024_uint1 x1601;
+  uint64_t x1602;
+  fiat_p1024_uint1 x1603;
+  uint64_t x1604;
+  fiat_p1024_uint1 x1605;
+  uint64_t x1606;
+  fiat_p1024_uint1 x1607;
+  uint64_t x1608;
+  fiat_p1024_uint1 x1609;
+  uint64_t x1610;
+  fiat_p1024_uint1 x1611;
+  uint64_t x1612;
+  fiat_p1024_uint1 x1613;
+  uint64_t x1614;
+  fiat_p1024_uint1 x1615;
+  uint64_t x1616;
+  fiat_p1024_uint1 x1617;
+  uint64_t x1618;
+  fiat_p1024_uint1 x1619;
+  uint64_t x1620;
+  uint64_t x1621;
+  fiat_p1024_uint1 x1622;
+  uint64_t x1623;
+  fiat_p1024_uint1 x1624;
+  uint64_t x1625;
+  fiat_p1024_uint1 x1626;
+  uint64_t x1627;
+  fiat_p1024_uint1 x1628;
+  uint64_t x1629;
+  fiat_p1024_uint1 x1630;
+  uint64_t x1631;
+  fiat_p1024_uint1 x1632;
+  uint64_t x1633;
+  fiat_p1024_uint1 x1634;
+  uint64_t x1635;
+  fiat_p1024_uint1 x1636;
+  uint64_t x1637;
+  fiat_p1024_uint1 x1638;
+  uint64_t x1639;
+  fiat_p1024_uint1 x1640;
+  uint64_t x1641;
+  fiat_p1024_uint1 x1642;
+  uint64_t x1643;
+  fiat_p1024_uint1 x1644;
+  uint64_t x1645;
+  fiat_p1024_uint1 x1646;
+  uint64_t x1647;
+  fiat_p1024_uint1 x1648;
+  uint64_t x1649;
+  fiat_p1024_uint1 x1650;
+  uint64_t x1651;
+  fiat_p1024_uint1 x1652;
+  uint64_t x1653;
+  fiat_p1024_uint1 x1654;
+  uint64_t x1655;
+  uint64_t x1656;
+  uint64_t x1657;
+  uint64_t x1658;
+  uint64_t x1659;
+  uint64_t x1660;
+  uint64_t x1661;
+  uint64_t x1662;
+  uint64_t x1663;
+  uint64_t x1664;
+  uint64_t x1665;
+  uint64_t x1666;
+  uint64_t x1667;
+  uint64_t x1668;
+  uint64_t x1669;
+  uint64_t x1670;
+  uint64_t x1671;
+  uint64_t x1672;
+  uint64_t x1673;
+  uint64_t x1674;
+  uint64_t x1675;
+  uint64_t x1676;
+  uint64_t x1677;
+  uint64_t x1678;
+  uint64_t x1679;
+  uint64_t x1680;
+  uint64_t x1681;
+  uint64_t x1682;
+  uint64_t x1683;
+  uint64_t x1684;
+  uint64_t x1685;
+  uint64_t x1686;
+  uint64_t x1687;
+  uint64_t x1688;
+  uint64_t x1689;
+  fiat_p1024_uint1 x1690;
+  uint64_t x1691;
+  fiat_p1024_uint1 x1692;
+  uint64_t x1693;
+  fiat_p1024_uint1 x1694;
+  uint64_t x1695;
+  fiat_p1024_uint1 x1696;
+  uint64_t x1697;
+  fiat_p1024_uint1 x1698;
+  uint64_t x1699;
+  fiat_p1024_uint1 x1700;
+  uint64_t x1701;
+  fiat_p1024_uint1 x1702;
+  uint64_t x1703;
+  fiat_p1024_uint1 x1704;
+  uint64_t x1705;
+  fiat_p1024_uint1 x1706;
+  uint64_t x1707;
+  fiat_p1024_uint1 x1708;
+  uint64_t x1709;
+  fiat_p1024_uint1 x1710;
+  uint64_t x1711;
+  fiat_p1024_uint1 x1712;
+  uint64_t x1713;
+  fiat_p1024_uint1 x1714;
+  uint64_t x1715;
+  fiat_p1024_uint1 x1716;
+  uint64_t x1717;
+  fiat_p1024_uint1 x1718;
+  uint64_t x1719;
+  uint64_t x1720;
+  fiat_p1024_uint1 x1721;
+  uint64_t x1722;
+  fiat_p1024_uint1 x1723;
+  uint64_t x1724;
+  fiat_p1024_uint1 x1725;
+  uint64_t x1726;
+  fiat_p1024_uint1 x1727;
+  uint64_t x1728;
+  fiat_p1024_uint1 x1729;
+  uint64_t x1730;
+  fiat_p1024_uint1 x1731;
+  uint64_t x1732;
+  fiat_p1024_uint1 x1733;
+  uint64_t x1734;
+  fiat_p1024_uint1 x1735;
+  uint64_t x1736;
+  fiat_p1024_uint1 x1737;
+  uint64_t x1738;
+  fiat_p1024_uint1 x1739;
+  uint64_t x1740;
+  fiat_p1024_uint1 x1741;
+  uint64_t x1742;
+  fiat_p1024_uint1 x1743;
+  uint64_t x1744;
+  fiat_p1024_uint1 x1745;
+  uint64_t x1746;
+  fiat_p1024_uint1 x1747;
+  uint64_t x1748;
+  fiat_p1024_uint1 x1749;
+  uint64_t x1750;
+  fiat_p1024_uint1 x1751;
+  uint64_t x1752;
+  fiat_p1024_uint1 x1753;
+  uint64_t x1754;
+  uint64_t x1755;
+  uint64_t x1756;
+  uint64_t x1757;
+  uint64_t x1758;
+  uint64_t x1759;
+  uint64_t x1760;
+  uint64_t x1761;
+  uint64_t x1762;
+  uint64_t x1763;
+  uint64_t x1764;
+  uint64_t x1765;
+  uint64_t x1766;
+  uint64_t x1767;
+  uint64_t x1768;
+  uint64_t x1769;
+  uint64_t x1770;
+  uint64_t x1771;
+  uint64_t x1772;
+  uint64_t x1773;
+  uint64_t x1774;
+  uint64_t x1775;
+  uint64_t x1776;
+  uint64_t x1777;
+  uint64_t x1778;
+  uint64_t x1779;
+  uint64_t x1780;
+  uint64_t x1781;
+  uint64_t x1782;
+  uint64_t x1783;
+  uint64_t x1784;
+  uint64_t x1785;
+  uint64_t x1786;
+  uint64_t x1787;
+  fiat_p1024_uint1 x1788;
+  uint64_t x1789;
+  fiat_p1024_uint1 x1790;
+  uint64_t x1791;
+  fiat_p1024_uint1 x1792;
+  uint64_t x1793;
+  fiat_p1024_uint1 x1794;
+  uint64_t x1795;
+  fiat_p1024_uint1 x1796;
+  uint64_t x1797;
+  fiat_p1024_uint1 x1798;
+  uint64_t x1799;
+  fiat_p1024_uint1 x1800;
+  uint64_t x1801;
+  fiat_p1024_uint1 x1802;
+  uint64_t x1803;
+  fiat_p1024_uint1 x1804;
+  uint64_t x1805;
+  fiat_p1024_uint1 x1806;
+  uint64_t x1807;
+  fiat_p1024_uint1 x1808;
+  uint64_t x1809;
+  fiat_p1024_uint1 x1810;
+  uint64_t x1811;
+  fiat_p1024_uint1 x1812;
+  uint64_t x1813;
+  fiat_p1024_uint1 x1814;
+  uint64_t x1815;
+  fiat_p1024_uint1 x1816;
+  uint64_t x1817;
+  uint64_t x1818;
+  fiat_p1024_uint1 x1819;
+  uint64_t x1820;
+  fiat_p1024_uint1 x1821;
+  uint64_t x1822;
+  fiat_p1024_uint1 x1823;
+  uint64_t x1824;
+  fiat_p1024_uint1 x1825;
+  uint64_t x1826;
+  fiat_p1024_uint1 x1827;
+  uint64_t x1828;
+  fiat_p1024_uint1 x1829;
+  uint64_t x1830;
+  fiat_p1024_uint1 x1831;
+  uint64_t x1832;
+  fiat_p1024_uint1 x1833;
+  uint64_t x1834;
+  fiat_p1024_uint1 x1835;
+  uint64_t x1836;
+  fiat_p1024_uint1 x1837;
+  uint64_t x1838;
+  fiat_p1024_uint1 x1839;
+  uint64_t x1840;
+  fiat_p1024_uint1 x1841;
+  uint64_t x1842;
+  fiat_p1024_uint1 x1843;
+  uint64_t x1844;
+  fiat_p1024_uint1 x1845;
+  uint64_t x1846;
+  fiat_p1024_uint1 x1847;
+  uint64_t x1848;
+  fiat_p1024_uint1 x1849;
+  uint64_t x1850;
+  fiat_p1024_uint1 x1851;
+  uint64_t x1852;
+  uint64_t x1853;
+  uint64_t x1854;
+  uint64_t x1855;
+  uint64_t x1856;
+  uint64_t x1857;
+  uint64_t x1858;
+  uint64_t x1859;
+  uint64_t x1860;
+  uint64_t x1861;
+  uint64_t x1862;
+  uint64_t x1863;
+  uint64_t x1864;
+  uint64_t x1865;
+  uint64_t x1866;
+  uint64_t x1867;
+  uint64_t x1868;
+  uint64_t x1869;
+  uint64_t x1870;
+  uint64_t x1871;
+  uint64_t x1872;
+  uint64_t x1873;
+  uint64_t x1874;
+  uint64_t x1875;
+  uint64_t x1876;
+  uint64_t x1877;
+  uint64_t x1878;
+  uint64_t x1879;
+  uint64_t x1880;
+  uint64_t x1881;
+  uint64_t x1882;
+  uint64_t x1883;
+  uint64_t x1884;
+  uint64_t x1885;
+  uint64_t x1886;
+  fiat_p1024_uint1 x1887;
+  uint64_t x1888;
+  fiat_p1024_uint1 x1889;
+  uint64_t x1890;
+  fiat_p1024_uint1 x1891;
+  uint64_t x1892;
+  fiat_p1024_uint1 x1893;
+  uint64_t x1894;
+  fiat_p1024_uint1 x1895;
+  uint64_t x1896;
+  fiat_p1024_uint1 x1897;
+  uint64_t x1898;
+  fiat_p1024_uint1 x1899;
+  uint64_t x1900;
+  fiat_p1024_uint1 x1901;
+  uint64_t x1902;
+  fiat_p1024_uint1 x1903;
+  uint64_t x1904;
+  fiat_p1024_uint1 x1905;
+  uint64_t x1906;
+  fiat_p1024_uint1 x1907;
+  uint64_t x1908;
+  fiat_p1024_uint1 x1909;
+  uint64_t x1910;
+  fiat_p1024_uint1 x1911;
+  uint64_t x1912;
+  fiat_p1024_uint1 x1913;
+  uint64_t x1914;
+  fiat_p1024_uint1 x1915;
+  uint64_t x1916;
+  uint64_t x1917;
+  fiat_p1024_uint1 x1918;
+  uint64_t x1919;
+  fiat_p1024_uint1 x1920;
+  uint64_t x1921;
+  fiat_p1024_uint1 x1922;
+  uint64_t x1923;
+  fiat_p1024_uint1 x1924;
+  uint64_t x1925;
+  fiat_p1024_uint1 x1926;
+  uint64_t x1927;
+  fiat_p1024_uint1 x1928;
+  uint64_t x1929;
+  fiat_p1024_uint1 x1930;
+  uint64_t x1931;
+  fiat_p1024_uint1 x1932;
+  uint64_t x1933;
+  fiat_p1024_uint1 x1934;
+  uint64_t x1935;
+  fiat_p1024_uint1 x1936;
+  uint64_t x1937;
+  fiat_p1024_uint1 x1938;
+  uint64_t x1939;
+  fiat_p1024_uint1 x1940;
+  uint64_t x1941;
+  fiat_p1024_uint1 x1942;
+  uint64_t x1943;
+  fiat_p1024_uint1 x1944;
+  uint64_t x1945;
+  fiat_p1024_uint1 x1946;
+  uint64_t x1947;
+  fiat_p1024_uint1 x1948;
+  uint64_t x1949;
+  fiat_p1024_uint1 x1950;
+  uint64_t x1951;
+  uint64_t x1952;
+  uint64_t x1953;
+  uint64_t x1954;
+  uint64_t x1955;
+  uint64_t x1956;
+  uint64_t x1957;
+  uint64_t x1958;
+  uint64_t x1959;
+  uint64_t x1960;
+  uint64_t x1961;
+  uint64_t x1962;
+  uint64_t x1963;
+  uint64_t x1964;
+  uint64_t x1965;
+  uint64_t x1966;
+  uint64_t x1967;
+  uint64_t x1968;
+  uint64_t x1969;
+  uint64_t x1970;
+  uint64_t x1971;
+  uint64_t x1972;
+  uint64_t x1973;
+  uint64_t x1974;
+  uint64_t x1975;
+  uint64_t x1976;
+  uint64_t x1977;
+  uint64_t x1978;
+  uint64_t x1979;
+  uint64_t x1980;
+  uint64_t x1981;
+  uint64_t x1982;
+  uint64_t x1983;
+  uint64_t x1984;
+  fiat_p1024_uint1 x1985;
+  uint64_t x1986;
+  fiat_p1024_uint1 x1987;
+  uint64_t x1988;
+  fiat_p1024_uint1 x1989;
+  uint64_t x1990;
+  fiat_p1024_uint1 x1991;
+  uint64_t x1992;
+  fiat_p1024_uint1 x1993;
+  uint64_t x1994;
+  fiat_p1024_uint1 x1995;
+  uint64_t x1996;
+  fiat_p1024_uint1 x1997;
+  uint64_t x1998;
+  fiat_p1024_uint1 x1999;
+  uint64_t x2000;
+  fiat_p1024_uint1 x2001;
+  uint64_t x2002;
+  fiat_p1024_uint1 x2003;
+  uint64_t x2004;
+  fiat_p1024_uint1 x2005;
+  uint64_t x2006;
+  fiat_p1024_uint1 x2007;
+  uint64_t x2008;
+  fiat_p1024_uint1 x2009;
+  uint64_t x2010;
+  fiat_p1024_uint1 x2011;
+  uint64_t x2012;
+  fiat_p1024_uint1 x2013;
+  uint64_t x2014;
+  uint64_t x2015;
+  fiat_p1024_uint1 x2016;
+  uint64_t x2017;
+  fiat_p1024_uint1 x2018;
+  uint64_t x2019;
+  fiat_p1024_uint1 x2020;
+  uint64_t x2021;
+  fiat_p1024_uint1 x2022;
+  uint64_t x2023;
+  fiat_p1024_uint1 x2024;
+  uint64_t x2025;
+  fiat_p1024_uint1 x2026;
+  uint64_t x2027;
+  fiat_p1024_uint1 x2028;
+  uint64_t x2029;
+  fiat_p1024_uint1 x2030;
+  uint64_t x2031;
+  fiat_p1024_uint1 x2032;
+  uint64_t x2033;
+  fiat_p1024_uint1 x2034;
+  uint64_t x2035;
+  fiat_p1024_uint1 x2036;
+  uint64_t x2037;
+  fiat_p1024_uint1 x2038;
+  uint64_t x2039;
+  fiat_p1024_uint1 x2040;
+  uint64_t x2041;
+  fiat_p1024_uint1 x2042;
+  uint64_t x2043;
+  fiat_p1024_uint1 x2044;
+  uint64_t x2045;
+  fiat_p1024_uint1 x2046;
+  uint64_t x2047;
+  fiat_p1024_uint1 x2048;
+  uint64_t x2049;
+  uint64_t x2050;
+  uint64_t x2051;
+  uint64_t x2052;
+  uint64_t x2053;
+  uint64_t x2054;
+  uint64_t x2055;
+  uint64_t x2056;
+  uint64_t x2057;
+  uint64_t x2058;
+  uint64_t x2059;
+  uint64_t x2060;
+  uint64_t x2061;
+  uint64_t x2062;
+  uint64_t x2063;
+  uint64_t x2064;
+  uint64_t x2065;
+  uint64_t x2066;
+  uint64_t x2067;
+  uint64_t x2068;
+  uint64_t x2069;
+  uint64_t x2070;
+  uint64_t x2071;
+  uint64_t x2072;
+  uint64_t x2073;
+  uint64_t x2074;
+  uint64_t x2075;
+  uint64_t x2076;
+  uint64_t x2077;
+  uint64_t x2078;
+  uint64_t x2079;
+  uint64_t x2080;
+  uint64_t x2081;
+  uint64_t x2082;
+  uint64_t x2083;
+  fiat_p1024_uint1 x2084;
+  uint64_t x2085;
+  fiat_p1024_uint1 x2086;
+  uint64_t x2087;
+  fiat_p1024_uint1 x2088;
+  uint64_t x2089;
+  fiat_p1024_uint1 x2090;
+  uint64_t x2091;
+  fiat_p1024_uint1 x2092;
+  uint64_t x2093;
+  fiat_p1024_uint1 x2094;
+  uint64_t x2095;
+  fiat_p1024_uint1 x2096;
+  uint64_t x2097;
+  fiat_p1024_uint1 x2098;
+  uint64_t x2099;
+  fiat_p1024_uint1 x2100;
+  uint64_t x2101;
+  fiat_p1024_uint1 x2102;
+  uint64_t x2103;
+  fiat_p1024_uint1 x2104;
+  uint64_t x2105;
+  fiat_p1024_uint1 x2106;
+  uint64_t x2107;
+  fiat_p1024_uint1 x2108;
+  uint64_t x2109;
+  fiat_p1024_uint1 x2110;
+  uint64_t x2111;
+  fiat_p1024_uint1 x2112;
+  uint64_t x2113;
+  uint64_t x2114;
+  fiat_p1024_uint1 x2115;
+  uint64_t x2116;
+  fiat_p1024_uint1 x2117;
+  uint64_t x2118;
+  fiat_p1024_uint1 x2119;
+  uint64_t x2120;
+  fiat_p1024_uint1 x2121;
+  uint64_t x2122;
+  fiat_p1024_uint1 x2123;
+  uint64_t x2124;
+  fiat_p1024_uint1 x2125;
+  uint64_t x2126;
+  fiat_p1024_uint1 x2127;
+  uint64_t x2128;
+  fiat_p1024_uint1 x2129;
+  uint64_t x2130;
+  fiat_p1024_uint1 x2131;
+  uint64_t x2132;
+  fiat_p1024_uint1 x2133;
+  uint64_t x2134;
+  fiat_p1024_uint1 x2135;
+  uint64_t x2136;
+  fiat_p1024_uint1 x2137;
+  uint64_t x2138;
+  fiat_p1024_uint1 x2139;
+  uint64_t x2140;
+  fiat_p1024_uint1 x2141;
+  uint64_t x2142;
+  fiat_p1024_uint1 x2143;
+  uint64_t x2144;
+  fiat_p1024_uint1 x2145;
+  uint64_t x2146;
+  fiat_p1024_uint1 x2147;
+  uint64_t x2148;
+  uint64_t x2149;
+  uint64_t x2150;
+  uint64_t x2151;
+  uint64_t x2152;
+  uint64_t x2153;
+  uint64_t x2154;
+  uint64_t x2155;
+  uint64_t x2156;
+  uint64_t x2157;
+  uint64_t x2158;
+  uint64_t x2159;
+  uint64_t x2160;
+  uint64_t x2161;
+  uint64_t x2162;
+  uint64_t x2163;
+  uint64_t x2164;
+  uint64_t x2165;
+  uint64_t x2166;
+  uint64_t x2167;
+  uint64_t x2168;
+  uint64_t x2169;
+  uint64_t x2170;
+  uint64_t x2171;
+  uint64_t x2172;
+  uint64_t x2173;
+  uint64_t x2174;
+  uint64_t x2175;
+  uint64_t x2176;
+  uint64_t x2177;
+  uint64_t x2178;
+  uint64_t x2179;
+  uint64_t x2180;
+  uint64_t x2181;
+  fiat_p1024_uint1 x2182;
+  uint64_t x2183;
+  fiat_p1024_uint1 x2184;
+  uint64_t x2185;
+  fiat_p1024_uint1 x2186;
+  uint64_t x2187;
+  fiat_p1024_uint1 x2188;
+  uint64_t x2189;
+  fiat_p1024_uint1 x2190;
+  uint64_t x2191;
+  fiat_p1024_uint1 x2192;
+  uint64_t x2193;
+  fiat_p1024_uint1 x2194;
+  uint64_t x2195;
+  fiat_p1024_uint1 x2196;
+  uint64_t x2197;
+  fiat_p1024_uint1 x2198;
+  uint64_t x2199;
+  fiat_p1024_uint1 x2200;
+  uint64_t x2201;
+  fiat_p1024_uint1 x2202;
+  uint64_t x2203;
+  fiat_p1024_uint1 x2204;
+  uint64_t x2205;
+  fiat_p1024_uint1 x2206;
+  uint64_t x2207;
+  fiat_p1024_uint1 x2208;
+  uint64_t x2209;
+  fiat_p1024_uint1 x2210;
+  uint64_t x2211;
+  uint64_t x2212;
+  fiat_p1024_uint1 x2213;
+  uint64_t x2214;
+  fiat_p1024_uint1 x2215;
+  uint64_t x2216;
+  fiat_p1024_uint1 x2217;
+  uint64_t x2218;
+  fiat_p1024_uint1 x2219;
+  uint64_t x2220;
+  fiat_p1024_uint1 x2221;
+  uint64_t x2222;
+  fiat_p1024_uint1 x2223;
+  uint64_t x2224;
+  fiat_p1024_uint1 x2225;
+  uint64_t x2226;
+  fiat_p1024_uint1 x2227;
+  uint64_t x2228;
+  fiat_p1024_uint1 x2229;
+  uint64_t x2230;
+  fiat_p1024_uint1 x2231;
+  uint64_t x2232;
+  fiat_p1024_uint1 x2233;
+  uint64_t x2234;
+  fiat_p1024_uint1 x2235;
+  uint64_t x2236;
+  fiat_p1024_uint1 x2237;
+  uint64_t x2238;
+  fiat_p1024_uint1 x2239;
+  uint64_t x2240;
+  fiat_p1024_uint1 x2241;
+  uint64_t x2242;
+  fiat_p1024_uint1 x2243;
+  uint64_t x2244;
+  fiat_p1024_uint1 x2245;
+  uint64_t x2246;
+  uint64_t x2247;
+  uint64_t x2248;
+  uint64_t x2249;
+  uint64_t x2250;
+  uint64_t x2251;
+  uint64_t x2252;
+  uint64_t x2253;
+  uint64_t x2254;
+  uint64_t x2255;
+  uint64_t x2256;
+  uint64_t x2257;
+  uint64_t x2258;
+  uint64_t x2259;
+  uint64_t x2260;
+  uint64_t x2261;
+  uint64_t x2262;
+  uint64_t x2263;
+  uint64_t x2264;
+  uint64_t x2265;
+  uint64_t x2266;
+  uint64_t x2267;
+  uint64_t x2268;
+  uint64_t x2269;
+  uint64_t x2270;
+  uint64_t x2271;
+  uint64_t x2272;
+  uint64_t x2273;
+  uint64_t x2274;
+  uint64_t x2275;
+  uint64_t x2276;
+  uint64_t x2277;
+  uint64_t x2278;
+  uint64_t x2279;
+  uint64_t x2280;
+  fiat_p1024_uint1 x2281;
+  uint64_t x2282;
+  fiat_p1024_uint1 x2283;
+  uint64_t x2284;
+  fiat_p1024_uint1 x2285;
+  uint64_t x2286;
+  fiat_p1024_uint1 x2287;
+  uint64_t x2288;
+  fiat_p1024_uint1 x2289;
+  uint64_t x2290;
+  fiat_p1024_uint1 x2291;
+  uint64_t x2292;
+  fiat_p1024_uint1 x2293;
+  uint64_t x2294;
+  fiat_p1024_uint1 x2295;
+  uint64_t x2296;
+  fiat_p1024_uint1 x2297;
+  uint64_t x2298;
+  fiat_p1024_uint1 x2299;
+  uint64_t x2300;
+  fiat_p1024_uint1 x2301;
+  uint64_t x2302;
+  fiat_p1024_uint1 x2303;
+  uint64_t x2304;
+  fiat_p1024_uint1 x2305;
+  uint64_t x2306;
+  fiat_p1024_uint1 x2307;
+  uint64_t x2308;
+  fiat_p1024_uint1 x2309;
+  uint64_t x2310;
+  uint64_t x2311;
+  fiat_p1024_uint1 x2312;
+  uint64_t x2313;
+  fiat_p1024_uint1 x2314;
+  uint64_t x2315;
+  fiat_p1024_uint1 x2316;
+  uint64_t x2317;
+  fiat_p1024_uint1 x2318;
+  uint64_t x2319;
+  fiat_p1024_uint1 x2320;
+  uint64_t x2321;
+  fiat_p1024_uint1 x2322;
+  uint64_t x2323;
+  fiat_p1024_uint1 x2324;
+  uint64_t x2325;
+  fiat_p1024_uint1 x2326;
+  uint64_t x2327;
+  fiat_p1024_uint1 x2328;
+  uint64_t x2329;
+  fiat_p1024_uint1 x2330;
+  uint64_t x2331;
+  fiat_p1024_uint1 x2332;
+  uint64_t x2333;
+  fiat_p1024_uint1 x2334;
+  uint64_t x2335;
+  fiat_p1024_uint1 x2336;
+  uint64_t x2337;
+  fiat_p1024_uint1 x2338;
+  uint64_t x2339;
+  fiat_p1024_uint1 x2340;
+  uint64_t x2341;
+  fiat_p1024_uint1 x2342;
+  uint64_t x2343;
+  fiat_p1024_uint1 x2344;
+  uint64_t x2345;
+  uint64_t x2346;
+  uint64_t x2347;
+  uint64_t x2348;
+  uint64_t x2349;
+  uint64_t x2350;
+  uint64_t x2351;
+  uint64_t x2352;
+  uint64_t x2353;
+  uint64_t x2354;
+  uint64_t x2355;
+  uint64_t x2356;
+  uint64_t x2357;
+  uint64_t x2358;
+  uint64_t x2359;
+  uint64_t x2360;
+  uint64_t x2361;
+  uint64_t x2362;
+  uint64_t x2363;
+  uint64_t x2364;
+  uint64_t x2365;
+  uint64_t x2366;
+  uint64_t x2367;
+  uint64_t x2368;
+  uint64_t x2369;
+  uint64_t x2370;
+  uint64_t x2371;
+  uint64_t x2372;
+  uint64_t x2373;
+  uint64_t x2374;
+  uint64_t x2375;
+  uint64_t x2376;
+  uint64_t x2377;
+  uint64_t x2378;
+  fiat_p1024_uint1 x2379;
+  uint64_t x2380;
+  fiat_p1024_uint1 x2381;
+  uint64_t x2382;
+  fiat_p1024_uint1 x2383;
+  uint64_t x2384;
+  fiat_p1024_uint1 x2385;
+  uint64_t x2386;
+  fiat_p1024_uint1 x2387;
+  uint64_t x2388;
+  fiat_p1024_uint1 x2389;
+  uint64_t x2390;
+  fiat_p1024_uint1 x2391;
+  uint64_t x2392;
+  fiat_p1024_uint1 x2393;
+  uint64_t x2394;
+  fiat_p1024_uint1 x2395;
+  uint64_t x2396;
+  fiat_p1024_uint1 x2397;
+  uint64_t x2398;
+  fiat_p1024_uint1 x2399;
+  uint64_t x2400;
+  fiat_p1024_uint1 x2401;
+  uint64_t x2402;
+  fiat_p1024_uint1 x2403;
+  uint64_t x2404;
+  fiat_p1024_uint1 x2405;
+  uint64_t x2406;
+  fiat_p1024_uint1 x2407;
+  uint64_t x2408;
+  uint64_t x2409;
+  fiat_p1024_uint1 x2410;
+  uint64_t x2411;
+  fiat_p1024_uint1 x2412;
+  uint64_t x2413;
+  fiat_p1024_uint1 x2414;
+  uint64_t x2415;
+  fiat_p1024_uint1 x2416;
+  uint64_t x2417;
+  fiat_p1024_uint1 x2418;
+  uint64_t x2419;
+  fiat_p1024_uint1 x2420;
+  uint64_t x2421;
+  fiat_p1024_uint1 x2422;
+  uint64_t x2423;
+  fiat_p1024_uint1 x2424;
+  uint64_t x2425;
+  fiat_p1024_uint1 x2426;
+  uint64_t x2427;
+  fiat_p1024_uint1 x2428;
+  uint64_t x2429;
+  fiat_p1024_uint1 x2430;
+  uint64_t x2431;
+  fiat_p1024_uint1 x2432;
+  uint64_t x2433;
+  fiat_p1024_uint1 x2434;
+  uint64_t x2435;
+  fiat_p1024_uint1 x2436;
+  uint64_t x2437;
+  fiat_p1024_uint1 x2438;
+  uint64_t x2439;
+  fiat_p1024_uint1 x2440;
+  uint64_t x2441;
+  fiat_p1024_uint1 x2442;
+  uint64_t x2443;
+  uint64_t x2444;
+  uint64_t x2445;
+  uint64_t x2446;
+  uint64_t x2447;
+  uint64_t x2448;
+  uint64_t x2449;
+  uint64_t x2450;
+  uint64_t x2451;
+  uint64_t x2452;
+  uint64_t x2453;
+  uint64_t x2454;
+  uint64_t x2455;
+  uint64_t x2456;
+  uint64_t x2457;
+  uint64_t x2458;
+  uint64_t x2459;
+  uint64_t x2460;
+  uint64_t x2461;
+  uint64_t x2462;
+  uint64_t x2463;
+  uint64_t x2464;
+  uint64_t x2465;
+  uint64_t x2466;
+  uint64_t x2467;
+  uint64_t x2468;
+  uint64_t x2469;
+  uint64_t x2470;
+  uint64_t x2471;
+  uint64_t x2472;
+  uint64_t x2473;
+  uint64_t x2474;
+  uint64_t x2475;
+  uint64_t x2476;
+  uint64_t x2477;
+  fiat_p1024_uint1 x2478;
+  uint64_t x2479;
+  fiat_p1024_uint1 x2480;
+  uint64_t x2481;
+  fiat_p1024_uint1 x2482;
+  uint64_t x2483;
+  fiat_p1024_uint1 x2484;
+  uint64_t x2485;
+  fiat_p1024_uint1 x2486;
+  uint64_t x2487;
+  fiat_p1024_uint1 x2488;
+  uint64_t x2489;
+  fiat_p1024_uint1 x2490;
+  uint64_t x2491;
+  fiat_p1024_uint1 x2492;
+  uint64_t x2493;
+  fiat_p1024_uint1 x2494;
+  uint64_t x2495;
+  fiat_p1024_uint1 x2496;
+  uint64_t x2497;
+  fiat_p1024_uint1 x2498;
+  uint64_t x2499;
+  fiat_p1024_uint1 x2500;
+  uint64_t x2501;
+  fiat_p1024_uint1 x2502;
+  uint64_t x2503;
+  fiat_p1024_uint1 x2504;
+  uint64_t x2505;
+  fiat_p1024_uint1 x2506;
+  uint64_t x2507;
+  uint64_t x2508;
+  fiat_p1024_uint1 x2509;
+  uint64_t x2510;
+  fiat_p1024_uint1 x2511;
+  uint64_t x2512;
+  fiat_p1024_uint1 x2513;
+  uint64_t x2514;
+  fiat_p1024_uint1 x2515;
+  uint64_t x2516;
+  fiat_p1024_uint1 x2517;
+  uint64_t x2518;
+  fiat_p1024_uint1 x2519;
+  uint64_t x2520;
+  fiat_p1024_uint1 x2521;
+  uint64_t x2522;
+  fiat_p1024_uint1 x2523;
+  uint64_t x2524;
+  fiat_p1024_uint1 x2525;
+  uint64_t x2526;
+  fiat_p1024_uint1 x2527;
+  uint64_t x2528;
+  fiat_p1024_uint1 x2529;
+  uint64_t x2530;
+  fiat_p1024_uint1 x2531;
+  uint64_t x2532;
+  fiat_p1024_uint1 x2533;
+  uint64_t x2534;
+  fiat_p1024_uint1 x2535;
+  uint64_t x2536;
+  fiat_p1024_uint1 x2537;
+  uint64_t x2538;
+  fiat_p1024_uint1 x2539;
+  uint64_t x2540;
+  fiat_p1024_uint1 x2541;
+  uint64_t x2542;
+  uint64_t x2543;
+  uint64_t x2544;
+  uint64_t x2545;
+  uint64_t x2546;
+  uint64_t x2547;
+  uint64_t x2548;
+  uint64_t x2549;
+  uint64_t x2550;
+  uint64_t x2551;
+  uint64_t x2552;
+  uint64_t x2553;
+  uint64_t x2554;
+  uint64_t x2555;
+  uint64_t x2556;
+  uint64_t x2557;
+  uint64_t x2558;
+  uint64_t x2559;
+  uint64_t x2560;
+  uint64_t x2561;
+  uint64_t x2562;
+  uint64_t x2563;
+  uint64_t x2564;
+  uint64_t x2565;
+  uint64_t x2566;
+  uint64_t x2567;
+  uint64_t x2568;
+  uint64_t x2569;
+  uint64_t x2570;
+  uint64_t x2571;
+  uint64_t x2572;
+  uint64_t x2573;
+  uint64_t x2574;
+  uint64_t x2575;
+  fiat_p1024_uint1 x2576;
+  uint64_t x2577;
+  fiat_p1024_uint1 x2578;
+  uint64_t x2579;
+  fiat_p1024_uint1 x2580;
+  uint64_t x2581;
+  fiat_p1024_uint1 x2582;
+  uint64_t x2583;
+  fiat_p1024_uint1 x2584;
+  uint64_t x2585;
+  fiat_p1024_uint1 x2586;
+  uint64_t x2587;
+  fiat_p1024_uint1 x2588;
+  uint64_t x2589;
+  fiat_p1024_uint1 x2590;
+  uint64_t x2591;
+  fiat_p1024_uint1 x2592;
+  uint64_t x2593;
+  fiat_p1024_uint1 x2594;
+  uint64_t x2595;
+  fiat_p1024_uint1 x2596;
+  uint64_t x2597;
+  fiat_p1024_uint1 x2598;
+  uint64_t x2599;
+  fiat_p1024_uint1 x2600;
+  uint64_t x2601;
+  fiat_p1024_uint1 x2602;
+  uint64_t x2603;
+  fiat_p1024_uint1 x2604;
+  uint64_t x2605;
+  uint64_t x2606;
+  fiat_p1024_uint1 x2607;
+  uint64_t x2608;
+  fiat_p1024_uint1 x2609;
+  uint64_t x2610;
+  fiat_p1024_uint1 x2611;
+  uint64_t x2612;
+  fiat_p1024_uint1 x2613;
+  uint64_t x2614;
+  fiat_p1024_uint1 x2615;
+  uint64_t x2616;
+  fiat_p1024_uint1 x2617;
+  uint64_t x2618;
+  fiat_p1024_uint1 x2619;
+  uint64_t x2620;
+  fiat_p1024_uint1 x2621;
+  uint64_t x2622;
+  fiat_p1024_uint1 x2623;
+  uint64_t x2624;
+  fiat_p1024_uint1 x2625;
+  uint64_t x2626;
+  fiat_p1024_uint1 x2627;
+  uint64_t x2628;
+  fiat_p1024_uint1 x2629;
+  uint64_t x2630;
+  fiat_p1024_uint1 x2631;
+  uint64_t x2632;
+  fiat_p1024_uint1 x2633;
+  uint64_t x2634;
+  fiat_p1024_uint1 x2635;
+  uint64_t x2636;
+  fiat_p1024_uint1 x2637;
+  uint64_t x2638;
+  fiat_p1024_uint1 x2639;
+  uint64_t x2640;
+  uint64_t x2641;
+  uint64_t x2642;
+  uint64_t x2643;
+  uint64_t x2644;
+  uint64_t x2645;
+  uint64_t x2646;
+  uint64_t x2647;
+  uint64_t x2648;
+  uint64_t x2649;
+  uint64_t x2650;
+  uint64_t x2651;
+  uint64_t x2652;
+  uint64_t x2653;
+  uint64_t x2654;
+  uint64_t x2655;
+  uint64_t x2656;
+  uint64_t x2657;
+  uint64_t x2658;
+  uint64_t x2659;
+  uint64_t x2660;
+  uint64_t x2661;
+  uint64_t x2662;
+  uint64_t x2663;
+  uint64_t x2664;
+  uint64_t x2665;
+  uint64_t x2666;
+  uint64_t x2667;
+  uint64_t x2668;
+  uint64_t x2669;
+  uint64_t x2670;
+  uint64_t x2671;
+  uint64_t x2672;
+  uint64_t x2673;
+  uint64_t x2674;
+  fiat_p1024_uint1 x2675;
+  uint64_t x2676;
+  fiat_p1024_uint1 x2677;
+  uint64_t x2678;
+  fiat_p1024_uint1 x2679;
+  uint64_t x2680;
+  fiat_p1024_uint1 x2681;
+  uint64_t x2682;
+  fiat_p1024_uint1 x2683;
+  uint64_t x2684;
+  fiat_p1024_uint1 x2685;
+  uint64_t x2686;
+  fiat_p1024_uint1 x2687;
+  uint64_t x2688;
+  fiat_p1024_uint1 x2689;
+  uint64_t x2690;
+  fiat_p1024_uint1 x2691;
+  uint64_t x2692;
+  fiat_p1024_uint1 x2693;
+  uint64_t x2694;
+  fiat_p1024_uint1 x2695;
+  uint64_t x2696;
+  fiat_p1024_uint1 x2697;
+  uint64_t x2698;
+  fiat_p1024_uint1 x2699;
+  uint64_t x2700;
+  fiat_p1024_uint1 x2701;
+  uint64_t x2702;
+  fiat_p1024_uint1 x2703;
+  uint64_t x2704;
+  uint64_t x2705;
+  fiat_p1024_uint1 x2706;
+  uint64_t x2707;
+  fiat_p1024_uint1 x2708;
+  uint64_t x2709;
+  fiat_p1024_uint1 x2710;
+  uint64_t x2711;
+  fiat_p1024_uint1 x2712;
+  uint64_t x2713;
+  fiat_p1024_uint1 x2714;
+  uint64_t x2715;
+  fiat_p1024_uint1 x2716;
+  uint64_t x2717;
+  fiat_p1024_uint1 x2718;
+  uint64_t x2719;
+  fiat_p1024_uint1 x2720;
+  uint64_t x2721;
+  fiat_p1024_uint1 x2722;
+  uint64_t x2723;
+  fiat_p1024_uint1 x2724;
+  uint64_t x2725;
+  fiat_p1024_uint1 x2726;
+  uint64_t x2727;
+  fiat_p1024_uint1 x2728;
+  uint64_t x2729;
+  fiat_p1024_uint1 x2730;
+  uint64_t x2731;
+  fiat_p1024_uint1 x2732;
+  uint64_t x2733;
+  fiat_p1024_uint1 x2734;
+  uint64_t x2735;
+  fiat_p1024_uint1 x2736;
+  uint64_t x2737;
+  fiat_p1024_uint1 x2738;
+  uint64_t x2739;
+  uint64_t x2740;
+  uint64_t x2741;
+  uint64_t x2742;
+  uint64_t x2743;
+  uint64_t x2744;
+  uint64_t x2745;
+  uint64_t x2746;
+  uint64_t x2747;
+  uint64_t x2748;
+  uint64_t x2749;
+  uint64_t x2750;
+  uint64_t x2751;
+  uint64_t x2752;
+  uint64_t x2753;
+  uint64_t x2754;
+  uint64_t x2755;
+  uint64_t x2756;
+  uint64_t x2757;
+  uint64_t x2758;
+  uint64_t x2759;
+  uint64_t x2760;
+  uint64_t x2761;
+  uint64_t x2762;
+  uint64_t x2763;
+  uint64_t x2764;
+  uint64_t x2765;
+  uint64_t x2766;
+  uint64_t x2767;
+  uint64_t x2768;
+  uint64_t x2769;
+  uint64_t x2770;
+  uint64_t x2771;
+  uint64_t x2772;
+  fiat_p1024_uint1 x2773;
+  uint64_t x2774;
+  fiat_p1024_uint1 x2775;
+  uint64_t x2776;
+  fiat_p1024_uint1 x2777;
+  uint64_t x2778;
+  fiat_p1024_uint1 x2779;
+  uint64_t x2780;
+  fiat_p1024_uint1 x2781;
+  uint64_t x2782;
+  fiat_p1024_uint1 x2783;
+  uint64_t x2784;
+  fiat_p1024_uint1 x2785;
+  uint64_t x2786;
+  fiat_p1024_uint1 x2787;
+  uint64_t x2788;
+  fiat_p1024_uint1 x2789;
+  uint64_t x2790;
+  fiat_p1024_uint1 x2791;
+  uint64_t x2792;
+  fiat_p1024_uint1 x2793;
+  uint64_t x2794;
+  fiat_p1024_uint1 x2795;
+  uint64_t x2796;
+  fiat_p1024_uint1 x2797;
+  uint64_t x2798;
+  fiat_p1024_uint1 x2799;
+  uint64_t x2800;
+  fiat_p1024_uint1 x2801;
+  uint64_t x2802;
+  uint64_t x2803;
+  fiat_p1024_uint1 x2804;
+  uint64_t x2805;
+  fiat_p1024_uint1 x2806;
+  uint64_t x2807;
+  fiat_p1024_uint1 x2808;
+  uint64_t x2809;
+  fiat_p1024_uint1 x2810;
+  uint64_t x2811;
+  fiat_p1024_uint1 x2812;
+  uint64_t x2813;
+  fiat_p1024_uint1 x2814;
+  uint64_t x2815;
+  fiat_p1024_uint1 x2816;
+  uint64_t x2817;
+  fiat_p1024_uint1 x2818;
+  uint64_t x2819;
+  fiat_p1024_uint1 x2820;
+  uint64_t x2821;
+  fiat_p1024_uint1 x2822;
+  uint64_t x2823;
+  fiat_p1024_uint1 x2824;
+  uint64_t x2825;
+  fiat_p1024_uint1 x2826;
+  uint64_t x2827;
+  fiat_p1024_uint1 x2828;
+  uint64_t x2829;
+  fiat_p1024_uint1 x2830;
+  uint64_t x2831;
+  fiat_p1024_uint1 x2832;
+  uint64_t x2833;
+  fiat_p1024_uint1 x2834;
+  uint64_t x2835;
+  fiat_p1024_uint1 x2836;
+  uint64_t x2837;
+  uint64_t x2838;
+  uint64_t x2839;
+  uint64_t x2840;
+  uint64_t x2841;
+  uint64_t x2842;
+  uint64_t x2843;
+  uint64_t x2844;
+  uint64_t x2845;
+  uint64_t x2846;
+  uint64_t x2847;
+  uint64_t x2848;
+  uint64_t x2849;
+  uint64_t x2850;
+  uint64_t x2851;
+  uint64_t x2852;
+  uint64_t x2853;
+  uint64_t x2854;
+  uint64_t x2855;
+  uint64_t x2856;
+  uint64_t x2857;
+  uint64_t x2858;
+  uint64_t x2859;
+  uint64_t x2860;
+  uint64_t x2861;
+  uint64_t x2862;
+  uint64_t x2863;
+  uint64_t x2864;
+  uint64_t x2865;
+  uint64_t x2866;
+  uint64_t x2867;
+  uint64_t x2868;
+  uint64_t x2869;
+  uint64_t x2870;
+  uint64_t x2871;
+  fiat_p1024_uint1 x2872;
+  uint64_t x2873;
+  fiat_p1024_uint1 x2874;
+  uint64_t x2875;
+  fiat_p1024_uint1 x2876;
+  uint64_t x2877;
+  fiat_p1024_uint1 x2878;
+  uint64_t x2879;
+  fiat_p1024_uint1 x2880;
+  uint64_t x2881;
+  fiat_p1024_uint1 x2882;
+  uint64_t x2883;
+  fiat_p1024_uint1 x2884;
+  uint64_t x2885;
+  fiat_p1024_uint1 x2886;
+  uint64_t x2887;
+  fiat_p1024_uint1 x2888;
+  uint64_t x2889;
+  fiat_p1024_uint1 x2890;
+  uint64_t x2891;
+  fiat_p1024_uint1 x2892;
+  uint64_t x2893;
+  fiat_p1024_uint1 x2894;
+  uint64_t x2895;
+  fiat_p1024_uint1 x2896;
+  uint64_t x2897;
+  fiat_p1024_uint1 x2898;
+  uint64_t x2899;
+  fiat_p1024_uint1 x2900;
+  uint64_t x2901;
+  uint64_t x2902;
+  fiat_p1024_uint1 x2903;
+  uint64_t x2904;
+  fiat_p1024_uint1 x2905;
+  uint64_t x2906;
+  fiat_p1024_uint1 x2907;
+  uint64_t x2908;
+  fiat_p1024_uint1 x2909;
+  uint64_t x2910;
+  fiat_p1024_uint1 x2911;
+  uint64_t x2912;
+  fiat_p1024_uint1 x2913;
+  uint64_t x2914;
+  fiat_p1024_uint1 x2915;
+  uint64_t x2916;
+  fiat_p1024_uint1 x2917;
+  uint64_t x2918;
+  fiat_p1024_uint1 x2919;
+  uint64_t x2920;
+  fiat_p1024_uint1 x2921;
+  uint64_t x2922;
+  fiat_p1024_uint1 x2923;
+  uint64_t x2924;
+  fiat_p1024_uint1 x2925;
+  uint64_t x2926;
+  fiat_p1024_uint1 x2927;
+  uint64_t x2928;
+  fiat_p1024_uint1 x2929;
+  uint64_t x2930;
+  fiat_p1024_uint1 x2931;
+  uint64_t x2932;
+  fiat_p1024_uint1 x2933;
+  uint64_t x2934;
+  fiat_p1024_uint1 x2935;
+  uint64_t x2936;
+  uint64_t x2937;
+  uint64_t x2938;
+  uint64_t x2939;
+  uint64_t x2940;
+  uint64_t x2941;
+  uint64_t x2942;
+  uint64_t x2943;
+  uint64_t x2944;
+  uint64_t x2945;
+  uint64_t x2946;
+  uint64_t x2947;
+  uint64_t x2948;
+  uint64_t x2949;
+  uint64_t x2950;
+  uint64_t x2951;
+  uint64_t x2952;
+  uint64_t x2953;
+  uint64_t x2954;
+  uint64_t x2955;
+  uint64_t x2956;
+  uint64_t x2957;
+  uint64_t x2958;
+  uint64_t x2959;
+  uint64_t x2960;
+  uint64_t x2961;
+  uint64_t x2962;
+  uint64_t x2963;
+  uint64_t x2964;
+  uint64_t x2965;
+  uint64_t x2966;
+  uint64_t x2967;
+  uint64_t x2968;
+  uint64_t x2969;
+  fiat_p1024_uint1 x2970;
+  uint64_t x2971;
+  fiat_p1024_uint1 x2972;
+  uint64_t x2973;
+  fiat_p1024_uint1 x2974;
+  uint64_t x2975;
+  fiat_p1024_uint1 x2976;
+  uint64_t x2977;
+  fiat_p1024_uint1 x2978;
+  uint64_t x2979;
+  fiat_p1024_uint1 x2980;
+  uint64_t x2981;
+  fiat_p1024_uint1 x2982;
+  uint64_t x2983;
+  fiat_p1024_uint1 x2984;
+  uint64_t x2985;
+  fiat_p1024_uint1 x2986;
+  uint64_t x2987;
+  fiat_p1024_uint1 x2988;
+  uint64_t x2989;
+  fiat_p1024_uint1 x2990;
+  uint64_t x2991;
+  fiat_p1024_uint1 x2992;
+  uint64_t x2993;
+  fiat_p1024_uint1 x2994;
+  uint64_t x2995;
+  fiat_p1024_uint1 x2996;
+  uint64_t x2997;
+  fiat_p1024_uint1 x2998;
+  uint64_t x2999;
+  uint64_t x3000;
+  fiat_p1024_uint1 x3001;
+  uint64_t x3002;
+  fiat_p1024_uint1 x3003;
+  uint64_t x3004;
+  fiat_p1024_uint1 x3005;
+  uint64_t x3006;
+  fiat_p1024_uint1 x3007;
+  uint64_t x3008;
+  fiat_p1024_uint1 x3009;
+  uint64_t x3010;
+  fiat_p1024_uint1 x3011;
+  uint64_t x3012;
+  fiat_p1024_uint1 x3013;
+  uint64_t x3014;
+  fiat_p1024_uint1 x3015;
+  uint64_t x3016;
+  fiat_p1024_uint1 x3017;
+  uint64_t x3018;
+  fiat_p1024_uint1 x3019;
+  uint64_t x3020;
+  fiat_p1024_uint1 x3021;
+  uint64_t x3022;
+  fiat_p1024_uint1 x3023;
+  uint64_t x3024;
+  fiat_p1024_uint1 x3025;
+  uint64_t x3026;
+  fiat_p1024_uint1 x3027;
+  uint64_t x3028;
+  fiat_p1024_uint1 x3029;
+  uint64_t x3030;
+  fiat_p1024_uint1 x3031;
+  uint64_t x3032;
+  fiat_p1024_uint1 x3033;
+  uint64_t x3034;
+  uint64_t x3035;
+  uint64_t x3036;
+  uint64_t x3037;
+  uint64_t x3038;
+  uint64_t x3039;
+  uint64_t x3040;
+  uint64_t x3041;
+  uint64_t x3042;
+  uint64_t x3043;
+  uint64_t x3044;
+  uint64_t x3045;
+  uint64_t x3046;
+  uint64_t x3047;
+  uint64_t x3048;
+  uint64_t x3049;
+  uint64_t x3050;
+  uint64_t x3051;
+  uint64_t x3052;
+  uint64_t x3053;
+  uint64_t x3054;
+  uint64_t x3055;
+  uint64_t x3056;
+  uint64_t x3057;
+  uint64_t x3058;
+  uint64_t x3059;
+  uint64_t x3060;
+  uint64_t x3061;
+  uint64_t x3062;
+  uint64_t x3063;
+  uint64_t x3064;
+  uint64_t x3065;
+  uint64_t x3066;
+  uint64_t x3067;
+  uint64_t x3068;
+  fiat_p1024_uint1 x3069;
+  uint64_t x3070;
+  fiat_p1024_uint1 x3071;
+  uint64_t x3072;
+  fiat_p1024_uint1 x3073;
+  uint64_t x3074;
+  fiat_p1024_uint1 x3075;
+  uint64_t x3076;
+  fiat_p1024_uint1 x3077;
+  uint64_t x3078;
+  fiat_p1024_uint1 x3079;
+  uint64_t x3080;
+  fiat_p1024_uint1 x3081;
+  uint64_t x3082;
+  fiat_p1024_uint1 x3083;
+  uint64_t x3084;
+  fiat_p1024_uint1 x3085;
+  uint64_t x3086;
+  fiat_p1024_uint1 x3087;
+  uint64_t x3088;
+  fiat_p1024_uint1 x3089;
+  uint64_t x3090;
+  fiat_p1024_uint1 x3091;
+  uint64_t x3092;
+  fiat_p1024_uint1 x3093;
+  uint64_t x3094;
+  fiat_p1024_uint1 x3095;
+  uint64_t x3096;
+  fiat_p1024_uint1 x3097;
+  uint64_t x3098;
+  uint64_t x3099;
+  fiat_p1024_uint1 x3100;
+  uint64_t x3101;
+  fiat_p1024_uint1 x3102;
+  uint64_t x3103;
+  fiat_p1024_uint1 x3104;
+  uint64_t x3105;
+  fiat_p1024_uint1 x3106;
+  uint64_t x3107;
+  fiat_p1024_uint1 x3108;
+  uint64_t x3109;
+  fiat_p1024_uint1 x3110;
+  uint64_t x3111;
+  fiat_p1024_uint1 x3112;
+  uint64_t x3113;
+  fiat_p1024_uint1 x3114;
+  uint64_t x3115;
+  fiat_p1024_uint1 x3116;
+  uint64_t x3117;
+  fiat_p1024_uint1 x3118;
+  uint64_t x3119;
+  fiat_p1024_uint1 x3120;
+  uint64_t x3121;
+  fiat_p1024_uint1 x3122;
+  uint64_t x3123;
+  fiat_p1024_uint1 x3124;
+  uint64_t x3125;
+  fiat_p1024_uint1 x3126;
+  uint64_t x3127;
+  fiat_p1024_uint1 x3128;
+  uint64_t x3129;
+  fiat_p1024_uint1 x3130;
+  uint64_t x3131;
+  fiat_p1024_uint1 x3132;
+  uint64_t x3133;
+  uint64_t x3134;
+  fiat_p1024_uint1 x3135;
+  uint64_t x3136;
+  fiat_p1024_uint1 x3137;
+  uint64_t x3138;
+  fiat_p1024_uint1 x3139;
+  uint64_t x3140;
+  fiat_p1024_uint1 x3141;
+  uint64_t x3142;
+  fiat_p1024_uint1 x3143;
+  uint64_t x3144;
+  fiat_p1024_uint1 x3145;
+  uint64_t x3146;
+  fiat_p1024_uint1 x3147;
+  uint64_t x3148;
+  fiat_p1024_uint1 x3149;
+  uint64_t x3150;
+  fiat_p1024_uint1 x3151;
+  uint64_t x3152;
+  fiat_p1024_uint1 x3153;
+  uint64_t x3154;
+  fiat_p1024_uint1 x3155;
+  uint64_t x3156;
+  fiat_p1024_uint1 x3157;
+  uint64_t x3158;
+  fiat_p1024_uint1 x3159;
+  uint64_t x3160;
+  fiat_p1024_uint1 x3161;
+  uint64_t x3162;
+  fiat_p1024_uint1 x3163;
+  uint64_t x3164;
+  fiat_p1024_uint1 x3165;
+  uint64_t x3166;
+  fiat_p1024_uint1 x3167;
+  uint64_t x3168;
+  uint64_t x3169;
+  uint64_t x3170;
+  uint64_t x3171;
+  uint64_t x3172;
+  uint64_t x3173;
+  uint64_t x3174;
+  uint64_t x3175;
+  uint64_t x3176;
+  uint64_t x3177;
+  uint64_t x3178;
+  uint64_t x3179;
+  uint64_t x3180;
+  uint64_t x3181;
+  uint64_t x3182;
+  uint64_t x3183;
+  x1 = (arg1[1]);
+  x2 = (arg1[2]);
+  x3 = (arg1[3]);
+  x4 = (arg1[4]);
+  x5 = (arg1[5]);
+  x6 = (arg1[6]);
+  x7 = (arg1[7]);
+  x8 = (arg1[8]);
+  x9 = (arg1[9]);
+  x10 = (arg1[10]);
+  x11 = (arg1[11]);
+  x12 = (arg1[12]);
+  x13 = (arg1[13]);
+  x14 = (arg1[14]);
+  x15 = (arg1[15]);
+  x16 = (arg1[0]);
+  fiat_p1024_mulx_u64(&x17, &x18, x16, (arg2[15]));
+  fiat_p1024_mulx_u64(&x19, &x20, x16, (arg2[14]));
+  fiat_p1024_mulx_u64(&x21, &x22, x16, (arg2[13]));
+  fiat_p1024_mulx_u64(&x23, &x24, x16, (arg2[12]));
+  fiat_p1024_mulx_u64(&x25, &x26, x16, (arg2[11]));
+  fiat_p1024_mulx_u64(&x27, &x28, x16, (arg2[10]));
+  fiat_p1024_mulx_u64(&x29, &x30, x16, (arg2[9]));
+  fiat_p1024_mulx_u64(&x31, &x32, x16, (arg2[8]));
+  fiat_p1024_mulx_u64(&x33, &x34, x16, (arg2[7]));
+  fiat_p1024_mulx_u64(&x35, &x36, x16, (arg2[6]));
+  fiat_p1024_mulx_u64(&x37, &x38, x16, (arg2[5]));
+  fiat_p1024_mulx_u64(&x39, &x40, x16, (arg2[4]));
+  fiat_p1024_mulx_u64(&x41, &x42, x16, (arg2[3]));
+  fiat_p1024_mulx_u64(&x43, &x44, x16, (arg2[2]));
+  fiat_p1024_mulx_u64(&x45, &x46, x16, (arg2[1]));
+  fiat_p1024_mulx_u64(&x47, &x48, x16, (arg2[0]));
+  fiat_p1024_addcarryx_u64(&x49, &x50, 0x0, x48, x45);
+  fiat_p1024_addcarryx_u64(&x51, &x52, x50, x46, x43);
+  fiat_p1024_addcarryx_u64(&x53, &x54, x52, x44, x41);
+  fiat_p1024_addcarryx_u64(&x55, &x56, x54, x42, x39);
+  fiat_p1024_addcarryx_u64(&x57, &x58, x56, x40, x37);
+  fiat_p1024_addcarryx_u64(&x59, &x60, x58, x38, x35);
+  fiat_p1024_addcarryx_u64(&x61, &x62, x60, x36, x33);
+  fiat_p1024_addcarryx_u64(&x63, &x64, x62, x34, x31);
+  fiat_p1024_addcarryx_u64(&x65, &x66, x64, x32, x29);
+  fiat_p1024_addcarryx_u64(&x67, &x68, x66, x30, x27);
+  fiat_p1024_addcarryx_u64(&x69, &x70, x68, x28, x25);
+  fiat_p1024_addcarryx_u64(&x71, &x72, x70, x26, x23);
+  fiat_p1024_addcarryx_u64(&x73, &x74, x72, x24, x21);
+  fiat_p1024_addcarryx_u64(&x75, &x76, x74, x22, x19);
+  fiat_p1024_addcarryx_u64(&x77, &x78, x76, x20, x17);
+  x79 = (x78 + x18);
+  fiat_p1024_mulx_u64(&x80, &x81, x47, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x82, &x83, x80, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x84, &x85, x80, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x86, &x87, x80, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x88, &x89, x80, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x90, &x91, x80, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x92, &x93, x80, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x94, &x95, x80, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x96, &x97, x80, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x98, &x99, x80, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x100, &x101, x80, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x102, &x103, x80, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x104, &x105, x80, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x106, &x107, x80, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x108, &x109, x80, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x110, &x111, x80, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x112, &x113, x80, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x114, &x115, 0x0, x113, x110);
+  fiat_p1024_addcarryx_u64(&x116, &x117, x115, x111, x108);
+  fiat_p1024_addcarryx_u64(&x118, &x119, x117, x109, x106);
+  fiat_p1024_addcarryx_u64(&x120, &x121, x119, x107, x104);
+  fiat_p1024_addcarryx_u64(&x122, &x123, x121, x105, x102);
+  fiat_p1024_addcarryx_u64(&x124, &x125, x123, x103, x100);
+  fiat_p1024_addcarryx_u64(&x126, &x127, x125, x101, x98);
+  fiat_p1024_addcarryx_u64(&x128, &x129, x127, x99, x96);
+  fiat_p1024_addcarryx_u64(&x130, &x131, x129, x97, x94);
+  fiat_p1024_addcarryx_u64(&x132, &x133, x131, x95, x92);
+  fiat_p1024_addcarryx_u64(&x134, &x135, x133, x93, x90);
+  fiat_p1024_addcarryx_u64(&x136, &x137, x135, x91, x88);
+  fiat_p1024_addcarryx_u64(&x138, &x139, x137, x89, x86);
+  fiat_p1024_addcarryx_u64(&x140, &x141, x139, x87, x84);
+  fiat_p1024_addcarryx_u64(&x142, &x143, x141, x85, x82);
+  x144 = (x143 + x83);
+  fiat_p1024_addcarryx_u64(&x145, &x146, 0x0, x47, x112);
+  fiat_p1024_addcarryx_u64(&x147, &x148, x146, x49, x114);
+  fiat_p1024_addcarryx_u64(&x149, &x150, x148, x51, x116);
+  fiat_p1024_addcarryx_u64(&x151, &x152, x150, x53, x118);
+  fiat_p1024_addcarryx_u64(&x153, &x154, x152, x55, x120);
+  fiat_p1024_addcarryx_u64(&x155, &x156, x154, x57, x122);
+  fiat_p1024_addcarryx_u64(&x157, &x158, x156, x59, x124);
+  fiat_p1024_addcarryx_u64(&x159, &x160, x158, x61, x126);
+  fiat_p1024_addcarryx_u64(&x161, &x162, x160, x63, x128);
+  fiat_p1024_addcarryx_u64(&x163, &x164, x162, x65, x130);
+  fiat_p1024_addcarryx_u64(&x165, &x166, x164, x67, x132);
+  fiat_p1024_addcarryx_u64(&x167, &x168, x166, x69, x134);
+  fiat_p1024_addcarryx_u64(&x169, &x170, x168, x71, x136);
+  fiat_p1024_addcarryx_u64(&x171, &x172, x170, x73, x138);
+  fiat_p1024_addcarryx_u64(&x173, &x174, x172, x75, x140);
+  fiat_p1024_addcarryx_u64(&x175, &x176, x174, x77, x142);
+  fiat_p1024_addcarryx_u64(&x177, &x178, x176, x79, x144);
+  fiat_p1024_mulx_u64(&x179, &x180, x1, (arg2[15]));
+  fiat_p1024_mulx_u64(&x181, &x182, x1, (arg2[14]));
+  fiat_p1024_mulx_u64(&x183, &x184, x1, (arg2[13]));
+  fiat_p1024_mulx_u64(&x185, &x186, x1, (arg2[12]));
+  fiat_p1024_mulx_u64(&x187, &x188, x1, (arg2[11]));
+  fiat_p1024_mulx_u64(&x189, &x190, x1, (arg2[10]));
+  fiat_p1024_mulx_u64(&x191, &x192, x1, (arg2[9]));
+  fiat_p1024_mulx_u64(&x193, &x194, x1, (arg2[8]));
+  fiat_p1024_mulx_u64(&x195, &x196, x1, (arg2[7]));
+  fiat_p1024_mulx_u64(&x197, &x198, x1, (arg2[6]));
+  fiat_p1024_mulx_u64(&x199, &x200, x1, (arg2[5]));
+  fiat_p1024_mulx_u64(&x201, &x202, x1, (arg2[4]));
+  fiat_p1024_mulx_u64(&x203, &x204, x1, (arg2[3]));
+  fiat_p1024_mulx_u64(&x205, &x206, x1, (arg2[2]));
+  fiat_p1024_mulx_u64(&x207, &x208, x1, (arg2[1]));
+  fiat_p1024_mulx_u64(&x209, &x210, x1, (arg2[0]));
+  fiat_p1024_addcarryx_u64(&x211, &x212, 0x0, x210, x207);
+  fiat_p1024_addcarryx_u64(&x213, &x214, x212, x208, x205);
+  fiat_p1024_addcarryx_u64(&x215, &x216, x214, x206, x203);
+  fiat_p1024_addcarryx_u64(&x217, &x218, x216, x204, x201);
+  fiat_p1024_addcarryx_u64(&x219, &x220, x218, x202, x199);
+  fiat_p1024_addcarryx_u64(&x221, &x222, x220, x200, x197);
+  fiat_p1024_addcarryx_u64(&x223, &x224, x222, x198, x195);
+  fiat_p1024_addcarryx_u64(&x225, &x226, x224, x196, x193);
+  fiat_p1024_addcarryx_u64(&x227, &x228, x226, x194, x191);
+  fiat_p1024_addcarryx_u64(&x229, &x230, x228, x192, x189);
+  fiat_p1024_addcarryx_u64(&x231, &x232, x230, x190, x187);
+  fiat_p1024_addcarryx_u64(&x233, &x234, x232, x188, x185);
+  fiat_p1024_addcarryx_u64(&x235, &x236, x234, x186, x183);
+  fiat_p1024_addcarryx_u64(&x237, &x238, x236, x184, x181);
+  fiat_p1024_addcarryx_u64(&x239, &x240, x238, x182, x179);
+  x241 = (x240 + x180);
+  fiat_p1024_addcarryx_u64(&x242, &x243, 0x0, x147, x209);
+  fiat_p1024_addcarryx_u64(&x244, &x245, x243, x149, x211);
+  fiat_p1024_addcarryx_u64(&x246, &x247, x245, x151, x213);
+  fiat_p1024_addcarryx_u64(&x248, &x249, x247, x153, x215);
+  fiat_p1024_addcarryx_u64(&x250, &x251, x249, x155, x217);
+  fiat_p1024_addcarryx_u64(&x252, &x253, x251, x157, x219);
+  fiat_p1024_addcarryx_u64(&x254, &x255, x253, x159, x221);
+  fiat_p1024_addcarryx_u64(&x256, &x257, x255, x161, x223);
+  fiat_p1024_addcarryx_u64(&x258, &x259, x257, x163, x225);
+  fiat_p1024_addcarryx_u64(&x260, &x261, x259, x165, x227);
+  fiat_p1024_addcarryx_u64(&x262, &x263, x261, x167, x229);
+  fiat_p1024_addcarryx_u64(&x264, &x265, x263, x169, x231);
+  fiat_p1024_addcarryx_u64(&x266, &x267, x265, x171, x233);
+  fiat_p1024_addcarryx_u64(&x268, &x269, x267, x173, x235);
+  fiat_p1024_addcarryx_u64(&x270, &x271, x269, x175, x237);
+  fiat_p1024_addcarryx_u64(&x272, &x273, x271, x177, x239);
+  fiat_p1024_addcarryx_u64(&x274, &x275, x273, x178, x241);
+  fiat_p1024_mulx_u64(&x276, &x277, x242, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x278, &x279, x276, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x280, &x281, x276, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x282, &x283, x276, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x284, &x285, x276, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x286, &x287, x276, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x288, &x289, x276, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x290, &x291, x276, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x292, &x293, x276, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x294, &x295, x276, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x296, &x297, x276, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x298, &x299, x276, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x300, &x301, x276, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x302, &x303, x276, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x304, &x305, x276, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x306, &x307, x276, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x308, &x309, x276, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x310, &x311, 0x0, x309, x306);
+  fiat_p1024_addcarryx_u64(&x312, &x313, x311, x307, x304);
+  fiat_p1024_addcarryx_u64(&x314, &x315, x313, x305, x302);
+  fiat_p1024_addcarryx_u64(&x316, &x317, x315, x303, x300);
+  fiat_p1024_addcarryx_u64(&x318, &x319, x317, x301, x298);
+  fiat_p1024_addcarryx_u64(&x320, &x321, x319, x299, x296);
+  fiat_p1024_addcarryx_u64(&x322, &x323, x321, x297, x294);
+  fiat_p1024_addcarryx_u64(&x324, &x325, x323, x295, x292);
+  fiat_p1024_addcarryx_u64(&x326, &x327, x325, x293, x290);
+  fiat_p1024_addcarryx_u64(&x328, &x329, x327, x291, x288);
+  fiat_p1024_addcarryx_u64(&x330, &x331, x329, x289, x286);
+  fiat_p1024_addcarryx_u64(&x332, &x333, x331, x287, x284);
+  fiat_p1024_addcarryx_u64(&x334, &x335, x333, x285, x282);
+  fiat_p1024_addcarryx_u64(&x336, &x337, x335, x283, x280);
+  fiat_p1024_addcarryx_u64(&x338, &x339, x337, x281, x278);
+  x340 = (x339 + x279);
+  fiat_p1024_addcarryx_u64(&x341, &x342, 0x0, x242, x308);
+  fiat_p1024_addcarryx_u64(&x343, &x344, x342, x244, x310);
+  fiat_p1024_addcarryx_u64(&x345, &x346, x344, x246, x312);
+  fiat_p1024_addcarryx_u64(&x347, &x348, x346, x248, x314);
+  fiat_p1024_addcarryx_u64(&x349, &x350, x348, x250, x316);
+  fiat_p1024_addcarryx_u64(&x351, &x352, x350, x252, x318);
+  fiat_p1024_addcarryx_u64(&x353, &x354, x352, x254, x320);
+  fiat_p1024_addcarryx_u64(&x355, &x356, x354, x256, x322);
+  fiat_p1024_addcarryx_u64(&x357, &x358, x356, x258, x324);
+  fiat_p1024_addcarryx_u64(&x359, &x360, x358, x260, x326);
+  fiat_p1024_addcarryx_u64(&x361, &x362, x360, x262, x328);
+  fiat_p1024_addcarryx_u64(&x363, &x364, x362, x264, x330);
+  fiat_p1024_addcarryx_u64(&x365, &x366, x364, x266, x332);
+  fiat_p1024_addcarryx_u64(&x367, &x368, x366, x268, x334);
+  fiat_p1024_addcarryx_u64(&x369, &x370, x368, x270, x336);
+  fiat_p1024_addcarryx_u64(&x371, &x372, x370, x272, x338);
+  fiat_p1024_addcarryx_u64(&x373, &x374, x372, x274, x340);
+  x375 = ((uint64_t)x374 + x275);
+  fiat_p1024_mulx_u64(&x376, &x377, x2, (arg2[15]));
+  fiat_p1024_mulx_u64(&x378, &x379, x2, (arg2[14]));
+  fiat_p1024_mulx_u64(&x380, &x381, x2, (arg2[13]));
+  fiat_p1024_mulx_u64(&x382, &x383, x2, (arg2[12]));
+  fiat_p1024_mulx_u64(&x384, &x385, x2, (arg2[11]));
+  fiat_p1024_mulx_u64(&x386, &x387, x2, (arg2[10]));
+  fiat_p1024_mulx_u64(&x388, &x389, x2, (arg2[9]));
+  fiat_p1024_mulx_u64(&x390, &x391, x2, (arg2[8]));
+  fiat_p1024_mulx_u64(&x392, &x393, x2, (arg2[7]));
+  fiat_p1024_mulx_u64(&x394, &x395, x2, (arg2[6]));
+  fiat_p1024_mulx_u64(&x396, &x397, x2, (arg2[5]));
+  fiat_p1024_mulx_u64(&x398, &x399, x2, (arg2[4]));
+  fiat_p1024_mulx_u64(&x400, &x401, x2, (arg2[3]));
+  fiat_p1024_mulx_u64(&x402, &x403, x2, (arg2[2]));
+  fiat_p1024_mulx_u64(&x404, &x405, x2, (arg2[1]));
+  fiat_p1024_mulx_u64(&x406, &x407, x2, (arg2[0]));
+  fiat_p1024_addcarryx_u64(&x408, &x409, 0x0, x407, x404);
+  fiat_p1024_addcarryx_u64(&x410, &x411, x409, x405, x402);
+  fiat_p1024_addcarryx_u64(&x412, &x413, x411, x403, x400);
+  fiat_p1024_addcarryx_u64(&x414, &x415, x413, x401, x398);
+  fiat_p1024_addcarryx_u64(&x416, &x417, x415, x399, x396);
+  fiat_p1024_addcarryx_u64(&x418, &x419, x417, x397, x394);
+  fiat_p1024_addcarryx_u64(&x420, &x421, x419, x395, x392);
+  fiat_p1024_addcarryx_u64(&x422, &x423, x421, x393, x390);
+  fiat_p1024_addcarryx_u64(&x424, &x425, x423, x391, x388);
+  fiat_p1024_addcarryx_u64(&x426, &x427, x425, x389, x386);
+  fiat_p1024_addcarryx_u64(&x428, &x429, x427, x387, x384);
+  fiat_p1024_addcarryx_u64(&x430, &x431, x429, x385, x382);
+  fiat_p1024_addcarryx_u64(&x432, &x433, x431, x383, x380);
+  fiat_p1024_addcarryx_u64(&x434, &x435, x433, x381, x378);
+  fiat_p1024_addcarryx_u64(&x436, &x437, x435, x379, x376);
+  x438 = (x437 + x377);
+  fiat_p1024_addcarryx_u64(&x439, &x440, 0x0, x343, x406);
+  fiat_p1024_addcarryx_u64(&x441, &x442, x440, x345, x408);
+  fiat_p1024_addcarryx_u64(&x443, &x444, x442, x347, x410);
+  fiat_p1024_addcarryx_u64(&x445, &x446, x444, x349, x412);
+  fiat_p1024_addcarryx_u64(&x447, &x448, x446, x351, x414);
+  fiat_p1024_addcarryx_u64(&x449, &x450, x448, x353, x416);
+  fiat_p1024_addcarryx_u64(&x451, &x452, x450, x355, x418);
+  fiat_p1024_addcarryx_u64(&x453, &x454, x452, x357, x420);
+  fiat_p1024_addcarryx_u64(&x455, &x456, x454, x359, x422);
+  fiat_p1024_addcarryx_u64(&x457, &x458, x456, x361, x424);
+  fiat_p1024_addcarryx_u64(&x459, &x460, x458, x363, x426);
+  fiat_p1024_addcarryx_u64(&x461, &x462, x460, x365, x428);
+  fiat_p1024_addcarryx_u64(&x463, &x464, x462, x367, x430);
+  fiat_p1024_addcarryx_u64(&x465, &x466, x464, x369, x432);
+  fiat_p1024_addcarryx_u64(&x467, &x468, x466, x371, x434);
+  fiat_p1024_addcarryx_u64(&x469, &x470, x468, x373, x436);
+  fiat_p1024_addcarryx_u64(&x471, &x472, x470, x375, x438);
+  fiat_p1024_mulx_u64(&x473, &x474, x439, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x475, &x476, x473, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x477, &x478, x473, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x479, &x480, x473, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x481, &x482, x473, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x483, &x484, x473, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x485, &x486, x473, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x487, &x488, x473, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x489, &x490, x473, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x491, &x492, x473, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x493, &x494, x473, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x495, &x496, x473, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x497, &x498, x473, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x499, &x500, x473, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x501, &x502, x473, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x503, &x504, x473, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x505, &x506, x473, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x507, &x508, 0x0, x506, x503);
+  fiat_p1024_addcarryx_u64(&x509, &x510, x508, x504, x501);
+  fiat_p1024_addcarryx_u64(&x511, &x512, x510, x502, x499);
+  fiat_p1024_addcarryx_u64(&x513, &x514, x512, x500, x497);
+  fiat_p1024_addcarryx_u64(&x515, &x516, x514, x498, x495);
+  fiat_p1024_addcarryx_u64(&x517, &x518, x516, x496, x493);
+  fiat_p1024_addcarryx_u64(&x519, &x520, x518, x494, x491);
+  fiat_p1024_addcarryx_u64(&x521, &x522, x520, x492, x489);
+  fiat_p1024_addcarryx_u64(&x523, &x524, x522, x490, x487);
+  fiat_p1024_addcarryx_u64(&x525, &x526, x524, x488, x485);
+  fiat_p1024_addcarryx_u64(&x527, &x528, x526, x486, x483);
+  fiat_p1024_addcarryx_u64(&x529, &x530, x528, x484, x481);
+  fiat_p1024_addcarryx_u64(&x531, &x532, x530, x482, x479);
+  fiat_p1024_addcarryx_u64(&x533, &x534, x532, x480, x477);
+  fiat_p1024_addcarryx_u64(&x535, &x536, x534, x478, x475);
+  x537 = (x536 + x476);
+  fiat_p1024_addcarryx_u64(&x538, &x539, 0x0, x439, x505);
+  fiat_p1024_addcarryx_u64(&x540, &x541, x539, x441, x507);
+  fiat_p1024_addcarryx_u64(&x542, &x543, x541, x443, x509);
+  fiat_p1024_addcarryx_u64(&x544, &x545, x543, x445, x511);
+  fiat_p1024_addcarryx_u64(&x546, &x547, x545, x447, x513);
+  fiat_p1024_addcarryx_u64(&x548, &x549, x547, x449, x515);
+  fiat_p1024_addcarryx_u64(&x550, &x551, x549, x451, x517);
+  fiat_p1024_addcarryx_u64(&x552, &x553, x551, x453, x519);
+  fiat_p1024_addcarryx_u64(&x554, &x555, x553, x455, x521);
+  fiat_p1024_addcarryx_u64(&x556, &x557, x555, x457, x523);
+  fiat_p1024_addcarryx_u64(&x558, &x559, x557, x459, x525);
+  fiat_p1024_addcarryx_u64(&x560, &x561, x559, x461, x527);
+  fiat_p1024_addcarryx_u64(&x562, &x563, x561, x463, x529);
+  fiat_p1024_addcarryx_u64(&x564, &x565, x563, x465, x531);
+  fiat_p1024_addcarryx_u64(&x566, &x567, x565, x467, x533);
+  fiat_p1024_addcarryx_u64(&x568, &x569, x567, x469, x535);
+  fiat_p1024_addcarryx_u64(&x570, &x571, x569, x471, x537);
+  x572 = ((uint64_t)x571 + x472);
+  fiat_p1024_mulx_u64(&x573, &x574, x3, (arg2[15]));
+  fiat_p1024_mulx_u64(&x575, &x576, x3, (arg2[14]));
+  fiat_p1024_mulx_u64(&x577, &x578, x3, (arg2[13]));
+  fiat_p1024_mulx_u64(&x579, &x580, x3, (arg2[12]));
+  fiat_p1024_mulx_u64(&x581, &x582, x3, (arg2[11]));
+  fiat_p1024_mulx_u64(&x583, &x584, x3, (arg2[10]));
+  fiat_p1024_mulx_u64(&x585, &x586, x3, (arg2[9]));
+  fiat_p1024_mulx_u64(&x587, &x588, x3, (arg2[8]));
+  fiat_p1024_mulx_u64(&x589, &x590, x3, (arg2[7]));
+  fiat_p1024_mulx_u64(&x591, &x592, x3, (arg2[6]));
+  fiat_p1024_mulx_u64(&x593, &x594, x3, (arg2[5]));
+  fiat_p1024_mulx_u64(&x595, &x596, x3, (arg2[4]));
+  fiat_p1024_mulx_u64(&x597, &x598, x3, (arg2[3]));
+  fiat_p1024_mulx_u64(&x599, &x600, x3, (arg2[2]));
+  fiat_p1024_mulx_u64(&x601, &x602, x3, (arg2[1]));
+  fiat_p1024_mulx_u64(&x603, &x604, x3, (arg2[0]));
+  fiat_p1024_addcarryx_u64(&x605, &x606, 0x0, x604, x601);
+  fiat_p1024_addcarryx_u64(&x607, &x608, x606, x602, x599);
+  fiat_p1024_addcarryx_u64(&x609, &x610, x608, x600, x597);
+  fiat_p1024_addcarryx_u64(&x611, &x612, x610, x598, x595);
+  fiat_p1024_addcarryx_u64(&x613, &x614, x612, x596, x593);
+  fiat_p1024_addcarryx_u64(&x615, &x616, x614, x594, x591);
+  fiat_p1024_addcarryx_u64(&x617, &x618, x616, x592, x589);
+  fiat_p1024_addcarryx_u64(&x619, &x620, x618, x590, x587);
+  fiat_p1024_addcarryx_u64(&x621, &x622, x620, x588, x585);
+  fiat_p1024_addcarryx_u64(&x623, &x624, x622, x586, x583);
+  fiat_p1024_addcarryx_u64(&x625, &x626, x624, x584, x581);
+  fiat_p1024_addcarryx_u64(&x627, &x628, x626, x582, x579);
+  fiat_p1024_addcarryx_u64(&x629, &x630, x628, x580, x577);
+  fiat_p1024_addcarryx_u64(&x631, &x632, x630, x578, x575);
+  fiat_p1024_addcarryx_u64(&x633, &x634, x632, x576, x573);
+  x635 = (x634 + x574);
+  fiat_p1024_addcarryx_u64(&x636, &x637, 0x0, x540, x603);
+  fiat_p1024_addcarryx_u64(&x638, &x639, x637, x542, x605);
+  fiat_p1024_addcarryx_u64(&x640, &x641, x639, x544, x607);
+  fiat_p1024_addcarryx_u64(&x642, &x643, x641, x546, x609);
+  fiat_p1024_addcarryx_u64(&x644, &x645, x643, x548, x611);
+  fiat_p1024_addcarryx_u64(&x646, &x647, x645, x550, x613);
+  fiat_p1024_addcarryx_u64(&x648, &x649, x647, x552, x615);
+  fiat_p1024_addcarryx_u64(&x650, &x651, x649, x554, x617);
+  fiat_p1024_addcarryx_u64(&x652, &x653, x651, x556, x619);
+  fiat_p1024_addcarryx_u64(&x654, &x655, x653, x558, x621);
+  fiat_p1024_addcarryx_u64(&x656, &x657, x655, x560, x623);
+  fiat_p1024_addcarryx_u64(&x658, &x659, x657, x562, x625);
+  fiat_p1024_addcarryx_u64(&x660, &x661, x659, x564, x627);
+  fiat_p1024_addcarryx_u64(&x662, &x663, x661, x566, x629);
+  fiat_p1024_addcarryx_u64(&x664, &x665, x663, x568, x631);
+  fiat_p1024_addcarryx_u64(&x666, &x667, x665, x570, x633);
+  fiat_p1024_addcarryx_u64(&x668, &x669, x667, x572, x635);
+  fiat_p1024_mulx_u64(&x670, &x671, x636, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x672, &x673, x670, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x674, &x675, x670, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x676, &x677, x670, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x678, &x679, x670, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x680, &x681, x670, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x682, &x683, x670, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x684, &x685, x670, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x686, &x687, x670, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x688, &x689, x670, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x690, &x691, x670, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x692, &x693, x670, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x694, &x695, x670, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x696, &x697, x670, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x698, &x699, x670, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x700, &x701, x670, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x702, &x703, x670, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x704, &x705, 0x0, x703, x700);
+  fiat_p1024_addcarryx_u64(&x706, &x707, x705, x701, x698);
+  fiat_p1024_addcarryx_u64(&x708, &x709, x707, x699, x696);
+  fiat_p1024_addcarryx_u64(&x710, &x711, x709, x697, x694);
+  fiat_p1024_addcarryx_u64(&x712, &x713, x711, x695, x692);
+  fiat_p1024_addcarryx_u64(&x714, &x715, x713, x693, x690);
+  fiat_p1024_addcarryx_u64(&x716, &x717, x715, x691, x688);
+  fiat_p1024_addcarryx_u64(&x718, &x719, x717, x689, x686);
+  fiat_p1024_addcarryx_u64(&x720, &x721, x719, x687, x684);
+  fiat_p1024_addcarryx_u64(&x722, &x723, x721, x685, x682);
+  fiat_p1024_addcarryx_u64(&x724, &x725, x723, x683, x680);
+  fiat_p1024_addcarryx_u64(&x726, &x727, x725, x681, x678);
+  fiat_p1024_addcarryx_u64(&x728, &x729, x727, x679, x676);
+  fiat_p1024_addcarryx_u64(&x730, &x731, x729, x677, x674);
+  fiat_p1024_addcarryx_u64(&x732, &x733, x731, x675, x672);
+  x734 = (x733 + x673);
+  fiat_p1024_addcarryx_u64(&x735, &x736, 0x0, x636, x702);
+  fiat_p1024_addcarryx_u64(&x737, &x738, x736, x638, x704);
+  fiat_p1024_addcarryx_u64(&x739, &x740, x738, x640, x706);
+  fiat_p1024_addcarryx_u64(&x741, &x742, x740, x642, x708);
+  fiat_p1024_addcarryx_u64(&x743, &x744, x742, x644, x710);
+  fiat_p1024_addcarryx_u64(&x745, &x746, x744, x646, x712);
+  fiat_p1024_addcarryx_u64(&x747, &x748, x746, x648, x714);
+  fiat_p1024_addcarryx_u64(&x749, &x750, x748, x650, x716);
+  fiat_p1024_addcarryx_u64(&x751, &x752, x750, x652, x718);
+  fiat_p1024_addcarryx_u64(&x753, &x754, x752, x654, x720);
+  fiat_p1024_addcarryx_u64(&x755, &x756, x754, x656, x722);
+  fiat_p1024_addcarryx_u64(&x757, &x758, x756, x658, x724);
+  fiat_p1024_addcarryx_u64(&x759, &x760, x758, x660, x726);
+  fiat_p1024_addcarryx_u64(&x761, &x762, x760, x662, x728);
+  fiat_p1024_addcarryx_u64(&x763, &x764, x762, x664, x730);
+  fiat_p1024_addcarryx_u64(&x765, &x766, x764, x666, x732);
+  fiat_p1024_addcarryx_u64(&x767, &x768, x766, x668, x734);
+  x769 = ((uint64_t)x768 + x669);
+  fiat_p1024_mulx_u64(&x770, &x771, x4, (arg2[15]));
+  fiat_p1024_mulx_u64(&x772, &x773, x4, (arg2[14]));
+  fiat_p1024_mulx_u64(&x774, &x775, x4, (arg2[13]));
+  fiat_p1024_mulx_u64(&x776, &x777, x4, (arg2[12]));
+  fiat_p1024_mulx_u64(&x778, &x779, x4, (arg2[11]));
+  fiat_p1024_mulx_u64(&x780, &x781, x4, (arg2[10]));
+  fiat_p1024_mulx_u64(&x782, &x783, x4, (arg2[9]));
+  fiat_p1024_mulx_u64(&x784, &x785, x4, (arg2[8]));
+  fiat_p1024_mulx_u64(&x786, &x787, x4, (arg2[7]));
+  fiat_p1024_mulx_u64(&x788, &x789, x4, (arg2[6]));
+  fiat_p1024_mulx_u64(&x790, &x791, x4, (arg2[5]));
+  fiat_p1024_mulx_u64(&x792, &x793, x4, (arg2[4]));
+  fiat_p1024_mulx_u64(&x794, &x795, x4, (arg2[3]));
+  fiat_p1024_mulx_u64(&x796, &x797, x4, (arg2[2]));
+  fiat_p1024_mulx_u64(&x798, &x799, x4, (arg2[1]));
+  fiat_p1024_mulx_u64(&x800, &x801, x4, (arg2[0]));
+  fiat_p1024_addcarryx_u64(&x802, &x803, 0x0, x801, x798);
+  fiat_p1024_addcarryx_u64(&x804, &x805, x803, x799, x796);
+  fiat_p1024_addcarryx_u64(&x806, &x807, x805, x797, x794);
+  fiat_p1024_addcarryx_u64(&x808, &x809, x807, x795, x792);
+  fiat_p1024_addcarryx_u64(&x810, &x811, x809, x793, x790);
+  fiat_p1024_addcarryx_u64(&x812, &x813, x811, x791, x788);
+  fiat_p1024_addcarryx_u64(&x814, &x815, x813, x789, x786);
+  fiat_p1024_addcarryx_u64(&x816, &x817, x815, x787, x784);
+  fiat_p1024_addcarryx_u64(&x818, &x819, x817, x785, x782);
+  fiat_p1024_addcarryx_u64(&x820, &x821, x819, x783, x780);
+  fiat_p1024_addcarryx_u64(&x822, &x823, x821, x781, x778);
+  fiat_p1024_addcarryx_u64(&x824, &x825, x823, x779, x776);
+  fiat_p1024_addcarryx_u64(&x826, &x827, x825, x777, x774);
+  fiat_p1024_addcarryx_u64(&x828, &x829, x827, x775, x772);
+  fiat_p1024_addcarryx_u64(&x830, &x831, x829, x773, x770);
+  x832 = (x831 + x771);
+  fiat_p1024_addcarryx_u64(&x833, &x834, 0x0, x737, x800);
+  fiat_p1024_addcarryx_u64(&x835, &x836, x834, x739, x802);
+  fiat_p1024_addcarryx_u64(&x837, &x838, x836, x741, x804);
+  fiat_p1024_addcarryx_u64(&x839, &x840, x838, x743, x806);
+  fiat_p1024_addcarryx_u64(&x841, &x842, x840, x745, x808);
+  fiat_p1024_addcarryx_u64(&x843, &x844, x842, x747, x810);
+  fiat_p1024_addcarryx_u64(&x845, &x846, x844, x749, x812);
+  fiat_p1024_addcarryx_u64(&x847, &x848, x846, x751, x814);
+  fiat_p1024_addcarryx_u64(&x849, &x850, x848, x753, x816);
+  fiat_p1024_addcarryx_u64(&x851, &x852, x850, x755, x818);
+  fiat_p1024_addcarryx_u64(&x853, &x854, x852, x757, x820);
+  fiat_p1024_addcarryx_u64(&x855, &x856, x854, x759, x822);
+  fiat_p1024_addcarryx_u64(&x857, &x858, x856, x761, x824);
+  fiat_p1024_addcarryx_u64(&x859, &x860, x858, x763, x826);
+  fiat_p1024_addcarryx_u64(&x861, &x862, x860, x765, x828);
+  fiat_p1024_addcarryx_u64(&x863, &x864, x862, x767, x830);
+  fiat_p1024_addcarryx_u64(&x865, &x866, x864, x769, x832);
+  fiat_p1024_mulx_u64(&x867, &x868, x833, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x869, &x870, x867, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x871, &x872, x867, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x873, &x874, x867, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x875, &x876, x867, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x877, &x878, x867, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x879, &x880, x867, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x881, &x882, x867, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x883, &x884, x867, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x885, &x886, x867, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x887, &x888, x867, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x889, &x890, x867, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x891, &x892, x867, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x893, &x894, x867, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x895, &x896, x867, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x897, &x898, x867, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x899, &x900, x867, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x901, &x902, 0x0, x900, x897);
+  fiat_p1024_addcarryx_u64(&x903, &x904, x902, x898, x895);
+  fiat_p1024_addcarryx_u64(&x905, &x906, x904, x896, x893);
+  fiat_p1024_addcarryx_u64(&x907, &x908, x906, x894, x891);
+  fiat_p1024_addcarryx_u64(&x909, &x910, x908, x892, x889);
+  fiat_p1024_addcarryx_u64(&x911, &x912, x910, x890, x887);
+  fiat_p1024_addcarryx_u64(&x913, &x914, x912, x888, x885);
+  fiat_p1024_addcarryx_u64(&x915, &x916, x914, x886, x883);
+  fiat_p1024_addcarryx_u64(&x917, &x918, x916, x884, x881);
+  fiat_p1024_addcarryx_u64(&x919, &x920, x918, x882, x879);
+  fiat_p1024_addcarryx_u64(&x921, &x922, x920, x880, x877);
+  fiat_p1024_addcarryx_u64(&x923, &x924, x922, x878, x875);
+  fiat_p1024_addcarryx_u64(&x925, &x926, x924, x876, x873);
+  fiat_p1024_addcarryx_u64(&x927, &x928, x926, x874, x871);
+  fiat_p1024_addcarryx_u64(&x929, &x930, x928, x872, x869);
+  x931 = (x930 + x870);
+  fiat_p1024_addcarryx_u64(&x932, &x933, 0x0, x833, x899);
+  fiat_p1024_addcarryx_u64(&x934, &x935, x933, x835, x901);
+  fiat_p1024_addcarryx_u64(&x936, &x937, x935, x837, x903);
+  fiat_p1024_addcarryx_u64(&x938, &x939, x937, x839, x905);
+  fiat_p1024_addcarryx_u64(&x940, &x941, x939, x841, x907);
+  fiat_p1024_addcarryx_u64(&x942, &x943, x941, x843, x909);
+  fiat_p1024_addcarryx_u64(&x944, &x945, x943, x845, x911);
+  fiat_p1024_addcarryx_u64(&x946, &x947, x945, x847, x913);
+  fiat_p1024_addcarryx_u64(&x948, &x949, x947, x849, x915);
+  fiat_p1024_addcarryx_u64(&x950, &x951, x949, x851, x917);
+  fiat_p1024_addcarryx_u64(&x952, &x953, x951, x853, x919);
+  fiat_p1024_addcarryx_u64(&x954, &x955, x953, x855, x921);
+  fiat_p1024_addcarryx_u64(&x956, &x957, x955, x857, x923);
+  fiat_p1024_addcarryx_u64(&x958, &x959, x957, x859, x925);
+  fiat_p1024_addcarryx_u64(&x960, &x961, x959, x861, x927);
+  fiat_p1024_addcarryx_u64(&x962, &x963, x961, x863, x929);
+  fiat_p1024_addcarryx_u64(&x964, &x965, x963, x865, x931);
+  x966 = ((uint64_t)x965 + x866);
+  fiat_p1024_mulx_u64(&x967, &x968, x5, (arg2[15]));
+  fiat_p1024_mulx_u64(&x969, &x970, x5, (arg2[14]));
+  fiat_p1024_mulx_u64(&x971, &x972, x5, (arg2[13]));
+  fiat_p1024_mulx_u64(&x973, &x974, x5, (arg2[12]));
+  fiat_p1024_mulx_u64(&x975, &x976, x5, (arg2[11]));
+  fiat_p1024_mulx_u64(&x977, &x978, x5, (arg2[10]));
+  fiat_p1024_mulx_u64(&x979, &x980, x5, (arg2[9]));
+  fiat_p1024_mulx_u64(&x981, &x982, x5, (arg2[8]));
+  fiat_p1024_mulx_u64(&x983, &x984, x5, (arg2[7]));
+  fiat_p1024_mulx_u64(&x985, &x986, x5, (arg2[6]));
+  fiat_p1024_mulx_u64(&x987, &x988, x5, (arg2[5]));
+  fiat_p1024_mulx_u64(&x989, &x990, x5, (arg2[4]));
+  fiat_p1024_mulx_u64(&x991, &x992, x5, (arg2[3]));
+  fiat_p1024_mulx_u64(&x993, &x994, x5, (arg2[2]));
+  fiat_p1024_mulx_u64(&x995, &x996, x5, (arg2[1]));
+  fiat_p1024_mulx_u64(&x997, &x998, x5, (arg2[0]));
+  fiat_p1024_addcarryx_u64(&x999, &x1000, 0x0, x998, x995);
+  fiat_p1024_addcarryx_u64(&x1001, &x1002, x1000, x996, x993);
+  fiat_p1024_addcarryx_u64(&x1003, &x1004, x1002, x994, x991);
+  fiat_p1024_addcarryx_u64(&x1005, &x1006, x1004, x992, x989);
+  fiat_p1024_addcarryx_u64(&x1007, &x1008, x1006, x990, x987);
+  fiat_p1024_addcarryx_u64(&x1009, &x1010, x1008, x988, x985);
+  fiat_p1024_addcarryx_u64(&x1011, &x1012, x1010, x986, x983);
+  fiat_p1024_addcarryx_u64(&x1013, &x1014, x1012, x984, x981);
+  fiat_p1024_addcarryx_u64(&x1015, &x1016, x1014, x982, x979);
+  fiat_p1024_addcarryx_u64(&x1017, &x1018, x1016, x980, x977);
+  fiat_p1024_addcarryx_u64(&x1019, &x1020, x1018, x978, x975);
+  fiat_p1024_addcarryx_u64(&x1021, &x1022, x1020, x976, x973);
+  fiat_p1024_addcarryx_u64(&x1023, &x1024, x1022, x974, x971);
+  fiat_p1024_addcarryx_u64(&x1025, &x1026, x1024, x972, x969);
+  fiat_p1024_addcarryx_u64(&x1027, &x1028, x1026, x970, x967);
+  x1029 = (x1028 + x968);
+  fiat_p1024_addcarryx_u64(&x1030, &x1031, 0x0, x934, x997);
+  fiat_p1024_addcarryx_u64(&x1032, &x1033, x1031, x936, x999);
+  fiat_p1024_addcarryx_u64(&x1034, &x1035, x1033, x938, x1001);
+  fiat_p1024_addcarryx_u64(&x1036, &x1037, x1035, x940, x1003);
+  fiat_p1024_addcarryx_u64(&x1038, &x1039, x1037, x942, x1005);
+  fiat_p1024_addcarryx_u64(&x1040, &x1041, x1039, x944, x1007);
+  fiat_p1024_addcarryx_u64(&x1042, &x1043, x1041, x946, x1009);
+  fiat_p1024_addcarryx_u64(&x1044, &x1045, x1043, x948, x1011);
+  fiat_p1024_addcarryx_u64(&x1046, &x1047, x1045, x950, x1013);
+  fiat_p1024_addcarryx_u64(&x1048, &x1049, x1047, x952, x1015);
+  fiat_p1024_addcarryx_u64(&x1050, &x1051, x1049, x954, x1017);
+  fiat_p1024_addcarryx_u64(&x1052, &x1053, x1051, x956, x1019);
+  fiat_p1024_addcarryx_u64(&x1054, &x1055, x1053, x958, x1021);
+  fiat_p1024_addcarryx_u64(&x1056, &x1057, x1055, x960, x1023);
+  fiat_p1024_addcarryx_u64(&x1058, &x1059, x1057, x962, x1025);
+  fiat_p1024_addcarryx_u64(&x1060, &x1061, x1059, x964, x1027);
+  fiat_p1024_addcarryx_u64(&x1062, &x1063, x1061, x966, x1029);
+  fiat_p1024_mulx_u64(&x1064, &x1065, x1030, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x1066, &x1067, x1064, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x1068, &x1069, x1064, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x1070, &x1071, x1064, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x1072, &x1073, x1064, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x1074, &x1075, x1064, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x1076, &x1077, x1064, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x1078, &x1079, x1064, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x1080, &x1081, x1064, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x1082, &x1083, x1064, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x1084, &x1085, x1064, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x1086, &x1087, x1064, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x1088, &x1089, x1064, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x1090, &x1091, x1064, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x1092, &x1093, x1064, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x1094, &x1095, x1064, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x1096, &x1097, x1064, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x1098, &x1099, 0x0, x1097, x1094);
+  fiat_p1024_addcarryx_u64(&x1100, &x1101, x1099, x1095, x1092);
+  fiat_p1024_addcarryx_u64(&x1102, &x1103, x1101, x1093, x1090);
+  fiat_p1024_addcarryx_u64(&x1104, &x1105, x1103, x1091, x1088);
+  fiat_p1024_addcarryx_u64(&x1106, &x1107, x1105, x1089, x1086);
+  fiat_p1024_addcarryx_u64(&x1108, &x1109, x1107, x1087, x1084);
+  fiat_p1024_addcarryx_u64(&x1110, &x1111, x1109, x1085, x1082);
+  fiat_p1024_addcarryx_u64(&x1112, &x1113, x1111, x1083, x1080);
+  fiat_p1024_addcarryx_u64(&x1114, &x1115, x1113, x1081, x1078);
+  fiat_p1024_addcarryx_u64(&x1116, &x1117, x1115, x1079, x1076);
+  fiat_p1024_addcarryx_u64(&x1118, &x1119, x1117, x1077, x1074);
+  fiat_p1024_addcarryx_u64(&x1120, &x1121, x1119, x1075, x1072);
+  fiat_p1024_addcarryx_u64(&x1122, &x1123, x1121, x1073, x1070);
+  fiat_p1024_addcarryx_u64(&x1124, &x1125, x1123, x1071, x1068);
+  fiat_p1024_addcarryx_u64(&x1126, &x1127, x1125, x1069, x1066);
+  x1128 = (x1127 + x1067);
+  fiat_p1024_addcarryx_u64(&x1129, &x1130, 0x0, x1030, x1096);
+  fiat_p1024_addcarryx_u64(&x1131, &x1132, x1130, x1032, x1098);
+  fiat_p1024_addcarryx_u64(&x1133, &x1134, x1132, x1034, x1100);
+  fiat_p1024_addcarryx_u64(&x1135, &x1136, x1134, x1036, x1102);
+  fiat_p1024_addcarryx_u64(&x1137, &x1138, x1136, x1038, x1104);
+  fiat_p1024_addcarryx_u64(&x1139, &x1140, x1138, x1040, x1106);
+  fiat_p1024_addcarryx_u64(&x1141, &x1142, x1140, x1042, x1108);
+  fiat_p1024_addcarryx_u64(&x1143, &x1144, x1142, x1044, x1110);
+  fiat_p1024_addcarryx_u64(&x1145, &x1146, x1144, x1046, x1112);
+  fiat_p1024_addcarryx_u64(&x1147, &x1148, x1146, x1048, x1114);
+  fiat_p1024_addcarryx_u64(&x1149, &x1150, x1148, x1050, x1116);
+  fiat_p1024_addcarryx_u64(&x1151, &x1152, x1150, x1052, x1118);
+  fiat_p1024_addcarryx_u64(&x1153, &x1154, x1152, x1054, x1120);
+  fiat_p1024_addcarryx_u64(&x1155, &x1156, x1154, x1056, x1122);
+  fiat_p1024_addcarryx_u64(&x1157, &x1158, x1156, x1058, x1124);
+  fiat_p1024_addcarryx_u64(&x1159, &x1160, x1158, x1060, x1126);
+  fiat_p1024_addcarryx_u64(&x1161, &x1162, x1160, x1062, x1128);
+  x1163 = ((uint64_t)x1162 + x1063);
+  fiat_p1024_mulx_u64(&x1164, &x1165, x6, (arg2[15]));
+  fiat_p1024_mulx_u64(&x1166, &x1167, x6, (arg2[14]));
+  fiat_p1024_mulx_u64(&x1168, &x1169, x6, (arg2[13]));
+  fiat_p1024_mulx_u64(&x1170, &x1171, x6, (arg2[12]));
+  fiat_p1024_mulx_u64(&x1172, &x1173, x6, (arg2[11]));
+  fiat_p1024_mulx_u64(&x1174, &x1175, x6, (arg2[10]));
+  fiat_p1024_mulx_u64(&x1176, &x1177, x6, (arg2[9]));
+  fiat_p1024_mulx_u64(&x1178, &x1179, x6, (arg2[8]));
+  fiat_p1024_mulx_u64(&x1180, &x1181, x6, (arg2[7]));
+  fiat_p1024_mulx_u64(&x1182, &x1183, x6, (arg2[6]));
+  fiat_p1024_mulx_u64(&x1184, &x1185, x6, (arg2[5]));
+  fiat_p1024_mulx_u64(&x1186, &x1187, x6, (arg2[4]));
+  fiat_p1024_mulx_u64(&x1188, &x1189, x6, (arg2[3]));
+  fiat_p1024_mulx_u64(&x1190, &x1191, x6, (arg2[2]));
+  fiat_p1024_mulx_u64(&x1192, &x1193, x6, (arg2[1]));
+  fiat_p1024_mulx_u64(&x1194, &x1195, x6, (arg2[0]));
+  fiat_p1024_addcarryx_u64(&x1196, &x1197, 0x0, x1195, x1192);
+  fiat_p1024_addcarryx_u64(&x1198, &x1199, x1197, x1193, x1190);
+  fiat_p1024_addcarryx_u64(&x1200, &x1201, x1199, x1191, x1188);
+  fiat_p1024_addcarryx_u64(&x1202, &x1203, x1201, x1189, x1186);
+  fiat_p1024_addcarryx_u64(&x1204, &x1205, x1203, x1187, x1184);
+  fiat_p1024_addcarryx_u64(&x1206, &x1207, x1205, x1185, x1182);
+  fiat_p1024_addcarryx_u64(&x1208, &x1209, x1207, x1183, x1180);
+  fiat_p1024_addcarryx_u64(&x1210, &x1211, x1209, x1181, x1178);
+  fiat_p1024_addcarryx_u64(&x1212, &x1213, x1211, x1179, x1176);
+  fiat_p1024_addcarryx_u64(&x1214, &x1215, x1213, x1177, x1174);
+  fiat_p1024_addcarryx_u64(&x1216, &x1217, x1215, x1175, x1172);
+  fiat_p1024_addcarryx_u64(&x1218, &x1219, x1217, x1173, x1170);
+  fiat_p1024_addcarryx_u64(&x1220, &x1221, x1219, x1171, x1168);
+  fiat_p1024_addcarryx_u64(&x1222, &x1223, x1221, x1169, x1166);
+  fiat_p1024_addcarryx_u64(&x1224, &x1225, x1223, x1167, x1164);
+  x1226 = (x1225 + x1165);
+  fiat_p1024_addcarryx_u64(&x1227, &x1228, 0x0, x1131, x1194);
+  fiat_p1024_addcarryx_u64(&x1229, &x1230, x1228, x1133, x1196);
+  fiat_p1024_addcarryx_u64(&x1231, &x1232, x1230, x1135, x1198);
+  fiat_p1024_addcarryx_u64(&x1233, &x1234, x1232, x1137, x1200);
+  fiat_p1024_addcarryx_u64(&x1235, &x1236, x1234, x1139, x1202);
+  fiat_p1024_addcarryx_u64(&x1237, &x1238, x1236, x1141, x1204);
+  fiat_p1024_addcarryx_u64(&x1239, &x1240, x1238, x1143, x1206);
+  fiat_p1024_addcarryx_u64(&x1241, &x1242, x1240, x1145, x1208);
+  fiat_p1024_addcarryx_u64(&x1243, &x1244, x1242, x1147, x1210);
+  fiat_p1024_addcarryx_u64(&x1245, &x1246, x1244, x1149, x1212);
+  fiat_p1024_addcarryx_u64(&x1247, &x1248, x1246, x1151, x1214);
+  fiat_p1024_addcarryx_u64(&x1249, &x1250, x1248, x1153, x1216);
+  fiat_p1024_addcarryx_u64(&x1251, &x1252, x1250, x1155, x1218);
+  fiat_p1024_addcarryx_u64(&x1253, &x1254, x1252, x1157, x1220);
+  fiat_p1024_addcarryx_u64(&x1255, &x1256, x1254, x1159, x1222);
+  fiat_p1024_addcarryx_u64(&x1257, &x1258, x1256, x1161, x1224);
+  fiat_p1024_addcarryx_u64(&x1259, &x1260, x1258, x1163, x1226);
+  fiat_p1024_mulx_u64(&x1261, &x1262, x1227, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x1263, &x1264, x1261, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x1265, &x1266, x1261, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x1267, &x1268, x1261, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x1269, &x1270, x1261, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x1271, &x1272, x1261, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x1273, &x1274, x1261, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x1275, &x1276, x1261, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x1277, &x1278, x1261, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x1279, &x1280, x1261, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x1281, &x1282, x1261, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x1283, &x1284, x1261, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x1285, &x1286, x1261, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x1287, &x1288, x1261, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x1289, &x1290, x1261, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x1291, &x1292, x1261, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x1293, &x1294, x1261, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x1295, &x1296, 0x0, x1294, x1291);
+  fiat_p1024_addcarryx_u64(&x1297, &x1298, x1296, x1292, x1289);
+  fiat_p1024_addcarryx_u64(&x1299, &x1300, x1298, x1290, x1287);
+  fiat_p1024_addcarryx_u64(&x1301, &x1302, x1300, x1288, x1285);
+  fiat_p1024_addcarryx_u64(&x1303, &x1304, x1302, x1286, x1283);
+  fiat_p1024_addcarryx_u64(&x1305, &x1306, x1304, x1284, x1281);
+  fiat_p1024_addcarryx_u64(&x1307, &x1308, x1306, x1282, x1279);
+  fiat_p1024_addcarryx_u64(&x1309, &x1310, x1308, x1280, x1277);
+  fiat_p1024_addcarryx_u64(&x1311, &x1312, x1310, x1278, x1275);
+  fiat_p1024_addcarryx_u64(&x1313, &x1314, x1312, x1276, x1273);
+  fiat_p1024_addcarryx_u64(&x1315, &x1316, x1314, x1274, x1271);
+  fiat_p1024_addcarryx_u64(&x1317, &x1318, x1316, x1272, x1269);
+  fiat_p1024_addcarryx_u64(&x1319, &x1320, x1318, x1270, x1267);
+  fiat_p1024_addcarryx_u64(&x1321, &x1322, x1320, x1268, x1265);
+  fiat_p1024_addcarryx_u64(&x1323, &x1324, x1322, x1266, x1263);
+  x1325 = (x1324 + x1264);
+  fiat_p1024_addcarryx_u64(&x1326, &x1327, 0x0, x1227, x1293);
+  fiat_p1024_addcarryx_u64(&x1328, &x1329, x1327, x1229, x1295);
+  fiat_p1024_addcarryx_u64(&x1330, &x1331, x1329, x1231, x1297);
+  fiat_p1024_addcarryx_u64(&x1332, &x1333, x1331, x1233, x1299);
+  fiat_p1024_addcarryx_u64(&x1334, &x1335, x1333, x1235, x1301);
+  fiat_p1024_addcarryx_u64(&x1336, &x1337, x1335, x1237, x1303);
+  fiat_p1024_addcarryx_u64(&x1338, &x1339, x1337, x1239, x1305);
+  fiat_p1024_addcarryx_u64(&x1340, &x1341, x1339, x1241, x1307);
+  fiat_p1024_addcarryx_u64(&x1342, &x1343, x1341, x1243, x1309);
+  fiat_p1024_addcarryx_u64(&x1344, &x1345, x1343, x1245, x1311);
+  fiat_p1024_addcarryx_u64(&x1346, &x1347, x1345, x1247, x1313);
+  fiat_p1024_addcarryx_u64(&x1348, &x1349, x1347, x1249, x1315);
+  fiat_p1024_addcarryx_u64(&x1350, &x1351, x1349, x1251, x1317);
+  fiat_p1024_addcarryx_u64(&x1352, &x1353, x1351, x1253, x1319);
+  fiat_p1024_addcarryx_u64(&x1354, &x1355, x1353, x1255, x1321);
+  fiat_p1024_addcarryx_u64(&x1356, &x1357, x1355, x1257, x1323);
+  fiat_p1024_addcarryx_u64(&x1358, &x1359, x1357, x1259, x1325);
+  x1360 = ((uint64_t)x1359 + x1260);
+  fiat_p1024_mulx_u64(&x1361, &x1362, x7, (arg2[15]));
+  fiat_p1024_mulx_u64(&x1363, &x1364, x7, (arg2[14]));
+  fiat_p1024_mulx_u64(&x1365, &x1366, x7, (arg2[13]));
+  fiat_p1024_mulx_u64(&x1367, &x1368, x7, (arg2[12]));
+  fiat_p1024_mulx_u64(&x1369, &x1370, x7, (arg2[11]));
+  fiat_p1024_mulx_u64(&x1371, &x1372, x7, (arg2[10]));
+  fiat_p1024_mulx_u64(&x1373, &x1374, x7, (arg2[9]));
+  fiat_p1024_mulx_u64(&x1375, &x1376, x7, (arg2[8]));
+  fiat_p1024_mulx_u64(&x1377, &x1378, x7, (arg2[7]));
+  fiat_p1024_mulx_u64(&x1379, &x1380, x7, (arg2[6]));
+  fiat_p1024_mulx_u64(&x1381, &x1382, x7, (arg2[5]));
+  fiat_p1024_mulx_u64(&x1383, &x1384, x7, (arg2[4]));
+  fiat_p1024_mulx_u64(&x1385, &x1386, x7, (arg2[3]));
+  fiat_p1024_mulx_u64(&x1387, &x1388, x7, (arg2[2]));
+  fiat_p1024_mulx_u64(&x1389, &x1390, x7, (arg2[1]));
+  fiat_p1024_mulx_u64(&x1391, &x1392, x7, (arg2[0]));
+  fiat_p1024_addcarryx_u64(&x1393, &x1394, 0x0, x1392, x1389);
+  fiat_p1024_addcarryx_u64(&x1395, &x1396, x1394, x1390, x1387);
+  fiat_p1024_addcarryx_u64(&x1397, &x1398, x1396, x1388, x1385);
+  fiat_p1024_addcarryx_u64(&x1399, &x1400, x1398, x1386, x1383);
+  fiat_p1024_addcarryx_u64(&x1401, &x1402, x1400, x1384, x1381);
+  fiat_p1024_addcarryx_u64(&x1403, &x1404, x1402, x1382, x1379);
+  fiat_p1024_addcarryx_u64(&x1405, &x1406, x1404, x1380, x1377);
+  fiat_p1024_addcarryx_u64(&x1407, &x1408, x1406, x1378, x1375);
+  fiat_p1024_addcarryx_u64(&x1409, &x1410, x1408, x1376, x1373);
+  fiat_p1024_addcarryx_u64(&x1411, &x1412, x1410, x1374, x1371);
+  fiat_p1024_addcarryx_u64(&x1413, &x1414, x1412, x1372, x1369);
+  fiat_p1024_addcarryx_u64(&x1415, &x1416, x1414, x1370, x1367);
+  fiat_p1024_addcarryx_u64(&x1417, &x1418, x1416, x1368, x1365);
+  fiat_p1024_addcarryx_u64(&x1419, &x1420, x1418, x1366, x1363);
+  fiat_p1024_addcarryx_u64(&x1421, &x1422, x1420, x1364, x1361);
+  x1423 = (x1422 + x1362);
+  fiat_p1024_addcarryx_u64(&x1424, &x1425, 0x0, x1328, x1391);
+  fiat_p1024_addcarryx_u64(&x1426, &x1427, x1425, x1330, x1393);
+  fiat_p1024_addcarryx_u64(&x1428, &x1429, x1427, x1332, x1395);
+  fiat_p1024_addcarryx_u64(&x1430, &x1431, x1429, x1334, x1397);
+  fiat_p1024_addcarryx_u64(&x1432, &x1433, x1431, x1336, x1399);
+  fiat_p1024_addcarryx_u64(&x1434, &x1435, x1433, x1338, x1401);
+  fiat_p1024_addcarryx_u64(&x1436, &x1437, x1435, x1340, x1403);
+  fiat_p1024_addcarryx_u64(&x1438, &x1439, x1437, x1342, x1405);
+  fiat_p1024_addcarryx_u64(&x1440, &x1441, x1439, x1344, x1407);
+  fiat_p1024_addcarryx_u64(&x1442, &x1443, x1441, x1346, x1409);
+  fiat_p1024_addcarryx_u64(&x1444, &x1445, x1443, x1348, x1411);
+  fiat_p1024_addcarryx_u64(&x1446, &x1447, x1445, x1350, x1413);
+  fiat_p1024_addcarryx_u64(&x1448, &x1449, x1447, x1352, x1415);
+  fiat_p1024_addcarryx_u64(&x1450, &x1451, x1449, x1354, x1417);
+  fiat_p1024_addcarryx_u64(&x1452, &x1453, x1451, x1356, x1419);
+  fiat_p1024_addcarryx_u64(&x1454, &x1455, x1453, x1358, x1421);
+  fiat_p1024_addcarryx_u64(&x1456, &x1457, x1455, x1360, x1423);
+  fiat_p1024_mulx_u64(&x1458, &x1459, x1424, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x1460, &x1461, x1458, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x1462, &x1463, x1458, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x1464, &x1465, x1458, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x1466, &x1467, x1458, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x1468, &x1469, x1458, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x1470, &x1471, x1458, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x1472, &x1473, x1458, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x1474, &x1475, x1458, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x1476, &x1477, x1458, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x1478, &x1479, x1458, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x1480, &x1481, x1458, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x1482, &x1483, x1458, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x1484, &x1485, x1458, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x1486, &x1487, x1458, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x1488, &x1489, x1458, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x1490, &x1491, x1458, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x1492, &x1493, 0x0, x1491, x1488);
+  fiat_p1024_addcarryx_u64(&x1494, &x1495, x1493, x1489, x1486);
+  fiat_p1024_addcarryx_u64(&x1496, &x1497, x1495, x1487, x1484);
+  fiat_p1024_addcarryx_u64(&x1498, &x1499, x1497, x1485, x1482);
+  fiat_p1024_addcarryx_u64(&x1500, &x1501, x1499, x1483, x1480);
+  fiat_p1024_addcarryx_u64(&x1502, &x1503, x1501, x1481, x1478);
+  fiat_p1024_addcarryx_u64(&x1504, &x1505, x1503, x1479, x1476);
+  fiat_p1024_addcarryx_u64(&x1506, &x1507, x1505, x1477, x1474);
+  fiat_p1024_addcarryx_u64(&x1508, &x1509, x1507, x1475, x1472);
+  fiat_p1024_addcarryx_u64(&x1510, &x1511, x1509, x1473, x1470);
+  fiat_p1024_addcarryx_u64(&x1512, &x1513, x1511, x1471, x1468);
+  fiat_p1024_addcarryx_u64(&x1514, &x1515, x1513, x1469, x1466);
+  fiat_p1024_addcarryx_u64(&x1516, &x1517, x1515, x1467, x1464);
+  fiat_p1024_addcarryx_u64(&x1518, &x1519, x1517, x1465, x1462);
+  fiat_p1024_addcarryx_u64(&x1520, &x1521, x1519, x1463, x1460);
+  x1522 = (x1521 + x1461);
+  fiat_p1024_addcarryx_u64(&x1523, &x1524, 0x0, x1424, x1490);
+  fiat_p1024_addcarryx_u64(&x1525, &x1526, x1524, x1426, x1492);
+  fiat_p1024_addcarryx_u64(&x1527, &x1528, x1526, x1428, x1494);
+  fiat_p1024_addcarryx_u64(&x1529, &x1530, x1528, x1430, x1496);
+  fiat_p1024_addcarryx_u64(&x1531, &x1532, x1530, x1432, x1498);
+  fiat_p1024_addcarryx_u64(&x1533, &x1534, x1532, x1434, x1500);
+  fiat_p1024_addcarryx_u64(&x1535, &x1536, x1534, x1436, x1502);
+  fiat_p1024_addcarryx_u64(&x1537, &x1538, x1536, x1438, x1504);
+  fiat_p1024_addcarryx_u64(&x1539, &x1540, x1538, x1440, x1506);
+  fiat_p1024_addcarryx_u64(&x1541, &x1542, x1540, x1442, x1508);
+  fiat_p1024_addcarryx_u64(&x1543, &x1544, x1542, x1444, x1510);
+  fiat_p1024_addcarryx_u64(&x1545, &x1546, x1544, x1446, x1512);
+  fiat_p1024_addcarryx_u64(&x1547, &x1548, x1546, x1448, x1514);
+  fiat_p1024_addcarryx_u64(&x1549, &x1550, x1548, x1450, x1516);
+  fiat_p1024_addcarryx_u64(&x1551, &x1552, x1550, x1452, x1518);
+  fiat_p1024_addcarryx_u64(&x1553, &x1554, x1552, x1454, x1520);
+  fiat_p1024_addcarryx_u64(&x1555, &x1556, x1554, x1456, x1522);
+  x1557 = ((uint64_t)x1556 + x1457);
+  fiat_p1024_mulx_u64(&x1558, &x1559, x8, (arg2[15]));
+  fiat_p1024_mulx_u64(&x1560, &x1561, x8, (arg2[14]));
+  fiat_p1024_mulx_u64(&x1562, &x1563, x8, (arg2[13]));
+  fiat_p1024_mulx_u64(&x1564, &x1565, x8, (arg2[12]));
+  fiat_p1024_mulx_u64(&x1566, &x1567, x8, (arg2[11]));
+  fiat_p1024_mulx_u64(&x1568, &x1569, x8, (arg2[10]));
+  fiat_p1024_mulx_u64(&x1570, &x1571, x8, (arg2[9]));
+  fiat_p1024_mulx_u64(&x1572, &x1573, x8, (arg2[8]));
+  fiat_p1024_mulx_u64(&x1574, &x1575, x8, (arg2[7]));
+  fiat_p1024_mulx_u64(&x1576, &x1577, x8, (arg2[6]));
+  fiat_p1024_mulx_u64(&x1578, &x1579, x8, (arg2[5]));
+  fiat_p1024_mulx_u64(&x1580, &x1581, x8, (arg2[4]));
+  fiat_p1024_mulx_u64(&x1582, &x1583, x8, (arg2[3]));
+  fiat_p1024_mulx_u64(&x1584, &x1585, x8, (arg2[2]));
+  fiat_p1024_mulx_u64(&x1586, &x1587, x8, (arg2[1]));
+  fiat_p1024_mulx_u64(&x1588, &x1589, x8, (arg2[0]));
+  fiat_p1024_addcarryx_u64(&x1590, &x1591, 0x0, x1589, x1586);
+  fiat_p1024_addcarryx_u64(&x1592, &x1593, x1591, x1587, x1584);
+  fiat_p1024_addcarryx_u64(&x1594, &x1595, x1593, x1585, x1582);
+  fiat_p1024_addcarryx_u64(&x1596, &x1597, x1595, x1583, x1580);
+  fiat_p1024_addcarryx_u64(&x1598, &x1599, x1597, x1581, x1578);
+  fiat_p1024_addcarryx_u64(&x1600, &x1601, x1599, x1579, x1576);
+  fiat_p1024_addcarryx_u64(&x1602, &x1603, x1601, x1577, x1574);
+  fiat_p1024_addcarryx_u64(&x1604, &x1605, x1603, x1575, x1572);
+  fiat_p1024_addcarryx_u64(&x1606, &x1607, x1605, x1573, x1570);
+  fiat_p1024_addcarryx_u64(&x1608, &x1609, x1607, x1571, x1568);
+  fiat_p1024_addcarryx_u64(&x1610, &x1611, x1609, x1569, x1566);
+  fiat_p1024_addcarryx_u64(&x1612, &x1613, x1611, x1567, x1564);
+  fiat_p1024_addcarryx_u64(&x1614, &x1615, x1613, x1565, x1562);
+  fiat_p1024_addcarryx_u64(&x1616, &x1617, x1615, x1563, x1560);
+  fiat_p1024_addcarryx_u64(&x1618, &x1619, x1617, x1561, x1558);
+  x1620 = (x1619 + x1559);
+  fiat_p1024_addcarryx_u64(&x1621, &x1622, 0x0, x1525, x1588);
+  fiat_p1024_addcarryx_u64(&x1623, &x1624, x1622, x1527, x1590);
+  fiat_p1024_addcarryx_u64(&x1625, &x1626, x1624, x1529, x1592);
+  fiat_p1024_addcarryx_u64(&x1627, &x1628, x1626, x1531, x1594);
+  fiat_p1024_addcarryx_u64(&x1629, &x1630, x1628, x1533, x1596);
+  fiat_p1024_addcarryx_u64(&x1631, &x1632, x1630, x1535, x1598);
+  fiat_p1024_addcarryx_u64(&x1633, &x1634, x1632, x1537, x1600);
+  fiat_p1024_addcarryx_u64(&x1635, &x1636, x1634, x1539, x1602);
+  fiat_p1024_addcarryx_u64(&x1637, &x1638, x1636, x1541, x1604);
+  fiat_p1024_addcarryx_u64(&x1639, &x1640, x1638, x1543, x1606);
+  fiat_p1024_addcarryx_u64(&x1641, &x1642, x1640, x1545, x1608);
+  fiat_p1024_addcarryx_u64(&x1643, &x1644, x1642, x1547, x1610);
+  fiat_p1024_addcarryx_u64(&x1645, &x1646, x1644, x1549, x1612);
+  fiat_p1024_addcarryx_u64(&x1647, &x1648, x1646, x1551, x1614);
+  fiat_p1024_addcarryx_u64(&x1649, &x1650, x1648, x1553, x1616);
+  fiat_p1024_addcarryx_u64(&x1651, &x1652, x1650, x1555, x1618);
+  fiat_p1024_addcarryx_u64(&x1653, &x1654, x1652, x1557, x1620);
+  fiat_p1024_mulx_u64(&x1655, &x1656, x1621, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x1657, &x1658, x1655, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x1659, &x1660, x1655, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x1661, &x1662, x1655, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x1663, &x1664, x1655, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x1665, &x1666, x1655, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x1667, &x1668, x1655, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x1669, &x1670, x1655, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x1671, &x1672, x1655, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x1673, &x1674, x1655, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x1675, &x1676, x1655, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x1677, &x1678, x1655, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x1679, &x1680, x1655, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x1681, &x1682, x1655, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x1683, &x1684, x1655, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x1685, &x1686, x1655, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x1687, &x1688, x1655, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x1689, &x1690, 0x0, x1688, x1685);
+  fiat_p1024_addcarryx_u64(&x1691, &x1692, x1690, x1686, x1683);
+  fiat_p1024_addcarryx_u64(&x1693, &x1694, x1692, x1684, x1681);
+  fiat_p1024_addcarryx_u64(&x1695, &x1696, x1694, x1682, x1679);
+  fiat_p1024_addcarryx_u64(&x1697, &x1698, x1696, x1680, x1677);
+  fiat_p1024_addcarryx_u64(&x1699, &x1700, x1698, x1678, x1675);
+  fiat_p1024_addcarryx_u64(&x1701, &x1702, x1700, x1676, x1673);
+  fiat_p1024_addcarryx_u64(&x1703, &x1704, x1702, x1674, x1671);
+  fiat_p1024_addcarryx_u64(&x1705, &x1706, x1704, x1672, x1669);
+  fiat_p1024_addcarryx_u64(&x1707, &x1708, x1706, x1670, x1667);
+  fiat_p1024_addcarryx_u64(&x1709, &x1710, x1708, x1668, x1665);
+  fiat_p1024_addcarryx_u64(&x1711, &x1712, x1710, x1666, x1663);
+  fiat_p1024_addcarryx_u64(&x1713, &x1714, x1712, x1664, x1661);
+  fiat_p1024_addcarryx_u64(&x1715, &x1716, x1714, x1662, x1659);
+  fiat_p1024_addcarryx_u64(&x1717, &x1718, x1716, x1660, x1657);
+  x1719 = (x1718 + x1658);
+  fiat_p1024_addcarryx_u64(&x1720, &x1721, 0x0, x1621, x1687);
+  fiat_p1024_addcarryx_u64(&x1722, &x1723, x1721, x1623, x1689);
+  fiat_p1024_addcarryx_u64(&x1724, &x1725, x1723, x1625, x1691);
+  fiat_p1024_addcarryx_u64(&x1726, &x1727, x1725, x1627, x1693);
+  fiat_p1024_addcarryx_u64(&x1728, &x1729, x1727, x1629, x1695);
+  fiat_p1024_addcarryx_u64(&x1730, &x1731, x1729, x1631, x1697);
+  fiat_p1024_addcarryx_u64(&x1732, &x1733, x1731, x1633, x1699);
+  fiat_p1024_addcarryx_u64(&x1734, &x1735, x1733, x1635, x1701);
+  fiat_p1024_addcarryx_u64(&x1736, &x1737, x1735, x1637, x1703);
+  fiat_p1024_addcarryx_u64(&x1738, &x1739, x1737, x1639, x1705);
+  fiat_p1024_addcarryx_u64(&x1740, &x1741, x1739, x1641, x1707);
+  fiat_p1024_addcarryx_u64(&x1742, &x1743, x1741, x1643, x1709);
+  fiat_p1024_addcarryx_u64(&x1744, &x1745, x1743, x1645, x1711);
+  fiat_p1024_addcarryx_u64(&x1746, &x1747, x1745, x1647, x1713);
+  fiat_p1024_addcarryx_u64(&x1748, &x1749, x1747, x1649, x1715);
+  fiat_p1024_addcarryx_u64(&x1750, &x1751, x1749, x1651, x1717);
+  fiat_p1024_addcarryx_u64(&x1752, &x1753, x1751, x1653, x1719);
+  x1754 = ((uint64_t)x1753 + x1654);
+  fiat_p1024_mulx_u64(&x1755, &x1756, x9, (arg2[15]));
+  fiat_p1024_mulx_u64(&x1757, &x1758, x9, (arg2[14]));
+  fiat_p1024_mulx_u64(&x1759, &x1760, x9, (arg2[13]));
+  fiat_p1024_mulx_u64(&x1761, &x1762, x9, (arg2[12]));
+  fiat_p1024_mulx_u64(&x1763, &x1764, x9, (arg2[11]));
+  fiat_p1024_mulx_u64(&x1765, &x1766, x9, (arg2[10]));
+  fiat_p1024_mulx_u64(&x1767, &x1768, x9, (arg2[9]));
+  fiat_p1024_mulx_u64(&x1769, &x1770, x9, (arg2[8]));
+  fiat_p1024_mulx_u64(&x1771, &x1772, x9, (arg2[7]));
+  fiat_p1024_mulx_u64(&x1773, &x1774, x9, (arg2[6]));
+  fiat_p1024_mulx_u64(&x1775, &x1776, x9, (arg2[5]));
+  fiat_p1024_mulx_u64(&x1777, &x1778, x9, (arg2[4]));
+  fiat_p1024_mulx_u64(&x1779, &x1780, x9, (arg2[3]));
+  fiat_p1024_mulx_u64(&x1781, &x1782, x9, (arg2[2]));
+  fiat_p1024_mulx_u64(&x1783, &x1784, x9, (arg2[1]));
+  fiat_p1024_mulx_u64(&x1785, &x1786, x9, (arg2[0]));
+  fiat_p1024_addcarryx_u64(&x1787, &x1788, 0x0, x1786, x1783);
+  fiat_p1024_addcarryx_u64(&x1789, &x1790, x1788, x1784, x1781);
+  fiat_p1024_addcarryx_u64(&x1791, &x1792, x1790, x1782, x1779);
+  fiat_p1024_addcarryx_u64(&x1793, &x1794, x1792, x1780, x1777);
+  fiat_p1024_addcarryx_u64(&x1795, &x1796, x1794, x1778, x1775);
+  fiat_p1024_addcarryx_u64(&x1797, &x1798, x1796, x1776, x1773);
+  fiat_p1024_addcarryx_u64(&x1799, &x1800, x1798, x1774, x1771);
+  fiat_p1024_addcarryx_u64(&x1801, &x1802, x1800, x1772, x1769);
+  fiat_p1024_addcarryx_u64(&x1803, &x1804, x1802, x1770, x1767);
+  fiat_p1024_addcarryx_u64(&x1805, &x1806, x1804, x1768, x1765);
+  fiat_p1024_addcarryx_u64(&x1807, &x1808, x1806, x1766, x1763);
+  fiat_p1024_addcarryx_u64(&x1809, &x1810, x1808, x1764, x1761);
+  fiat_p1024_addcarryx_u64(&x1811, &x1812, x1810, x1762, x1759);
+  fiat_p1024_addcarryx_u64(&x1813, &x1814, x1812, x1760, x1757);
+  fiat_p1024_addcarryx_u64(&x1815, &x1816, x1814, x1758, x1755);
+  x1817 = (x1816 + x1756);
+  fiat_p1024_addcarryx_u64(&x1818, &x1819, 0x0, x1722, x1785);
+  fiat_p1024_addcarryx_u64(&x1820, &x1821, x1819, x1724, x1787);
+  fiat_p1024_addcarryx_u64(&x1822, &x1823, x1821, x1726, x1789);
+  fiat_p1024_addcarryx_u64(&x1824, &x1825, x1823, x1728, x1791);
+  fiat_p1024_addcarryx_u64(&x1826, &x1827, x1825, x1730, x1793);
+  fiat_p1024_addcarryx_u64(&x1828, &x1829, x1827, x1732, x1795);
+  fiat_p1024_addcarryx_u64(&x1830, &x1831, x1829, x1734, x1797);
+  fiat_p1024_addcarryx_u64(&x1832, &x1833, x1831, x1736, x1799);
+  fiat_p1024_addcarryx_u64(&x1834, &x1835, x1833, x1738, x1801);
+  fiat_p1024_addcarryx_u64(&x1836, &x1837, x1835, x1740, x1803);
+  fiat_p1024_addcarryx_u64(&x1838, &x1839, x1837, x1742, x1805);
+  fiat_p1024_addcarryx_u64(&x1840, &x1841, x1839, x1744, x1807);
+  fiat_p1024_addcarryx_u64(&x1842, &x1843, x1841, x1746, x1809);
+  fiat_p1024_addcarryx_u64(&x1844, &x1845, x1843, x1748, x1811);
+  fiat_p1024_addcarryx_u64(&x1846, &x1847, x1845, x1750, x1813);
+  fiat_p1024_addcarryx_u64(&x1848, &x1849, x1847, x1752, x1815);
+  fiat_p1024_addcarryx_u64(&x1850, &x1851, x1849, x1754, x1817);
+  fiat_p1024_mulx_u64(&x1852, &x1853, x1818, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x1854, &x1855, x1852, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x1856, &x1857, x1852, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x1858, &x1859, x1852, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x1860, &x1861, x1852, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x1862, &x1863, x1852, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x1864, &x1865, x1852, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x1866, &x1867, x1852, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x1868, &x1869, x1852, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x1870, &x1871, x1852, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x1872, &x1873, x1852, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x1874, &x1875, x1852, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x1876, &x1877, x1852, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x1878, &x1879, x1852, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x1880, &x1881, x1852, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x1882, &x1883, x1852, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x1884, &x1885, x1852, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x1886, &x1887, 0x0, x1885, x1882);
+  fiat_p1024_addcarryx_u64(&x1888, &x1889, x1887, x1883, x1880);
+  fiat_p1024_addcarryx_u64(&x1890, &x1891, x1889, x1881, x1878);
+  fiat_p1024_addcarryx_u64(&x1892, &x1893, x1891, x1879, x1876);
+  fiat_p1024_addcarryx_u64(&x1894, &x1895, x1893, x1877, x1874);
+  fiat_p1024_addcarryx_u64(&x1896, &x1897, x1895, x1875, x1872);
+  fiat_p1024_addcarryx_u64(&x1898, &x1899, x1897, x1873, x1870);
+  fiat_p1024_addcarryx_u64(&x1900, &x1901, x1899, x1871, x1868);
+  fiat_p1024_addcarryx_u64(&x1902, &x1903, x1901, x1869, x1866);
+  fiat_p1024_addcarryx_u64(&x1904, &x1905, x1903, x1867, x1864);
+  fiat_p1024_addcarryx_u64(&x1906, &x1907, x1905, x1865, x1862);
+  fiat_p1024_addcarryx_u64(&x1908, &x1909, x1907, x1863, x1860);
+  fiat_p1024_addcarryx_u64(&x1910, &x1911, x1909, x1861, x1858);
+  fiat_p1024_addcarryx_u64(&x1912, &x1913, x1911, x1859, x1856);
+  fiat_p1024_addcarryx_u64(&x1914, &x1915, x1913, x1857, x1854);
+  x1916 = (x1915 + x1855);
+  fiat_p1024_addcarryx_u64(&x1917, &x1918, 0x0, x1818, x1884);
+  fiat_p1024_addcarryx_u64(&x1919, &x1920, x1918, x1820, x1886);
+  fiat_p1024_addcarryx_u64(&x1921, &x1922, x1920, x1822, x1888);
+  fiat_p1024_addcarryx_u64(&x1923, &x1924, x1922, x1824, x1890);
+  fiat_p1024_addcarryx_u64(&x1925, &x1926, x1924, x1826, x1892);
+  fiat_p1024_addcarryx_u64(&x1927, &x1928, x1926, x1828, x1894);
+  fiat_p1024_addcarryx_u64(&x1929, &x1930, x1928, x1830, x1896);
+  fiat_p1024_addcarryx_u64(&x1931, &x1932, x1930, x1832, x1898);
+  fiat_p1024_addcarryx_u64(&x1933, &x1934, x1932, x1834, x1900);
+  fiat_p1024_addcarryx_u64(&x1935, &x1936, x1934, x1836, x1902);
+  fiat_p1024_addcarryx_u64(&x1937, &x1938, x1936, x1838, x1904);
+  fiat_p1024_addcarryx_u64(&x1939, &x1940, x1938, x1840, x1906);
+  fiat_p1024_addcarryx_u64(&x1941, &x1942, x1940, x1842, x1908);
+  fiat_p1024_addcarryx_u64(&x1943, &x1944, x1942, x1844, x1910);
+  fiat_p1024_addcarryx_u64(&x1945, &x1946, x1944, x1846, x1912);
+  fiat_p1024_addcarryx_u64(&x1947, &x1948, x1946, x1848, x1914);
+  fiat_p1024_addcarryx_u64(&x1949, &x1950, x1948, x1850, x1916);
+  x1951 = ((uint64_t)x1950 + x1851);
+  fiat_p1024_mulx_u64(&x1952, &x1953, x10, (arg2[15]));
+  fiat_p1024_mulx_u64(&x1954, &x1955, x10, (arg2[14]));
+  fiat_p1024_mulx_u64(&x1956, &x1957, x10, (arg2[13]));
+  fiat_p1024_mulx_u64(&x1958, &x1959, x10, (arg2[12]));
+  fiat_p1024_mulx_u64(&x1960, &x1961, x10, (arg2[11]));
+  fiat_p1024_mulx_u64(&x1962, &x1963, x10, (arg2[10]));
+  fiat_p1024_mulx_u64(&x1964, &x1965, x10, (arg2[9]));
+  fiat_p1024_mulx_u64(&x1966, &x1967, x10, (arg2[8]));
+  fiat_p1024_mulx_u64(&x1968, &x1969, x10, (arg2[7]));
+  fiat_p1024_mulx_u64(&x1970, &x1971, x10, (arg2[6]));
+  fiat_p1024_mulx_u64(&x1972, &x1973, x10, (arg2[5]));
+  fiat_p1024_mulx_u64(&x1974, &x1975, x10, (arg2[4]));
+  fiat_p1024_mulx_u64(&x1976, &x1977, x10, (arg2[3]));
+  fiat_p1024_mulx_u64(&x1978, &x1979, x10, (arg2[2]));
+  fiat_p1024_mulx_u64(&x1980, &x1981, x10, (arg2[1]));
+  fiat_p1024_mulx_u64(&x1982, &x1983, x10, (arg2[0]));
+  fiat_p1024_addcarryx_u64(&x1984, &x1985, 0x0, x1983, x1980);
+  fiat_p1024_addcarryx_u64(&x1986, &x1987, x1985, x1981, x1978);
+  fiat_p1024_addcarryx_u64(&x1988, &x1989, x1987, x1979, x1976);
+  fiat_p1024_addcarryx_u64(&x1990, &x1991, x1989, x1977, x1974);
+  fiat_p1024_addcarryx_u64(&x1992, &x1993, x1991, x1975, x1972);
+  fiat_p1024_addcarryx_u64(&x1994, &x1995, x1993, x1973, x1970);
+  fiat_p1024_addcarryx_u64(&x1996, &x1997, x1995, x1971, x1968);
+  fiat_p1024_addcarryx_u64(&x1998, &x1999, x1997, x1969, x1966);
+  fiat_p1024_addcarryx_u64(&x2000, &x2001, x1999, x1967, x1964);
+  fiat_p1024_addcarryx_u64(&x2002, &x2003, x2001, x1965, x1962);
+  fiat_p1024_addcarryx_u64(&x2004, &x2005, x2003, x1963, x1960);
+  fiat_p1024_addcarryx_u64(&x2006, &x2007, x2005, x1961, x1958);
+  fiat_p1024_addcarryx_u64(&x2008, &x2009, x2007, x1959, x1956);
+  fiat_p1024_addcarryx_u64(&x2010, &x2011, x2009, x1957, x1954);
+  fiat_p1024_addcarryx_u64(&x2012, &x2013, x2011, x1955, x1952);
+  x2014 = (x2013 + x1953);
+  fiat_p1024_addcarryx_u64(&x2015, &x2016, 0x0, x1919, x1982);
+  fiat_p1024_addcarryx_u64(&x2017, &x2018, x2016, x1921, x1984);
+  fiat_p1024_addcarryx_u64(&x2019, &x2020, x2018, x1923, x1986);
+  fiat_p1024_addcarryx_u64(&x2021, &x2022, x2020, x1925, x1988);
+  fiat_p1024_addcarryx_u64(&x2023, &x2024, x2022, x1927, x1990);
+  fiat_p1024_addcarryx_u64(&x2025, &x2026, x2024, x1929, x1992);
+  fiat_p1024_addcarryx_u64(&x2027, &x2028, x2026, x1931, x1994);
+  fiat_p1024_addcarryx_u64(&x2029, &x2030, x2028, x1933, x1996);
+  fiat_p1024_addcarryx_u64(&x2031, &x2032, x2030, x1935, x1998);
+  fiat_p1024_addcarryx_u64(&x2033, &x2034, x2032, x1937, x2000);
+  fiat_p1024_addcarryx_u64(&x2035, &x2036, x2034, x1939, x2002);
+  fiat_p1024_addcarryx_u64(&x2037, &x2038, x2036, x1941, x2004);
+  fiat_p1024_addcarryx_u64(&x2039, &x2040, x2038, x1943, x2006);
+  fiat_p1024_addcarryx_u64(&x2041, &x2042, x2040, x1945, x2008);
+  fiat_p1024_addcarryx_u64(&x2043, &x2044, x2042, x1947, x2010);
+  fiat_p1024_addcarryx_u64(&x2045, &x2046, x2044, x1949, x2012);
+  fiat_p1024_addcarryx_u64(&x2047, &x2048, x2046, x1951, x2014);
+  fiat_p1024_mulx_u64(&x2049, &x2050, x2015, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x2051, &x2052, x2049, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x2053, &x2054, x2049, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x2055, &x2056, x2049, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x2057, &x2058, x2049, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x2059, &x2060, x2049, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x2061, &x2062, x2049, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x2063, &x2064, x2049, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x2065, &x2066, x2049, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x2067, &x2068, x2049, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x2069, &x2070, x2049, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x2071, &x2072, x2049, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x2073, &x2074, x2049, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x2075, &x2076, x2049, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x2077, &x2078, x2049, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x2079, &x2080, x2049, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x2081, &x2082, x2049, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x2083, &x2084, 0x0, x2082, x2079);
+  fiat_p1024_addcarryx_u64(&x2085, &x2086, x2084, x2080, x2077);
+  fiat_p1024_addcarryx_u64(&x2087, &x2088, x2086, x2078, x2075);
+  fiat_p1024_addcarryx_u64(&x2089, &x2090, x2088, x2076, x2073);
+  fiat_p1024_addcarryx_u64(&x2091, &x2092, x2090, x2074, x2071);
+  fiat_p1024_addcarryx_u64(&x2093, &x2094, x2092, x2072, x2069);
+  fiat_p1024_addcarryx_u64(&x2095, &x2096, x2094, x2070, x2067);
+  fiat_p1024_addcarryx_u64(&x2097, &x2098, x2096, x2068, x2065);
+  fiat_p1024_addcarryx_u64(&x2099, &x2100, x2098, x2066, x2063);
+  fiat_p1024_addcarryx_u64(&x2101, &x2102, x2100, x2064, x2061);
+  fiat_p1024_addcarryx_u64(&x2103, &x2104, x2102, x2062, x2059);
+  fiat_p1024_addcarryx_u64(&x2105, &x2106, x2104, x2060, x2057);
+  fiat_p1024_addcarryx_u64(&x2107, &x2108, x2106, x2058, x2055);
+  fiat_p1024_addcarryx_u64(&x2109, &x2110, x2108, x2056, x2053);
+  fiat_p1024_addcarryx_u64(&x2111, &x2112, x2110, x2054, x2051);
+  x2113 = (x2112 + x2052);
+  fiat_p1024_addcarryx_u64(&x2114, &x2115, 0x0, x2015, x2081);
+  fiat_p1024_addcarryx_u64(&x2116, &x2117, x2115, x2017, x2083);
+  fiat_p1024_addcarryx_u64(&x2118, &x2119, x2117, x2019, x2085);
+  fiat_p1024_addcarryx_u64(&x2120, &x2121, x2119, x2021, x2087);
+  fiat_p1024_addcarryx_u64(&x2122, &x2123, x2121, x2023, x2089);
+  fiat_p1024_addcarryx_u64(&x2124, &x2125, x2123, x2025, x2091);
+  fiat_p1024_addcarryx_u64(&x2126, &x2127, x2125, x2027, x2093);
+  fiat_p1024_addcarryx_u64(&x2128, &x2129, x2127, x2029, x2095);
+  fiat_p1024_addcarryx_u64(&x2130, &x2131, x2129, x2031, x2097);
+  fiat_p1024_addcarryx_u64(&x2132, &x2133, x2131, x2033, x2099);
+  fiat_p1024_addcarryx_u64(&x2134, &x2135, x2133, x2035, x2101);
+  fiat_p1024_addcarryx_u64(&x2136, &x2137, x2135, x2037, x2103);
+  fiat_p1024_addcarryx_u64(&x2138, &x2139, x2137, x2039, x2105);
+  fiat_p1024_addcarryx_u64(&x2140, &x2141, x2139, x2041, x2107);
+  fiat_p1024_addcarryx_u64(&x2142, &x2143, x2141, x2043, x2109);
+  fiat_p1024_addcarryx_u64(&x2144, &x2145, x2143, x2045, x2111);
+  fiat_p1024_addcarryx_u64(&x2146, &x2147, x2145, x2047, x2113);
+  x2148 = ((uint64_t)x2147 + x2048);
+  fiat_p1024_mulx_u64(&x2149, &x2150, x11, (arg2[15]));
+  fiat_p1024_mulx_u64(&x2151, &x2152, x11, (arg2[14]));
+  fiat_p1024_mulx_u64(&x2153, &x2154, x11, (arg2[13]));
+  fiat_p1024_mulx_u64(&x2155, &x2156, x11, (arg2[12]));
+  fiat_p1024_mulx_u64(&x2157, &x2158, x11, (arg2[11]));
+  fiat_p1024_mulx_u64(&x2159, &x2160, x11, (arg2[10]));
+  fiat_p1024_mulx_u64(&x2161, &x2162, x11, (arg2[9]));
+  fiat_p1024_mulx_u64(&x2163, &x2164, x11, (arg2[8]));
+  fiat_p1024_mulx_u64(&x2165, &x2166, x11, (arg2[7]));
+  fiat_p1024_mulx_u64(&x2167, &x2168, x11, (arg2[6]));
+  fiat_p1024_mulx_u64(&x2169, &x2170, x11, (arg2[5]));
+  fiat_p1024_mulx_u64(&x2171, &x2172, x11, (arg2[4]));
+  fiat_p1024_mulx_u64(&x2173, &x2174, x11, (arg2[3]));
+  fiat_p1024_mulx_u64(&x2175, &x2176, x11, (arg2[2]));
+  fiat_p1024_mulx_u64(&x2177, &x2178, x11, (arg2[1]));
+  fiat_p1024_mulx_u64(&x2179, &x2180, x11, (arg2[0]));
+  fiat_p1024_addcarryx_u64(&x2181, &x2182, 0x0, x2180, x2177);
+  fiat_p1024_addcarryx_u64(&x2183, &x2184, x2182, x2178, x2175);
+  fiat_p1024_addcarryx_u64(&x2185, &x2186, x2184, x2176, x2173);
+  fiat_p1024_addcarryx_u64(&x2187, &x2188, x2186, x2174, x2171);
+  fiat_p1024_addcarryx_u64(&x2189, &x2190, x2188, x2172, x2169);
+  fiat_p1024_addcarryx_u64(&x2191, &x2192, x2190, x2170, x2167);
+  fiat_p1024_addcarryx_u64(&x2193, &x2194, x2192, x2168, x2165);
+  fiat_p1024_addcarryx_u64(&x2195, &x2196, x2194, x2166, x2163);
+  fiat_p1024_addcarryx_u64(&x2197, &x2198, x2196, x2164, x2161);
+  fiat_p1024_addcarryx_u64(&x2199, &x2200, x2198, x2162, x2159);
+  fiat_p1024_addcarryx_u64(&x2201, &x2202, x2200, x2160, x2157);
+  fiat_p1024_addcarryx_u64(&x2203, &x2204, x2202, x2158, x2155);
+  fiat_p1024_addcarryx_u64(&x2205, &x2206, x2204, x2156, x2153);
+  fiat_p1024_addcarryx_u64(&x2207, &x2208, x2206, x2154, x2151);
+  fiat_p1024_addcarryx_u64(&x2209, &x2210, x2208, x2152, x2149);
+  x2211 = (x2210 + x2150);
+  fiat_p1024_addcarryx_u64(&x2212, &x2213, 0x0, x2116, x2179);
+  fiat_p1024_addcarryx_u64(&x2214, &x2215, x2213, x2118, x2181);
+  fiat_p1024_addcarryx_u64(&x2216, &x2217, x2215, x2120, x2183);
+  fiat_p1024_addcarryx_u64(&x2218, &x2219, x2217, x2122, x2185);
+  fiat_p1024_addcarryx_u64(&x2220, &x2221, x2219, x2124, x2187);
+  fiat_p1024_addcarryx_u64(&x2222, &x2223, x2221, x2126, x2189);
+  fiat_p1024_addcarryx_u64(&x2224, &x2225, x2223, x2128, x2191);
+  fiat_p1024_addcarryx_u64(&x2226, &x2227, x2225, x2130, x2193);
+  fiat_p1024_addcarryx_u64(&x2228, &x2229, x2227, x2132, x2195);
+  fiat_p1024_addcarryx_u64(&x2230, &x2231, x2229, x2134, x2197);
+  fiat_p1024_addcarryx_u64(&x2232, &x2233, x2231, x2136, x2199);
+  fiat_p1024_addcarryx_u64(&x2234, &x2235, x2233, x2138, x2201);
+  fiat_p1024_addcarryx_u64(&x2236, &x2237, x2235, x2140, x2203);
+  fiat_p1024_addcarryx_u64(&x2238, &x2239, x2237, x2142, x2205);
+  fiat_p1024_addcarryx_u64(&x2240, &x2241, x2239, x2144, x2207);
+  fiat_p1024_addcarryx_u64(&x2242, &x2243, x2241, x2146, x2209);
+  fiat_p1024_addcarryx_u64(&x2244, &x2245, x2243, x2148, x2211);
+  fiat_p1024_mulx_u64(&x2246, &x2247, x2212, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x2248, &x2249, x2246, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x2250, &x2251, x2246, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x2252, &x2253, x2246, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x2254, &x2255, x2246, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x2256, &x2257, x2246, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x2258, &x2259, x2246, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x2260, &x2261, x2246, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x2262, &x2263, x2246, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x2264, &x2265, x2246, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x2266, &x2267, x2246, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x2268, &x2269, x2246, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x2270, &x2271, x2246, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x2272, &x2273, x2246, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x2274, &x2275, x2246, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x2276, &x2277, x2246, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x2278, &x2279, x2246, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x2280, &x2281, 0x0, x2279, x2276);
+  fiat_p1024_addcarryx_u64(&x2282, &x2283, x2281, x2277, x2274);
+  fiat_p1024_addcarryx_u64(&x2284, &x2285, x2283, x2275, x2272);
+  fiat_p1024_addcarryx_u64(&x2286, &x2287, x2285, x2273, x2270);
+  fiat_p1024_addcarryx_u64(&x2288, &x2289, x2287, x2271, x2268);
+  fiat_p1024_addcarryx_u64(&x2290, &x2291, x2289, x2269, x2266);
+  fiat_p1024_addcarryx_u64(&x2292, &x2293, x2291, x2267, x2264);
+  fiat_p1024_addcarryx_u64(&x2294, &x2295, x2293, x2265, x2262);
+  fiat_p1024_addcarryx_u64(&x2296, &x2297, x2295, x2263, x2260);
+  fiat_p1024_addcarryx_u64(&x2298, &x2299, x2297, x2261, x2258);
+  fiat_p1024_addcarryx_u64(&x2300, &x2301, x2299, x2259, x2256);
+  fiat_p1024_addcarryx_u64(&x2302, &x2303, x2301, x2257, x2254);
+  fiat_p1024_addcarryx_u64(&x2304, &x2305, x2303, x2255, x2252);
+  fiat_p1024_addcarryx_u64(&x2306, &x2307, x2305, x2253, x2250);
+  fiat_p1024_addcarryx_u64(&x2308, &x2309, x2307, x2251, x2248);
+  x2310 = (x2309 + x2249);
+  fiat_p1024_addcarryx_u64(&x2311, &x2312, 0x0, x2212, x2278);
+  fiat_p1024_addcarryx_u64(&x2313, &x2314, x2312, x2214, x2280);
+  fiat_p1024_addcarryx_u64(&x2315, &x2316, x2314, x2216, x2282);
+  fiat_p1024_addcarryx_u64(&x2317, &x2318, x2316, x2218, x2284);
+  fiat_p1024_addcarryx_u64(&x2319, &x2320, x2318, x2220, x2286);
+  fiat_p1024_addcarryx_u64(&x2321, &x2322, x2320, x2222, x2288);
+  fiat_p1024_addcarryx_u64(&x2323, &x2324, x2322, x2224, x2290);
+  fiat_p1024_addcarryx_u64(&x2325, &x2326, x2324, x2226, x2292);
+  fiat_p1024_addcarryx_u64(&x2327, &x2328, x2326, x2228, x2294);
+  fiat_p1024_addcarryx_u64(&x2329, &x2330, x2328, x2230, x2296);
+  fiat_p1024_addcarryx_u64(&x2331, &x2332, x2330, x2232, x2298);
+  fiat_p1024_addcarryx_u64(&x2333, &x2334, x2332, x2234, x2300);
+  fiat_p1024_addcarryx_u64(&x2335, &x2336, x2334, x2236, x2302);
+  fiat_p1024_addcarryx_u64(&x2337, &x2338, x2336, x2238, x2304);
+  fiat_p1024_addcarryx_u64(&x2339, &x2340, x2338, x2240, x2306);
+  fiat_p1024_addcarryx_u64(&x2341, &x2342, x2340, x2242, x2308);
+  fiat_p1024_addcarryx_u64(&x2343, &x2344, x2342, x2244, x2310);
+  x2345 = ((uint64_t)x2344 + x2245);
+  fiat_p1024_mulx_u64(&x2346, &x2347, x12, (arg2[15]));
+  fiat_p1024_mulx_u64(&x2348, &x2349, x12, (arg2[14]));
+  fiat_p1024_mulx_u64(&x2350, &x2351, x12, (arg2[13]));
+  fiat_p1024_mulx_u64(&x2352, &x2353, x12, (arg2[12]));
+  fiat_p1024_mulx_u64(&x2354, &x2355, x12, (arg2[11]));
+  fiat_p1024_mulx_u64(&x2356, &x2357, x12, (arg2[10]));
+  fiat_p1024_mulx_u64(&x2358, &x2359, x12, (arg2[9]));
+  fiat_p1024_mulx_u64(&x2360, &x2361, x12, (arg2[8]));
+  fiat_p1024_mulx_u64(&x2362, &x2363, x12, (arg2[7]));
+  fiat_p1024_mulx_u64(&x2364, &x2365, x12, (arg2[6]));
+  fiat_p1024_mulx_u64(&x2366, &x2367, x12, (arg2[5]));
+  fiat_p1024_mulx_u64(&x2368, &x2369, x12, (arg2[4]));
+  fiat_p1024_mulx_u64(&x2370, &x2371, x12, (arg2[3]));
+  fiat_p1024_mulx_u64(&x2372, &x2373, x12, (arg2[2]));
+  fiat_p1024_mulx_u64(&x2374, &x2375, x12, (arg2[1]));
+  fiat_p1024_mulx_u64(&x2376, &x2377, x12, (arg2[0]));
+  fiat_p1024_addcarryx_u64(&x2378, &x2379, 0x0, x2377, x2374);
+  fiat_p1024_addcarryx_u64(&x2380, &x2381, x2379, x2375, x2372);
+  fiat_p1024_addcarryx_u64(&x2382, &x2383, x2381, x2373, x2370);
+  fiat_p1024_addcarryx_u64(&x2384, &x2385, x2383, x2371, x2368);
+  fiat_p1024_addcarryx_u64(&x2386, &x2387, x2385, x2369, x2366);
+  fiat_p1024_addcarryx_u64(&x2388, &x2389, x2387, x2367, x2364);
+  fiat_p1024_addcarryx_u64(&x2390, &x2391, x2389, x2365, x2362);
+  fiat_p1024_addcarryx_u64(&x2392, &x2393, x2391, x2363, x2360);
+  fiat_p1024_addcarryx_u64(&x2394, &x2395, x2393, x2361, x2358);
+  fiat_p1024_addcarryx_u64(&x2396, &x2397, x2395, x2359, x2356);
+  fiat_p1024_addcarryx_u64(&x2398, &x2399, x2397, x2357, x2354);
+  fiat_p1024_addcarryx_u64(&x2400, &x2401, x2399, x2355, x2352);
+  fiat_p1024_addcarryx_u64(&x2402, &x2403, x2401, x2353, x2350);
+  fiat_p1024_addcarryx_u64(&x2404, &x2405, x2403, x2351, x2348);
+  fiat_p1024_addcarryx_u64(&x2406, &x2407, x2405, x2349, x2346);
+  x2408 = (x2407 + x2347);
+  fiat_p1024_addcarryx_u64(&x2409, &x2410, 0x0, x2313, x2376);
+  fiat_p1024_addcarryx_u64(&x2411, &x2412, x2410, x2315, x2378);
+  fiat_p1024_addcarryx_u64(&x2413, &x2414, x2412, x2317, x2380);
+  fiat_p1024_addcarryx_u64(&x2415, &x2416, x2414, x2319, x2382);
+  fiat_p1024_addcarryx_u64(&x2417, &x2418, x2416, x2321, x2384);
+  fiat_p1024_addcarryx_u64(&x2419, &x2420, x2418, x2323, x2386);
+  fiat_p1024_addcarryx_u64(&x2421, &x2422, x2420, x2325, x2388);
+  fiat_p1024_addcarryx_u64(&x2423, &x2424, x2422, x2327, x2390);
+  fiat_p1024_addcarryx_u64(&x2425, &x2426, x2424, x2329, x2392);
+  fiat_p1024_addcarryx_u64(&x2427, &x2428, x2426, x2331, x2394);
+  fiat_p1024_addcarryx_u64(&x2429, &x2430, x2428, x2333, x2396);
+  fiat_p1024_addcarryx_u64(&x2431, &x2432, x2430, x2335, x2398);
+  fiat_p1024_addcarryx_u64(&x2433, &x2434, x2432, x2337, x2400);
+  fiat_p1024_addcarryx_u64(&x2435, &x2436, x2434, x2339, x2402);
+  fiat_p1024_addcarryx_u64(&x2437, &x2438, x2436, x2341, x2404);
+  fiat_p1024_addcarryx_u64(&x2439, &x2440, x2438, x2343, x2406);
+  fiat_p1024_addcarryx_u64(&x2441, &x2442, x2440, x2345, x2408);
+  fiat_p1024_mulx_u64(&x2443, &x2444, x2409, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x2445, &x2446, x2443, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x2447, &x2448, x2443, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x2449, &x2450, x2443, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x2451, &x2452, x2443, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x2453, &x2454, x2443, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x2455, &x2456, x2443, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x2457, &x2458, x2443, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x2459, &x2460, x2443, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x2461, &x2462, x2443, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x2463, &x2464, x2443, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x2465, &x2466, x2443, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x2467, &x2468, x2443, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x2469, &x2470, x2443, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x2471, &x2472, x2443, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x2473, &x2474, x2443, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x2475, &x2476, x2443, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x2477, &x2478, 0x0, x2476, x2473);
+  fiat_p1024_addcarryx_u64(&x2479, &x2480, x2478, x2474, x2471);
+  fiat_p1024_addcarryx_u64(&x2481, &x2482, x2480, x2472, x2469);
+  fiat_p1024_addcarryx_u64(&x2483, &x2484, x2482, x2470, x2467);
+  fiat_p1024_addcarryx_u64(&x2485, &x2486, x2484, x2468, x2465);
+  fiat_p1024_addcarryx_u64(&x2487, &x2488, x2486, x2466, x2463);
+  fiat_p1024_addcarryx_u64(&x2489, &x2490, x2488, x2464, x2461);
+  fiat_p1024_addcarryx_u64(&x2491, &x2492, x2490, x2462, x2459);
+  fiat_p1024_addcarryx_u64(&x2493, &x2494, x2492, x2460, x2457);
+  fiat_p1024_addcarryx_u64(&x2495, &x2496, x2494, x2458, x2455);
+  fiat_p1024_addcarryx_u64(&x2497, &x2498, x2496, x2456, x2453);
+  fiat_p1024_addcarryx_u64(&x2499, &x2500, x2498, x2454, x2451);
+  fiat_p1024_addcarryx_u64(&x2501, &x2502, x2500, x2452, x2449);
+  fiat_p1024_addcarryx_u64(&x2503, &x2504, x2502, x2450, x2447);
+  fiat_p1024_addcarryx_u64(&x2505, &x2506, x2504, x2448, x2445);
+  x2507 = (x2506 + x2446);
+  fiat_p1024_addcarryx_u64(&x2508, &x2509, 0x0, x2409, x2475);
+  fiat_p1024_addcarryx_u64(&x2510, &x2511, x2509, x2411, x2477);
+  fiat_p1024_addcarryx_u64(&x2512, &x2513, x2511, x2413, x2479);
+  fiat_p1024_addcarryx_u64(&x2514, &x2515, x2513, x2415, x2481);
+  fiat_p1024_addcarryx_u64(&x2516, &x2517, x2515, x2417, x2483);
+  fiat_p1024_addcarryx_u64(&x2518, &x2519, x2517, x2419, x2485);
+  fiat_p1024_addcarryx_u64(&x2520, &x2521, x2519, x2421, x2487);
+  fiat_p1024_addcarryx_u64(&x2522, &x2523, x2521, x2423, x2489);
+  fiat_p1024_addcarryx_u64(&x2524, &x2525, x2523, x2425, x2491);
+  fiat_p1024_addcarryx_u64(&x2526, &x2527, x2525, x2427, x2493);
+  fiat_p1024_addcarryx_u64(&x2528, &x2529, x2527, x2429, x2495);
+  fiat_p1024_addcarryx_u64(&x2530, &x2531, x2529, x2431, x2497);
+  fiat_p1024_addcarryx_u64(&x2532, &x2533, x2531, x2433, x2499);
+  fiat_p1024_addcarryx_u64(&x2534, &x2535, x2533, x2435, x2501);
+  fiat_p1024_addcarryx_u64(&x2536, &x2537, x2535, x2437, x2503);
+  fiat_p1024_addcarryx_u64(&x2538, &x2539, x2537, x2439, x2505);
+  fiat_p1024_addcarryx_u64(&x2540, &x2541, x2539, x2441, x2507);
+  x2542 = ((uint64_t)x2541 + x2442);
+  fiat_p1024_mulx_u64(&x2543, &x2544, x13, (arg2[15]));
+  fiat_p1024_mulx_u64(&x2545, &x2546, x13, (arg2[14]));
+  fiat_p1024_mulx_u64(&x2547, &x2548, x13, (arg2[13]));
+  fiat_p1024_mulx_u64(&x2549, &x2550, x13, (arg2[12]));
+  fiat_p1024_mulx_u64(&x2551, &x2552, x13, (arg2[11]));
+  fiat_p1024_mulx_u64(&x2553, &x2554, x13, (arg2[10]));
+  fiat_p1024_mulx_u64(&x2555, &x2556, x13, (arg2[9]));
+  fiat_p1024_mulx_u64(&x2557, &x2558, x13, (arg2[8]));
+  fiat_p1024_mulx_u64(&x2559, &x2560, x13, (arg2[7]));
+  fiat_p1024_mulx_u64(&x2561, &x2562, x13, (arg2[6]));
+  fiat_p1024_mulx_u64(&x2563, &x2564, x13, (arg2[5]));
+  fiat_p1024_mulx_u64(&x2565, &x2566, x13, (arg2[4]));
+  fiat_p1024_mulx_u64(&x2567, &x2568, x13, (arg2[3]));
+  fiat_p1024_mulx_u64(&x2569, &x2570, x13, (arg2[2]));
+  fiat_p1024_mulx_u64(&x2571, &x2572, x13, (arg2[1]));
+  fiat_p1024_mulx_u64(&x2573, &x2574, x13, (arg2[0]));
+  fiat_p1024_addcarryx_u64(&x2575, &x2576, 0x0, x2574, x2571);
+  fiat_p1024_addcarryx_u64(&x2577, &x2578, x2576, x2572, x2569);
+  fiat_p1024_addcarryx_u64(&x2579, &x2580, x2578, x2570, x2567);
+  fiat_p1024_addcarryx_u64(&x2581, &x2582, x2580, x2568, x2565);
+  fiat_p1024_addcarryx_u64(&x2583, &x2584, x2582, x2566, x2563);
+  fiat_p1024_addcarryx_u64(&x2585, &x2586, x2584, x2564, x2561);
+  fiat_p1024_addcarryx_u64(&x2587, &x2588, x2586, x2562, x2559);
+  fiat_p1024_addcarryx_u64(&x2589, &x2590, x2588, x2560, x2557);
+  fiat_p1024_addcarryx_u64(&x2591, &x2592, x2590, x2558, x2555);
+  fiat_p1024_addcarryx_u64(&x2593, &x2594, x2592, x2556, x2553);
+  fiat_p1024_addcarryx_u64(&x2595, &x2596, x2594, x2554, x2551);
+  fiat_p1024_addcarryx_u64(&x2597, &x2598, x2596, x2552, x2549);
+  fiat_p1024_addcarryx_u64(&x2599, &x2600, x2598, x2550, x2547);
+  fiat_p1024_addcarryx_u64(&x2601, &x2602, x2600, x2548, x2545);
+  fiat_p1024_addcarryx_u64(&x2603, &x2604, x2602, x2546, x2543);
+  x2605 = (x2604 + x2544);
+  fiat_p1024_addcarryx_u64(&x2606, &x2607, 0x0, x2510, x2573);
+  fiat_p1024_addcarryx_u64(&x2608, &x2609, x2607, x2512, x2575);
+  fiat_p1024_addcarryx_u64(&x2610, &x2611, x2609, x2514, x2577);
+  fiat_p1024_addcarryx_u64(&x2612, &x2613, x2611, x2516, x2579);
+  fiat_p1024_addcarryx_u64(&x2614, &x2615, x2613, x2518, x2581);
+  fiat_p1024_addcarryx_u64(&x2616, &x2617, x2615, x2520, x2583);
+  fiat_p1024_addcarryx_u64(&x2618, &x2619, x2617, x2522, x2585);
+  fiat_p1024_addcarryx_u64(&x2620, &x2621, x2619, x2524, x2587);
+  fiat_p1024_addcarryx_u64(&x2622, &x2623, x2621, x2526, x2589);
+  fiat_p1024_addcarryx_u64(&x2624, &x2625, x2623, x2528, x2591);
+  fiat_p1024_addcarryx_u64(&x2626, &x2627, x2625, x2530, x2593);
+  fiat_p1024_addcarryx_u64(&x2628, &x2629, x2627, x2532, x2595);
+  fiat_p1024_addcarryx_u64(&x2630, &x2631, x2629, x2534, x2597);
+  fiat_p1024_addcarryx_u64(&x2632, &x2633, x2631, x2536, x2599);
+  fiat_p1024_addcarryx_u64(&x2634, &x2635, x2633, x2538, x2601);
+  fiat_p1024_addcarryx_u64(&x2636, &x2637, x2635, x2540, x2603);
+  fiat_p1024_addcarryx_u64(&x2638, &x2639, x2637, x2542, x2605);
+  fiat_p1024_mulx_u64(&x2640, &x2641, x2606, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x2642, &x2643, x2640, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x2644, &x2645, x2640, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x2646, &x2647, x2640, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x2648, &x2649, x2640, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x2650, &x2651, x2640, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x2652, &x2653, x2640, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x2654, &x2655, x2640, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x2656, &x2657, x2640, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x2658, &x2659, x2640, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x2660, &x2661, x2640, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x2662, &x2663, x2640, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x2664, &x2665, x2640, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x2666, &x2667, x2640, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x2668, &x2669, x2640, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x2670, &x2671, x2640, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x2672, &x2673, x2640, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x2674, &x2675, 0x0, x2673, x2670);
+  fiat_p1024_addcarryx_u64(&x2676, &x2677, x2675, x2671, x2668);
+  fiat_p1024_addcarryx_u64(&x2678, &x2679, x2677, x2669, x2666);
+  fiat_p1024_addcarryx_u64(&x2680, &x2681, x2679, x2667, x2664);
+  fiat_p1024_addcarryx_u64(&x2682, &x2683, x2681, x2665, x2662);
+  fiat_p1024_addcarryx_u64(&x2684, &x2685, x2683, x2663, x2660);
+  fiat_p1024_addcarryx_u64(&x2686, &x2687, x2685, x2661, x2658);
+  fiat_p1024_addcarryx_u64(&x2688, &x2689, x2687, x2659, x2656);
+  fiat_p1024_addcarryx_u64(&x2690, &x2691, x2689, x2657, x2654);
+  fiat_p1024_addcarryx_u64(&x2692, &x2693, x2691, x2655, x2652);
+  fiat_p1024_addcarryx_u64(&x2694, &x2695, x2693, x2653, x2650);
+  fiat_p1024_addcarryx_u64(&x2696, &x2697, x2695, x2651, x2648);
+  fiat_p1024_addcarryx_u64(&x2698, &x2699, x2697, x2649, x2646);
+  fiat_p1024_addcarryx_u64(&x2700, &x2701, x2699, x2647, x2644);
+  fiat_p1024_addcarryx_u64(&x2702, &x2703, x2701, x2645, x2642);
+  x2704 = (x2703 + x2643);
+  fiat_p1024_addcarryx_u64(&x2705, &x2706, 0x0, x2606, x2672);
+  fiat_p1024_addcarryx_u64(&x2707, &x2708, x2706, x2608, x2674);
+  fiat_p1024_addcarryx_u64(&x2709, &x2710, x2708, x2610, x2676);
+  fiat_p1024_addcarryx_u64(&x2711, &x2712, x2710, x2612, x2678);
+  fiat_p1024_addcarryx_u64(&x2713, &x2714, x2712, x2614, x2680);
+  fiat_p1024_addcarryx_u64(&x2715, &x2716, x2714, x2616, x2682);
+  fiat_p1024_addcarryx_u64(&x2717, &x2718, x2716, x2618, x2684);
+  fiat_p1024_addcarryx_u64(&x2719, &x2720, x2718, x2620, x2686);
+  fiat_p1024_addcarryx_u64(&x2721, &x2722, x2720, x2622, x2688);
+  fiat_p1024_addcarryx_u64(&x2723, &x2724, x2722, x2624, x2690);
+  fiat_p1024_addcarryx_u64(&x2725, &x2726, x2724, x2626, x2692);
+  fiat_p1024_addcarryx_u64(&x2727, &x2728, x2726, x2628, x2694);
+  fiat_p1024_addcarryx_u64(&x2729, &x2730, x2728, x2630, x2696);
+  fiat_p1024_addcarryx_u64(&x2731, &x2732, x2730, x2632, x2698);
+  fiat_p1024_addcarryx_u64(&x2733, &x2734, x2732, x2634, x2700);
+  fiat_p1024_addcarryx_u64(&x2735, &x2736, x2734, x2636, x2702);
+  fiat_p1024_addcarryx_u64(&x2737, &x2738, x2736, x2638, x2704);
+  x2739 = ((uint64_t)x2738 + x2639);
+  fiat_p1024_mulx_u64(&x2740, &x2741, x14, (arg2[15]));
+  fiat_p1024_mulx_u64(&x2742, &x2743, x14, (arg2[14]));
+  fiat_p1024_mulx_u64(&x2744, &x2745, x14, (arg2[13]));
+  fiat_p1024_mulx_u64(&x2746, &x2747, x14, (arg2[12]));
+  fiat_p1024_mulx_u64(&x2748, &x2749, x14, (arg2[11]));
+  fiat_p1024_mulx_u64(&x2750, &x2751, x14, (arg2[10]));
+  fiat_p1024_mulx_u64(&x2752, &x2753, x14, (arg2[9]));
+  fiat_p1024_mulx_u64(&x2754, &x2755, x14, (arg2[8]));
+  fiat_p1024_mulx_u64(&x2756, &x2757, x14, (arg2[7]));
+  fiat_p1024_mulx_u64(&x2758, &x2759, x14, (arg2[6]));
+  fiat_p1024_mulx_u64(&x2760, &x2761, x14, (arg2[5]));
+  fiat_p1024_mulx_u64(&x2762, &x2763, x14, (arg2[4]));
+  fiat_p1024_mulx_u64(&x2764, &x2765, x14, (arg2[3]));
+  fiat_p1024_mulx_u64(&x2766, &x2767, x14, (arg2[2]));
+  fiat_p1024_mulx_u64(&x2768, &x2769, x14, (arg2[1]));
+  fiat_p1024_mulx_u64(&x2770, &x2771, x14, (arg2[0]));
+  fiat_p1024_addcarryx_u64(&x2772, &x2773, 0x0, x2771, x2768);
+  fiat_p1024_addcarryx_u64(&x2774, &x2775, x2773, x2769, x2766);
+  fiat_p1024_addcarryx_u64(&x2776, &x2777, x2775, x2767, x2764);
+  fiat_p1024_addcarryx_u64(&x2778, &x2779, x2777, x2765, x2762);
+  fiat_p1024_addcarryx_u64(&x2780, &x2781, x2779, x2763, x2760);
+  fiat_p1024_addcarryx_u64(&x2782, &x2783, x2781, x2761, x2758);
+  fiat_p1024_addcarryx_u64(&x2784, &x2785, x2783, x2759, x2756);
+  fiat_p1024_addcarryx_u64(&x2786, &x2787, x2785, x2757, x2754);
+  fiat_p1024_addcarryx_u64(&x2788, &x2789, x2787, x2755, x2752);
+  fiat_p1024_addcarryx_u64(&x2790, &x2791, x2789, x2753, x2750);
+  fiat_p1024_addcarryx_u64(&x2792, &x2793, x2791, x2751, x2748);
+  fiat_p1024_addcarryx_u64(&x2794, &x2795, x2793, x2749, x2746);
+  fiat_p1024_addcarryx_u64(&x2796, &x2797, x2795, x2747, x2744);
+  fiat_p1024_addcarryx_u64(&x2798, &x2799, x2797, x2745, x2742);
+  fiat_p1024_addcarryx_u64(&x2800, &x2801, x2799, x2743, x2740);
+  x2802 = (x2801 + x2741);
+  fiat_p1024_addcarryx_u64(&x2803, &x2804, 0x0, x2707, x2770);
+  fiat_p1024_addcarryx_u64(&x2805, &x2806, x2804, x2709, x2772);
+  fiat_p1024_addcarryx_u64(&x2807, &x2808, x2806, x2711, x2774);
+  fiat_p1024_addcarryx_u64(&x2809, &x2810, x2808, x2713, x2776);
+  fiat_p1024_addcarryx_u64(&x2811, &x2812, x2810, x2715, x2778);
+  fiat_p1024_addcarryx_u64(&x2813, &x2814, x2812, x2717, x2780);
+  fiat_p1024_addcarryx_u64(&x2815, &x2816, x2814, x2719, x2782);
+  fiat_p1024_addcarryx_u64(&x2817, &x2818, x2816, x2721, x2784);
+  fiat_p1024_addcarryx_u64(&x2819, &x2820, x2818, x2723, x2786);
+  fiat_p1024_addcarryx_u64(&x2821, &x2822, x2820, x2725, x2788);
+  fiat_p1024_addcarryx_u64(&x2823, &x2824, x2822, x2727, x2790);
+  fiat_p1024_addcarryx_u64(&x2825, &x2826, x2824, x2729, x2792);
+  fiat_p1024_addcarryx_u64(&x2827, &x2828, x2826, x2731, x2794);
+  fiat_p1024_addcarryx_u64(&x2829, &x2830, x2828, x2733, x2796);
+  fiat_p1024_addcarryx_u64(&x2831, &x2832, x2830, x2735, x2798);
+  fiat_p1024_addcarryx_u64(&x2833, &x2834, x2832, x2737, x2800);
+  fiat_p1024_addcarryx_u64(&x2835, &x2836, x2834, x2739, x2802);
+  fiat_p1024_mulx_u64(&x2837, &x2838, x2803, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x2839, &x2840, x2837, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x2841, &x2842, x2837, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x2843, &x2844, x2837, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x2845, &x2846, x2837, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x2847, &x2848, x2837, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x2849, &x2850, x2837, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x2851, &x2852, x2837, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x2853, &x2854, x2837, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x2855, &x2856, x2837, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x2857, &x2858, x2837, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x2859, &x2860, x2837, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x2861, &x2862, x2837, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x2863, &x2864, x2837, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x2865, &x2866, x2837, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x2867, &x2868, x2837, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x2869, &x2870, x2837, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x2871, &x2872, 0x0, x2870, x2867);
+  fiat_p1024_addcarryx_u64(&x2873, &x2874, x2872, x2868, x2865);
+  fiat_p1024_addcarryx_u64(&x2875, &x2876, x2874, x2866, x2863);
+  fiat_p1024_addcarryx_u64(&x2877, &x2878, x2876, x2864, x2861);
+  fiat_p1024_addcarryx_u64(&x2879, &x2880, x2878, x2862, x2859);
+  fiat_p1024_addcarryx_u64(&x2881, &x2882, x2880, x2860, x2857);
+  fiat_p1024_addcarryx_u64(&x2883, &x2884, x2882, x2858, x2855);
+  fiat_p1024_addcarryx_u64(&x2885, &x2886, x2884, x2856, x2853);
+  fiat_p1024_addcarryx_u64(&x2887, &x2888, x2886, x2854, x2851);
+  fiat_p1024_addcarryx_u64(&x2889, &x2890, x2888, x2852, x2849);
+  fiat_p1024_addcarryx_u64(&x2891, &x2892, x2890, x2850, x2847);
+  fiat_p1024_addcarryx_u64(&x2893, &x2894, x2892, x2848, x2845);
+  fiat_p1024_addcarryx_u64(&x2895, &x2896, x2894, x2846, x2843);
+  fiat_p1024_addcarryx_u64(&x2897, &x2898, x2896, x2844, x2841);
+  fiat_p1024_addcarryx_u64(&x2899, &x2900, x2898, x2842, x2839);
+  x2901 = (x2900 + x2840);
+  fiat_p1024_addcarryx_u64(&x2902, &x2903, 0x0, x2803, x2869);
+  fiat_p1024_addcarryx_u64(&x2904, &x2905, x2903, x2805, x2871);
+  fiat_p1024_addcarryx_u64(&x2906, &x2907, x2905, x2807, x2873);
+  fiat_p1024_addcarryx_u64(&x2908, &x2909, x2907, x2809, x2875);
+  fiat_p1024_addcarryx_u64(&x2910, &x2911, x2909, x2811, x2877);
+  fiat_p1024_addcarryx_u64(&x2912, &x2913, x2911, x2813, x2879);
+  fiat_p1024_addcarryx_u64(&x2914, &x2915, x2913, x2815, x2881);
+  fiat_p1024_addcarryx_u64(&x2916, &x2917, x2915, x2817, x2883);
+  fiat_p1024_addcarryx_u64(&x2918, &x2919, x2917, x2819, x2885);
+  fiat_p1024_addcarryx_u64(&x2920, &x2921, x2919, x2821, x2887);
+  fiat_p1024_addcarryx_u64(&x2922, &x2923, x2921, x2823, x2889);
+  fiat_p1024_addcarryx_u64(&x2924, &x2925, x2923, x2825, x2891);
+  fiat_p1024_addcarryx_u64(&x2926, &x2927, x2925, x2827, x2893);
+  fiat_p1024_addcarryx_u64(&x2928, &x2929, x2927, x2829, x2895);
+  fiat_p1024_addcarryx_u64(&x2930, &x2931, x2929, x2831, x2897);
+  fiat_p1024_addcarryx_u64(&x2932, &x2933, x2931, x2833, x2899);
+  fiat_p1024_addcarryx_u64(&x2934, &x2935, x2933, x2835, x2901);
+  x2936 = ((uint64_t)x2935 + x2836);
+  fiat_p1024_mulx_u64(&x2937, &x2938, x15, (arg2[15]));
+  fiat_p1024_mulx_u64(&x2939, &x2940, x15, (arg2[14]));
+  fiat_p1024_mulx_u64(&x2941, &x2942, x15, (arg2[13]));
+  fiat_p1024_mulx_u64(&x2943, &x2944, x15, (arg2[12]));
+  fiat_p1024_mulx_u64(&x2945, &x2946, x15, (arg2[11]));
+  fiat_p1024_mulx_u64(&x2947, &x2948, x15, (arg2[10]));
+  fiat_p1024_mulx_u64(&x2949, &x2950, x15, (arg2[9]));
+  fiat_p1024_mulx_u64(&x2951, &x2952, x15, (arg2[8]));
+  fiat_p1024_mulx_u64(&x2953, &x2954, x15, (arg2[7]));
+  fiat_p1024_mulx_u64(&x2955, &x2956, x15, (arg2[6]));
+  fiat_p1024_mulx_u64(&x2957, &x2958, x15, (arg2[5]));
+  fiat_p1024_mulx_u64(&x2959, &x2960, x15, (arg2[4]));
+  fiat_p1024_mulx_u64(&x2961, &x2962, x15, (arg2[3]));
+  fiat_p1024_mulx_u64(&x2963, &x2964, x15, (arg2[2]));
+  fiat_p1024_mulx_u64(&x2965, &x2966, x15, (arg2[1]));
+  fiat_p1024_mulx_u64(&x2967, &x2968, x15, (arg2[0]));
+  fiat_p1024_addcarryx_u64(&x2969, &x2970, 0x0, x2968, x2965);
+  fiat_p1024_addcarryx_u64(&x2971, &x2972, x2970, x2966, x2963);
+  fiat_p1024_addcarryx_u64(&x2973, &x2974, x2972, x2964, x2961);
+  fiat_p1024_addcarryx_u64(&x2975, &x2976, x2974, x2962, x2959);
+  fiat_p1024_addcarryx_u64(&x2977, &x2978, x2976, x2960, x2957);
+  fiat_p1024_addcarryx_u64(&x2979, &x2980, x2978, x2958, x2955);
+  fiat_p1024_addcarryx_u64(&x2981, &x2982, x2980, x2956, x2953);
+  fiat_p1024_addcarryx_u64(&x2983, &x2984, x2982, x2954, x2951);
+  fiat_p1024_addcarryx_u64(&x2985, &x2986, x2984, x2952, x2949);
+  fiat_p1024_addcarryx_u64(&x2987, &x2988, x2986, x2950, x2947);
+  fiat_p1024_addcarryx_u64(&x2989, &x2990, x2988, x2948, x2945);
+  fiat_p1024_addcarryx_u64(&x2991, &x2992, x2990, x2946, x2943);
+  fiat_p1024_addcarryx_u64(&x2993, &x2994, x2992, x2944, x2941);
+  fiat_p1024_addcarryx_u64(&x2995, &x2996, x2994, x2942, x2939);
+  fiat_p1024_addcarryx_u64(&x2997, &x2998, x2996, x2940, x2937);
+  x2999 = (x2998 + x2938);
+  fiat_p1024_addcarryx_u64(&x3000, &x3001, 0x0, x2904, x2967);
+  fiat_p1024_addcarryx_u64(&x3002, &x3003, x3001, x2906, x2969);
+  fiat_p1024_addcarryx_u64(&x3004, &x3005, x3003, x2908, x2971);
+  fiat_p1024_addcarryx_u64(&x3006, &x3007, x3005, x2910, x2973);
+  fiat_p1024_addcarryx_u64(&x3008, &x3009, x3007, x2912, x2975);
+  fiat_p1024_addcarryx_u64(&x3010, &x3011, x3009, x2914, x2977);
+  fiat_p1024_addcarryx_u64(&x3012, &x3013, x3011, x2916, x2979);
+  fiat_p1024_addcarryx_u64(&x3014, &x3015, x3013, x2918, x2981);
+  fiat_p1024_addcarryx_u64(&x3016, &x3017, x3015, x2920, x2983);
+  fiat_p1024_addcarryx_u64(&x3018, &x3019, x3017, x2922, x2985);
+  fiat_p1024_addcarryx_u64(&x3020, &x3021, x3019, x2924, x2987);
+  fiat_p1024_addcarryx_u64(&x3022, &x3023, x3021, x2926, x2989);
+  fiat_p1024_addcarryx_u64(&x3024, &x3025, x3023, x2928, x2991);
+  fiat_p1024_addcarryx_u64(&x3026, &x3027, x3025, x2930, x2993);
+  fiat_p1024_addcarryx_u64(&x3028, &x3029, x3027, x2932, x2995);
+  fiat_p1024_addcarryx_u64(&x3030, &x3031, x3029, x2934, x2997);
+  fiat_p1024_addcarryx_u64(&x3032, &x3033, x3031, x2936, x2999);
+  fiat_p1024_mulx_u64(&x3034, &x3035, x3000, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x3036, &x3037, x3034, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x3038, &x3039, x3034, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x3040, &x3041, x3034, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x3042, &x3043, x3034, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x3044, &x3045, x3034, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x3046, &x3047, x3034, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x3048, &x3049, x3034, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x3050, &x3051, x3034, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x3052, &x3053, x3034, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x3054, &x3055, x3034, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x3056, &x3057, x3034, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x3058, &x3059, x3034, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x3060, &x3061, x3034, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x3062, &x3063, x3034, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x3064, &x3065, x3034, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x3066, &x3067, x3034, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x3068, &x3069, 0x0, x3067, x3064);
+  fiat_p1024_addcarryx_u64(&x3070, &x3071, x3069, x3065, x3062);
+  fiat_p1024_addcarryx_u64(&x3072, &x3073, x3071, x3063, x3060);
+  fiat_p1024_addcarryx_u64(&x3074, &x3075, x3073, x3061, x3058);
+  fiat_p1024_addcarryx_u64(&x3076, &x3077, x3075, x3059, x3056);
+  fiat_p1024_addcarryx_u64(&x3078, &x3079, x3077, x3057, x3054);
+  fiat_p1024_addcarryx_u64(&x3080, &x3081, x3079, x3055, x3052);
+  fiat_p1024_addcarryx_u64(&x3082, &x3083, x3081, x3053, x3050);
+  fiat_p1024_addcarryx_u64(&x3084, &x3085, x3083, x3051, x3048);
+  fiat_p1024_addcarryx_u64(&x3086, &x3087, x3085, x3049, x3046);
+  fiat_p1024_addcarryx_u64(&x3088, &x3089, x3087, x3047, x3044);
+  fiat_p1024_addcarryx_u64(&x3090, &x3091, x3089, x3045, x3042);
+  fiat_p1024_addcarryx_u64(&x3092, &x3093, x3091, x3043, x3040);
+  fiat_p1024_addcarryx_u64(&x3094, &x3095, x3093, x3041, x3038);
+  fiat_p1024_addcarryx_u64(&x3096, &x3097, x3095, x3039, x3036);
+  x3098 = (x3097 + x3037);
+  fiat_p1024_addcarryx_u64(&x3099, &x3100, 0x0, x3000, x3066);
+  fiat_p1024_addcarryx_u64(&x3101, &x3102, x3100, x3002, x3068);
+  fiat_p1024_addcarryx_u64(&x3103, &x3104, x3102, x3004, x3070);
+  fiat_p1024_addcarryx_u64(&x3105, &x3106, x3104, x3006, x3072);
+  fiat_p1024_addcarryx_u64(&x3107, &x3108, x3106, x3008, x3074);
+  fiat_p1024_addcarryx_u64(&x3109, &x3110, x3108, x3010, x3076);
+  fiat_p1024_addcarryx_u64(&x3111, &x3112, x3110, x3012, x3078);
+  fiat_p1024_addcarryx_u64(&x3113, &x3114, x3112, x3014, x3080);
+  fiat_p1024_addcarryx_u64(&x3115, &x3116, x3114, x3016, x3082);
+  fiat_p1024_addcarryx_u64(&x3117, &x3118, x3116, x3018, x3084);
+  fiat_p1024_addcarryx_u64(&x3119, &x3120, x3118, x3020, x3086);
+  fiat_p1024_addcarryx_u64(&x3121, &x3122, x3120, x3022, x3088);
+  fiat_p1024_addcarryx_u64(&x3123, &x3124, x3122, x3024, x3090);
+  fiat_p1024_addcarryx_u64(&x3125, &x3126, x3124, x3026, x3092);
+  fiat_p1024_addcarryx_u64(&x3127, &x3128, x3126, x3028, x3094);
+  fiat_p1024_addcarryx_u64(&x3129, &x3130, x3128, x3030, x3096);
+  fiat_p1024_addcarryx_u64(&x3131, &x3132, x3130, x3032, x3098);
+  x3133 = ((uint64_t)x3132 + x3033);
+  fiat_p1024_subborrowx_u64(&x3134, &x3135, 0x0, x3101, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_subborrowx_u64(&x3136, &x3137, x3135, x3103, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_subborrowx_u64(&x3138, &x3139, x3137, x3105, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_subborrowx_u64(&x3140, &x3141, x3139, x3107, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_subborrowx_u64(&x3142, &x3143, x3141, x3109, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_subborrowx_u64(&x3144, &x3145, x3143, x3111, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_subborrowx_u64(&x3146, &x3147, x3145, x3113, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_subborrowx_u64(&x3148, &x3149, x3147, x3115, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_subborrowx_u64(&x3150, &x3151, x3149, x3117, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_subborrowx_u64(&x3152, &x3153, x3151, x3119, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_subborrowx_u64(&x3154, &x3155, x3153, x3121, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_subborrowx_u64(&x3156, &x3157, x3155, x3123, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_subborrowx_u64(&x3158, &x3159, x3157, x3125, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_subborrowx_u64(&x3160, &x3161, x3159, x3127, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_subborrowx_u64(&x3162, &x3163, x3161, x3129, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_subborrowx_u64(&x3164, &x3165, x3163, x3131, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_subborrowx_u64(&x3166, &x3167, x3165, x3133, 0x0);
+  fiat_p1024_cmovznz_u64(&x3168, x3167, x3134, x3101);
+  fiat_p1024_cmovznz_u64(&x3169, x3167, x3136, x3103);
+  fiat_p1024_cmovznz_u64(&x3170, x3167, x3138, x3105);
+  fiat_p1024_cmovznz_u64(&x3171, x3167, x3140, x3107);
+  fiat_p1024_cmovznz_u64(&x3172, x3167, x3142, x3109);
+  fiat_p1024_cmovznz_u64(&x3173, x3167, x3144, x3111);
+  fiat_p1024_cmovznz_u64(&x3174, x3167, x3146, x3113);
+  fiat_p1024_cmovznz_u64(&x3175, x3167, x3148, x3115);
+  fiat_p1024_cmovznz_u64(&x3176, x3167, x3150, x3117);
+  fiat_p1024_cmovznz_u64(&x3177, x3167, x3152, x3119);
+  fiat_p1024_cmovznz_u64(&x3178, x3167, x3154, x3121);
+  fiat_p1024_cmovznz_u64(&x3179, x3167, x3156, x3123);
+  fiat_p1024_cmovznz_u64(&x3180, x3167, x3158, x3125);
+  fiat_p1024_cmovznz_u64(&x3181, x3167, x3160, x3127);
+  fiat_p1024_cmovznz_u64(&x3182, x3167, x3162, x3129);
+  fiat_p1024_cmovznz_u64(&x3183, x3167, x3164, x3131);
+  out1[0] = x3168;
+  out1[1] = x3169;
+  out1[2] = x3170;
+  out1[3] = x3171;
+  out1[4] = x3172;
+  out1[5] = x3173;
+  out1[6] = x3174;
+  out1[7] = x3175;
+  out1[8] = x3176;
+  out1[9] = x3177;
+  out1[10] = x3178;
+  out1[11] = x3179;
+  out1[12] = x3180;
+  out1[13] = x3181;
+  out1[14] = x3182;
+  out1[15] = x3183;
+}
+
+/*
+ * The function fiat_p1024_square squares a field element in the Montgomery domain.
+ *
+ * Preconditions:
+ *   0 ≤ eval arg1 < m
+ * Postconditions:
+ *   eval (from_montgomery out1) mod m = (eval (from_montgomery arg1) * eval (from_montgomery arg1)) mod m
+ *   0 ≤ eval out1 < m
+ *
+ */
+void fiat_p1024_square(fiat_p1024_montgomery_domain_field_element out1, const fiat_p1024_montgomery_domain_field_element arg1) {
+  uint64_t x1;
+  uint64_t x2;
+  uint64_t x3;
+  uint64_t x4;
+  uint64_t x5;
+  uint64_t x6;
+  uint64_t x7;
+  uint64_t x8;
+  uint64_t x9;
+  uint64_t x10;
+  uint64_t x11;
+  uint64_t x12;
+  uint64_t x13;
+  uint64_t x14;
+  uint64_t x15;
+  uint64_t x16;
+  uint64_t x17;
+  uint64_t x18;
+  uint64_t x19;
+  uint64_t x20;
+  uint64_t x21;
+  uint64_t x22;
+  uint64_t x23;
+  uint64_t x24;
+  uint64_t x25;
+  uint64_t x26;
+  uint64_t x27;
+  uint64_t x28;
+  uint64_t x29;
+  uint64_t x30;
+  uint64_t x31;
+  uint64_t x32;
+  uint64_t x33;
+  uint64_t x34;
+  uint64_t x35;
+  uint64_t x36;
+  uint64_t x37;
+  uint64_t x38;
+  uint64_t x39;
+  uint64_t x40;
+  uint64_t x41;
+  uint64_t x42;
+  uint64_t x43;
+  uint64_t x44;
+  uint64_t x45;
+  uint64_t x46;
+  uint64_t x47;
+  uint64_t x48;
+  uint64_t x49;
+  fiat_p1024_uint1 x50;
+  uint64_t x51;
+  fiat_p1024_uint1 x52;
+  uint64_t x53;
+  fiat_p1024_uint1 x54;
+  uint64_t x55;
+  fiat_p1024_uint1 x56;
+  uint64_t x57;
+  fiat_p1024_uint1 x58;
+  uint64_t x59;
+  fiat_p1024_uint1 x60;
+  uint64_t x61;
+  fiat_p1024_uint1 x62;
+  uint64_t x63;
+  fiat_p1024_uint1 x64;
+  uint64_t x65;
+  fiat_p1024_uint1 x66;
+  uint64_t x67;
+  fiat_p1024_uint1 x68;
+  uint64_t x69;
+  fiat_p1024_uint1 x70;
+  uint64_t x71;
+  fiat_p1024_uint1 x72;
+  uint64_t x73;
+  fiat_p1024_uint1 x74;
+  uint64_t x75;
+  fiat_p1024_uint1 x76;
+  uint64_t x77;
+  fiat_p1024_uint1 x78;
+  uint64_t x79;
+  uint64_t x80;
+  uint64_t x81;
+  uint64_t x82;
+  uint64_t x83;
+  uint64_t x84;
+  uint64_t x85;
+  uint64_t x86;
+  uint64_t x87;
+  uint64_t x88;
+  uint64_t x89;
+  uint64_t x90;
+  uint64_t x91;
+  uint64_t x92;
+  uint64_t x93;
+  uint64_t x94;
+  uint64_t x95;
+  uint64_t x96;
+  uint64_t x97;
+  uint64_t x98;
+  uint64_t x99;
+  uint64_t x100;
+  uint64_t x101;
+  uint64_t x102;
+  uint64_t x103;
+  uint64_t x104;
+  uint64_t x105;
+  uint64_t x106;
+  uint64_t x107;
+  uint64_t x108;
+  uint64_t x109;
+  uint64_t x110;
+  uint64_t x111;
+  uint64_t x112;
+  uint64_t x113;
+  uint64_t x114;
+  fiat_p1024_uint1 x115;
+  uint64_t x116;
+  fiat_p1024_uint1 x117;
+  uint64_t x118;
+  fiat_p1024_uint1 x119;
+  uint64_t x120;
+  fiat_p1024_uint1 x121;
+  uint64_t x122;
+  fiat_p1024_uint1 x123;
+  uint64_t x124;
+  fiat_p1024_uint1 x125;
+  uint64_t x126;
+  fiat_p1024_uint1 x127;
+  uint64_t x128;
+  fiat_p1024_uint1 x129;
+  uint64_t x130;
+  fiat_p1024_uint1 x131;
+  uint64_t x132;
+  fiat_p1024_uint1 x133;
+  uint64_t x134;
+  fiat_p1024_uint1 x135;
+  uint64_t x136;
+  fiat_p1024_uint1 x137;
+  uint64_t x138;
+  fiat_p1024_uint1 x139;
+  uint64_t x140;
+  fiat_p1024_uint1 x141;
+  uint64_t x142;
+  fiat_p1024_uint1 x143;
+  uint64_t x144;
+  uint64_t x145;
+  fiat_p1024_uint1 x146;
+  uint64_t x147;
+  fiat_p1024_uint1 x148;
+  uint64_t x149;
+  fiat_p1024_uint1 x150;
+  uint64_t x151;
+  fiat_p1024_uint1 x152;
+  uint64_t x153;
+  fiat_p1024_uint1 x154;
+  uint64_t x155;
+  fiat_p1024_uint1 x156;
+  uint64_t x157;
+  fiat_p1024_uint1 x158;
+  uint64_t x159;
+  fiat_p1024_uint1 x160;
+  uint64_t x161;
+  fiat_p1024_uint1 x162;
+  uint64_t x163;
+  fiat_p1024_uint1 x164;
+  uint64_t x165;
+  fiat_p1024_uint1 x166;
+  uint64_t x167;
+  fiat_p1024_uint1 x168;
+  uint64_t x169;
+  fiat_p1024_uint1 x170;
+  uint64_t x171;
+  fiat_p1024_uint1 x172;
+  uint64_t x173;
+  fiat_p1024_uint1 x174;
+  uint64_t x175;
+  fiat_p1024_uint1 x176;
+  uint64_t x177;
+  fiat_p1024_uint1 x178;
+  uint64_t x179;
+  uint64_t x180;
+  uint64_t x181;
+  uint64_t x182;
+  uint64_t x183;
+  uint64_t x184;
+  uint64_t x185;
+  uint64_t x186;
+  uint64_t x187;
+  uint64_t x188;
+  uint64_t x189;
+  uint64_t x190;
+  uint64_t x191;
+  uint64_t x192;
+  uint64_t x193;
+  uint64_t x194;
+  uint64_t x195;
+  uint64_t x196;
+  uint64_t x197;
+  uint64_t x198;
+  uint64_t x199;
+  uint64_t x200;
+  uint64_t x201;
+  uint64_t x202;
+  uint64_t x203;
+  uint64_t x204;
+  uint64_t x205;
+  uint64_t x206;
+  uint64_t x207;
+  uint64_t x208;
+  uint64_t x209;
+  uint64_t x210;
+  uint64_t x211;
+  fiat_p1024_uint1 x212;
+  uint64_t x213;
+  fiat_p1024_uint1 x214;
+  uint64_t x215;
+  fiat_p1024_uint1 x216;
+  uint64_t x217;
+  fiat_p1024_uint1 x218;
+  uint64_t x219;
+  fiat_p1024_uint1 x220;
+  uint64_t x221;
+  fiat_p1024_uint1 x222;
+  uint64_t x223;
+  fiat_p1024_uint1 x224;
+  uint64_t x225;
+  fiat_p1024_uint1 x226;
+  uint64_t x227;
+  fiat_p1024_uint1 x228;
+  uint64_t x229;
+  fiat_p1024_uint1 x230;
+  uint64_t x231;
+  fiat_p1024_uint1 x232;
+  uint64_t x233;
+  fiat_p1024_uint1 x234;
+  uint64_t x235;
+  fiat_p1024_uint1 x236;
+  uint64_t x237;
+  fiat_p1024_uint1 x238;
+  uint64_t x239;
+  fiat_p1024_uint1 x240;
+  uint64_t x241;
+  uint64_t x242;
+  fiat_p1024_uint1 x243;
+  uint64_t x244;
+  fiat_p1024_uint1 x245;
+  uint64_t x246;
+  fiat_p1024_uint1 x247;
+  uint64_t x248;
+  fiat_p1024_uint1 x249;
+  uint64_t x250;
+  fiat_p1024_uint1 x251;
+  uint64_t x252;
+  fiat_p1024_uint1 x253;
+  uint64_t x254;
+  fiat_p1024_uint1 x255;
+  uint64_t x256;
+  fiat_p1024_uint1 x257;
+  uint64_t x258;
+  fiat_p1024_uint1 x259;
+  uint64_t x260;
+  fiat_p1024_uint1 x261;
+  uint64_t x262;
+  fiat_p1024_uint1 x263;
+  uint64_t x264;
+  fiat_p1024_uint1 x265;
+  uint64_t x266;
+  fiat_p1024_uint1 x267;
+  uint64_t x268;
+  fiat_p1024_uint1 x269;
+  uint64_t x270;
+  fiat_p1024_uint1 x271;
+  uint64_t x272;
+  fiat_p1024_uint1 x273;
+  uint64_t x274;
+  fiat_p1024_uint1 x275;
+  uint64_t x276;
+  uint64_t x277;
+  uint64_t x278;
+  uint64_t x279;
+  uint64_t x280;
+  uint64_t x281;
+  uint64_t x282;
+  uint64_t x283;
+  uint64_t x284;
+  uint64_t x285;
+  uint64_t x286;
+  uint64_t x287;
+  uint64_t x288;
+  uint64_t x289;
+  uint64_t x290;
+  uint64_t x291;
+  uint64_t x292;
+  uint64_t x293;
+  uint64_t x294;
+  uint64_t x295;
+  uint64_t x296;
+  uint64_t x297;
+  uint64_t x298;
+  uint64_t x299;
+  uint64_t x300;
+  uint64_t x301;
+  uint64_t x302;
+  uint64_t x303;
+  uint64_t x304;
+  uint64_t x305;
+  uint64_t x306;
+  uint64_t x307;
+  uint64_t x308;
+  uint64_t x309;
+  uint64_t x310;
+  fiat_p1024_uint1 x311;
+  uint64_t x312;
+  fiat_p1024_uint1 x313;
+  uint64_t x314;
+  fiat_p1024_uint1 x315;
+  uint64_t x316;
+  fiat_p1024_uint1 x317;
+  uint64_t x318;
+  fiat_p1024_uint1 x319;
+  uint64_t x320;
+  fiat_p1024_uint1 x321;
+  uint64_t x322;
+  fiat_p1024_uint1 x323;
+  uint64_t x324;
+  fiat_p1024_uint1 x325;
+  uint64_t x326;
+  fiat_p1024_uint1 x327;
+  uint64_t x328;
+  fiat_p1024_uint1 x329;
+  uint64_t x330;
+  fiat_p1024_uint1 x331;
+  uint64_t x332;
+  fiat_p1024_uint1 x333;
+  uint64_t x334;
+  fiat_p1024_uint1 x335;
+  uint64_t x336;
+  fiat_p1024_uint1 x337;
+  uint64_t x338;
+  fiat_p1024_uint1 x339;
+  uint64_t x340;
+  uint64_t x341;
+  fiat_p1024_uint1 x342;
+  uint64_t x343;
+  fiat_p1024_uint1 x344;
+  uint64_t x345;
+  fiat_p1024_uint1 x346;
+  uint64_t x347;
+  fiat_p1024_uint1 x348;
+  uint64_t x349;
+  fiat_p1024_uint1 x350;
+  uint64_t x351;
+  fiat_p1024_uint1 x352;
+  uint64_t x353;
+  fiat_p1024_uint1 x354;
+  uint64_t x355;
+  fiat_p1024_uint1 x356;
+  uint64_t x357;
+  fiat_p1024_uint1 x358;
+  uint64_t x359;
+  fiat_p1024_uint1 x360;
+  uint64_t x361;
+  fiat_p1024_uint1 x362;
+  uint64_t x363;
+  fiat_p1024_uint1 x364;
+  uint64_t x365;
+  fiat_p1024_uint1 x366;
+  uint64_t x367;
+  fiat_p1024_uint1 x368;
+  uint64_t x369;
+  fiat_p1024_uint1 x370;
+  uint64_t x371;
+  fiat_p1024_uint1 x372;
+  uint64_t x373;
+  fiat_p1024_uint1 x374;
+  uint64_t x375;
+  uint64_t x376;
+  uint64_t x377;
+  uint64_t x378;
+  uint64_t x379;
+  uint64_t x380;
+  uint64_t x381;
+  uint64_t x382;
+  uint64_t x383;
+  uint64_t x384;
+  uint64_t x385;
+  uint64_t x386;
+  uint64_t x387;
+  uint64_t x388;
+  uint64_t x389;
+  uint64_t x390;
+  uint64_t x391;
+  uint64_t x392;
+  uint64_t x393;
+  uint64_t x394;
+  uint64_t x395;
+  uint64_t x396;
+  uint64_t x397;
+  uint64_t x398;
+  uint64_t x399;
+  uint64_t x400;
+  uint64_t x401;
+  uint64_t x402;
+  uint64_t x403;
+  uint64_t x404;
+  uint64_t x405;
+  uint64_t x406;
+  uint64_t x407;
+  uint64_t x408;
+  fiat_p1024_uint1 x409;
+  uint64_t x410;
+  fiat_p1024_uint1 x411;
+  uint64_t x412;
+  fiat_p1024_uint1 x413;
+  uint64_t x414;
+  fiat_p1024_uint1 x415;
+  uint64_t x416;
+  fiat_p1024_uint1 x417;
+  uint64_t x418;
+  fiat_p1024_uint1 x419;
+  uint64_t x420;
+  fiat_p1024_uint1 x421;
+  uint64_t x422;
+  fiat_p1024_uint1 x423;
+  uint64_t x424;
+  fiat_p1024_uint1 x425;
+  uint64_t x426;
+  fiat_p1024_uint1 x427;
+  uint64_t x428;
+  fiat_p1024_uint1 x429;
+  uint64_t x430;
+  fiat_p1024_uint1 x431;
+  uint64_t x432;
+  fiat_p1024_uint1 x433;
+  uint64_t x434;
+  fiat_p1024_uint1 x435;
+  uint64_t x436;
+  fiat_p1024_uint1 x437;
+  uint64_t x438;
+  uint64_t x439;
+  fiat_p1024_uint1 x440;
+  uint64_t x441;
+  fiat_p1024_uint1 x442;
+  uint64_t x443;
+  fiat_p1024_uint1 x444;
+  uint64_t x445;
+  fiat_p1024_uint1 x446;
+  uint64_t x447;
+  fiat_p1024_uint1 x448;
+  uint64_t x449;
+  fiat_p1024_uint1 x450;
+  uint64_t x451;
+  fiat_p1024_uint1 x452;
+  uint64_t x453;
+  fiat_p1024_uint1 x454;
+  uint64_t x455;
+  fiat_p1024_uint1 x456;
+  uint64_t x457;
+  fiat_p1024_uint1 x458;
+  uint64_t x459;
+  fiat_p1024_uint1 x460;
+  uint64_t x461;
+  fiat_p1024_uint1 x462;
+  uint64_t x463;
+  fiat_p1024_uint1 x464;
+  uint64_t x465;
+  fiat_p1024_uint1 x466;
+  uint64_t x467;
+  fiat_p1024_uint1 x468;
+  uint64_t x469;
+  fiat_p1024_uint1 x470;
+  uint64_t x471;
+  fiat_p1024_uint1 x472;
+  uint64_t x473;
+  uint64_t x474;
+  uint64_t x475;
+  uint64_t x476;
+  uint64_t x477;
+  uint64_t x478;
+  uint64_t x479;
+  uint64_t x480;
+  uint64_t x481;
+  uint64_t x482;
+  uint64_t x483;
+  uint64_t x484;
+  uint64_t x485;
+  uint64_t x486;
+  uint64_t x487;
+  uint64_t x488;
+  uint64_t x489;
+  uint64_t x490;
+  uint64_t x491;
+  uint64_t x492;
+  uint64_t x493;
+  uint64_t x494;
+  uint64_t x495;
+  uint64_t x496;
+  uint64_t x497;
+  uint64_t x498;
+  uint64_t x499;
+  uint64_t x500;
+  uint64_t x501;
+  uint64_t x502;
+  uint64_t x503;
+  uint64_t x504;
+  uint64_t x505;
+  uint64_t x506;
+  uint64_t x507;
+  fiat_p1024_uint1 x508;
+  uint64_t x509;
+  fiat_p1024_uint1 x510;
+  uint64_t x511;
+  fiat_p1024_uint1 x512;
+  uint64_t x513;
+  fiat_p1024_uint1 x514;
+  uint64_t x515;
+  fiat_p1024_uint1 x516;
+  uint64_t x517;
+  fiat_p1024_uint1 x518;
+  uint64_t x519;
+  fiat_p1024_uint1 x520;
+  uint64_t x521;
+  fiat_p1024_uint1 x522;
+  uint64_t x523;
+  fiat_p1024_uint1 x524;
+  uint64_t x525;
+  fiat_p1024_uint1 x526;
+  uint64_t x527;
+  fiat_p1024_uint1 x528;
+  uint64_t x529;
+  fiat_p1024_uint1 x530;
+  uint64_t x531;
+  fiat_p1024_uint1 x532;
+  uint64_t x533;
+  fiat_p1024_uint1 x534;
+  uint64_t x535;
+  fiat_p1024_uint1 x536;
+  uint64_t x537;
+  uint64_t x538;
+  fiat_p1024_uint1 x539;
+  uint64_t x540;
+  fiat_p1024_uint1 x541;
+  uint64_t x542;
+  fiat_p1024_uint1 x543;
+  uint64_t x544;
+  fiat_p1024_uint1 x545;
+  uint64_t x546;
+  fiat_p1024_uint1 x547;
+  uint64_t x548;
+  fiat_p1024_uint1 x549;
+  uint64_t x550;
+  fiat_p1024_uint1 x551;
+  uint64_t x552;
+  fiat_p1024_uint1 x553;
+  uint64_t x554;
+  fiat_p1024_uint1 x555;
+  uint64_t x556;
+  fiat_p1024_uint1 x557;
+  uint64_t x558;
+  fiat_p1024_uint1 x559;
+  uint64_t x560;
+  fiat_p1024_uint1 x561;
+  uint64_t x562;
+  fiat_p1024_uint1 x563;
+  uint64_t x564;
+  fiat_p1024_uint1 x565;
+  uint64_t x566;
+  fiat_p1024_uint1 x567;
+  uint64_t x568;
+  fiat_p1024_uint1 x569;
+  uint64_t x570;
+  fiat_p1024_uint1 x571;
+  uint64_t x572;
+  uint64_t x573;
+  uint64_t x574;
+  uint64_t x575;
+  uint64_t x576;
+  uint64_t x577;
+  uint64_t x578;
+  uint64_t x579;
+  uint64_t x580;
+  uint64_t x581;
+  uint64_t x582;
+  uint64_t x583;
+  uint64_t x584;
+  uint64_t x585;
+  uint64_t x586;
+  uint64_t x587;
+  uint64_t x588;
+  uint64_t x589;
+  uint64_t x590;
+  uint64_t x591;
+  uint64_t x592;
+  uint64_t x593;
+  uint64_t x594;
+  uint64_t x595;
+  uint64_t x596;
+  uint64_t x597;
+  uint64_t x598;
+  uint64_t x599;
+  uint64_t x600;
+  uint64_t x601;
+  uint64_t x602;
+  uint64_t x603;
+  uint64_t x604;
+  uint64_t x605;
+  fiat_p1024_uint1 x606;
+  uint64_t x607;
+  fiat_p1024_uint1 x608;
+  uint64_t x609;
+  fiat_p1024_uint1 x610;
+  uint64_t x611;
+  fiat_p1024_uint1 x612;
+  uint64_t x613;
+  fiat_p1024_uint1 x614;
+  uint64_t x615;
+  fiat_p1024_uint1 x616;
+  uint64_t x617;
+  fiat_p1024_uint1 x618;
+  uint64_t x619;
+  fiat_p1024_uint1 x620;
+  uint64_t x621;
+  fiat_p1024_uint1 x622;
+  uint64_t x623;
+  fiat_p1024_uint1 x624;
+  uint64_t x625;
+  fiat_p1024_uint1 x626;
+  uint64_t x627;
+  fiat_p1024_uint1 x628;
+  uint64_t x629;
+  fiat_p1024_uint1 x630;
+  uint64_t x631;
+  fiat_p1024_uint1 x632;
+  uint64_t x633;
+  fiat_p1024_uint1 x634;
+  uint64_t x635;
+  uint64_t x636;
+  fiat_p1024_uint1 x637;
+  uint64_t x638;
+  fiat_p1024_uint1 x639;
+  uint64_t x640;
+  fiat_p1024_uint1 x641;
+  uint64_t x642;
+  fiat_p1024_uint1 x643;
+  uint64_t x644;
+  fiat_p1024_uint1 x645;
+  uint64_t x646;
+  fiat_p1024_uint1 x647;
+  uint64_t x648;
+  fiat_p1024_uint1 x649;
+  uint64_t x650;
+  fiat_p1024_uint1 x651;
+  uint64_t x652;
+  fiat_p1024_uint1 x653;
+  uint64_t x654;
+  fiat_p1024_uint1 x655;
+  uint64_t x656;
+  fiat_p1024_uint1 x657;
+  uint64_t x658;
+  fiat_p1024_uint1 x659;
+  uint64_t x660;
+  fiat_p1024_uint1 x661;
+  uint64_t x662;
+  fiat_p1024_uint1 x663;
+  uint64_t x664;
+  fiat_p1024_uint1 x665;
+  uint64_t x666;
+  fiat_p1024_uint1 x667;
+  uint64_t x668;
+  fiat_p1024_uint1 x669;
+  uint64_t x670;
+  uint64_t x671;
+  uint64_t x672;
+  uint64_t x673;
+  uint64_t x674;
+  uint64_t x675;
+  uint64_t x676;
+  uint64_t x677;
+  uint64_t x678;
+  uint64_t x679;
+  uint64_t x680;
+  uint64_t x681;
+  uint64_t x682;
+  uint64_t x683;
+  uint64_t x684;
+  uint64_t x685;
+  uint64_t x686;
+  uint64_t x687;
+  uint64_t x688;
+  uint64_t x689;
+  uint64_t x690;
+  uint64_t x691;
+  uint64_t x692;
+  uint64_t x693;
+  uint64_t x694;
+  uint64_t x695;
+  uint64_t x696;
+  uint64_t x697;
+  uint64_t x698;
+  uint64_t x699;
+  uint64_t x700;
+  uint64_t x701;
+  uint64_t x702;
+  uint64_t x703;
+  uint64_t x704;
+  fiat_p1024_uint1 x705;
+  uint64_t x706;
+  fiat_p1024_uint1 x707;
+  uint64_t x708;
+  fiat_p1024_uint1 x709;
+  uint64_t x710;
+  fiat_p1024_uint1 x711;
+  uint64_t x712;
+  fiat_p1024_uint1 x713;
+  uint64_t x714;
+  fiat_p1024_uint1 x715;
+  uint64_t x716;
+  fiat_p1024_uint1 x717;
+  uint64_t x718;
+  fiat_p1024_uint1 x719;
+  uint64_t x720;
+  fiat_p1024_uint1 x721;
+  uint64_t x722;
+  fiat_p1024_uint1 x723;
+  uint64_t x724;
+  fiat_p1024_uint1 x725;
+  uint64_t x726;
+  fiat_p1024_uint1 x727;
+  uint64_t x728;
+  fiat_p1024_uint1 x729;
+  uint64_t x730;
+  fiat_p1024_uint1 x731;
+  uint64_t x732;
+  fiat_p1024_uint1 x733;
+  uint64_t x734;
+  uint64_t x735;
+  fiat_p1024_uint1 x736;
+  uint64_t x737;
+  fiat_p1024_uint1 x738;
+  uint64_t x739;
+  fiat_p1024_uint1 x740;
+  uint64_t x741;
+  fiat_p1024_uint1 x742;
+  uint64_t x743;
+  fiat_p1024_uint1 x744;
+  uint64_t x745;
+  fiat_p1024_uint1 x746;
+  uint64_t x747;
+  fiat_p1024_uint1 x748;
+  uint64_t x749;
+  fiat_p1024_uint1 x750;
+  uint64_t x751;
+  fiat_p1024_uint1 x752;
+  uint64_t x753;
+  fiat_p1024_uint1 x754;
+  uint64_t x755;
+  fiat_p1024_uint1 x756;
+  uint64_t x757;
+  fiat_p1024_uint1 x758;
+  uint64_t x759;
+  fiat_p1024_uint1 x760;
+  uint64_t x761;
+  fiat_p1024_uint1 x762;
+  uint64_t x763;
+  fiat_p1024_uint1 x764;
+  uint64_t x765;
+  fiat_p1024_uint1 x766;
+  uint64_t x767;
+  fiat_p1024_uint1 x768;
+  uint64_t x769;
+  uint64_t x770;
+  uint64_t x771;
+  uint64_t x772;
+  uint64_t x773;
+  uint64_t x774;
+  uint64_t x775;
+  uint64_t x776;
+  uint64_t x777;
+  uint64_t x778;
+  uint64_t x779;
+  uint64_t x780;
+  uint64_t x781;
+  uint64_t x782;
+  uint64_t x783;
+  uint64_t x784;
+  uint64_t x785;
+  uint64_t x786;
+  uint64_t x787;
+  uint64_t x788;
+  uint64_t x789;
+  uint64_t x790;
+  uint64_t x791;
+  uint64_t x792;
+  uint64_t x793;
+  uint64_t x794;
+  uint64_t x795;
+  uint64_t x796;
+  uint64_t x797;
+  uint64_t x798;
+  uint64_t x799;
+  uint64_t x800;
+  uint64_t x801;
+  uint64_t x802;
+  fiat_p1024_uint1 x803;
+  uint64_t x804;
+  fiat_p1024_uint1 x805;
+  uint64_t x806;
+  fiat_p1024_uint1 x807;
+  uint64_t x808;
+  fiat_p1024_uint1 x809;
+  uint64_t x810;
+  fiat_p1024_uint1 x811;
+  uint64_t x812;
+  fiat_p1024_uint1 x813;
+  uint64_t x814;
+  fiat_p1024_uint1 x815;
+  uint64_t x816;
+  fiat_p1024_uint1 x817;
+  uint64_t x818;
+  fiat_p1024_uint1 x819;
+  uint64_t x820;
+  fiat_p1024_uint1 x821;
+  uint64_t x822;
+  fiat_p1024_uint1 x823;
+  uint64_t x824;
+  fiat_p1024_uint1 x825;
+  uint64_t x826;
+  fiat_p1024_uint1 x827;
+  uint64_t x828;
+  fiat_p1024_uint1 x829;
+  uint64_t x830;
+  fiat_p1024_uint1 x831;
+  uint64_t x832;
+  uint64_t x833;
+  fiat_p1024_uint1 x834;
+  uint64_t x835;
+  fiat_p1024_uint1 x836;
+  uint64_t x837;
+  fiat_p1024_uint1 x838;
+  uint64_t x839;
+  fiat_p1024_uint1 x840;
+  uint64_t x841;
+  fiat_p1024_uint1 x842;
+  uint64_t x843;
+  fiat_p1024_uint1 x844;
+  uint64_t x845;
+  fiat_p1024_uint1 x846;
+  uint64_t x847;
+  fiat_p1024_uint1 x848;
+  uint64_t x849;
+  fiat_p1024_uint1 x850;
+  uint64_t x851;
+  fiat_p1024_uint1 x852;
+  uint64_t x853;
+  fiat_p1024_uint1 x854;
+  uint64_t x855;
+  fiat_p1024_uint1 x856;
+  uint64_t x857;
+  fiat_p1024_uint1 x858;
+  uint64_t x859;
+  fiat_p1024_uint1 x860;
+  uint64_t x861;
+  fiat_p1024_uint1 x862;
+  uint64_t x863;
+  fiat_p1024_uint1 x864;
+  uint64_t x865;
+  fiat_p1024_uint1 x866;
+  uint64_t x867;
+  uint64_t x868;
+  uint64_t x869;
+  uint64_t x870;
+  uint64_t x871;
+  uint64_t x872;
+  uint64_t x873;
+  uint64_t x874;
+  uint64_t x875;
+  uint64_t x876;
+  uint64_t x877;
+  uint64_t x878;
+  uint64_t x879;
+  uint64_t x880;
+  uint64_t x881;
+  uint64_t x882;
+  uint64_t x883;
+  uint64_t x884;
+  uint64_t x885;
+  uint64_t x886;
+  uint64_t x887;
+  uint64_t x888;
+  uint64_t x889;
+  uint64_t x890;
+  uint64_t x891;
+  uint64_t x892;
+  uint64_t x893;
+  uint64_t x894;
+  uint64_t x895;
+  uint64_t x896;
+  uint64_t x897;
+  uint64_t x898;
+  uint64_t x899;
+  uint64_t x900;
+  uint64_t x901;
+  fiat_p1024_uint1 x902;
+  uint64_t x903;
+  fiat_p1024_uint1 x904;
+  uint64_t x905;
+  fiat_p1024_uint1 x906;
+  uint64_t x907;
+  fiat_p1024_uint1 x908;
+  uint64_t x909;
+  fiat_p1024_uint1 x910;
+  uint64_t x911;
+  fiat_p1024_uint1 x912;
+  uint64_t x913;
+  fiat_p1024_uint1 x914;
+  uint64_t x915;
+  fiat_p1024_uint1 x916;
+  uint64_t x917;
+  fiat_p1024_uint1 x918;
+  uint64_t x919;
+  fiat_p1024_uint1 x920;
+  uint64_t x921;
+  fiat_p1024_uint1 x922;
+  uint64_t x923;
+  fiat_p1024_uint1 x924;
+  uint64_t x925;
+  fiat_p1024_uint1 x926;
+  uint64_t x927;
+  fiat_p1024_uint1 x928;
+  uint64_t x929;
+  fiat_p1024_uint1 x930;
+  uint64_t x931;
+  uint64_t x932;
+  fiat_p1024_uint1 x933;
+  uint64_t x934;
+  fiat_p1024_uint1 x935;
+  uint64_t x936;
+  fiat_p1024_uint1 x937;
+  uint64_t x938;
+  fiat_p1024_uint1 x939;
+  uint64_t x940;
+  fiat_p1024_uint1 x941;
+  uint64_t x942;
+  fiat_p1024_uint1 x943;
+  uint64_t x944;
+  fiat_p1024_uint1 x945;
+  uint64_t x946;
+  fiat_p1024_uint1 x947;
+  uint64_t x948;
+  fiat_p1024_uint1 x949;
+  uint64_t x950;
+  fiat_p1024_uint1 x951;
+  uint64_t x952;
+  fiat_p1024_uint1 x953;
+  uint64_t x954;
+  fiat_p1024_uint1 x955;
+  uint64_t x956;
+  fiat_p1024_uint1 x957;
+  uint64_t x958;
+  fiat_p1024_uint1 x959;
+  uint64_t x960;
+  fiat_p1024_uint1 x961;
+  uint64_t x962;
+  fiat_p1024_uint1 x963;
+  uint64_t x964;
+  fiat_p1024_uint1 x965;
+  uint64_t x966;
+  uint64_t x967;
+  uint64_t x968;
+  uint64_t x969;
+  uint64_t x970;
+  uint64_t x971;
+  uint64_t x972;
+  uint64_t x973;
+  uint64_t x974;
+  uint64_t x975;
+  uint64_t x976;
+  uint64_t x977;
+  uint64_t x978;
+  uint64_t x979;
+  uint64_t x980;
+  uint64_t x981;
+  uint64_t x982;
+  uint64_t x983;
+  uint64_t x984;
+  uint64_t x985;
+  uint64_t x986;
+  uint64_t x987;
+  uint64_t x988;
+  uint64_t x989;
+  uint64_t x990;
+  uint64_t x991;
+  uint64_t x992;
+  uint64_t x993;
+  uint64_t x994;
+  uint64_t x995;
+  uint64_t x996;
+  uint64_t x997;
+  uint64_t x998;
+  uint64_t x999;
+  fiat_p1024_uint1 x1000;
+  uint64_t x1001;
+  fiat_p1024_uint1 x1002;
+  uint64_t x1003;
+  fiat_p1024_uint1 x1004;
+  uint64_t x1005;
+  fiat_p1024_uint1 x1006;
+  uint64_t x1007;
+  fiat_p1024_uint1 x1008;
+  uint64_t x1009;
+  fiat_p1024_uint1 x1010;
+  uint64_t x1011;
+  fiat_p1024_uint1 x1012;
+  uint64_t x1013;
+  fiat_p1024_uint1 x1014;
+  uint64_t x1015;
+  fiat_p1024_uint1 x1016;
+  uint64_t x1017;
+  fiat_p1024_uint1 x1018;
+  uint64_t x1019;
+  fiat_p1024_uint1 x1020;
+  uint64_t x1021;
+  fiat_p1024_uint1 x1022;
+  uint64_t x1023;
+  fiat_p1024_uint1 x1024;
+  uint64_t x1025;
+  fiat_p1024_uint1 x1026;
+  uint64_t x1027;
+  fiat_p1024_uint1 x1028;
+  uint64_t x1029;
+  uint64_t x1030;
+  fiat_p1024_uint1 x1031;
+  uint64_t x1032;
+  fiat_p1024_uint1 x1033;
+  uint64_t x1034;
+  fiat_p1024_uint1 x1035;
+  uint64_t x1036;
+  fiat_p1024_uint1 x1037;
+  uint64_t x1038;
+  fiat_p1024_uint1 x1039;
+  uint64_t x1040;
+  fiat_p1024_uint1 x1041;
+  uint64_t x1042;
+  fiat_p1024_uint1 x1043;
+  uint64_t x1044;
+  fiat_p1024_uint1 x1045;
+  uint64_t x1046;
+  fiat_p1024_uint1 x1047;
+  uint64_t x1048;
+  fiat_p1024_uint1 x1049;
+  uint64_t x1050;
+  fiat_p1024_uint1 x1051;
+  uint64_t x1052;
+  fiat_p1024_uint1 x1053;
+  uint64_t x1054;
+  fiat_p1024_uint1 x1055;
+  uint64_t x1056;
+  fiat_p1024_uint1 x1057;
+  uint64_t x1058;
+  fiat_p1024_uint1 x1059;
+  uint64_t x1060;
+  fiat_p1024_uint1 x1061;
+  uint64_t x1062;
+  fiat_p1024_uint1 x1063;
+  uint64_t x1064;
+  uint64_t x1065;
+  uint64_t x1066;
+  uint64_t x1067;
+  uint64_t x1068;
+  uint64_t x1069;
+  uint64_t x1070;
+  uint64_t x1071;
+  uint64_t x1072;
+  uint64_t x1073;
+  uint64_t x1074;
+  uint64_t x1075;
+  uint64_t x1076;
+  uint64_t x1077;
+  uint64_t x1078;
+  uint64_t x1079;
+  uint64_t x1080;
+  uint64_t x1081;
+  uint64_t x1082;
+  uint64_t x1083;
+  uint64_t x1084;
+  uint64_t x1085;
+  uint64_t x1086;
+  uint64_t x1087;
+  uint64_t x1088;
+  uint64_t x1089;
+  uint64_t x1090;
+  uint64_t x1091;
+  uint64_t x1092;
+  uint64_t x1093;
+  uint64_t x1094;
+  uint64_t x1095;
+  uint64_t x1096;
+  uint64_t x1097;
+  uint64_t x1098;
+  fiat_p1024_uint1 x1099;
+  uint64_t x1100;
+  fiat_p1024_uint1 x1101;
+  uint64_t x1102;
+  fiat_p1024_uint1 x1103;
+  uint64_t x1104;
+  fiat_p1024_uint1 x1105;
+  uint64_t x1106;
+  fiat_p1024_uint1 x1107;
+  uint64_t x1108;
+  fiat_p1024_uint1 x1109;
+  uint64_t x1110;
+  fiat_p1024_uint1 x1111;
+  uint64_t x1112;
+  fiat_p1024_uint1 x1113;
+  uint64_t x1114;
+  fiat_p1024_uint1 x1115;
+  uint64_t x1116;
+  fiat_p1024_uint1 x1117;
+  uint64_t x1118;
+  fiat_p1024_uint1 x1119;
+  uint64_t x1120;
+  fiat_p1024_uint1 x1121;
+  uint64_t x1122;
+  fiat_p1024_uint1 x1123;
+  uint64_t x1124;
+  fiat_p1024_uint1 x1125;
+  uint64_t x1126;
+  fiat_p1024_uint1 x1127;
+  uint64_t x1128;
+  uint64_t x1129;
+  fiat_p1024_uint1 x1130;
+  uint64_t x1131;
+  fiat_p1024_uint1 x1132;
+  uint64_t x1133;
+  fiat_p1024_uint1 x1134;
+  uint64_t x1135;
+  fiat_p1024_uint1 x1136;
+  uint64_t x1137;
+  fiat_p1024_uint1 x1138;
+  uint64_t x1139;
+  fiat_p1024_uint1 x1140;
+  uint64_t x1141;
+  fiat_p1024_uint1 x1142;
+  uint64_t x1143;
+  fiat_p1024_uint1 x1144;
+  uint64_t x1145;
+  fiat_p1024_uint1 x1146;
+  uint64_t x1147;
+  fiat_p1024_uint1 x1148;
+  uint64_t x1149;
+  fiat_p1024_uint1 x1150;
+  uint64_t x1151;
+  fiat_p1024_uint1 x1152;
+  uint64_t x1153;
+  fiat_p1024_uint1 x1154;
+  uint64_t x1155;
+  fiat_p1024_uint1 x1156;
+  uint64_t x1157;
+  fiat_p1024_uint1 x1158;
+  uint64_t x1159;
+  fiat_p1024_uint1 x1160;
+  uint64_t x1161;
+  fiat_p1024_uint1 x1162;
+  uint64_t x1163;
+  uint64_t x1164;
+  uint64_t x1165;
+  uint64_t x1166;
+  uint64_t x1167;
+  uint64_t x1168;
+  uint64_t x1169;
+  uint64_t x1170;
+  uint64_t x1171;
+  uint64_t x1172;
+  uint64_t x1173;
+  uint64_t x1174;
+  uint64_t x1175;
+  uint64_t x1176;
+  uint64_t x1177;
+  uint64_t x1178;
+  uint64_t x1179;
+  uint64_t x1180;
+  uint64_t x1181;
+  uint64_t x1182;
+  uint64_t x1183;
+  uint64_t x1184;
+  uint64_t x1185;
+  uint64_t x1186;
+  uint64_t x1187;
+  uint64_t x1188;
+  uint64_t x1189;
+  uint64_t x1190;
+  uint64_t x1191;
+  uint64_t x1192;
+  uint64_t x1193;
+  uint64_t x1194;
+  uint64_t x1195;
+  uint64_t x1196;
+  fiat_p1024_uint1 x1197;
+  uint64_t x1198;
+  fiat_p1024_uint1 x1199;
+  uint64_t x1200;
+  fiat_p1024_uint1 x1201;
+  uint64_t x1202;
+  fiat_p1024_uint1 x1203;
+  uint64_t x1204;
+  fiat_p1024_uint1 x1205;
+  uint64_t x1206;
+  fiat_p1024_uint1 x1207;
+  uint64_t x1208;
+  fiat_p1024_uint1 x1209;
+  uint64_t x1210;
+  fiat_p1024_uint1 x1211;
+  uint64_t x1212;
+  fiat_p1024_uint1 x1213;
+  uint64_t x1214;
+  fiat_p1024_uint1 x1215;
+  uint64_t x1216;
+  fiat_p1024_uint1 x1217;
+  uint64_t x1218;
+  fiat_p1024_uint1 x1219;
+  uint64_t x1220;
+  fiat_p1024_uint1 x1221;
+  uint64_t x1222;
+  fiat_p1024_uint1 x1223;
+  uint64_t x1224;
+  fiat_p1024_uint1 x1225;
+  uint64_t x1226;
+  uint64_t x1227;
+  fiat_p1024_uint1 x1228;
+  uint64_t x1229;
+  fiat_p1024_uint1 x1230;
+  uint64_t x1231;
+  fiat_p1024_uint1 x1232;
+  uint64_t x1233;
+  fiat_p1024_uint1 x1234;
+  uint64_t x1235;
+  fiat_p1024_uint1 x1236;
+  uint64_t x1237;
+  fiat_p1024_uint1 x1238;
+  uint64_t x1239;
+  fiat_p1024_uint1 x1240;
+  uint64_t x1241;
+  fiat_p1024_uint1 x1242;
+  uint64_t x1243;
+  fiat_p1024_uint1 x1244;
+  uint64_t x1245;
+  fiat_p1024_uint1 x1246;
+  uint64_t x1247;
+  fiat_p1024_uint1 x1248;
+  uint64_t x1249;
+  fiat_p1024_uint1 x1250;
+  uint64_t x1251;
+  fiat_p1024_uint1 x1252;
+  uint64_t x1253;
+  fiat_p1024_uint1 x1254;
+  uint64_t x1255;
+  fiat_p1024_uint1 x1256;
+  uint64_t x1257;
+  fiat_p1024_uint1 x1258;
+  uint64_t x1259;
+  fiat_p1024_uint1 x1260;
+  uint64_t x1261;
+  uint64_t x1262;
+  uint64_t x1263;
+  uint64_t x1264;
+  uint64_t x1265;
+  uint64_t x1266;
+  uint64_t x1267;
+  uint64_t x1268;
+  uint64_t x1269;
+  uint64_t x1270;
+  uint64_t x1271;
+  uint64_t x1272;
+  uint64_t x1273;
+  uint64_t x1274;
+  uint64_t x1275;
+  uint64_t x1276;
+  uint64_t x1277;
+  uint64_t x1278;
+  uint64_t x1279;
+  uint64_t x1280;
+  uint64_t x1281;
+  uint64_t x1282;
+  uint64_t x1283;
+  uint64_t x1284;
+  uint64_t x1285;
+  uint64_t x1286;
+  uint64_t x1287;
+  uint64_t x1288;
+  uint64_t x1289;
+  uint64_t x1290;
+  uint64_t x1291;
+  uint64_t x1292;
+  uint64_t x1293;
+  uint64_t x1294;
+  uint64_t x1295;
+  fiat_p1024_uint1 x1296;
+  uint64_t x1297;
+  fiat_p1024_uint1 x1298;
+  uint64_t x1299;
+  fiat_p1024_uint1 x1300;
+  uint64_t x1301;
+  fiat_p1024_uint1 x1302;
+  uint64_t x1303;
+  fiat_p1024_uint1 x1304;
+  uint64_t x1305;
+  fiat_p1024_uint1 x1306;
+  uint64_t x1307;
+  fiat_p1024_uint1 x1308;
+  uint64_t x1309;
+  fiat_p1024_uint1 x1310;
+  uint64_t x1311;
+  fiat_p1024_uint1 x1312;
+  uint64_t x1313;
+  fiat_p1024_uint1 x1314;
+  uint64_t x1315;
+  fiat_p1024_uint1 x1316;
+  uint64_t x1317;
+  fiat_p1024_uint1 x1318;
+  uint64_t x1319;
+  fiat_p1024_uint1 x1320;
+  uint64_t x1321;
+  fiat_p1024_uint1 x1322;
+  uint64_t x1323;
+  fiat_p1024_uint1 x1324;
+  uint64_t x1325;
+  uint64_t x1326;
+  fiat_p1024_uint1 x1327;
+  uint64_t x1328;
+  fiat_p1024_uint1 x1329;
+  uint64_t x1330;
+  fiat_p1024_uint1 x1331;
+  uint64_t x1332;
+  fiat_p1024_uint1 x1333;
+  uint64_t x1334;
+  fiat_p1024_uint1 x1335;
+  uint64_t x1336;
+  fiat_p1024_uint1 x1337;
+  uint64_t x1338;
+  fiat_p1024_uint1 x1339;
+  uint64_t x1340;
+  fiat_p1024_uint1 x1341;
+  uint64_t x1342;
+  fiat_p1024_uint1 x1343;
+  uint64_t x1344;
+  fiat_p1024_uint1 x1345;
+  uint64_t x1346;
+  fiat_p1024_uint1 x1347;
+  uint64_t x1348;
+  fiat_p1024_uint1 x1349;
+  uint64_t x1350;
+  fiat_p1024_uint1 x1351;
+  uint64_t x1352;
+  fiat_p1024_uint1 x1353;
+  uint64_t x1354;
+  fiat_p1024_uint1 x1355;
+  uint64_t x1356;
+  fiat_p1024_uint1 x1357;
+  uint64_t x1358;
+  fiat_p1024_uint1 x1359;
+  uint64_t x1360;
+  uint64_t x1361;
+  uint64_t x1362;
+  uint64_t x1363;
+  uint64_t x1364;
+  uint64_t x1365;
+  uint64_t x1366;
+  uint64_t x1367;
+  uint64_t x1368;
+  uint64_t x1369;
+  uint64_t x1370;
+  uint64_t x1371;
+  uint64_t x1372;
+  uint64_t x1373;
+  uint64_t x1374;
+  uint64_t x1375;
+  uint64_t x1376;
+  uint64_t x1377;
+  uint64_t x1378;
+  uint64_t x1379;
+  uint64_t x1380;
+  uint64_t x1381;
+  uint64_t x1382;
+  uint64_t x1383;
+  uint64_t x1384;
+  uint64_t x1385;
+  uint64_t x1386;
+  uint64_t x1387;
+  uint64_t x1388;
+  uint64_t x1389;
+  uint64_t x1390;
+  uint64_t x1391;
+  uint64_t x1392;
+  uint64_t x1393;
+  fiat_p1024_uint1 x1394;
+  uint64_t x1395;
+  fiat_p1024_uint1 x1396;
+  uint64_t x1397;
+  fiat_p1024_uint1 x1398;
+  uint64_t x1399;
+  fiat_p1024_uint1 x1400;
+  uint64_t x1401;
+  fiat_p1024_uint1 x1402;
+  uint64_t x1403;
+  fiat_p1024_uint1 x1404;
+  uint64_t x1405;
+  fiat_p1024_uint1 x1406;
+  uint64_t x1407;
+  fiat_p1024_uint1 x1408;
+  uint64_t x1409;
+  fiat_p1024_uint1 x1410;
+  uint64_t x1411;
+  fiat_p1024_uint1 x1412;
+  uint64_t x1413;
+  fiat_p1024_uint1 x1414;
+  uint64_t x1415;
+  fiat_p1024_uint1 x1416;
+  uint64_t x1417;
+  fiat_p1024_uint1 x1418;
+  uint64_t x1419;
+  fiat_p1024_uint1 x1420;
+  uint64_t x1421;
+  fiat_p1024_uint1 x1422;
+  uint64_t x1423;
+  uint64_t x1424;
+  fiat_p1024_uint1 x1425;
+  uint64_t x1426;
+  fiat_p1024_uint1 x1427;
+  uint64_t x1428;
+  fiat_p1024_uint1 x1429;
+  uint64_t x1430;
+  fiat_p1024_uint1 x1431;
+  uint64_t x1432;
+  fiat_p1024_uint1 x1433;
+  uint64_t x1434;
+  fiat_p1024_uint1 x1435;
+  uint64_t x1436;
+  fiat_p1024_uint1 x1437;
+  uint64_t x1438;
+  fiat_p1024_uint1 x1439;
+  uint64_t x1440;
+  fiat_p1024_uint1 x1441;
+  uint64_t x1442;
+  fiat_p1024_uint1 x1443;
+  uint64_t x1444;
+  fiat_p1024_uint1 x1445;
+  uint64_t x1446;
+  fiat_p1024_uint1 x1447;
+  uint64_t x1448;
+  fiat_p1024_uint1 x1449;
+  uint64_t x1450;
+  fiat_p1024_uint1 x1451;
+  uint64_t x1452;
+  fiat_p1024_uint1 x1453;
+  uint64_t x1454;
+  fiat_p1024_uint1 x1455;
+  uint64_t x1456;
+  fiat_p1024_uint1 x1457;
+  uint64_t x1458;
+  uint64_t x1459;
+  uint64_t x1460;
+  uint64_t x1461;
+  uint64_t x1462;
+  uint64_t x1463;
+  uint64_t x1464;
+  uint64_t x1465;
+  uint64_t x1466;
+  uint64_t x1467;
+  uint64_t x1468;
+  uint64_t x1469;
+  uint64_t x1470;
+  uint64_t x1471;
+  uint64_t x1472;
+  uint64_t x1473;
+  uint64_t x1474;
+  uint64_t x1475;
+  uint64_t x1476;
+  uint64_t x1477;
+  uint64_t x1478;
+  uint64_t x1479;
+  uint64_t x1480;
+  uint64_t x1481;
+  uint64_t x1482;
+  uint64_t x1483;
+  uint64_t x1484;
+  uint64_t x1485;
+  uint64_t x1486;
+  uint64_t x1487;
+  uint64_t x1488;
+  uint64_t x1489;
+  uint64_t x1490;
+  uint64_t x1491;
+  uint64_t x1492;
+  fiat_p1024_uint1 x1493;
+  uint64_t x1494;
+  fiat_p1024_uint1 x1495;
+  uint64_t x1496;
+  fiat_p1024_uint1 x1497;
+  uint64_t x1498;
+  fiat_p1024_uint1 x1499;
+  uint64_t x1500;
+  fiat_p1024_uint1 x1501;
+  uint64_t x1502;
+  fiat_p1024_uint1 x1503;
+  uint64_t x1504;
+  fiat_p1024_uint1 x1505;
+  uint64_t x1506;
+  fiat_p1024_uint1 x1507;
+  uint64_t x1508;
+  fiat_p1024_uint1 x1509;
+  uint64_t x1510;
+  fiat_p1024_uint1 x1511;
+  uint64_t x1512;
+  fiat_p1024_uint1 x1513;
+  uint64_t x1514;
+  fiat_p1024_uint1 x1515;
+  uint64_t x1516;
+  fiat_p1024_uint1 x1517;
+  uint64_t x1518;
+  fiat_p1024_uint1 x1519;
+  uint64_t x1520;
+  fiat_p1024_uint1 x1521;
+  uint64_t x1522;
+  uint64_t x1523;
+  fiat_p1024_uint1 x1524;
+  uint64_t x1525;
+  fiat_p1024_uint1 x1526;
+  uint64_t x1527;
+  fiat_p1024_uint1 x1528;
+  uint64_t x1529;
+  fiat_p1024_uint1 x1530;
+  uint64_t x1531;
+  fiat_p1024_uint1 x1532;
+  uint64_t x1533;
+  fiat_p1024_uint1 x1534;
+  uint64_t x1535;
+  fiat_p1024_uint1 x1536;
+  uint64_t x1537;
+  fiat_p1024_uint1 x1538;
+  uint64_t x1539;
+  fiat_p1024_uint1 x1540;
+  uint64_t x1541;
+  fiat_p1024_uint1 x1542;
+  uint64_t x1543;
+  fiat_p1024_uint1 x1544;
+  uint64_t x1545;
+  fiat_p1024_uint1 x1546;
+  uint64_t x1547;
+  fiat_p1024_uint1 x1548;
+  uint64_t x1549;
+  fiat_p1024_uint1 x1550;
+  uint64_t x1551;
+  fiat_p1024_uint1 x1552;
+  uint64_t x1553;
+  fiat_p1024_uint1 x1554;
+  uint64_t x1555;
+  fiat_p1024_uint1 x1556;
+  uint64_t x1557;
+  uint64_t x1558;
+  uint64_t x1559;
+  uint64_t x1560;
+  uint64_t x1561;
+  uint64_t x1562;
+  uint64_t x1563;
+  uint64_t x1564;
+  uint64_t x1565;
+  uint64_t x1566;
+  uint64_t x1567;
+  uint64_t x1568;
+  uint64_t x1569;
+  uint64_t x1570;
+  uint64_t x1571;
+  uint64_t x1572;
+  uint64_t x1573;
+  uint64_t x1574;
+  uint64_t x1575;
+  uint64_t x1576;
+  uint64_t x1577;
+  uint64_t x1578;
+  uint64_t x1579;
+  uint64_t x1580;
+  uint64_t x1581;
+  uint64_t x1582;
+  uint64_t x1583;
+  uint64_t x1584;
+  uint64_t x1585;
+  uint64_t x1586;
+  uint64_t x1587;
+  uint64_t x1588;
+  uint64_t x1589;
+  uint64_t x1590;
+  fiat_p1024_uint1 x1591;
+  uint64_t x1592;
+  fiat_p1024_uint1 x1593;
+  uint64_t x1594;
+  fiat_p1024_uint1 x1595;
+  uint64_t x1596;
+  fiat_p1024_uint1 x1597;
+  uint64_t x1598;
+  fiat_p1024_uint1 x1599;
+  uint64_t x1600;
+  fiat_p1024_uint1 x1601;
+  uint64_t x1602;
+  fiat_p1024_uint1 x1603;
+  uint64_t x1604;
+  fiat_p1024_uint1 x1605;
+  uint64_t x1606;
+  fiat_p1024_uint1 x1607;
+  uint64_t x1608;
+  fiat_p1024_uint1 x1609;
+  uint64_t x1610;
+  fiat_p1024_uint1 x1611;
+  uint64_t x1612;
+  fiat_p1024_uint1 x1613;
+  uint64_t x1614;
+  fiat_p1024_uint1 x1615;
+  uint64_t x1616;
+  fiat_p1024_uint1 x1617;
+  uint64_t x1618;
+  fiat_p1024_uint1 x1619;
+  uint64_t x1620;
+  uint64_t x1621;
+  fiat_p1024_uint1 x1622;
+  uint64_t x1623;
+  fiat_p1024_uint1 x1624;
+  uint64_t x1625;
+  fiat_p1024_uint1 x1626;
+  uint64_t x1627;
+  fiat_p1024_uint1 x1628;
+  uint64_t x1629;
+  fiat_p1024_uint1 x1630;
+  uint64_t x1631;
+  fiat_p1024_uint1 x1632;
+  uint64_t x1633;
+  fiat_p1024_uint1 x1634;
+  uint64_t x1635;
+  fiat_p1024_uint1 x1636;
+  uint64_t x1637;
+  fiat_p1024_uint1 x1638;
+  uint64_t x1639;
+  fiat_p1024_uint1 x1640;
+  uint64_t x1641;
+  fiat_p1024_uint1 x1642;
+  uint64_t x1643;
+  fiat_p1024_uint1 x1644;
+  uint64_t x1645;
+  fiat_p1024_uint1 x1646;
+  uint64_t x1647;
+  fiat_p1024_uint1 x1648;
+  uint64_t x1649;
+  fiat_p1024_uint1 x1650;
+  uint64_t x1651;
+  fiat_p1024_uint1 x1652;
+  uint64_t x1653;
+  fiat_p1024_uint1 x1654;
+  uint64_t x1655;
+  uint64_t x1656;
+  uint64_t x1657;
+  uint64_t x1658;
+  uint64_t x1659;
+  uint64_t x1660;
+  uint64_t x1661;
+  uint64_t x1662;
+  uint64_t x1663;
+  uint64_t x1664;
+  uint64_t x1665;
+  uint64_t x1666;
+  uint64_t x1667;
+  uint64_t x1668;
+  uint64_t x1669;
+  uint64_t x1670;
+  uint64_t x1671;
+  uint64_t x1672;
+  uint64_t x1673;
+  uint64_t x1674;
+  uint64_t x1675;
+  uint64_t x1676;
+  uint64_t x1677;
+  uint64_t x1678;
+  uint64_t x1679;
+  uint64_t x1680;
+  uint64_t x1681;
+  uint64_t x1682;
+  uint64_t x1683;
+  uint64_t x1684;
+  uint64_t x1685;
+  uint64_t x1686;
+  uint64_t x1687;
+  uint64_t x1688;
+  uint64_t x1689;
+  fiat_p1024_uint1 x1690;
+  uint64_t x1691;
+  fiat_p1024_uint1 x1692;
+  uint64_t x1693;
+  fiat_p1024_uint1 x1694;
+  uint64_t x1695;
+  fiat_p1024_uint1 x1696;
+  uint64_t x1697;
+  fiat_p1024_uint1 x1698;
+  uint64_t x1699;
+  fiat_p1024_uint1 x1700;
+  uint64_t x1701;
+  fiat_p1024_uint1 x1702;
+  uint64_t x1703;
+  fiat_p1024_uint1 x1704;
+  uint64_t x1705;
+  fiat_p1024_uint1 x1706;
+  uint64_t x1707;
+  fiat_p1024_uint1 x1708;
+  uint64_t x1709;
+  fiat_p1024_uint1 x1710;
+  uint64_t x1711;
+  fiat_p1024_uint1 x1712;
+  uint64_t x1713;
+  fiat_p1024_uint1 x1714;
+  uint64_t x1715;
+  fiat_p1024_uint1 x1716;
+  uint64_t x1717;
+  fiat_p1024_uint1 x1718;
+  uint64_t x1719;
+  uint64_t x1720;
+  fiat_p1024_uint1 x1721;
+  uint64_t x1722;
+  fiat_p1024_uint1 x1723;
+  uint64_t x1724;
+  fiat_p1024_uint1 x1725;
+  uint64_t x1726;
+  fiat_p1024_uint1 x1727;
+  uint64_t x1728;
+  fiat_p1024_uint1 x1729;
+  uint64_t x1730;
+  fiat_p1024_uint1 x1731;
+  uint64_t x1732;
+  fiat_p1024_uint1 x1733;
+  uint64_t x1734;
+  fiat_p1024_uint1 x1735;
+  uint64_t x1736;
+  fiat_p1024_uint1 x1737;
+  uint64_t x1738;
+  fiat_p1024_uint1 x1739;
+  uint64_t x1740;
+  fiat_p1024_uint1 x1741;
+  uint64_t x1742;
+  fiat_p1024_uint1 x1743;
+  uint64_t x1744;
+  fiat_p1024_uint1 x1745;
+  uint64_t x1746;
+  fiat_p1024_uint1 x1747;
+  uint64_t x1748;
+  fiat_p1024_uint1 x1749;
+  uint64_t x1750;
+  fiat_p1024_uint1 x1751;
+  uint64_t x1752;
+  fiat_p1024_uint1 x1753;
+  uint64_t x1754;
+  uint64_t x1755;
+  uint64_t x1756;
+  uint64_t x1757;
+  uint64_t x1758;
+  uint64_t x1759;
+  uint64_t x1760;
+  uint64_t x1761;
+  uint64_t x1762;
+  uint64_t x1763;
+  uint64_t x1764;
+  uint64_t x1765;
+  uint64_t x1766;
+  uint64_t x1767;
+  uint64_t x1768;
+  uint64_t x1769;
+  uint64_t x1770;
+  uint64_t x1771;
+  uint64_t x1772;
+  uint64_t x1773;
+  uint64_t x1774;
+  uint64_t x1775;
+  uint64_t x1776;
+  uint64_t x1777;
+  uint64_t x1778;
+  uint64_t x1779;
+  uint64_t x1780;
+  uint64_t x1781;
+  uint64_t x1782;
+  uint64_t x1783;
+  uint64_t x1784;
+  uint64_t x1785;
+  uint64_t x1786;
+  uint64_t x1787;
+  fiat_p1024_uint1 x1788;
+  uint64_t x1789;
+  fiat_p1024_uint1 x1790;
+  uint64_t x1791;
+  fiat_p1024_uint1 x1792;
+  uint64_t x1793;
+  fiat_p1024_uint1 x1794;
+  uint64_t x1795;
+  fiat_p1024_uint1 x1796;
+  uint64_t x1797;
+  fiat_p1024_uint1 x1798;
+  uint64_t x1799;
+  fiat_p1024_uint1 x1800;
+  uint64_t x1801;
+  fiat_p1024_uint1 x1802;
+  uint64_t x1803;
+  fiat_p1024_uint1 x1804;
+  uint64_t x1805;
+  fiat_p1024_uint1 x1806;
+  uint64_t x1807;
+  fiat_p1024_uint1 x1808;
+  uint64_t x1809;
+  fiat_p1024_uint1 x1810;
+  uint64_t x1811;
+  fiat_p1024_uint1 x1812;
+  uint64_t x1813;
+  fiat_p1024_uint1 x1814;
+  uint64_t x1815;
+  fiat_p1024_uint1 x1816;
+  uint64_t x1817;
+  uint64_t x1818;
+  fiat_p1024_uint1 x1819;
+  uint64_t x1820;
+  fiat_p1024_uint1 x1821;
+  uint64_t x1822;
+  fiat_p1024_uint1 x1823;
+  uint64_t x1824;
+  fiat_p1024_uint1 x1825;
+  uint64_t x1826;
+  fiat_p1024_uint1 x1827;
+  uint64_t x1828;
+  fiat_p1024_uint1 x1829;
+  uint64_t x1830;
+  fiat_p1024_uint1 x1831;
+  uint64_t x1832;
+  fiat_p1024_uint1 x1833;
+  uint64_t x1834;
+  fiat_p1024_uint1 x1835;
+  uint64_t x1836;
+  fiat_p1024_uint1 x1837;
+  uint64_t x1838;
+  fiat_p1024_uint1 x1839;
+  uint64_t x1840;
+  fiat_p1024_uint1 x1841;
+  uint64_t x1842;
+  fiat_p1024_uint1 x1843;
+  uint64_t x1844;
+  fiat_p1024_uint1 x1845;
+  uint64_t x1846;
+  fiat_p1024_uint1 x1847;
+  uint64_t x1848;
+  fiat_p1024_uint1 x1849;
+  uint64_t x1850;
+  fiat_p1024_uint1 x1851;
+  uint64_t x1852;
+  uint64_t x1853;
+  uint64_t x1854;
+  uint64_t x1855;
+  uint64_t x1856;
+  uint64_t x1857;
+  uint64_t x1858;
+  uint64_t x1859;
+  uint64_t x1860;
+  uint64_t x1861;
+  uint64_t x1862;
+  uint64_t x1863;
+  uint64_t x1864;
+  uint64_t x1865;
+  uint64_t x1866;
+  uint64_t x1867;
+  uint64_t x1868;
+  uint64_t x1869;
+  uint64_t x1870;
+  uint64_t x1871;
+  uint64_t x1872;
+  uint64_t x1873;
+  uint64_t x1874;
+  uint64_t x1875;
+  uint64_t x1876;
+  uint64_t x1877;
+  uint64_t x1878;
+  uint64_t x1879;
+  uint64_t x1880;
+  uint64_t x1881;
+  uint64_t x1882;
+  uint64_t x1883;
+  uint64_t x1884;
+  uint64_t x1885;
+  uint64_t x1886;
+  fiat_p1024_uint1 x1887;
+  uint64_t x1888;
+  fiat_p1024_uint1 x1889;
+  uint64_t x1890;
+  fiat_p1024_uint1 x1891;
+  uint64_t x1892;
+  fiat_p1024_uint1 x1893;
+  uint64_t x1894;
+  fiat_p1024_uint1 x1895;
+  uint64_t x1896;
+  fiat_p1024_uint1 x1897;
+  uint64_t x1898;
+  fiat_p1024_uint1 x1899;
+  uint64_t x1900;
+  fiat_p1024_uint1 x1901;
+  uint64_t x1902;
+  fiat_p1024_uint1 x1903;
+  uint64_t x1904;
+  fiat_p1024_uint1 x1905;
+  uint64_t x1906;
+  fiat_p1024_uint1 x1907;
+  uint64_t x1908;
+  fiat_p1024_uint1 x1909;
+  uint64_t x1910;
+  fiat_p1024_uint1 x1911;
+  uint64_t x1912;
+  fiat_p1024_uint1 x1913;
+  uint64_t x1914;
+  fiat_p1024_uint1 x1915;
+  uint64_t x1916;
+  uint64_t x1917;
+  fiat_p1024_uint1 x1918;
+  uint64_t x1919;
+  fiat_p1024_uint1 x1920;
+  uint64_t x1921;
+  fiat_p1024_uint1 x1922;
+  uint64_t x1923;
+  fiat_p1024_uint1 x1924;
+  uint64_t x1925;
+  fiat_p1024_uint1 x1926;
+  uint64_t x1927;
+  fiat_p1024_uint1 x1928;
+  uint64_t x1929;
+  fiat_p1024_uint1 x1930;
+  uint64_t x1931;
+  fiat_p1024_uint1 x1932;
+  uint64_t x1933;
+  fiat_p1024_uint1 x1934;
+  uint64_t x1935;
+  fiat_p1024_uint1 x1936;
+  uint64_t x1937;
+  fiat_p1024_uint1 x1938;
+  uint64_t x1939;
+  fiat_p1024_uint1 x1940;
+  uint64_t x1941;
+  fiat_p1024_uint1 x1942;
+  uint64_t x1943;
+  fiat_p1024_uint1 x1944;
+  uint64_t x1945;
+  fiat_p1024_uint1 x1946;
+  uint64_t x1947;
+  fiat_p1024_uint1 x1948;
+  uint64_t x1949;
+  fiat_p1024_uint1 x1950;
+  uint64_t x1951;
+  uint64_t x1952;
+  uint64_t x1953;
+  uint64_t x1954;
+  uint64_t x1955;
+  uint64_t x1956;
+  uint64_t x1957;
+  uint64_t x1958;
+  uint64_t x1959;
+  uint64_t x1960;
+  uint64_t x1961;
+  uint64_t x1962;
+  uint64_t x1963;
+  uint64_t x1964;
+  uint64_t x1965;
+  uint64_t x1966;
+  uint64_t x1967;
+  uint64_t x1968;
+  uint64_t x1969;
+  uint64_t x1970;
+  uint64_t x1971;
+  uint64_t x1972;
+  uint64_t x1973;
+  uint64_t x1974;
+  uint64_t x1975;
+  uint64_t x1976;
+  uint64_t x1977;
+  uint64_t x1978;
+  uint64_t x1979;
+  uint64_t x1980;
+  uint64_t x1981;
+  uint64_t x1982;
+  uint64_t x1983;
+  uint64_t x1984;
+  fiat_p1024_uint1 x1985;
+  uint64_t x1986;
+  fiat_p1024_uint1 x1987;
+  uint64_t x1988;
+  fiat_p1024_uint1 x1989;
+  uint64_t x1990;
+  fiat_p1024_uint1 x1991;
+  uint64_t x1992;
+  fiat_p1024_uint1 x1993;
+  uint64_t x1994;
+  fiat_p1024_uint1 x1995;
+  uint64_t x1996;
+  fiat_p1024_uint1 x1997;
+  uint64_t x1998;
+  fiat_p1024_uint1 x1999;
+  uint64_t x2000;
+  fiat_p1024_uint1 x2001;
+  uint64_t x2002;
+  fiat_p1024_uint1 x2003;
+  uint64_t x2004;
+  fiat_p1024_uint1 x2005;
+  uint64_t x2006;
+  fiat_p1024_uint1 x2007;
+  uint64_t x2008;
+  fiat_p1024_uint1 x2009;
+  uint64_t x2010;
+  fiat_p1024_uint1 x2011;
+  uint64_t x2012;
+  fiat_p1024_uint1 x2013;
+  uint64_t x2014;
+  uint64_t x2015;
+  fiat_p1024_uint1 x2016;
+  uint64_t x2017;
+  fiat_p1024_uint1 x2018;
+  uint64_t x2019;
+  fiat_p1024_uint1 x2020;
+  uint64_t x2021;
+  fiat_p1024_uint1 x2022;
+  uint64_t x2023;
+  fiat_p1024_uint1 x2024;
+  uint64_t x2025;
+  fiat_p1024_uint1 x2026;
+  uint64_t x2027;
+  fiat_p1024_uint1 x2028;
+  uint64_t x2029;
+  fiat_p1024_uint1 x2030;
+  uint64_t x2031;
+  fiat_p1024_uint1 x2032;
+  uint64_t x2033;
+  fiat_p1024_uint1 x2034;
+  uint64_t x2035;
+  fiat_p1024_uint1 x2036;
+  uint64_t x2037;
+  fiat_p1024_uint1 x2038;
+  uint64_t x2039;
+  fiat_p1024_uint1 x2040;
+  uint64_t x2041;
+  fiat_p1024_uint1 x2042;
+  uint64_t x2043;
+  fiat_p1024_uint1 x2044;
+  uint64_t x2045;
+  fiat_p1024_uint1 x2046;
+  uint64_t x2047;
+  fiat_p1024_uint1 x2048;
+  uint64_t x2049;
+  uint64_t x2050;
+  uint64_t x2051;
+  uint64_t x2052;
+  uint64_t x2053;
+  uint64_t x2054;
+  uint64_t x2055;
+  uint64_t x2056;
+  uint64_t x2057;
+  uint64_t x2058;
+  uint64_t x2059;
+  uint64_t x2060;
+  uint64_t x2061;
+  uint64_t x2062;
+  uint64_t x2063;
+  uint64_t x2064;
+  uint64_t x2065;
+  uint64_t x2066;
+  uint64_t x2067;
+  uint64_t x2068;
+  uint64_t x2069;
+  uint64_t x2070;
+  uint64_t x2071;
+  uint64_t x2072;
+  uint64_t x2073;
+  uint64_t x2074;
+  uint64_t x2075;
+  uint64_t x2076;
+  uint64_t x2077;
+  uint64_t x2078;
+  uint64_t x2079;
+  uint64_t x2080;
+  uint64_t x2081;
+  uint64_t x2082;
+  uint64_t x2083;
+  fiat_p1024_uint1 x2084;
+  uint64_t x2085;
+  fiat_p1024_uint1 x2086;
+  uint64_t x2087;
+  fiat_p1024_uint1 x2088;
+  uint64_t x2089;
+  fiat_p1024_uint1 x2090;
+  uint64_t x2091;
+  fiat_p1024_uint1 x2092;
+  uint64_t x2093;
+  fiat_p1024_uint1 x2094;
+  uint64_t x2095;
+  fiat_p1024_uint1 x2096;
+  uint64_t x2097;
+  fiat_p1024_uint1 x2098;
+  uint64_t x2099;
+  fiat_p1024_uint1 x2100;
+  uint64_t x2101;
+  fiat_p1024_uint1 x2102;
+  uint64_t x2103;
+  fiat_p1024_uint1 x2104;
+  uint64_t x2105;
+  fiat_p1024_uint1 x2106;
+  uint64_t x2107;
+  fiat_p1024_uint1 x2108;
+  uint64_t x2109;
+  fiat_p1024_uint1 x2110;
+  uint64_t x2111;
+  fiat_p1024_uint1 x2112;
+  uint64_t x2113;
+  uint64_t x2114;
+  fiat_p1024_uint1 x2115;
+  uint64_t x2116;
+  fiat_p1024_uint1 x2117;
+  uint64_t x2118;
+  fiat_p1024_uint1 x2119;
+  uint64_t x2120;
+  fiat_p1024_uint1 x2121;
+  uint64_t x2122;
+  fiat_p1024_uint1 x2123;
+  uint64_t x2124;
+  fiat_p1024_uint1 x2125;
+  uint64_t x2126;
+  fiat_p1024_uint1 x2127;
+  uint64_t x2128;
+  fiat_p1024_uint1 x2129;
+  uint64_t x2130;
+  fiat_p1024_uint1 x2131;
+  uint64_t x2132;
+  fiat_p1024_uint1 x2133;
+  uint64_t x2134;
+  fiat_p1024_uint1 x2135;
+  uint64_t x2136;
+  fiat_p1024_uint1 x2137;
+  uint64_t x2138;
+  fiat_p1024_uint1 x2139;
+  uint64_t x2140;
+  fiat_p1024_uint1 x2141;
+  uint64_t x2142;
+  fiat_p1024_uint1 x2143;
+  uint64_t x2144;
+  fiat_p1024_uint1 x2145;
+  uint64_t x2146;
+  fiat_p1024_uint1 x2147;
+  uint64_t x2148;
+  uint64_t x2149;
+  uint64_t x2150;
+  uint64_t x2151;
+  uint64_t x2152;
+  uint64_t x2153;
+  uint64_t x2154;
+  uint64_t x2155;
+  uint64_t x2156;
+  uint64_t x2157;
+  uint64_t x2158;
+  uint64_t x2159;
+  uint64_t x2160;
+  uint64_t x2161;
+  uint64_t x2162;
+  uint64_t x2163;
+  uint64_t x2164;
+  uint64_t x2165;
+  uint64_t x2166;
+  uint64_t x2167;
+  uint64_t x2168;
+  uint64_t x2169;
+  uint64_t x2170;
+  uint64_t x2171;
+  uint64_t x2172;
+  uint64_t x2173;
+  uint64_t x2174;
+  uint64_t x2175;
+  uint64_t x2176;
+  uint64_t x2177;
+  uint64_t x2178;
+  uint64_t x2179;
+  uint64_t x2180;
+  uint64_t x2181;
+  fiat_p1024_uint1 x2182;
+  uint64_t x2183;
+  fiat_p1024_uint1 x2184;
+  uint64_t x2185;
+  fiat_p1024_uint1 x2186;
+  uint64_t x2187;
+  fiat_p1024_uint1 x2188;
+  uint64_t x2189;
+  fiat_p1024_uint1 x2190;
+  uint64_t x2191;
+  fiat_p1024_uint1 x2192;
+  uint64_t x2193;
+  fiat_p1024_uint1 x2194;
+  uint64_t x2195;
+  fiat_p1024_uint1 x2196;
+  uint64_t x2197;
+  fiat_p1024_uint1 x2198;
+  uint64_t x2199;
+  fiat_p1024_uint1 x2200;
+  uint64_t x2201;
+  fiat_p1024_uint1 x2202;
+  uint64_t x2203;
+  fiat_p1024_uint1 x2204;
+  uint64_t x2205;
+  fiat_p1024_uint1 x2206;
+  uint64_t x2207;
+  fiat_p1024_uint1 x2208;
+  uint64_t x2209;
+  fiat_p1024_uint1 x2210;
+  uint64_t x2211;
+  uint64_t x2212;
+  fiat_p1024_uint1 x2213;
+  uint64_t x2214;
+  fiat_p1024_uint1 x2215;
+  uint64_t x2216;
+  fiat_p1024_uint1 x2217;
+  uint64_t x2218;
+  fiat_p1024_uint1 x2219;
+  uint64_t x2220;
+  fiat_p1024_uint1 x2221;
+  uint64_t x2222;
+  fiat_p1024_uint1 x2223;
+  uint64_t x2224;
+  fiat_p1024_uint1 x2225;
+  uint64_t x2226;
+  fiat_p1024_uint1 x2227;
+  uint64_t x2228;
+  fiat_p1024_uint1 x2229;
+  uint64_t x2230;
+  fiat_p1024_uint1 x2231;
+  uint64_t x2232;
+  fiat_p1024_uint1 x2233;
+  uint64_t x2234;
+  fiat_p1024_uint1 x2235;
+  uint64_t x2236;
+  fiat_p1024_uint1 x2237;
+  uint64_t x2238;
+  fiat_p1024_uint1 x2239;
+  uint64_t x2240;
+  fiat_p1024_uint1 x2241;
+  uint64_t x2242;
+  fiat_p1024_uint1 x2243;
+  uint64_t x2244;
+  fiat_p1024_uint1 x2245;
+  uint64_t x2246;
+  uint64_t x2247;
+  uint64_t x2248;
+  uint64_t x2249;
+  uint64_t x2250;
+  uint64_t x2251;
+  uint64_t x2252;
+  uint64_t x2253;
+  uint64_t x2254;
+  uint64_t x2255;
+  uint64_t x2256;
+  uint64_t x2257;
+  uint64_t x2258;
+  uint64_t x2259;
+  uint64_t x2260;
+  uint64_t x2261;
+  uint64_t x2262;
+  uint64_t x2263;
+  uint64_t x2264;
+  uint64_t x2265;
+  uint64_t x2266;
+  uint64_t x2267;
+  uint64_t x2268;
+  uint64_t x2269;
+  uint64_t x2270;
+  uint64_t x2271;
+  uint64_t x2272;
+  uint64_t x2273;
+  uint64_t x2274;
+  uint64_t x2275;
+  uint64_t x2276;
+  uint64_t x2277;
+  uint64_t x2278;
+  uint64_t x2279;
+  uint64_t x2280;
+  fiat_p1024_uint1 x2281;
+  uint64_t x2282;
+  fiat_p1024_uint1 x2283;
+  uint64_t x2284;
+  fiat_p1024_uint1 x2285;
+  uint64_t x2286;
+  fiat_p1024_uint1 x2287;
+  uint64_t x2288;
+  fiat_p1024_uint1 x2289;
+  uint64_t x2290;
+  fiat_p1024_uint1 x2291;
+  uint64_t x2292;
+  fiat_p1024_uint1 x2293;
+  uint64_t x2294;
+  fiat_p1024_uint1 x2295;
+  uint64_t x2296;
+  fiat_p1024_uint1 x2297;
+  uint64_t x2298;
+  fiat_p1024_uint1 x2299;
+  uint64_t x2300;
+  fiat_p1024_uint1 x2301;
+  uint64_t x2302;
+  fiat_p1024_uint1 x2303;
+  uint64_t x2304;
+  fiat_p1024_uint1 x2305;
+  uint64_t x2306;
+  fiat_p1024_uint1 x2307;
+  uint64_t x2308;
+  fiat_p1024_uint1 x2309;
+  uint64_t x2310;
+  uint64_t x2311;
+  fiat_p1024_uint1 x2312;
+  uint64_t x2313;
+  fiat_p1024_uint1 x2314;
+  uint64_t x2315;
+  fiat_p1024_uint1 x2316;
+  uint64_t x2317;
+  fiat_p1024_uint1 x2318;
+  uint64_t x2319;
+  fiat_p1024_uint1 x2320;
+  uint64_t x2321;
+  fiat_p1024_uint1 x2322;
+  uint64_t x2323;
+  fiat_p1024_uint1 x2324;
+  uint64_t x2325;
+  fiat_p1024_uint1 x2326;
+  uint64_t x2327;
+  fiat_p1024_uint1 x2328;
+  uint64_t x2329;
+  fiat_p1024_uint1 x2330;
+  uint64_t x2331;
+  fiat_p1024_uint1 x2332;
+  uint64_t x2333;
+  fiat_p1024_uint1 x2334;
+  uint64_t x2335;
+  fiat_p1024_uint1 x2336;
+  uint64_t x2337;
+  fiat_p1024_uint1 x2338;
+  uint64_t x2339;
+  fiat_p1024_uint1 x2340;
+  uint64_t x2341;
+  fiat_p1024_uint1 x2342;
+  uint64_t x2343;
+  fiat_p1024_uint1 x2344;
+  uint64_t x2345;
+  uint64_t x2346;
+  uint64_t x2347;
+  uint64_t x2348;
+  uint64_t x2349;
+  uint64_t x2350;
+  uint64_t x2351;
+  uint64_t x2352;
+  uint64_t x2353;
+  uint64_t x2354;
+  uint64_t x2355;
+  uint64_t x2356;
+  uint64_t x2357;
+  uint64_t x2358;
+  uint64_t x2359;
+  uint64_t x2360;
+  uint64_t x2361;
+  uint64_t x2362;
+  uint64_t x2363;
+  uint64_t x2364;
+  uint64_t x2365;
+  uint64_t x2366;
+  uint64_t x2367;
+  uint64_t x2368;
+  uint64_t x2369;
+  uint64_t x2370;
+  uint64_t x2371;
+  uint64_t x2372;
+  uint64_t x2373;
+  uint64_t x2374;
+  uint64_t x2375;
+  uint64_t x2376;
+  uint64_t x2377;
+  uint64_t x2378;
+  fiat_p1024_uint1 x2379;
+  uint64_t x2380;
+  fiat_p1024_uint1 x2381;
+  uint64_t x2382;
+  fiat_p1024_uint1 x2383;
+  uint64_t x2384;
+  fiat_p1024_uint1 x2385;
+  uint64_t x2386;
+  fiat_p1024_uint1 x2387;
+  uint64_t x2388;
+  fiat_p1024_uint1 x2389;
+  uint64_t x2390;
+  fiat_p1024_uint1 x2391;
+  uint64_t x2392;
+  fiat_p1024_uint1 x2393;
+  uint64_t x2394;
+  fiat_p1024_uint1 x2395;
+  uint64_t x2396;
+  fiat_p1024_uint1 x2397;
+  uint64_t x2398;
+  fiat_p1024_uint1 x2399;
+  uint64_t x2400;
+  fiat_p1024_uint1 x2401;
+  uint64_t x2402;
+  fiat_p1024_uint1 x2403;
+  uint64_t x2404;
+  fiat_p1024_uint1 x2405;
+  uint64_t x2406;
+  fiat_p1024_uint1 x2407;
+  uint64_t x2408;
+  uint64_t x2409;
+  fiat_p1024_uint1 x2410;
+  uint64_t x2411;
+  fiat_p1024_uint1 x2412;
+  uint64_t x2413;
+  fiat_p1024_uint1 x2414;
+  uint64_t x2415;
+  fiat_p1024_uint1 x2416;
+  uint64_t x2417;
+  fiat_p1024_uint1 x2418;
+  uint64_t x2419;
+  fiat_p1024_uint1 x2420;
+  uint64_t x2421;
+  fiat_p1024_uint1 x2422;
+  uint64_t x2423;
+  fiat_p1024_uint1 x2424;
+  uint64_t x2425;
+  fiat_p1024_uint1 x2426;
+  uint64_t x2427;
+  fiat_p1024_uint1 x2428;
+  uint64_t x2429;
+  fiat_p1024_uint1 x2430;
+  uint64_t x2431;
+  fiat_p1024_uint1 x2432;
+  uint64_t x2433;
+  fiat_p1024_uint1 x2434;
+  uint64_t x2435;
+  fiat_p1024_uint1 x2436;
+  uint64_t x2437;
+  fiat_p1024_uint1 x2438;
+  uint64_t x2439;
+  fiat_p1024_uint1 x2440;
+  uint64_t x2441;
+  fiat_p1024_uint1 x2442;
+  uint64_t x2443;
+  uint64_t x2444;
+  uint64_t x2445;
+  uint64_t x2446;
+  uint64_t x2447;
+  uint64_t x2448;
+  uint64_t x2449;
+  uint64_t x2450;
+  uint64_t x2451;
+  uint64_t x2452;
+  uint64_t x2453;
+  uint64_t x2454;
+  uint64_t x2455;
+  uint64_t x2456;
+  uint64_t x2457;
+  uint64_t x2458;
+  uint64_t x2459;
+  uint64_t x2460;
+  uint64_t x2461;
+  uint64_t x2462;
+  uint64_t x2463;
+  uint64_t x2464;
+  uint64_t x2465;
+  uint64_t x2466;
+  uint64_t x2467;
+  uint64_t x2468;
+  uint64_t x2469;
+  uint64_t x2470;
+  uint64_t x2471;
+  uint64_t x2472;
+  uint64_t x2473;
+  uint64_t x2474;
+  uint64_t x2475;
+  uint64_t x2476;
+  uint64_t x2477;
+  fiat_p1024_uint1 x2478;
+  uint64_t x2479;
+  fiat_p1024_uint1 x2480;
+  uint64_t x2481;
+  fiat_p1024_uint1 x2482;
+  uint64_t x2483;
+  fiat_p1024_uint1 x2484;
+  uint64_t x2485;
+  fiat_p1024_uint1 x2486;
+  uint64_t x2487;
+  fiat_p1024_uint1 x2488;
+  uint64_t x2489;
+  fiat_p1024_uint1 x2490;
+  uint64_t x2491;
+  fiat_p1024_uint1 x2492;
+  uint64_t x2493;
+  fiat_p1024_uint1 x2494;
+  uint64_t x2495;
+  fiat_p1024_uint1 x2496;
+  uint64_t x2497;
+  fiat_p1024_uint1 x2498;
+  uint64_t x2499;
+  fiat_p1024_uint1 x2500;
+  uint64_t x2501;
+  fiat_p1024_uint1 x2502;
+  uint64_t x2503;
+  fiat_p1024_uint1 x2504;
+  uint64_t x2505;
+  fiat_p1024_uint1 x2506;
+  uint64_t x2507;
+  uint64_t x2508;
+  fiat_p1024_uint1 x2509;
+  uint64_t x2510;
+  fiat_p1024_uint1 x2511;
+  uint64_t x2512;
+  fiat_p1024_uint1 x2513;
+  uint64_t x2514;
+  fiat_p1024_uint1 x2515;
+  uint64_t x2516;
+  fiat_p1024_uint1 x2517;
+  uint64_t x2518;
+  fiat_p1024_uint1 x2519;
+  uint64_t x2520;
+  fiat_p1024_uint1 x2521;
+  uint64_t x2522;
+  fiat_p1024_uint1 x2523;
+  uint64_t x2524;
+  fiat_p1024_uint1 x2525;
+  uint64_t x2526;
+  fiat_p1024_uint1 x2527;
+  uint64_t x2528;
+  fiat_p1024_uint1 x2529;
+  uint64_t x2530;
+  fiat_p1024_uint1 x2531;
+  uint64_t x2532;
+  fiat_p1024_uint1 x2533;
+  uint64_t x2534;
+  fiat_p1024_uint1 x2535;
+  uint64_t x2536;
+  fiat_p1024_uint1 x2537;
+  uint64_t x2538;
+  fiat_p1024_uint1 x2539;
+  uint64_t x2540;
+  fiat_p1024_uint1 x2541;
+  uint64_t x2542;
+  uint64_t x2543;
+  uint64_t x2544;
+  uint64_t x2545;
+  uint64_t x2546;
+  uint64_t x2547;
+  uint64_t x2548;
+  uint64_t x2549;
+  uint64_t x2550;
+  uint64_t x2551;
+  uint64_t x2552;
+  uint64_t x2553;
+  uint64_t x2554;
+  uint64_t x2555;
+  uint64_t x2556;
+  uint64_t x2557;
+  uint64_t x2558;
+  uint64_t x2559;
+  uint64_t x2560;
+  uint64_t x2561;
+  uint64_t x2562;
+  uint64_t x2563;
+  uint64_t x2564;
+  uint64_t x2565;
+  uint64_t x2566;
+  uint64_t x2567;
+  uint64_t x2568;
+  uint64_t x2569;
+  uint64_t x2570;
+  uint64_t x2571;
+  uint64_t x2572;
+  uint64_t x2573;
+  uint64_t x2574;
+  uint64_t x2575;
+  fiat_p1024_uint1 x2576;
+  uint64_t x2577;
+  fiat_p1024_uint1 x2578;
+  uint64_t x2579;
+  fiat_p1024_uint1 x2580;
+  uint64_t x2581;
+  fiat_p1024_uint1 x2582;
+  uint64_t x2583;
+  fiat_p1024_uint1 x2584;
+  uint64_t x2585;
+  fiat_p1024_uint1 x2586;
+  uint64_t x2587;
+  fiat_p1024_uint1 x2588;
+  uint64_t x2589;
+  fiat_p1024_uint1 x2590;
+  uint64_t x2591;
+  fiat_p1024_uint1 x2592;
+  uint64_t x2593;
+  fiat_p1024_uint1 x2594;
+  uint64_t x2595;
+  fiat_p1024_uint1 x2596;
+  uint64_t x2597;
+  fiat_p1024_uint1 x2598;
+  uint64_t x2599;
+  fiat_p1024_uint1 x2600;
+  uint64_t x2601;
+  fiat_p1024_uint1 x2602;
+  uint64_t x2603;
+  fiat_p1024_uint1 x2604;
+  uint64_t x2605;
+  uint64_t x2606;
+  fiat_p1024_uint1 x2607;
+  uint64_t x2608;
+  fiat_p1024_uint1 x2609;
+  uint64_t x2610;
+  fiat_p1024_uint1 x2611;
+  uint64_t x2612;
+  fiat_p1024_uint1 x2613;
+  uint64_t x2614;
+  fiat_p1024_uint1 x2615;
+  uint64_t x2616;
+  fiat_p1024_uint1 x2617;
+  uint64_t x2618;
+  fiat_p1024_uint1 x2619;
+  uint64_t x2620;
+  fiat_p1024_uint1 x2621;
+  uint64_t x2622;
+  fiat_p1024_uint1 x2623;
+  uint64_t x2624;
+  fiat_p1024_uint1 x2625;
+  uint64_t x2626;
+  fiat_p1024_uint1 x2627;
+  uint64_t x2628;
+  fiat_p1024_uint1 x2629;
+  uint64_t x2630;
+  fiat_p1024_uint1 x2631;
+  uint64_t x2632;
+  fiat_p1024_uint1 x2633;
+  uint64_t x2634;
+  fiat_p1024_uint1 x2635;
+  uint64_t x2636;
+  fiat_p1024_uint1 x2637;
+  uint64_t x2638;
+  fiat_p1024_uint1 x2639;
+  uint64_t x2640;
+  uint64_t x2641;
+  uint64_t x2642;
+  uint64_t x2643;
+  uint64_t x2644;
+  uint64_t x2645;
+  uint64_t x2646;
+  uint64_t x2647;
+  uint64_t x2648;
+  uint64_t x2649;
+  uint64_t x2650;
+  uint64_t x2651;
+  uint64_t x2652;
+  uint64_t x2653;
+  uint64_t x2654;
+  uint64_t x2655;
+  uint64_t x2656;
+  uint64_t x2657;
+  uint64_t x2658;
+  uint64_t x2659;
+  uint64_t x2660;
+  uint64_t x2661;
+  uint64_t x2662;
+  uint64_t x2663;
+  uint64_t x2664;
+  uint64_t x2665;
+  uint64_t x2666;
+  uint64_t x2667;
+  uint64_t x2668;
+  uint64_t x2669;
+  uint64_t x2670;
+  uint64_t x2671;
+  uint64_t x2672;
+  uint64_t x2673;
+  uint64_t x2674;
+  fiat_p1024_uint1 x2675;
+  uint64_t x2676;
+  fiat_p1024_uint1 x2677;
+  uint64_t x2678;
+  fiat_p1024_uint1 x2679;
+  uint64_t x2680;
+  fiat_p1024_uint1 x2681;
+  uint64_t x2682;
+  fiat_p1024_uint1 x2683;
+  uint64_t x2684;
+  fiat_p1024_uint1 x2685;
+  uint64_t x2686;
+  fiat_p1024_uint1 x2687;
+  uint64_t x2688;
+  fiat_p1024_uint1 x2689;
+  uint64_t x2690;
+  fiat_p1024_uint1 x2691;
+  uint64_t x2692;
+  fiat_p1024_uint1 x2693;
+  uint64_t x2694;
+  fiat_p1024_uint1 x2695;
+  uint64_t x2696;
+  fiat_p1024_uint1 x2697;
+  uint64_t x2698;
+  fiat_p1024_uint1 x2699;
+  uint64_t x2700;
+  fiat_p1024_uint1 x2701;
+  uint64_t x2702;
+  fiat_p1024_uint1 x2703;
+  uint64_t x2704;
+  uint64_t x2705;
+  fiat_p1024_uint1 x2706;
+  uint64_t x2707;
+  fiat_p1024_uint1 x2708;
+  uint64_t x2709;
+  fiat_p1024_uint1 x2710;
+  uint64_t x2711;
+  fiat_p1024_uint1 x2712;
+  uint64_t x2713;
+  fiat_p1024_uint1 x2714;
+  uint64_t x2715;
+  fiat_p1024_uint1 x2716;
+  uint64_t x2717;
+  fiat_p1024_uint1 x2718;
+  uint64_t x2719;
+  fiat_p1024_uint1 x2720;
+  uint64_t x2721;
+  fiat_p1024_uint1 x2722;
+  uint64_t x2723;
+  fiat_p1024_uint1 x2724;
+  uint64_t x2725;
+  fiat_p1024_uint1 x2726;
+  uint64_t x2727;
+  fiat_p1024_uint1 x2728;
+  uint64_t x2729;
+  fiat_p1024_uint1 x2730;
+  uint64_t x2731;
+  fiat_p1024_uint1 x2732;
+  uint64_t x2733;
+  fiat_p1024_uint1 x2734;
+  uint64_t x2735;
+  fiat_p1024_uint1 x2736;
+  uint64_t x2737;
+  fiat_p1024_uint1 x2738;
+  uint64_t x2739;
+  uint64_t x2740;
+  uint64_t x2741;
+  uint64_t x2742;
+  uint64_t x2743;
+  uint64_t x2744;
+  uint64_t x2745;
+  uint64_t x2746;
+  uint64_t x2747;
+  uint64_t x2748;
+  uint64_t x2749;
+  uint64_t x2750;
+  uint64_t x2751;
+  uint64_t x2752;
+  uint64_t x2753;
+  uint64_t x2754;
+  uint64_t x2755;
+  uint64_t x2756;
+  uint64_t x2757;
+  uint64_t x2758;
+  uint64_t x2759;
+  uint64_t x2760;
+  uint64_t x2761;
+  uint64_t x2762;
+  uint64_t x2763;
+  uint64_t x2764;
+  uint64_t x2765;
+  uint64_t x2766;
+  uint64_t x2767;
+  uint64_t x2768;
+  uint64_t x2769;
+  uint64_t x2770;
+  uint64_t x2771;
+  uint64_t x2772;
+  fiat_p1024_uint1 x2773;
+  uint64_t x2774;
+  fiat_p1024_uint1 x2775;
+  uint64_t x2776;
+  fiat_p1024_uint1 x2777;
+  uint64_t x2778;
+  fiat_p1024_uint1 x2779;
+  uint64_t x2780;
+  fiat_p1024_uint1 x2781;
+  uint64_t x2782;
+  fiat_p1024_uint1 x2783;
+  uint64_t x2784;
+  fiat_p1024_uint1 x2785;
+  uint64_t x2786;
+  fiat_p1024_uint1 x2787;
+  uint64_t x2788;
+  fiat_p1024_uint1 x2789;
+  uint64_t x2790;
+  fiat_p1024_uint1 x2791;
+  uint64_t x2792;
+  fiat_p1024_uint1 x2793;
+  uint64_t x2794;
+  fiat_p1024_uint1 x2795;
+  uint64_t x2796;
+  fiat_p1024_uint1 x2797;
+  uint64_t x2798;
+  fiat_p1024_uint1 x2799;
+  uint64_t x2800;
+  fiat_p1024_uint1 x2801;
+  uint64_t x2802;
+  uint64_t x2803;
+  fiat_p1024_uint1 x2804;
+  uint64_t x2805;
+  fiat_p1024_uint1 x2806;
+  uint64_t x2807;
+  fiat_p1024_uint1 x2808;
+  uint64_t x2809;
+  fiat_p1024_uint1 x2810;
+  uint64_t x2811;
+  fiat_p1024_uint1 x2812;
+  uint64_t x2813;
+  fiat_p1024_uint1 x2814;
+  uint64_t x2815;
+  fiat_p1024_uint1 x2816;
+  uint64_t x2817;
+  fiat_p1024_uint1 x2818;
+  uint64_t x2819;
+  fiat_p1024_uint1 x2820;
+  uint64_t x2821;
+  fiat_p1024_uint1 x2822;
+  uint64_t x2823;
+  fiat_p1024_uint1 x2824;
+  uint64_t x2825;
+  fiat_p1024_uint1 x2826;
+  uint64_t x2827;
+  fiat_p1024_uint1 x2828;
+  uint64_t x2829;
+  fiat_p1024_uint1 x2830;
+  uint64_t x2831;
+  fiat_p1024_uint1 x2832;
+  uint64_t x2833;
+  fiat_p1024_uint1 x2834;
+  uint64_t x2835;
+  fiat_p1024_uint1 x2836;
+  uint64_t x2837;
+  uint64_t x2838;
+  uint64_t x2839;
+  uint64_t x2840;
+  uint64_t x2841;
+  uint64_t x2842;
+  uint64_t x2843;
+  uint64_t x2844;
+  uint64_t x2845;
+  uint64_t x2846;
+  uint64_t x2847;
+  uint64_t x2848;
+  uint64_t x2849;
+  uint64_t x2850;
+  uint64_t x2851;
+  uint64_t x2852;
+  uint64_t x2853;
+  uint64_t x2854;
+  uint64_t x2855;
+  uint64_t x2856;
+  uint64_t x2857;
+  uint64_t x2858;
+  uint64_t x2859;
+  uint64_t x2860;
+  uint64_t x2861;
+  uint64_t x2862;
+  uint64_t x2863;
+  uint64_t x2864;
+  uint64_t x2865;
+  uint64_t x2866;
+  uint64_t x2867;
+  uint64_t x2868;
+  uint64_t x2869;
+  uint64_t x2870;
+  uint64_t x2871;
+  fiat_p1024_uint1 x2872;
+  uint64_t x2873;
+  fiat_p1024_uint1 x2874;
+  uint64_t x2875;
+  fiat_p1024_uint1 x2876;
+  uint64_t x2877;
+  fiat_p1024_uint1 x2878;
+  uint64_t x2879;
+  fiat_p1024_uint1 x2880;
+  uint64_t x2881;
+  fiat_p1024_uint1 x2882;
+  uint64_t x2883;
+  fiat_p1024_uint1 x2884;
+  uint64_t x2885;
+  fiat_p1024_uint1 x2886;
+  uint64_t x2887;
+  fiat_p1024_uint1 x2888;
+  uint64_t x2889;
+  fiat_p1024_uint1 x2890;
+  uint64_t x2891;
+  fiat_p1024_uint1 x2892;
+  uint64_t x2893;
+  fiat_p1024_uint1 x2894;
+  uint64_t x2895;
+  fiat_p1024_uint1 x2896;
+  uint64_t x2897;
+  fiat_p1024_uint1 x2898;
+  uint64_t x2899;
+  fiat_p1024_uint1 x2900;
+  uint64_t x2901;
+  uint64_t x2902;
+  fiat_p1024_uint1 x2903;
+  uint64_t x2904;
+  fiat_p1024_uint1 x2905;
+  uint64_t x2906;
+  fiat_p1024_uint1 x2907;
+  uint64_t x2908;
+  fiat_p1024_uint1 x2909;
+  uint64_t x2910;
+  fiat_p1024_uint1 x2911;
+  uint64_t x2912;
+  fiat_p1024_uint1 x2913;
+  uint64_t x2914;
+  fiat_p1024_uint1 x2915;
+  uint64_t x2916;
+  fiat_p1024_uint1 x2917;
+  uint64_t x2918;
+  fiat_p1024_uint1 x2919;
+  uint64_t x2920;
+  fiat_p1024_uint1 x2921;
+  uint64_t x2922;
+  fiat_p1024_uint1 x2923;
+  uint64_t x2924;
+  fiat_p1024_uint1 x2925;
+  uint64_t x2926;
+  fiat_p1024_uint1 x2927;
+  uint64_t x2928;
+  fiat_p1024_uint1 x2929;
+  uint64_t x2930;
+  fiat_p1024_uint1 x2931;
+  uint64_t x2932;
+  fiat_p1024_uint1 x2933;
+  uint64_t x2934;
+  fiat_p1024_uint1 x2935;
+  uint64_t x2936;
+  uint64_t x2937;
+  uint64_t x2938;
+  uint64_t x2939;
+  uint64_t x2940;
+  uint64_t x2941;
+  uint64_t x2942;
+  uint64_t x2943;
+  uint64_t x2944;
+  uint64_t x2945;
+  uint64_t x2946;
+  uint64_t x2947;
+  uint64_t x2948;
+  uint64_t x2949;
+  uint64_t x2950;
+  uint64_t x2951;
+  uint64_t x2952;
+  uint64_t x2953;
+  uint64_t x2954;
+  uint64_t x2955;
+  uint64_t x2956;
+  uint64_t x2957;
+  uint64_t x2958;
+  uint64_t x2959;
+  uint64_t x2960;
+  uint64_t x2961;
+  uint64_t x2962;
+  uint64_t x2963;
+  uint64_t x2964;
+  uint64_t x2965;
+  uint64_t x2966;
+  uint64_t x2967;
+  uint64_t x2968;
+  uint64_t x2969;
+  fiat_p1024_uint1 x2970;
+  uint64_t x2971;
+  fiat_p1024_uint1 x2972;
+  uint64_t x2973;
+  fiat_p1024_uint1 x2974;
+  uint64_t x2975;
+  fiat_p1024_uint1 x2976;
+  uint64_t x2977;
+  fiat_p1024_uint1 x2978;
+  uint64_t x2979;
+  fiat_p1024_uint1 x2980;
+  uint64_t x2981;
+  fiat_p1024_uint1 x2982;
+  uint64_t x2983;
+  fiat_p1024_uint1 x2984;
+  uint64_t x2985;
+  fiat_p1024_uint1 x2986;
+  uint64_t x2987;
+  fiat_p1024_uint1 x2988;
+  uint64_t x2989;
+  fiat_p1024_uint1 x2990;
+  uint64_t x2991;
+  fiat_p1024_uint1 x2992;
+  uint64_t x2993;
+  fiat_p1024_uint1 x2994;
+  uint64_t x2995;
+  fiat_p1024_uint1 x2996;
+  uint64_t x2997;
+  fiat_p1024_uint1 x2998;
+  uint64_t x2999;
+  uint64_t x3000;
+  fiat_p1024_uint1 x3001;
+  uint64_t x3002;
+  fiat_p1024_uint1 x3003;
+  uint64_t x3004;
+  fiat_p1024_uint1 x3005;
+  uint64_t x3006;
+  fiat_p1024_uint1 x3007;
+  uint64_t x3008;
+  fiat_p1024_uint1 x3009;
+  uint64_t x3010;
+  fiat_p1024_uint1 x3011;
+  uint64_t x3012;
+  fiat_p1024_uint1 x3013;
+  uint64_t x3014;
+  fiat_p1024_uint1 x3015;
+  uint64_t x3016;
+  fiat_p1024_uint1 x3017;
+  uint64_t x3018;
+  fiat_p1024_uint1 x3019;
+  uint64_t x3020;
+  fiat_p1024_uint1 x3021;
+  uint64_t x3022;
+  fiat_p1024_uint1 x3023;
+  uint64_t x3024;
+  fiat_p1024_uint1 x3025;
+  uint64_t x3026;
+  fiat_p1024_uint1 x3027;
+  uint64_t x3028;
+  fiat_p1024_uint1 x3029;
+  uint64_t x3030;
+  fiat_p1024_uint1 x3031;
+  uint64_t x3032;
+  fiat_p1024_uint1 x3033;
+  uint64_t x3034;
+  uint64_t x3035;
+  uint64_t x3036;
+  uint64_t x3037;
+  uint64_t x3038;
+  uint64_t x3039;
+  uint64_t x3040;
+  uint64_t x3041;
+  uint64_t x3042;
+  uint64_t x3043;
+  uint64_t x3044;
+  uint64_t x3045;
+  uint64_t x3046;
+  uint64_t x3047;
+  uint64_t x3048;
+  uint64_t x3049;
+  uint64_t x3050;
+  uint64_t x3051;
+  uint64_t x3052;
+  uint64_t x3053;
+  uint64_t x3054;
+  uint64_t x3055;
+  uint64_t x3056;
+  uint64_t x3057;
+  uint64_t x3058;
+  uint64_t x3059;
+  uint64_t x3060;
+  uint64_t x3061;
+  uint64_t x3062;
+  uint64_t x3063;
+  uint64_t x3064;
+  uint64_t x3065;
+  uint64_t x3066;
+  uint64_t x3067;
+  uint64_t x3068;
+  fiat_p1024_uint1 x3069;
+  uint64_t x3070;
+  fiat_p1024_uint1 x3071;
+  uint64_t x3072;
+  fiat_p1024_uint1 x3073;
+  uint64_t x3074;
+  fiat_p1024_uint1 x3075;
+  uint64_t x3076;
+  fiat_p1024_uint1 x3077;
+  uint64_t x3078;
+  fiat_p1024_uint1 x3079;
+  uint64_t x3080;
+  fiat_p1024_uint1 x3081;
+  uint64_t x3082;
+  fiat_p1024_uint1 x3083;
+  uint64_t x3084;
+  fiat_p1024_uint1 x3085;
+  uint64_t x3086;
+  fiat_p1024_uint1 x3087;
+  uint64_t x3088;
+  fiat_p1024_uint1 x3089;
+  uint64_t x3090;
+  fiat_p1024_uint1 x3091;
+  uint64_t x3092;
+  fiat_p1024_uint1 x3093;
+  uint64_t x3094;
+  fiat_p1024_uint1 x3095;
+  uint64_t x3096;
+  fiat_p1024_uint1 x3097;
+  uint64_t x3098;
+  uint64_t x3099;
+  fiat_p1024_uint1 x3100;
+  uint64_t x3101;
+  fiat_p1024_uint1 x3102;
+  uint64_t x3103;
+  fiat_p1024_uint1 x3104;
+  uint64_t x3105;
+  fiat_p1024_uint1 x3106;
+  uint64_t x3107;
+  fiat_p1024_uint1 x3108;
+  uint64_t x3109;
+  fiat_p1024_uint1 x3110;
+  uint64_t x3111;
+  fiat_p1024_uint1 x3112;
+  uint64_t x3113;
+  fiat_p1024_uint1 x3114;
+  uint64_t x3115;
+  fiat_p1024_uint1 x3116;
+  uint64_t x3117;
+  fiat_p1024_uint1 x3118;
+  uint64_t x3119;
+  fiat_p1024_uint1 x3120;
+  uint64_t x3121;
+  fiat_p1024_uint1 x3122;
+  uint64_t x3123;
+  fiat_p1024_uint1 x3124;
+  uint64_t x3125;
+  fiat_p1024_uint1 x3126;
+  uint64_t x3127;
+  fiat_p1024_uint1 x3128;
+  uint64_t x3129;
+  fiat_p1024_uint1 x3130;
+  uint64_t x3131;
+  fiat_p1024_uint1 x3132;
+  uint64_t x3133;
+  uint64_t x3134;
+  fiat_p1024_uint1 x3135;
+  uint64_t x3136;
+  fiat_p1024_uint1 x3137;
+  uint64_t x3138;
+  fiat_p1024_uint1 x3139;
+  uint64_t x3140;
+  fiat_p1024_uint1 x3141;
+  uint64_t x3142;
+  fiat_p1024_uint1 x3143;
+  uint64_t x3144;
+  fiat_p1024_uint1 x3145;
+  uint64_t x3146;
+  fiat_p1024_uint1 x3147;
+  uint64_t x3148;
+  fiat_p1024_uint1 x3149;
+  uint64_t x3150;
+  fiat_p1024_uint1 x3151;
+  uint64_t x3152;
+  fiat_p1024_uint1 x3153;
+  uint64_t x3154;
+  fiat_p1024_uint1 x3155;
+  uint64_t x3156;
+  fiat_p1024_uint1 x3157;
+  uint64_t x3158;
+  fiat_p1024_uint1 x3159;
+  uint64_t x3160;
+  fiat_p1024_uint1 x3161;
+  uint64_t x3162;
+  fiat_p1024_uint1 x3163;
+  uint64_t x3164;
+  fiat_p1024_uint1 x3165;
+  uint64_t x3166;
+  fiat_p1024_uint1 x3167;
+  uint64_t x3168;
+  uint64_t x3169;
+  uint64_t x3170;
+  uint64_t x3171;
+  uint64_t x3172;
+  uint64_t x3173;
+  uint64_t x3174;
+  uint64_t x3175;
+  uint64_t x3176;
+  uint64_t x3177;
+  uint64_t x3178;
+  uint64_t x3179;
+  uint64_t x3180;
+  uint64_t x3181;
+  uint64_t x3182;
+  uint64_t x3183;
+  x1 = (arg1[1]);
+  x2 = (arg1[2]);
+  x3 = (arg1[3]);
+  x4 = (arg1[4]);
+  x5 = (arg1[5]);
+  x6 = (arg1[6]);
+  x7 = (arg1[7]);
+  x8 = (arg1[8]);
+  x9 = (arg1[9]);
+  x10 = (arg1[10]);
+  x11 = (arg1[11]);
+  x12 = (arg1[12]);
+  x13 = (arg1[13]);
+  x14 = (arg1[14]);
+  x15 = (arg1[15]);
+  x16 = (arg1[0]);
+  fiat_p1024_mulx_u64(&x17, &x18, x16, (arg1[15]));
+  fiat_p1024_mulx_u64(&x19, &x20, x16, (arg1[14]));
+  fiat_p1024_mulx_u64(&x21, &x22, x16, (arg1[13]));
+  fiat_p1024_mulx_u64(&x23, &x24, x16, (arg1[12]));
+  fiat_p1024_mulx_u64(&x25, &x26, x16, (arg1[11]));
+  fiat_p1024_mulx_u64(&x27, &x28, x16, (arg1[10]));
+  fiat_p1024_mulx_u64(&x29, &x30, x16, (arg1[9]));
+  fiat_p1024_mulx_u64(&x31, &x32, x16, (arg1[8]));
+  fiat_p1024_mulx_u64(&x33, &x34, x16, (arg1[7]));
+  fiat_p1024_mulx_u64(&x35, &x36, x16, (arg1[6]));
+  fiat_p1024_mulx_u64(&x37, &x38, x16, (arg1[5]));
+  fiat_p1024_mulx_u64(&x39, &x40, x16, (arg1[4]));
+  fiat_p1024_mulx_u64(&x41, &x42, x16, (arg1[3]));
+  fiat_p1024_mulx_u64(&x43, &x44, x16, (arg1[2]));
+  fiat_p1024_mulx_u64(&x45, &x46, x16, (arg1[1]));
+  fiat_p1024_mulx_u64(&x47, &x48, x16, (arg1[0]));
+  fiat_p1024_addcarryx_u64(&x49, &x50, 0x0, x48, x45);
+  fiat_p1024_addcarryx_u64(&x51, &x52, x50, x46, x43);
+  fiat_p1024_addcarryx_u64(&x53, &x54, x52, x44, x41);
+  fiat_p1024_addcarryx_u64(&x55, &x56, x54, x42, x39);
+  fiat_p1024_addcarryx_u64(&x57, &x58, x56, x40, x37);
+  fiat_p1024_addcarryx_u64(&x59, &x60, x58, x38, x35);
+  fiat_p1024_addcarryx_u64(&x61, &x62, x60, x36, x33);
+  fiat_p1024_addcarryx_u64(&x63, &x64, x62, x34, x31);
+  fiat_p1024_addcarryx_u64(&x65, &x66, x64, x32, x29);
+  fiat_p1024_addcarryx_u64(&x67, &x68, x66, x30, x27);
+  fiat_p1024_addcarryx_u64(&x69, &x70, x68, x28, x25);
+  fiat_p1024_addcarryx_u64(&x71, &x72, x70, x26, x23);
+  fiat_p1024_addcarryx_u64(&x73, &x74, x72, x24, x21);
+  fiat_p1024_addcarryx_u64(&x75, &x76, x74, x22, x19);
+  fiat_p1024_addcarryx_u64(&x77, &x78, x76, x20, x17);
+  x79 = (x78 + x18);
+  fiat_p1024_mulx_u64(&x80, &x81, x47, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x82, &x83, x80, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x84, &x85, x80, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x86, &x87, x80, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x88, &x89, x80, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x90, &x91, x80, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x92, &x93, x80, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x94, &x95, x80, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x96, &x97, x80, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x98, &x99, x80, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x100, &x101, x80, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x102, &x103, x80, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x104, &x105, x80, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x106, &x107, x80, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x108, &x109, x80, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x110, &x111, x80, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x112, &x113, x80, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x114, &x115, 0x0, x113, x110);
+  fiat_p1024_addcarryx_u64(&x116, &x117, x115, x111, x108);
+  fiat_p1024_addcarryx_u64(&x118, &x119, x117, x109, x106);
+  fiat_p1024_addcarryx_u64(&x120, &x121, x119, x107, x104);
+  fiat_p1024_addcarryx_u64(&x122, &x123, x121, x105, x102);
+  fiat_p1024_addcarryx_u64(&x124, &x125, x123, x103, x100);
+  fiat_p1024_addcarryx_u64(&x126, &x127, x125, x101, x98);
+  fiat_p1024_addcarryx_u64(&x128, &x129, x127, x99, x96);
+  fiat_p1024_addcarryx_u64(&x130, &x131, x129, x97, x94);
+  fiat_p1024_addcarryx_u64(&x132, &x133, x131, x95, x92);
+  fiat_p1024_addcarryx_u64(&x134, &x135, x133, x93, x90);
+  fiat_p1024_addcarryx_u64(&x136, &x137, x135, x91, x88);
+  fiat_p1024_addcarryx_u64(&x138, &x139, x137, x89, x86);
+  fiat_p1024_addcarryx_u64(&x140, &x141, x139, x87, x84);
+  fiat_p1024_addcarryx_u64(&x142, &x143, x141, x85, x82);
+  x144 = (x143 + x83);
+  fiat_p1024_addcarryx_u64(&x145, &x146, 0x0, x47, x112);
+  fiat_p1024_addcarryx_u64(&x147, &x148, x146, x49, x114);
+  fiat_p1024_addcarryx_u64(&x149, &x150, x148, x51, x116);
+  fiat_p1024_addcarryx_u64(&x151, &x152, x150, x53, x118);
+  fiat_p1024_addcarryx_u64(&x153, &x154, x152, x55, x120);
+  fiat_p1024_addcarryx_u64(&x155, &x156, x154, x57, x122);
+  fiat_p1024_addcarryx_u64(&x157, &x158, x156, x59, x124);
+  fiat_p1024_addcarryx_u64(&x159, &x160, x158, x61, x126);
+  fiat_p1024_addcarryx_u64(&x161, &x162, x160, x63, x128);
+  fiat_p1024_addcarryx_u64(&x163, &x164, x162, x65, x130);
+  fiat_p1024_addcarryx_u64(&x165, &x166, x164, x67, x132);
+  fiat_p1024_addcarryx_u64(&x167, &x168, x166, x69, x134);
+  fiat_p1024_addcarryx_u64(&x169, &x170, x168, x71, x136);
+  fiat_p1024_addcarryx_u64(&x171, &x172, x170, x73, x138);
+  fiat_p1024_addcarryx_u64(&x173, &x174, x172, x75, x140);
+  fiat_p1024_addcarryx_u64(&x175, &x176, x174, x77, x142);
+  fiat_p1024_addcarryx_u64(&x177, &x178, x176, x79, x144);
+  fiat_p1024_mulx_u64(&x179, &x180, x1, (arg1[15]));
+  fiat_p1024_mulx_u64(&x181, &x182, x1, (arg1[14]));
+  fiat_p1024_mulx_u64(&x183, &x184, x1, (arg1[13]));
+  fiat_p1024_mulx_u64(&x185, &x186, x1, (arg1[12]));
+  fiat_p1024_mulx_u64(&x187, &x188, x1, (arg1[11]));
+  fiat_p1024_mulx_u64(&x189, &x190, x1, (arg1[10]));
+  fiat_p1024_mulx_u64(&x191, &x192, x1, (arg1[9]));
+  fiat_p1024_mulx_u64(&x193, &x194, x1, (arg1[8]));
+  fiat_p1024_mulx_u64(&x195, &x196, x1, (arg1[7]));
+  fiat_p1024_mulx_u64(&x197, &x198, x1, (arg1[6]));
+  fiat_p1024_mulx_u64(&x199, &x200, x1, (arg1[5]));
+  fiat_p1024_mulx_u64(&x201, &x202, x1, (arg1[4]));
+  fiat_p1024_mulx_u64(&x203, &x204, x1, (arg1[3]));
+  fiat_p1024_mulx_u64(&x205, &x206, x1, (arg1[2]));
+  fiat_p1024_mulx_u64(&x207, &x208, x1, (arg1[1]));
+  fiat_p1024_mulx_u64(&x209, &x210, x1, (arg1[0]));
+  fiat_p1024_addcarryx_u64(&x211, &x212, 0x0, x210, x207);
+  fiat_p1024_addcarryx_u64(&x213, &x214, x212, x208, x205);
+  fiat_p1024_addcarryx_u64(&x215, &x216, x214, x206, x203);
+  fiat_p1024_addcarryx_u64(&x217, &x218, x216, x204, x201);
+  fiat_p1024_addcarryx_u64(&x219, &x220, x218, x202, x199);
+  fiat_p1024_addcarryx_u64(&x221, &x222, x220, x200, x197);
+  fiat_p1024_addcarryx_u64(&x223, &x224, x222, x198, x195);
+  fiat_p1024_addcarryx_u64(&x225, &x226, x224, x196, x193);
+  fiat_p1024_addcarryx_u64(&x227, &x228, x226, x194, x191);
+  fiat_p1024_addcarryx_u64(&x229, &x230, x228, x192, x189);
+  fiat_p1024_addcarryx_u64(&x231, &x232, x230, x190, x187);
+  fiat_p1024_addcarryx_u64(&x233, &x234, x232, x188, x185);
+  fiat_p1024_addcarryx_u64(&x235, &x236, x234, x186, x183);
+  fiat_p1024_addcarryx_u64(&x237, &x238, x236, x184, x181);
+  fiat_p1024_addcarryx_u64(&x239, &x240, x238, x182, x179);
+  x241 = (x240 + x180);
+  fiat_p1024_addcarryx_u64(&x242, &x243, 0x0, x147, x209);
+  fiat_p1024_addcarryx_u64(&x244, &x245, x243, x149, x211);
+  fiat_p1024_addcarryx_u64(&x246, &x247, x245, x151, x213);
+  fiat_p1024_addcarryx_u64(&x248, &x249, x247, x153, x215);
+  fiat_p1024_addcarryx_u64(&x250, &x251, x249, x155, x217);
+  fiat_p1024_addcarryx_u64(&x252, &x253, x251, x157, x219);
+  fiat_p1024_addcarryx_u64(&x254, &x255, x253, x159, x221);
+  fiat_p1024_addcarryx_u64(&x256, &x257, x255, x161, x223);
+  fiat_p1024_addcarryx_u64(&x258, &x259, x257, x163, x225);
+  fiat_p1024_addcarryx_u64(&x260, &x261, x259, x165, x227);
+  fiat_p1024_addcarryx_u64(&x262, &x263, x261, x167, x229);
+  fiat_p1024_addcarryx_u64(&x264, &x265, x263, x169, x231);
+  fiat_p1024_addcarryx_u64(&x266, &x267, x265, x171, x233);
+  fiat_p1024_addcarryx_u64(&x268, &x269, x267, x173, x235);
+  fiat_p1024_addcarryx_u64(&x270, &x271, x269, x175, x237);
+  fiat_p1024_addcarryx_u64(&x272, &x273, x271, x177, x239);
+  fiat_p1024_addcarryx_u64(&x274, &x275, x273, x178, x241);
+  fiat_p1024_mulx_u64(&x276, &x277, x242, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x278, &x279, x276, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x280, &x281, x276, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x282, &x283, x276, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x284, &x285, x276, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x286, &x287, x276, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x288, &x289, x276, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x290, &x291, x276, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x292, &x293, x276, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x294, &x295, x276, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x296, &x297, x276, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x298, &x299, x276, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x300, &x301, x276, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x302, &x303, x276, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x304, &x305, x276, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x306, &x307, x276, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x308, &x309, x276, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x310, &x311, 0x0, x309, x306);
+  fiat_p1024_addcarryx_u64(&x312, &x313, x311, x307, x304);
+  fiat_p1024_addcarryx_u64(&x314, &x315, x313, x305, x302);
+  fiat_p1024_addcarryx_u64(&x316, &x317, x315, x303, x300);
+  fiat_p1024_addcarryx_u64(&x318, &x319, x317, x301, x298);
+  fiat_p1024_addcarryx_u64(&x320, &x321, x319, x299, x296);
+  fiat_p1024_addcarryx_u64(&x322, &x323, x321, x297, x294);
+  fiat_p1024_addcarryx_u64(&x324, &x325, x323, x295, x292);
+  fiat_p1024_addcarryx_u64(&x326, &x327, x325, x293, x290);
+  fiat_p1024_addcarryx_u64(&x328, &x329, x327, x291, x288);
+  fiat_p1024_addcarryx_u64(&x330, &x331, x329, x289, x286);
+  fiat_p1024_addcarryx_u64(&x332, &x333, x331, x287, x284);
+  fiat_p1024_addcarryx_u64(&x334, &x335, x333, x285, x282);
+  fiat_p1024_addcarryx_u64(&x336, &x337, x335, x283, x280);
+  fiat_p1024_addcarryx_u64(&x338, &x339, x337, x281, x278);
+  x340 = (x339 + x279);
+  fiat_p1024_addcarryx_u64(&x341, &x342, 0x0, x242, x308);
+  fiat_p1024_addcarryx_u64(&x343, &x344, x342, x244, x310);
+  fiat_p1024_addcarryx_u64(&x345, &x346, x344, x246, x312);
+  fiat_p1024_addcarryx_u64(&x347, &x348, x346, x248, x314);
+  fiat_p1024_addcarryx_u64(&x349, &x350, x348, x250, x316);
+  fiat_p1024_addcarryx_u64(&x351, &x352, x350, x252, x318);
+  fiat_p1024_addcarryx_u64(&x353, &x354, x352, x254, x320);
+  fiat_p1024_addcarryx_u64(&x355, &x356, x354, x256, x322);
+  fiat_p1024_addcarryx_u64(&x357, &x358, x356, x258, x324);
+  fiat_p1024_addcarryx_u64(&x359, &x360, x358, x260, x326);
+  fiat_p1024_addcarryx_u64(&x361, &x362, x360, x262, x328);
+  fiat_p1024_addcarryx_u64(&x363, &x364, x362, x264, x330);
+  fiat_p1024_addcarryx_u64(&x365, &x366, x364, x266, x332);
+  fiat_p1024_addcarryx_u64(&x367, &x368, x366, x268, x334);
+  fiat_p1024_addcarryx_u64(&x369, &x370, x368, x270, x336);
+  fiat_p1024_addcarryx_u64(&x371, &x372, x370, x272, x338);
+  fiat_p1024_addcarryx_u64(&x373, &x374, x372, x274, x340);
+  x375 = ((uint64_t)x374 + x275);
+  fiat_p1024_mulx_u64(&x376, &x377, x2, (arg1[15]));
+  fiat_p1024_mulx_u64(&x378, &x379, x2, (arg1[14]));
+  fiat_p1024_mulx_u64(&x380, &x381, x2, (arg1[13]));
+  fiat_p1024_mulx_u64(&x382, &x383, x2, (arg1[12]));
+  fiat_p1024_mulx_u64(&x384, &x385, x2, (arg1[11]));
+  fiat_p1024_mulx_u64(&x386, &x387, x2, (arg1[10]));
+  fiat_p1024_mulx_u64(&x388, &x389, x2, (arg1[9]));
+  fiat_p1024_mulx_u64(&x390, &x391, x2, (arg1[8]));
+  fiat_p1024_mulx_u64(&x392, &x393, x2, (arg1[7]));
+  fiat_p1024_mulx_u64(&x394, &x395, x2, (arg1[6]));
+  fiat_p1024_mulx_u64(&x396, &x397, x2, (arg1[5]));
+  fiat_p1024_mulx_u64(&x398, &x399, x2, (arg1[4]));
+  fiat_p1024_mulx_u64(&x400, &x401, x2, (arg1[3]));
+  fiat_p1024_mulx_u64(&x402, &x403, x2, (arg1[2]));
+  fiat_p1024_mulx_u64(&x404, &x405, x2, (arg1[1]));
+  fiat_p1024_mulx_u64(&x406, &x407, x2, (arg1[0]));
+  fiat_p1024_addcarryx_u64(&x408, &x409, 0x0, x407, x404);
+  fiat_p1024_addcarryx_u64(&x410, &x411, x409, x405, x402);
+  fiat_p1024_addcarryx_u64(&x412, &x413, x411, x403, x400);
+  fiat_p1024_addcarryx_u64(&x414, &x415, x413, x401, x398);
+  fiat_p1024_addcarryx_u64(&x416, &x417, x415, x399, x396);
+  fiat_p1024_addcarryx_u64(&x418, &x419, x417, x397, x394);
+  fiat_p1024_addcarryx_u64(&x420, &x421, x419, x395, x392);
+  fiat_p1024_addcarryx_u64(&x422, &x423, x421, x393, x390);
+  fiat_p1024_addcarryx_u64(&x424, &x425, x423, x391, x388);
+  fiat_p1024_addcarryx_u64(&x426, &x427, x425, x389, x386);
+  fiat_p1024_addcarryx_u64(&x428, &x429, x427, x387, x384);
+  fiat_p1024_addcarryx_u64(&x430, &x431, x429, x385, x382);
+  fiat_p1024_addcarryx_u64(&x432, &x433, x431, x383, x380);
+  fiat_p1024_addcarryx_u64(&x434, &x435, x433, x381, x378);
+  fiat_p1024_addcarryx_u64(&x436, &x437, x435, x379, x376);
+  x438 = (x437 + x377);
+  fiat_p1024_addcarryx_u64(&x439, &x440, 0x0, x343, x406);
+  fiat_p1024_addcarryx_u64(&x441, &x442, x440, x345, x408);
+  fiat_p1024_addcarryx_u64(&x443, &x444, x442, x347, x410);
+  fiat_p1024_addcarryx_u64(&x445, &x446, x444, x349, x412);
+  fiat_p1024_addcarryx_u64(&x447, &x448, x446, x351, x414);
+  fiat_p1024_addcarryx_u64(&x449, &x450, x448, x353, x416);
+  fiat_p1024_addcarryx_u64(&x451, &x452, x450, x355, x418);
+  fiat_p1024_addcarryx_u64(&x453, &x454, x452, x357, x420);
+  fiat_p1024_addcarryx_u64(&x455, &x456, x454, x359, x422);
+  fiat_p1024_addcarryx_u64(&x457, &x458, x456, x361, x424);
+  fiat_p1024_addcarryx_u64(&x459, &x460, x458, x363, x426);
+  fiat_p1024_addcarryx_u64(&x461, &x462, x460, x365, x428);
+  fiat_p1024_addcarryx_u64(&x463, &x464, x462, x367, x430);
+  fiat_p1024_addcarryx_u64(&x465, &x466, x464, x369, x432);
+  fiat_p1024_addcarryx_u64(&x467, &x468, x466, x371, x434);
+  fiat_p1024_addcarryx_u64(&x469, &x470, x468, x373, x436);
+  fiat_p1024_addcarryx_u64(&x471, &x472, x470, x375, x438);
+  fiat_p1024_mulx_u64(&x473, &x474, x439, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x475, &x476, x473, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x477, &x478, x473, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x479, &x480, x473, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x481, &x482, x473, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x483, &x484, x473, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x485, &x486, x473, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x487, &x488, x473, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x489, &x490, x473, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x491, &x492, x473, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x493, &x494, x473, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x495, &x496, x473, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x497, &x498, x473, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x499, &x500, x473, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x501, &x502, x473, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x503, &x504, x473, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x505, &x506, x473, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x507, &x508, 0x0, x506, x503);
+  fiat_p1024_addcarryx_u64(&x509, &x510, x508, x504, x501);
+  fiat_p1024_addcarryx_u64(&x511, &x512, x510, x502, x499);
+  fiat_p1024_addcarryx_u64(&x513, &x514, x512, x500, x497);
+  fiat_p1024_addcarryx_u64(&x515, &x516, x514, x498, x495);
+  fiat_p1024_addcarryx_u64(&x517, &x518, x516, x496, x493);
+  fiat_p1024_addcarryx_u64(&x519, &x520, x518, x494, x491);
+  fiat_p1024_addcarryx_u64(&x521, &x522, x520, x492, x489);
+  fiat_p1024_addcarryx_u64(&x523, &x524, x522, x490, x487);
+  fiat_p1024_addcarryx_u64(&x525, &x526, x524, x488, x485);
+  fiat_p1024_addcarryx_u64(&x527, &x528, x526, x486, x483);
+  fiat_p1024_addcarryx_u64(&x529, &x530, x528, x484, x481);
+  fiat_p1024_addcarryx_u64(&x531, &x532, x530, x482, x479);
+  fiat_p1024_addcarryx_u64(&x533, &x534, x532, x480, x477);
+  fiat_p1024_addcarryx_u64(&x535, &x536, x534, x478, x475);
+  x537 = (x536 + x476);
+  fiat_p1024_addcarryx_u64(&x538, &x539, 0x0, x439, x505);
+  fiat_p1024_addcarryx_u64(&x540, &x541, x539, x441, x507);
+  fiat_p1024_addcarryx_u64(&x542, &x543, x541, x443, x509);
+  fiat_p1024_addcarryx_u64(&x544, &x545, x543, x445, x511);
+  fiat_p1024_addcarryx_u64(&x546, &x547, x545, x447, x513);
+  fiat_p1024_addcarryx_u64(&x548, &x549, x547, x449, x515);
+  fiat_p1024_addcarryx_u64(&x550, &x551, x549, x451, x517);
+  fiat_p1024_addcarryx_u64(&x552, &x553, x551, x453, x519);
+  fiat_p1024_addcarryx_u64(&x554, &x555, x553, x455, x521);
+  fiat_p1024_addcarryx_u64(&x556, &x557, x555, x457, x523);
+  fiat_p1024_addcarryx_u64(&x558, &x559, x557, x459, x525);
+  fiat_p1024_addcarryx_u64(&x560, &x561, x559, x461, x527);
+  fiat_p1024_addcarryx_u64(&x562, &x563, x561, x463, x529);
+  fiat_p1024_addcarryx_u64(&x564, &x565, x563, x465, x531);
+  fiat_p1024_addcarryx_u64(&x566, &x567, x565, x467, x533);
+  fiat_p1024_addcarryx_u64(&x568, &x569, x567, x469, x535);
+  fiat_p1024_addcarryx_u64(&x570, &x571, x569, x471, x537);
+  x572 = ((uint64_t)x571 + x472);
+  fiat_p1024_mulx_u64(&x573, &x574, x3, (arg1[15]));
+  fiat_p1024_mulx_u64(&x575, &x576, x3, (arg1[14]));
+  fiat_p1024_mulx_u64(&x577, &x578, x3, (arg1[13]));
+  fiat_p1024_mulx_u64(&x579, &x580, x3, (arg1[12]));
+  fiat_p1024_mulx_u64(&x581, &x582, x3, (arg1[11]));
+  fiat_p1024_mulx_u64(&x583, &x584, x3, (arg1[10]));
+  fiat_p1024_mulx_u64(&x585, &x586, x3, (arg1[9]));
+  fiat_p1024_mulx_u64(&x587, &x588, x3, (arg1[8]));
+  fiat_p1024_mulx_u64(&x589, &x590, x3, (arg1[7]));
+  fiat_p1024_mulx_u64(&x591, &x592, x3, (arg1[6]));
+  fiat_p1024_mulx_u64(&x593, &x594, x3, (arg1[5]));
+  fiat_p1024_mulx_u64(&x595, &x596, x3, (arg1[4]));
+  fiat_p1024_mulx_u64(&x597, &x598, x3, (arg1[3]));
+  fiat_p1024_mulx_u64(&x599, &x600, x3, (arg1[2]));
+  fiat_p1024_mulx_u64(&x601, &x602, x3, (arg1[1]));
+  fiat_p1024_mulx_u64(&x603, &x604, x3, (arg1[0]));
+  fiat_p1024_addcarryx_u64(&x605, &x606, 0x0, x604, x601);
+  fiat_p1024_addcarryx_u64(&x607, &x608, x606, x602, x599);
+  fiat_p1024_addcarryx_u64(&x609, &x610, x608, x600, x597);
+  fiat_p1024_addcarryx_u64(&x611, &x612, x610, x598, x595);
+  fiat_p1024_addcarryx_u64(&x613, &x614, x612, x596, x593);
+  fiat_p1024_addcarryx_u64(&x615, &x616, x614, x594, x591);
+  fiat_p1024_addcarryx_u64(&x617, &x618, x616, x592, x589);
+  fiat_p1024_addcarryx_u64(&x619, &x620, x618, x590, x587);
+  fiat_p1024_addcarryx_u64(&x621, &x622, x620, x588, x585);
+  fiat_p1024_addcarryx_u64(&x623, &x624, x622, x586, x583);
+  fiat_p1024_addcarryx_u64(&x625, &x626, x624, x584, x581);
+  fiat_p1024_addcarryx_u64(&x627, &x628, x626, x582, x579);
+  fiat_p1024_addcarryx_u64(&x629, &x630, x628, x580, x577);
+  fiat_p1024_addcarryx_u64(&x631, &x632, x630, x578, x575);
+  fiat_p1024_addcarryx_u64(&x633, &x634, x632, x576, x573);
+  x635 = (x634 + x574);
+  fiat_p1024_addcarryx_u64(&x636, &x637, 0x0, x540, x603);
+  fiat_p1024_addcarryx_u64(&x638, &x639, x637, x542, x605);
+  fiat_p1024_addcarryx_u64(&x640, &x641, x639, x544, x607);
+  fiat_p1024_addcarryx_u64(&x642, &x643, x641, x546, x609);
+  fiat_p1024_addcarryx_u64(&x644, &x645, x643, x548, x611);
+  fiat_p1024_addcarryx_u64(&x646, &x647, x645, x550, x613);
+  fiat_p1024_addcarryx_u64(&x648, &x649, x647, x552, x615);
+  fiat_p1024_addcarryx_u64(&x650, &x651, x649, x554, x617);
+  fiat_p1024_addcarryx_u64(&x652, &x653, x651, x556, x619);
+  fiat_p1024_addcarryx_u64(&x654, &x655, x653, x558, x621);
+  fiat_p1024_addcarryx_u64(&x656, &x657, x655, x560, x623);
+  fiat_p1024_addcarryx_u64(&x658, &x659, x657, x562, x625);
+  fiat_p1024_addcarryx_u64(&x660, &x661, x659, x564, x627);
+  fiat_p1024_addcarryx_u64(&x662, &x663, x661, x566, x629);
+  fiat_p1024_addcarryx_u64(&x664, &x665, x663, x568, x631);
+  fiat_p1024_addcarryx_u64(&x666, &x667, x665, x570, x633);
+  fiat_p1024_addcarryx_u64(&x668, &x669, x667, x572, x635);
+  fiat_p1024_mulx_u64(&x670, &x671, x636, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x672, &x673, x670, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x674, &x675, x670, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x676, &x677, x670, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x678, &x679, x670, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x680, &x681, x670, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x682, &x683, x670, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x684, &x685, x670, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x686, &x687, x670, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x688, &x689, x670, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x690, &x691, x670, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x692, &x693, x670, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x694, &x695, x670, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x696, &x697, x670, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x698, &x699, x670, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x700, &x701, x670, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x702, &x703, x670, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x704, &x705, 0x0, x703, x700);
+  fiat_p1024_addcarryx_u64(&x706, &x707, x705, x701, x698);
+  fiat_p1024_addcarryx_u64(&x708, &x709, x707, x699, x696);
+  fiat_p1024_addcarryx_u64(&x710, &x711, x709, x697, x694);
+  fiat_p1024_addcarryx_u64(&x712, &x713, x711, x695, x692);
+  fiat_p1024_addcarryx_u64(&x714, &x715, x713, x693, x690);
+  fiat_p1024_addcarryx_u64(&x716, &x717, x715, x691, x688);
+  fiat_p1024_addcarryx_u64(&x718, &x719, x717, x689, x686);
+  fiat_p1024_addcarryx_u64(&x720, &x721, x719, x687, x684);
+  fiat_p1024_addcarryx_u64(&x722, &x723, x721, x685, x682);
+  fiat_p1024_addcarryx_u64(&x724, &x725, x723, x683, x680);
+  fiat_p1024_addcarryx_u64(&x726, &x727, x725, x681, x678);
+  fiat_p1024_addcarryx_u64(&x728, &x729, x727, x679, x676);
+  fiat_p1024_addcarryx_u64(&x730, &x731, x729, x677, x674);
+  fiat_p1024_addcarryx_u64(&x732, &x733, x731, x675, x672);
+  x734 = (x733 + x673);
+  fiat_p1024_addcarryx_u64(&x735, &x736, 0x0, x636, x702);
+  fiat_p1024_addcarryx_u64(&x737, &x738, x736, x638, x704);
+  fiat_p1024_addcarryx_u64(&x739, &x740, x738, x640, x706);
+  fiat_p1024_addcarryx_u64(&x741, &x742, x740, x642, x708);
+  fiat_p1024_addcarryx_u64(&x743, &x744, x742, x644, x710);
+  fiat_p1024_addcarryx_u64(&x745, &x746, x744, x646, x712);
+  fiat_p1024_addcarryx_u64(&x747, &x748, x746, x648, x714);
+  fiat_p1024_addcarryx_u64(&x749, &x750, x748, x650, x716);
+  fiat_p1024_addcarryx_u64(&x751, &x752, x750, x652, x718);
+  fiat_p1024_addcarryx_u64(&x753, &x754, x752, x654, x720);
+  fiat_p1024_addcarryx_u64(&x755, &x756, x754, x656, x722);
+  fiat_p1024_addcarryx_u64(&x757, &x758, x756, x658, x724);
+  fiat_p1024_addcarryx_u64(&x759, &x760, x758, x660, x726);
+  fiat_p1024_addcarryx_u64(&x761, &x762, x760, x662, x728);
+  fiat_p1024_addcarryx_u64(&x763, &x764, x762, x664, x730);
+  fiat_p1024_addcarryx_u64(&x765, &x766, x764, x666, x732);
+  fiat_p1024_addcarryx_u64(&x767, &x768, x766, x668, x734);
+  x769 = ((uint64_t)x768 + x669);
+  fiat_p1024_mulx_u64(&x770, &x771, x4, (arg1[15]));
+  fiat_p1024_mulx_u64(&x772, &x773, x4, (arg1[14]));
+  fiat_p1024_mulx_u64(&x774, &x775, x4, (arg1[13]));
+  fiat_p1024_mulx_u64(&x776, &x777, x4, (arg1[12]));
+  fiat_p1024_mulx_u64(&x778, &x779, x4, (arg1[11]));
+  fiat_p1024_mulx_u64(&x780, &x781, x4, (arg1[10]));
+  fiat_p1024_mulx_u64(&x782, &x783, x4, (arg1[9]));
+  fiat_p1024_mulx_u64(&x784, &x785, x4, (arg1[8]));
+  fiat_p1024_mulx_u64(&x786, &x787, x4, (arg1[7]));
+  fiat_p1024_mulx_u64(&x788, &x789, x4, (arg1[6]));
+  fiat_p1024_mulx_u64(&x790, &x791, x4, (arg1[5]));
+  fiat_p1024_mulx_u64(&x792, &x793, x4, (arg1[4]));
+  fiat_p1024_mulx_u64(&x794, &x795, x4, (arg1[3]));
+  fiat_p1024_mulx_u64(&x796, &x797, x4, (arg1[2]));
+  fiat_p1024_mulx_u64(&x798, &x799, x4, (arg1[1]));
+  fiat_p1024_mulx_u64(&x800, &x801, x4, (arg1[0]));
+  fiat_p1024_addcarryx_u64(&x802, &x803, 0x0, x801, x798);
+  fiat_p1024_addcarryx_u64(&x804, &x805, x803, x799, x796);
+  fiat_p1024_addcarryx_u64(&x806, &x807, x805, x797, x794);
+  fiat_p1024_addcarryx_u64(&x808, &x809, x807, x795, x792);
+  fiat_p1024_addcarryx_u64(&x810, &x811, x809, x793, x790);
+  fiat_p1024_addcarryx_u64(&x812, &x813, x811, x791, x788);
+  fiat_p1024_addcarryx_u64(&x814, &x815, x813, x789, x786);
+  fiat_p1024_addcarryx_u64(&x816, &x817, x815, x787, x784);
+  fiat_p1024_addcarryx_u64(&x818, &x819, x817, x785, x782);
+  fiat_p1024_addcarryx_u64(&x820, &x821, x819, x783, x780);
+  fiat_p1024_addcarryx_u64(&x822, &x823, x821, x781, x778);
+  fiat_p1024_addcarryx_u64(&x824, &x825, x823, x779, x776);
+  fiat_p1024_addcarryx_u64(&x826, &x827, x825, x777, x774);
+  fiat_p1024_addcarryx_u64(&x828, &x829, x827, x775, x772);
+  fiat_p1024_addcarryx_u64(&x830, &x831, x829, x773, x770);
+  x832 = (x831 + x771);
+  fiat_p1024_addcarryx_u64(&x833, &x834, 0x0, x737, x800);
+  fiat_p1024_addcarryx_u64(&x835, &x836, x834, x739, x802);
+  fiat_p1024_addcarryx_u64(&x837, &x838, x836, x741, x804);
+  fiat_p1024_addcarryx_u64(&x839, &x840, x838, x743, x806);
+  fiat_p1024_addcarryx_u64(&x841, &x842, x840, x745, x808);
+  fiat_p1024_addcarryx_u64(&x843, &x844, x842, x747, x810);
+  fiat_p1024_addcarryx_u64(&x845, &x846, x844, x749, x812);
+  fiat_p1024_addcarryx_u64(&x847, &x848, x846, x751, x814);
+  fiat_p1024_addcarryx_u64(&x849, &x850, x848, x753, x816);
+  fiat_p1024_addcarryx_u64(&x851, &x852, x850, x755, x818);
+  fiat_p1024_addcarryx_u64(&x853, &x854, x852, x757, x820);
+  fiat_p1024_addcarryx_u64(&x855, &x856, x854, x759, x822);
+  fiat_p1024_addcarryx_u64(&x857, &x858, x856, x761, x824);
+  fiat_p1024_addcarryx_u64(&x859, &x860, x858, x763, x826);
+  fiat_p1024_addcarryx_u64(&x861, &x862, x860, x765, x828);
+  fiat_p1024_addcarryx_u64(&x863, &x864, x862, x767, x830);
+  fiat_p1024_addcarryx_u64(&x865, &x866, x864, x769, x832);
+  fiat_p1024_mulx_u64(&x867, &x868, x833, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x869, &x870, x867, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x871, &x872, x867, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x873, &x874, x867, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x875, &x876, x867, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x877, &x878, x867, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x879, &x880, x867, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x881, &x882, x867, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x883, &x884, x867, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x885, &x886, x867, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x887, &x888, x867, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x889, &x890, x867, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x891, &x892, x867, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x893, &x894, x867, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x895, &x896, x867, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x897, &x898, x867, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x899, &x900, x867, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x901, &x902, 0x0, x900, x897);
+  fiat_p1024_addcarryx_u64(&x903, &x904, x902, x898, x895);
+  fiat_p1024_addcarryx_u64(&x905, &x906, x904, x896, x893);
+  fiat_p1024_addcarryx_u64(&x907, &x908, x906, x894, x891);
+  fiat_p1024_addcarryx_u64(&x909, &x910, x908, x892, x889);
+  fiat_p1024_addcarryx_u64(&x911, &x912, x910, x890, x887);
+  fiat_p1024_addcarryx_u64(&x913, &x914, x912, x888, x885);
+  fiat_p1024_addcarryx_u64(&x915, &x916, x914, x886, x883);
+  fiat_p1024_addcarryx_u64(&x917, &x918, x916, x884, x881);
+  fiat_p1024_addcarryx_u64(&x919, &x920, x918, x882, x879);
+  fiat_p1024_addcarryx_u64(&x921, &x922, x920, x880, x877);
+  fiat_p1024_addcarryx_u64(&x923, &x924, x922, x878, x875);
+  fiat_p1024_addcarryx_u64(&x925, &x926, x924, x876, x873);
+  fiat_p1024_addcarryx_u64(&x927, &x928, x926, x874, x871);
+  fiat_p1024_addcarryx_u64(&x929, &x930, x928, x872, x869);
+  x931 = (x930 + x870);
+  fiat_p1024_addcarryx_u64(&x932, &x933, 0x0, x833, x899);
+  fiat_p1024_addcarryx_u64(&x934, &x935, x933, x835, x901);
+  fiat_p1024_addcarryx_u64(&x936, &x937, x935, x837, x903);
+  fiat_p1024_addcarryx_u64(&x938, &x939, x937, x839, x905);
+  fiat_p1024_addcarryx_u64(&x940, &x941, x939, x841, x907);
+  fiat_p1024_addcarryx_u64(&x942, &x943, x941, x843, x909);
+  fiat_p1024_addcarryx_u64(&x944, &x945, x943, x845, x911);
+  fiat_p1024_addcarryx_u64(&x946, &x947, x945, x847, x913);
+  fiat_p1024_addcarryx_u64(&x948, &x949, x947, x849, x915);
+  fiat_p1024_addcarryx_u64(&x950, &x951, x949, x851, x917);
+  fiat_p1024_addcarryx_u64(&x952, &x953, x951, x853, x919);
+  fiat_p1024_addcarryx_u64(&x954, &x955, x953, x855, x921);
+  fiat_p1024_addcarryx_u64(&x956, &x957, x955, x857, x923);
+  fiat_p1024_addcarryx_u64(&x958, &x959, x957, x859, x925);
+  fiat_p1024_addcarryx_u64(&x960, &x961, x959, x861, x927);
+  fiat_p1024_addcarryx_u64(&x962, &x963, x961, x863, x929);
+  fiat_p1024_addcarryx_u64(&x964, &x965, x963, x865, x931);
+  x966 = ((uint64_t)x965 + x866);
+  fiat_p1024_mulx_u64(&x967, &x968, x5, (arg1[15]));
+  fiat_p1024_mulx_u64(&x969, &x970, x5, (arg1[14]));
+  fiat_p1024_mulx_u64(&x971, &x972, x5, (arg1[13]));
+  fiat_p1024_mulx_u64(&x973, &x974, x5, (arg1[12]));
+  fiat_p1024_mulx_u64(&x975, &x976, x5, (arg1[11]));
+  fiat_p1024_mulx_u64(&x977, &x978, x5, (arg1[10]));
+  fiat_p1024_mulx_u64(&x979, &x980, x5, (arg1[9]));
+  fiat_p1024_mulx_u64(&x981, &x982, x5, (arg1[8]));
+  fiat_p1024_mulx_u64(&x983, &x984, x5, (arg1[7]));
+  fiat_p1024_mulx_u64(&x985, &x986, x5, (arg1[6]));
+  fiat_p1024_mulx_u64(&x987, &x988, x5, (arg1[5]));
+  fiat_p1024_mulx_u64(&x989, &x990, x5, (arg1[4]));
+  fiat_p1024_mulx_u64(&x991, &x992, x5, (arg1[3]));
+  fiat_p1024_mulx_u64(&x993, &x994, x5, (arg1[2]));
+  fiat_p1024_mulx_u64(&x995, &x996, x5, (arg1[1]));
+  fiat_p1024_mulx_u64(&x997, &x998, x5, (arg1[0]));
+  fiat_p1024_addcarryx_u64(&x999, &x1000, 0x0, x998, x995);
+  fiat_p1024_addcarryx_u64(&x1001, &x1002, x1000, x996, x993);
+  fiat_p1024_addcarryx_u64(&x1003, &x1004, x1002, x994, x991);
+  fiat_p1024_addcarryx_u64(&x1005, &x1006, x1004, x992, x989);
+  fiat_p1024_addcarryx_u64(&x1007, &x1008, x1006, x990, x987);
+  fiat_p1024_addcarryx_u64(&x1009, &x1010, x1008, x988, x985);
+  fiat_p1024_addcarryx_u64(&x1011, &x1012, x1010, x986, x983);
+  fiat_p1024_addcarryx_u64(&x1013, &x1014, x1012, x984, x981);
+  fiat_p1024_addcarryx_u64(&x1015, &x1016, x1014, x982, x979);
+  fiat_p1024_addcarryx_u64(&x1017, &x1018, x1016, x980, x977);
+  fiat_p1024_addcarryx_u64(&x1019, &x1020, x1018, x978, x975);
+  fiat_p1024_addcarryx_u64(&x1021, &x1022, x1020, x976, x973);
+  fiat_p1024_addcarryx_u64(&x1023, &x1024, x1022, x974, x971);
+  fiat_p1024_addcarryx_u64(&x1025, &x1026, x1024, x972, x969);
+  fiat_p1024_addcarryx_u64(&x1027, &x1028, x1026, x970, x967);
+  x1029 = (x1028 + x968);
+  fiat_p1024_addcarryx_u64(&x1030, &x1031, 0x0, x934, x997);
+  fiat_p1024_addcarryx_u64(&x1032, &x1033, x1031, x936, x999);
+  fiat_p1024_addcarryx_u64(&x1034, &x1035, x1033, x938, x1001);
+  fiat_p1024_addcarryx_u64(&x1036, &x1037, x1035, x940, x1003);
+  fiat_p1024_addcarryx_u64(&x1038, &x1039, x1037, x942, x1005);
+  fiat_p1024_addcarryx_u64(&x1040, &x1041, x1039, x944, x1007);
+  fiat_p1024_addcarryx_u64(&x1042, &x1043, x1041, x946, x1009);
+  fiat_p1024_addcarryx_u64(&x1044, &x1045, x1043, x948, x1011);
+  fiat_p1024_addcarryx_u64(&x1046, &x1047, x1045, x950, x1013);
+  fiat_p1024_addcarryx_u64(&x1048, &x1049, x1047, x952, x1015);
+  fiat_p1024_addcarryx_u64(&x1050, &x1051, x1049, x954, x1017);
+  fiat_p1024_addcarryx_u64(&x1052, &x1053, x1051, x956, x1019);
+  fiat_p1024_addcarryx_u64(&x1054, &x1055, x1053, x958, x1021);
+  fiat_p1024_addcarryx_u64(&x1056, &x1057, x1055, x960, x1023);
+  fiat_p1024_addcarryx_u64(&x1058, &x1059, x1057, x962, x1025);
+  fiat_p1024_addcarryx_u64(&x1060, &x1061, x1059, x964, x1027);
+  fiat_p1024_addcarryx_u64(&x1062, &x1063, x1061, x966, x1029);
+  fiat_p1024_mulx_u64(&x1064, &x1065, x1030, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x1066, &x1067, x1064, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x1068, &x1069, x1064, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x1070, &x1071, x1064, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x1072, &x1073, x1064, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x1074, &x1075, x1064, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x1076, &x1077, x1064, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x1078, &x1079, x1064, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x1080, &x1081, x1064, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x1082, &x1083, x1064, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x1084, &x1085, x1064, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x1086, &x1087, x1064, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x1088, &x1089, x1064, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x1090, &x1091, x1064, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x1092, &x1093, x1064, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x1094, &x1095, x1064, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x1096, &x1097, x1064, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x1098, &x1099, 0x0, x1097, x1094);
+  fiat_p1024_addcarryx_u64(&x1100, &x1101, x1099, x1095, x1092);
+  fiat_p1024_addcarryx_u64(&x1102, &x1103, x1101, x1093, x1090);
+  fiat_p1024_addcarryx_u64(&x1104, &x1105, x1103, x1091, x1088);
+  fiat_p1024_addcarryx_u64(&x1106, &x1107, x1105, x1089, x1086);
+  fiat_p1024_addcarryx_u64(&x1108, &x1109, x1107, x1087, x1084);
+  fiat_p1024_addcarryx_u64(&x1110, &x1111, x1109, x1085, x1082);
+  fiat_p1024_addcarryx_u64(&x1112, &x1113, x1111, x1083, x1080);
+  fiat_p1024_addcarryx_u64(&x1114, &x1115, x1113, x1081, x1078);
+  fiat_p1024_addcarryx_u64(&x1116, &x1117, x1115, x1079, x1076);
+  fiat_p1024_addcarryx_u64(&x1118, &x1119, x1117, x1077, x1074);
+  fiat_p1024_addcarryx_u64(&x1120, &x1121, x1119, x1075, x1072);
+  fiat_p1024_addcarryx_u64(&x1122, &x1123, x1121, x1073, x1070);
+  fiat_p1024_addcarryx_u64(&x1124, &x1125, x1123, x1071, x1068);
+  fiat_p1024_addcarryx_u64(&x1126, &x1127, x1125, x1069, x1066);
+  x1128 = (x1127 + x1067);
+  fiat_p1024_addcarryx_u64(&x1129, &x1130, 0x0, x1030, x1096);
+  fiat_p1024_addcarryx_u64(&x1131, &x1132, x1130, x1032, x1098);
+  fiat_p1024_addcarryx_u64(&x1133, &x1134, x1132, x1034, x1100);
+  fiat_p1024_addcarryx_u64(&x1135, &x1136, x1134, x1036, x1102);
+  fiat_p1024_addcarryx_u64(&x1137, &x1138, x1136, x1038, x1104);
+  fiat_p1024_addcarryx_u64(&x1139, &x1140, x1138, x1040, x1106);
+  fiat_p1024_addcarryx_u64(&x1141, &x1142, x1140, x1042, x1108);
+  fiat_p1024_addcarryx_u64(&x1143, &x1144, x1142, x1044, x1110);
+  fiat_p1024_addcarryx_u64(&x1145, &x1146, x1144, x1046, x1112);
+  fiat_p1024_addcarryx_u64(&x1147, &x1148, x1146, x1048, x1114);
+  fiat_p1024_addcarryx_u64(&x1149, &x1150, x1148, x1050, x1116);
+  fiat_p1024_addcarryx_u64(&x1151, &x1152, x1150, x1052, x1118);
+  fiat_p1024_addcarryx_u64(&x1153, &x1154, x1152, x1054, x1120);
+  fiat_p1024_addcarryx_u64(&x1155, &x1156, x1154, x1056, x1122);
+  fiat_p1024_addcarryx_u64(&x1157, &x1158, x1156, x1058, x1124);
+  fiat_p1024_addcarryx_u64(&x1159, &x1160, x1158, x1060, x1126);
+  fiat_p1024_addcarryx_u64(&x1161, &x1162, x1160, x1062, x1128);
+  x1163 = ((uint64_t)x1162 + x1063);
+  fiat_p1024_mulx_u64(&x1164, &x1165, x6, (arg1[15]));
+  fiat_p1024_mulx_u64(&x1166, &x1167, x6, (arg1[14]));
+  fiat_p1024_mulx_u64(&x1168, &x1169, x6, (arg1[13]));
+  fiat_p1024_mulx_u64(&x1170, &x1171, x6, (arg1[12]));
+  fiat_p1024_mulx_u64(&x1172, &x1173, x6, (arg1[11]));
+  fiat_p1024_mulx_u64(&x1174, &x1175, x6, (arg1[10]));
+  fiat_p1024_mulx_u64(&x1176, &x1177, x6, (arg1[9]));
+  fiat_p1024_mulx_u64(&x1178, &x1179, x6, (arg1[8]));
+  fiat_p1024_mulx_u64(&x1180, &x1181, x6, (arg1[7]));
+  fiat_p1024_mulx_u64(&x1182, &x1183, x6, (arg1[6]));
+  fiat_p1024_mulx_u64(&x1184, &x1185, x6, (arg1[5]));
+  fiat_p1024_mulx_u64(&x1186, &x1187, x6, (arg1[4]));
+  fiat_p1024_mulx_u64(&x1188, &x1189, x6, (arg1[3]));
+  fiat_p1024_mulx_u64(&x1190, &x1191, x6, (arg1[2]));
+  fiat_p1024_mulx_u64(&x1192, &x1193, x6, (arg1[1]));
+  fiat_p1024_mulx_u64(&x1194, &x1195, x6, (arg1[0]));
+  fiat_p1024_addcarryx_u64(&x1196, &x1197, 0x0, x1195, x1192);
+  fiat_p1024_addcarryx_u64(&x1198, &x1199, x1197, x1193, x1190);
+  fiat_p1024_addcarryx_u64(&x1200, &x1201, x1199, x1191, x1188);
+  fiat_p1024_addcarryx_u64(&x1202, &x1203, x1201, x1189, x1186);
+  fiat_p1024_addcarryx_u64(&x1204, &x1205, x1203, x1187, x1184);
+  fiat_p1024_addcarryx_u64(&x1206, &x1207, x1205, x1185, x1182);
+  fiat_p1024_addcarryx_u64(&x1208, &x1209, x1207, x1183, x1180);
+  fiat_p1024_addcarryx_u64(&x1210, &x1211, x1209, x1181, x1178);
+  fiat_p1024_addcarryx_u64(&x1212, &x1213, x1211, x1179, x1176);
+  fiat_p1024_addcarryx_u64(&x1214, &x1215, x1213, x1177, x1174);
+  fiat_p1024_addcarryx_u64(&x1216, &x1217, x1215, x1175, x1172);
+  fiat_p1024_addcarryx_u64(&x1218, &x1219, x1217, x1173, x1170);
+  fiat_p1024_addcarryx_u64(&x1220, &x1221, x1219, x1171, x1168);
+  fiat_p1024_addcarryx_u64(&x1222, &x1223, x1221, x1169, x1166);
+  fiat_p1024_addcarryx_u64(&x1224, &x1225, x1223, x1167, x1164);
+  x1226 = (x1225 + x1165);
+  fiat_p1024_addcarryx_u64(&x1227, &x1228, 0x0, x1131, x1194);
+  fiat_p1024_addcarryx_u64(&x1229, &x1230, x1228, x1133, x1196);
+  fiat_p1024_addcarryx_u64(&x1231, &x1232, x1230, x1135, x1198);
+  fiat_p1024_addcarryx_u64(&x1233, &x1234, x1232, x1137, x1200);
+  fiat_p1024_addcarryx_u64(&x1235, &x1236, x1234, x1139, x1202);
+  fiat_p1024_addcarryx_u64(&x1237, &x1238, x1236, x1141, x1204);
+  fiat_p1024_addcarryx_u64(&x1239, &x1240, x1238, x1143, x1206);
+  fiat_p1024_addcarryx_u64(&x1241, &x1242, x1240, x1145, x1208);
+  fiat_p1024_addcarryx_u64(&x1243, &x1244, x1242, x1147, x1210);
+  fiat_p1024_addcarryx_u64(&x1245, &x1246, x1244, x1149, x1212);
+  fiat_p1024_addcarryx_u64(&x1247, &x1248, x1246, x1151, x1214);
+  fiat_p1024_addcarryx_u64(&x1249, &x1250, x1248, x1153, x1216);
+  fiat_p1024_addcarryx_u64(&x1251, &x1252, x1250, x1155, x1218);
+  fiat_p1024_addcarryx_u64(&x1253, &x1254, x1252, x1157, x1220);
+  fiat_p1024_addcarryx_u64(&x1255, &x1256, x1254, x1159, x1222);
+  fiat_p1024_addcarryx_u64(&x1257, &x1258, x1256, x1161, x1224);
+  fiat_p1024_addcarryx_u64(&x1259, &x1260, x1258, x1163, x1226);
+  fiat_p1024_mulx_u64(&x1261, &x1262, x1227, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x1263, &x1264, x1261, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x1265, &x1266, x1261, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x1267, &x1268, x1261, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x1269, &x1270, x1261, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x1271, &x1272, x1261, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x1273, &x1274, x1261, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x1275, &x1276, x1261, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x1277, &x1278, x1261, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x1279, &x1280, x1261, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x1281, &x1282, x1261, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x1283, &x1284, x1261, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x1285, &x1286, x1261, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x1287, &x1288, x1261, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x1289, &x1290, x1261, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x1291, &x1292, x1261, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x1293, &x1294, x1261, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x1295, &x1296, 0x0, x1294, x1291);
+  fiat_p1024_addcarryx_u64(&x1297, &x1298, x1296, x1292, x1289);
+  fiat_p1024_addcarryx_u64(&x1299, &x1300, x1298, x1290, x1287);
+  fiat_p1024_addcarryx_u64(&x1301, &x1302, x1300, x1288, x1285);
+  fiat_p1024_addcarryx_u64(&x1303, &x1304, x1302, x1286, x1283);
+  fiat_p1024_addcarryx_u64(&x1305, &x1306, x1304, x1284, x1281);
+  fiat_p1024_addcarryx_u64(&x1307, &x1308, x1306, x1282, x1279);
+  fiat_p1024_addcarryx_u64(&x1309, &x1310, x1308, x1280, x1277);
+  fiat_p1024_addcarryx_u64(&x1311, &x1312, x1310, x1278, x1275);
+  fiat_p1024_addcarryx_u64(&x1313, &x1314, x1312, x1276, x1273);
+  fiat_p1024_addcarryx_u64(&x1315, &x1316, x1314, x1274, x1271);
+  fiat_p1024_addcarryx_u64(&x1317, &x1318, x1316, x1272, x1269);
+  fiat_p1024_addcarryx_u64(&x1319, &x1320, x1318, x1270, x1267);
+  fiat_p1024_addcarryx_u64(&x1321, &x1322, x1320, x1268, x1265);
+  fiat_p1024_addcarryx_u64(&x1323, &x1324, x1322, x1266, x1263);
+  x1325 = (x1324 + x1264);
+  fiat_p1024_addcarryx_u64(&x1326, &x1327, 0x0, x1227, x1293);
+  fiat_p1024_addcarryx_u64(&x1328, &x1329, x1327, x1229, x1295);
+  fiat_p1024_addcarryx_u64(&x1330, &x1331, x1329, x1231, x1297);
+  fiat_p1024_addcarryx_u64(&x1332, &x1333, x1331, x1233, x1299);
+  fiat_p1024_addcarryx_u64(&x1334, &x1335, x1333, x1235, x1301);
+  fiat_p1024_addcarryx_u64(&x1336, &x1337, x1335, x1237, x1303);
+  fiat_p1024_addcarryx_u64(&x1338, &x1339, x1337, x1239, x1305);
+  fiat_p1024_addcarryx_u64(&x1340, &x1341, x1339, x1241, x1307);
+  fiat_p1024_addcarryx_u64(&x1342, &x1343, x1341, x1243, x1309);
+  fiat_p1024_addcarryx_u64(&x1344, &x1345, x1343, x1245, x1311);
+  fiat_p1024_addcarryx_u64(&x1346, &x1347, x1345, x1247, x1313);
+  fiat_p1024_addcarryx_u64(&x1348, &x1349, x1347, x1249, x1315);
+  fiat_p1024_addcarryx_u64(&x1350, &x1351, x1349, x1251, x1317);
+  fiat_p1024_addcarryx_u64(&x1352, &x1353, x1351, x1253, x1319);
+  fiat_p1024_addcarryx_u64(&x1354, &x1355, x1353, x1255, x1321);
+  fiat_p1024_addcarryx_u64(&x1356, &x1357, x1355, x1257, x1323);
+  fiat_p1024_addcarryx_u64(&x1358, &x1359, x1357, x1259, x1325);
+  x1360 = ((uint64_t)x1359 + x1260);
+  fiat_p1024_mulx_u64(&x1361, &x1362, x7, (arg1[15]));
+  fiat_p1024_mulx_u64(&x1363, &x1364, x7, (arg1[14]));
+  fiat_p1024_mulx_u64(&x1365, &x1366, x7, (arg1[13]));
+  fiat_p1024_mulx_u64(&x1367, &x1368, x7, (arg1[12]));
+  fiat_p1024_mulx_u64(&x1369, &x1370, x7, (arg1[11]));
+  fiat_p1024_mulx_u64(&x1371, &x1372, x7, (arg1[10]));
+  fiat_p1024_mulx_u64(&x1373, &x1374, x7, (arg1[9]));
+  fiat_p1024_mulx_u64(&x1375, &x1376, x7, (arg1[8]));
+  fiat_p1024_mulx_u64(&x1377, &x1378, x7, (arg1[7]));
+  fiat_p1024_mulx_u64(&x1379, &x1380, x7, (arg1[6]));
+  fiat_p1024_mulx_u64(&x1381, &x1382, x7, (arg1[5]));
+  fiat_p1024_mulx_u64(&x1383, &x1384, x7, (arg1[4]));
+  fiat_p1024_mulx_u64(&x1385, &x1386, x7, (arg1[3]));
+  fiat_p1024_mulx_u64(&x1387, &x1388, x7, (arg1[2]));
+  fiat_p1024_mulx_u64(&x1389, &x1390, x7, (arg1[1]));
+  fiat_p1024_mulx_u64(&x1391, &x1392, x7, (arg1[0]));
+  fiat_p1024_addcarryx_u64(&x1393, &x1394, 0x0, x1392, x1389);
+  fiat_p1024_addcarryx_u64(&x1395, &x1396, x1394, x1390, x1387);
+  fiat_p1024_addcarryx_u64(&x1397, &x1398, x1396, x1388, x1385);
+  fiat_p1024_addcarryx_u64(&x1399, &x1400, x1398, x1386, x1383);
+  fiat_p1024_addcarryx_u64(&x1401, &x1402, x1400, x1384, x1381);
+  fiat_p1024_addcarryx_u64(&x1403, &x1404, x1402, x1382, x1379);
+  fiat_p1024_addcarryx_u64(&x1405, &x1406, x1404, x1380, x1377);
+  fiat_p1024_addcarryx_u64(&x1407, &x1408, x1406, x1378, x1375);
+  fiat_p1024_addcarryx_u64(&x1409, &x1410, x1408, x1376, x1373);
+  fiat_p1024_addcarryx_u64(&x1411, &x1412, x1410, x1374, x1371);
+  fiat_p1024_addcarryx_u64(&x1413, &x1414, x1412, x1372, x1369);
+  fiat_p1024_addcarryx_u64(&x1415, &x1416, x1414, x1370, x1367);
+  fiat_p1024_addcarryx_u64(&x1417, &x1418, x1416, x1368, x1365);
+  fiat_p1024_addcarryx_u64(&x1419, &x1420, x1418, x1366, x1363);
+  fiat_p1024_addcarryx_u64(&x1421, &x1422, x1420, x1364, x1361);
+  x1423 = (x1422 + x1362);
+  fiat_p1024_addcarryx_u64(&x1424, &x1425, 0x0, x1328, x1391);
+  fiat_p1024_addcarryx_u64(&x1426, &x1427, x1425, x1330, x1393);
+  fiat_p1024_addcarryx_u64(&x1428, &x1429, x1427, x1332, x1395);
+  fiat_p1024_addcarryx_u64(&x1430, &x1431, x1429, x1334, x1397);
+  fiat_p1024_addcarryx_u64(&x1432, &x1433, x1431, x1336, x1399);
+  fiat_p1024_addcarryx_u64(&x1434, &x1435, x1433, x1338, x1401);
+  fiat_p1024_addcarryx_u64(&x1436, &x1437, x1435, x1340, x1403);
+  fiat_p1024_addcarryx_u64(&x1438, &x1439, x1437, x1342, x1405);
+  fiat_p1024_addcarryx_u64(&x1440, &x1441, x1439, x1344, x1407);
+  fiat_p1024_addcarryx_u64(&x1442, &x1443, x1441, x1346, x1409);
+  fiat_p1024_addcarryx_u64(&x1444, &x1445, x1443, x1348, x1411);
+  fiat_p1024_addcarryx_u64(&x1446, &x1447, x1445, x1350, x1413);
+  fiat_p1024_addcarryx_u64(&x1448, &x1449, x1447, x1352, x1415);
+  fiat_p1024_addcarryx_u64(&x1450, &x1451, x1449, x1354, x1417);
+  fiat_p1024_addcarryx_u64(&x1452, &x1453, x1451, x1356, x1419);
+  fiat_p1024_addcarryx_u64(&x1454, &x1455, x1453, x1358, x1421);
+  fiat_p1024_addcarryx_u64(&x1456, &x1457, x1455, x1360, x1423);
+  fiat_p1024_mulx_u64(&x1458, &x1459, x1424, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x1460, &x1461, x1458, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x1462, &x1463, x1458, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x1464, &x1465, x1458, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x1466, &x1467, x1458, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x1468, &x1469, x1458, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x1470, &x1471, x1458, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x1472, &x1473, x1458, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x1474, &x1475, x1458, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x1476, &x1477, x1458, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x1478, &x1479, x1458, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x1480, &x1481, x1458, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x1482, &x1483, x1458, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x1484, &x1485, x1458, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x1486, &x1487, x1458, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x1488, &x1489, x1458, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x1490, &x1491, x1458, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x1492, &x1493, 0x0, x1491, x1488);
+  fiat_p1024_addcarryx_u64(&x1494, &x1495, x1493, x1489, x1486);
+  fiat_p1024_addcarryx_u64(&x1496, &x1497, x1495, x1487, x1484);
+  fiat_p1024_addcarryx_u64(&x1498, &x1499, x1497, x1485, x1482);
+  fiat_p1024_addcarryx_u64(&x1500, &x1501, x1499, x1483, x1480);
+  fiat_p1024_addcarryx_u64(&x1502, &x1503, x1501, x1481, x1478);
+  fiat_p1024_addcarryx_u64(&x1504, &x1505, x1503, x1479, x1476);
+  fiat_p1024_addcarryx_u64(&x1506, &x1507, x1505, x1477, x1474);
+  fiat_p1024_addcarryx_u64(&x1508, &x1509, x1507, x1475, x1472);
+  fiat_p1024_addcarryx_u64(&x1510, &x1511, x1509, x1473, x1470);
+  fiat_p1024_addcarryx_u64(&x1512, &x1513, x1511, x1471, x1468);
+  fiat_p1024_addcarryx_u64(&x1514, &x1515, x1513, x1469, x1466);
+  fiat_p1024_addcarryx_u64(&x1516, &x1517, x1515, x1467, x1464);
+  fiat_p1024_addcarryx_u64(&x1518, &x1519, x1517, x1465, x1462);
+  fiat_p1024_addcarryx_u64(&x1520, &x1521, x1519, x1463, x1460);
+  x1522 = (x1521 + x1461);
+  fiat_p1024_addcarryx_u64(&x1523, &x1524, 0x0, x1424, x1490);
+  fiat_p1024_addcarryx_u64(&x1525, &x1526, x1524, x1426, x1492);
+  fiat_p1024_addcarryx_u64(&x1527, &x1528, x1526, x1428, x1494);
+  fiat_p1024_addcarryx_u64(&x1529, &x1530, x1528, x1430, x1496);
+  fiat_p1024_addcarryx_u64(&x1531, &x1532, x1530, x1432, x1498);
+  fiat_p1024_addcarryx_u64(&x1533, &x1534, x1532, x1434, x1500);
+  fiat_p1024_addcarryx_u64(&x1535, &x1536, x1534, x1436, x1502);
+  fiat_p1024_addcarryx_u64(&x1537, &x1538, x1536, x1438, x1504);
+  fiat_p1024_addcarryx_u64(&x1539, &x1540, x1538, x1440, x1506);
+  fiat_p1024_addcarryx_u64(&x1541, &x1542, x1540, x1442, x1508);
+  fiat_p1024_addcarryx_u64(&x1543, &x1544, x1542, x1444, x1510);
+  fiat_p1024_addcarryx_u64(&x1545, &x1546, x1544, x1446, x1512);
+  fiat_p1024_addcarryx_u64(&x1547, &x1548, x1546, x1448, x1514);
+  fiat_p1024_addcarryx_u64(&x1549, &x1550, x1548, x1450, x1516);
+  fiat_p1024_addcarryx_u64(&x1551, &x1552, x1550, x1452, x1518);
+  fiat_p1024_addcarryx_u64(&x1553, &x1554, x1552, x1454, x1520);
+  fiat_p1024_addcarryx_u64(&x1555, &x1556, x1554, x1456, x1522);
+  x1557 = ((uint64_t)x1556 + x1457);
+  fiat_p1024_mulx_u64(&x1558, &x1559, x8, (arg1[15]));
+  fiat_p1024_mulx_u64(&x1560, &x1561, x8, (arg1[14]));
+  fiat_p1024_mulx_u64(&x1562, &x1563, x8, (arg1[13]));
+  fiat_p1024_mulx_u64(&x1564, &x1565, x8, (arg1[12]));
+  fiat_p1024_mulx_u64(&x1566, &x1567, x8, (arg1[11]));
+  fiat_p1024_mulx_u64(&x1568, &x1569, x8, (arg1[10]));
+  fiat_p1024_mulx_u64(&x1570, &x1571, x8, (arg1[9]));
+  fiat_p1024_mulx_u64(&x1572, &x1573, x8, (arg1[8]));
+  fiat_p1024_mulx_u64(&x1574, &x1575, x8, (arg1[7]));
+  fiat_p1024_mulx_u64(&x1576, &x1577, x8, (arg1[6]));
+  fiat_p1024_mulx_u64(&x1578, &x1579, x8, (arg1[5]));
+  fiat_p1024_mulx_u64(&x1580, &x1581, x8, (arg1[4]));
+  fiat_p1024_mulx_u64(&x1582, &x1583, x8, (arg1[3]));
+  fiat_p1024_mulx_u64(&x1584, &x1585, x8, (arg1[2]));
+  fiat_p1024_mulx_u64(&x1586, &x1587, x8, (arg1[1]));
+  fiat_p1024_mulx_u64(&x1588, &x1589, x8, (arg1[0]));
+  fiat_p1024_addcarryx_u64(&x1590, &x1591, 0x0, x1589, x1586);
+  fiat_p1024_addcarryx_u64(&x1592, &x1593, x1591, x1587, x1584);
+  fiat_p1024_addcarryx_u64(&x1594, &x1595, x1593, x1585, x1582);
+  fiat_p1024_addcarryx_u64(&x1596, &x1597, x1595, x1583, x1580);
+  fiat_p1024_addcarryx_u64(&x1598, &x1599, x1597, x1581, x1578);
+  fiat_p1024_addcarryx_u64(&x1600, &x1601, x1599, x1579, x1576);
+  fiat_p1024_addcarryx_u64(&x1602, &x1603, x1601, x1577, x1574);
+  fiat_p1024_addcarryx_u64(&x1604, &x1605, x1603, x1575, x1572);
+  fiat_p1024_addcarryx_u64(&x1606, &x1607, x1605, x1573, x1570);
+  fiat_p1024_addcarryx_u64(&x1608, &x1609, x1607, x1571, x1568);
+  fiat_p1024_addcarryx_u64(&x1610, &x1611, x1609, x1569, x1566);
+  fiat_p1024_addcarryx_u64(&x1612, &x1613, x1611, x1567, x1564);
+  fiat_p1024_addcarryx_u64(&x1614, &x1615, x1613, x1565, x1562);
+  fiat_p1024_addcarryx_u64(&x1616, &x1617, x1615, x1563, x1560);
+  fiat_p1024_addcarryx_u64(&x1618, &x1619, x1617, x1561, x1558);
+  x1620 = (x1619 + x1559);
+  fiat_p1024_addcarryx_u64(&x1621, &x1622, 0x0, x1525, x1588);
+  fiat_p1024_addcarryx_u64(&x1623, &x1624, x1622, x1527, x1590);
+  fiat_p1024_addcarryx_u64(&x1625, &x1626, x1624, x1529, x1592);
+  fiat_p1024_addcarryx_u64(&x1627, &x1628, x1626, x1531, x1594);
+  fiat_p1024_addcarryx_u64(&x1629, &x1630, x1628, x1533, x1596);
+  fiat_p1024_addcarryx_u64(&x1631, &x1632, x1630, x1535, x1598);
+  fiat_p1024_addcarryx_u64(&x1633, &x1634, x1632, x1537, x1600);
+  fiat_p1024_addcarryx_u64(&x1635, &x1636, x1634, x1539, x1602);
+  fiat_p1024_addcarryx_u64(&x1637, &x1638, x1636, x1541, x1604);
+  fiat_p1024_addcarryx_u64(&x1639, &x1640, x1638, x1543, x1606);
+  fiat_p1024_addcarryx_u64(&x1641, &x1642, x1640, x1545, x1608);
+  fiat_p1024_addcarryx_u64(&x1643, &x1644, x1642, x1547, x1610);
+  fiat_p1024_addcarryx_u64(&x1645, &x1646, x1644, x1549, x1612);
+  fiat_p1024_addcarryx_u64(&x1647, &x1648, x1646, x1551, x1614);
+  fiat_p1024_addcarryx_u64(&x1649, &x1650, x1648, x1553, x1616);
+  fiat_p1024_addcarryx_u64(&x1651, &x1652, x1650, x1555, x1618);
+  fiat_p1024_addcarryx_u64(&x1653, &x1654, x1652, x1557, x1620);
+  fiat_p1024_mulx_u64(&x1655, &x1656, x1621, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x1657, &x1658, x1655, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x1659, &x1660, x1655, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x1661, &x1662, x1655, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x1663, &x1664, x1655, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x1665, &x1666, x1655, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x1667, &x1668, x1655, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x1669, &x1670, x1655, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x1671, &x1672, x1655, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x1673, &x1674, x1655, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x1675, &x1676, x1655, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x1677, &x1678, x1655, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x1679, &x1680, x1655, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x1681, &x1682, x1655, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x1683, &x1684, x1655, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x1685, &x1686, x1655, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x1687, &x1688, x1655, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x1689, &x1690, 0x0, x1688, x1685);
+  fiat_p1024_addcarryx_u64(&x1691, &x1692, x1690, x1686, x1683);
+  fiat_p1024_addcarryx_u64(&x1693, &x1694, x1692, x1684, x1681);
+  fiat_p1024_addcarryx_u64(&x1695, &x1696, x1694, x1682, x1679);
+  fiat_p1024_addcarryx_u64(&x1697, &x1698, x1696, x1680, x1677);
+  fiat_p1024_addcarryx_u64(&x1699, &x1700, x1698, x1678, x1675);
+  fiat_p1024_addcarryx_u64(&x1701, &x1702, x1700, x1676, x1673);
+  fiat_p1024_addcarryx_u64(&x1703, &x1704, x1702, x1674, x1671);
+  fiat_p1024_addcarryx_u64(&x1705, &x1706, x1704, x1672, x1669);
+  fiat_p1024_addcarryx_u64(&x1707, &x1708, x1706, x1670, x1667);
+  fiat_p1024_addcarryx_u64(&x1709, &x1710, x1708, x1668, x1665);
+  fiat_p1024_addcarryx_u64(&x1711, &x1712, x1710, x1666, x1663);
+  fiat_p1024_addcarryx_u64(&x1713, &x1714, x1712, x1664, x1661);
+  fiat_p1024_addcarryx_u64(&x1715, &x1716, x1714, x1662, x1659);
+  fiat_p1024_addcarryx_u64(&x1717, &x1718, x1716, x1660, x1657);
+  x1719 = (x1718 + x1658);
+  fiat_p1024_addcarryx_u64(&x1720, &x1721, 0x0, x1621, x1687);
+  fiat_p1024_addcarryx_u64(&x1722, &x1723, x1721, x1623, x1689);
+  fiat_p1024_addcarryx_u64(&x1724, &x1725, x1723, x1625, x1691);
+  fiat_p1024_addcarryx_u64(&x1726, &x1727, x1725, x1627, x1693);
+  fiat_p1024_addcarryx_u64(&x1728, &x1729, x1727, x1629, x1695);
+  fiat_p1024_addcarryx_u64(&x1730, &x1731, x1729, x1631, x1697);
+  fiat_p1024_addcarryx_u64(&x1732, &x1733, x1731, x1633, x1699);
+  fiat_p1024_addcarryx_u64(&x1734, &x1735, x1733, x1635, x1701);
+  fiat_p1024_addcarryx_u64(&x1736, &x1737, x1735, x1637, x1703);
+  fiat_p1024_addcarryx_u64(&x1738, &x1739, x1737, x1639, x1705);
+  fiat_p1024_addcarryx_u64(&x1740, &x1741, x1739, x1641, x1707);
+  fiat_p1024_addcarryx_u64(&x1742, &x1743, x1741, x1643, x1709);
+  fiat_p1024_addcarryx_u64(&x1744, &x1745, x1743, x1645, x1711);
+  fiat_p1024_addcarryx_u64(&x1746, &x1747, x1745, x1647, x1713);
+  fiat_p1024_addcarryx_u64(&x1748, &x1749, x1747, x1649, x1715);
+  fiat_p1024_addcarryx_u64(&x1750, &x1751, x1749, x1651, x1717);
+  fiat_p1024_addcarryx_u64(&x1752, &x1753, x1751, x1653, x1719);
+  x1754 = ((uint64_t)x1753 + x1654);
+  fiat_p1024_mulx_u64(&x1755, &x1756, x9, (arg1[15]));
+  fiat_p1024_mulx_u64(&x1757, &x1758, x9, (arg1[14]));
+  fiat_p1024_mulx_u64(&x1759, &x1760, x9, (arg1[13]));
+  fiat_p1024_mulx_u64(&x1761, &x1762, x9, (arg1[12]));
+  fiat_p1024_mulx_u64(&x1763, &x1764, x9, (arg1[11]));
+  fiat_p1024_mulx_u64(&x1765, &x1766, x9, (arg1[10]));
+  fiat_p1024_mulx_u64(&x1767, &x1768, x9, (arg1[9]));
+  fiat_p1024_mulx_u64(&x1769, &x1770, x9, (arg1[8]));
+  fiat_p1024_mulx_u64(&x1771, &x1772, x9, (arg1[7]));
+  fiat_p1024_mulx_u64(&x1773, &x1774, x9, (arg1[6]));
+  fiat_p1024_mulx_u64(&x1775, &x1776, x9, (arg1[5]));
+  fiat_p1024_mulx_u64(&x1777, &x1778, x9, (arg1[4]));
+  fiat_p1024_mulx_u64(&x1779, &x1780, x9, (arg1[3]));
+  fiat_p1024_mulx_u64(&x1781, &x1782, x9, (arg1[2]));
+  fiat_p1024_mulx_u64(&x1783, &x1784, x9, (arg1[1]));
+  fiat_p1024_mulx_u64(&x1785, &x1786, x9, (arg1[0]));
+  fiat_p1024_addcarryx_u64(&x1787, &x1788, 0x0, x1786, x1783);
+  fiat_p1024_addcarryx_u64(&x1789, &x1790, x1788, x1784, x1781);
+  fiat_p1024_addcarryx_u64(&x1791, &x1792, x1790, x1782, x1779);
+  fiat_p1024_addcarryx_u64(&x1793, &x1794, x1792, x1780, x1777);
+  fiat_p1024_addcarryx_u64(&x1795, &x1796, x1794, x1778, x1775);
+  fiat_p1024_addcarryx_u64(&x1797, &x1798, x1796, x1776, x1773);
+  fiat_p1024_addcarryx_u64(&x1799, &x1800, x1798, x1774, x1771);
+  fiat_p1024_addcarryx_u64(&x1801, &x1802, x1800, x1772, x1769);
+  fiat_p1024_addcarryx_u64(&x1803, &x1804, x1802, x1770, x1767);
+  fiat_p1024_addcarryx_u64(&x1805, &x1806, x1804, x1768, x1765);
+  fiat_p1024_addcarryx_u64(&x1807, &x1808, x1806, x1766, x1763);
+  fiat_p1024_addcarryx_u64(&x1809, &x1810, x1808, x1764, x1761);
+  fiat_p1024_addcarryx_u64(&x1811, &x1812, x1810, x1762, x1759);
+  fiat_p1024_addcarryx_u64(&x1813, &x1814, x1812, x1760, x1757);
+  fiat_p1024_addcarryx_u64(&x1815, &x1816, x1814, x1758, x1755);
+  x1817 = (x1816 + x1756);
+  fiat_p1024_addcarryx_u64(&x1818, &x1819, 0x0, x1722, x1785);
+  fiat_p1024_addcarryx_u64(&x1820, &x1821, x1819, x1724, x1787);
+  fiat_p1024_addcarryx_u64(&x1822, &x1823, x1821, x1726, x1789);
+  fiat_p1024_addcarryx_u64(&x1824, &x1825, x1823, x1728, x1791);
+  fiat_p1024_addcarryx_u64(&x1826, &x1827, x1825, x1730, x1793);
+  fiat_p1024_addcarryx_u64(&x1828, &x1829, x1827, x1732, x1795);
+  fiat_p1024_addcarryx_u64(&x1830, &x1831, x1829, x1734, x1797);
+  fiat_p1024_addcarryx_u64(&x1832, &x1833, x1831, x1736, x1799);
+  fiat_p1024_addcarryx_u64(&x1834, &x1835, x1833, x1738, x1801);
+  fiat_p1024_addcarryx_u64(&x1836, &x1837, x1835, x1740, x1803);
+  fiat_p1024_addcarryx_u64(&x1838, &x1839, x1837, x1742, x1805);
+  fiat_p1024_addcarryx_u64(&x1840, &x1841, x1839, x1744, x1807);
+  fiat_p1024_addcarryx_u64(&x1842, &x1843, x1841, x1746, x1809);
+  fiat_p1024_addcarryx_u64(&x1844, &x1845, x1843, x1748, x1811);
+  fiat_p1024_addcarryx_u64(&x1846, &x1847, x1845, x1750, x1813);
+  fiat_p1024_addcarryx_u64(&x1848, &x1849, x1847, x1752, x1815);
+  fiat_p1024_addcarryx_u64(&x1850, &x1851, x1849, x1754, x1817);
+  fiat_p1024_mulx_u64(&x1852, &x1853, x1818, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x1854, &x1855, x1852, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x1856, &x1857, x1852, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x1858, &x1859, x1852, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x1860, &x1861, x1852, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x1862, &x1863, x1852, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x1864, &x1865, x1852, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x1866, &x1867, x1852, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x1868, &x1869, x1852, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x1870, &x1871, x1852, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x1872, &x1873, x1852, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x1874, &x1875, x1852, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x1876, &x1877, x1852, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x1878, &x1879, x1852, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x1880, &x1881, x1852, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x1882, &x1883, x1852, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x1884, &x1885, x1852, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x1886, &x1887, 0x0, x1885, x1882);
+  fiat_p1024_addcarryx_u64(&x1888, &x1889, x1887, x1883, x1880);
+  fiat_p1024_addcarryx_u64(&x1890, &x1891, x1889, x1881, x1878);
+  fiat_p1024_addcarryx_u64(&x1892, &x1893, x1891, x1879, x1876);
+  fiat_p1024_addcarryx_u64(&x1894, &x1895, x1893, x1877, x1874);
+  fiat_p1024_addcarryx_u64(&x1896, &x1897, x1895, x1875, x1872);
+  fiat_p1024_addcarryx_u64(&x1898, &x1899, x1897, x1873, x1870);
+  fiat_p1024_addcarryx_u64(&x1900, &x1901, x1899, x1871, x1868);
+  fiat_p1024_addcarryx_u64(&x1902, &x1903, x1901, x1869, x1866);
+  fiat_p1024_addcarryx_u64(&x1904, &x1905, x1903, x1867, x1864);
+  fiat_p1024_addcarryx_u64(&x1906, &x1907, x1905, x1865, x1862);
+  fiat_p1024_addcarryx_u64(&x1908, &x1909, x1907, x1863, x1860);
+  fiat_p1024_addcarryx_u64(&x1910, &x1911, x1909, x1861, x1858);
+  fiat_p1024_addcarryx_u64(&x1912, &x1913, x1911, x1859, x1856);
+  fiat_p1024_addcarryx_u64(&x1914, &x1915, x1913, x1857, x1854);
+  x1916 = (x1915 + x1855);
+  fiat_p1024_addcarryx_u64(&x1917, &x1918, 0x0, x1818, x1884);
+  fiat_p1024_addcarryx_u64(&x1919, &x1920, x1918, x1820, x1886);
+  fiat_p1024_addcarryx_u64(&x1921, &x1922, x1920, x1822, x1888);
+  fiat_p1024_addcarryx_u64(&x1923, &x1924, x1922, x1824, x1890);
+  fiat_p1024_addcarryx_u64(&x1925, &x1926, x1924, x1826, x1892);
+  fiat_p1024_addcarryx_u64(&x1927, &x1928, x1926, x1828, x1894);
+  fiat_p1024_addcarryx_u64(&x1929, &x1930, x1928, x1830, x1896);
+  fiat_p1024_addcarryx_u64(&x1931, &x1932, x1930, x1832, x1898);
+  fiat_p1024_addcarryx_u64(&x1933, &x1934, x1932, x1834, x1900);
+  fiat_p1024_addcarryx_u64(&x1935, &x1936, x1934, x1836, x1902);
+  fiat_p1024_addcarryx_u64(&x1937, &x1938, x1936, x1838, x1904);
+  fiat_p1024_addcarryx_u64(&x1939, &x1940, x1938, x1840, x1906);
+  fiat_p1024_addcarryx_u64(&x1941, &x1942, x1940, x1842, x1908);
+  fiat_p1024_addcarryx_u64(&x1943, &x1944, x1942, x1844, x1910);
+  fiat_p1024_addcarryx_u64(&x1945, &x1946, x1944, x1846, x1912);
+  fiat_p1024_addcarryx_u64(&x1947, &x1948, x1946, x1848, x1914);
+  fiat_p1024_addcarryx_u64(&x1949, &x1950, x1948, x1850, x1916);
+  x1951 = ((uint64_t)x1950 + x1851);
+  fiat_p1024_mulx_u64(&x1952, &x1953, x10, (arg1[15]));
+  fiat_p1024_mulx_u64(&x1954, &x1955, x10, (arg1[14]));
+  fiat_p1024_mulx_u64(&x1956, &x1957, x10, (arg1[13]));
+  fiat_p1024_mulx_u64(&x1958, &x1959, x10, (arg1[12]));
+  fiat_p1024_mulx_u64(&x1960, &x1961, x10, (arg1[11]));
+  fiat_p1024_mulx_u64(&x1962, &x1963, x10, (arg1[10]));
+  fiat_p1024_mulx_u64(&x1964, &x1965, x10, (arg1[9]));
+  fiat_p1024_mulx_u64(&x1966, &x1967, x10, (arg1[8]));
+  fiat_p1024_mulx_u64(&x1968, &x1969, x10, (arg1[7]));
+  fiat_p1024_mulx_u64(&x1970, &x1971, x10, (arg1[6]));
+  fiat_p1024_mulx_u64(&x1972, &x1973, x10, (arg1[5]));
+  fiat_p1024_mulx_u64(&x1974, &x1975, x10, (arg1[4]));
+  fiat_p1024_mulx_u64(&x1976, &x1977, x10, (arg1[3]));
+  fiat_p1024_mulx_u64(&x1978, &x1979, x10, (arg1[2]));
+  fiat_p1024_mulx_u64(&x1980, &x1981, x10, (arg1[1]));
+  fiat_p1024_mulx_u64(&x1982, &x1983, x10, (arg1[0]));
+  fiat_p1024_addcarryx_u64(&x1984, &x1985, 0x0, x1983, x1980);
+  fiat_p1024_addcarryx_u64(&x1986, &x1987, x1985, x1981, x1978);
+  fiat_p1024_addcarryx_u64(&x1988, &x1989, x1987, x1979, x1976);
+  fiat_p1024_addcarryx_u64(&x1990, &x1991, x1989, x1977, x1974);
+  fiat_p1024_addcarryx_u64(&x1992, &x1993, x1991, x1975, x1972);
+  fiat_p1024_addcarryx_u64(&x1994, &x1995, x1993, x1973, x1970);
+  fiat_p1024_addcarryx_u64(&x1996, &x1997, x1995, x1971, x1968);
+  fiat_p1024_addcarryx_u64(&x1998, &x1999, x1997, x1969, x1966);
+  fiat_p1024_addcarryx_u64(&x2000, &x2001, x1999, x1967, x1964);
+  fiat_p1024_addcarryx_u64(&x2002, &x2003, x2001, x1965, x1962);
+  fiat_p1024_addcarryx_u64(&x2004, &x2005, x2003, x1963, x1960);
+  fiat_p1024_addcarryx_u64(&x2006, &x2007, x2005, x1961, x1958);
+  fiat_p1024_addcarryx_u64(&x2008, &x2009, x2007, x1959, x1956);
+  fiat_p1024_addcarryx_u64(&x2010, &x2011, x2009, x1957, x1954);
+  fiat_p1024_addcarryx_u64(&x2012, &x2013, x2011, x1955, x1952);
+  x2014 = (x2013 + x1953);
+  fiat_p1024_addcarryx_u64(&x2015, &x2016, 0x0, x1919, x1982);
+  fiat_p1024_addcarryx_u64(&x2017, &x2018, x2016, x1921, x1984);
+  fiat_p1024_addcarryx_u64(&x2019, &x2020, x2018, x1923, x1986);
+  fiat_p1024_addcarryx_u64(&x2021, &x2022, x2020, x1925, x1988);
+  fiat_p1024_addcarryx_u64(&x2023, &x2024, x2022, x1927, x1990);
+  fiat_p1024_addcarryx_u64(&x2025, &x2026, x2024, x1929, x1992);
+  fiat_p1024_addcarryx_u64(&x2027, &x2028, x2026, x1931, x1994);
+  fiat_p1024_addcarryx_u64(&x2029, &x2030, x2028, x1933, x1996);
+  fiat_p1024_addcarryx_u64(&x2031, &x2032, x2030, x1935, x1998);
+  fiat_p1024_addcarryx_u64(&x2033, &x2034, x2032, x1937, x2000);
+  fiat_p1024_addcarryx_u64(&x2035, &x2036, x2034, x1939, x2002);
+  fiat_p1024_addcarryx_u64(&x2037, &x2038, x2036, x1941, x2004);
+  fiat_p1024_addcarryx_u64(&x2039, &x2040, x2038, x1943, x2006);
+  fiat_p1024_addcarryx_u64(&x2041, &x2042, x2040, x1945, x2008);
+  fiat_p1024_addcarryx_u64(&x2043, &x2044, x2042, x1947, x2010);
+  fiat_p1024_addcarryx_u64(&x2045, &x2046, x2044, x1949, x2012);
+  fiat_p1024_addcarryx_u64(&x2047, &x2048, x2046, x1951, x2014);
+  fiat_p1024_mulx_u64(&x2049, &x2050, x2015, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x2051, &x2052, x2049, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x2053, &x2054, x2049, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x2055, &x2056, x2049, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x2057, &x2058, x2049, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x2059, &x2060, x2049, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x2061, &x2062, x2049, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x2063, &x2064, x2049, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x2065, &x2066, x2049, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x2067, &x2068, x2049, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x2069, &x2070, x2049, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x2071, &x2072, x2049, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x2073, &x2074, x2049, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x2075, &x2076, x2049, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x2077, &x2078, x2049, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x2079, &x2080, x2049, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x2081, &x2082, x2049, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x2083, &x2084, 0x0, x2082, x2079);
+  fiat_p1024_addcarryx_u64(&x2085, &x2086, x2084, x2080, x2077);
+  fiat_p1024_addcarryx_u64(&x2087, &x2088, x2086, x2078, x2075);
+  fiat_p1024_addcarryx_u64(&x2089, &x2090, x2088, x2076, x2073);
+  fiat_p1024_addcarryx_u64(&x2091, &x2092, x2090, x2074, x2071);
+  fiat_p1024_addcarryx_u64(&x2093, &x2094, x2092, x2072, x2069);
+  fiat_p1024_addcarryx_u64(&x2095, &x2096, x2094, x2070, x2067);
+  fiat_p1024_addcarryx_u64(&x2097, &x2098, x2096, x2068, x2065);
+  fiat_p1024_addcarryx_u64(&x2099, &x2100, x2098, x2066, x2063);
+  fiat_p1024_addcarryx_u64(&x2101, &x2102, x2100, x2064, x2061);
+  fiat_p1024_addcarryx_u64(&x2103, &x2104, x2102, x2062, x2059);
+  fiat_p1024_addcarryx_u64(&x2105, &x2106, x2104, x2060, x2057);
+  fiat_p1024_addcarryx_u64(&x2107, &x2108, x2106, x2058, x2055);
+  fiat_p1024_addcarryx_u64(&x2109, &x2110, x2108, x2056, x2053);
+  fiat_p1024_addcarryx_u64(&x2111, &x2112, x2110, x2054, x2051);
+  x2113 = (x2112 + x2052);
+  fiat_p1024_addcarryx_u64(&x2114, &x2115, 0x0, x2015, x2081);
+  fiat_p1024_addcarryx_u64(&x2116, &x2117, x2115, x2017, x2083);
+  fiat_p1024_addcarryx_u64(&x2118, &x2119, x2117, x2019, x2085);
+  fiat_p1024_addcarryx_u64(&x2120, &x2121, x2119, x2021, x2087);
+  fiat_p1024_addcarryx_u64(&x2122, &x2123, x2121, x2023, x2089);
+  fiat_p1024_addcarryx_u64(&x2124, &x2125, x2123, x2025, x2091);
+  fiat_p1024_addcarryx_u64(&x2126, &x2127, x2125, x2027, x2093);
+  fiat_p1024_addcarryx_u64(&x2128, &x2129, x2127, x2029, x2095);
+  fiat_p1024_addcarryx_u64(&x2130, &x2131, x2129, x2031, x2097);
+  fiat_p1024_addcarryx_u64(&x2132, &x2133, x2131, x2033, x2099);
+  fiat_p1024_addcarryx_u64(&x2134, &x2135, x2133, x2035, x2101);
+  fiat_p1024_addcarryx_u64(&x2136, &x2137, x2135, x2037, x2103);
+  fiat_p1024_addcarryx_u64(&x2138, &x2139, x2137, x2039, x2105);
+  fiat_p1024_addcarryx_u64(&x2140, &x2141, x2139, x2041, x2107);
+  fiat_p1024_addcarryx_u64(&x2142, &x2143, x2141, x2043, x2109);
+  fiat_p1024_addcarryx_u64(&x2144, &x2145, x2143, x2045, x2111);
+  fiat_p1024_addcarryx_u64(&x2146, &x2147, x2145, x2047, x2113);
+  x2148 = ((uint64_t)x2147 + x2048);
+  fiat_p1024_mulx_u64(&x2149, &x2150, x11, (arg1[15]));
+  fiat_p1024_mulx_u64(&x2151, &x2152, x11, (arg1[14]));
+  fiat_p1024_mulx_u64(&x2153, &x2154, x11, (arg1[13]));
+  fiat_p1024_mulx_u64(&x2155, &x2156, x11, (arg1[12]));
+  fiat_p1024_mulx_u64(&x2157, &x2158, x11, (arg1[11]));
+  fiat_p1024_mulx_u64(&x2159, &x2160, x11, (arg1[10]));
+  fiat_p1024_mulx_u64(&x2161, &x2162, x11, (arg1[9]));
+  fiat_p1024_mulx_u64(&x2163, &x2164, x11, (arg1[8]));
+  fiat_p1024_mulx_u64(&x2165, &x2166, x11, (arg1[7]));
+  fiat_p1024_mulx_u64(&x2167, &x2168, x11, (arg1[6]));
+  fiat_p1024_mulx_u64(&x2169, &x2170, x11, (arg1[5]));
+  fiat_p1024_mulx_u64(&x2171, &x2172, x11, (arg1[4]));
+  fiat_p1024_mulx_u64(&x2173, &x2174, x11, (arg1[3]));
+  fiat_p1024_mulx_u64(&x2175, &x2176, x11, (arg1[2]));
+  fiat_p1024_mulx_u64(&x2177, &x2178, x11, (arg1[1]));
+  fiat_p1024_mulx_u64(&x2179, &x2180, x11, (arg1[0]));
+  fiat_p1024_addcarryx_u64(&x2181, &x2182, 0x0, x2180, x2177);
+  fiat_p1024_addcarryx_u64(&x2183, &x2184, x2182, x2178, x2175);
+  fiat_p1024_addcarryx_u64(&x2185, &x2186, x2184, x2176, x2173);
+  fiat_p1024_addcarryx_u64(&x2187, &x2188, x2186, x2174, x2171);
+  fiat_p1024_addcarryx_u64(&x2189, &x2190, x2188, x2172, x2169);
+  fiat_p1024_addcarryx_u64(&x2191, &x2192, x2190, x2170, x2167);
+  fiat_p1024_addcarryx_u64(&x2193, &x2194, x2192, x2168, x2165);
+  fiat_p1024_addcarryx_u64(&x2195, &x2196, x2194, x2166, x2163);
+  fiat_p1024_addcarryx_u64(&x2197, &x2198, x2196, x2164, x2161);
+  fiat_p1024_addcarryx_u64(&x2199, &x2200, x2198, x2162, x2159);
+  fiat_p1024_addcarryx_u64(&x2201, &x2202, x2200, x2160, x2157);
+  fiat_p1024_addcarryx_u64(&x2203, &x2204, x2202, x2158, x2155);
+  fiat_p1024_addcarryx_u64(&x2205, &x2206, x2204, x2156, x2153);
+  fiat_p1024_addcarryx_u64(&x2207, &x2208, x2206, x2154, x2151);
+  fiat_p1024_addcarryx_u64(&x2209, &x2210, x2208, x2152, x2149);
+  x2211 = (x2210 + x2150);
+  fiat_p1024_addcarryx_u64(&x2212, &x2213, 0x0, x2116, x2179);
+  fiat_p1024_addcarryx_u64(&x2214, &x2215, x2213, x2118, x2181);
+  fiat_p1024_addcarryx_u64(&x2216, &x2217, x2215, x2120, x2183);
+  fiat_p1024_addcarryx_u64(&x2218, &x2219, x2217, x2122, x2185);
+  fiat_p1024_addcarryx_u64(&x2220, &x2221, x2219, x2124, x2187);
+  fiat_p1024_addcarryx_u64(&x2222, &x2223, x2221, x2126, x2189);
+  fiat_p1024_addcarryx_u64(&x2224, &x2225, x2223, x2128, x2191);
+  fiat_p1024_addcarryx_u64(&x2226, &x2227, x2225, x2130, x2193);
+  fiat_p1024_addcarryx_u64(&x2228, &x2229, x2227, x2132, x2195);
+  fiat_p1024_addcarryx_u64(&x2230, &x2231, x2229, x2134, x2197);
+  fiat_p1024_addcarryx_u64(&x2232, &x2233, x2231, x2136, x2199);
+  fiat_p1024_addcarryx_u64(&x2234, &x2235, x2233, x2138, x2201);
+  fiat_p1024_addcarryx_u64(&x2236, &x2237, x2235, x2140, x2203);
+  fiat_p1024_addcarryx_u64(&x2238, &x2239, x2237, x2142, x2205);
+  fiat_p1024_addcarryx_u64(&x2240, &x2241, x2239, x2144, x2207);
+  fiat_p1024_addcarryx_u64(&x2242, &x2243, x2241, x2146, x2209);
+  fiat_p1024_addcarryx_u64(&x2244, &x2245, x2243, x2148, x2211);
+  fiat_p1024_mulx_u64(&x2246, &x2247, x2212, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x2248, &x2249, x2246, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x2250, &x2251, x2246, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x2252, &x2253, x2246, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x2254, &x2255, x2246, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x2256, &x2257, x2246, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x2258, &x2259, x2246, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x2260, &x2261, x2246, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x2262, &x2263, x2246, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x2264, &x2265, x2246, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x2266, &x2267, x2246, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x2268, &x2269, x2246, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x2270, &x2271, x2246, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x2272, &x2273, x2246, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x2274, &x2275, x2246, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x2276, &x2277, x2246, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x2278, &x2279, x2246, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x2280, &x2281, 0x0, x2279, x2276);
+  fiat_p1024_addcarryx_u64(&x2282, &x2283, x2281, x2277, x2274);
+  fiat_p1024_addcarryx_u64(&x2284, &x2285, x2283, x2275, x2272);
+  fiat_p1024_addcarryx_u64(&x2286, &x2287, x2285, x2273, x2270);
+  fiat_p1024_addcarryx_u64(&x2288, &x2289, x2287, x2271, x2268);
+  fiat_p1024_addcarryx_u64(&x2290, &x2291, x2289, x2269, x2266);
+  fiat_p1024_addcarryx_u64(&x2292, &x2293, x2291, x2267, x2264);
+  fiat_p1024_addcarryx_u64(&x2294, &x2295, x2293, x2265, x2262);
+  fiat_p1024_addcarryx_u64(&x2296, &x2297, x2295, x2263, x2260);
+  fiat_p1024_addcarryx_u64(&x2298, &x2299, x2297, x2261, x2258);
+  fiat_p1024_addcarryx_u64(&x2300, &x2301, x2299, x2259, x2256);
+  fiat_p1024_addcarryx_u64(&x2302, &x2303, x2301, x2257, x2254);
+  fiat_p1024_addcarryx_u64(&x2304, &x2305, x2303, x2255, x2252);
+  fiat_p1024_addcarryx_u64(&x2306, &x2307, x2305, x2253, x2250);
+  fiat_p1024_addcarryx_u64(&x2308, &x2309, x2307, x2251, x2248);
+  x2310 = (x2309 + x2249);
+  fiat_p1024_addcarryx_u64(&x2311, &x2312, 0x0, x2212, x2278);
+  fiat_p1024_addcarryx_u64(&x2313, &x2314, x2312, x2214, x2280);
+  fiat_p1024_addcarryx_u64(&x2315, &x2316, x2314, x2216, x2282);
+  fiat_p1024_addcarryx_u64(&x2317, &x2318, x2316, x2218, x2284);
+  fiat_p1024_addcarryx_u64(&x2319, &x2320, x2318, x2220, x2286);
+  fiat_p1024_addcarryx_u64(&x2321, &x2322, x2320, x2222, x2288);
+  fiat_p1024_addcarryx_u64(&x2323, &x2324, x2322, x2224, x2290);
+  fiat_p1024_addcarryx_u64(&x2325, &x2326, x2324, x2226, x2292);
+  fiat_p1024_addcarryx_u64(&x2327, &x2328, x2326, x2228, x2294);
+  fiat_p1024_addcarryx_u64(&x2329, &x2330, x2328, x2230, x2296);
+  fiat_p1024_addcarryx_u64(&x2331, &x2332, x2330, x2232, x2298);
+  fiat_p1024_addcarryx_u64(&x2333, &x2334, x2332, x2234, x2300);
+  fiat_p1024_addcarryx_u64(&x2335, &x2336, x2334, x2236, x2302);
+  fiat_p1024_addcarryx_u64(&x2337, &x2338, x2336, x2238, x2304);
+  fiat_p1024_addcarryx_u64(&x2339, &x2340, x2338, x2240, x2306);
+  fiat_p1024_addcarryx_u64(&x2341, &x2342, x2340, x2242, x2308);
+  fiat_p1024_addcarryx_u64(&x2343, &x2344, x2342, x2244, x2310);
+  x2345 = ((uint64_t)x2344 + x2245);
+  fiat_p1024_mulx_u64(&x2346, &x2347, x12, (arg1[15]));
+  fiat_p1024_mulx_u64(&x2348, &x2349, x12, (arg1[14]));
+  fiat_p1024_mulx_u64(&x2350, &x2351, x12, (arg1[13]));
+  fiat_p1024_mulx_u64(&x2352, &x2353, x12, (arg1[12]));
+  fiat_p1024_mulx_u64(&x2354, &x2355, x12, (arg1[11]));
+  fiat_p1024_mulx_u64(&x2356, &x2357, x12, (arg1[10]));
+  fiat_p1024_mulx_u64(&x2358, &x2359, x12, (arg1[9]));
+  fiat_p1024_mulx_u64(&x2360, &x2361, x12, (arg1[8]));
+  fiat_p1024_mulx_u64(&x2362, &x2363, x12, (arg1[7]));
+  fiat_p1024_mulx_u64(&x2364, &x2365, x12, (arg1[6]));
+  fiat_p1024_mulx_u64(&x2366, &x2367, x12, (arg1[5]));
+  fiat_p1024_mulx_u64(&x2368, &x2369, x12, (arg1[4]));
+  fiat_p1024_mulx_u64(&x2370, &x2371, x12, (arg1[3]));
+  fiat_p1024_mulx_u64(&x2372, &x2373, x12, (arg1[2]));
+  fiat_p1024_mulx_u64(&x2374, &x2375, x12, (arg1[1]));
+  fiat_p1024_mulx_u64(&x2376, &x2377, x12, (arg1[0]));
+  fiat_p1024_addcarryx_u64(&x2378, &x2379, 0x0, x2377, x2374);
+  fiat_p1024_addcarryx_u64(&x2380, &x2381, x2379, x2375, x2372);
+  fiat_p1024_addcarryx_u64(&x2382, &x2383, x2381, x2373, x2370);
+  fiat_p1024_addcarryx_u64(&x2384, &x2385, x2383, x2371, x2368);
+  fiat_p1024_addcarryx_u64(&x2386, &x2387, x2385, x2369, x2366);
+  fiat_p1024_addcarryx_u64(&x2388, &x2389, x2387, x2367, x2364);
+  fiat_p1024_addcarryx_u64(&x2390, &x2391, x2389, x2365, x2362);
+  fiat_p1024_addcarryx_u64(&x2392, &x2393, x2391, x2363, x2360);
+  fiat_p1024_addcarryx_u64(&x2394, &x2395, x2393, x2361, x2358);
+  fiat_p1024_addcarryx_u64(&x2396, &x2397, x2395, x2359, x2356);
+  fiat_p1024_addcarryx_u64(&x2398, &x2399, x2397, x2357, x2354);
+  fiat_p1024_addcarryx_u64(&x2400, &x2401, x2399, x2355, x2352);
+  fiat_p1024_addcarryx_u64(&x2402, &x2403, x2401, x2353, x2350);
+  fiat_p1024_addcarryx_u64(&x2404, &x2405, x2403, x2351, x2348);
+  fiat_p1024_addcarryx_u64(&x2406, &x2407, x2405, x2349, x2346);
+  x2408 = (x2407 + x2347);
+  fiat_p1024_addcarryx_u64(&x2409, &x2410, 0x0, x2313, x2376);
+  fiat_p1024_addcarryx_u64(&x2411, &x2412, x2410, x2315, x2378);
+  fiat_p1024_addcarryx_u64(&x2413, &x2414, x2412, x2317, x2380);
+  fiat_p1024_addcarryx_u64(&x2415, &x2416, x2414, x2319, x2382);
+  fiat_p1024_addcarryx_u64(&x2417, &x2418, x2416, x2321, x2384);
+  fiat_p1024_addcarryx_u64(&x2419, &x2420, x2418, x2323, x2386);
+  fiat_p1024_addcarryx_u64(&x2421, &x2422, x2420, x2325, x2388);
+  fiat_p1024_addcarryx_u64(&x2423, &x2424, x2422, x2327, x2390);
+  fiat_p1024_addcarryx_u64(&x2425, &x2426, x2424, x2329, x2392);
+  fiat_p1024_addcarryx_u64(&x2427, &x2428, x2426, x2331, x2394);
+  fiat_p1024_addcarryx_u64(&x2429, &x2430, x2428, x2333, x2396);
+  fiat_p1024_addcarryx_u64(&x2431, &x2432, x2430, x2335, x2398);
+  fiat_p1024_addcarryx_u64(&x2433, &x2434, x2432, x2337, x2400);
+  fiat_p1024_addcarryx_u64(&x2435, &x2436, x2434, x2339, x2402);
+  fiat_p1024_addcarryx_u64(&x2437, &x2438, x2436, x2341, x2404);
+  fiat_p1024_addcarryx_u64(&x2439, &x2440, x2438, x2343, x2406);
+  fiat_p1024_addcarryx_u64(&x2441, &x2442, x2440, x2345, x2408);
+  fiat_p1024_mulx_u64(&x2443, &x2444, x2409, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x2445, &x2446, x2443, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x2447, &x2448, x2443, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x2449, &x2450, x2443, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x2451, &x2452, x2443, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x2453, &x2454, x2443, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x2455, &x2456, x2443, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x2457, &x2458, x2443, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x2459, &x2460, x2443, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x2461, &x2462, x2443, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x2463, &x2464, x2443, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x2465, &x2466, x2443, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x2467, &x2468, x2443, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x2469, &x2470, x2443, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x2471, &x2472, x2443, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x2473, &x2474, x2443, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x2475, &x2476, x2443, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x2477, &x2478, 0x0, x2476, x2473);
+  fiat_p1024_addcarryx_u64(&x2479, &x2480, x2478, x2474, x2471);
+  fiat_p1024_addcarryx_u64(&x2481, &x2482, x2480, x2472, x2469);
+  fiat_p1024_addcarryx_u64(&x2483, &x2484, x2482, x2470, x2467);
+  fiat_p1024_addcarryx_u64(&x2485, &x2486, x2484, x2468, x2465);
+  fiat_p1024_addcarryx_u64(&x2487, &x2488, x2486, x2466, x2463);
+  fiat_p1024_addcarryx_u64(&x2489, &x2490, x2488, x2464, x2461);
+  fiat_p1024_addcarryx_u64(&x2491, &x2492, x2490, x2462, x2459);
+  fiat_p1024_addcarryx_u64(&x2493, &x2494, x2492, x2460, x2457);
+  fiat_p1024_addcarryx_u64(&x2495, &x2496, x2494, x2458, x2455);
+  fiat_p1024_addcarryx_u64(&x2497, &x2498, x2496, x2456, x2453);
+  fiat_p1024_addcarryx_u64(&x2499, &x2500, x2498, x2454, x2451);
+  fiat_p1024_addcarryx_u64(&x2501, &x2502, x2500, x2452, x2449);
+  fiat_p1024_addcarryx_u64(&x2503, &x2504, x2502, x2450, x2447);
+  fiat_p1024_addcarryx_u64(&x2505, &x2506, x2504, x2448, x2445);
+  x2507 = (x2506 + x2446);
+  fiat_p1024_addcarryx_u64(&x2508, &x2509, 0x0, x2409, x2475);
+  fiat_p1024_addcarryx_u64(&x2510, &x2511, x2509, x2411, x2477);
+  fiat_p1024_addcarryx_u64(&x2512, &x2513, x2511, x2413, x2479);
+  fiat_p1024_addcarryx_u64(&x2514, &x2515, x2513, x2415, x2481);
+  fiat_p1024_addcarryx_u64(&x2516, &x2517, x2515, x2417, x2483);
+  fiat_p1024_addcarryx_u64(&x2518, &x2519, x2517, x2419, x2485);
+  fiat_p1024_addcarryx_u64(&x2520, &x2521, x2519, x2421, x2487);
+  fiat_p1024_addcarryx_u64(&x2522, &x2523, x2521, x2423, x2489);
+  fiat_p1024_addcarryx_u64(&x2524, &x2525, x2523, x2425, x2491);
+  fiat_p1024_addcarryx_u64(&x2526, &x2527, x2525, x2427, x2493);
+  fiat_p1024_addcarryx_u64(&x2528, &x2529, x2527, x2429, x2495);
+  fiat_p1024_addcarryx_u64(&x2530, &x2531, x2529, x2431, x2497);
+  fiat_p1024_addcarryx_u64(&x2532, &x2533, x2531, x2433, x2499);
+  fiat_p1024_addcarryx_u64(&x2534, &x2535, x2533, x2435, x2501);
+  fiat_p1024_addcarryx_u64(&x2536, &x2537, x2535, x2437, x2503);
+  fiat_p1024_addcarryx_u64(&x2538, &x2539, x2537, x2439, x2505);
+  fiat_p1024_addcarryx_u64(&x2540, &x2541, x2539, x2441, x2507);
+  x2542 = ((uint64_t)x2541 + x2442);
+  fiat_p1024_mulx_u64(&x2543, &x2544, x13, (arg1[15]));
+  fiat_p1024_mulx_u64(&x2545, &x2546, x13, (arg1[14]));
+  fiat_p1024_mulx_u64(&x2547, &x2548, x13, (arg1[13]));
+  fiat_p1024_mulx_u64(&x2549, &x2550, x13, (arg1[12]));
+  fiat_p1024_mulx_u64(&x2551, &x2552, x13, (arg1[11]));
+  fiat_p1024_mulx_u64(&x2553, &x2554, x13, (arg1[10]));
+  fiat_p1024_mulx_u64(&x2555, &x2556, x13, (arg1[9]));
+  fiat_p1024_mulx_u64(&x2557, &x2558, x13, (arg1[8]));
+  fiat_p1024_mulx_u64(&x2559, &x2560, x13, (arg1[7]));
+  fiat_p1024_mulx_u64(&x2561, &x2562, x13, (arg1[6]));
+  fiat_p1024_mulx_u64(&x2563, &x2564, x13, (arg1[5]));
+  fiat_p1024_mulx_u64(&x2565, &x2566, x13, (arg1[4]));
+  fiat_p1024_mulx_u64(&x2567, &x2568, x13, (arg1[3]));
+  fiat_p1024_mulx_u64(&x2569, &x2570, x13, (arg1[2]));
+  fiat_p1024_mulx_u64(&x2571, &x2572, x13, (arg1[1]));
+  fiat_p1024_mulx_u64(&x2573, &x2574, x13, (arg1[0]));
+  fiat_p1024_addcarryx_u64(&x2575, &x2576, 0x0, x2574, x2571);
+  fiat_p1024_addcarryx_u64(&x2577, &x2578, x2576, x2572, x2569);
+  fiat_p1024_addcarryx_u64(&x2579, &x2580, x2578, x2570, x2567);
+  fiat_p1024_addcarryx_u64(&x2581, &x2582, x2580, x2568, x2565);
+  fiat_p1024_addcarryx_u64(&x2583, &x2584, x2582, x2566, x2563);
+  fiat_p1024_addcarryx_u64(&x2585, &x2586, x2584, x2564, x2561);
+  fiat_p1024_addcarryx_u64(&x2587, &x2588, x2586, x2562, x2559);
+  fiat_p1024_addcarryx_u64(&x2589, &x2590, x2588, x2560, x2557);
+  fiat_p1024_addcarryx_u64(&x2591, &x2592, x2590, x2558, x2555);
+  fiat_p1024_addcarryx_u64(&x2593, &x2594, x2592, x2556, x2553);
+  fiat_p1024_addcarryx_u64(&x2595, &x2596, x2594, x2554, x2551);
+  fiat_p1024_addcarryx_u64(&x2597, &x2598, x2596, x2552, x2549);
+  fiat_p1024_addcarryx_u64(&x2599, &x2600, x2598, x2550, x2547);
+  fiat_p1024_addcarryx_u64(&x2601, &x2602, x2600, x2548, x2545);
+  fiat_p1024_addcarryx_u64(&x2603, &x2604, x2602, x2546, x2543);
+  x2605 = (x2604 + x2544);
+  fiat_p1024_addcarryx_u64(&x2606, &x2607, 0x0, x2510, x2573);
+  fiat_p1024_addcarryx_u64(&x2608, &x2609, x2607, x2512, x2575);
+  fiat_p1024_addcarryx_u64(&x2610, &x2611, x2609, x2514, x2577);
+  fiat_p1024_addcarryx_u64(&x2612, &x2613, x2611, x2516, x2579);
+  fiat_p1024_addcarryx_u64(&x2614, &x2615, x2613, x2518, x2581);
+  fiat_p1024_addcarryx_u64(&x2616, &x2617, x2615, x2520, x2583);
+  fiat_p1024_addcarryx_u64(&x2618, &x2619, x2617, x2522, x2585);
+  fiat_p1024_addcarryx_u64(&x2620, &x2621, x2619, x2524, x2587);
+  fiat_p1024_addcarryx_u64(&x2622, &x2623, x2621, x2526, x2589);
+  fiat_p1024_addcarryx_u64(&x2624, &x2625, x2623, x2528, x2591);
+  fiat_p1024_addcarryx_u64(&x2626, &x2627, x2625, x2530, x2593);
+  fiat_p1024_addcarryx_u64(&x2628, &x2629, x2627, x2532, x2595);
+  fiat_p1024_addcarryx_u64(&x2630, &x2631, x2629, x2534, x2597);
+  fiat_p1024_addcarryx_u64(&x2632, &x2633, x2631, x2536, x2599);
+  fiat_p1024_addcarryx_u64(&x2634, &x2635, x2633, x2538, x2601);
+  fiat_p1024_addcarryx_u64(&x2636, &x2637, x2635, x2540, x2603);
+  fiat_p1024_addcarryx_u64(&x2638, &x2639, x2637, x2542, x2605);
+  fiat_p1024_mulx_u64(&x2640, &x2641, x2606, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x2642, &x2643, x2640, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x2644, &x2645, x2640, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x2646, &x2647, x2640, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x2648, &x2649, x2640, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x2650, &x2651, x2640, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x2652, &x2653, x2640, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x2654, &x2655, x2640, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x2656, &x2657, x2640, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x2658, &x2659, x2640, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x2660, &x2661, x2640, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x2662, &x2663, x2640, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x2664, &x2665, x2640, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x2666, &x2667, x2640, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x2668, &x2669, x2640, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x2670, &x2671, x2640, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x2672, &x2673, x2640, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x2674, &x2675, 0x0, x2673, x2670);
+  fiat_p1024_addcarryx_u64(&x2676, &x2677, x2675, x2671, x2668);
+  fiat_p1024_addcarryx_u64(&x2678, &x2679, x2677, x2669, x2666);
+  fiat_p1024_addcarryx_u64(&x2680, &x2681, x2679, x2667, x2664);
+  fiat_p1024_addcarryx_u64(&x2682, &x2683, x2681, x2665, x2662);
+  fiat_p1024_addcarryx_u64(&x2684, &x2685, x2683, x2663, x2660);
+  fiat_p1024_addcarryx_u64(&x2686, &x2687, x2685, x2661, x2658);
+  fiat_p1024_addcarryx_u64(&x2688, &x2689, x2687, x2659, x2656);
+  fiat_p1024_addcarryx_u64(&x2690, &x2691, x2689, x2657, x2654);
+  fiat_p1024_addcarryx_u64(&x2692, &x2693, x2691, x2655, x2652);
+  fiat_p1024_addcarryx_u64(&x2694, &x2695, x2693, x2653, x2650);
+  fiat_p1024_addcarryx_u64(&x2696, &x2697, x2695, x2651, x2648);
+  fiat_p1024_addcarryx_u64(&x2698, &x2699, x2697, x2649, x2646);
+  fiat_p1024_addcarryx_u64(&x2700, &x2701, x2699, x2647, x2644);
+  fiat_p1024_addcarryx_u64(&x2702, &x2703, x2701, x2645, x2642);
+  x2704 = (x2703 + x2643);
+  fiat_p1024_addcarryx_u64(&x2705, &x2706, 0x0, x2606, x2672);
+  fiat_p1024_addcarryx_u64(&x2707, &x2708, x2706, x2608, x2674);
+  fiat_p1024_addcarryx_u64(&x2709, &x2710, x2708, x2610, x2676);
+  fiat_p1024_addcarryx_u64(&x2711, &x2712, x2710, x2612, x2678);
+  fiat_p1024_addcarryx_u64(&x2713, &x2714, x2712, x2614, x2680);
+  fiat_p1024_addcarryx_u64(&x2715, &x2716, x2714, x2616, x2682);
+  fiat_p1024_addcarryx_u64(&x2717, &x2718, x2716, x2618, x2684);
+  fiat_p1024_addcarryx_u64(&x2719, &x2720, x2718, x2620, x2686);
+  fiat_p1024_addcarryx_u64(&x2721, &x2722, x2720, x2622, x2688);
+  fiat_p1024_addcarryx_u64(&x2723, &x2724, x2722, x2624, x2690);
+  fiat_p1024_addcarryx_u64(&x2725, &x2726, x2724, x2626, x2692);
+  fiat_p1024_addcarryx_u64(&x2727, &x2728, x2726, x2628, x2694);
+  fiat_p1024_addcarryx_u64(&x2729, &x2730, x2728, x2630, x2696);
+  fiat_p1024_addcarryx_u64(&x2731, &x2732, x2730, x2632, x2698);
+  fiat_p1024_addcarryx_u64(&x2733, &x2734, x2732, x2634, x2700);
+  fiat_p1024_addcarryx_u64(&x2735, &x2736, x2734, x2636, x2702);
+  fiat_p1024_addcarryx_u64(&x2737, &x2738, x2736, x2638, x2704);
+  x2739 = ((uint64_t)x2738 + x2639);
+  fiat_p1024_mulx_u64(&x2740, &x2741, x14, (arg1[15]));
+  fiat_p1024_mulx_u64(&x2742, &x2743, x14, (arg1[14]));
+  fiat_p1024_mulx_u64(&x2744, &x2745, x14, (arg1[13]));
+  fiat_p1024_mulx_u64(&x2746, &x2747, x14, (arg1[12]));
+  fiat_p1024_mulx_u64(&x2748, &x2749, x14, (arg1[11]));
+  fiat_p1024_mulx_u64(&x2750, &x2751, x14, (arg1[10]));
+  fiat_p1024_mulx_u64(&x2752, &x2753, x14, (arg1[9]));
+  fiat_p1024_mulx_u64(&x2754, &x2755, x14, (arg1[8]));
+  fiat_p1024_mulx_u64(&x2756, &x2757, x14, (arg1[7]));
+  fiat_p1024_mulx_u64(&x2758, &x2759, x14, (arg1[6]));
+  fiat_p1024_mulx_u64(&x2760, &x2761, x14, (arg1[5]));
+  fiat_p1024_mulx_u64(&x2762, &x2763, x14, (arg1[4]));
+  fiat_p1024_mulx_u64(&x2764, &x2765, x14, (arg1[3]));
+  fiat_p1024_mulx_u64(&x2766, &x2767, x14, (arg1[2]));
+  fiat_p1024_mulx_u64(&x2768, &x2769, x14, (arg1[1]));
+  fiat_p1024_mulx_u64(&x2770, &x2771, x14, (arg1[0]));
+  fiat_p1024_addcarryx_u64(&x2772, &x2773, 0x0, x2771, x2768);
+  fiat_p1024_addcarryx_u64(&x2774, &x2775, x2773, x2769, x2766);
+  fiat_p1024_addcarryx_u64(&x2776, &x2777, x2775, x2767, x2764);
+  fiat_p1024_addcarryx_u64(&x2778, &x2779, x2777, x2765, x2762);
+  fiat_p1024_addcarryx_u64(&x2780, &x2781, x2779, x2763, x2760);
+  fiat_p1024_addcarryx_u64(&x2782, &x2783, x2781, x2761, x2758);
+  fiat_p1024_addcarryx_u64(&x2784, &x2785, x2783, x2759, x2756);
+  fiat_p1024_addcarryx_u64(&x2786, &x2787, x2785, x2757, x2754);
+  fiat_p1024_addcarryx_u64(&x2788, &x2789, x2787, x2755, x2752);
+  fiat_p1024_addcarryx_u64(&x2790, &x2791, x2789, x2753, x2750);
+  fiat_p1024_addcarryx_u64(&x2792, &x2793, x2791, x2751, x2748);
+  fiat_p1024_addcarryx_u64(&x2794, &x2795, x2793, x2749, x2746);
+  fiat_p1024_addcarryx_u64(&x2796, &x2797, x2795, x2747, x2744);
+  fiat_p1024_addcarryx_u64(&x2798, &x2799, x2797, x2745, x2742);
+  fiat_p1024_addcarryx_u64(&x2800, &x2801, x2799, x2743, x2740);
+  x2802 = (x2801 + x2741);
+  fiat_p1024_addcarryx_u64(&x2803, &x2804, 0x0, x2707, x2770);
+  fiat_p1024_addcarryx_u64(&x2805, &x2806, x2804, x2709, x2772);
+  fiat_p1024_addcarryx_u64(&x2807, &x2808, x2806, x2711, x2774);
+  fiat_p1024_addcarryx_u64(&x2809, &x2810, x2808, x2713, x2776);
+  fiat_p1024_addcarryx_u64(&x2811, &x2812, x2810, x2715, x2778);
+  fiat_p1024_addcarryx_u64(&x2813, &x2814, x2812, x2717, x2780);
+  fiat_p1024_addcarryx_u64(&x2815, &x2816, x2814, x2719, x2782);
+  fiat_p1024_addcarryx_u64(&x2817, &x2818, x2816, x2721, x2784);
+  fiat_p1024_addcarryx_u64(&x2819, &x2820, x2818, x2723, x2786);
+  fiat_p1024_addcarryx_u64(&x2821, &x2822, x2820, x2725, x2788);
+  fiat_p1024_addcarryx_u64(&x2823, &x2824, x2822, x2727, x2790);
+  fiat_p1024_addcarryx_u64(&x2825, &x2826, x2824, x2729, x2792);
+  fiat_p1024_addcarryx_u64(&x2827, &x2828, x2826, x2731, x2794);
+  fiat_p1024_addcarryx_u64(&x2829, &x2830, x2828, x2733, x2796);
+  fiat_p1024_addcarryx_u64(&x2831, &x2832, x2830, x2735, x2798);
+  fiat_p1024_addcarryx_u64(&x2833, &x2834, x2832, x2737, x2800);
+  fiat_p1024_addcarryx_u64(&x2835, &x2836, x2834, x2739, x2802);
+  fiat_p1024_mulx_u64(&x2837, &x2838, x2803, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x2839, &x2840, x2837, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x2841, &x2842, x2837, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x2843, &x2844, x2837, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x2845, &x2846, x2837, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x2847, &x2848, x2837, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x2849, &x2850, x2837, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x2851, &x2852, x2837, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x2853, &x2854, x2837, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x2855, &x2856, x2837, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x2857, &x2858, x2837, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x2859, &x2860, x2837, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x2861, &x2862, x2837, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x2863, &x2864, x2837, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x2865, &x2866, x2837, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x2867, &x2868, x2837, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x2869, &x2870, x2837, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x2871, &x2872, 0x0, x2870, x2867);
+  fiat_p1024_addcarryx_u64(&x2873, &x2874, x2872, x2868, x2865);
+  fiat_p1024_addcarryx_u64(&x2875, &x2876, x2874, x2866, x2863);
+  fiat_p1024_addcarryx_u64(&x2877, &x2878, x2876, x2864, x2861);
+  fiat_p1024_addcarryx_u64(&x2879, &x2880, x2878, x2862, x2859);
+  fiat_p1024_addcarryx_u64(&x2881, &x2882, x2880, x2860, x2857);
+  fiat_p1024_addcarryx_u64(&x2883, &x2884, x2882, x2858, x2855);
+  fiat_p1024_addcarryx_u64(&x2885, &x2886, x2884, x2856, x2853);
+  fiat_p1024_addcarryx_u64(&x2887, &x2888, x2886, x2854, x2851);
+  fiat_p1024_addcarryx_u64(&x2889, &x2890, x2888, x2852, x2849);
+  fiat_p1024_addcarryx_u64(&x2891, &x2892, x2890, x2850, x2847);
+  fiat_p1024_addcarryx_u64(&x2893, &x2894, x2892, x2848, x2845);
+  fiat_p1024_addcarryx_u64(&x2895, &x2896, x2894, x2846, x2843);
+  fiat_p1024_addcarryx_u64(&x2897, &x2898, x2896, x2844, x2841);
+  fiat_p1024_addcarryx_u64(&x2899, &x2900, x2898, x2842, x2839);
+  x2901 = (x2900 + x2840);
+  fiat_p1024_addcarryx_u64(&x2902, &x2903, 0x0, x2803, x2869);
+  fiat_p1024_addcarryx_u64(&x2904, &x2905, x2903, x2805, x2871);
+  fiat_p1024_addcarryx_u64(&x2906, &x2907, x2905, x2807, x2873);
+  fiat_p1024_addcarryx_u64(&x2908, &x2909, x2907, x2809, x2875);
+  fiat_p1024_addcarryx_u64(&x2910, &x2911, x2909, x2811, x2877);
+  fiat_p1024_addcarryx_u64(&x2912, &x2913, x2911, x2813, x2879);
+  fiat_p1024_addcarryx_u64(&x2914, &x2915, x2913, x2815, x2881);
+  fiat_p1024_addcarryx_u64(&x2916, &x2917, x2915, x2817, x2883);
+  fiat_p1024_addcarryx_u64(&x2918, &x2919, x2917, x2819, x2885);
+  fiat_p1024_addcarryx_u64(&x2920, &x2921, x2919, x2821, x2887);
+  fiat_p1024_addcarryx_u64(&x2922, &x2923, x2921, x2823, x2889);
+  fiat_p1024_addcarryx_u64(&x2924, &x2925, x2923, x2825, x2891);
+  fiat_p1024_addcarryx_u64(&x2926, &x2927, x2925, x2827, x2893);
+  fiat_p1024_addcarryx_u64(&x2928, &x2929, x2927, x2829, x2895);
+  fiat_p1024_addcarryx_u64(&x2930, &x2931, x2929, x2831, x2897);
+  fiat_p1024_addcarryx_u64(&x2932, &x2933, x2931, x2833, x2899);
+  fiat_p1024_addcarryx_u64(&x2934, &x2935, x2933, x2835, x2901);
+  x2936 = ((uint64_t)x2935 + x2836);
+  fiat_p1024_mulx_u64(&x2937, &x2938, x15, (arg1[15]));
+  fiat_p1024_mulx_u64(&x2939, &x2940, x15, (arg1[14]));
+  fiat_p1024_mulx_u64(&x2941, &x2942, x15, (arg1[13]));
+  fiat_p1024_mulx_u64(&x2943, &x2944, x15, (arg1[12]));
+  fiat_p1024_mulx_u64(&x2945, &x2946, x15, (arg1[11]));
+  fiat_p1024_mulx_u64(&x2947, &x2948, x15, (arg1[10]));
+  fiat_p1024_mulx_u64(&x2949, &x2950, x15, (arg1[9]));
+  fiat_p1024_mulx_u64(&x2951, &x2952, x15, (arg1[8]));
+  fiat_p1024_mulx_u64(&x2953, &x2954, x15, (arg1[7]));
+  fiat_p1024_mulx_u64(&x2955, &x2956, x15, (arg1[6]));
+  fiat_p1024_mulx_u64(&x2957, &x2958, x15, (arg1[5]));
+  fiat_p1024_mulx_u64(&x2959, &x2960, x15, (arg1[4]));
+  fiat_p1024_mulx_u64(&x2961, &x2962, x15, (arg1[3]));
+  fiat_p1024_mulx_u64(&x2963, &x2964, x15, (arg1[2]));
+  fiat_p1024_mulx_u64(&x2965, &x2966, x15, (arg1[1]));
+  fiat_p1024_mulx_u64(&x2967, &x2968, x15, (arg1[0]));
+  fiat_p1024_addcarryx_u64(&x2969, &x2970, 0x0, x2968, x2965);
+  fiat_p1024_addcarryx_u64(&x2971, &x2972, x2970, x2966, x2963);
+  fiat_p1024_addcarryx_u64(&x2973, &x2974, x2972, x2964, x2961);
+  fiat_p1024_addcarryx_u64(&x2975, &x2976, x2974, x2962, x2959);
+  fiat_p1024_addcarryx_u64(&x2977, &x2978, x2976, x2960, x2957);
+  fiat_p1024_addcarryx_u64(&x2979, &x2980, x2978, x2958, x2955);
+  fiat_p1024_addcarryx_u64(&x2981, &x2982, x2980, x2956, x2953);
+  fiat_p1024_addcarryx_u64(&x2983, &x2984, x2982, x2954, x2951);
+  fiat_p1024_addcarryx_u64(&x2985, &x2986, x2984, x2952, x2949);
+  fiat_p1024_addcarryx_u64(&x2987, &x2988, x2986, x2950, x2947);
+  fiat_p1024_addcarryx_u64(&x2989, &x2990, x2988, x2948, x2945);
+  fiat_p1024_addcarryx_u64(&x2991, &x2992, x2990, x2946, x2943);
+  fiat_p1024_addcarryx_u64(&x2993, &x2994, x2992, x2944, x2941);
+  fiat_p1024_addcarryx_u64(&x2995, &x2996, x2994, x2942, x2939);
+  fiat_p1024_addcarryx_u64(&x2997, &x2998, x2996, x2940, x2937);
+  x2999 = (x2998 + x2938);
+  fiat_p1024_addcarryx_u64(&x3000, &x3001, 0x0, x2904, x2967);
+  fiat_p1024_addcarryx_u64(&x3002, &x3003, x3001, x2906, x2969);
+  fiat_p1024_addcarryx_u64(&x3004, &x3005, x3003, x2908, x2971);
+  fiat_p1024_addcarryx_u64(&x3006, &x3007, x3005, x2910, x2973);
+  fiat_p1024_addcarryx_u64(&x3008, &x3009, x3007, x2912, x2975);
+  fiat_p1024_addcarryx_u64(&x3010, &x3011, x3009, x2914, x2977);
+  fiat_p1024_addcarryx_u64(&x3012, &x3013, x3011, x2916, x2979);
+  fiat_p1024_addcarryx_u64(&x3014, &x3015, x3013, x2918, x2981);
+  fiat_p1024_addcarryx_u64(&x3016, &x3017, x3015, x2920, x2983);
+  fiat_p1024_addcarryx_u64(&x3018, &x3019, x3017, x2922, x2985);
+  fiat_p1024_addcarryx_u64(&x3020, &x3021, x3019, x2924, x2987);
+  fiat_p1024_addcarryx_u64(&x3022, &x3023, x3021, x2926, x2989);
+  fiat_p1024_addcarryx_u64(&x3024, &x3025, x3023, x2928, x2991);
+  fiat_p1024_addcarryx_u64(&x3026, &x3027, x3025, x2930, x2993);
+  fiat_p1024_addcarryx_u64(&x3028, &x3029, x3027, x2932, x2995);
+  fiat_p1024_addcarryx_u64(&x3030, &x3031, x3029, x2934, x2997);
+  fiat_p1024_addcarryx_u64(&x3032, &x3033, x3031, x2936, x2999);
+  fiat_p1024_mulx_u64(&x3034, &x3035, x3000, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x3036, &x3037, x3034, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x3038, &x3039, x3034, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x3040, &x3041, x3034, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x3042, &x3043, x3034, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x3044, &x3045, x3034, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x3046, &x3047, x3034, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x3048, &x3049, x3034, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x3050, &x3051, x3034, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x3052, &x3053, x3034, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x3054, &x3055, x3034, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x3056, &x3057, x3034, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x3058, &x3059, x3034, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x3060, &x3061, x3034, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x3062, &x3063, x3034, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x3064, &x3065, x3034, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x3066, &x3067, x3034, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x3068, &x3069, 0x0, x3067, x3064);
+  fiat_p1024_addcarryx_u64(&x3070, &x3071, x3069, x3065, x3062);
+  fiat_p1024_addcarryx_u64(&x3072, &x3073, x3071, x3063, x3060);
+  fiat_p1024_addcarryx_u64(&x3074, &x3075, x3073, x3061, x3058);
+  fiat_p1024_addcarryx_u64(&x3076, &x3077, x3075, x3059, x3056);
+  fiat_p1024_addcarryx_u64(&x3078, &x3079, x3077, x3057, x3054);
+  fiat_p1024_addcarryx_u64(&x3080, &x3081, x3079, x3055, x3052);
+  fiat_p1024_addcarryx_u64(&x3082, &x3083, x3081, x3053, x3050);
+  fiat_p1024_addcarryx_u64(&x3084, &x3085, x3083, x3051, x3048);
+  fiat_p1024_addcarryx_u64(&x3086, &x3087, x3085, x3049, x3046);
+  fiat_p1024_addcarryx_u64(&x3088, &x3089, x3087, x3047, x3044);
+  fiat_p1024_addcarryx_u64(&x3090, &x3091, x3089, x3045, x3042);
+  fiat_p1024_addcarryx_u64(&x3092, &x3093, x3091, x3043, x3040);
+  fiat_p1024_addcarryx_u64(&x3094, &x3095, x3093, x3041, x3038);
+  fiat_p1024_addcarryx_u64(&x3096, &x3097, x3095, x3039, x3036);
+  x3098 = (x3097 + x3037);
+  fiat_p1024_addcarryx_u64(&x3099, &x3100, 0x0, x3000, x3066);
+  fiat_p1024_addcarryx_u64(&x3101, &x3102, x3100, x3002, x3068);
+  fiat_p1024_addcarryx_u64(&x3103, &x3104, x3102, x3004, x3070);
+  fiat_p1024_addcarryx_u64(&x3105, &x3106, x3104, x3006, x3072);
+  fiat_p1024_addcarryx_u64(&x3107, &x3108, x3106, x3008, x3074);
+  fiat_p1024_addcarryx_u64(&x3109, &x3110, x3108, x3010, x3076);
+  fiat_p1024_addcarryx_u64(&x3111, &x3112, x3110, x3012, x3078);
+  fiat_p1024_addcarryx_u64(&x3113, &x3114, x3112, x3014, x3080);
+  fiat_p1024_addcarryx_u64(&x3115, &x3116, x3114, x3016, x3082);
+  fiat_p1024_addcarryx_u64(&x3117, &x3118, x3116, x3018, x3084);
+  fiat_p1024_addcarryx_u64(&x3119, &x3120, x3118, x3020, x3086);
+  fiat_p1024_addcarryx_u64(&x3121, &x3122, x3120, x3022, x3088);
+  fiat_p1024_addcarryx_u64(&x3123, &x3124, x3122, x3024, x3090);
+  fiat_p1024_addcarryx_u64(&x3125, &x3126, x3124, x3026, x3092);
+  fiat_p1024_addcarryx_u64(&x3127, &x3128, x3126, x3028, x3094);
+  fiat_p1024_addcarryx_u64(&x3129, &x3130, x3128, x3030, x3096);
+  fiat_p1024_addcarryx_u64(&x3131, &x3132, x3130, x3032, x3098);
+  x3133 = ((uint64_t)x3132 + x3033);
+  fiat_p1024_subborrowx_u64(&x3134, &x3135, 0x0, x3101, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_subborrowx_u64(&x3136, &x3137, x3135, x3103, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_subborrowx_u64(&x3138, &x3139, x3137, x3105, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_subborrowx_u64(&x3140, &x3141, x3139, x3107, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_subborrowx_u64(&x3142, &x3143, x3141, x3109, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_subborrowx_u64(&x3144, &x3145, x3143, x3111, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_subborrowx_u64(&x3146, &x3147, x3145, x3113, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_subborrowx_u64(&x3148, &x3149, x3147, x3115, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_subborrowx_u64(&x3150, &x3151, x3149, x3117, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_subborrowx_u64(&x3152, &x3153, x3151, x3119, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_subborrowx_u64(&x3154, &x3155, x3153, x3121, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_subborrowx_u64(&x3156, &x3157, x3155, x3123, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_subborrowx_u64(&x3158, &x3159, x3157, x3125, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_subborrowx_u64(&x3160, &x3161, x3159, x3127, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_subborrowx_u64(&x3162, &x3163, x3161, x3129, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_subborrowx_u64(&x3164, &x3165, x3163, x3131, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_subborrowx_u64(&x3166, &x3167, x3165, x3133, 0x0);
+  fiat_p1024_cmovznz_u64(&x3168, x3167, x3134, x3101);
+  fiat_p1024_cmovznz_u64(&x3169, x3167, x3136, x3103);
+  fiat_p1024_cmovznz_u64(&x3170, x3167, x3138, x3105);
+  fiat_p1024_cmovznz_u64(&x3171, x3167, x3140, x3107);
+  fiat_p1024_cmovznz_u64(&x3172, x3167, x3142, x3109);
+  fiat_p1024_cmovznz_u64(&x3173, x3167, x3144, x3111);
+  fiat_p1024_cmovznz_u64(&x3174, x3167, x3146, x3113);
+  fiat_p1024_cmovznz_u64(&x3175, x3167, x3148, x3115);
+  fiat_p1024_cmovznz_u64(&x3176, x3167, x3150, x3117);
+  fiat_p1024_cmovznz_u64(&x3177, x3167, x3152, x3119);
+  fiat_p1024_cmovznz_u64(&x3178, x3167, x3154, x3121);
+  fiat_p1024_cmovznz_u64(&x3179, x3167, x3156, x3123);
+  fiat_p1024_cmovznz_u64(&x3180, x3167, x3158, x3125);
+  fiat_p1024_cmovznz_u64(&x3181, x3167, x3160, x3127);
+  fiat_p1024_cmovznz_u64(&x3182, x3167, x3162, x3129);
+  fiat_p1024_cmovznz_u64(&x3183, x3167, x3164, x3131);
+  out1[0] = x3168;
+  out1[1] = x3169;
+  out1[2] = x3170;
+  out1[3] = x3171;
+  out1[4] = x3172;
+  out1[5] = x3173;
+  out1[6] = x3174;
+  out1[7] = x3175;
+  out1[8] = x3176;
+  out1[9] = x3177;
+  out1[10] = x3178;
+  out1[11] = x3179;
+  out1[12] = x3180;
+  out1[13] = x3181;
+  out1[14] = x3182;
+  out1[15] = x3183;
+}
+
+/*
+ * The function fiat_p1024_add adds two field elements in the Montgomery domain.
+ *
+ * Preconditions:
+ *   0 ≤ eval arg1 < m
+ *   0 ≤ eval arg2 < m
+ * Postconditions:
+ *   eval (from_montgomery out1) mod m = (eval (from_montgomery arg1) + eval (from_montgomery arg2)) mod m
+ *   0 ≤ eval out1 < m
+ *
+ */
+void fiat_p1024_add(fiat_p1024_montgomery_domain_field_element out1, const fiat_p1024_montgomery_domain_field_element arg1, const fiat_p1024_montgomery_domain_field_element arg2) {
+  uint64_t x1;
+  fiat_p1024_uint1 x2;
+  uint64_t x3;
+  fiat_p1024_uint1 x4;
+  uint64_t x5;
+  fiat_p1024_uint1 x6;
+  uint64_t x7;
+  fiat_p1024_uint1 x8;
+  uint64_t x9;
+  fiat_p1024_uint1 x10;
+  uint64_t x11;
+  fiat_p1024_uint1 x12;
+  uint64_t x13;
+  fiat_p1024_uint1 x14;
+  uint64_t x15;
+  fiat_p1024_uint1 x16;
+  uint64_t x17;
+  fiat_p1024_uint1 x18;
+  uint64_t x19;
+  fiat_p1024_uint1 x20;
+  uint64_t x21;
+  fiat_p1024_uint1 x22;
+  uint64_t x23;
+  fiat_p1024_uint1 x24;
+  uint64_t x25;
+  fiat_p1024_uint1 x26;
+  uint64_t x27;
+  fiat_p1024_uint1 x28;
+  uint64_t x29;
+  fiat_p1024_uint1 x30;
+  uint64_t x31;
+  fiat_p1024_uint1 x32;
+  uint64_t x33;
+  fiat_p1024_uint1 x34;
+  uint64_t x35;
+  fiat_p1024_uint1 x36;
+  uint64_t x37;
+  fiat_p1024_uint1 x38;
+  uint64_t x39;
+  fiat_p1024_uint1 x40;
+  uint64_t x41;
+  fiat_p1024_uint1 x42;
+  uint64_t x43;
+  fiat_p1024_uint1 x44;
+  uint64_t x45;
+  fiat_p1024_uint1 x46;
+  uint64_t x47;
+  fiat_p1024_uint1 x48;
+  uint64_t x49;
+  fiat_p1024_uint1 x50;
+  uint64_t x51;
+  fiat_p1024_uint1 x52;
+  uint64_t x53;
+  fiat_p1024_uint1 x54;
+  uint64_t x55;
+  fiat_p1024_uint1 x56;
+  uint64_t x57;
+  fiat_p1024_uint1 x58;
+  uint64_t x59;
+  fiat_p1024_uint1 x60;
+  uint64_t x61;
+  fiat_p1024_uint1 x62;
+  uint64_t x63;
+  fiat_p1024_uint1 x64;
+  uint64_t x65;
+  fiat_p1024_uint1 x66;
+  uint64_t x67;
+  uint64_t x68;
+  uint64_t x69;
+  uint64_t x70;
+  uint64_t x71;
+  uint64_t x72;
+  uint64_t x73;
+  uint64_t x74;
+  uint64_t x75;
+  uint64_t x76;
+  uint64_t x77;
+  uint64_t x78;
+  uint64_t x79;
+  uint64_t x80;
+  uint64_t x81;
+  uint64_t x82;
+  fiat_p1024_addcarryx_u64(&x1, &x2, 0x0, (arg1[0]), (arg2[0]));
+  fiat_p1024_addcarryx_u64(&x3, &x4, x2, (arg1[1]), (arg2[1]));
+  fiat_p1024_addcarryx_u64(&x5, &x6, x4, (arg1[2]), (arg2[2]));
+  fiat_p1024_addcarryx_u64(&x7, &x8, x6, (arg1[3]), (arg2[3]));
+  fiat_p1024_addcarryx_u64(&x9, &x10, x8, (arg1[4]), (arg2[4]));
+  fiat_p1024_addcarryx_u64(&x11, &x12, x10, (arg1[5]), (arg2[5]));
+  fiat_p1024_addcarryx_u64(&x13, &x14, x12, (arg1[6]), (arg2[6]));
+  fiat_p1024_addcarryx_u64(&x15, &x16, x14, (arg1[7]), (arg2[7]));
+  fiat_p1024_addcarryx_u64(&x17, &x18, x16, (arg1[8]), (arg2[8]));
+  fiat_p1024_addcarryx_u64(&x19, &x20, x18, (arg1[9]), (arg2[9]));
+  fiat_p1024_addcarryx_u64(&x21, &x22, x20, (arg1[10]), (arg2[10]));
+  fiat_p1024_addcarryx_u64(&x23, &x24, x22, (arg1[11]), (arg2[11]));
+  fiat_p1024_addcarryx_u64(&x25, &x26, x24, (arg1[12]), (arg2[12]));
+  fiat_p1024_addcarryx_u64(&x27, &x28, x26, (arg1[13]), (arg2[13]));
+  fiat_p1024_addcarryx_u64(&x29, &x30, x28, (arg1[14]), (arg2[14]));
+  fiat_p1024_addcarryx_u64(&x31, &x32, x30, (arg1[15]), (arg2[15]));
+  fiat_p1024_subborrowx_u64(&x33, &x34, 0x0, x1, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_subborrowx_u64(&x35, &x36, x34, x3, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_subborrowx_u64(&x37, &x38, x36, x5, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_subborrowx_u64(&x39, &x40, x38, x7, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_subborrowx_u64(&x41, &x42, x40, x9, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_subborrowx_u64(&x43, &x44, x42, x11, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_subborrowx_u64(&x45, &x46, x44, x13, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_subborrowx_u64(&x47, &x48, x46, x15, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_subborrowx_u64(&x49, &x50, x48, x17, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_subborrowx_u64(&x51, &x52, x50, x19, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_subborrowx_u64(&x53, &x54, x52, x21, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_subborrowx_u64(&x55, &x56, x54, x23, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_subborrowx_u64(&x57, &x58, x56, x25, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_subborrowx_u64(&x59, &x60, x58, x27, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_subborrowx_u64(&x61, &x62, x60, x29, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_subborrowx_u64(&x63, &x64, x62, x31, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_subborrowx_u64(&x65, &x66, x64, x32, 0x0);
+  fiat_p1024_cmovznz_u64(&x67, x66, x33, x1);
+  fiat_p1024_cmovznz_u64(&x68, x66, x35, x3);
+  fiat_p1024_cmovznz_u64(&x69, x66, x37, x5);
+  fiat_p1024_cmovznz_u64(&x70, x66, x39, x7);
+  fiat_p1024_cmovznz_u64(&x71, x66, x41, x9);
+  fiat_p1024_cmovznz_u64(&x72, x66, x43, x11);
+  fiat_p1024_cmovznz_u64(&x73, x66, x45, x13);
+  fiat_p1024_cmovznz_u64(&x74, x66, x47, x15);
+  fiat_p1024_cmovznz_u64(&x75, x66, x49, x17);
+  fiat_p1024_cmovznz_u64(&x76, x66, x51, x19);
+  fiat_p1024_cmovznz_u64(&x77, x66, x53, x21);
+  fiat_p1024_cmovznz_u64(&x78, x66, x55, x23);
+  fiat_p1024_cmovznz_u64(&x79, x66, x57, x25);
+  fiat_p1024_cmovznz_u64(&x80, x66, x59, x27);
+  fiat_p1024_cmovznz_u64(&x81, x66, x61, x29);
+  fiat_p1024_cmovznz_u64(&x82, x66, x63, x31);
+  out1[0] = x67;
+  out1[1] = x68;
+  out1[2] = x69;
+  out1[3] = x70;
+  out1[4] = x71;
+  out1[5] = x72;
+  out1[6] = x73;
+  out1[7] = x74;
+  out1[8] = x75;
+  out1[9] = x76;
+  out1[10] = x77;
+  out1[11] = x78;
+  out1[12] = x79;
+  out1[13] = x80;
+  out1[14] = x81;
+  out1[15] = x82;
+}
+
+/*
+ * The function fiat_p1024_sub subtracts two field elements in the Montgomery domain.
+ *
+ * Preconditions:
+ *   0 ≤ eval arg1 < m
+ *   0 ≤ eval arg2 < m
+ * Postconditions:
+ *   eval (from_montgomery out1) mod m = (eval (from_montgomery arg1) - eval (from_montgomery arg2)) mod m
+ *   0 ≤ eval out1 < m
+ *
+ */
+void fiat_p1024_sub(fiat_p1024_montgomery_domain_field_element out1, const fiat_p1024_montgomery_domain_field_element arg1, const fiat_p1024_montgomery_domain_field_element arg2) {
+  uint64_t x1;
+  fiat_p1024_uint1 x2;
+  uint64_t x3;
+  fiat_p1024_uint1 x4;
+  uint64_t x5;
+  fiat_p1024_uint1 x6;
+  uint64_t x7;
+  fiat_p1024_uint1 x8;
+  uint64_t x9;
+  fiat_p1024_uint1 x10;
+  uint64_t x11;
+  fiat_p1024_uint1 x12;
+  uint64_t x13;
+  fiat_p1024_uint1 x14;
+  uint64_t x15;
+  fiat_p1024_uint1 x16;
+  uint64_t x17;
+  fiat_p1024_uint1 x18;
+  uint64_t x19;
+  fiat_p1024_uint1 x20;
+  uint64_t x21;
+  fiat_p1024_uint1 x22;
+  uint64_t x23;
+  fiat_p1024_uint1 x24;
+  uint64_t x25;
+  fiat_p1024_uint1 x26;
+  uint64_t x27;
+  fiat_p1024_uint1 x28;
+  uint64_t x29;
+  fiat_p1024_uint1 x30;
+  uint64_t x31;
+  fiat_p1024_uint1 x32;
+  uint64_t x33;
+  uint64_t x34;
+  fiat_p1024_uint1 x35;
+  uint64_t x36;
+  fiat_p1024_uint1 x37;
+  uint64_t x38;
+  fiat_p1024_uint1 x39;
+  uint64_t x40;
+  fiat_p1024_uint1 x41;
+  uint64_t x42;
+  fiat_p1024_uint1 x43;
+  uint64_t x44;
+  fiat_p1024_uint1 x45;
+  uint64_t x46;
+  fiat_p1024_uint1 x47;
+  uint64_t x48;
+  fiat_p1024_uint1 x49;
+  uint64_t x50;
+  fiat_p1024_uint1 x51;
+  uint64_t x52;
+  fiat_p1024_uint1 x53;
+  uint64_t x54;
+  fiat_p1024_uint1 x55;
+  uint64_t x56;
+  fiat_p1024_uint1 x57;
+  uint64_t x58;
+  fiat_p1024_uint1 x59;
+  uint64_t x60;
+  fiat_p1024_uint1 x61;
+  uint64_t x62;
+  fiat_p1024_uint1 x63;
+  uint64_t x64;
+  fiat_p1024_uint1 x65;
+  fiat_p1024_subborrowx_u64(&x1, &x2, 0x0, (arg1[0]), (arg2[0]));
+  fiat_p1024_subborrowx_u64(&x3, &x4, x2, (arg1[1]), (arg2[1]));
+  fiat_p1024_subborrowx_u64(&x5, &x6, x4, (arg1[2]), (arg2[2]));
+  fiat_p1024_subborrowx_u64(&x7, &x8, x6, (arg1[3]), (arg2[3]));
+  fiat_p1024_subborrowx_u64(&x9, &x10, x8, (arg1[4]), (arg2[4]));
+  fiat_p1024_subborrowx_u64(&x11, &x12, x10, (arg1[5]), (arg2[5]));
+  fiat_p1024_subborrowx_u64(&x13, &x14, x12, (arg1[6]), (arg2[6]));
+  fiat_p1024_subborrowx_u64(&x15, &x16, x14, (arg1[7]), (arg2[7]));
+  fiat_p1024_subborrowx_u64(&x17, &x18, x16, (arg1[8]), (arg2[8]));
+  fiat_p1024_subborrowx_u64(&x19, &x20, x18, (arg1[9]), (arg2[9]));
+  fiat_p1024_subborrowx_u64(&x21, &x22, x20, (arg1[10]), (arg2[10]));
+  fiat_p1024_subborrowx_u64(&x23, &x24, x22, (arg1[11]), (arg2[11]));
+  fiat_p1024_subborrowx_u64(&x25, &x26, x24, (arg1[12]), (arg2[12]));
+  fiat_p1024_subborrowx_u64(&x27, &x28, x26, (arg1[13]), (arg2[13]));
+  fiat_p1024_subborrowx_u64(&x29, &x30, x28, (arg1[14]), (arg2[14]));
+  fiat_p1024_subborrowx_u64(&x31, &x32, x30, (arg1[15]), (arg2[15]));
+  fiat_p1024_cmovznz_u64(&x33, x32, 0x0, UINT64_C(0xffffffffffffffff));
+  fiat_p1024_addcarryx_u64(&x34, &x35, 0x0, x1, (x33 & UINT64_C(0xdbe34c5460e36453)));
+  fiat_p1024_addcarryx_u64(&x36, &x37, x35, x3, (x33 & UINT64_C(0xa1d81eebbc3d344d)));
+  fiat_p1024_addcarryx_u64(&x38, &x39, x37, x5, (x33 & UINT64_C(0x514ba72cb8d89fd3)));
+  fiat_p1024_addcarryx_u64(&x40, &x41, x39, x7, (x33 & UINT64_C(0xc2cab6a0e287f1bd)));
+  fiat_p1024_addcarryx_u64(&x42, &x43, x41, x9, (x33 & UINT64_C(0x642aca4d5a313709)));
+  fiat_p1024_addcarryx_u64(&x44, &x45, x43, x11, (x33 & UINT64_C(0x6b317c5431541f40)));
+  fiat_p1024_addcarryx_u64(&x46, &x47, x45, x13, (x33 & UINT64_C(0xb97c56d1de81ede5)));
+  fiat_p1024_addcarryx_u64(&x48, &x49, x47, x15, (x33 & UINT64_C(0x978dbeed90a2b58)));
+  fiat_p1024_addcarryx_u64(&x50, &x51, x49, x17, (x33 & UINT64_C(0x7611ad4f90441c80)));
+  fiat_p1024_addcarryx_u64(&x52, &x53, x51, x19, (x33 & UINT64_C(0xf811d9c419ec8329)));
+  fiat_p1024_addcarryx_u64(&x54, &x55, x53, x21, (x33 & UINT64_C(0x4d6c594a8ad82d2d)));
+  fiat_p1024_addcarryx_u64(&x56, &x57, x55, x23, (x33 & UINT64_C(0xf06de2471cf9386e)));
+  fiat_p1024_addcarryx_u64(&x58, &x59, x57, x25, (x33 & UINT64_C(0x683cf25db31ad5b)));
+  fiat_p1024_addcarryx_u64(&x60, &x61, x59, x27, (x33 & UINT64_C(0x216c22bc86f21a08)));
+  fiat_p1024_addcarryx_u64(&x62, &x63, x61, x29, (x33 & UINT64_C(0xd89dec879007ebd7)));
+  fiat_p1024_addcarryx_u64(&x64, &x65, x63, x31, (x33 & UINT64_C(0xece55ed427012a9)));
+  out1[0] = x34;
+  out1[1] = x36;
+  out1[2] = x38;
+  out1[3] = x40;
+  out1[4] = x42;
+  out1[5] = x44;
+  out1[6] = x46;
+  out1[7] = x48;
+  out1[8] = x50;
+  out1[9] = x52;
+  out1[10] = x54;
+  out1[11] = x56;
+  out1[12] = x58;
+  out1[13] = x60;
+  out1[14] = x62;
+  out1[15] = x64;
+}
+
+/*
+ * The function fiat_p1024_opp negates a field element in the Montgomery domain.
+ *
+ * Preconditions:
+ *   0 ≤ eval arg1 < m
+ * Postconditions:
+ *   eval (from_montgomery out1) mod m = -eval (from_montgomery arg1) mod m
+ *   0 ≤ eval out1 < m
+ *
+ */
+void fiat_p1024_opp(fiat_p1024_montgomery_domain_field_element out1, const fiat_p1024_montgomery_domain_field_element arg1) {
+  uint64_t x1;
+  fiat_p1024_uint1 x2;
+  uint64_t x3;
+  fiat_p1024_uint1 x4;
+  uint64_t x5;
+  fiat_p1024_uint1 x6;
+  uint64_t x7;
+  fiat_p1024_uint1 x8;
+  uint64_t x9;
+  fiat_p1024_uint1 x10;
+  uint64_t x11;
+  fiat_p1024_uint1 x12;
+  uint64_t x13;
+  fiat_p1024_uint1 x14;
+  uint64_t x15;
+  fiat_p1024_uint1 x16;
+  uint64_t x17;
+  fiat_p1024_uint1 x18;
+  uint64_t x19;
+  fiat_p1024_uint1 x20;
+  uint64_t x21;
+  fiat_p1024_uint1 x22;
+  uint64_t x23;
+  fiat_p1024_uint1 x24;
+  uint64_t x25;
+  fiat_p1024_uint1 x26;
+  uint64_t x27;
+  fiat_p1024_uint1 x28;
+  uint64_t x29;
+  fiat_p1024_uint1 x30;
+  uint64_t x31;
+  fiat_p1024_uint1 x32;
+  uint64_t x33;
+  uint64_t x34;
+  fiat_p1024_uint1 x35;
+  uint64_t x36;
+  fiat_p1024_uint1 x37;
+  uint64_t x38;
+  fiat_p1024_uint1 x39;
+  uint64_t x40;
+  fiat_p1024_uint1 x41;
+  uint64_t x42;
+  fiat_p1024_uint1 x43;
+  uint64_t x44;
+  fiat_p1024_uint1 x45;
+  uint64_t x46;
+  fiat_p1024_uint1 x47;
+  uint64_t x48;
+  fiat_p1024_uint1 x49;
+  uint64_t x50;
+  fiat_p1024_uint1 x51;
+  uint64_t x52;
+  fiat_p1024_uint1 x53;
+  uint64_t x54;
+  fiat_p1024_uint1 x55;
+  uint64_t x56;
+  fiat_p1024_uint1 x57;
+  uint64_t x58;
+  fiat_p1024_uint1 x59;
+  uint64_t x60;
+  fiat_p1024_uint1 x61;
+  uint64_t x62;
+  fiat_p1024_uint1 x63;
+  uint64_t x64;
+  fiat_p1024_uint1 x65;
+  fiat_p1024_subborrowx_u64(&x1, &x2, 0x0, 0x0, (arg1[0]));
+  fiat_p1024_subborrowx_u64(&x3, &x4, x2, 0x0, (arg1[1]));
+  fiat_p1024_subborrowx_u64(&x5, &x6, x4, 0x0, (arg1[2]));
+  fiat_p1024_subborrowx_u64(&x7, &x8, x6, 0x0, (arg1[3]));
+  fiat_p1024_subborrowx_u64(&x9, &x10, x8, 0x0, (arg1[4]));
+  fiat_p1024_subborrowx_u64(&x11, &x12, x10, 0x0, (arg1[5]));
+  fiat_p1024_subborrowx_u64(&x13, &x14, x12, 0x0, (arg1[6]));
+  fiat_p1024_subborrowx_u64(&x15, &x16, x14, 0x0, (arg1[7]));
+  fiat_p1024_subborrowx_u64(&x17, &x18, x16, 0x0, (arg1[8]));
+  fiat_p1024_subborrowx_u64(&x19, &x20, x18, 0x0, (arg1[9]));
+  fiat_p1024_subborrowx_u64(&x21, &x22, x20, 0x0, (arg1[10]));
+  fiat_p1024_subborrowx_u64(&x23, &x24, x22, 0x0, (arg1[11]));
+  fiat_p1024_subborrowx_u64(&x25, &x26, x24, 0x0, (arg1[12]));
+  fiat_p1024_subborrowx_u64(&x27, &x28, x26, 0x0, (arg1[13]));
+  fiat_p1024_subborrowx_u64(&x29, &x30, x28, 0x0, (arg1[14]));
+  fiat_p1024_subborrowx_u64(&x31, &x32, x30, 0x0, (arg1[15]));
+  fiat_p1024_cmovznz_u64(&x33, x32, 0x0, UINT64_C(0xffffffffffffffff));
+  fiat_p1024_addcarryx_u64(&x34, &x35, 0x0, x1, (x33 & UINT64_C(0xdbe34c5460e36453)));
+  fiat_p1024_addcarryx_u64(&x36, &x37, x35, x3, (x33 & UINT64_C(0xa1d81eebbc3d344d)));
+  fiat_p1024_addcarryx_u64(&x38, &x39, x37, x5, (x33 & UINT64_C(0x514ba72cb8d89fd3)));
+  fiat_p1024_addcarryx_u64(&x40, &x41, x39, x7, (x33 & UINT64_C(0xc2cab6a0e287f1bd)));
+  fiat_p1024_addcarryx_u64(&x42, &x43, x41, x9, (x33 & UINT64_C(0x642aca4d5a313709)));
+  fiat_p1024_addcarryx_u64(&x44, &x45, x43, x11, (x33 & UINT64_C(0x6b317c5431541f40)));
+  fiat_p1024_addcarryx_u64(&x46, &x47, x45, x13, (x33 & UINT64_C(0xb97c56d1de81ede5)));
+  fiat_p1024_addcarryx_u64(&x48, &x49, x47, x15, (x33 & UINT64_C(0x978dbeed90a2b58)));
+  fiat_p1024_addcarryx_u64(&x50, &x51, x49, x17, (x33 & UINT64_C(0x7611ad4f90441c80)));
+  fiat_p1024_addcarryx_u64(&x52, &x53, x51, x19, (x33 & UINT64_C(0xf811d9c419ec8329)));
+  fiat_p1024_addcarryx_u64(&x54, &x55, x53, x21, (x33 & UINT64_C(0x4d6c594a8ad82d2d)));
+  fiat_p1024_addcarryx_u64(&x56, &x57, x55, x23, (x33 & UINT64_C(0xf06de2471cf9386e)));
+  fiat_p1024_addcarryx_u64(&x58, &x59, x57, x25, (x33 & UINT64_C(0x683cf25db31ad5b)));
+  fiat_p1024_addcarryx_u64(&x60, &x61, x59, x27, (x33 & UINT64_C(0x216c22bc86f21a08)));
+  fiat_p1024_addcarryx_u64(&x62, &x63, x61, x29, (x33 & UINT64_C(0xd89dec879007ebd7)));
+  fiat_p1024_addcarryx_u64(&x64, &x65, x63, x31, (x33 & UINT64_C(0xece55ed427012a9)));
+  out1[0] = x34;
+  out1[1] = x36;
+  out1[2] = x38;
+  out1[3] = x40;
+  out1[4] = x42;
+  out1[5] = x44;
+  out1[6] = x46;
+  out1[7] = x48;
+  out1[8] = x50;
+  out1[9] = x52;
+  out1[10] = x54;
+  out1[11] = x56;
+  out1[12] = x58;
+  out1[13] = x60;
+  out1[14] = x62;
+  out1[15] = x64;
+}
+
+/*
+ * The function fiat_p1024_from_montgomery translates a field element out of the Montgomery domain.
+ *
+ * Preconditions:
+ *   0 ≤ eval arg1 < m
+ * Postconditions:
+ *   eval out1 mod m = (eval arg1 * ((2^64)⁻¹ mod m)^16) mod m
+ *   0 ≤ eval out1 < m
+ *
+ */
+void fiat_p1024_from_montgomery(fiat_p1024_non_montgomery_domain_field_element out1, const fiat_p1024_montgomery_domain_field_element arg1) {
+  uint64_t x1;
+  uint64_t x2;
+  uint64_t x3;
+  uint64_t x4;
+  uint64_t x5;
+  uint64_t x6;
+  uint64_t x7;
+  uint64_t x8;
+  uint64_t x9;
+  uint64_t x10;
+  uint64_t x11;
+  uint64_t x12;
+  uint64_t x13;
+  uint64_t x14;
+  uint64_t x15;
+  uint64_t x16;
+  uint64_t x17;
+  uint64_t x18;
+  uint64_t x19;
+  uint64_t x20;
+  uint64_t x21;
+  uint64_t x22;
+  uint64_t x23;
+  uint64_t x24;
+  uint64_t x25;
+  uint64_t x26;
+  uint64_t x27;
+  uint64_t x28;
+  uint64_t x29;
+  uint64_t x30;
+  uint64_t x31;
+  uint64_t x32;
+  uint64_t x33;
+  uint64_t x34;
+  uint64_t x35;
+  uint64_t x36;
+  fiat_p1024_uint1 x37;
+  uint64_t x38;
+  fiat_p1024_uint1 x39;
+  uint64_t x40;
+  fiat_p1024_uint1 x41;
+  uint64_t x42;
+  fiat_p1024_uint1 x43;
+  uint64_t x44;
+  fiat_p1024_uint1 x45;
+  uint64_t x46;
+  fiat_p1024_uint1 x47;
+  uint64_t x48;
+  fiat_p1024_uint1 x49;
+  uint64_t x50;
+  fiat_p1024_uint1 x51;
+  uint64_t x52;
+  fiat_p1024_uint1 x53;
+  uint64_t x54;
+  fiat_p1024_uint1 x55;
+  uint64_t x56;
+  fiat_p1024_uint1 x57;
+  uint64_t x58;
+  fiat_p1024_uint1 x59;
+  uint64_t x60;
+  fiat_p1024_uint1 x61;
+  uint64_t x62;
+  fiat_p1024_uint1 x63;
+  uint64_t x64;
+  fiat_p1024_uint1 x65;
+  uint64_t x66;
+  fiat_p1024_uint1 x67;
+  uint64_t x68;
+  fiat_p1024_uint1 x69;
+  uint64_t x70;
+  fiat_p1024_uint1 x71;
+  uint64_t x72;
+  fiat_p1024_uint1 x73;
+  uint64_t x74;
+  fiat_p1024_uint1 x75;
+  uint64_t x76;
+  fiat_p1024_uint1 x77;
+  uint64_t x78;
+  fiat_p1024_uint1 x79;
+  uint64_t x80;
+  fiat_p1024_uint1 x81;
+  uint64_t x82;
+  fiat_p1024_uint1 x83;
+  uint64_t x84;
+  fiat_p1024_uint1 x85;
+  uint64_t x86;
+  fiat_p1024_uint1 x87;
+  uint64_t x88;
+  fiat_p1024_uint1 x89;
+  uint64_t x90;
+  fiat_p1024_uint1 x91;
+  uint64_t x92;
+  fiat_p1024_uint1 x93;
+  uint64_t x94;
+  fiat_p1024_uint1 x95;
+  uint64_t x96;
+  fiat_p1024_uint1 x97;
+  uint64_t x98;
+  fiat_p1024_uint1 x99;
+  uint64_t x100;
+  fiat_p1024_uint1 x101;
+  uint64_t x102;
+  fiat_p1024_uint1 x103;
+  uint64_t x104;
+  fiat_p1024_uint1 x105;
+  uint64_t x106;
+  fiat_p1024_uint1 x107;
+  uint64_t x108;
+  fiat_p1024_uint1 x109;
+  uint64_t x110;
+  fiat_p1024_uint1 x111;
+  uint64_t x112;
+  fiat_p1024_uint1 x113;
+  uint64_t x114;
+  fiat_p1024_uint1 x115;
+  uint64_t x116;
+  fiat_p1024_uint1 x117;
+  uint64_t x118;
+  fiat_p1024_uint1 x119;
+  uint64_t x120;
+  fiat_p1024_uint1 x121;
+  uint64_t x122;
+  fiat_p1024_uint1 x123;
+  uint64_t x124;
+  fiat_p1024_uint1 x125;
+  uint64_t x126;
+  fiat_p1024_uint1 x127;
+  uint64_t x128;
+  uint64_t x129;
+  uint64_t x130;
+  uint64_t x131;
+  uint64_t x132;
+  uint64_t x133;
+  uint64_t x134;
+  uint64_t x135;
+  uint64_t x136;
+  uint64_t x137;
+  uint64_t x138;
+  uint64_t x139;
+  uint64_t x140;
+  uint64_t x141;
+  uint64_t x142;
+  uint64_t x143;
+  uint64_t x144;
+  uint64_t x145;
+  uint64_t x146;
+  uint64_t x147;
+  uint64_t x148;
+  uint64_t x149;
+  uint64_t x150;
+  uint64_t x151;
+  uint64_t x152;
+  uint64_t x153;
+  uint64_t x154;
+  uint64_t x155;
+  uint64_t x156;
+  uint64_t x157;
+  uint64_t x158;
+  uint64_t x159;
+  uint64_t x160;
+  uint64_t x161;
+  uint64_t x162;
+  fiat_p1024_uint1 x163;
+  uint64_t x164;
+  fiat_p1024_uint1 x165;
+  uint64_t x166;
+  fiat_p1024_uint1 x167;
+  uint64_t x168;
+  fiat_p1024_uint1 x169;
+  uint64_t x170;
+  fiat_p1024_uint1 x171;
+  uint64_t x172;
+  fiat_p1024_uint1 x173;
+  uint64_t x174;
+  fiat_p1024_uint1 x175;
+  uint64_t x176;
+  fiat_p1024_uint1 x177;
+  uint64_t x178;
+  fiat_p1024_uint1 x179;
+  uint64_t x180;
+  fiat_p1024_uint1 x181;
+  uint64_t x182;
+  fiat_p1024_uint1 x183;
+  uint64_t x184;
+  fiat_p1024_uint1 x185;
+  uint64_t x186;
+  fiat_p1024_uint1 x187;
+  uint64_t x188;
+  fiat_p1024_uint1 x189;
+  uint64_t x190;
+  fiat_p1024_uint1 x191;
+  uint64_t x192;
+  fiat_p1024_uint1 x193;
+  uint64_t x194;
+  fiat_p1024_uint1 x195;
+  uint64_t x196;
+  fiat_p1024_uint1 x197;
+  uint64_t x198;
+  fiat_p1024_uint1 x199;
+  uint64_t x200;
+  fiat_p1024_uint1 x201;
+  uint64_t x202;
+  fiat_p1024_uint1 x203;
+  uint64_t x204;
+  fiat_p1024_uint1 x205;
+  uint64_t x206;
+  fiat_p1024_uint1 x207;
+  uint64_t x208;
+  fiat_p1024_uint1 x209;
+  uint64_t x210;
+  fiat_p1024_uint1 x211;
+  uint64_t x212;
+  fiat_p1024_uint1 x213;
+  uint64_t x214;
+  fiat_p1024_uint1 x215;
+  uint64_t x216;
+  fiat_p1024_uint1 x217;
+  uint64_t x218;
+  fiat_p1024_uint1 x219;
+  uint64_t x220;
+  fiat_p1024_uint1 x221;
+  uint64_t x222;
+  fiat_p1024_uint1 x223;
+  uint64_t x224;
+  fiat_p1024_uint1 x225;
+  uint64_t x226;
+  fiat_p1024_uint1 x227;
+  uint64_t x228;
+  fiat_p1024_uint1 x229;
+  uint64_t x230;
+  fiat_p1024_uint1 x231;
+  uint64_t x232;
+  fiat_p1024_uint1 x233;
+  uint64_t x234;
+  fiat_p1024_uint1 x235;
+  uint64_t x236;
+  fiat_p1024_uint1 x237;
+  uint64_t x238;
+  fiat_p1024_uint1 x239;
+  uint64_t x240;
+  fiat_p1024_uint1 x241;
+  uint64_t x242;
+  fiat_p1024_uint1 x243;
+  uint64_t x244;
+  fiat_p1024_uint1 x245;
+  uint64_t x246;
+  fiat_p1024_uint1 x247;
+  uint64_t x248;
+  fiat_p1024_uint1 x249;
+  uint64_t x250;
+  fiat_p1024_uint1 x251;
+  uint64_t x252;
+  fiat_p1024_uint1 x253;
+  uint64_t x254;
+  uint64_t x255;
+  uint64_t x256;
+  uint64_t x257;
+  uint64_t x258;
+  uint64_t x259;
+  uint64_t x260;
+  uint64_t x261;
+  uint64_t x262;
+  uint64_t x263;
+  uint64_t x264;
+  uint64_t x265;
+  uint64_t x266;
+  uint64_t x267;
+  uint64_t x268;
+  uint64_t x269;
+  uint64_t x270;
+  uint64_t x271;
+  uint64_t x272;
+  uint64_t x273;
+  uint64_t x274;
+  uint64_t x275;
+  uint64_t x276;
+  uint64_t x277;
+  uint64_t x278;
+  uint64_t x279;
+  uint64_t x280;
+  uint64_t x281;
+  uint64_t x282;
+  uint64_t x283;
+  uint64_t x284;
+  uint64_t x285;
+  uint64_t x286;
+  uint64_t x287;
+  uint64_t x288;
+  fiat_p1024_uint1 x289;
+  uint64_t x290;
+  fiat_p1024_uint1 x291;
+  uint64_t x292;
+  fiat_p1024_uint1 x293;
+  uint64_t x294;
+  fiat_p1024_uint1 x295;
+  uint64_t x296;
+  fiat_p1024_uint1 x297;
+  uint64_t x298;
+  fiat_p1024_uint1 x299;
+  uint64_t x300;
+  fiat_p1024_uint1 x301;
+  uint64_t x302;
+  fiat_p1024_uint1 x303;
+  uint64_t x304;
+  fiat_p1024_uint1 x305;
+  uint64_t x306;
+  fiat_p1024_uint1 x307;
+  uint64_t x308;
+  fiat_p1024_uint1 x309;
+  uint64_t x310;
+  fiat_p1024_uint1 x311;
+  uint64_t x312;
+  fiat_p1024_uint1 x313;
+  uint64_t x314;
+  fiat_p1024_uint1 x315;
+  uint64_t x316;
+  fiat_p1024_uint1 x317;
+  uint64_t x318;
+  fiat_p1024_uint1 x319;
+  uint64_t x320;
+  fiat_p1024_uint1 x321;
+  uint64_t x322;
+  fiat_p1024_uint1 x323;
+  uint64_t x324;
+  fiat_p1024_uint1 x325;
+  uint64_t x326;
+  fiat_p1024_uint1 x327;
+  uint64_t x328;
+  fiat_p1024_uint1 x329;
+  uint64_t x330;
+  fiat_p1024_uint1 x331;
+  uint64_t x332;
+  fiat_p1024_uint1 x333;
+  uint64_t x334;
+  fiat_p1024_uint1 x335;
+  uint64_t x336;
+  fiat_p1024_uint1 x337;
+  uint64_t x338;
+  fiat_p1024_uint1 x339;
+  uint64_t x340;
+  fiat_p1024_uint1 x341;
+  uint64_t x342;
+  fiat_p1024_uint1 x343;
+  uint64_t x344;
+  fiat_p1024_uint1 x345;
+  uint64_t x346;
+  fiat_p1024_uint1 x347;
+  uint64_t x348;
+  fiat_p1024_uint1 x349;
+  uint64_t x350;
+  fiat_p1024_uint1 x351;
+  uint64_t x352;
+  fiat_p1024_uint1 x353;
+  uint64_t x354;
+  fiat_p1024_uint1 x355;
+  uint64_t x356;
+  fiat_p1024_uint1 x357;
+  uint64_t x358;
+  fiat_p1024_uint1 x359;
+  uint64_t x360;
+  fiat_p1024_uint1 x361;
+  uint64_t x362;
+  fiat_p1024_uint1 x363;
+  uint64_t x364;
+  fiat_p1024_uint1 x365;
+  uint64_t x366;
+  fiat_p1024_uint1 x367;
+  uint64_t x368;
+  fiat_p1024_uint1 x369;
+  uint64_t x370;
+  fiat_p1024_uint1 x371;
+  uint64_t x372;
+  fiat_p1024_uint1 x373;
+  uint64_t x374;
+  fiat_p1024_uint1 x375;
+  uint64_t x376;
+  fiat_p1024_uint1 x377;
+  uint64_t x378;
+  fiat_p1024_uint1 x379;
+  uint64_t x380;
+  uint64_t x381;
+  uint64_t x382;
+  uint64_t x383;
+  uint64_t x384;
+  uint64_t x385;
+  uint64_t x386;
+  uint64_t x387;
+  uint64_t x388;
+  uint64_t x389;
+  uint64_t x390;
+  uint64_t x391;
+  uint64_t x392;
+  uint64_t x393;
+  uint64_t x394;
+  uint64_t x395;
+  uint64_t x396;
+  uint64_t x397;
+  uint64_t x398;
+  uint64_t x399;
+  uint64_t x400;
+  uint64_t x401;
+  uint64_t x402;
+  uint64_t x403;
+  uint64_t x404;
+  uint64_t x405;
+  uint64_t x406;
+  uint64_t x407;
+  uint64_t x408;
+  uint64_t x409;
+  uint64_t x410;
+  uint64_t x411;
+  uint64_t x412;
+  uint64_t x413;
+  uint64_t x414;
+  fiat_p1024_uint1 x415;
+  uint64_t x416;
+  fiat_p1024_uint1 x417;
+  uint64_t x418;
+  fiat_p1024_uint1 x419;
+  uint64_t x420;
+  fiat_p1024_uint1 x421;
+  uint64_t x422;
+  fiat_p1024_uint1 x423;
+  uint64_t x424;
+  fiat_p1024_uint1 x425;
+  uint64_t x426;
+  fiat_p1024_uint1 x427;
+  uint64_t x428;
+  fiat_p1024_uint1 x429;
+  uint64_t x430;
+  fiat_p1024_uint1 x431;
+  uint64_t x432;
+  fiat_p1024_uint1 x433;
+  uint64_t x434;
+  fiat_p1024_uint1 x435;
+  uint64_t x436;
+  fiat_p1024_uint1 x437;
+  uint64_t x438;
+  fiat_p1024_uint1 x439;
+  uint64_t x440;
+  fiat_p1024_uint1 x441;
+  uint64_t x442;
+  fiat_p1024_uint1 x443;
+  uint64_t x444;
+  fiat_p1024_uint1 x445;
+  uint64_t x446;
+  fiat_p1024_uint1 x447;
+  uint64_t x448;
+  fiat_p1024_uint1 x449;
+  uint64_t x450;
+  fiat_p1024_uint1 x451;
+  uint64_t x452;
+  fiat_p1024_uint1 x453;
+  uint64_t x454;
+  fiat_p1024_uint1 x455;
+  uint64_t x456;
+  fiat_p1024_uint1 x457;
+  uint64_t x458;
+  fiat_p1024_uint1 x459;
+  uint64_t x460;
+  fiat_p1024_uint1 x461;
+  uint64_t x462;
+  fiat_p1024_uint1 x463;
+  uint64_t x464;
+  fiat_p1024_uint1 x465;
+  uint64_t x466;
+  fiat_p1024_uint1 x467;
+  uint64_t x468;
+  fiat_p1024_uint1 x469;
+  uint64_t x470;
+  fiat_p1024_uint1 x471;
+  uint64_t x472;
+  fiat_p1024_uint1 x473;
+  uint64_t x474;
+  fiat_p1024_uint1 x475;
+  uint64_t x476;
+  fiat_p1024_uint1 x477;
+  uint64_t x478;
+  fiat_p1024_uint1 x479;
+  uint64_t x480;
+  fiat_p1024_uint1 x481;
+  uint64_t x482;
+  fiat_p1024_uint1 x483;
+  uint64_t x484;
+  fiat_p1024_uint1 x485;
+  uint64_t x486;
+  fiat_p1024_uint1 x487;
+  uint64_t x488;
+  fiat_p1024_uint1 x489;
+  uint64_t x490;
+  fiat_p1024_uint1 x491;
+  uint64_t x492;
+  fiat_p1024_uint1 x493;
+  uint64_t x494;
+  fiat_p1024_uint1 x495;
+  uint64_t x496;
+  fiat_p1024_uint1 x497;
+  uint64_t x498;
+  fiat_p1024_uint1 x499;
+  uint64_t x500;
+  fiat_p1024_uint1 x501;
+  uint64_t x502;
+  fiat_p1024_uint1 x503;
+  uint64_t x504;
+  fiat_p1024_uint1 x505;
+  uint64_t x506;
+  uint64_t x507;
+  uint64_t x508;
+  uint64_t x509;
+  uint64_t x510;
+  uint64_t x511;
+  uint64_t x512;
+  uint64_t x513;
+  uint64_t x514;
+  uint64_t x515;
+  uint64_t x516;
+  uint64_t x517;
+  uint64_t x518;
+  uint64_t x519;
+  uint64_t x520;
+  uint64_t x521;
+  uint64_t x522;
+  uint64_t x523;
+  uint64_t x524;
+  uint64_t x525;
+  uint64_t x526;
+  uint64_t x527;
+  uint64_t x528;
+  uint64_t x529;
+  uint64_t x530;
+  uint64_t x531;
+  uint64_t x532;
+  uint64_t x533;
+  uint64_t x534;
+  uint64_t x535;
+  uint64_t x536;
+  uint64_t x537;
+  uint64_t x538;
+  uint64_t x539;
+  uint64_t x540;
+  fiat_p1024_uint1 x541;
+  uint64_t x542;
+  fiat_p1024_uint1 x543;
+  uint64_t x544;
+  fiat_p1024_uint1 x545;
+  uint64_t x546;
+  fiat_p1024_uint1 x547;
+  uint64_t x548;
+  fiat_p1024_uint1 x549;
+  uint64_t x550;
+  fiat_p1024_uint1 x551;
+  uint64_t x552;
+  fiat_p1024_uint1 x553;
+  uint64_t x554;
+  fiat_p1024_uint1 x555;
+  uint64_t x556;
+  fiat_p1024_uint1 x557;
+  uint64_t x558;
+  fiat_p1024_uint1 x559;
+  uint64_t x560;
+  fiat_p1024_uint1 x561;
+  uint64_t x562;
+  fiat_p1024_uint1 x563;
+  uint64_t x564;
+  fiat_p1024_uint1 x565;
+  uint64_t x566;
+  fiat_p1024_uint1 x567;
+  uint64_t x568;
+  fiat_p1024_uint1 x569;
+  uint64_t x570;
+  fiat_p1024_uint1 x571;
+  uint64_t x572;
+  fiat_p1024_uint1 x573;
+  uint64_t x574;
+  fiat_p1024_uint1 x575;
+  uint64_t x576;
+  fiat_p1024_uint1 x577;
+  uint64_t x578;
+  fiat_p1024_uint1 x579;
+  uint64_t x580;
+  fiat_p1024_uint1 x581;
+  uint64_t x582;
+  fiat_p1024_uint1 x583;
+  uint64_t x584;
+  fiat_p1024_uint1 x585;
+  uint64_t x586;
+  fiat_p1024_uint1 x587;
+  uint64_t x588;
+  fiat_p1024_uint1 x589;
+  uint64_t x590;
+  fiat_p1024_uint1 x591;
+  uint64_t x592;
+  fiat_p1024_uint1 x593;
+  uint64_t x594;
+  fiat_p1024_uint1 x595;
+  uint64_t x596;
+  fiat_p1024_uint1 x597;
+  uint64_t x598;
+  fiat_p1024_uint1 x599;
+  uint64_t x600;
+  fiat_p1024_uint1 x601;
+  uint64_t x602;
+  fiat_p1024_uint1 x603;
+  uint64_t x604;
+  fiat_p1024_uint1 x605;
+  uint64_t x606;
+  fiat_p1024_uint1 x607;
+  uint64_t x608;
+  fiat_p1024_uint1 x609;
+  uint64_t x610;
+  fiat_p1024_uint1 x611;
+  uint64_t x612;
+  fiat_p1024_uint1 x613;
+  uint64_t x614;
+  fiat_p1024_uint1 x615;
+  uint64_t x616;
+  fiat_p1024_uint1 x617;
+  uint64_t x618;
+  fiat_p1024_uint1 x619;
+  uint64_t x620;
+  fiat_p1024_uint1 x621;
+  uint64_t x622;
+  fiat_p1024_uint1 x623;
+  uint64_t x624;
+  fiat_p1024_uint1 x625;
+  uint64_t x626;
+  fiat_p1024_uint1 x627;
+  uint64_t x628;
+  fiat_p1024_uint1 x629;
+  uint64_t x630;
+  fiat_p1024_uint1 x631;
+  uint64_t x632;
+  uint64_t x633;
+  uint64_t x634;
+  uint64_t x635;
+  uint64_t x636;
+  uint64_t x637;
+  uint64_t x638;
+  uint64_t x639;
+  uint64_t x640;
+  uint64_t x641;
+  uint64_t x642;
+  uint64_t x643;
+  uint64_t x644;
+  uint64_t x645;
+  uint64_t x646;
+  uint64_t x647;
+  uint64_t x648;
+  uint64_t x649;
+  uint64_t x650;
+  uint64_t x651;
+  uint64_t x652;
+  uint64_t x653;
+  uint64_t x654;
+  uint64_t x655;
+  uint64_t x656;
+  uint64_t x657;
+  uint64_t x658;
+  uint64_t x659;
+  uint64_t x660;
+  uint64_t x661;
+  uint64_t x662;
+  uint64_t x663;
+  uint64_t x664;
+  uint64_t x665;
+  uint64_t x666;
+  fiat_p1024_uint1 x667;
+  uint64_t x668;
+  fiat_p1024_uint1 x669;
+  uint64_t x670;
+  fiat_p1024_uint1 x671;
+  uint64_t x672;
+  fiat_p1024_uint1 x673;
+  uint64_t x674;
+  fiat_p1024_uint1 x675;
+  uint64_t x676;
+  fiat_p1024_uint1 x677;
+  uint64_t x678;
+  fiat_p1024_uint1 x679;
+  uint64_t x680;
+  fiat_p1024_uint1 x681;
+  uint64_t x682;
+  fiat_p1024_uint1 x683;
+  uint64_t x684;
+  fiat_p1024_uint1 x685;
+  uint64_t x686;
+  fiat_p1024_uint1 x687;
+  uint64_t x688;
+  fiat_p1024_uint1 x689;
+  uint64_t x690;
+  fiat_p1024_uint1 x691;
+  uint64_t x692;
+  fiat_p1024_uint1 x693;
+  uint64_t x694;
+  fiat_p1024_uint1 x695;
+  uint64_t x696;
+  fiat_p1024_uint1 x697;
+  uint64_t x698;
+  fiat_p1024_uint1 x699;
+  uint64_t x700;
+  fiat_p1024_uint1 x701;
+  uint64_t x702;
+  fiat_p1024_uint1 x703;
+  uint64_t x704;
+  fiat_p1024_uint1 x705;
+  uint64_t x706;
+  fiat_p1024_uint1 x707;
+  uint64_t x708;
+  fiat_p1024_uint1 x709;
+  uint64_t x710;
+  fiat_p1024_uint1 x711;
+  uint64_t x712;
+  fiat_p1024_uint1 x713;
+  uint64_t x714;
+  fiat_p1024_uint1 x715;
+  uint64_t x716;
+  fiat_p1024_uint1 x717;
+  uint64_t x718;
+  fiat_p1024_uint1 x719;
+  uint64_t x720;
+  fiat_p1024_uint1 x721;
+  uint64_t x722;
+  fiat_p1024_uint1 x723;
+  uint64_t x724;
+  fiat_p1024_uint1 x725;
+  uint64_t x726;
+  fiat_p1024_uint1 x727;
+  uint64_t x728;
+  fiat_p1024_uint1 x729;
+  uint64_t x730;
+  fiat_p1024_uint1 x731;
+  uint64_t x732;
+  fiat_p1024_uint1 x733;
+  uint64_t x734;
+  fiat_p1024_uint1 x735;
+  uint64_t x736;
+  fiat_p1024_uint1 x737;
+  uint64_t x738;
+  fiat_p1024_uint1 x739;
+  uint64_t x740;
+  fiat_p1024_uint1 x741;
+  uint64_t x742;
+  fiat_p1024_uint1 x743;
+  uint64_t x744;
+  fiat_p1024_uint1 x745;
+  uint64_t x746;
+  fiat_p1024_uint1 x747;
+  uint64_t x748;
+  fiat_p1024_uint1 x749;
+  uint64_t x750;
+  fiat_p1024_uint1 x751;
+  uint64_t x752;
+  fiat_p1024_uint1 x753;
+  uint64_t x754;
+  fiat_p1024_uint1 x755;
+  uint64_t x756;
+  fiat_p1024_uint1 x757;
+  uint64_t x758;
+  uint64_t x759;
+  uint64_t x760;
+  uint64_t x761;
+  uint64_t x762;
+  uint64_t x763;
+  uint64_t x764;
+  uint64_t x765;
+  uint64_t x766;
+  uint64_t x767;
+  uint64_t x768;
+  uint64_t x769;
+  uint64_t x770;
+  uint64_t x771;
+  uint64_t x772;
+  uint64_t x773;
+  uint64_t x774;
+  uint64_t x775;
+  uint64_t x776;
+  uint64_t x777;
+  uint64_t x778;
+  uint64_t x779;
+  uint64_t x780;
+  uint64_t x781;
+  uint64_t x782;
+  uint64_t x783;
+  uint64_t x784;
+  uint64_t x785;
+  uint64_t x786;
+  uint64_t x787;
+  uint64_t x788;
+  uint64_t x789;
+  uint64_t x790;
+  uint64_t x791;
+  uint64_t x792;
+  fiat_p1024_uint1 x793;
+  uint64_t x794;
+  fiat_p1024_uint1 x795;
+  uint64_t x796;
+  fiat_p1024_uint1 x797;
+  uint64_t x798;
+  fiat_p1024_uint1 x799;
+  uint64_t x800;
+  fiat_p1024_uint1 x801;
+  uint64_t x802;
+  fiat_p1024_uint1 x803;
+  uint64_t x804;
+  fiat_p1024_uint1 x805;
+  uint64_t x806;
+  fiat_p1024_uint1 x807;
+  uint64_t x808;
+  fiat_p1024_uint1 x809;
+  uint64_t x810;
+  fiat_p1024_uint1 x811;
+  uint64_t x812;
+  fiat_p1024_uint1 x813;
+  uint64_t x814;
+  fiat_p1024_uint1 x815;
+  uint64_t x816;
+  fiat_p1024_uint1 x817;
+  uint64_t x818;
+  fiat_p1024_uint1 x819;
+  uint64_t x820;
+  fiat_p1024_uint1 x821;
+  uint64_t x822;
+  fiat_p1024_uint1 x823;
+  uint64_t x824;
+  fiat_p1024_uint1 x825;
+  uint64_t x826;
+  fiat_p1024_uint1 x827;
+  uint64_t x828;
+  fiat_p1024_uint1 x829;
+  uint64_t x830;
+  fiat_p1024_uint1 x831;
+  uint64_t x832;
+  fiat_p1024_uint1 x833;
+  uint64_t x834;
+  fiat_p1024_uint1 x835;
+  uint64_t x836;
+  fiat_p1024_uint1 x837;
+  uint64_t x838;
+  fiat_p1024_uint1 x839;
+  uint64_t x840;
+  fiat_p1024_uint1 x841;
+  uint64_t x842;
+  fiat_p1024_uint1 x843;
+  uint64_t x844;
+  fiat_p1024_uint1 x845;
+  uint64_t x846;
+  fiat_p1024_uint1 x847;
+  uint64_t x848;
+  fiat_p1024_uint1 x849;
+  uint64_t x850;
+  fiat_p1024_uint1 x851;
+  uint64_t x852;
+  fiat_p1024_uint1 x853;
+  uint64_t x854;
+  fiat_p1024_uint1 x855;
+  uint64_t x856;
+  fiat_p1024_uint1 x857;
+  uint64_t x858;
+  fiat_p1024_uint1 x859;
+  uint64_t x860;
+  fiat_p1024_uint1 x861;
+  uint64_t x862;
+  fiat_p1024_uint1 x863;
+  uint64_t x864;
+  fiat_p1024_uint1 x865;
+  uint64_t x866;
+  fiat_p1024_uint1 x867;
+  uint64_t x868;
+  fiat_p1024_uint1 x869;
+  uint64_t x870;
+  fiat_p1024_uint1 x871;
+  uint64_t x872;
+  fiat_p1024_uint1 x873;
+  uint64_t x874;
+  fiat_p1024_uint1 x875;
+  uint64_t x876;
+  fiat_p1024_uint1 x877;
+  uint64_t x878;
+  fiat_p1024_uint1 x879;
+  uint64_t x880;
+  fiat_p1024_uint1 x881;
+  uint64_t x882;
+  fiat_p1024_uint1 x883;
+  uint64_t x884;
+  uint64_t x885;
+  uint64_t x886;
+  uint64_t x887;
+  uint64_t x888;
+  uint64_t x889;
+  uint64_t x890;
+  uint64_t x891;
+  uint64_t x892;
+  uint64_t x893;
+  uint64_t x894;
+  uint64_t x895;
+  uint64_t x896;
+  uint64_t x897;
+  uint64_t x898;
+  uint64_t x899;
+  uint64_t x900;
+  uint64_t x901;
+  uint64_t x902;
+  uint64_t x903;
+  uint64_t x904;
+  uint64_t x905;
+  uint64_t x906;
+  uint64_t x907;
+  uint64_t x908;
+  uint64_t x909;
+  uint64_t x910;
+  uint64_t x911;
+  uint64_t x912;
+  uint64_t x913;
+  uint64_t x914;
+  uint64_t x915;
+  uint64_t x916;
+  uint64_t x917;
+  uint64_t x918;
+  fiat_p1024_uint1 x919;
+  uint64_t x920;
+  fiat_p1024_uint1 x921;
+  uint64_t x922;
+  fiat_p1024_uint1 x923;
+  uint64_t x924;
+  fiat_p1024_uint1 x925;
+  uint64_t x926;
+  fiat_p1024_uint1 x927;
+  uint64_t x928;
+  fiat_p1024_uint1 x929;
+  uint64_t x930;
+  fiat_p1024_uint1 x931;
+  uint64_t x932;
+  fiat_p1024_uint1 x933;
+  uint64_t x934;
+  fiat_p1024_uint1 x935;
+  uint64_t x936;
+  fiat_p1024_uint1 x937;
+  uint64_t x938;
+  fiat_p1024_uint1 x939;
+  uint64_t x940;
+  fiat_p1024_uint1 x941;
+  uint64_t x942;
+  fiat_p1024_uint1 x943;
+  uint64_t x944;
+  fiat_p1024_uint1 x945;
+  uint64_t x946;
+  fiat_p1024_uint1 x947;
+  uint64_t x948;
+  fiat_p1024_uint1 x949;
+  uint64_t x950;
+  fiat_p1024_uint1 x951;
+  uint64_t x952;
+  fiat_p1024_uint1 x953;
+  uint64_t x954;
+  fiat_p1024_uint1 x955;
+  uint64_t x956;
+  fiat_p1024_uint1 x957;
+  uint64_t x958;
+  fiat_p1024_uint1 x959;
+  uint64_t x960;
+  fiat_p1024_uint1 x961;
+  uint64_t x962;
+  fiat_p1024_uint1 x963;
+  uint64_t x964;
+  fiat_p1024_uint1 x965;
+  uint64_t x966;
+  fiat_p1024_uint1 x967;
+  uint64_t x968;
+  fiat_p1024_uint1 x969;
+  uint64_t x970;
+  fiat_p1024_uint1 x971;
+  uint64_t x972;
+  fiat_p1024_uint1 x973;
+  uint64_t x974;
+  fiat_p1024_uint1 x975;
+  uint64_t x976;
+  fiat_p1024_uint1 x977;
+  uint64_t x978;
+  fiat_p1024_uint1 x979;
+  uint64_t x980;
+  fiat_p1024_uint1 x981;
+  uint64_t x982;
+  fiat_p1024_uint1 x983;
+  uint64_t x984;
+  fiat_p1024_uint1 x985;
+  uint64_t x986;
+  fiat_p1024_uint1 x987;
+  uint64_t x988;
+  fiat_p1024_uint1 x989;
+  uint64_t x990;
+  fiat_p1024_uint1 x991;
+  uint64_t x992;
+  fiat_p1024_uint1 x993;
+  uint64_t x994;
+  fiat_p1024_uint1 x995;
+  uint64_t x996;
+  fiat_p1024_uint1 x997;
+  uint64_t x998;
+  fiat_p1024_uint1 x999;
+  uint64_t x1000;
+  fiat_p1024_uint1 x1001;
+  uint64_t x1002;
+  fiat_p1024_uint1 x1003;
+  uint64_t x1004;
+  fiat_p1024_uint1 x1005;
+  uint64_t x1006;
+  fiat_p1024_uint1 x1007;
+  uint64_t x1008;
+  fiat_p1024_uint1 x1009;
+  uint64_t x1010;
+  uint64_t x1011;
+  uint64_t x1012;
+  uint64_t x1013;
+  uint64_t x1014;
+  uint64_t x1015;
+  uint64_t x1016;
+  uint64_t x1017;
+  uint64_t x1018;
+  uint64_t x1019;
+  uint64_t x1020;
+  uint64_t x1021;
+  uint64_t x1022;
+  uint64_t x1023;
+  uint64_t x1024;
+  uint64_t x1025;
+  uint64_t x1026;
+  uint64_t x1027;
+  uint64_t x1028;
+  uint64_t x1029;
+  uint64_t x1030;
+  uint64_t x1031;
+  uint64_t x1032;
+  uint64_t x1033;
+  uint64_t x1034;
+  uint64_t x1035;
+  uint64_t x1036;
+  uint64_t x1037;
+  uint64_t x1038;
+  uint64_t x1039;
+  uint64_t x1040;
+  uint64_t x1041;
+  uint64_t x1042;
+  uint64_t x1043;
+  uint64_t x1044;
+  fiat_p1024_uint1 x1045;
+  uint64_t x1046;
+  fiat_p1024_uint1 x1047;
+  uint64_t x1048;
+  fiat_p1024_uint1 x1049;
+  uint64_t x1050;
+  fiat_p1024_uint1 x1051;
+  uint64_t x1052;
+  fiat_p1024_uint1 x1053;
+  uint64_t x1054;
+  fiat_p1024_uint1 x1055;
+  uint64_t x1056;
+  fiat_p1024_uint1 x1057;
+  uint64_t x1058;
+  fiat_p1024_uint1 x1059;
+  uint64_t x1060;
+  fiat_p1024_uint1 x1061;
+  uint64_t x1062;
+  fiat_p1024_uint1 x1063;
+  uint64_t x1064;
+  fiat_p1024_uint1 x1065;
+  uint64_t x1066;
+  fiat_p1024_uint1 x1067;
+  uint64_t x1068;
+  fiat_p1024_uint1 x1069;
+  uint64_t x1070;
+  fiat_p1024_uint1 x1071;
+  uint64_t x1072;
+  fiat_p1024_uint1 x1073;
+  uint64_t x1074;
+  fiat_p1024_uint1 x1075;
+  uint64_t x1076;
+  fiat_p1024_uint1 x1077;
+  uint64_t x1078;
+  fiat_p1024_uint1 x1079;
+  uint64_t x1080;
+  fiat_p1024_uint1 x1081;
+  uint64_t x1082;
+  fiat_p1024_uint1 x1083;
+  uint64_t x1084;
+  fiat_p1024_uint1 x1085;
+  uint64_t x1086;
+  fiat_p1024_uint1 x1087;
+  uint64_t x1088;
+  fiat_p1024_uint1 x1089;
+  uint64_t x1090;
+  fiat_p1024_uint1 x1091;
+  uint64_t x1092;
+  fiat_p1024_uint1 x1093;
+  uint64_t x1094;
+  fiat_p1024_uint1 x1095;
+  uint64_t x1096;
+  fiat_p1024_uint1 x1097;
+  uint64_t x1098;
+  fiat_p1024_uint1 x1099;
+  uint64_t x1100;
+  fiat_p1024_uint1 x1101;
+  uint64_t x1102;
+  fiat_p1024_uint1 x1103;
+  uint64_t x1104;
+  fiat_p1024_uint1 x1105;
+  uint64_t x1106;
+  fiat_p1024_uint1 x1107;
+  uint64_t x1108;
+  fiat_p1024_uint1 x1109;
+  uint64_t x1110;
+  fiat_p1024_uint1 x1111;
+  uint64_t x1112;
+  fiat_p1024_uint1 x1113;
+  uint64_t x1114;
+  fiat_p1024_uint1 x1115;
+  uint64_t x1116;
+  fiat_p1024_uint1 x1117;
+  uint64_t x1118;
+  fiat_p1024_uint1 x1119;
+  uint64_t x1120;
+  fiat_p1024_uint1 x1121;
+  uint64_t x1122;
+  fiat_p1024_uint1 x1123;
+  uint64_t x1124;
+  fiat_p1024_uint1 x1125;
+  uint64_t x1126;
+  fiat_p1024_uint1 x1127;
+  uint64_t x1128;
+  fiat_p1024_uint1 x1129;
+  uint64_t x1130;
+  fiat_p1024_uint1 x1131;
+  uint64_t x1132;
+  fiat_p1024_uint1 x1133;
+  uint64_t x1134;
+  fiat_p1024_uint1 x1135;
+  uint64_t x1136;
+  uint64_t x1137;
+  uint64_t x1138;
+  uint64_t x1139;
+  uint64_t x1140;
+  uint64_t x1141;
+  uint64_t x1142;
+  uint64_t x1143;
+  uint64_t x1144;
+  uint64_t x1145;
+  uint64_t x1146;
+  uint64_t x1147;
+  uint64_t x1148;
+  uint64_t x1149;
+  uint64_t x1150;
+  uint64_t x1151;
+  uint64_t x1152;
+  uint64_t x1153;
+  uint64_t x1154;
+  uint64_t x1155;
+  uint64_t x1156;
+  uint64_t x1157;
+  uint64_t x1158;
+  uint64_t x1159;
+  uint64_t x1160;
+  uint64_t x1161;
+  uint64_t x1162;
+  uint64_t x1163;
+  uint64_t x1164;
+  uint64_t x1165;
+  uint64_t x1166;
+  uint64_t x1167;
+  uint64_t x1168;
+  uint64_t x1169;
+  uint64_t x1170;
+  fiat_p1024_uint1 x1171;
+  uint64_t x1172;
+  fiat_p1024_uint1 x1173;
+  uint64_t x1174;
+  fiat_p1024_uint1 x1175;
+  uint64_t x1176;
+  fiat_p1024_uint1 x1177;
+  uint64_t x1178;
+  fiat_p1024_uint1 x1179;
+  uint64_t x1180;
+  fiat_p1024_uint1 x1181;
+  uint64_t x1182;
+  fiat_p1024_uint1 x1183;
+  uint64_t x1184;
+  fiat_p1024_uint1 x1185;
+  uint64_t x1186;
+  fiat_p1024_uint1 x1187;
+  uint64_t x1188;
+  fiat_p1024_uint1 x1189;
+  uint64_t x1190;
+  fiat_p1024_uint1 x1191;
+  uint64_t x1192;
+  fiat_p1024_uint1 x1193;
+  uint64_t x1194;
+  fiat_p1024_uint1 x1195;
+  uint64_t x1196;
+  fiat_p1024_uint1 x1197;
+  uint64_t x1198;
+  fiat_p1024_uint1 x1199;
+  uint64_t x1200;
+  fiat_p1024_uint1 x1201;
+  uint64_t x1202;
+  fiat_p1024_uint1 x1203;
+  uint64_t x1204;
+  fiat_p1024_uint1 x1205;
+  uint64_t x1206;
+  fiat_p1024_uint1 x1207;
+  uint64_t x1208;
+  fiat_p1024_uint1 x1209;
+  uint64_t x1210;
+  fiat_p1024_uint1 x1211;
+  uint64_t x1212;
+  fiat_p1024_uint1 x1213;
+  uint64_t x1214;
+  fiat_p1024_uint1 x1215;
+  uint64_t x1216;
+  fiat_p1024_uint1 x1217;
+  uint64_t x1218;
+  fiat_p1024_uint1 x1219;
+  uint64_t x1220;
+  fiat_p1024_uint1 x1221;
+  uint64_t x1222;
+  fiat_p1024_uint1 x1223;
+  uint64_t x1224;
+  fiat_p1024_uint1 x1225;
+  uint64_t x1226;
+  fiat_p1024_uint1 x1227;
+  uint64_t x1228;
+  fiat_p1024_uint1 x1229;
+  uint64_t x1230;
+  fiat_p1024_uint1 x1231;
+  uint64_t x1232;
+  fiat_p1024_uint1 x1233;
+  uint64_t x1234;
+  fiat_p1024_uint1 x1235;
+  uint64_t x1236;
+  fiat_p1024_uint1 x1237;
+  uint64_t x1238;
+  fiat_p1024_uint1 x1239;
+  uint64_t x1240;
+  fiat_p1024_uint1 x1241;
+  uint64_t x1242;
+  fiat_p1024_uint1 x1243;
+  uint64_t x1244;
+  fiat_p1024_uint1 x1245;
+  uint64_t x1246;
+  fiat_p1024_uint1 x1247;
+  uint64_t x1248;
+  fiat_p1024_uint1 x1249;
+  uint64_t x1250;
+  fiat_p1024_uint1 x1251;
+  uint64_t x1252;
+  fiat_p1024_uint1 x1253;
+  uint64_t x1254;
+  fiat_p1024_uint1 x1255;
+  uint64_t x1256;
+  fiat_p1024_uint1 x1257;
+  uint64_t x1258;
+  fiat_p1024_uint1 x1259;
+  uint64_t x1260;
+  fiat_p1024_uint1 x1261;
+  uint64_t x1262;
+  uint64_t x1263;
+  uint64_t x1264;
+  uint64_t x1265;
+  uint64_t x1266;
+  uint64_t x1267;
+  uint64_t x1268;
+  uint64_t x1269;
+  uint64_t x1270;
+  uint64_t x1271;
+  uint64_t x1272;
+  uint64_t x1273;
+  uint64_t x1274;
+  uint64_t x1275;
+  uint64_t x1276;
+  uint64_t x1277;
+  uint64_t x1278;
+  uint64_t x1279;
+  uint64_t x1280;
+  uint64_t x1281;
+  uint64_t x1282;
+  uint64_t x1283;
+  uint64_t x1284;
+  uint64_t x1285;
+  uint64_t x1286;
+  uint64_t x1287;
+  uint64_t x1288;
+  uint64_t x1289;
+  uint64_t x1290;
+  uint64_t x1291;
+  uint64_t x1292;
+  uint64_t x1293;
+  uint64_t x1294;
+  uint64_t x1295;
+  uint64_t x1296;
+  fiat_p1024_uint1 x1297;
+  uint64_t x1298;
+  fiat_p1024_uint1 x1299;
+  uint64_t x1300;
+  fiat_p1024_uint1 x1301;
+  uint64_t x1302;
+  fiat_p1024_uint1 x1303;
+  uint64_t x1304;
+  fiat_p1024_uint1 x1305;
+  uint64_t x1306;
+  fiat_p1024_uint1 x1307;
+  uint64_t x1308;
+  fiat_p1024_uint1 x1309;
+  uint64_t x1310;
+  fiat_p1024_uint1 x1311;
+  uint64_t x1312;
+  fiat_p1024_uint1 x1313;
+  uint64_t x1314;
+  fiat_p1024_uint1 x1315;
+  uint64_t x1316;
+  fiat_p1024_uint1 x1317;
+  uint64_t x1318;
+  fiat_p1024_uint1 x1319;
+  uint64_t x1320;
+  fiat_p1024_uint1 x1321;
+  uint64_t x1322;
+  fiat_p1024_uint1 x1323;
+  uint64_t x1324;
+  fiat_p1024_uint1 x1325;
+  uint64_t x1326;
+  fiat_p1024_uint1 x1327;
+  uint64_t x1328;
+  fiat_p1024_uint1 x1329;
+  uint64_t x1330;
+  fiat_p1024_uint1 x1331;
+  uint64_t x1332;
+  fiat_p1024_uint1 x1333;
+  uint64_t x1334;
+  fiat_p1024_uint1 x1335;
+  uint64_t x1336;
+  fiat_p1024_uint1 x1337;
+  uint64_t x1338;
+  fiat_p1024_uint1 x1339;
+  uint64_t x1340;
+  fiat_p1024_uint1 x1341;
+  uint64_t x1342;
+  fiat_p1024_uint1 x1343;
+  uint64_t x1344;
+  fiat_p1024_uint1 x1345;
+  uint64_t x1346;
+  fiat_p1024_uint1 x1347;
+  uint64_t x1348;
+  fiat_p1024_uint1 x1349;
+  uint64_t x1350;
+  fiat_p1024_uint1 x1351;
+  uint64_t x1352;
+  fiat_p1024_uint1 x1353;
+  uint64_t x1354;
+  fiat_p1024_uint1 x1355;
+  uint64_t x1356;
+  fiat_p1024_uint1 x1357;
+  uint64_t x1358;
+  fiat_p1024_uint1 x1359;
+  uint64_t x1360;
+  fiat_p1024_uint1 x1361;
+  uint64_t x1362;
+  fiat_p1024_uint1 x1363;
+  uint64_t x1364;
+  fiat_p1024_uint1 x1365;
+  uint64_t x1366;
+  fiat_p1024_uint1 x1367;
+  uint64_t x1368;
+  fiat_p1024_uint1 x1369;
+  uint64_t x1370;
+  fiat_p1024_uint1 x1371;
+  uint64_t x1372;
+  fiat_p1024_uint1 x1373;
+  uint64_t x1374;
+  fiat_p1024_uint1 x1375;
+  uint64_t x1376;
+  fiat_p1024_uint1 x1377;
+  uint64_t x1378;
+  fiat_p1024_uint1 x1379;
+  uint64_t x1380;
+  fiat_p1024_uint1 x1381;
+  uint64_t x1382;
+  fiat_p1024_uint1 x1383;
+  uint64_t x1384;
+  fiat_p1024_uint1 x1385;
+  uint64_t x1386;
+  fiat_p1024_uint1 x1387;
+  uint64_t x1388;
+  uint64_t x1389;
+  uint64_t x1390;
+  uint64_t x1391;
+  uint64_t x1392;
+  uint64_t x1393;
+  uint64_t x1394;
+  uint64_t x1395;
+  uint64_t x1396;
+  uint64_t x1397;
+  uint64_t x1398;
+  uint64_t x1399;
+  uint64_t x1400;
+  uint64_t x1401;
+  uint64_t x1402;
+  uint64_t x1403;
+  uint64_t x1404;
+  uint64_t x1405;
+  uint64_t x1406;
+  uint64_t x1407;
+  uint64_t x1408;
+  uint64_t x1409;
+  uint64_t x1410;
+  uint64_t x1411;
+  uint64_t x1412;
+  uint64_t x1413;
+  uint64_t x1414;
+  uint64_t x1415;
+  uint64_t x1416;
+  uint64_t x1417;
+  uint64_t x1418;
+  uint64_t x1419;
+  uint64_t x1420;
+  uint64_t x1421;
+  uint64_t x1422;
+  fiat_p1024_uint1 x1423;
+  uint64_t x1424;
+  fiat_p1024_uint1 x1425;
+  uint64_t x1426;
+  fiat_p1024_uint1 x1427;
+  uint64_t x1428;
+  fiat_p1024_uint1 x1429;
+  uint64_t x1430;
+  fiat_p1024_uint1 x1431;
+  uint64_t x1432;
+  fiat_p1024_uint1 x1433;
+  uint64_t x1434;
+  fiat_p1024_uint1 x1435;
+  uint64_t x1436;
+  fiat_p1024_uint1 x1437;
+  uint64_t x1438;
+  fiat_p1024_uint1 x1439;
+  uint64_t x1440;
+  fiat_p1024_uint1 x1441;
+  uint64_t x1442;
+  fiat_p1024_uint1 x1443;
+  uint64_t x1444;
+  fiat_p1024_uint1 x1445;
+  uint64_t x1446;
+  fiat_p1024_uint1 x1447;
+  uint64_t x1448;
+  fiat_p1024_uint1 x1449;
+  uint64_t x1450;
+  fiat_p1024_uint1 x1451;
+  uint64_t x1452;
+  fiat_p1024_uint1 x1453;
+  uint64_t x1454;
+  fiat_p1024_uint1 x1455;
+  uint64_t x1456;
+  fiat_p1024_uint1 x1457;
+  uint64_t x1458;
+  fiat_p1024_uint1 x1459;
+  uint64_t x1460;
+  fiat_p1024_uint1 x1461;
+  uint64_t x1462;
+  fiat_p1024_uint1 x1463;
+  uint64_t x1464;
+  fiat_p1024_uint1 x1465;
+  uint64_t x1466;
+  fiat_p1024_uint1 x1467;
+  uint64_t x1468;
+  fiat_p1024_uint1 x1469;
+  uint64_t x1470;
+  fiat_p1024_uint1 x1471;
+  uint64_t x1472;
+  fiat_p1024_uint1 x1473;
+  uint64_t x1474;
+  fiat_p1024_uint1 x1475;
+  uint64_t x1476;
+  fiat_p1024_uint1 x1477;
+  uint64_t x1478;
+  fiat_p1024_uint1 x1479;
+  uint64_t x1480;
+  fiat_p1024_uint1 x1481;
+  uint64_t x1482;
+  fiat_p1024_uint1 x1483;
+  uint64_t x1484;
+  fiat_p1024_uint1 x1485;
+  uint64_t x1486;
+  fiat_p1024_uint1 x1487;
+  uint64_t x1488;
+  fiat_p1024_uint1 x1489;
+  uint64_t x1490;
+  fiat_p1024_uint1 x1491;
+  uint64_t x1492;
+  fiat_p1024_uint1 x1493;
+  uint64_t x1494;
+  fiat_p1024_uint1 x1495;
+  uint64_t x1496;
+  fiat_p1024_uint1 x1497;
+  uint64_t x1498;
+  fiat_p1024_uint1 x1499;
+  uint64_t x1500;
+  fiat_p1024_uint1 x1501;
+  uint64_t x1502;
+  fiat_p1024_uint1 x1503;
+  uint64_t x1504;
+  fiat_p1024_uint1 x1505;
+  uint64_t x1506;
+  fiat_p1024_uint1 x1507;
+  uint64_t x1508;
+  fiat_p1024_uint1 x1509;
+  uint64_t x1510;
+  fiat_p1024_uint1 x1511;
+  uint64_t x1512;
+  fiat_p1024_uint1 x1513;
+  uint64_t x1514;
+  uint64_t x1515;
+  uint64_t x1516;
+  uint64_t x1517;
+  uint64_t x1518;
+  uint64_t x1519;
+  uint64_t x1520;
+  uint64_t x1521;
+  uint64_t x1522;
+  uint64_t x1523;
+  uint64_t x1524;
+  uint64_t x1525;
+  uint64_t x1526;
+  uint64_t x1527;
+  uint64_t x1528;
+  uint64_t x1529;
+  uint64_t x1530;
+  uint64_t x1531;
+  uint64_t x1532;
+  uint64_t x1533;
+  uint64_t x1534;
+  uint64_t x1535;
+  uint64_t x1536;
+  uint64_t x1537;
+  uint64_t x1538;
+  uint64_t x1539;
+  uint64_t x1540;
+  uint64_t x1541;
+  uint64_t x1542;
+  uint64_t x1543;
+  uint64_t x1544;
+  uint64_t x1545;
+  uint64_t x1546;
+  uint64_t x1547;
+  uint64_t x1548;
+  fiat_p1024_uint1 x1549;
+  uint64_t x1550;
+  fiat_p1024_uint1 x1551;
+  uint64_t x1552;
+  fiat_p1024_uint1 x1553;
+  uint64_t x1554;
+  fiat_p1024_uint1 x1555;
+  uint64_t x1556;
+  fiat_p1024_uint1 x1557;
+  uint64_t x1558;
+  fiat_p1024_uint1 x1559;
+  uint64_t x1560;
+  fiat_p1024_uint1 x1561;
+  uint64_t x1562;
+  fiat_p1024_uint1 x1563;
+  uint64_t x1564;
+  fiat_p1024_uint1 x1565;
+  uint64_t x1566;
+  fiat_p1024_uint1 x1567;
+  uint64_t x1568;
+  fiat_p1024_uint1 x1569;
+  uint64_t x1570;
+  fiat_p1024_uint1 x1571;
+  uint64_t x1572;
+  fiat_p1024_uint1 x1573;
+  uint64_t x1574;
+  fiat_p1024_uint1 x1575;
+  uint64_t x1576;
+  fiat_p1024_uint1 x1577;
+  uint64_t x1578;
+  fiat_p1024_uint1 x1579;
+  uint64_t x1580;
+  fiat_p1024_uint1 x1581;
+  uint64_t x1582;
+  fiat_p1024_uint1 x1583;
+  uint64_t x1584;
+  fiat_p1024_uint1 x1585;
+  uint64_t x1586;
+  fiat_p1024_uint1 x1587;
+  uint64_t x1588;
+  fiat_p1024_uint1 x1589;
+  uint64_t x1590;
+  fiat_p1024_uint1 x1591;
+  uint64_t x1592;
+  fiat_p1024_uint1 x1593;
+  uint64_t x1594;
+  fiat_p1024_uint1 x1595;
+  uint64_t x1596;
+  fiat_p1024_uint1 x1597;
+  uint64_t x1598;
+  fiat_p1024_uint1 x1599;
+  uint64_t x1600;
+  fiat_p1024_uint1 x1601;
+  uint64_t x1602;
+  fiat_p1024_uint1 x1603;
+  uint64_t x1604;
+  fiat_p1024_uint1 x1605;
+  uint64_t x1606;
+  fiat_p1024_uint1 x1607;
+  uint64_t x1608;
+  fiat_p1024_uint1 x1609;
+  uint64_t x1610;
+  fiat_p1024_uint1 x1611;
+  uint64_t x1612;
+  fiat_p1024_uint1 x1613;
+  uint64_t x1614;
+  fiat_p1024_uint1 x1615;
+  uint64_t x1616;
+  fiat_p1024_uint1 x1617;
+  uint64_t x1618;
+  fiat_p1024_uint1 x1619;
+  uint64_t x1620;
+  fiat_p1024_uint1 x1621;
+  uint64_t x1622;
+  fiat_p1024_uint1 x1623;
+  uint64_t x1624;
+  fiat_p1024_uint1 x1625;
+  uint64_t x1626;
+  fiat_p1024_uint1 x1627;
+  uint64_t x1628;
+  fiat_p1024_uint1 x1629;
+  uint64_t x1630;
+  fiat_p1024_uint1 x1631;
+  uint64_t x1632;
+  fiat_p1024_uint1 x1633;
+  uint64_t x1634;
+  fiat_p1024_uint1 x1635;
+  uint64_t x1636;
+  fiat_p1024_uint1 x1637;
+  uint64_t x1638;
+  fiat_p1024_uint1 x1639;
+  uint64_t x1640;
+  uint64_t x1641;
+  uint64_t x1642;
+  uint64_t x1643;
+  uint64_t x1644;
+  uint64_t x1645;
+  uint64_t x1646;
+  uint64_t x1647;
+  uint64_t x1648;
+  uint64_t x1649;
+  uint64_t x1650;
+  uint64_t x1651;
+  uint64_t x1652;
+  uint64_t x1653;
+  uint64_t x1654;
+  uint64_t x1655;
+  uint64_t x1656;
+  uint64_t x1657;
+  uint64_t x1658;
+  uint64_t x1659;
+  uint64_t x1660;
+  uint64_t x1661;
+  uint64_t x1662;
+  uint64_t x1663;
+  uint64_t x1664;
+  uint64_t x1665;
+  uint64_t x1666;
+  uint64_t x1667;
+  uint64_t x1668;
+  uint64_t x1669;
+  uint64_t x1670;
+  uint64_t x1671;
+  uint64_t x1672;
+  uint64_t x1673;
+  uint64_t x1674;
+  fiat_p1024_uint1 x1675;
+  uint64_t x1676;
+  fiat_p1024_uint1 x1677;
+  uint64_t x1678;
+  fiat_p1024_uint1 x1679;
+  uint64_t x1680;
+  fiat_p1024_uint1 x1681;
+  uint64_t x1682;
+  fiat_p1024_uint1 x1683;
+  uint64_t x1684;
+  fiat_p1024_uint1 x1685;
+  uint64_t x1686;
+  fiat_p1024_uint1 x1687;
+  uint64_t x1688;
+  fiat_p1024_uint1 x1689;
+  uint64_t x1690;
+  fiat_p1024_uint1 x1691;
+  uint64_t x1692;
+  fiat_p1024_uint1 x1693;
+  uint64_t x1694;
+  fiat_p1024_uint1 x1695;
+  uint64_t x1696;
+  fiat_p1024_uint1 x1697;
+  uint64_t x1698;
+  fiat_p1024_uint1 x1699;
+  uint64_t x1700;
+  fiat_p1024_uint1 x1701;
+  uint64_t x1702;
+  fiat_p1024_uint1 x1703;
+  uint64_t x1704;
+  fiat_p1024_uint1 x1705;
+  uint64_t x1706;
+  fiat_p1024_uint1 x1707;
+  uint64_t x1708;
+  fiat_p1024_uint1 x1709;
+  uint64_t x1710;
+  fiat_p1024_uint1 x1711;
+  uint64_t x1712;
+  fiat_p1024_uint1 x1713;
+  uint64_t x1714;
+  fiat_p1024_uint1 x1715;
+  uint64_t x1716;
+  fiat_p1024_uint1 x1717;
+  uint64_t x1718;
+  fiat_p1024_uint1 x1719;
+  uint64_t x1720;
+  fiat_p1024_uint1 x1721;
+  uint64_t x1722;
+  fiat_p1024_uint1 x1723;
+  uint64_t x1724;
+  fiat_p1024_uint1 x1725;
+  uint64_t x1726;
+  fiat_p1024_uint1 x1727;
+  uint64_t x1728;
+  fiat_p1024_uint1 x1729;
+  uint64_t x1730;
+  fiat_p1024_uint1 x1731;
+  uint64_t x1732;
+  fiat_p1024_uint1 x1733;
+  uint64_t x1734;
+  fiat_p1024_uint1 x1735;
+  uint64_t x1736;
+  fiat_p1024_uint1 x1737;
+  uint64_t x1738;
+  fiat_p1024_uint1 x1739;
+  uint64_t x1740;
+  fiat_p1024_uint1 x1741;
+  uint64_t x1742;
+  fiat_p1024_uint1 x1743;
+  uint64_t x1744;
+  fiat_p1024_uint1 x1745;
+  uint64_t x1746;
+  fiat_p1024_uint1 x1747;
+  uint64_t x1748;
+  fiat_p1024_uint1 x1749;
+  uint64_t x1750;
+  fiat_p1024_uint1 x1751;
+  uint64_t x1752;
+  fiat_p1024_uint1 x1753;
+  uint64_t x1754;
+  fiat_p1024_uint1 x1755;
+  uint64_t x1756;
+  fiat_p1024_uint1 x1757;
+  uint64_t x1758;
+  fiat_p1024_uint1 x1759;
+  uint64_t x1760;
+  fiat_p1024_uint1 x1761;
+  uint64_t x1762;
+  fiat_p1024_uint1 x1763;
+  uint64_t x1764;
+  fiat_p1024_uint1 x1765;
+  uint64_t x1766;
+  uint64_t x1767;
+  uint64_t x1768;
+  uint64_t x1769;
+  uint64_t x1770;
+  uint64_t x1771;
+  uint64_t x1772;
+  uint64_t x1773;
+  uint64_t x1774;
+  uint64_t x1775;
+  uint64_t x1776;
+  uint64_t x1777;
+  uint64_t x1778;
+  uint64_t x1779;
+  uint64_t x1780;
+  uint64_t x1781;
+  uint64_t x1782;
+  uint64_t x1783;
+  uint64_t x1784;
+  uint64_t x1785;
+  uint64_t x1786;
+  uint64_t x1787;
+  uint64_t x1788;
+  uint64_t x1789;
+  uint64_t x1790;
+  uint64_t x1791;
+  uint64_t x1792;
+  uint64_t x1793;
+  uint64_t x1794;
+  uint64_t x1795;
+  uint64_t x1796;
+  uint64_t x1797;
+  uint64_t x1798;
+  uint64_t x1799;
+  uint64_t x1800;
+  fiat_p1024_uint1 x1801;
+  uint64_t x1802;
+  fiat_p1024_uint1 x1803;
+  uint64_t x1804;
+  fiat_p1024_uint1 x1805;
+  uint64_t x1806;
+  fiat_p1024_uint1 x1807;
+  uint64_t x1808;
+  fiat_p1024_uint1 x1809;
+  uint64_t x1810;
+  fiat_p1024_uint1 x1811;
+  uint64_t x1812;
+  fiat_p1024_uint1 x1813;
+  uint64_t x1814;
+  fiat_p1024_uint1 x1815;
+  uint64_t x1816;
+  fiat_p1024_uint1 x1817;
+  uint64_t x1818;
+  fiat_p1024_uint1 x1819;
+  uint64_t x1820;
+  fiat_p1024_uint1 x1821;
+  uint64_t x1822;
+  fiat_p1024_uint1 x1823;
+  uint64_t x1824;
+  fiat_p1024_uint1 x1825;
+  uint64_t x1826;
+  fiat_p1024_uint1 x1827;
+  uint64_t x1828;
+  fiat_p1024_uint1 x1829;
+  uint64_t x1830;
+  fiat_p1024_uint1 x1831;
+  uint64_t x1832;
+  fiat_p1024_uint1 x1833;
+  uint64_t x1834;
+  fiat_p1024_uint1 x1835;
+  uint64_t x1836;
+  fiat_p1024_uint1 x1837;
+  uint64_t x1838;
+  fiat_p1024_uint1 x1839;
+  uint64_t x1840;
+  fiat_p1024_uint1 x1841;
+  uint64_t x1842;
+  fiat_p1024_uint1 x1843;
+  uint64_t x1844;
+  fiat_p1024_uint1 x1845;
+  uint64_t x1846;
+  fiat_p1024_uint1 x1847;
+  uint64_t x1848;
+  fiat_p1024_uint1 x1849;
+  uint64_t x1850;
+  fiat_p1024_uint1 x1851;
+  uint64_t x1852;
+  fiat_p1024_uint1 x1853;
+  uint64_t x1854;
+  fiat_p1024_uint1 x1855;
+  uint64_t x1856;
+  fiat_p1024_uint1 x1857;
+  uint64_t x1858;
+  fiat_p1024_uint1 x1859;
+  uint64_t x1860;
+  fiat_p1024_uint1 x1861;
+  uint64_t x1862;
+  fiat_p1024_uint1 x1863;
+  uint64_t x1864;
+  fiat_p1024_uint1 x1865;
+  uint64_t x1866;
+  fiat_p1024_uint1 x1867;
+  uint64_t x1868;
+  fiat_p1024_uint1 x1869;
+  uint64_t x1870;
+  fiat_p1024_uint1 x1871;
+  uint64_t x1872;
+  fiat_p1024_uint1 x1873;
+  uint64_t x1874;
+  fiat_p1024_uint1 x1875;
+  uint64_t x1876;
+  fiat_p1024_uint1 x1877;
+  uint64_t x1878;
+  fiat_p1024_uint1 x1879;
+  uint64_t x1880;
+  fiat_p1024_uint1 x1881;
+  uint64_t x1882;
+  fiat_p1024_uint1 x1883;
+  uint64_t x1884;
+  fiat_p1024_uint1 x1885;
+  uint64_t x1886;
+  fiat_p1024_uint1 x1887;
+  uint64_t x1888;
+  fiat_p1024_uint1 x1889;
+  uint64_t x1890;
+  fiat_p1024_uint1 x1891;
+  uint64_t x1892;
+  uint64_t x1893;
+  uint64_t x1894;
+  uint64_t x1895;
+  uint64_t x1896;
+  uint64_t x1897;
+  uint64_t x1898;
+  uint64_t x1899;
+  uint64_t x1900;
+  uint64_t x1901;
+  uint64_t x1902;
+  uint64_t x1903;
+  uint64_t x1904;
+  uint64_t x1905;
+  uint64_t x1906;
+  uint64_t x1907;
+  uint64_t x1908;
+  uint64_t x1909;
+  uint64_t x1910;
+  uint64_t x1911;
+  uint64_t x1912;
+  uint64_t x1913;
+  uint64_t x1914;
+  uint64_t x1915;
+  uint64_t x1916;
+  uint64_t x1917;
+  uint64_t x1918;
+  uint64_t x1919;
+  uint64_t x1920;
+  uint64_t x1921;
+  uint64_t x1922;
+  uint64_t x1923;
+  uint64_t x1924;
+  uint64_t x1925;
+  uint64_t x1926;
+  fiat_p1024_uint1 x1927;
+  uint64_t x1928;
+  fiat_p1024_uint1 x1929;
+  uint64_t x1930;
+  fiat_p1024_uint1 x1931;
+  uint64_t x1932;
+  fiat_p1024_uint1 x1933;
+  uint64_t x1934;
+  fiat_p1024_uint1 x1935;
+  uint64_t x1936;
+  fiat_p1024_uint1 x1937;
+  uint64_t x1938;
+  fiat_p1024_uint1 x1939;
+  uint64_t x1940;
+  fiat_p1024_uint1 x1941;
+  uint64_t x1942;
+  fiat_p1024_uint1 x1943;
+  uint64_t x1944;
+  fiat_p1024_uint1 x1945;
+  uint64_t x1946;
+  fiat_p1024_uint1 x1947;
+  uint64_t x1948;
+  fiat_p1024_uint1 x1949;
+  uint64_t x1950;
+  fiat_p1024_uint1 x1951;
+  uint64_t x1952;
+  fiat_p1024_uint1 x1953;
+  uint64_t x1954;
+  fiat_p1024_uint1 x1955;
+  uint64_t x1956;
+  fiat_p1024_uint1 x1957;
+  uint64_t x1958;
+  fiat_p1024_uint1 x1959;
+  uint64_t x1960;
+  fiat_p1024_uint1 x1961;
+  uint64_t x1962;
+  fiat_p1024_uint1 x1963;
+  uint64_t x1964;
+  fiat_p1024_uint1 x1965;
+  uint64_t x1966;
+  fiat_p1024_uint1 x1967;
+  uint64_t x1968;
+  fiat_p1024_uint1 x1969;
+  uint64_t x1970;
+  fiat_p1024_uint1 x1971;
+  uint64_t x1972;
+  fiat_p1024_uint1 x1973;
+  uint64_t x1974;
+  fiat_p1024_uint1 x1975;
+  uint64_t x1976;
+  fiat_p1024_uint1 x1977;
+  uint64_t x1978;
+  fiat_p1024_uint1 x1979;
+  uint64_t x1980;
+  fiat_p1024_uint1 x1981;
+  uint64_t x1982;
+  fiat_p1024_uint1 x1983;
+  uint64_t x1984;
+  fiat_p1024_uint1 x1985;
+  uint64_t x1986;
+  fiat_p1024_uint1 x1987;
+  uint64_t x1988;
+  uint64_t x1989;
+  fiat_p1024_uint1 x1990;
+  uint64_t x1991;
+  fiat_p1024_uint1 x1992;
+  uint64_t x1993;
+  fiat_p1024_uint1 x1994;
+  uint64_t x1995;
+  fiat_p1024_uint1 x1996;
+  uint64_t x1997;
+  fiat_p1024_uint1 x1998;
+  uint64_t x1999;
+  fiat_p1024_uint1 x2000;
+  uint64_t x2001;
+  fiat_p1024_uint1 x2002;
+  uint64_t x2003;
+  fiat_p1024_uint1 x2004;
+  uint64_t x2005;
+  fiat_p1024_uint1 x2006;
+  uint64_t x2007;
+  fiat_p1024_uint1 x2008;
+  uint64_t x2009;
+  fiat_p1024_uint1 x2010;
+  uint64_t x2011;
+  fiat_p1024_uint1 x2012;
+  uint64_t x2013;
+  fiat_p1024_uint1 x2014;
+  uint64_t x2015;
+  fiat_p1024_uint1 x2016;
+  uint64_t x2017;
+  fiat_p1024_uint1 x2018;
+  uint64_t x2019;
+  fiat_p1024_uint1 x2020;
+  uint64_t x2021;
+  fiat_p1024_uint1 x2022;
+  uint64_t x2023;
+  uint64_t x2024;
+  uint64_t x2025;
+  uint64_t x2026;
+  uint64_t x2027;
+  uint64_t x2028;
+  uint64_t x2029;
+  uint64_t x2030;
+  uint64_t x2031;
+  uint64_t x2032;
+  uint64_t x2033;
+  uint64_t x2034;
+  uint64_t x2035;
+  uint64_t x2036;
+  uint64_t x2037;
+  uint64_t x2038;
+  x1 = (arg1[0]);
+  fiat_p1024_mulx_u64(&x2, &x3, x1, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x4, &x5, x2, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x6, &x7, x2, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x8, &x9, x2, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x10, &x11, x2, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x12, &x13, x2, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x14, &x15, x2, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x16, &x17, x2, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x18, &x19, x2, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x20, &x21, x2, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x22, &x23, x2, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x24, &x25, x2, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x26, &x27, x2, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x28, &x29, x2, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x30, &x31, x2, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x32, &x33, x2, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x34, &x35, x2, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x36, &x37, 0x0, x35, x32);
+  fiat_p1024_addcarryx_u64(&x38, &x39, x37, x33, x30);
+  fiat_p1024_addcarryx_u64(&x40, &x41, x39, x31, x28);
+  fiat_p1024_addcarryx_u64(&x42, &x43, x41, x29, x26);
+  fiat_p1024_addcarryx_u64(&x44, &x45, x43, x27, x24);
+  fiat_p1024_addcarryx_u64(&x46, &x47, x45, x25, x22);
+  fiat_p1024_addcarryx_u64(&x48, &x49, x47, x23, x20);
+  fiat_p1024_addcarryx_u64(&x50, &x51, x49, x21, x18);
+  fiat_p1024_addcarryx_u64(&x52, &x53, x51, x19, x16);
+  fiat_p1024_addcarryx_u64(&x54, &x55, x53, x17, x14);
+  fiat_p1024_addcarryx_u64(&x56, &x57, x55, x15, x12);
+  fiat_p1024_addcarryx_u64(&x58, &x59, x57, x13, x10);
+  fiat_p1024_addcarryx_u64(&x60, &x61, x59, x11, x8);
+  fiat_p1024_addcarryx_u64(&x62, &x63, x61, x9, x6);
+  fiat_p1024_addcarryx_u64(&x64, &x65, x63, x7, x4);
+  fiat_p1024_addcarryx_u64(&x66, &x67, 0x0, x1, x34);
+  fiat_p1024_addcarryx_u64(&x68, &x69, x67, 0x0, x36);
+  fiat_p1024_addcarryx_u64(&x70, &x71, x69, 0x0, x38);
+  fiat_p1024_addcarryx_u64(&x72, &x73, x71, 0x0, x40);
+  fiat_p1024_addcarryx_u64(&x74, &x75, x73, 0x0, x42);
+  fiat_p1024_addcarryx_u64(&x76, &x77, x75, 0x0, x44);
+  fiat_p1024_addcarryx_u64(&x78, &x79, x77, 0x0, x46);
+  fiat_p1024_addcarryx_u64(&x80, &x81, x79, 0x0, x48);
+  fiat_p1024_addcarryx_u64(&x82, &x83, x81, 0x0, x50);
+  fiat_p1024_addcarryx_u64(&x84, &x85, x83, 0x0, x52);
+  fiat_p1024_addcarryx_u64(&x86, &x87, x85, 0x0, x54);
+  fiat_p1024_addcarryx_u64(&x88, &x89, x87, 0x0, x56);
+  fiat_p1024_addcarryx_u64(&x90, &x91, x89, 0x0, x58);
+  fiat_p1024_addcarryx_u64(&x92, &x93, x91, 0x0, x60);
+  fiat_p1024_addcarryx_u64(&x94, &x95, x93, 0x0, x62);
+  fiat_p1024_addcarryx_u64(&x96, &x97, x95, 0x0, x64);
+  fiat_p1024_addcarryx_u64(&x98, &x99, 0x0, x68, (arg1[1]));
+  fiat_p1024_addcarryx_u64(&x100, &x101, x99, x70, 0x0);
+  fiat_p1024_addcarryx_u64(&x102, &x103, x101, x72, 0x0);
+  fiat_p1024_addcarryx_u64(&x104, &x105, x103, x74, 0x0);
+  fiat_p1024_addcarryx_u64(&x106, &x107, x105, x76, 0x0);
+  fiat_p1024_addcarryx_u64(&x108, &x109, x107, x78, 0x0);
+  fiat_p1024_addcarryx_u64(&x110, &x111, x109, x80, 0x0);
+  fiat_p1024_addcarryx_u64(&x112, &x113, x111, x82, 0x0);
+  fiat_p1024_addcarryx_u64(&x114, &x115, x113, x84, 0x0);
+  fiat_p1024_addcarryx_u64(&x116, &x117, x115, x86, 0x0);
+  fiat_p1024_addcarryx_u64(&x118, &x119, x117, x88, 0x0);
+  fiat_p1024_addcarryx_u64(&x120, &x121, x119, x90, 0x0);
+  fiat_p1024_addcarryx_u64(&x122, &x123, x121, x92, 0x0);
+  fiat_p1024_addcarryx_u64(&x124, &x125, x123, x94, 0x0);
+  fiat_p1024_addcarryx_u64(&x126, &x127, x125, x96, 0x0);
+  fiat_p1024_mulx_u64(&x128, &x129, x98, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x130, &x131, x128, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x132, &x133, x128, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x134, &x135, x128, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x136, &x137, x128, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x138, &x139, x128, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x140, &x141, x128, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x142, &x143, x128, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x144, &x145, x128, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x146, &x147, x128, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x148, &x149, x128, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x150, &x151, x128, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x152, &x153, x128, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x154, &x155, x128, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x156, &x157, x128, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x158, &x159, x128, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x160, &x161, x128, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x162, &x163, 0x0, x161, x158);
+  fiat_p1024_addcarryx_u64(&x164, &x165, x163, x159, x156);
+  fiat_p1024_addcarryx_u64(&x166, &x167, x165, x157, x154);
+  fiat_p1024_addcarryx_u64(&x168, &x169, x167, x155, x152);
+  fiat_p1024_addcarryx_u64(&x170, &x171, x169, x153, x150);
+  fiat_p1024_addcarryx_u64(&x172, &x173, x171, x151, x148);
+  fiat_p1024_addcarryx_u64(&x174, &x175, x173, x149, x146);
+  fiat_p1024_addcarryx_u64(&x176, &x177, x175, x147, x144);
+  fiat_p1024_addcarryx_u64(&x178, &x179, x177, x145, x142);
+  fiat_p1024_addcarryx_u64(&x180, &x181, x179, x143, x140);
+  fiat_p1024_addcarryx_u64(&x182, &x183, x181, x141, x138);
+  fiat_p1024_addcarryx_u64(&x184, &x185, x183, x139, x136);
+  fiat_p1024_addcarryx_u64(&x186, &x187, x185, x137, x134);
+  fiat_p1024_addcarryx_u64(&x188, &x189, x187, x135, x132);
+  fiat_p1024_addcarryx_u64(&x190, &x191, x189, x133, x130);
+  fiat_p1024_addcarryx_u64(&x192, &x193, 0x0, x98, x160);
+  fiat_p1024_addcarryx_u64(&x194, &x195, x193, x100, x162);
+  fiat_p1024_addcarryx_u64(&x196, &x197, x195, x102, x164);
+  fiat_p1024_addcarryx_u64(&x198, &x199, x197, x104, x166);
+  fiat_p1024_addcarryx_u64(&x200, &x201, x199, x106, x168);
+  fiat_p1024_addcarryx_u64(&x202, &x203, x201, x108, x170);
+  fiat_p1024_addcarryx_u64(&x204, &x205, x203, x110, x172);
+  fiat_p1024_addcarryx_u64(&x206, &x207, x205, x112, x174);
+  fiat_p1024_addcarryx_u64(&x208, &x209, x207, x114, x176);
+  fiat_p1024_addcarryx_u64(&x210, &x211, x209, x116, x178);
+  fiat_p1024_addcarryx_u64(&x212, &x213, x211, x118, x180);
+  fiat_p1024_addcarryx_u64(&x214, &x215, x213, x120, x182);
+  fiat_p1024_addcarryx_u64(&x216, &x217, x215, x122, x184);
+  fiat_p1024_addcarryx_u64(&x218, &x219, x217, x124, x186);
+  fiat_p1024_addcarryx_u64(&x220, &x221, x219, x126, x188);
+  fiat_p1024_addcarryx_u64(&x222, &x223, x221, (x127 + (x97 + (x65 + x5))), x190);
+  fiat_p1024_addcarryx_u64(&x224, &x225, 0x0, x194, (arg1[2]));
+  fiat_p1024_addcarryx_u64(&x226, &x227, x225, x196, 0x0);
+  fiat_p1024_addcarryx_u64(&x228, &x229, x227, x198, 0x0);
+  fiat_p1024_addcarryx_u64(&x230, &x231, x229, x200, 0x0);
+  fiat_p1024_addcarryx_u64(&x232, &x233, x231, x202, 0x0);
+  fiat_p1024_addcarryx_u64(&x234, &x235, x233, x204, 0x0);
+  fiat_p1024_addcarryx_u64(&x236, &x237, x235, x206, 0x0);
+  fiat_p1024_addcarryx_u64(&x238, &x239, x237, x208, 0x0);
+  fiat_p1024_addcarryx_u64(&x240, &x241, x239, x210, 0x0);
+  fiat_p1024_addcarryx_u64(&x242, &x243, x241, x212, 0x0);
+  fiat_p1024_addcarryx_u64(&x244, &x245, x243, x214, 0x0);
+  fiat_p1024_addcarryx_u64(&x246, &x247, x245, x216, 0x0);
+  fiat_p1024_addcarryx_u64(&x248, &x249, x247, x218, 0x0);
+  fiat_p1024_addcarryx_u64(&x250, &x251, x249, x220, 0x0);
+  fiat_p1024_addcarryx_u64(&x252, &x253, x251, x222, 0x0);
+  fiat_p1024_mulx_u64(&x254, &x255, x224, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x256, &x257, x254, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x258, &x259, x254, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x260, &x261, x254, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x262, &x263, x254, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x264, &x265, x254, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x266, &x267, x254, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x268, &x269, x254, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x270, &x271, x254, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x272, &x273, x254, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x274, &x275, x254, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x276, &x277, x254, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x278, &x279, x254, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x280, &x281, x254, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x282, &x283, x254, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x284, &x285, x254, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x286, &x287, x254, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x288, &x289, 0x0, x287, x284);
+  fiat_p1024_addcarryx_u64(&x290, &x291, x289, x285, x282);
+  fiat_p1024_addcarryx_u64(&x292, &x293, x291, x283, x280);
+  fiat_p1024_addcarryx_u64(&x294, &x295, x293, x281, x278);
+  fiat_p1024_addcarryx_u64(&x296, &x297, x295, x279, x276);
+  fiat_p1024_addcarryx_u64(&x298, &x299, x297, x277, x274);
+  fiat_p1024_addcarryx_u64(&x300, &x301, x299, x275, x272);
+  fiat_p1024_addcarryx_u64(&x302, &x303, x301, x273, x270);
+  fiat_p1024_addcarryx_u64(&x304, &x305, x303, x271, x268);
+  fiat_p1024_addcarryx_u64(&x306, &x307, x305, x269, x266);
+  fiat_p1024_addcarryx_u64(&x308, &x309, x307, x267, x264);
+  fiat_p1024_addcarryx_u64(&x310, &x311, x309, x265, x262);
+  fiat_p1024_addcarryx_u64(&x312, &x313, x311, x263, x260);
+  fiat_p1024_addcarryx_u64(&x314, &x315, x313, x261, x258);
+  fiat_p1024_addcarryx_u64(&x316, &x317, x315, x259, x256);
+  fiat_p1024_addcarryx_u64(&x318, &x319, 0x0, x224, x286);
+  fiat_p1024_addcarryx_u64(&x320, &x321, x319, x226, x288);
+  fiat_p1024_addcarryx_u64(&x322, &x323, x321, x228, x290);
+  fiat_p1024_addcarryx_u64(&x324, &x325, x323, x230, x292);
+  fiat_p1024_addcarryx_u64(&x326, &x327, x325, x232, x294);
+  fiat_p1024_addcarryx_u64(&x328, &x329, x327, x234, x296);
+  fiat_p1024_addcarryx_u64(&x330, &x331, x329, x236, x298);
+  fiat_p1024_addcarryx_u64(&x332, &x333, x331, x238, x300);
+  fiat_p1024_addcarryx_u64(&x334, &x335, x333, x240, x302);
+  fiat_p1024_addcarryx_u64(&x336, &x337, x335, x242, x304);
+  fiat_p1024_addcarryx_u64(&x338, &x339, x337, x244, x306);
+  fiat_p1024_addcarryx_u64(&x340, &x341, x339, x246, x308);
+  fiat_p1024_addcarryx_u64(&x342, &x343, x341, x248, x310);
+  fiat_p1024_addcarryx_u64(&x344, &x345, x343, x250, x312);
+  fiat_p1024_addcarryx_u64(&x346, &x347, x345, x252, x314);
+  fiat_p1024_addcarryx_u64(&x348, &x349, x347, (x253 + (x223 + (x191 + x131))), x316);
+  fiat_p1024_addcarryx_u64(&x350, &x351, 0x0, x320, (arg1[3]));
+  fiat_p1024_addcarryx_u64(&x352, &x353, x351, x322, 0x0);
+  fiat_p1024_addcarryx_u64(&x354, &x355, x353, x324, 0x0);
+  fiat_p1024_addcarryx_u64(&x356, &x357, x355, x326, 0x0);
+  fiat_p1024_addcarryx_u64(&x358, &x359, x357, x328, 0x0);
+  fiat_p1024_addcarryx_u64(&x360, &x361, x359, x330, 0x0);
+  fiat_p1024_addcarryx_u64(&x362, &x363, x361, x332, 0x0);
+  fiat_p1024_addcarryx_u64(&x364, &x365, x363, x334, 0x0);
+  fiat_p1024_addcarryx_u64(&x366, &x367, x365, x336, 0x0);
+  fiat_p1024_addcarryx_u64(&x368, &x369, x367, x338, 0x0);
+  fiat_p1024_addcarryx_u64(&x370, &x371, x369, x340, 0x0);
+  fiat_p1024_addcarryx_u64(&x372, &x373, x371, x342, 0x0);
+  fiat_p1024_addcarryx_u64(&x374, &x375, x373, x344, 0x0);
+  fiat_p1024_addcarryx_u64(&x376, &x377, x375, x346, 0x0);
+  fiat_p1024_addcarryx_u64(&x378, &x379, x377, x348, 0x0);
+  fiat_p1024_mulx_u64(&x380, &x381, x350, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x382, &x383, x380, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x384, &x385, x380, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x386, &x387, x380, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x388, &x389, x380, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x390, &x391, x380, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x392, &x393, x380, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x394, &x395, x380, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x396, &x397, x380, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x398, &x399, x380, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x400, &x401, x380, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x402, &x403, x380, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x404, &x405, x380, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x406, &x407, x380, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x408, &x409, x380, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x410, &x411, x380, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x412, &x413, x380, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x414, &x415, 0x0, x413, x410);
+  fiat_p1024_addcarryx_u64(&x416, &x417, x415, x411, x408);
+  fiat_p1024_addcarryx_u64(&x418, &x419, x417, x409, x406);
+  fiat_p1024_addcarryx_u64(&x420, &x421, x419, x407, x404);
+  fiat_p1024_addcarryx_u64(&x422, &x423, x421, x405, x402);
+  fiat_p1024_addcarryx_u64(&x424, &x425, x423, x403, x400);
+  fiat_p1024_addcarryx_u64(&x426, &x427, x425, x401, x398);
+  fiat_p1024_addcarryx_u64(&x428, &x429, x427, x399, x396);
+  fiat_p1024_addcarryx_u64(&x430, &x431, x429, x397, x394);
+  fiat_p1024_addcarryx_u64(&x432, &x433, x431, x395, x392);
+  fiat_p1024_addcarryx_u64(&x434, &x435, x433, x393, x390);
+  fiat_p1024_addcarryx_u64(&x436, &x437, x435, x391, x388);
+  fiat_p1024_addcarryx_u64(&x438, &x439, x437, x389, x386);
+  fiat_p1024_addcarryx_u64(&x440, &x441, x439, x387, x384);
+  fiat_p1024_addcarryx_u64(&x442, &x443, x441, x385, x382);
+  fiat_p1024_addcarryx_u64(&x444, &x445, 0x0, x350, x412);
+  fiat_p1024_addcarryx_u64(&x446, &x447, x445, x352, x414);
+  fiat_p1024_addcarryx_u64(&x448, &x449, x447, x354, x416);
+  fiat_p1024_addcarryx_u64(&x450, &x451, x449, x356, x418);
+  fiat_p1024_addcarryx_u64(&x452, &x453, x451, x358, x420);
+  fiat_p1024_addcarryx_u64(&x454, &x455, x453, x360, x422);
+  fiat_p1024_addcarryx_u64(&x456, &x457, x455, x362, x424);
+  fiat_p1024_addcarryx_u64(&x458, &x459, x457, x364, x426);
+  fiat_p1024_addcarryx_u64(&x460, &x461, x459, x366, x428);
+  fiat_p1024_addcarryx_u64(&x462, &x463, x461, x368, x430);
+  fiat_p1024_addcarryx_u64(&x464, &x465, x463, x370, x432);
+  fiat_p1024_addcarryx_u64(&x466, &x467, x465, x372, x434);
+  fiat_p1024_addcarryx_u64(&x468, &x469, x467, x374, x436);
+  fiat_p1024_addcarryx_u64(&x470, &x471, x469, x376, x438);
+  fiat_p1024_addcarryx_u64(&x472, &x473, x471, x378, x440);
+  fiat_p1024_addcarryx_u64(&x474, &x475, x473, (x379 + (x349 + (x317 + x257))), x442);
+  fiat_p1024_addcarryx_u64(&x476, &x477, 0x0, x446, (arg1[4]));
+  fiat_p1024_addcarryx_u64(&x478, &x479, x477, x448, 0x0);
+  fiat_p1024_addcarryx_u64(&x480, &x481, x479, x450, 0x0);
+  fiat_p1024_addcarryx_u64(&x482, &x483, x481, x452, 0x0);
+  fiat_p1024_addcarryx_u64(&x484, &x485, x483, x454, 0x0);
+  fiat_p1024_addcarryx_u64(&x486, &x487, x485, x456, 0x0);
+  fiat_p1024_addcarryx_u64(&x488, &x489, x487, x458, 0x0);
+  fiat_p1024_addcarryx_u64(&x490, &x491, x489, x460, 0x0);
+  fiat_p1024_addcarryx_u64(&x492, &x493, x491, x462, 0x0);
+  fiat_p1024_addcarryx_u64(&x494, &x495, x493, x464, 0x0);
+  fiat_p1024_addcarryx_u64(&x496, &x497, x495, x466, 0x0);
+  fiat_p1024_addcarryx_u64(&x498, &x499, x497, x468, 0x0);
+  fiat_p1024_addcarryx_u64(&x500, &x501, x499, x470, 0x0);
+  fiat_p1024_addcarryx_u64(&x502, &x503, x501, x472, 0x0);
+  fiat_p1024_addcarryx_u64(&x504, &x505, x503, x474, 0x0);
+  fiat_p1024_mulx_u64(&x506, &x507, x476, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x508, &x509, x506, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x510, &x511, x506, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x512, &x513, x506, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x514, &x515, x506, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x516, &x517, x506, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x518, &x519, x506, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x520, &x521, x506, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x522, &x523, x506, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x524, &x525, x506, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x526, &x527, x506, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x528, &x529, x506, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x530, &x531, x506, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x532, &x533, x506, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x534, &x535, x506, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x536, &x537, x506, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x538, &x539, x506, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x540, &x541, 0x0, x539, x536);
+  fiat_p1024_addcarryx_u64(&x542, &x543, x541, x537, x534);
+  fiat_p1024_addcarryx_u64(&x544, &x545, x543, x535, x532);
+  fiat_p1024_addcarryx_u64(&x546, &x547, x545, x533, x530);
+  fiat_p1024_addcarryx_u64(&x548, &x549, x547, x531, x528);
+  fiat_p1024_addcarryx_u64(&x550, &x551, x549, x529, x526);
+  fiat_p1024_addcarryx_u64(&x552, &x553, x551, x527, x524);
+  fiat_p1024_addcarryx_u64(&x554, &x555, x553, x525, x522);
+  fiat_p1024_addcarryx_u64(&x556, &x557, x555, x523, x520);
+  fiat_p1024_addcarryx_u64(&x558, &x559, x557, x521, x518);
+  fiat_p1024_addcarryx_u64(&x560, &x561, x559, x519, x516);
+  fiat_p1024_addcarryx_u64(&x562, &x563, x561, x517, x514);
+  fiat_p1024_addcarryx_u64(&x564, &x565, x563, x515, x512);
+  fiat_p1024_addcarryx_u64(&x566, &x567, x565, x513, x510);
+  fiat_p1024_addcarryx_u64(&x568, &x569, x567, x511, x508);
+  fiat_p1024_addcarryx_u64(&x570, &x571, 0x0, x476, x538);
+  fiat_p1024_addcarryx_u64(&x572, &x573, x571, x478, x540);
+  fiat_p1024_addcarryx_u64(&x574, &x575, x573, x480, x542);
+  fiat_p1024_addcarryx_u64(&x576, &x577, x575, x482, x544);
+  fiat_p1024_addcarryx_u64(&x578, &x579, x577, x484, x546);
+  fiat_p1024_addcarryx_u64(&x580, &x581, x579, x486, x548);
+  fiat_p1024_addcarryx_u64(&x582, &x583, x581, x488, x550);
+  fiat_p1024_addcarryx_u64(&x584, &x585, x583, x490, x552);
+  fiat_p1024_addcarryx_u64(&x586, &x587, x585, x492, x554);
+  fiat_p1024_addcarryx_u64(&x588, &x589, x587, x494, x556);
+  fiat_p1024_addcarryx_u64(&x590, &x591, x589, x496, x558);
+  fiat_p1024_addcarryx_u64(&x592, &x593, x591, x498, x560);
+  fiat_p1024_addcarryx_u64(&x594, &x595, x593, x500, x562);
+  fiat_p1024_addcarryx_u64(&x596, &x597, x595, x502, x564);
+  fiat_p1024_addcarryx_u64(&x598, &x599, x597, x504, x566);
+  fiat_p1024_addcarryx_u64(&x600, &x601, x599, (x505 + (x475 + (x443 + x383))), x568);
+  fiat_p1024_addcarryx_u64(&x602, &x603, 0x0, x572, (arg1[5]));
+  fiat_p1024_addcarryx_u64(&x604, &x605, x603, x574, 0x0);
+  fiat_p1024_addcarryx_u64(&x606, &x607, x605, x576, 0x0);
+  fiat_p1024_addcarryx_u64(&x608, &x609, x607, x578, 0x0);
+  fiat_p1024_addcarryx_u64(&x610, &x611, x609, x580, 0x0);
+  fiat_p1024_addcarryx_u64(&x612, &x613, x611, x582, 0x0);
+  fiat_p1024_addcarryx_u64(&x614, &x615, x613, x584, 0x0);
+  fiat_p1024_addcarryx_u64(&x616, &x617, x615, x586, 0x0);
+  fiat_p1024_addcarryx_u64(&x618, &x619, x617, x588, 0x0);
+  fiat_p1024_addcarryx_u64(&x620, &x621, x619, x590, 0x0);
+  fiat_p1024_addcarryx_u64(&x622, &x623, x621, x592, 0x0);
+  fiat_p1024_addcarryx_u64(&x624, &x625, x623, x594, 0x0);
+  fiat_p1024_addcarryx_u64(&x626, &x627, x625, x596, 0x0);
+  fiat_p1024_addcarryx_u64(&x628, &x629, x627, x598, 0x0);
+  fiat_p1024_addcarryx_u64(&x630, &x631, x629, x600, 0x0);
+  fiat_p1024_mulx_u64(&x632, &x633, x602, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x634, &x635, x632, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x636, &x637, x632, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x638, &x639, x632, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x640, &x641, x632, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x642, &x643, x632, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x644, &x645, x632, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x646, &x647, x632, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x648, &x649, x632, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x650, &x651, x632, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x652, &x653, x632, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x654, &x655, x632, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x656, &x657, x632, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x658, &x659, x632, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x660, &x661, x632, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x662, &x663, x632, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x664, &x665, x632, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x666, &x667, 0x0, x665, x662);
+  fiat_p1024_addcarryx_u64(&x668, &x669, x667, x663, x660);
+  fiat_p1024_addcarryx_u64(&x670, &x671, x669, x661, x658);
+  fiat_p1024_addcarryx_u64(&x672, &x673, x671, x659, x656);
+  fiat_p1024_addcarryx_u64(&x674, &x675, x673, x657, x654);
+  fiat_p1024_addcarryx_u64(&x676, &x677, x675, x655, x652);
+  fiat_p1024_addcarryx_u64(&x678, &x679, x677, x653, x650);
+  fiat_p1024_addcarryx_u64(&x680, &x681, x679, x651, x648);
+  fiat_p1024_addcarryx_u64(&x682, &x683, x681, x649, x646);
+  fiat_p1024_addcarryx_u64(&x684, &x685, x683, x647, x644);
+  fiat_p1024_addcarryx_u64(&x686, &x687, x685, x645, x642);
+  fiat_p1024_addcarryx_u64(&x688, &x689, x687, x643, x640);
+  fiat_p1024_addcarryx_u64(&x690, &x691, x689, x641, x638);
+  fiat_p1024_addcarryx_u64(&x692, &x693, x691, x639, x636);
+  fiat_p1024_addcarryx_u64(&x694, &x695, x693, x637, x634);
+  fiat_p1024_addcarryx_u64(&x696, &x697, 0x0, x602, x664);
+  fiat_p1024_addcarryx_u64(&x698, &x699, x697, x604, x666);
+  fiat_p1024_addcarryx_u64(&x700, &x701, x699, x606, x668);
+  fiat_p1024_addcarryx_u64(&x702, &x703, x701, x608, x670);
+  fiat_p1024_addcarryx_u64(&x704, &x705, x703, x610, x672);
+  fiat_p1024_addcarryx_u64(&x706, &x707, x705, x612, x674);
+  fiat_p1024_addcarryx_u64(&x708, &x709, x707, x614, x676);
+  fiat_p1024_addcarryx_u64(&x710, &x711, x709, x616, x678);
+  fiat_p1024_addcarryx_u64(&x712, &x713, x711, x618, x680);
+  fiat_p1024_addcarryx_u64(&x714, &x715, x713, x620, x682);
+  fiat_p1024_addcarryx_u64(&x716, &x717, x715, x622, x684);
+  fiat_p1024_addcarryx_u64(&x718, &x719, x717, x624, x686);
+  fiat_p1024_addcarryx_u64(&x720, &x721, x719, x626, x688);
+  fiat_p1024_addcarryx_u64(&x722, &x723, x721, x628, x690);
+  fiat_p1024_addcarryx_u64(&x724, &x725, x723, x630, x692);
+  fiat_p1024_addcarryx_u64(&x726, &x727, x725, (x631 + (x601 + (x569 + x509))), x694);
+  fiat_p1024_addcarryx_u64(&x728, &x729, 0x0, x698, (arg1[6]));
+  fiat_p1024_addcarryx_u64(&x730, &x731, x729, x700, 0x0);
+  fiat_p1024_addcarryx_u64(&x732, &x733, x731, x702, 0x0);
+  fiat_p1024_addcarryx_u64(&x734, &x735, x733, x704, 0x0);
+  fiat_p1024_addcarryx_u64(&x736, &x737, x735, x706, 0x0);
+  fiat_p1024_addcarryx_u64(&x738, &x739, x737, x708, 0x0);
+  fiat_p1024_addcarryx_u64(&x740, &x741, x739, x710, 0x0);
+  fiat_p1024_addcarryx_u64(&x742, &x743, x741, x712, 0x0);
+  fiat_p1024_addcarryx_u64(&x744, &x745, x743, x714, 0x0);
+  fiat_p1024_addcarryx_u64(&x746, &x747, x745, x716, 0x0);
+  fiat_p1024_addcarryx_u64(&x748, &x749, x747, x718, 0x0);
+  fiat_p1024_addcarryx_u64(&x750, &x751, x749, x720, 0x0);
+  fiat_p1024_addcarryx_u64(&x752, &x753, x751, x722, 0x0);
+  fiat_p1024_addcarryx_u64(&x754, &x755, x753, x724, 0x0);
+  fiat_p1024_addcarryx_u64(&x756, &x757, x755, x726, 0x0);
+  fiat_p1024_mulx_u64(&x758, &x759, x728, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x760, &x761, x758, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x762, &x763, x758, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x764, &x765, x758, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x766, &x767, x758, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x768, &x769, x758, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x770, &x771, x758, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x772, &x773, x758, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x774, &x775, x758, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x776, &x777, x758, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x778, &x779, x758, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x780, &x781, x758, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x782, &x783, x758, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x784, &x785, x758, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x786, &x787, x758, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x788, &x789, x758, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x790, &x791, x758, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x792, &x793, 0x0, x791, x788);
+  fiat_p1024_addcarryx_u64(&x794, &x795, x793, x789, x786);
+  fiat_p1024_addcarryx_u64(&x796, &x797, x795, x787, x784);
+  fiat_p1024_addcarryx_u64(&x798, &x799, x797, x785, x782);
+  fiat_p1024_addcarryx_u64(&x800, &x801, x799, x783, x780);
+  fiat_p1024_addcarryx_u64(&x802, &x803, x801, x781, x778);
+  fiat_p1024_addcarryx_u64(&x804, &x805, x803, x779, x776);
+  fiat_p1024_addcarryx_u64(&x806, &x807, x805, x777, x774);
+  fiat_p1024_addcarryx_u64(&x808, &x809, x807, x775, x772);
+  fiat_p1024_addcarryx_u64(&x810, &x811, x809, x773, x770);
+  fiat_p1024_addcarryx_u64(&x812, &x813, x811, x771, x768);
+  fiat_p1024_addcarryx_u64(&x814, &x815, x813, x769, x766);
+  fiat_p1024_addcarryx_u64(&x816, &x817, x815, x767, x764);
+  fiat_p1024_addcarryx_u64(&x818, &x819, x817, x765, x762);
+  fiat_p1024_addcarryx_u64(&x820, &x821, x819, x763, x760);
+  fiat_p1024_addcarryx_u64(&x822, &x823, 0x0, x728, x790);
+  fiat_p1024_addcarryx_u64(&x824, &x825, x823, x730, x792);
+  fiat_p1024_addcarryx_u64(&x826, &x827, x825, x732, x794);
+  fiat_p1024_addcarryx_u64(&x828, &x829, x827, x734, x796);
+  fiat_p1024_addcarryx_u64(&x830, &x831, x829, x736, x798);
+  fiat_p1024_addcarryx_u64(&x832, &x833, x831, x738, x800);
+  fiat_p1024_addcarryx_u64(&x834, &x835, x833, x740, x802);
+  fiat_p1024_addcarryx_u64(&x836, &x837, x835, x742, x804);
+  fiat_p1024_addcarryx_u64(&x838, &x839, x837, x744, x806);
+  fiat_p1024_addcarryx_u64(&x840, &x841, x839, x746, x808);
+  fiat_p1024_addcarryx_u64(&x842, &x843, x841, x748, x810);
+  fiat_p1024_addcarryx_u64(&x844, &x845, x843, x750, x812);
+  fiat_p1024_addcarryx_u64(&x846, &x847, x845, x752, x814);
+  fiat_p1024_addcarryx_u64(&x848, &x849, x847, x754, x816);
+  fiat_p1024_addcarryx_u64(&x850, &x851, x849, x756, x818);
+  fiat_p1024_addcarryx_u64(&x852, &x853, x851, (x757 + (x727 + (x695 + x635))), x820);
+  fiat_p1024_addcarryx_u64(&x854, &x855, 0x0, x824, (arg1[7]));
+  fiat_p1024_addcarryx_u64(&x856, &x857, x855, x826, 0x0);
+  fiat_p1024_addcarryx_u64(&x858, &x859, x857, x828, 0x0);
+  fiat_p1024_addcarryx_u64(&x860, &x861, x859, x830, 0x0);
+  fiat_p1024_addcarryx_u64(&x862, &x863, x861, x832, 0x0);
+  fiat_p1024_addcarryx_u64(&x864, &x865, x863, x834, 0x0);
+  fiat_p1024_addcarryx_u64(&x866, &x867, x865, x836, 0x0);
+  fiat_p1024_addcarryx_u64(&x868, &x869, x867, x838, 0x0);
+  fiat_p1024_addcarryx_u64(&x870, &x871, x869, x840, 0x0);
+  fiat_p1024_addcarryx_u64(&x872, &x873, x871, x842, 0x0);
+  fiat_p1024_addcarryx_u64(&x874, &x875, x873, x844, 0x0);
+  fiat_p1024_addcarryx_u64(&x876, &x877, x875, x846, 0x0);
+  fiat_p1024_addcarryx_u64(&x878, &x879, x877, x848, 0x0);
+  fiat_p1024_addcarryx_u64(&x880, &x881, x879, x850, 0x0);
+  fiat_p1024_addcarryx_u64(&x882, &x883, x881, x852, 0x0);
+  fiat_p1024_mulx_u64(&x884, &x885, x854, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x886, &x887, x884, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x888, &x889, x884, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x890, &x891, x884, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x892, &x893, x884, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x894, &x895, x884, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x896, &x897, x884, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x898, &x899, x884, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x900, &x901, x884, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x902, &x903, x884, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x904, &x905, x884, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x906, &x907, x884, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x908, &x909, x884, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x910, &x911, x884, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x912, &x913, x884, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x914, &x915, x884, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x916, &x917, x884, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x918, &x919, 0x0, x917, x914);
+  fiat_p1024_addcarryx_u64(&x920, &x921, x919, x915, x912);
+  fiat_p1024_addcarryx_u64(&x922, &x923, x921, x913, x910);
+  fiat_p1024_addcarryx_u64(&x924, &x925, x923, x911, x908);
+  fiat_p1024_addcarryx_u64(&x926, &x927, x925, x909, x906);
+  fiat_p1024_addcarryx_u64(&x928, &x929, x927, x907, x904);
+  fiat_p1024_addcarryx_u64(&x930, &x931, x929, x905, x902);
+  fiat_p1024_addcarryx_u64(&x932, &x933, x931, x903, x900);
+  fiat_p1024_addcarryx_u64(&x934, &x935, x933, x901, x898);
+  fiat_p1024_addcarryx_u64(&x936, &x937, x935, x899, x896);
+  fiat_p1024_addcarryx_u64(&x938, &x939, x937, x897, x894);
+  fiat_p1024_addcarryx_u64(&x940, &x941, x939, x895, x892);
+  fiat_p1024_addcarryx_u64(&x942, &x943, x941, x893, x890);
+  fiat_p1024_addcarryx_u64(&x944, &x945, x943, x891, x888);
+  fiat_p1024_addcarryx_u64(&x946, &x947, x945, x889, x886);
+  fiat_p1024_addcarryx_u64(&x948, &x949, 0x0, x854, x916);
+  fiat_p1024_addcarryx_u64(&x950, &x951, x949, x856, x918);
+  fiat_p1024_addcarryx_u64(&x952, &x953, x951, x858, x920);
+  fiat_p1024_addcarryx_u64(&x954, &x955, x953, x860, x922);
+  fiat_p1024_addcarryx_u64(&x956, &x957, x955, x862, x924);
+  fiat_p1024_addcarryx_u64(&x958, &x959, x957, x864, x926);
+  fiat_p1024_addcarryx_u64(&x960, &x961, x959, x866, x928);
+  fiat_p1024_addcarryx_u64(&x962, &x963, x961, x868, x930);
+  fiat_p1024_addcarryx_u64(&x964, &x965, x963, x870, x932);
+  fiat_p1024_addcarryx_u64(&x966, &x967, x965, x872, x934);
+  fiat_p1024_addcarryx_u64(&x968, &x969, x967, x874, x936);
+  fiat_p1024_addcarryx_u64(&x970, &x971, x969, x876, x938);
+  fiat_p1024_addcarryx_u64(&x972, &x973, x971, x878, x940);
+  fiat_p1024_addcarryx_u64(&x974, &x975, x973, x880, x942);
+  fiat_p1024_addcarryx_u64(&x976, &x977, x975, x882, x944);
+  fiat_p1024_addcarryx_u64(&x978, &x979, x977, (x883 + (x853 + (x821 + x761))), x946);
+  fiat_p1024_addcarryx_u64(&x980, &x981, 0x0, x950, (arg1[8]));
+  fiat_p1024_addcarryx_u64(&x982, &x983, x981, x952, 0x0);
+  fiat_p1024_addcarryx_u64(&x984, &x985, x983, x954, 0x0);
+  fiat_p1024_addcarryx_u64(&x986, &x987, x985, x956, 0x0);
+  fiat_p1024_addcarryx_u64(&x988, &x989, x987, x958, 0x0);
+  fiat_p1024_addcarryx_u64(&x990, &x991, x989, x960, 0x0);
+  fiat_p1024_addcarryx_u64(&x992, &x993, x991, x962, 0x0);
+  fiat_p1024_addcarryx_u64(&x994, &x995, x993, x964, 0x0);
+  fiat_p1024_addcarryx_u64(&x996, &x997, x995, x966, 0x0);
+  fiat_p1024_addcarryx_u64(&x998, &x999, x997, x968, 0x0);
+  fiat_p1024_addcarryx_u64(&x1000, &x1001, x999, x970, 0x0);
+  fiat_p1024_addcarryx_u64(&x1002, &x1003, x1001, x972, 0x0);
+  fiat_p1024_addcarryx_u64(&x1004, &x1005, x1003, x974, 0x0);
+  fiat_p1024_addcarryx_u64(&x1006, &x1007, x1005, x976, 0x0);
+  fiat_p1024_addcarryx_u64(&x1008, &x1009, x1007, x978, 0x0);
+  fiat_p1024_mulx_u64(&x1010, &x1011, x980, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x1012, &x1013, x1010, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x1014, &x1015, x1010, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x1016, &x1017, x1010, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x1018, &x1019, x1010, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x1020, &x1021, x1010, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x1022, &x1023, x1010, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x1024, &x1025, x1010, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x1026, &x1027, x1010, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x1028, &x1029, x1010, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x1030, &x1031, x1010, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x1032, &x1033, x1010, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x1034, &x1035, x1010, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x1036, &x1037, x1010, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x1038, &x1039, x1010, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x1040, &x1041, x1010, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x1042, &x1043, x1010, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x1044, &x1045, 0x0, x1043, x1040);
+  fiat_p1024_addcarryx_u64(&x1046, &x1047, x1045, x1041, x1038);
+  fiat_p1024_addcarryx_u64(&x1048, &x1049, x1047, x1039, x1036);
+  fiat_p1024_addcarryx_u64(&x1050, &x1051, x1049, x1037, x1034);
+  fiat_p1024_addcarryx_u64(&x1052, &x1053, x1051, x1035, x1032);
+  fiat_p1024_addcarryx_u64(&x1054, &x1055, x1053, x1033, x1030);
+  fiat_p1024_addcarryx_u64(&x1056, &x1057, x1055, x1031, x1028);
+  fiat_p1024_addcarryx_u64(&x1058, &x1059, x1057, x1029, x1026);
+  fiat_p1024_addcarryx_u64(&x1060, &x1061, x1059, x1027, x1024);
+  fiat_p1024_addcarryx_u64(&x1062, &x1063, x1061, x1025, x1022);
+  fiat_p1024_addcarryx_u64(&x1064, &x1065, x1063, x1023, x1020);
+  fiat_p1024_addcarryx_u64(&x1066, &x1067, x1065, x1021, x1018);
+  fiat_p1024_addcarryx_u64(&x1068, &x1069, x1067, x1019, x1016);
+  fiat_p1024_addcarryx_u64(&x1070, &x1071, x1069, x1017, x1014);
+  fiat_p1024_addcarryx_u64(&x1072, &x1073, x1071, x1015, x1012);
+  fiat_p1024_addcarryx_u64(&x1074, &x1075, 0x0, x980, x1042);
+  fiat_p1024_addcarryx_u64(&x1076, &x1077, x1075, x982, x1044);
+  fiat_p1024_addcarryx_u64(&x1078, &x1079, x1077, x984, x1046);
+  fiat_p1024_addcarryx_u64(&x1080, &x1081, x1079, x986, x1048);
+  fiat_p1024_addcarryx_u64(&x1082, &x1083, x1081, x988, x1050);
+  fiat_p1024_addcarryx_u64(&x1084, &x1085, x1083, x990, x1052);
+  fiat_p1024_addcarryx_u64(&x1086, &x1087, x1085, x992, x1054);
+  fiat_p1024_addcarryx_u64(&x1088, &x1089, x1087, x994, x1056);
+  fiat_p1024_addcarryx_u64(&x1090, &x1091, x1089, x996, x1058);
+  fiat_p1024_addcarryx_u64(&x1092, &x1093, x1091, x998, x1060);
+  fiat_p1024_addcarryx_u64(&x1094, &x1095, x1093, x1000, x1062);
+  fiat_p1024_addcarryx_u64(&x1096, &x1097, x1095, x1002, x1064);
+  fiat_p1024_addcarryx_u64(&x1098, &x1099, x1097, x1004, x1066);
+  fiat_p1024_addcarryx_u64(&x1100, &x1101, x1099, x1006, x1068);
+  fiat_p1024_addcarryx_u64(&x1102, &x1103, x1101, x1008, x1070);
+  fiat_p1024_addcarryx_u64(&x1104, &x1105, x1103, (x1009 + (x979 + (x947 + x887))), x1072);
+  fiat_p1024_addcarryx_u64(&x1106, &x1107, 0x0, x1076, (arg1[9]));
+  fiat_p1024_addcarryx_u64(&x1108, &x1109, x1107, x1078, 0x0);
+  fiat_p1024_addcarryx_u64(&x1110, &x1111, x1109, x1080, 0x0);
+  fiat_p1024_addcarryx_u64(&x1112, &x1113, x1111, x1082, 0x0);
+  fiat_p1024_addcarryx_u64(&x1114, &x1115, x1113, x1084, 0x0);
+  fiat_p1024_addcarryx_u64(&x1116, &x1117, x1115, x1086, 0x0);
+  fiat_p1024_addcarryx_u64(&x1118, &x1119, x1117, x1088, 0x0);
+  fiat_p1024_addcarryx_u64(&x1120, &x1121, x1119, x1090, 0x0);
+  fiat_p1024_addcarryx_u64(&x1122, &x1123, x1121, x1092, 0x0);
+  fiat_p1024_addcarryx_u64(&x1124, &x1125, x1123, x1094, 0x0);
+  fiat_p1024_addcarryx_u64(&x1126, &x1127, x1125, x1096, 0x0);
+  fiat_p1024_addcarryx_u64(&x1128, &x1129, x1127, x1098, 0x0);
+  fiat_p1024_addcarryx_u64(&x1130, &x1131, x1129, x1100, 0x0);
+  fiat_p1024_addcarryx_u64(&x1132, &x1133, x1131, x1102, 0x0);
+  fiat_p1024_addcarryx_u64(&x1134, &x1135, x1133, x1104, 0x0);
+  fiat_p1024_mulx_u64(&x1136, &x1137, x1106, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x1138, &x1139, x1136, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x1140, &x1141, x1136, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x1142, &x1143, x1136, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x1144, &x1145, x1136, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x1146, &x1147, x1136, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x1148, &x1149, x1136, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x1150, &x1151, x1136, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x1152, &x1153, x1136, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x1154, &x1155, x1136, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x1156, &x1157, x1136, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x1158, &x1159, x1136, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x1160, &x1161, x1136, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x1162, &x1163, x1136, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x1164, &x1165, x1136, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x1166, &x1167, x1136, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x1168, &x1169, x1136, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x1170, &x1171, 0x0, x1169, x1166);
+  fiat_p1024_addcarryx_u64(&x1172, &x1173, x1171, x1167, x1164);
+  fiat_p1024_addcarryx_u64(&x1174, &x1175, x1173, x1165, x1162);
+  fiat_p1024_addcarryx_u64(&x1176, &x1177, x1175, x1163, x1160);
+  fiat_p1024_addcarryx_u64(&x1178, &x1179, x1177, x1161, x1158);
+  fiat_p1024_addcarryx_u64(&x1180, &x1181, x1179, x1159, x1156);
+  fiat_p1024_addcarryx_u64(&x1182, &x1183, x1181, x1157, x1154);
+  fiat_p1024_addcarryx_u64(&x1184, &x1185, x1183, x1155, x1152);
+  fiat_p1024_addcarryx_u64(&x1186, &x1187, x1185, x1153, x1150);
+  fiat_p1024_addcarryx_u64(&x1188, &x1189, x1187, x1151, x1148);
+  fiat_p1024_addcarryx_u64(&x1190, &x1191, x1189, x1149, x1146);
+  fiat_p1024_addcarryx_u64(&x1192, &x1193, x1191, x1147, x1144);
+  fiat_p1024_addcarryx_u64(&x1194, &x1195, x1193, x1145, x1142);
+  fiat_p1024_addcarryx_u64(&x1196, &x1197, x1195, x1143, x1140);
+  fiat_p1024_addcarryx_u64(&x1198, &x1199, x1197, x1141, x1138);
+  fiat_p1024_addcarryx_u64(&x1200, &x1201, 0x0, x1106, x1168);
+  fiat_p1024_addcarryx_u64(&x1202, &x1203, x1201, x1108, x1170);
+  fiat_p1024_addcarryx_u64(&x1204, &x1205, x1203, x1110, x1172);
+  fiat_p1024_addcarryx_u64(&x1206, &x1207, x1205, x1112, x1174);
+  fiat_p1024_addcarryx_u64(&x1208, &x1209, x1207, x1114, x1176);
+  fiat_p1024_addcarryx_u64(&x1210, &x1211, x1209, x1116, x1178);
+  fiat_p1024_addcarryx_u64(&x1212, &x1213, x1211, x1118, x1180);
+  fiat_p1024_addcarryx_u64(&x1214, &x1215, x1213, x1120, x1182);
+  fiat_p1024_addcarryx_u64(&x1216, &x1217, x1215, x1122, x1184);
+  fiat_p1024_addcarryx_u64(&x1218, &x1219, x1217, x1124, x1186);
+  fiat_p1024_addcarryx_u64(&x1220, &x1221, x1219, x1126, x1188);
+  fiat_p1024_addcarryx_u64(&x1222, &x1223, x1221, x1128, x1190);
+  fiat_p1024_addcarryx_u64(&x1224, &x1225, x1223, x1130, x1192);
+  fiat_p1024_addcarryx_u64(&x1226, &x1227, x1225, x1132, x1194);
+  fiat_p1024_addcarryx_u64(&x1228, &x1229, x1227, x1134, x1196);
+  fiat_p1024_addcarryx_u64(&x1230, &x1231, x1229, (x1135 + (x1105 + (x1073 + x1013))), x1198);
+  fiat_p1024_addcarryx_u64(&x1232, &x1233, 0x0, x1202, (arg1[10]));
+  fiat_p1024_addcarryx_u64(&x1234, &x1235, x1233, x1204, 0x0);
+  fiat_p1024_addcarryx_u64(&x1236, &x1237, x1235, x1206, 0x0);
+  fiat_p1024_addcarryx_u64(&x1238, &x1239, x1237, x1208, 0x0);
+  fiat_p1024_addcarryx_u64(&x1240, &x1241, x1239, x1210, 0x0);
+  fiat_p1024_addcarryx_u64(&x1242, &x1243, x1241, x1212, 0x0);
+  fiat_p1024_addcarryx_u64(&x1244, &x1245, x1243, x1214, 0x0);
+  fiat_p1024_addcarryx_u64(&x1246, &x1247, x1245, x1216, 0x0);
+  fiat_p1024_addcarryx_u64(&x1248, &x1249, x1247, x1218, 0x0);
+  fiat_p1024_addcarryx_u64(&x1250, &x1251, x1249, x1220, 0x0);
+  fiat_p1024_addcarryx_u64(&x1252, &x1253, x1251, x1222, 0x0);
+  fiat_p1024_addcarryx_u64(&x1254, &x1255, x1253, x1224, 0x0);
+  fiat_p1024_addcarryx_u64(&x1256, &x1257, x1255, x1226, 0x0);
+  fiat_p1024_addcarryx_u64(&x1258, &x1259, x1257, x1228, 0x0);
+  fiat_p1024_addcarryx_u64(&x1260, &x1261, x1259, x1230, 0x0);
+  fiat_p1024_mulx_u64(&x1262, &x1263, x1232, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x1264, &x1265, x1262, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x1266, &x1267, x1262, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x1268, &x1269, x1262, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x1270, &x1271, x1262, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x1272, &x1273, x1262, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x1274, &x1275, x1262, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x1276, &x1277, x1262, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x1278, &x1279, x1262, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x1280, &x1281, x1262, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x1282, &x1283, x1262, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x1284, &x1285, x1262, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x1286, &x1287, x1262, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x1288, &x1289, x1262, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x1290, &x1291, x1262, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x1292, &x1293, x1262, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x1294, &x1295, x1262, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x1296, &x1297, 0x0, x1295, x1292);
+  fiat_p1024_addcarryx_u64(&x1298, &x1299, x1297, x1293, x1290);
+  fiat_p1024_addcarryx_u64(&x1300, &x1301, x1299, x1291, x1288);
+  fiat_p1024_addcarryx_u64(&x1302, &x1303, x1301, x1289, x1286);
+  fiat_p1024_addcarryx_u64(&x1304, &x1305, x1303, x1287, x1284);
+  fiat_p1024_addcarryx_u64(&x1306, &x1307, x1305, x1285, x1282);
+  fiat_p1024_addcarryx_u64(&x1308, &x1309, x1307, x1283, x1280);
+  fiat_p1024_addcarryx_u64(&x1310, &x1311, x1309, x1281, x1278);
+  fiat_p1024_addcarryx_u64(&x1312, &x1313, x1311, x1279, x1276);
+  fiat_p1024_addcarryx_u64(&x1314, &x1315, x1313, x1277, x1274);
+  fiat_p1024_addcarryx_u64(&x1316, &x1317, x1315, x1275, x1272);
+  fiat_p1024_addcarryx_u64(&x1318, &x1319, x1317, x1273, x1270);
+  fiat_p1024_addcarryx_u64(&x1320, &x1321, x1319, x1271, x1268);
+  fiat_p1024_addcarryx_u64(&x1322, &x1323, x1321, x1269, x1266);
+  fiat_p1024_addcarryx_u64(&x1324, &x1325, x1323, x1267, x1264);
+  fiat_p1024_addcarryx_u64(&x1326, &x1327, 0x0, x1232, x1294);
+  fiat_p1024_addcarryx_u64(&x1328, &x1329, x1327, x1234, x1296);
+  fiat_p1024_addcarryx_u64(&x1330, &x1331, x1329, x1236, x1298);
+  fiat_p1024_addcarryx_u64(&x1332, &x1333, x1331, x1238, x1300);
+  fiat_p1024_addcarryx_u64(&x1334, &x1335, x1333, x1240, x1302);
+  fiat_p1024_addcarryx_u64(&x1336, &x1337, x1335, x1242, x1304);
+  fiat_p1024_addcarryx_u64(&x1338, &x1339, x1337, x1244, x1306);
+  fiat_p1024_addcarryx_u64(&x1340, &x1341, x1339, x1246, x1308);
+  fiat_p1024_addcarryx_u64(&x1342, &x1343, x1341, x1248, x1310);
+  fiat_p1024_addcarryx_u64(&x1344, &x1345, x1343, x1250, x1312);
+  fiat_p1024_addcarryx_u64(&x1346, &x1347, x1345, x1252, x1314);
+  fiat_p1024_addcarryx_u64(&x1348, &x1349, x1347, x1254, x1316);
+  fiat_p1024_addcarryx_u64(&x1350, &x1351, x1349, x1256, x1318);
+  fiat_p1024_addcarryx_u64(&x1352, &x1353, x1351, x1258, x1320);
+  fiat_p1024_addcarryx_u64(&x1354, &x1355, x1353, x1260, x1322);
+  fiat_p1024_addcarryx_u64(&x1356, &x1357, x1355, (x1261 + (x1231 + (x1199 + x1139))), x1324);
+  fiat_p1024_addcarryx_u64(&x1358, &x1359, 0x0, x1328, (arg1[11]));
+  fiat_p1024_addcarryx_u64(&x1360, &x1361, x1359, x1330, 0x0);
+  fiat_p1024_addcarryx_u64(&x1362, &x1363, x1361, x1332, 0x0);
+  fiat_p1024_addcarryx_u64(&x1364, &x1365, x1363, x1334, 0x0);
+  fiat_p1024_addcarryx_u64(&x1366, &x1367, x1365, x1336, 0x0);
+  fiat_p1024_addcarryx_u64(&x1368, &x1369, x1367, x1338, 0x0);
+  fiat_p1024_addcarryx_u64(&x1370, &x1371, x1369, x1340, 0x0);
+  fiat_p1024_addcarryx_u64(&x1372, &x1373, x1371, x1342, 0x0);
+  fiat_p1024_addcarryx_u64(&x1374, &x1375, x1373, x1344, 0x0);
+  fiat_p1024_addcarryx_u64(&x1376, &x1377, x1375, x1346, 0x0);
+  fiat_p1024_addcarryx_u64(&x1378, &x1379, x1377, x1348, 0x0);
+  fiat_p1024_addcarryx_u64(&x1380, &x1381, x1379, x1350, 0x0);
+  fiat_p1024_addcarryx_u64(&x1382, &x1383, x1381, x1352, 0x0);
+  fiat_p1024_addcarryx_u64(&x1384, &x1385, x1383, x1354, 0x0);
+  fiat_p1024_addcarryx_u64(&x1386, &x1387, x1385, x1356, 0x0);
+  fiat_p1024_mulx_u64(&x1388, &x1389, x1358, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x1390, &x1391, x1388, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x1392, &x1393, x1388, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x1394, &x1395, x1388, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x1396, &x1397, x1388, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x1398, &x1399, x1388, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x1400, &x1401, x1388, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x1402, &x1403, x1388, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x1404, &x1405, x1388, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x1406, &x1407, x1388, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x1408, &x1409, x1388, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x1410, &x1411, x1388, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x1412, &x1413, x1388, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x1414, &x1415, x1388, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x1416, &x1417, x1388, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x1418, &x1419, x1388, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x1420, &x1421, x1388, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x1422, &x1423, 0x0, x1421, x1418);
+  fiat_p1024_addcarryx_u64(&x1424, &x1425, x1423, x1419, x1416);
+  fiat_p1024_addcarryx_u64(&x1426, &x1427, x1425, x1417, x1414);
+  fiat_p1024_addcarryx_u64(&x1428, &x1429, x1427, x1415, x1412);
+  fiat_p1024_addcarryx_u64(&x1430, &x1431, x1429, x1413, x1410);
+  fiat_p1024_addcarryx_u64(&x1432, &x1433, x1431, x1411, x1408);
+  fiat_p1024_addcarryx_u64(&x1434, &x1435, x1433, x1409, x1406);
+  fiat_p1024_addcarryx_u64(&x1436, &x1437, x1435, x1407, x1404);
+  fiat_p1024_addcarryx_u64(&x1438, &x1439, x1437, x1405, x1402);
+  fiat_p1024_addcarryx_u64(&x1440, &x1441, x1439, x1403, x1400);
+  fiat_p1024_addcarryx_u64(&x1442, &x1443, x1441, x1401, x1398);
+  fiat_p1024_addcarryx_u64(&x1444, &x1445, x1443, x1399, x1396);
+  fiat_p1024_addcarryx_u64(&x1446, &x1447, x1445, x1397, x1394);
+  fiat_p1024_addcarryx_u64(&x1448, &x1449, x1447, x1395, x1392);
+  fiat_p1024_addcarryx_u64(&x1450, &x1451, x1449, x1393, x1390);
+  fiat_p1024_addcarryx_u64(&x1452, &x1453, 0x0, x1358, x1420);
+  fiat_p1024_addcarryx_u64(&x1454, &x1455, x1453, x1360, x1422);
+  fiat_p1024_addcarryx_u64(&x1456, &x1457, x1455, x1362, x1424);
+  fiat_p1024_addcarryx_u64(&x1458, &x1459, x1457, x1364, x1426);
+  fiat_p1024_addcarryx_u64(&x1460, &x1461, x1459, x1366, x1428);
+  fiat_p1024_addcarryx_u64(&x1462, &x1463, x1461, x1368, x1430);
+  fiat_p1024_addcarryx_u64(&x1464, &x1465, x1463, x1370, x1432);
+  fiat_p1024_addcarryx_u64(&x1466, &x1467, x1465, x1372, x1434);
+  fiat_p1024_addcarryx_u64(&x1468, &x1469, x1467, x1374, x1436);
+  fiat_p1024_addcarryx_u64(&x1470, &x1471, x1469, x1376, x1438);
+  fiat_p1024_addcarryx_u64(&x1472, &x1473, x1471, x1378, x1440);
+  fiat_p1024_addcarryx_u64(&x1474, &x1475, x1473, x1380, x1442);
+  fiat_p1024_addcarryx_u64(&x1476, &x1477, x1475, x1382, x1444);
+  fiat_p1024_addcarryx_u64(&x1478, &x1479, x1477, x1384, x1446);
+  fiat_p1024_addcarryx_u64(&x1480, &x1481, x1479, x1386, x1448);
+  fiat_p1024_addcarryx_u64(&x1482, &x1483, x1481, (x1387 + (x1357 + (x1325 + x1265))), x1450);
+  fiat_p1024_addcarryx_u64(&x1484, &x1485, 0x0, x1454, (arg1[12]));
+  fiat_p1024_addcarryx_u64(&x1486, &x1487, x1485, x1456, 0x0);
+  fiat_p1024_addcarryx_u64(&x1488, &x1489, x1487, x1458, 0x0);
+  fiat_p1024_addcarryx_u64(&x1490, &x1491, x1489, x1460, 0x0);
+  fiat_p1024_addcarryx_u64(&x1492, &x1493, x1491, x1462, 0x0);
+  fiat_p1024_addcarryx_u64(&x1494, &x1495, x1493, x1464, 0x0);
+  fiat_p1024_addcarryx_u64(&x1496, &x1497, x1495, x1466, 0x0);
+  fiat_p1024_addcarryx_u64(&x1498, &x1499, x1497, x1468, 0x0);
+  fiat_p1024_addcarryx_u64(&x1500, &x1501, x1499, x1470, 0x0);
+  fiat_p1024_addcarryx_u64(&x1502, &x1503, x1501, x1472, 0x0);
+  fiat_p1024_addcarryx_u64(&x1504, &x1505, x1503, x1474, 0x0);
+  fiat_p1024_addcarryx_u64(&x1506, &x1507, x1505, x1476, 0x0);
+  fiat_p1024_addcarryx_u64(&x1508, &x1509, x1507, x1478, 0x0);
+  fiat_p1024_addcarryx_u64(&x1510, &x1511, x1509, x1480, 0x0);
+  fiat_p1024_addcarryx_u64(&x1512, &x1513, x1511, x1482, 0x0);
+  fiat_p1024_mulx_u64(&x1514, &x1515, x1484, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x1516, &x1517, x1514, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x1518, &x1519, x1514, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x1520, &x1521, x1514, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x1522, &x1523, x1514, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x1524, &x1525, x1514, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x1526, &x1527, x1514, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x1528, &x1529, x1514, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x1530, &x1531, x1514, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x1532, &x1533, x1514, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x1534, &x1535, x1514, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x1536, &x1537, x1514, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x1538, &x1539, x1514, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x1540, &x1541, x1514, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x1542, &x1543, x1514, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x1544, &x1545, x1514, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x1546, &x1547, x1514, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x1548, &x1549, 0x0, x1547, x1544);
+  fiat_p1024_addcarryx_u64(&x1550, &x1551, x1549, x1545, x1542);
+  fiat_p1024_addcarryx_u64(&x1552, &x1553, x1551, x1543, x1540);
+  fiat_p1024_addcarryx_u64(&x1554, &x1555, x1553, x1541, x1538);
+  fiat_p1024_addcarryx_u64(&x1556, &x1557, x1555, x1539, x1536);
+  fiat_p1024_addcarryx_u64(&x1558, &x1559, x1557, x1537, x1534);
+  fiat_p1024_addcarryx_u64(&x1560, &x1561, x1559, x1535, x1532);
+  fiat_p1024_addcarryx_u64(&x1562, &x1563, x1561, x1533, x1530);
+  fiat_p1024_addcarryx_u64(&x1564, &x1565, x1563, x1531, x1528);
+  fiat_p1024_addcarryx_u64(&x1566, &x1567, x1565, x1529, x1526);
+  fiat_p1024_addcarryx_u64(&x1568, &x1569, x1567, x1527, x1524);
+  fiat_p1024_addcarryx_u64(&x1570, &x1571, x1569, x1525, x1522);
+  fiat_p1024_addcarryx_u64(&x1572, &x1573, x1571, x1523, x1520);
+  fiat_p1024_addcarryx_u64(&x1574, &x1575, x1573, x1521, x1518);
+  fiat_p1024_addcarryx_u64(&x1576, &x1577, x1575, x1519, x1516);
+  fiat_p1024_addcarryx_u64(&x1578, &x1579, 0x0, x1484, x1546);
+  fiat_p1024_addcarryx_u64(&x1580, &x1581, x1579, x1486, x1548);
+  fiat_p1024_addcarryx_u64(&x1582, &x1583, x1581, x1488, x1550);
+  fiat_p1024_addcarryx_u64(&x1584, &x1585, x1583, x1490, x1552);
+  fiat_p1024_addcarryx_u64(&x1586, &x1587, x1585, x1492, x1554);
+  fiat_p1024_addcarryx_u64(&x1588, &x1589, x1587, x1494, x1556);
+  fiat_p1024_addcarryx_u64(&x1590, &x1591, x1589, x1496, x1558);
+  fiat_p1024_addcarryx_u64(&x1592, &x1593, x1591, x1498, x1560);
+  fiat_p1024_addcarryx_u64(&x1594, &x1595, x1593, x1500, x1562);
+  fiat_p1024_addcarryx_u64(&x1596, &x1597, x1595, x1502, x1564);
+  fiat_p1024_addcarryx_u64(&x1598, &x1599, x1597, x1504, x1566);
+  fiat_p1024_addcarryx_u64(&x1600, &x1601, x1599, x1506, x1568);
+  fiat_p1024_addcarryx_u64(&x1602, &x1603, x1601, x1508, x1570);
+  fiat_p1024_addcarryx_u64(&x1604, &x1605, x1603, x1510, x1572);
+  fiat_p1024_addcarryx_u64(&x1606, &x1607, x1605, x1512, x1574);
+  fiat_p1024_addcarryx_u64(&x1608, &x1609, x1607, (x1513 + (x1483 + (x1451 + x1391))), x1576);
+  fiat_p1024_addcarryx_u64(&x1610, &x1611, 0x0, x1580, (arg1[13]));
+  fiat_p1024_addcarryx_u64(&x1612, &x1613, x1611, x1582, 0x0);
+  fiat_p1024_addcarryx_u64(&x1614, &x1615, x1613, x1584, 0x0);
+  fiat_p1024_addcarryx_u64(&x1616, &x1617, x1615, x1586, 0x0);
+  fiat_p1024_addcarryx_u64(&x1618, &x1619, x1617, x1588, 0x0);
+  fiat_p1024_addcarryx_u64(&x1620, &x1621, x1619, x1590, 0x0);
+  fiat_p1024_addcarryx_u64(&x1622, &x1623, x1621, x1592, 0x0);
+  fiat_p1024_addcarryx_u64(&x1624, &x1625, x1623, x1594, 0x0);
+  fiat_p1024_addcarryx_u64(&x1626, &x1627, x1625, x1596, 0x0);
+  fiat_p1024_addcarryx_u64(&x1628, &x1629, x1627, x1598, 0x0);
+  fiat_p1024_addcarryx_u64(&x1630, &x1631, x1629, x1600, 0x0);
+  fiat_p1024_addcarryx_u64(&x1632, &x1633, x1631, x1602, 0x0);
+  fiat_p1024_addcarryx_u64(&x1634, &x1635, x1633, x1604, 0x0);
+  fiat_p1024_addcarryx_u64(&x1636, &x1637, x1635, x1606, 0x0);
+  fiat_p1024_addcarryx_u64(&x1638, &x1639, x1637, x1608, 0x0);
+  fiat_p1024_mulx_u64(&x1640, &x1641, x1610, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x1642, &x1643, x1640, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x1644, &x1645, x1640, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x1646, &x1647, x1640, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x1648, &x1649, x1640, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x1650, &x1651, x1640, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x1652, &x1653, x1640, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x1654, &x1655, x1640, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x1656, &x1657, x1640, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x1658, &x1659, x1640, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x1660, &x1661, x1640, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x1662, &x1663, x1640, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x1664, &x1665, x1640, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x1666, &x1667, x1640, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x1668, &x1669, x1640, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x1670, &x1671, x1640, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x1672, &x1673, x1640, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x1674, &x1675, 0x0, x1673, x1670);
+  fiat_p1024_addcarryx_u64(&x1676, &x1677, x1675, x1671, x1668);
+  fiat_p1024_addcarryx_u64(&x1678, &x1679, x1677, x1669, x1666);
+  fiat_p1024_addcarryx_u64(&x1680, &x1681, x1679, x1667, x1664);
+  fiat_p1024_addcarryx_u64(&x1682, &x1683, x1681, x1665, x1662);
+  fiat_p1024_addcarryx_u64(&x1684, &x1685, x1683, x1663, x1660);
+  fiat_p1024_addcarryx_u64(&x1686, &x1687, x1685, x1661, x1658);
+  fiat_p1024_addcarryx_u64(&x1688, &x1689, x1687, x1659, x1656);
+  fiat_p1024_addcarryx_u64(&x1690, &x1691, x1689, x1657, x1654);
+  fiat_p1024_addcarryx_u64(&x1692, &x1693, x1691, x1655, x1652);
+  fiat_p1024_addcarryx_u64(&x1694, &x1695, x1693, x1653, x1650);
+  fiat_p1024_addcarryx_u64(&x1696, &x1697, x1695, x1651, x1648);
+  fiat_p1024_addcarryx_u64(&x1698, &x1699, x1697, x1649, x1646);
+  fiat_p1024_addcarryx_u64(&x1700, &x1701, x1699, x1647, x1644);
+  fiat_p1024_addcarryx_u64(&x1702, &x1703, x1701, x1645, x1642);
+  fiat_p1024_addcarryx_u64(&x1704, &x1705, 0x0, x1610, x1672);
+  fiat_p1024_addcarryx_u64(&x1706, &x1707, x1705, x1612, x1674);
+  fiat_p1024_addcarryx_u64(&x1708, &x1709, x1707, x1614, x1676);
+  fiat_p1024_addcarryx_u64(&x1710, &x1711, x1709, x1616, x1678);
+  fiat_p1024_addcarryx_u64(&x1712, &x1713, x1711, x1618, x1680);
+  fiat_p1024_addcarryx_u64(&x1714, &x1715, x1713, x1620, x1682);
+  fiat_p1024_addcarryx_u64(&x1716, &x1717, x1715, x1622, x1684);
+  fiat_p1024_addcarryx_u64(&x1718, &x1719, x1717, x1624, x1686);
+  fiat_p1024_addcarryx_u64(&x1720, &x1721, x1719, x1626, x1688);
+  fiat_p1024_addcarryx_u64(&x1722, &x1723, x1721, x1628, x1690);
+  fiat_p1024_addcarryx_u64(&x1724, &x1725, x1723, x1630, x1692);
+  fiat_p1024_addcarryx_u64(&x1726, &x1727, x1725, x1632, x1694);
+  fiat_p1024_addcarryx_u64(&x1728, &x1729, x1727, x1634, x1696);
+  fiat_p1024_addcarryx_u64(&x1730, &x1731, x1729, x1636, x1698);
+  fiat_p1024_addcarryx_u64(&x1732, &x1733, x1731, x1638, x1700);
+  fiat_p1024_addcarryx_u64(&x1734, &x1735, x1733, (x1639 + (x1609 + (x1577 + x1517))), x1702);
+  fiat_p1024_addcarryx_u64(&x1736, &x1737, 0x0, x1706, (arg1[14]));
+  fiat_p1024_addcarryx_u64(&x1738, &x1739, x1737, x1708, 0x0);
+  fiat_p1024_addcarryx_u64(&x1740, &x1741, x1739, x1710, 0x0);
+  fiat_p1024_addcarryx_u64(&x1742, &x1743, x1741, x1712, 0x0);
+  fiat_p1024_addcarryx_u64(&x1744, &x1745, x1743, x1714, 0x0);
+  fiat_p1024_addcarryx_u64(&x1746, &x1747, x1745, x1716, 0x0);
+  fiat_p1024_addcarryx_u64(&x1748, &x1749, x1747, x1718, 0x0);
+  fiat_p1024_addcarryx_u64(&x1750, &x1751, x1749, x1720, 0x0);
+  fiat_p1024_addcarryx_u64(&x1752, &x1753, x1751, x1722, 0x0);
+  fiat_p1024_addcarryx_u64(&x1754, &x1755, x1753, x1724, 0x0);
+  fiat_p1024_addcarryx_u64(&x1756, &x1757, x1755, x1726, 0x0);
+  fiat_p1024_addcarryx_u64(&x1758, &x1759, x1757, x1728, 0x0);
+  fiat_p1024_addcarryx_u64(&x1760, &x1761, x1759, x1730, 0x0);
+  fiat_p1024_addcarryx_u64(&x1762, &x1763, x1761, x1732, 0x0);
+  fiat_p1024_addcarryx_u64(&x1764, &x1765, x1763, x1734, 0x0);
+  fiat_p1024_mulx_u64(&x1766, &x1767, x1736, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x1768, &x1769, x1766, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x1770, &x1771, x1766, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x1772, &x1773, x1766, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x1774, &x1775, x1766, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x1776, &x1777, x1766, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x1778, &x1779, x1766, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x1780, &x1781, x1766, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x1782, &x1783, x1766, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x1784, &x1785, x1766, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x1786, &x1787, x1766, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x1788, &x1789, x1766, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x1790, &x1791, x1766, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x1792, &x1793, x1766, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x1794, &x1795, x1766, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x1796, &x1797, x1766, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x1798, &x1799, x1766, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x1800, &x1801, 0x0, x1799, x1796);
+  fiat_p1024_addcarryx_u64(&x1802, &x1803, x1801, x1797, x1794);
+  fiat_p1024_addcarryx_u64(&x1804, &x1805, x1803, x1795, x1792);
+  fiat_p1024_addcarryx_u64(&x1806, &x1807, x1805, x1793, x1790);
+  fiat_p1024_addcarryx_u64(&x1808, &x1809, x1807, x1791, x1788);
+  fiat_p1024_addcarryx_u64(&x1810, &x1811, x1809, x1789, x1786);
+  fiat_p1024_addcarryx_u64(&x1812, &x1813, x1811, x1787, x1784);
+  fiat_p1024_addcarryx_u64(&x1814, &x1815, x1813, x1785, x1782);
+  fiat_p1024_addcarryx_u64(&x1816, &x1817, x1815, x1783, x1780);
+  fiat_p1024_addcarryx_u64(&x1818, &x1819, x1817, x1781, x1778);
+  fiat_p1024_addcarryx_u64(&x1820, &x1821, x1819, x1779, x1776);
+  fiat_p1024_addcarryx_u64(&x1822, &x1823, x1821, x1777, x1774);
+  fiat_p1024_addcarryx_u64(&x1824, &x1825, x1823, x1775, x1772);
+  fiat_p1024_addcarryx_u64(&x1826, &x1827, x1825, x1773, x1770);
+  fiat_p1024_addcarryx_u64(&x1828, &x1829, x1827, x1771, x1768);
+  fiat_p1024_addcarryx_u64(&x1830, &x1831, 0x0, x1736, x1798);
+  fiat_p1024_addcarryx_u64(&x1832, &x1833, x1831, x1738, x1800);
+  fiat_p1024_addcarryx_u64(&x1834, &x1835, x1833, x1740, x1802);
+  fiat_p1024_addcarryx_u64(&x1836, &x1837, x1835, x1742, x1804);
+  fiat_p1024_addcarryx_u64(&x1838, &x1839, x1837, x1744, x1806);
+  fiat_p1024_addcarryx_u64(&x1840, &x1841, x1839, x1746, x1808);
+  fiat_p1024_addcarryx_u64(&x1842, &x1843, x1841, x1748, x1810);
+  fiat_p1024_addcarryx_u64(&x1844, &x1845, x1843, x1750, x1812);
+  fiat_p1024_addcarryx_u64(&x1846, &x1847, x1845, x1752, x1814);
+  fiat_p1024_addcarryx_u64(&x1848, &x1849, x1847, x1754, x1816);
+  fiat_p1024_addcarryx_u64(&x1850, &x1851, x1849, x1756, x1818);
+  fiat_p1024_addcarryx_u64(&x1852, &x1853, x1851, x1758, x1820);
+  fiat_p1024_addcarryx_u64(&x1854, &x1855, x1853, x1760, x1822);
+  fiat_p1024_addcarryx_u64(&x1856, &x1857, x1855, x1762, x1824);
+  fiat_p1024_addcarryx_u64(&x1858, &x1859, x1857, x1764, x1826);
+  fiat_p1024_addcarryx_u64(&x1860, &x1861, x1859, (x1765 + (x1735 + (x1703 + x1643))), x1828);
+  fiat_p1024_addcarryx_u64(&x1862, &x1863, 0x0, x1832, (arg1[15]));
+  fiat_p1024_addcarryx_u64(&x1864, &x1865, x1863, x1834, 0x0);
+  fiat_p1024_addcarryx_u64(&x1866, &x1867, x1865, x1836, 0x0);
+  fiat_p1024_addcarryx_u64(&x1868, &x1869, x1867, x1838, 0x0);
+  fiat_p1024_addcarryx_u64(&x1870, &x1871, x1869, x1840, 0x0);
+  fiat_p1024_addcarryx_u64(&x1872, &x1873, x1871, x1842, 0x0);
+  fiat_p1024_addcarryx_u64(&x1874, &x1875, x1873, x1844, 0x0);
+  fiat_p1024_addcarryx_u64(&x1876, &x1877, x1875, x1846, 0x0);
+  fiat_p1024_addcarryx_u64(&x1878, &x1879, x1877, x1848, 0x0);
+  fiat_p1024_addcarryx_u64(&x1880, &x1881, x1879, x1850, 0x0);
+  fiat_p1024_addcarryx_u64(&x1882, &x1883, x1881, x1852, 0x0);
+  fiat_p1024_addcarryx_u64(&x1884, &x1885, x1883, x1854, 0x0);
+  fiat_p1024_addcarryx_u64(&x1886, &x1887, x1885, x1856, 0x0);
+  fiat_p1024_addcarryx_u64(&x1888, &x1889, x1887, x1858, 0x0);
+  fiat_p1024_addcarryx_u64(&x1890, &x1891, x1889, x1860, 0x0);
+  fiat_p1024_mulx_u64(&x1892, &x1893, x1862, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x1894, &x1895, x1892, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x1896, &x1897, x1892, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x1898, &x1899, x1892, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x1900, &x1901, x1892, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x1902, &x1903, x1892, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x1904, &x1905, x1892, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x1906, &x1907, x1892, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x1908, &x1909, x1892, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x1910, &x1911, x1892, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x1912, &x1913, x1892, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x1914, &x1915, x1892, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x1916, &x1917, x1892, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x1918, &x1919, x1892, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x1920, &x1921, x1892, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x1922, &x1923, x1892, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x1924, &x1925, x1892, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x1926, &x1927, 0x0, x1925, x1922);
+  fiat_p1024_addcarryx_u64(&x1928, &x1929, x1927, x1923, x1920);
+  fiat_p1024_addcarryx_u64(&x1930, &x1931, x1929, x1921, x1918);
+  fiat_p1024_addcarryx_u64(&x1932, &x1933, x1931, x1919, x1916);
+  fiat_p1024_addcarryx_u64(&x1934, &x1935, x1933, x1917, x1914);
+  fiat_p1024_addcarryx_u64(&x1936, &x1937, x1935, x1915, x1912);
+  fiat_p1024_addcarryx_u64(&x1938, &x1939, x1937, x1913, x1910);
+  fiat_p1024_addcarryx_u64(&x1940, &x1941, x1939, x1911, x1908);
+  fiat_p1024_addcarryx_u64(&x1942, &x1943, x1941, x1909, x1906);
+  fiat_p1024_addcarryx_u64(&x1944, &x1945, x1943, x1907, x1904);
+  fiat_p1024_addcarryx_u64(&x1946, &x1947, x1945, x1905, x1902);
+  fiat_p1024_addcarryx_u64(&x1948, &x1949, x1947, x1903, x1900);
+  fiat_p1024_addcarryx_u64(&x1950, &x1951, x1949, x1901, x1898);
+  fiat_p1024_addcarryx_u64(&x1952, &x1953, x1951, x1899, x1896);
+  fiat_p1024_addcarryx_u64(&x1954, &x1955, x1953, x1897, x1894);
+  fiat_p1024_addcarryx_u64(&x1956, &x1957, 0x0, x1862, x1924);
+  fiat_p1024_addcarryx_u64(&x1958, &x1959, x1957, x1864, x1926);
+  fiat_p1024_addcarryx_u64(&x1960, &x1961, x1959, x1866, x1928);
+  fiat_p1024_addcarryx_u64(&x1962, &x1963, x1961, x1868, x1930);
+  fiat_p1024_addcarryx_u64(&x1964, &x1965, x1963, x1870, x1932);
+  fiat_p1024_addcarryx_u64(&x1966, &x1967, x1965, x1872, x1934);
+  fiat_p1024_addcarryx_u64(&x1968, &x1969, x1967, x1874, x1936);
+  fiat_p1024_addcarryx_u64(&x1970, &x1971, x1969, x1876, x1938);
+  fiat_p1024_addcarryx_u64(&x1972, &x1973, x1971, x1878, x1940);
+  fiat_p1024_addcarryx_u64(&x1974, &x1975, x1973, x1880, x1942);
+  fiat_p1024_addcarryx_u64(&x1976, &x1977, x1975, x1882, x1944);
+  fiat_p1024_addcarryx_u64(&x1978, &x1979, x1977, x1884, x1946);
+  fiat_p1024_addcarryx_u64(&x1980, &x1981, x1979, x1886, x1948);
+  fiat_p1024_addcarryx_u64(&x1982, &x1983, x1981, x1888, x1950);
+  fiat_p1024_addcarryx_u64(&x1984, &x1985, x1983, x1890, x1952);
+  fiat_p1024_addcarryx_u64(&x1986, &x1987, x1985, (x1891 + (x1861 + (x1829 + x1769))), x1954);
+  x1988 = (x1987 + (x1955 + x1895));
+  fiat_p1024_subborrowx_u64(&x1989, &x1990, 0x0, x1958, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_subborrowx_u64(&x1991, &x1992, x1990, x1960, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_subborrowx_u64(&x1993, &x1994, x1992, x1962, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_subborrowx_u64(&x1995, &x1996, x1994, x1964, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_subborrowx_u64(&x1997, &x1998, x1996, x1966, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_subborrowx_u64(&x1999, &x2000, x1998, x1968, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_subborrowx_u64(&x2001, &x2002, x2000, x1970, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_subborrowx_u64(&x2003, &x2004, x2002, x1972, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_subborrowx_u64(&x2005, &x2006, x2004, x1974, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_subborrowx_u64(&x2007, &x2008, x2006, x1976, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_subborrowx_u64(&x2009, &x2010, x2008, x1978, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_subborrowx_u64(&x2011, &x2012, x2010, x1980, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_subborrowx_u64(&x2013, &x2014, x2012, x1982, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_subborrowx_u64(&x2015, &x2016, x2014, x1984, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_subborrowx_u64(&x2017, &x2018, x2016, x1986, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_subborrowx_u64(&x2019, &x2020, x2018, x1988, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_subborrowx_u64(&x2021, &x2022, x2020, 0x0, 0x0);
+  fiat_p1024_cmovznz_u64(&x2023, x2022, x1989, x1958);
+  fiat_p1024_cmovznz_u64(&x2024, x2022, x1991, x1960);
+  fiat_p1024_cmovznz_u64(&x2025, x2022, x1993, x1962);
+  fiat_p1024_cmovznz_u64(&x2026, x2022, x1995, x1964);
+  fiat_p1024_cmovznz_u64(&x2027, x2022, x1997, x1966);
+  fiat_p1024_cmovznz_u64(&x2028, x2022, x1999, x1968);
+  fiat_p1024_cmovznz_u64(&x2029, x2022, x2001, x1970);
+  fiat_p1024_cmovznz_u64(&x2030, x2022, x2003, x1972);
+  fiat_p1024_cmovznz_u64(&x2031, x2022, x2005, x1974);
+  fiat_p1024_cmovznz_u64(&x2032, x2022, x2007, x1976);
+  fiat_p1024_cmovznz_u64(&x2033, x2022, x2009, x1978);
+  fiat_p1024_cmovznz_u64(&x2034, x2022, x2011, x1980);
+  fiat_p1024_cmovznz_u64(&x2035, x2022, x2013, x1982);
+  fiat_p1024_cmovznz_u64(&x2036, x2022, x2015, x1984);
+  fiat_p1024_cmovznz_u64(&x2037, x2022, x2017, x1986);
+  fiat_p1024_cmovznz_u64(&x2038, x2022, x2019, x1988);
+  out1[0] = x2023;
+  out1[1] = x2024;
+  out1[2] = x2025;
+  out1[3] = x2026;
+  out1[4] = x2027;
+  out1[5] = x2028;
+  out1[6] = x2029;
+  out1[7] = x2030;
+  out1[8] = x2031;
+  out1[9] = x2032;
+  out1[10] = x2033;
+  out1[11] = x2034;
+  out1[12] = x2035;
+  out1[13] = x2036;
+  out1[14] = x2037;
+  out1[15] = x2038;
+}
+
+/*
+ * The function fiat_p1024_to_montgomery translates a field element into the Montgomery domain.
+ *
+ * Preconditions:
+ *   0 ≤ eval arg1 < m
+ * Postconditions:
+ *   eval (from_montgomery out1) mod m = eval arg1 mod m
+ *   0 ≤ eval out1 < m
+ *
+ */
+void fiat_p1024_to_montgomery(fiat_p1024_montgomery_domain_field_element out1, const fiat_p1024_non_montgomery_domain_field_element arg1) {
+  uint64_t x1;
+  uint64_t x2;
+  uint64_t x3;
+  uint64_t x4;
+  uint64_t x5;
+  uint64_t x6;
+  uint64_t x7;
+  uint64_t x8;
+  uint64_t x9;
+  uint64_t x10;
+  uint64_t x11;
+  uint64_t x12;
+  uint64_t x13;
+  uint64_t x14;
+  uint64_t x15;
+  uint64_t x16;
+  uint64_t x17;
+  uint64_t x18;
+  uint64_t x19;
+  uint64_t x20;
+  uint64_t x21;
+  uint64_t x22;
+  uint64_t x23;
+  uint64_t x24;
+  uint64_t x25;
+  uint64_t x26;
+  uint64_t x27;
+  uint64_t x28;
+  uint64_t x29;
+  uint64_t x30;
+  uint64_t x31;
+  uint64_t x32;
+  uint64_t x33;
+  uint64_t x34;
+  uint64_t x35;
+  uint64_t x36;
+  uint64_t x37;
+  uint64_t x38;
+  uint64_t x39;
+  uint64_t x40;
+  uint64_t x41;
+  uint64_t x42;
+  uint64_t x43;
+  uint64_t x44;
+  uint64_t x45;
+  uint64_t x46;
+  uint64_t x47;
+  uint64_t x48;
+  uint64_t x49;
+  fiat_p1024_uint1 x50;
+  uint64_t x51;
+  fiat_p1024_uint1 x52;
+  uint64_t x53;
+  fiat_p1024_uint1 x54;
+  uint64_t x55;
+  fiat_p1024_uint1 x56;
+  uint64_t x57;
+  fiat_p1024_uint1 x58;
+  uint64_t x59;
+  fiat_p1024_uint1 x60;
+  uint64_t x61;
+  fiat_p1024_uint1 x62;
+  uint64_t x63;
+  fiat_p1024_uint1 x64;
+  uint64_t x65;
+  fiat_p1024_uint1 x66;
+  uint64_t x67;
+  fiat_p1024_uint1 x68;
+  uint64_t x69;
+  fiat_p1024_uint1 x70;
+  uint64_t x71;
+  fiat_p1024_uint1 x72;
+  uint64_t x73;
+  fiat_p1024_uint1 x74;
+  uint64_t x75;
+  fiat_p1024_uint1 x76;
+  uint64_t x77;
+  fiat_p1024_uint1 x78;
+  uint64_t x79;
+  uint64_t x80;
+  uint64_t x81;
+  uint64_t x82;
+  uint64_t x83;
+  uint64_t x84;
+  uint64_t x85;
+  uint64_t x86;
+  uint64_t x87;
+  uint64_t x88;
+  uint64_t x89;
+  uint64_t x90;
+  uint64_t x91;
+  uint64_t x92;
+  uint64_t x93;
+  uint64_t x94;
+  uint64_t x95;
+  uint64_t x96;
+  uint64_t x97;
+  uint64_t x98;
+  uint64_t x99;
+  uint64_t x100;
+  uint64_t x101;
+  uint64_t x102;
+  uint64_t x103;
+  uint64_t x104;
+  uint64_t x105;
+  uint64_t x106;
+  uint64_t x107;
+  uint64_t x108;
+  uint64_t x109;
+  uint64_t x110;
+  uint64_t x111;
+  uint64_t x112;
+  uint64_t x113;
+  fiat_p1024_uint1 x114;
+  uint64_t x115;
+  fiat_p1024_uint1 x116;
+  uint64_t x117;
+  fiat_p1024_uint1 x118;
+  uint64_t x119;
+  fiat_p1024_uint1 x120;
+  uint64_t x121;
+  fiat_p1024_uint1 x122;
+  uint64_t x123;
+  fiat_p1024_uint1 x124;
+  uint64_t x125;
+  fiat_p1024_uint1 x126;
+  uint64_t x127;
+  fiat_p1024_uint1 x128;
+  uint64_t x129;
+  fiat_p1024_uint1 x130;
+  uint64_t x131;
+  fiat_p1024_uint1 x132;
+  uint64_t x133;
+  fiat_p1024_uint1 x134;
+  uint64_t x135;
+  fiat_p1024_uint1 x136;
+  uint64_t x137;
+  fiat_p1024_uint1 x138;
+  uint64_t x139;
+  fiat_p1024_uint1 x140;
+  uint64_t x141;
+  fiat_p1024_uint1 x142;
+  uint64_t x143;
+  fiat_p1024_uint1 x144;
+  uint64_t x145;
+  fiat_p1024_uint1 x146;
+  uint64_t x147;
+  fiat_p1024_uint1 x148;
+  uint64_t x149;
+  fiat_p1024_uint1 x150;
+  uint64_t x151;
+  fiat_p1024_uint1 x152;
+  uint64_t x153;
+  fiat_p1024_uint1 x154;
+  uint64_t x155;
+  fiat_p1024_uint1 x156;
+  uint64_t x157;
+  fiat_p1024_uint1 x158;
+  uint64_t x159;
+  fiat_p1024_uint1 x160;
+  uint64_t x161;
+  fiat_p1024_uint1 x162;
+  uint64_t x163;
+  fiat_p1024_uint1 x164;
+  uint64_t x165;
+  fiat_p1024_uint1 x166;
+  uint64_t x167;
+  fiat_p1024_uint1 x168;
+  uint64_t x169;
+  fiat_p1024_uint1 x170;
+  uint64_t x171;
+  fiat_p1024_uint1 x172;
+  uint64_t x173;
+  fiat_p1024_uint1 x174;
+  uint64_t x175;
+  uint64_t x176;
+  uint64_t x177;
+  uint64_t x178;
+  uint64_t x179;
+  uint64_t x180;
+  uint64_t x181;
+  uint64_t x182;
+  uint64_t x183;
+  uint64_t x184;
+  uint64_t x185;
+  uint64_t x186;
+  uint64_t x187;
+  uint64_t x188;
+  uint64_t x189;
+  uint64_t x190;
+  uint64_t x191;
+  uint64_t x192;
+  uint64_t x193;
+  uint64_t x194;
+  uint64_t x195;
+  uint64_t x196;
+  uint64_t x197;
+  uint64_t x198;
+  uint64_t x199;
+  uint64_t x200;
+  uint64_t x201;
+  uint64_t x202;
+  uint64_t x203;
+  uint64_t x204;
+  uint64_t x205;
+  uint64_t x206;
+  uint64_t x207;
+  fiat_p1024_uint1 x208;
+  uint64_t x209;
+  fiat_p1024_uint1 x210;
+  uint64_t x211;
+  fiat_p1024_uint1 x212;
+  uint64_t x213;
+  fiat_p1024_uint1 x214;
+  uint64_t x215;
+  fiat_p1024_uint1 x216;
+  uint64_t x217;
+  fiat_p1024_uint1 x218;
+  uint64_t x219;
+  fiat_p1024_uint1 x220;
+  uint64_t x221;
+  fiat_p1024_uint1 x222;
+  uint64_t x223;
+  fiat_p1024_uint1 x224;
+  uint64_t x225;
+  fiat_p1024_uint1 x226;
+  uint64_t x227;
+  fiat_p1024_uint1 x228;
+  uint64_t x229;
+  fiat_p1024_uint1 x230;
+  uint64_t x231;
+  fiat_p1024_uint1 x232;
+  uint64_t x233;
+  fiat_p1024_uint1 x234;
+  uint64_t x235;
+  fiat_p1024_uint1 x236;
+  uint64_t x237;
+  fiat_p1024_uint1 x238;
+  uint64_t x239;
+  fiat_p1024_uint1 x240;
+  uint64_t x241;
+  fiat_p1024_uint1 x242;
+  uint64_t x243;
+  fiat_p1024_uint1 x244;
+  uint64_t x245;
+  fiat_p1024_uint1 x246;
+  uint64_t x247;
+  fiat_p1024_uint1 x248;
+  uint64_t x249;
+  fiat_p1024_uint1 x250;
+  uint64_t x251;
+  fiat_p1024_uint1 x252;
+  uint64_t x253;
+  fiat_p1024_uint1 x254;
+  uint64_t x255;
+  fiat_p1024_uint1 x256;
+  uint64_t x257;
+  fiat_p1024_uint1 x258;
+  uint64_t x259;
+  fiat_p1024_uint1 x260;
+  uint64_t x261;
+  fiat_p1024_uint1 x262;
+  uint64_t x263;
+  fiat_p1024_uint1 x264;
+  uint64_t x265;
+  fiat_p1024_uint1 x266;
+  uint64_t x267;
+  fiat_p1024_uint1 x268;
+  uint64_t x269;
+  uint64_t x270;
+  uint64_t x271;
+  uint64_t x272;
+  uint64_t x273;
+  uint64_t x274;
+  uint64_t x275;
+  uint64_t x276;
+  uint64_t x277;
+  uint64_t x278;
+  uint64_t x279;
+  uint64_t x280;
+  uint64_t x281;
+  uint64_t x282;
+  uint64_t x283;
+  uint64_t x284;
+  uint64_t x285;
+  uint64_t x286;
+  uint64_t x287;
+  uint64_t x288;
+  uint64_t x289;
+  uint64_t x290;
+  uint64_t x291;
+  uint64_t x292;
+  uint64_t x293;
+  uint64_t x294;
+  uint64_t x295;
+  uint64_t x296;
+  uint64_t x297;
+  uint64_t x298;
+  uint64_t x299;
+  uint64_t x300;
+  uint64_t x301;
+  uint64_t x302;
+  uint64_t x303;
+  fiat_p1024_uint1 x304;
+  uint64_t x305;
+  fiat_p1024_uint1 x306;
+  uint64_t x307;
+  fiat_p1024_uint1 x308;
+  uint64_t x309;
+  fiat_p1024_uint1 x310;
+  uint64_t x311;
+  fiat_p1024_uint1 x312;
+  uint64_t x313;
+  fiat_p1024_uint1 x314;
+  uint64_t x315;
+  fiat_p1024_uint1 x316;
+  uint64_t x317;
+  fiat_p1024_uint1 x318;
+  uint64_t x319;
+  fiat_p1024_uint1 x320;
+  uint64_t x321;
+  fiat_p1024_uint1 x322;
+  uint64_t x323;
+  fiat_p1024_uint1 x324;
+  uint64_t x325;
+  fiat_p1024_uint1 x326;
+  uint64_t x327;
+  fiat_p1024_uint1 x328;
+  uint64_t x329;
+  fiat_p1024_uint1 x330;
+  uint64_t x331;
+  fiat_p1024_uint1 x332;
+  uint64_t x333;
+  fiat_p1024_uint1 x334;
+  uint64_t x335;
+  fiat_p1024_uint1 x336;
+  uint64_t x337;
+  fiat_p1024_uint1 x338;
+  uint64_t x339;
+  fiat_p1024_uint1 x340;
+  uint64_t x341;
+  fiat_p1024_uint1 x342;
+  uint64_t x343;
+  fiat_p1024_uint1 x344;
+  uint64_t x345;
+  fiat_p1024_uint1 x346;
+  uint64_t x347;
+  fiat_p1024_uint1 x348;
+  uint64_t x349;
+  fiat_p1024_uint1 x350;
+  uint64_t x351;
+  fiat_p1024_uint1 x352;
+  uint64_t x353;
+  fiat_p1024_uint1 x354;
+  uint64_t x355;
+  fiat_p1024_uint1 x356;
+  uint64_t x357;
+  fiat_p1024_uint1 x358;
+  uint64_t x359;
+  fiat_p1024_uint1 x360;
+  uint64_t x361;
+  fiat_p1024_uint1 x362;
+  uint64_t x363;
+  fiat_p1024_uint1 x364;
+  uint64_t x365;
+  uint64_t x366;
+  uint64_t x367;
+  uint64_t x368;
+  uint64_t x369;
+  uint64_t x370;
+  uint64_t x371;
+  uint64_t x372;
+  uint64_t x373;
+  uint64_t x374;
+  uint64_t x375;
+  uint64_t x376;
+  uint64_t x377;
+  uint64_t x378;
+  uint64_t x379;
+  uint64_t x380;
+  uint64_t x381;
+  uint64_t x382;
+  uint64_t x383;
+  uint64_t x384;
+  uint64_t x385;
+  uint64_t x386;
+  uint64_t x387;
+  uint64_t x388;
+  uint64_t x389;
+  uint64_t x390;
+  uint64_t x391;
+  uint64_t x392;
+  uint64_t x393;
+  uint64_t x394;
+  uint64_t x395;
+  uint64_t x396;
+  uint64_t x397;
+  fiat_p1024_uint1 x398;
+  uint64_t x399;
+  fiat_p1024_uint1 x400;
+  uint64_t x401;
+  fiat_p1024_uint1 x402;
+  uint64_t x403;
+  fiat_p1024_uint1 x404;
+  uint64_t x405;
+  fiat_p1024_uint1 x406;
+  uint64_t x407;
+  fiat_p1024_uint1 x408;
+  uint64_t x409;
+  fiat_p1024_uint1 x410;
+  uint64_t x411;
+  fiat_p1024_uint1 x412;
+  uint64_t x413;
+  fiat_p1024_uint1 x414;
+  uint64_t x415;
+  fiat_p1024_uint1 x416;
+  uint64_t x417;
+  fiat_p1024_uint1 x418;
+  uint64_t x419;
+  fiat_p1024_uint1 x420;
+  uint64_t x421;
+  fiat_p1024_uint1 x422;
+  uint64_t x423;
+  fiat_p1024_uint1 x424;
+  uint64_t x425;
+  fiat_p1024_uint1 x426;
+  uint64_t x427;
+  fiat_p1024_uint1 x428;
+  uint64_t x429;
+  fiat_p1024_uint1 x430;
+  uint64_t x431;
+  fiat_p1024_uint1 x432;
+  uint64_t x433;
+  fiat_p1024_uint1 x434;
+  uint64_t x435;
+  fiat_p1024_uint1 x436;
+  uint64_t x437;
+  fiat_p1024_uint1 x438;
+  uint64_t x439;
+  fiat_p1024_uint1 x440;
+  uint64_t x441;
+  fiat_p1024_uint1 x442;
+  uint64_t x443;
+  fiat_p1024_uint1 x444;
+  uint64_t x445;
+  fiat_p1024_uint1 x446;
+  uint64_t x447;
+  fiat_p1024_uint1 x448;
+  uint64_t x449;
+  fiat_p1024_uint1 x450;
+  uint64_t x451;
+  fiat_p1024_uint1 x452;
+  uint64_t x453;
+  fiat_p1024_uint1 x454;
+  uint64_t x455;
+  fiat_p1024_uint1 x456;
+  uint64_t x457;
+  fiat_p1024_uint1 x458;
+  uint64_t x459;
+  uint64_t x460;
+  uint64_t x461;
+  uint64_t x462;
+  uint64_t x463;
+  uint64_t x464;
+  uint64_t x465;
+  uint64_t x466;
+  uint64_t x467;
+  uint64_t x468;
+  uint64_t x469;
+  uint64_t x470;
+  uint64_t x471;
+  uint64_t x472;
+  uint64_t x473;
+  uint64_t x474;
+  uint64_t x475;
+  uint64_t x476;
+  uint64_t x477;
+  uint64_t x478;
+  uint64_t x479;
+  uint64_t x480;
+  uint64_t x481;
+  uint64_t x482;
+  uint64_t x483;
+  uint64_t x484;
+  uint64_t x485;
+  uint64_t x486;
+  uint64_t x487;
+  uint64_t x488;
+  uint64_t x489;
+  uint64_t x490;
+  uint64_t x491;
+  uint64_t x492;
+  uint64_t x493;
+  fiat_p1024_uint1 x494;
+  uint64_t x495;
+  fiat_p1024_uint1 x496;
+  uint64_t x497;
+  fiat_p1024_uint1 x498;
+  uint64_t x499;
+  fiat_p1024_uint1 x500;
+  uint64_t x501;
+  fiat_p1024_uint1 x502;
+  uint64_t x503;
+  fiat_p1024_uint1 x504;
+  uint64_t x505;
+  fiat_p1024_uint1 x506;
+  uint64_t x507;
+  fiat_p1024_uint1 x508;
+  uint64_t x509;
+  fiat_p1024_uint1 x510;
+  uint64_t x511;
+  fiat_p1024_uint1 x512;
+  uint64_t x513;
+  fiat_p1024_uint1 x514;
+  uint64_t x515;
+  fiat_p1024_uint1 x516;
+  uint64_t x517;
+  fiat_p1024_uint1 x518;
+  uint64_t x519;
+  fiat_p1024_uint1 x520;
+  uint64_t x521;
+  fiat_p1024_uint1 x522;
+  uint64_t x523;
+  fiat_p1024_uint1 x524;
+  uint64_t x525;
+  fiat_p1024_uint1 x526;
+  uint64_t x527;
+  fiat_p1024_uint1 x528;
+  uint64_t x529;
+  fiat_p1024_uint1 x530;
+  uint64_t x531;
+  fiat_p1024_uint1 x532;
+  uint64_t x533;
+  fiat_p1024_uint1 x534;
+  uint64_t x535;
+  fiat_p1024_uint1 x536;
+  uint64_t x537;
+  fiat_p1024_uint1 x538;
+  uint64_t x539;
+  fiat_p1024_uint1 x540;
+  uint64_t x541;
+  fiat_p1024_uint1 x542;
+  uint64_t x543;
+  fiat_p1024_uint1 x544;
+  uint64_t x545;
+  fiat_p1024_uint1 x546;
+  uint64_t x547;
+  fiat_p1024_uint1 x548;
+  uint64_t x549;
+  fiat_p1024_uint1 x550;
+  uint64_t x551;
+  fiat_p1024_uint1 x552;
+  uint64_t x553;
+  fiat_p1024_uint1 x554;
+  uint64_t x555;
+  uint64_t x556;
+  uint64_t x557;
+  uint64_t x558;
+  uint64_t x559;
+  uint64_t x560;
+  uint64_t x561;
+  uint64_t x562;
+  uint64_t x563;
+  uint64_t x564;
+  uint64_t x565;
+  uint64_t x566;
+  uint64_t x567;
+  uint64_t x568;
+  uint64_t x569;
+  uint64_t x570;
+  uint64_t x571;
+  uint64_t x572;
+  uint64_t x573;
+  uint64_t x574;
+  uint64_t x575;
+  uint64_t x576;
+  uint64_t x577;
+  uint64_t x578;
+  uint64_t x579;
+  uint64_t x580;
+  uint64_t x581;
+  uint64_t x582;
+  uint64_t x583;
+  uint64_t x584;
+  uint64_t x585;
+  uint64_t x586;
+  uint64_t x587;
+  fiat_p1024_uint1 x588;
+  uint64_t x589;
+  fiat_p1024_uint1 x590;
+  uint64_t x591;
+  fiat_p1024_uint1 x592;
+  uint64_t x593;
+  fiat_p1024_uint1 x594;
+  uint64_t x595;
+  fiat_p1024_uint1 x596;
+  uint64_t x597;
+  fiat_p1024_uint1 x598;
+  uint64_t x599;
+  fiat_p1024_uint1 x600;
+  uint64_t x601;
+  fiat_p1024_uint1 x602;
+  uint64_t x603;
+  fiat_p1024_uint1 x604;
+  uint64_t x605;
+  fiat_p1024_uint1 x606;
+  uint64_t x607;
+  fiat_p1024_uint1 x608;
+  uint64_t x609;
+  fiat_p1024_uint1 x610;
+  uint64_t x611;
+  fiat_p1024_uint1 x612;
+  uint64_t x613;
+  fiat_p1024_uint1 x614;
+  uint64_t x615;
+  fiat_p1024_uint1 x616;
+  uint64_t x617;
+  fiat_p1024_uint1 x618;
+  uint64_t x619;
+  fiat_p1024_uint1 x620;
+  uint64_t x621;
+  fiat_p1024_uint1 x622;
+  uint64_t x623;
+  fiat_p1024_uint1 x624;
+  uint64_t x625;
+  fiat_p1024_uint1 x626;
+  uint64_t x627;
+  fiat_p1024_uint1 x628;
+  uint64_t x629;
+  fiat_p1024_uint1 x630;
+  uint64_t x631;
+  fiat_p1024_uint1 x632;
+  uint64_t x633;
+  fiat_p1024_uint1 x634;
+  uint64_t x635;
+  fiat_p1024_uint1 x636;
+  uint64_t x637;
+  fiat_p1024_uint1 x638;
+  uint64_t x639;
+  fiat_p1024_uint1 x640;
+  uint64_t x641;
+  fiat_p1024_uint1 x642;
+  uint64_t x643;
+  fiat_p1024_uint1 x644;
+  uint64_t x645;
+  fiat_p1024_uint1 x646;
+  uint64_t x647;
+  fiat_p1024_uint1 x648;
+  uint64_t x649;
+  uint64_t x650;
+  uint64_t x651;
+  uint64_t x652;
+  uint64_t x653;
+  uint64_t x654;
+  uint64_t x655;
+  uint64_t x656;
+  uint64_t x657;
+  uint64_t x658;
+  uint64_t x659;
+  uint64_t x660;
+  uint64_t x661;
+  uint64_t x662;
+  uint64_t x663;
+  uint64_t x664;
+  uint64_t x665;
+  uint64_t x666;
+  uint64_t x667;
+  uint64_t x668;
+  uint64_t x669;
+  uint64_t x670;
+  uint64_t x671;
+  uint64_t x672;
+  uint64_t x673;
+  uint64_t x674;
+  uint64_t x675;
+  uint64_t x676;
+  uint64_t x677;
+  uint64_t x678;
+  uint64_t x679;
+  uint64_t x680;
+  uint64_t x681;
+  uint64_t x682;
+  uint64_t x683;
+  fiat_p1024_uint1 x684;
+  uint64_t x685;
+  fiat_p1024_uint1 x686;
+  uint64_t x687;
+  fiat_p1024_uint1 x688;
+  uint64_t x689;
+  fiat_p1024_uint1 x690;
+  uint64_t x691;
+  fiat_p1024_uint1 x692;
+  uint64_t x693;
+  fiat_p1024_uint1 x694;
+  uint64_t x695;
+  fiat_p1024_uint1 x696;
+  uint64_t x697;
+  fiat_p1024_uint1 x698;
+  uint64_t x699;
+  fiat_p1024_uint1 x700;
+  uint64_t x701;
+  fiat_p1024_uint1 x702;
+  uint64_t x703;
+  fiat_p1024_uint1 x704;
+  uint64_t x705;
+  fiat_p1024_uint1 x706;
+  uint64_t x707;
+  fiat_p1024_uint1 x708;
+  uint64_t x709;
+  fiat_p1024_uint1 x710;
+  uint64_t x711;
+  fiat_p1024_uint1 x712;
+  uint64_t x713;
+  fiat_p1024_uint1 x714;
+  uint64_t x715;
+  fiat_p1024_uint1 x716;
+  uint64_t x717;
+  fiat_p1024_uint1 x718;
+  uint64_t x719;
+  fiat_p1024_uint1 x720;
+  uint64_t x721;
+  fiat_p1024_uint1 x722;
+  uint64_t x723;
+  fiat_p1024_uint1 x724;
+  uint64_t x725;
+  fiat_p1024_uint1 x726;
+  uint64_t x727;
+  fiat_p1024_uint1 x728;
+  uint64_t x729;
+  fiat_p1024_uint1 x730;
+  uint64_t x731;
+  fiat_p1024_uint1 x732;
+  uint64_t x733;
+  fiat_p1024_uint1 x734;
+  uint64_t x735;
+  fiat_p1024_uint1 x736;
+  uint64_t x737;
+  fiat_p1024_uint1 x738;
+  uint64_t x739;
+  fiat_p1024_uint1 x740;
+  uint64_t x741;
+  fiat_p1024_uint1 x742;
+  uint64_t x743;
+  fiat_p1024_uint1 x744;
+  uint64_t x745;
+  uint64_t x746;
+  uint64_t x747;
+  uint64_t x748;
+  uint64_t x749;
+  uint64_t x750;
+  uint64_t x751;
+  uint64_t x752;
+  uint64_t x753;
+  uint64_t x754;
+  uint64_t x755;
+  uint64_t x756;
+  uint64_t x757;
+  uint64_t x758;
+  uint64_t x759;
+  uint64_t x760;
+  uint64_t x761;
+  uint64_t x762;
+  uint64_t x763;
+  uint64_t x764;
+  uint64_t x765;
+  uint64_t x766;
+  uint64_t x767;
+  uint64_t x768;
+  uint64_t x769;
+  uint64_t x770;
+  uint64_t x771;
+  uint64_t x772;
+  uint64_t x773;
+  uint64_t x774;
+  uint64_t x775;
+  uint64_t x776;
+  uint64_t x777;
+  fiat_p1024_uint1 x778;
+  uint64_t x779;
+  fiat_p1024_uint1 x780;
+  uint64_t x781;
+  fiat_p1024_uint1 x782;
+  uint64_t x783;
+  fiat_p1024_uint1 x784;
+  uint64_t x785;
+  fiat_p1024_uint1 x786;
+  uint64_t x787;
+  fiat_p1024_uint1 x788;
+  uint64_t x789;
+  fiat_p1024_uint1 x790;
+  uint64_t x791;
+  fiat_p1024_uint1 x792;
+  uint64_t x793;
+  fiat_p1024_uint1 x794;
+  uint64_t x795;
+  fiat_p1024_uint1 x796;
+  uint64_t x797;
+  fiat_p1024_uint1 x798;
+  uint64_t x799;
+  fiat_p1024_uint1 x800;
+  uint64_t x801;
+  fiat_p1024_uint1 x802;
+  uint64_t x803;
+  fiat_p1024_uint1 x804;
+  uint64_t x805;
+  fiat_p1024_uint1 x806;
+  uint64_t x807;
+  fiat_p1024_uint1 x808;
+  uint64_t x809;
+  fiat_p1024_uint1 x810;
+  uint64_t x811;
+  fiat_p1024_uint1 x812;
+  uint64_t x813;
+  fiat_p1024_uint1 x814;
+  uint64_t x815;
+  fiat_p1024_uint1 x816;
+  uint64_t x817;
+  fiat_p1024_uint1 x818;
+  uint64_t x819;
+  fiat_p1024_uint1 x820;
+  uint64_t x821;
+  fiat_p1024_uint1 x822;
+  uint64_t x823;
+  fiat_p1024_uint1 x824;
+  uint64_t x825;
+  fiat_p1024_uint1 x826;
+  uint64_t x827;
+  fiat_p1024_uint1 x828;
+  uint64_t x829;
+  fiat_p1024_uint1 x830;
+  uint64_t x831;
+  fiat_p1024_uint1 x832;
+  uint64_t x833;
+  fiat_p1024_uint1 x834;
+  uint64_t x835;
+  fiat_p1024_uint1 x836;
+  uint64_t x837;
+  fiat_p1024_uint1 x838;
+  uint64_t x839;
+  uint64_t x840;
+  uint64_t x841;
+  uint64_t x842;
+  uint64_t x843;
+  uint64_t x844;
+  uint64_t x845;
+  uint64_t x846;
+  uint64_t x847;
+  uint64_t x848;
+  uint64_t x849;
+  uint64_t x850;
+  uint64_t x851;
+  uint64_t x852;
+  uint64_t x853;
+  uint64_t x854;
+  uint64_t x855;
+  uint64_t x856;
+  uint64_t x857;
+  uint64_t x858;
+  uint64_t x859;
+  uint64_t x860;
+  uint64_t x861;
+  uint64_t x862;
+  uint64_t x863;
+  uint64_t x864;
+  uint64_t x865;
+  uint64_t x866;
+  uint64_t x867;
+  uint64_t x868;
+  uint64_t x869;
+  uint64_t x870;
+  uint64_t x871;
+  uint64_t x872;
+  uint64_t x873;
+  fiat_p1024_uint1 x874;
+  uint64_t x875;
+  fiat_p1024_uint1 x876;
+  uint64_t x877;
+  fiat_p1024_uint1 x878;
+  uint64_t x879;
+  fiat_p1024_uint1 x880;
+  uint64_t x881;
+  fiat_p1024_uint1 x882;
+  uint64_t x883;
+  fiat_p1024_uint1 x884;
+  uint64_t x885;
+  fiat_p1024_uint1 x886;
+  uint64_t x887;
+  fiat_p1024_uint1 x888;
+  uint64_t x889;
+  fiat_p1024_uint1 x890;
+  uint64_t x891;
+  fiat_p1024_uint1 x892;
+  uint64_t x893;
+  fiat_p1024_uint1 x894;
+  uint64_t x895;
+  fiat_p1024_uint1 x896;
+  uint64_t x897;
+  fiat_p1024_uint1 x898;
+  uint64_t x899;
+  fiat_p1024_uint1 x900;
+  uint64_t x901;
+  fiat_p1024_uint1 x902;
+  uint64_t x903;
+  fiat_p1024_uint1 x904;
+  uint64_t x905;
+  fiat_p1024_uint1 x906;
+  uint64_t x907;
+  fiat_p1024_uint1 x908;
+  uint64_t x909;
+  fiat_p1024_uint1 x910;
+  uint64_t x911;
+  fiat_p1024_uint1 x912;
+  uint64_t x913;
+  fiat_p1024_uint1 x914;
+  uint64_t x915;
+  fiat_p1024_uint1 x916;
+  uint64_t x917;
+  fiat_p1024_uint1 x918;
+  uint64_t x919;
+  fiat_p1024_uint1 x920;
+  uint64_t x921;
+  fiat_p1024_uint1 x922;
+  uint64_t x923;
+  fiat_p1024_uint1 x924;
+  uint64_t x925;
+  fiat_p1024_uint1 x926;
+  uint64_t x927;
+  fiat_p1024_uint1 x928;
+  uint64_t x929;
+  fiat_p1024_uint1 x930;
+  uint64_t x931;
+  fiat_p1024_uint1 x932;
+  uint64_t x933;
+  fiat_p1024_uint1 x934;
+  uint64_t x935;
+  uint64_t x936;
+  uint64_t x937;
+  uint64_t x938;
+  uint64_t x939;
+  uint64_t x940;
+  uint64_t x941;
+  uint64_t x942;
+  uint64_t x943;
+  uint64_t x944;
+  uint64_t x945;
+  uint64_t x946;
+  uint64_t x947;
+  uint64_t x948;
+  uint64_t x949;
+  uint64_t x950;
+  uint64_t x951;
+  uint64_t x952;
+  uint64_t x953;
+  uint64_t x954;
+  uint64_t x955;
+  uint64_t x956;
+  uint64_t x957;
+  uint64_t x958;
+  uint64_t x959;
+  uint64_t x960;
+  uint64_t x961;
+  uint64_t x962;
+  uint64_t x963;
+  uint64_t x964;
+  uint64_t x965;
+  uint64_t x966;
+  uint64_t x967;
+  fiat_p1024_uint1 x968;
+  uint64_t x969;
+  fiat_p1024_uint1 x970;
+  uint64_t x971;
+  fiat_p1024_uint1 x972;
+  uint64_t x973;
+  fiat_p1024_uint1 x974;
+  uint64_t x975;
+  fiat_p1024_uint1 x976;
+  uint64_t x977;
+  fiat_p1024_uint1 x978;
+  uint64_t x979;
+  fiat_p1024_uint1 x980;
+  uint64_t x981;
+  fiat_p1024_uint1 x982;
+  uint64_t x983;
+  fiat_p1024_uint1 x984;
+  uint64_t x985;
+  fiat_p1024_uint1 x986;
+  uint64_t x987;
+  fiat_p1024_uint1 x988;
+  uint64_t x989;
+  fiat_p1024_uint1 x990;
+  uint64_t x991;
+  fiat_p1024_uint1 x992;
+  uint64_t x993;
+  fiat_p1024_uint1 x994;
+  uint64_t x995;
+  fiat_p1024_uint1 x996;
+  uint64_t x997;
+  fiat_p1024_uint1 x998;
+  uint64_t x999;
+  fiat_p1024_uint1 x1000;
+  uint64_t x1001;
+  fiat_p1024_uint1 x1002;
+  uint64_t x1003;
+  fiat_p1024_uint1 x1004;
+  uint64_t x1005;
+  fiat_p1024_uint1 x1006;
+  uint64_t x1007;
+  fiat_p1024_uint1 x1008;
+  uint64_t x1009;
+  fiat_p1024_uint1 x1010;
+  uint64_t x1011;
+  fiat_p1024_uint1 x1012;
+  uint64_t x1013;
+  fiat_p1024_uint1 x1014;
+  uint64_t x1015;
+  fiat_p1024_uint1 x1016;
+  uint64_t x1017;
+  fiat_p1024_uint1 x1018;
+  uint64_t x1019;
+  fiat_p1024_uint1 x1020;
+  uint64_t x1021;
+  fiat_p1024_uint1 x1022;
+  uint64_t x1023;
+  fiat_p1024_uint1 x1024;
+  uint64_t x1025;
+  fiat_p1024_uint1 x1026;
+  uint64_t x1027;
+  fiat_p1024_uint1 x1028;
+  uint64_t x1029;
+  uint64_t x1030;
+  uint64_t x1031;
+  uint64_t x1032;
+  uint64_t x1033;
+  uint64_t x1034;
+  uint64_t x1035;
+  uint64_t x1036;
+  uint64_t x1037;
+  uint64_t x1038;
+  uint64_t x1039;
+  uint64_t x1040;
+  uint64_t x1041;
+  uint64_t x1042;
+  uint64_t x1043;
+  uint64_t x1044;
+  uint64_t x1045;
+  uint64_t x1046;
+  uint64_t x1047;
+  uint64_t x1048;
+  uint64_t x1049;
+  uint64_t x1050;
+  uint64_t x1051;
+  uint64_t x1052;
+  uint64_t x1053;
+  uint64_t x1054;
+  uint64_t x1055;
+  uint64_t x1056;
+  uint64_t x1057;
+  uint64_t x1058;
+  uint64_t x1059;
+  uint64_t x1060;
+  uint64_t x1061;
+  uint64_t x1062;
+  uint64_t x1063;
+  fiat_p1024_uint1 x1064;
+  uint64_t x1065;
+  fiat_p1024_uint1 x1066;
+  uint64_t x1067;
+  fiat_p1024_uint1 x1068;
+  uint64_t x1069;
+  fiat_p1024_uint1 x1070;
+  uint64_t x1071;
+  fiat_p1024_uint1 x1072;
+  uint64_t x1073;
+  fiat_p1024_uint1 x1074;
+  uint64_t x1075;
+  fiat_p1024_uint1 x1076;
+  uint64_t x1077;
+  fiat_p1024_uint1 x1078;
+  uint64_t x1079;
+  fiat_p1024_uint1 x1080;
+  uint64_t x1081;
+  fiat_p1024_uint1 x1082;
+  uint64_t x1083;
+  fiat_p1024_uint1 x1084;
+  uint64_t x1085;
+  fiat_p1024_uint1 x1086;
+  uint64_t x1087;
+  fiat_p1024_uint1 x1088;
+  uint64_t x1089;
+  fiat_p1024_uint1 x1090;
+  uint64_t x1091;
+  fiat_p1024_uint1 x1092;
+  uint64_t x1093;
+  fiat_p1024_uint1 x1094;
+  uint64_t x1095;
+  fiat_p1024_uint1 x1096;
+  uint64_t x1097;
+  fiat_p1024_uint1 x1098;
+  uint64_t x1099;
+  fiat_p1024_uint1 x1100;
+  uint64_t x1101;
+  fiat_p1024_uint1 x1102;
+  uint64_t x1103;
+  fiat_p1024_uint1 x1104;
+  uint64_t x1105;
+  fiat_p1024_uint1 x1106;
+  uint64_t x1107;
+  fiat_p1024_uint1 x1108;
+  uint64_t x1109;
+  fiat_p1024_uint1 x1110;
+  uint64_t x1111;
+  fiat_p1024_uint1 x1112;
+  uint64_t x1113;
+  fiat_p1024_uint1 x1114;
+  uint64_t x1115;
+  fiat_p1024_uint1 x1116;
+  uint64_t x1117;
+  fiat_p1024_uint1 x1118;
+  uint64_t x1119;
+  fiat_p1024_uint1 x1120;
+  uint64_t x1121;
+  fiat_p1024_uint1 x1122;
+  uint64_t x1123;
+  fiat_p1024_uint1 x1124;
+  uint64_t x1125;
+  uint64_t x1126;
+  uint64_t x1127;
+  uint64_t x1128;
+  uint64_t x1129;
+  uint64_t x1130;
+  uint64_t x1131;
+  uint64_t x1132;
+  uint64_t x1133;
+  uint64_t x1134;
+  uint64_t x1135;
+  uint64_t x1136;
+  uint64_t x1137;
+  uint64_t x1138;
+  uint64_t x1139;
+  uint64_t x1140;
+  uint64_t x1141;
+  uint64_t x1142;
+  uint64_t x1143;
+  uint64_t x1144;
+  uint64_t x1145;
+  uint64_t x1146;
+  uint64_t x1147;
+  uint64_t x1148;
+  uint64_t x1149;
+  uint64_t x1150;
+  uint64_t x1151;
+  uint64_t x1152;
+  uint64_t x1153;
+  uint64_t x1154;
+  uint64_t x1155;
+  uint64_t x1156;
+  uint64_t x1157;
+  fiat_p1024_uint1 x1158;
+  uint64_t x1159;
+  fiat_p1024_uint1 x1160;
+  uint64_t x1161;
+  fiat_p1024_uint1 x1162;
+  uint64_t x1163;
+  fiat_p1024_uint1 x1164;
+  uint64_t x1165;
+  fiat_p1024_uint1 x1166;
+  uint64_t x1167;
+  fiat_p1024_uint1 x1168;
+  uint64_t x1169;
+  fiat_p1024_uint1 x1170;
+  uint64_t x1171;
+  fiat_p1024_uint1 x1172;
+  uint64_t x1173;
+  fiat_p1024_uint1 x1174;
+  uint64_t x1175;
+  fiat_p1024_uint1 x1176;
+  uint64_t x1177;
+  fiat_p1024_uint1 x1178;
+  uint64_t x1179;
+  fiat_p1024_uint1 x1180;
+  uint64_t x1181;
+  fiat_p1024_uint1 x1182;
+  uint64_t x1183;
+  fiat_p1024_uint1 x1184;
+  uint64_t x1185;
+  fiat_p1024_uint1 x1186;
+  uint64_t x1187;
+  fiat_p1024_uint1 x1188;
+  uint64_t x1189;
+  fiat_p1024_uint1 x1190;
+  uint64_t x1191;
+  fiat_p1024_uint1 x1192;
+  uint64_t x1193;
+  fiat_p1024_uint1 x1194;
+  uint64_t x1195;
+  fiat_p1024_uint1 x1196;
+  uint64_t x1197;
+  fiat_p1024_uint1 x1198;
+  uint64_t x1199;
+  fiat_p1024_uint1 x1200;
+  uint64_t x1201;
+  fiat_p1024_uint1 x1202;
+  uint64_t x1203;
+  fiat_p1024_uint1 x1204;
+  uint64_t x1205;
+  fiat_p1024_uint1 x1206;
+  uint64_t x1207;
+  fiat_p1024_uint1 x1208;
+  uint64_t x1209;
+  fiat_p1024_uint1 x1210;
+  uint64_t x1211;
+  fiat_p1024_uint1 x1212;
+  uint64_t x1213;
+  fiat_p1024_uint1 x1214;
+  uint64_t x1215;
+  fiat_p1024_uint1 x1216;
+  uint64_t x1217;
+  fiat_p1024_uint1 x1218;
+  uint64_t x1219;
+  uint64_t x1220;
+  uint64_t x1221;
+  uint64_t x1222;
+  uint64_t x1223;
+  uint64_t x1224;
+  uint64_t x1225;
+  uint64_t x1226;
+  uint64_t x1227;
+  uint64_t x1228;
+  uint64_t x1229;
+  uint64_t x1230;
+  uint64_t x1231;
+  uint64_t x1232;
+  uint64_t x1233;
+  uint64_t x1234;
+  uint64_t x1235;
+  uint64_t x1236;
+  uint64_t x1237;
+  uint64_t x1238;
+  uint64_t x1239;
+  uint64_t x1240;
+  uint64_t x1241;
+  uint64_t x1242;
+  uint64_t x1243;
+  uint64_t x1244;
+  uint64_t x1245;
+  uint64_t x1246;
+  uint64_t x1247;
+  uint64_t x1248;
+  uint64_t x1249;
+  uint64_t x1250;
+  uint64_t x1251;
+  uint64_t x1252;
+  uint64_t x1253;
+  fiat_p1024_uint1 x1254;
+  uint64_t x1255;
+  fiat_p1024_uint1 x1256;
+  uint64_t x1257;
+  fiat_p1024_uint1 x1258;
+  uint64_t x1259;
+  fiat_p1024_uint1 x1260;
+  uint64_t x1261;
+  fiat_p1024_uint1 x1262;
+  uint64_t x1263;
+  fiat_p1024_uint1 x1264;
+  uint64_t x1265;
+  fiat_p1024_uint1 x1266;
+  uint64_t x1267;
+  fiat_p1024_uint1 x1268;
+  uint64_t x1269;
+  fiat_p1024_uint1 x1270;
+  uint64_t x1271;
+  fiat_p1024_uint1 x1272;
+  uint64_t x1273;
+  fiat_p1024_uint1 x1274;
+  uint64_t x1275;
+  fiat_p1024_uint1 x1276;
+  uint64_t x1277;
+  fiat_p1024_uint1 x1278;
+  uint64_t x1279;
+  fiat_p1024_uint1 x1280;
+  uint64_t x1281;
+  fiat_p1024_uint1 x1282;
+  uint64_t x1283;
+  fiat_p1024_uint1 x1284;
+  uint64_t x1285;
+  fiat_p1024_uint1 x1286;
+  uint64_t x1287;
+  fiat_p1024_uint1 x1288;
+  uint64_t x1289;
+  fiat_p1024_uint1 x1290;
+  uint64_t x1291;
+  fiat_p1024_uint1 x1292;
+  uint64_t x1293;
+  fiat_p1024_uint1 x1294;
+  uint64_t x1295;
+  fiat_p1024_uint1 x1296;
+  uint64_t x1297;
+  fiat_p1024_uint1 x1298;
+  uint64_t x1299;
+  fiat_p1024_uint1 x1300;
+  uint64_t x1301;
+  fiat_p1024_uint1 x1302;
+  uint64_t x1303;
+  fiat_p1024_uint1 x1304;
+  uint64_t x1305;
+  fiat_p1024_uint1 x1306;
+  uint64_t x1307;
+  fiat_p1024_uint1 x1308;
+  uint64_t x1309;
+  fiat_p1024_uint1 x1310;
+  uint64_t x1311;
+  fiat_p1024_uint1 x1312;
+  uint64_t x1313;
+  fiat_p1024_uint1 x1314;
+  uint64_t x1315;
+  uint64_t x1316;
+  uint64_t x1317;
+  uint64_t x1318;
+  uint64_t x1319;
+  uint64_t x1320;
+  uint64_t x1321;
+  uint64_t x1322;
+  uint64_t x1323;
+  uint64_t x1324;
+  uint64_t x1325;
+  uint64_t x1326;
+  uint64_t x1327;
+  uint64_t x1328;
+  uint64_t x1329;
+  uint64_t x1330;
+  uint64_t x1331;
+  uint64_t x1332;
+  uint64_t x1333;
+  uint64_t x1334;
+  uint64_t x1335;
+  uint64_t x1336;
+  uint64_t x1337;
+  uint64_t x1338;
+  uint64_t x1339;
+  uint64_t x1340;
+  uint64_t x1341;
+  uint64_t x1342;
+  uint64_t x1343;
+  uint64_t x1344;
+  uint64_t x1345;
+  uint64_t x1346;
+  uint64_t x1347;
+  fiat_p1024_uint1 x1348;
+  uint64_t x1349;
+  fiat_p1024_uint1 x1350;
+  uint64_t x1351;
+  fiat_p1024_uint1 x1352;
+  uint64_t x1353;
+  fiat_p1024_uint1 x1354;
+  uint64_t x1355;
+  fiat_p1024_uint1 x1356;
+  uint64_t x1357;
+  fiat_p1024_uint1 x1358;
+  uint64_t x1359;
+  fiat_p1024_uint1 x1360;
+  uint64_t x1361;
+  fiat_p1024_uint1 x1362;
+  uint64_t x1363;
+  fiat_p1024_uint1 x1364;
+  uint64_t x1365;
+  fiat_p1024_uint1 x1366;
+  uint64_t x1367;
+  fiat_p1024_uint1 x1368;
+  uint64_t x1369;
+  fiat_p1024_uint1 x1370;
+  uint64_t x1371;
+  fiat_p1024_uint1 x1372;
+  uint64_t x1373;
+  fiat_p1024_uint1 x1374;
+  uint64_t x1375;
+  fiat_p1024_uint1 x1376;
+  uint64_t x1377;
+  fiat_p1024_uint1 x1378;
+  uint64_t x1379;
+  fiat_p1024_uint1 x1380;
+  uint64_t x1381;
+  fiat_p1024_uint1 x1382;
+  uint64_t x1383;
+  fiat_p1024_uint1 x1384;
+  uint64_t x1385;
+  fiat_p1024_uint1 x1386;
+  uint64_t x1387;
+  fiat_p1024_uint1 x1388;
+  uint64_t x1389;
+  fiat_p1024_uint1 x1390;
+  uint64_t x1391;
+  fiat_p1024_uint1 x1392;
+  uint64_t x1393;
+  fiat_p1024_uint1 x1394;
+  uint64_t x1395;
+  fiat_p1024_uint1 x1396;
+  uint64_t x1397;
+  fiat_p1024_uint1 x1398;
+  uint64_t x1399;
+  fiat_p1024_uint1 x1400;
+  uint64_t x1401;
+  fiat_p1024_uint1 x1402;
+  uint64_t x1403;
+  fiat_p1024_uint1 x1404;
+  uint64_t x1405;
+  fiat_p1024_uint1 x1406;
+  uint64_t x1407;
+  fiat_p1024_uint1 x1408;
+  uint64_t x1409;
+  uint64_t x1410;
+  uint64_t x1411;
+  uint64_t x1412;
+  uint64_t x1413;
+  uint64_t x1414;
+  uint64_t x1415;
+  uint64_t x1416;
+  uint64_t x1417;
+  uint64_t x1418;
+  uint64_t x1419;
+  uint64_t x1420;
+  uint64_t x1421;
+  uint64_t x1422;
+  uint64_t x1423;
+  uint64_t x1424;
+  uint64_t x1425;
+  uint64_t x1426;
+  uint64_t x1427;
+  uint64_t x1428;
+  uint64_t x1429;
+  uint64_t x1430;
+  uint64_t x1431;
+  uint64_t x1432;
+  uint64_t x1433;
+  uint64_t x1434;
+  uint64_t x1435;
+  uint64_t x1436;
+  uint64_t x1437;
+  uint64_t x1438;
+  uint64_t x1439;
+  uint64_t x1440;
+  uint64_t x1441;
+  uint64_t x1442;
+  uint64_t x1443;
+  fiat_p1024_uint1 x1444;
+  uint64_t x1445;
+  fiat_p1024_uint1 x1446;
+  uint64_t x1447;
+  fiat_p1024_uint1 x1448;
+  uint64_t x1449;
+  fiat_p1024_uint1 x1450;
+  uint64_t x1451;
+  fiat_p1024_uint1 x1452;
+  uint64_t x1453;
+  fiat_p1024_uint1 x1454;
+  uint64_t x1455;
+  fiat_p1024_uint1 x1456;
+  uint64_t x1457;
+  fiat_p1024_uint1 x1458;
+  uint64_t x1459;
+  fiat_p1024_uint1 x1460;
+  uint64_t x1461;
+  fiat_p1024_uint1 x1462;
+  uint64_t x1463;
+  fiat_p1024_uint1 x1464;
+  uint64_t x1465;
+  fiat_p1024_uint1 x1466;
+  uint64_t x1467;
+  fiat_p1024_uint1 x1468;
+  uint64_t x1469;
+  fiat_p1024_uint1 x1470;
+  uint64_t x1471;
+  fiat_p1024_uint1 x1472;
+  uint64_t x1473;
+  fiat_p1024_uint1 x1474;
+  uint64_t x1475;
+  fiat_p1024_uint1 x1476;
+  uint64_t x1477;
+  fiat_p1024_uint1 x1478;
+  uint64_t x1479;
+  fiat_p1024_uint1 x1480;
+  uint64_t x1481;
+  fiat_p1024_uint1 x1482;
+  uint64_t x1483;
+  fiat_p1024_uint1 x1484;
+  uint64_t x1485;
+  fiat_p1024_uint1 x1486;
+  uint64_t x1487;
+  fiat_p1024_uint1 x1488;
+  uint64_t x1489;
+  fiat_p1024_uint1 x1490;
+  uint64_t x1491;
+  fiat_p1024_uint1 x1492;
+  uint64_t x1493;
+  fiat_p1024_uint1 x1494;
+  uint64_t x1495;
+  fiat_p1024_uint1 x1496;
+  uint64_t x1497;
+  fiat_p1024_uint1 x1498;
+  uint64_t x1499;
+  fiat_p1024_uint1 x1500;
+  uint64_t x1501;
+  fiat_p1024_uint1 x1502;
+  uint64_t x1503;
+  fiat_p1024_uint1 x1504;
+  uint64_t x1505;
+  uint64_t x1506;
+  uint64_t x1507;
+  uint64_t x1508;
+  uint64_t x1509;
+  uint64_t x1510;
+  uint64_t x1511;
+  uint64_t x1512;
+  uint64_t x1513;
+  uint64_t x1514;
+  uint64_t x1515;
+  uint64_t x1516;
+  uint64_t x1517;
+  uint64_t x1518;
+  uint64_t x1519;
+  uint64_t x1520;
+  uint64_t x1521;
+  uint64_t x1522;
+  uint64_t x1523;
+  uint64_t x1524;
+  uint64_t x1525;
+  uint64_t x1526;
+  uint64_t x1527;
+  uint64_t x1528;
+  uint64_t x1529;
+  uint64_t x1530;
+  uint64_t x1531;
+  uint64_t x1532;
+  uint64_t x1533;
+  uint64_t x1534;
+  uint64_t x1535;
+  uint64_t x1536;
+  uint64_t x1537;
+  fiat_p1024_uint1 x1538;
+  uint64_t x1539;
+  fiat_p1024_uint1 x1540;
+  uint64_t x1541;
+  fiat_p1024_uint1 x1542;
+  uint64_t x1543;
+  fiat_p1024_uint1 x1544;
+  uint64_t x1545;
+  fiat_p1024_uint1 x1546;
+  uint64_t x1547;
+  fiat_p1024_uint1 x1548;
+  uint64_t x1549;
+  fiat_p1024_uint1 x1550;
+  uint64_t x1551;
+  fiat_p1024_uint1 x1552;
+  uint64_t x1553;
+  fiat_p1024_uint1 x1554;
+  uint64_t x1555;
+  fiat_p1024_uint1 x1556;
+  uint64_t x1557;
+  fiat_p1024_uint1 x1558;
+  uint64_t x1559;
+  fiat_p1024_uint1 x1560;
+  uint64_t x1561;
+  fiat_p1024_uint1 x1562;
+  uint64_t x1563;
+  fiat_p1024_uint1 x1564;
+  uint64_t x1565;
+  fiat_p1024_uint1 x1566;
+  uint64_t x1567;
+  fiat_p1024_uint1 x1568;
+  uint64_t x1569;
+  fiat_p1024_uint1 x1570;
+  uint64_t x1571;
+  fiat_p1024_uint1 x1572;
+  uint64_t x1573;
+  fiat_p1024_uint1 x1574;
+  uint64_t x1575;
+  fiat_p1024_uint1 x1576;
+  uint64_t x1577;
+  fiat_p1024_uint1 x1578;
+  uint64_t x1579;
+  fiat_p1024_uint1 x1580;
+  uint64_t x1581;
+  fiat_p1024_uint1 x1582;
+  uint64_t x1583;
+  fiat_p1024_uint1 x1584;
+  uint64_t x1585;
+  fiat_p1024_uint1 x1586;
+  uint64_t x1587;
+  fiat_p1024_uint1 x1588;
+  uint64_t x1589;
+  fiat_p1024_uint1 x1590;
+  uint64_t x1591;
+  fiat_p1024_uint1 x1592;
+  uint64_t x1593;
+  fiat_p1024_uint1 x1594;
+  uint64_t x1595;
+  fiat_p1024_uint1 x1596;
+  uint64_t x1597;
+  fiat_p1024_uint1 x1598;
+  uint64_t x1599;
+  uint64_t x1600;
+  uint64_t x1601;
+  uint64_t x1602;
+  uint64_t x1603;
+  uint64_t x1604;
+  uint64_t x1605;
+  uint64_t x1606;
+  uint64_t x1607;
+  uint64_t x1608;
+  uint64_t x1609;
+  uint64_t x1610;
+  uint64_t x1611;
+  uint64_t x1612;
+  uint64_t x1613;
+  uint64_t x1614;
+  uint64_t x1615;
+  uint64_t x1616;
+  uint64_t x1617;
+  uint64_t x1618;
+  uint64_t x1619;
+  uint64_t x1620;
+  uint64_t x1621;
+  uint64_t x1622;
+  uint64_t x1623;
+  uint64_t x1624;
+  uint64_t x1625;
+  uint64_t x1626;
+  uint64_t x1627;
+  uint64_t x1628;
+  uint64_t x1629;
+  uint64_t x1630;
+  uint64_t x1631;
+  uint64_t x1632;
+  uint64_t x1633;
+  fiat_p1024_uint1 x1634;
+  uint64_t x1635;
+  fiat_p1024_uint1 x1636;
+  uint64_t x1637;
+  fiat_p1024_uint1 x1638;
+  uint64_t x1639;
+  fiat_p1024_uint1 x1640;
+  uint64_t x1641;
+  fiat_p1024_uint1 x1642;
+  uint64_t x1643;
+  fiat_p1024_uint1 x1644;
+  uint64_t x1645;
+  fiat_p1024_uint1 x1646;
+  uint64_t x1647;
+  fiat_p1024_uint1 x1648;
+  uint64_t x1649;
+  fiat_p1024_uint1 x1650;
+  uint64_t x1651;
+  fiat_p1024_uint1 x1652;
+  uint64_t x1653;
+  fiat_p1024_uint1 x1654;
+  uint64_t x1655;
+  fiat_p1024_uint1 x1656;
+  uint64_t x1657;
+  fiat_p1024_uint1 x1658;
+  uint64_t x1659;
+  fiat_p1024_uint1 x1660;
+  uint64_t x1661;
+  fiat_p1024_uint1 x1662;
+  uint64_t x1663;
+  fiat_p1024_uint1 x1664;
+  uint64_t x1665;
+  fiat_p1024_uint1 x1666;
+  uint64_t x1667;
+  fiat_p1024_uint1 x1668;
+  uint64_t x1669;
+  fiat_p1024_uint1 x1670;
+  uint64_t x1671;
+  fiat_p1024_uint1 x1672;
+  uint64_t x1673;
+  fiat_p1024_uint1 x1674;
+  uint64_t x1675;
+  fiat_p1024_uint1 x1676;
+  uint64_t x1677;
+  fiat_p1024_uint1 x1678;
+  uint64_t x1679;
+  fiat_p1024_uint1 x1680;
+  uint64_t x1681;
+  fiat_p1024_uint1 x1682;
+  uint64_t x1683;
+  fiat_p1024_uint1 x1684;
+  uint64_t x1685;
+  fiat_p1024_uint1 x1686;
+  uint64_t x1687;
+  fiat_p1024_uint1 x1688;
+  uint64_t x1689;
+  fiat_p1024_uint1 x1690;
+  uint64_t x1691;
+  fiat_p1024_uint1 x1692;
+  uint64_t x1693;
+  fiat_p1024_uint1 x1694;
+  uint64_t x1695;
+  uint64_t x1696;
+  uint64_t x1697;
+  uint64_t x1698;
+  uint64_t x1699;
+  uint64_t x1700;
+  uint64_t x1701;
+  uint64_t x1702;
+  uint64_t x1703;
+  uint64_t x1704;
+  uint64_t x1705;
+  uint64_t x1706;
+  uint64_t x1707;
+  uint64_t x1708;
+  uint64_t x1709;
+  uint64_t x1710;
+  uint64_t x1711;
+  uint64_t x1712;
+  uint64_t x1713;
+  uint64_t x1714;
+  uint64_t x1715;
+  uint64_t x1716;
+  uint64_t x1717;
+  uint64_t x1718;
+  uint64_t x1719;
+  uint64_t x1720;
+  uint64_t x1721;
+  uint64_t x1722;
+  uint64_t x1723;
+  uint64_t x1724;
+  uint64_t x1725;
+  uint64_t x1726;
+  uint64_t x1727;
+  fiat_p1024_uint1 x1728;
+  uint64_t x1729;
+  fiat_p1024_uint1 x1730;
+  uint64_t x1731;
+  fiat_p1024_uint1 x1732;
+  uint64_t x1733;
+  fiat_p1024_uint1 x1734;
+  uint64_t x1735;
+  fiat_p1024_uint1 x1736;
+  uint64_t x1737;
+  fiat_p1024_uint1 x1738;
+  uint64_t x1739;
+  fiat_p1024_uint1 x1740;
+  uint64_t x1741;
+  fiat_p1024_uint1 x1742;
+  uint64_t x1743;
+  fiat_p1024_uint1 x1744;
+  uint64_t x1745;
+  fiat_p1024_uint1 x1746;
+  uint64_t x1747;
+  fiat_p1024_uint1 x1748;
+  uint64_t x1749;
+  fiat_p1024_uint1 x1750;
+  uint64_t x1751;
+  fiat_p1024_uint1 x1752;
+  uint64_t x1753;
+  fiat_p1024_uint1 x1754;
+  uint64_t x1755;
+  fiat_p1024_uint1 x1756;
+  uint64_t x1757;
+  fiat_p1024_uint1 x1758;
+  uint64_t x1759;
+  fiat_p1024_uint1 x1760;
+  uint64_t x1761;
+  fiat_p1024_uint1 x1762;
+  uint64_t x1763;
+  fiat_p1024_uint1 x1764;
+  uint64_t x1765;
+  fiat_p1024_uint1 x1766;
+  uint64_t x1767;
+  fiat_p1024_uint1 x1768;
+  uint64_t x1769;
+  fiat_p1024_uint1 x1770;
+  uint64_t x1771;
+  fiat_p1024_uint1 x1772;
+  uint64_t x1773;
+  fiat_p1024_uint1 x1774;
+  uint64_t x1775;
+  fiat_p1024_uint1 x1776;
+  uint64_t x1777;
+  fiat_p1024_uint1 x1778;
+  uint64_t x1779;
+  fiat_p1024_uint1 x1780;
+  uint64_t x1781;
+  fiat_p1024_uint1 x1782;
+  uint64_t x1783;
+  fiat_p1024_uint1 x1784;
+  uint64_t x1785;
+  fiat_p1024_uint1 x1786;
+  uint64_t x1787;
+  fiat_p1024_uint1 x1788;
+  uint64_t x1789;
+  uint64_t x1790;
+  uint64_t x1791;
+  uint64_t x1792;
+  uint64_t x1793;
+  uint64_t x1794;
+  uint64_t x1795;
+  uint64_t x1796;
+  uint64_t x1797;
+  uint64_t x1798;
+  uint64_t x1799;
+  uint64_t x1800;
+  uint64_t x1801;
+  uint64_t x1802;
+  uint64_t x1803;
+  uint64_t x1804;
+  uint64_t x1805;
+  uint64_t x1806;
+  uint64_t x1807;
+  uint64_t x1808;
+  uint64_t x1809;
+  uint64_t x1810;
+  uint64_t x1811;
+  uint64_t x1812;
+  uint64_t x1813;
+  uint64_t x1814;
+  uint64_t x1815;
+  uint64_t x1816;
+  uint64_t x1817;
+  uint64_t x1818;
+  uint64_t x1819;
+  uint64_t x1820;
+  uint64_t x1821;
+  uint64_t x1822;
+  uint64_t x1823;
+  fiat_p1024_uint1 x1824;
+  uint64_t x1825;
+  fiat_p1024_uint1 x1826;
+  uint64_t x1827;
+  fiat_p1024_uint1 x1828;
+  uint64_t x1829;
+  fiat_p1024_uint1 x1830;
+  uint64_t x1831;
+  fiat_p1024_uint1 x1832;
+  uint64_t x1833;
+  fiat_p1024_uint1 x1834;
+  uint64_t x1835;
+  fiat_p1024_uint1 x1836;
+  uint64_t x1837;
+  fiat_p1024_uint1 x1838;
+  uint64_t x1839;
+  fiat_p1024_uint1 x1840;
+  uint64_t x1841;
+  fiat_p1024_uint1 x1842;
+  uint64_t x1843;
+  fiat_p1024_uint1 x1844;
+  uint64_t x1845;
+  fiat_p1024_uint1 x1846;
+  uint64_t x1847;
+  fiat_p1024_uint1 x1848;
+  uint64_t x1849;
+  fiat_p1024_uint1 x1850;
+  uint64_t x1851;
+  fiat_p1024_uint1 x1852;
+  uint64_t x1853;
+  fiat_p1024_uint1 x1854;
+  uint64_t x1855;
+  fiat_p1024_uint1 x1856;
+  uint64_t x1857;
+  fiat_p1024_uint1 x1858;
+  uint64_t x1859;
+  fiat_p1024_uint1 x1860;
+  uint64_t x1861;
+  fiat_p1024_uint1 x1862;
+  uint64_t x1863;
+  fiat_p1024_uint1 x1864;
+  uint64_t x1865;
+  fiat_p1024_uint1 x1866;
+  uint64_t x1867;
+  fiat_p1024_uint1 x1868;
+  uint64_t x1869;
+  fiat_p1024_uint1 x1870;
+  uint64_t x1871;
+  fiat_p1024_uint1 x1872;
+  uint64_t x1873;
+  fiat_p1024_uint1 x1874;
+  uint64_t x1875;
+  fiat_p1024_uint1 x1876;
+  uint64_t x1877;
+  fiat_p1024_uint1 x1878;
+  uint64_t x1879;
+  fiat_p1024_uint1 x1880;
+  uint64_t x1881;
+  fiat_p1024_uint1 x1882;
+  uint64_t x1883;
+  fiat_p1024_uint1 x1884;
+  uint64_t x1885;
+  uint64_t x1886;
+  uint64_t x1887;
+  uint64_t x1888;
+  uint64_t x1889;
+  uint64_t x1890;
+  uint64_t x1891;
+  uint64_t x1892;
+  uint64_t x1893;
+  uint64_t x1894;
+  uint64_t x1895;
+  uint64_t x1896;
+  uint64_t x1897;
+  uint64_t x1898;
+  uint64_t x1899;
+  uint64_t x1900;
+  uint64_t x1901;
+  uint64_t x1902;
+  uint64_t x1903;
+  uint64_t x1904;
+  uint64_t x1905;
+  uint64_t x1906;
+  uint64_t x1907;
+  uint64_t x1908;
+  uint64_t x1909;
+  uint64_t x1910;
+  uint64_t x1911;
+  uint64_t x1912;
+  uint64_t x1913;
+  uint64_t x1914;
+  uint64_t x1915;
+  uint64_t x1916;
+  uint64_t x1917;
+  fiat_p1024_uint1 x1918;
+  uint64_t x1919;
+  fiat_p1024_uint1 x1920;
+  uint64_t x1921;
+  fiat_p1024_uint1 x1922;
+  uint64_t x1923;
+  fiat_p1024_uint1 x1924;
+  uint64_t x1925;
+  fiat_p1024_uint1 x1926;
+  uint64_t x1927;
+  fiat_p1024_uint1 x1928;
+  uint64_t x1929;
+  fiat_p1024_uint1 x1930;
+  uint64_t x1931;
+  fiat_p1024_uint1 x1932;
+  uint64_t x1933;
+  fiat_p1024_uint1 x1934;
+  uint64_t x1935;
+  fiat_p1024_uint1 x1936;
+  uint64_t x1937;
+  fiat_p1024_uint1 x1938;
+  uint64_t x1939;
+  fiat_p1024_uint1 x1940;
+  uint64_t x1941;
+  fiat_p1024_uint1 x1942;
+  uint64_t x1943;
+  fiat_p1024_uint1 x1944;
+  uint64_t x1945;
+  fiat_p1024_uint1 x1946;
+  uint64_t x1947;
+  fiat_p1024_uint1 x1948;
+  uint64_t x1949;
+  fiat_p1024_uint1 x1950;
+  uint64_t x1951;
+  fiat_p1024_uint1 x1952;
+  uint64_t x1953;
+  fiat_p1024_uint1 x1954;
+  uint64_t x1955;
+  fiat_p1024_uint1 x1956;
+  uint64_t x1957;
+  fiat_p1024_uint1 x1958;
+  uint64_t x1959;
+  fiat_p1024_uint1 x1960;
+  uint64_t x1961;
+  fiat_p1024_uint1 x1962;
+  uint64_t x1963;
+  fiat_p1024_uint1 x1964;
+  uint64_t x1965;
+  fiat_p1024_uint1 x1966;
+  uint64_t x1967;
+  fiat_p1024_uint1 x1968;
+  uint64_t x1969;
+  fiat_p1024_uint1 x1970;
+  uint64_t x1971;
+  fiat_p1024_uint1 x1972;
+  uint64_t x1973;
+  fiat_p1024_uint1 x1974;
+  uint64_t x1975;
+  fiat_p1024_uint1 x1976;
+  uint64_t x1977;
+  fiat_p1024_uint1 x1978;
+  uint64_t x1979;
+  uint64_t x1980;
+  uint64_t x1981;
+  uint64_t x1982;
+  uint64_t x1983;
+  uint64_t x1984;
+  uint64_t x1985;
+  uint64_t x1986;
+  uint64_t x1987;
+  uint64_t x1988;
+  uint64_t x1989;
+  uint64_t x1990;
+  uint64_t x1991;
+  uint64_t x1992;
+  uint64_t x1993;
+  uint64_t x1994;
+  uint64_t x1995;
+  uint64_t x1996;
+  uint64_t x1997;
+  uint64_t x1998;
+  uint64_t x1999;
+  uint64_t x2000;
+  uint64_t x2001;
+  uint64_t x2002;
+  uint64_t x2003;
+  uint64_t x2004;
+  uint64_t x2005;
+  uint64_t x2006;
+  uint64_t x2007;
+  uint64_t x2008;
+  uint64_t x2009;
+  uint64_t x2010;
+  uint64_t x2011;
+  uint64_t x2012;
+  uint64_t x2013;
+  fiat_p1024_uint1 x2014;
+  uint64_t x2015;
+  fiat_p1024_uint1 x2016;
+  uint64_t x2017;
+  fiat_p1024_uint1 x2018;
+  uint64_t x2019;
+  fiat_p1024_uint1 x2020;
+  uint64_t x2021;
+  fiat_p1024_uint1 x2022;
+  uint64_t x2023;
+  fiat_p1024_uint1 x2024;
+  uint64_t x2025;
+  fiat_p1024_uint1 x2026;
+  uint64_t x2027;
+  fiat_p1024_uint1 x2028;
+  uint64_t x2029;
+  fiat_p1024_uint1 x2030;
+  uint64_t x2031;
+  fiat_p1024_uint1 x2032;
+  uint64_t x2033;
+  fiat_p1024_uint1 x2034;
+  uint64_t x2035;
+  fiat_p1024_uint1 x2036;
+  uint64_t x2037;
+  fiat_p1024_uint1 x2038;
+  uint64_t x2039;
+  fiat_p1024_uint1 x2040;
+  uint64_t x2041;
+  fiat_p1024_uint1 x2042;
+  uint64_t x2043;
+  fiat_p1024_uint1 x2044;
+  uint64_t x2045;
+  fiat_p1024_uint1 x2046;
+  uint64_t x2047;
+  fiat_p1024_uint1 x2048;
+  uint64_t x2049;
+  fiat_p1024_uint1 x2050;
+  uint64_t x2051;
+  fiat_p1024_uint1 x2052;
+  uint64_t x2053;
+  fiat_p1024_uint1 x2054;
+  uint64_t x2055;
+  fiat_p1024_uint1 x2056;
+  uint64_t x2057;
+  fiat_p1024_uint1 x2058;
+  uint64_t x2059;
+  fiat_p1024_uint1 x2060;
+  uint64_t x2061;
+  fiat_p1024_uint1 x2062;
+  uint64_t x2063;
+  fiat_p1024_uint1 x2064;
+  uint64_t x2065;
+  fiat_p1024_uint1 x2066;
+  uint64_t x2067;
+  fiat_p1024_uint1 x2068;
+  uint64_t x2069;
+  fiat_p1024_uint1 x2070;
+  uint64_t x2071;
+  fiat_p1024_uint1 x2072;
+  uint64_t x2073;
+  fiat_p1024_uint1 x2074;
+  uint64_t x2075;
+  uint64_t x2076;
+  uint64_t x2077;
+  uint64_t x2078;
+  uint64_t x2079;
+  uint64_t x2080;
+  uint64_t x2081;
+  uint64_t x2082;
+  uint64_t x2083;
+  uint64_t x2084;
+  uint64_t x2085;
+  uint64_t x2086;
+  uint64_t x2087;
+  uint64_t x2088;
+  uint64_t x2089;
+  uint64_t x2090;
+  uint64_t x2091;
+  uint64_t x2092;
+  uint64_t x2093;
+  uint64_t x2094;
+  uint64_t x2095;
+  uint64_t x2096;
+  uint64_t x2097;
+  uint64_t x2098;
+  uint64_t x2099;
+  uint64_t x2100;
+  uint64_t x2101;
+  uint64_t x2102;
+  uint64_t x2103;
+  uint64_t x2104;
+  uint64_t x2105;
+  uint64_t x2106;
+  uint64_t x2107;
+  fiat_p1024_uint1 x2108;
+  uint64_t x2109;
+  fiat_p1024_uint1 x2110;
+  uint64_t x2111;
+  fiat_p1024_uint1 x2112;
+  uint64_t x2113;
+  fiat_p1024_uint1 x2114;
+  uint64_t x2115;
+  fiat_p1024_uint1 x2116;
+  uint64_t x2117;
+  fiat_p1024_uint1 x2118;
+  uint64_t x2119;
+  fiat_p1024_uint1 x2120;
+  uint64_t x2121;
+  fiat_p1024_uint1 x2122;
+  uint64_t x2123;
+  fiat_p1024_uint1 x2124;
+  uint64_t x2125;
+  fiat_p1024_uint1 x2126;
+  uint64_t x2127;
+  fiat_p1024_uint1 x2128;
+  uint64_t x2129;
+  fiat_p1024_uint1 x2130;
+  uint64_t x2131;
+  fiat_p1024_uint1 x2132;
+  uint64_t x2133;
+  fiat_p1024_uint1 x2134;
+  uint64_t x2135;
+  fiat_p1024_uint1 x2136;
+  uint64_t x2137;
+  fiat_p1024_uint1 x2138;
+  uint64_t x2139;
+  fiat_p1024_uint1 x2140;
+  uint64_t x2141;
+  fiat_p1024_uint1 x2142;
+  uint64_t x2143;
+  fiat_p1024_uint1 x2144;
+  uint64_t x2145;
+  fiat_p1024_uint1 x2146;
+  uint64_t x2147;
+  fiat_p1024_uint1 x2148;
+  uint64_t x2149;
+  fiat_p1024_uint1 x2150;
+  uint64_t x2151;
+  fiat_p1024_uint1 x2152;
+  uint64_t x2153;
+  fiat_p1024_uint1 x2154;
+  uint64_t x2155;
+  fiat_p1024_uint1 x2156;
+  uint64_t x2157;
+  fiat_p1024_uint1 x2158;
+  uint64_t x2159;
+  fiat_p1024_uint1 x2160;
+  uint64_t x2161;
+  fiat_p1024_uint1 x2162;
+  uint64_t x2163;
+  fiat_p1024_uint1 x2164;
+  uint64_t x2165;
+  fiat_p1024_uint1 x2166;
+  uint64_t x2167;
+  fiat_p1024_uint1 x2168;
+  uint64_t x2169;
+  uint64_t x2170;
+  uint64_t x2171;
+  uint64_t x2172;
+  uint64_t x2173;
+  uint64_t x2174;
+  uint64_t x2175;
+  uint64_t x2176;
+  uint64_t x2177;
+  uint64_t x2178;
+  uint64_t x2179;
+  uint64_t x2180;
+  uint64_t x2181;
+  uint64_t x2182;
+  uint64_t x2183;
+  uint64_t x2184;
+  uint64_t x2185;
+  uint64_t x2186;
+  uint64_t x2187;
+  uint64_t x2188;
+  uint64_t x2189;
+  uint64_t x2190;
+  uint64_t x2191;
+  uint64_t x2192;
+  uint64_t x2193;
+  uint64_t x2194;
+  uint64_t x2195;
+  uint64_t x2196;
+  uint64_t x2197;
+  uint64_t x2198;
+  uint64_t x2199;
+  uint64_t x2200;
+  uint64_t x2201;
+  uint64_t x2202;
+  uint64_t x2203;
+  fiat_p1024_uint1 x2204;
+  uint64_t x2205;
+  fiat_p1024_uint1 x2206;
+  uint64_t x2207;
+  fiat_p1024_uint1 x2208;
+  uint64_t x2209;
+  fiat_p1024_uint1 x2210;
+  uint64_t x2211;
+  fiat_p1024_uint1 x2212;
+  uint64_t x2213;
+  fiat_p1024_uint1 x2214;
+  uint64_t x2215;
+  fiat_p1024_uint1 x2216;
+  uint64_t x2217;
+  fiat_p1024_uint1 x2218;
+  uint64_t x2219;
+  fiat_p1024_uint1 x2220;
+  uint64_t x2221;
+  fiat_p1024_uint1 x2222;
+  uint64_t x2223;
+  fiat_p1024_uint1 x2224;
+  uint64_t x2225;
+  fiat_p1024_uint1 x2226;
+  uint64_t x2227;
+  fiat_p1024_uint1 x2228;
+  uint64_t x2229;
+  fiat_p1024_uint1 x2230;
+  uint64_t x2231;
+  fiat_p1024_uint1 x2232;
+  uint64_t x2233;
+  fiat_p1024_uint1 x2234;
+  uint64_t x2235;
+  fiat_p1024_uint1 x2236;
+  uint64_t x2237;
+  fiat_p1024_uint1 x2238;
+  uint64_t x2239;
+  fiat_p1024_uint1 x2240;
+  uint64_t x2241;
+  fiat_p1024_uint1 x2242;
+  uint64_t x2243;
+  fiat_p1024_uint1 x2244;
+  uint64_t x2245;
+  fiat_p1024_uint1 x2246;
+  uint64_t x2247;
+  fiat_p1024_uint1 x2248;
+  uint64_t x2249;
+  fiat_p1024_uint1 x2250;
+  uint64_t x2251;
+  fiat_p1024_uint1 x2252;
+  uint64_t x2253;
+  fiat_p1024_uint1 x2254;
+  uint64_t x2255;
+  fiat_p1024_uint1 x2256;
+  uint64_t x2257;
+  fiat_p1024_uint1 x2258;
+  uint64_t x2259;
+  fiat_p1024_uint1 x2260;
+  uint64_t x2261;
+  fiat_p1024_uint1 x2262;
+  uint64_t x2263;
+  fiat_p1024_uint1 x2264;
+  uint64_t x2265;
+  uint64_t x2266;
+  uint64_t x2267;
+  uint64_t x2268;
+  uint64_t x2269;
+  uint64_t x2270;
+  uint64_t x2271;
+  uint64_t x2272;
+  uint64_t x2273;
+  uint64_t x2274;
+  uint64_t x2275;
+  uint64_t x2276;
+  uint64_t x2277;
+  uint64_t x2278;
+  uint64_t x2279;
+  uint64_t x2280;
+  uint64_t x2281;
+  uint64_t x2282;
+  uint64_t x2283;
+  uint64_t x2284;
+  uint64_t x2285;
+  uint64_t x2286;
+  uint64_t x2287;
+  uint64_t x2288;
+  uint64_t x2289;
+  uint64_t x2290;
+  uint64_t x2291;
+  uint64_t x2292;
+  uint64_t x2293;
+  uint64_t x2294;
+  uint64_t x2295;
+  uint64_t x2296;
+  uint64_t x2297;
+  fiat_p1024_uint1 x2298;
+  uint64_t x2299;
+  fiat_p1024_uint1 x2300;
+  uint64_t x2301;
+  fiat_p1024_uint1 x2302;
+  uint64_t x2303;
+  fiat_p1024_uint1 x2304;
+  uint64_t x2305;
+  fiat_p1024_uint1 x2306;
+  uint64_t x2307;
+  fiat_p1024_uint1 x2308;
+  uint64_t x2309;
+  fiat_p1024_uint1 x2310;
+  uint64_t x2311;
+  fiat_p1024_uint1 x2312;
+  uint64_t x2313;
+  fiat_p1024_uint1 x2314;
+  uint64_t x2315;
+  fiat_p1024_uint1 x2316;
+  uint64_t x2317;
+  fiat_p1024_uint1 x2318;
+  uint64_t x2319;
+  fiat_p1024_uint1 x2320;
+  uint64_t x2321;
+  fiat_p1024_uint1 x2322;
+  uint64_t x2323;
+  fiat_p1024_uint1 x2324;
+  uint64_t x2325;
+  fiat_p1024_uint1 x2326;
+  uint64_t x2327;
+  fiat_p1024_uint1 x2328;
+  uint64_t x2329;
+  fiat_p1024_uint1 x2330;
+  uint64_t x2331;
+  fiat_p1024_uint1 x2332;
+  uint64_t x2333;
+  fiat_p1024_uint1 x2334;
+  uint64_t x2335;
+  fiat_p1024_uint1 x2336;
+  uint64_t x2337;
+  fiat_p1024_uint1 x2338;
+  uint64_t x2339;
+  fiat_p1024_uint1 x2340;
+  uint64_t x2341;
+  fiat_p1024_uint1 x2342;
+  uint64_t x2343;
+  fiat_p1024_uint1 x2344;
+  uint64_t x2345;
+  fiat_p1024_uint1 x2346;
+  uint64_t x2347;
+  fiat_p1024_uint1 x2348;
+  uint64_t x2349;
+  fiat_p1024_uint1 x2350;
+  uint64_t x2351;
+  fiat_p1024_uint1 x2352;
+  uint64_t x2353;
+  fiat_p1024_uint1 x2354;
+  uint64_t x2355;
+  fiat_p1024_uint1 x2356;
+  uint64_t x2357;
+  fiat_p1024_uint1 x2358;
+  uint64_t x2359;
+  uint64_t x2360;
+  uint64_t x2361;
+  uint64_t x2362;
+  uint64_t x2363;
+  uint64_t x2364;
+  uint64_t x2365;
+  uint64_t x2366;
+  uint64_t x2367;
+  uint64_t x2368;
+  uint64_t x2369;
+  uint64_t x2370;
+  uint64_t x2371;
+  uint64_t x2372;
+  uint64_t x2373;
+  uint64_t x2374;
+  uint64_t x2375;
+  uint64_t x2376;
+  uint64_t x2377;
+  uint64_t x2378;
+  uint64_t x2379;
+  uint64_t x2380;
+  uint64_t x2381;
+  uint64_t x2382;
+  uint64_t x2383;
+  uint64_t x2384;
+  uint64_t x2385;
+  uint64_t x2386;
+  uint64_t x2387;
+  uint64_t x2388;
+  uint64_t x2389;
+  uint64_t x2390;
+  uint64_t x2391;
+  uint64_t x2392;
+  uint64_t x2393;
+  fiat_p1024_uint1 x2394;
+  uint64_t x2395;
+  fiat_p1024_uint1 x2396;
+  uint64_t x2397;
+  fiat_p1024_uint1 x2398;
+  uint64_t x2399;
+  fiat_p1024_uint1 x2400;
+  uint64_t x2401;
+  fiat_p1024_uint1 x2402;
+  uint64_t x2403;
+  fiat_p1024_uint1 x2404;
+  uint64_t x2405;
+  fiat_p1024_uint1 x2406;
+  uint64_t x2407;
+  fiat_p1024_uint1 x2408;
+  uint64_t x2409;
+  fiat_p1024_uint1 x2410;
+  uint64_t x2411;
+  fiat_p1024_uint1 x2412;
+  uint64_t x2413;
+  fiat_p1024_uint1 x2414;
+  uint64_t x2415;
+  fiat_p1024_uint1 x2416;
+  uint64_t x2417;
+  fiat_p1024_uint1 x2418;
+  uint64_t x2419;
+  fiat_p1024_uint1 x2420;
+  uint64_t x2421;
+  fiat_p1024_uint1 x2422;
+  uint64_t x2423;
+  fiat_p1024_uint1 x2424;
+  uint64_t x2425;
+  fiat_p1024_uint1 x2426;
+  uint64_t x2427;
+  fiat_p1024_uint1 x2428;
+  uint64_t x2429;
+  fiat_p1024_uint1 x2430;
+  uint64_t x2431;
+  fiat_p1024_uint1 x2432;
+  uint64_t x2433;
+  fiat_p1024_uint1 x2434;
+  uint64_t x2435;
+  fiat_p1024_uint1 x2436;
+  uint64_t x2437;
+  fiat_p1024_uint1 x2438;
+  uint64_t x2439;
+  fiat_p1024_uint1 x2440;
+  uint64_t x2441;
+  fiat_p1024_uint1 x2442;
+  uint64_t x2443;
+  fiat_p1024_uint1 x2444;
+  uint64_t x2445;
+  fiat_p1024_uint1 x2446;
+  uint64_t x2447;
+  fiat_p1024_uint1 x2448;
+  uint64_t x2449;
+  fiat_p1024_uint1 x2450;
+  uint64_t x2451;
+  fiat_p1024_uint1 x2452;
+  uint64_t x2453;
+  fiat_p1024_uint1 x2454;
+  uint64_t x2455;
+  uint64_t x2456;
+  uint64_t x2457;
+  uint64_t x2458;
+  uint64_t x2459;
+  uint64_t x2460;
+  uint64_t x2461;
+  uint64_t x2462;
+  uint64_t x2463;
+  uint64_t x2464;
+  uint64_t x2465;
+  uint64_t x2466;
+  uint64_t x2467;
+  uint64_t x2468;
+  uint64_t x2469;
+  uint64_t x2470;
+  uint64_t x2471;
+  uint64_t x2472;
+  uint64_t x2473;
+  uint64_t x2474;
+  uint64_t x2475;
+  uint64_t x2476;
+  uint64_t x2477;
+  uint64_t x2478;
+  uint64_t x2479;
+  uint64_t x2480;
+  uint64_t x2481;
+  uint64_t x2482;
+  uint64_t x2483;
+  uint64_t x2484;
+  uint64_t x2485;
+  uint64_t x2486;
+  uint64_t x2487;
+  fiat_p1024_uint1 x2488;
+  uint64_t x2489;
+  fiat_p1024_uint1 x2490;
+  uint64_t x2491;
+  fiat_p1024_uint1 x2492;
+  uint64_t x2493;
+  fiat_p1024_uint1 x2494;
+  uint64_t x2495;
+  fiat_p1024_uint1 x2496;
+  uint64_t x2497;
+  fiat_p1024_uint1 x2498;
+  uint64_t x2499;
+  fiat_p1024_uint1 x2500;
+  uint64_t x2501;
+  fiat_p1024_uint1 x2502;
+  uint64_t x2503;
+  fiat_p1024_uint1 x2504;
+  uint64_t x2505;
+  fiat_p1024_uint1 x2506;
+  uint64_t x2507;
+  fiat_p1024_uint1 x2508;
+  uint64_t x2509;
+  fiat_p1024_uint1 x2510;
+  uint64_t x2511;
+  fiat_p1024_uint1 x2512;
+  uint64_t x2513;
+  fiat_p1024_uint1 x2514;
+  uint64_t x2515;
+  fiat_p1024_uint1 x2516;
+  uint64_t x2517;
+  fiat_p1024_uint1 x2518;
+  uint64_t x2519;
+  fiat_p1024_uint1 x2520;
+  uint64_t x2521;
+  fiat_p1024_uint1 x2522;
+  uint64_t x2523;
+  fiat_p1024_uint1 x2524;
+  uint64_t x2525;
+  fiat_p1024_uint1 x2526;
+  uint64_t x2527;
+  fiat_p1024_uint1 x2528;
+  uint64_t x2529;
+  fiat_p1024_uint1 x2530;
+  uint64_t x2531;
+  fiat_p1024_uint1 x2532;
+  uint64_t x2533;
+  fiat_p1024_uint1 x2534;
+  uint64_t x2535;
+  fiat_p1024_uint1 x2536;
+  uint64_t x2537;
+  fiat_p1024_uint1 x2538;
+  uint64_t x2539;
+  fiat_p1024_uint1 x2540;
+  uint64_t x2541;
+  fiat_p1024_uint1 x2542;
+  uint64_t x2543;
+  fiat_p1024_uint1 x2544;
+  uint64_t x2545;
+  fiat_p1024_uint1 x2546;
+  uint64_t x2547;
+  fiat_p1024_uint1 x2548;
+  uint64_t x2549;
+  uint64_t x2550;
+  uint64_t x2551;
+  uint64_t x2552;
+  uint64_t x2553;
+  uint64_t x2554;
+  uint64_t x2555;
+  uint64_t x2556;
+  uint64_t x2557;
+  uint64_t x2558;
+  uint64_t x2559;
+  uint64_t x2560;
+  uint64_t x2561;
+  uint64_t x2562;
+  uint64_t x2563;
+  uint64_t x2564;
+  uint64_t x2565;
+  uint64_t x2566;
+  uint64_t x2567;
+  uint64_t x2568;
+  uint64_t x2569;
+  uint64_t x2570;
+  uint64_t x2571;
+  uint64_t x2572;
+  uint64_t x2573;
+  uint64_t x2574;
+  uint64_t x2575;
+  uint64_t x2576;
+  uint64_t x2577;
+  uint64_t x2578;
+  uint64_t x2579;
+  uint64_t x2580;
+  uint64_t x2581;
+  uint64_t x2582;
+  uint64_t x2583;
+  fiat_p1024_uint1 x2584;
+  uint64_t x2585;
+  fiat_p1024_uint1 x2586;
+  uint64_t x2587;
+  fiat_p1024_uint1 x2588;
+  uint64_t x2589;
+  fiat_p1024_uint1 x2590;
+  uint64_t x2591;
+  fiat_p1024_uint1 x2592;
+  uint64_t x2593;
+  fiat_p1024_uint1 x2594;
+  uint64_t x2595;
+  fiat_p1024_uint1 x2596;
+  uint64_t x2597;
+  fiat_p1024_uint1 x2598;
+  uint64_t x2599;
+  fiat_p1024_uint1 x2600;
+  uint64_t x2601;
+  fiat_p1024_uint1 x2602;
+  uint64_t x2603;
+  fiat_p1024_uint1 x2604;
+  uint64_t x2605;
+  fiat_p1024_uint1 x2606;
+  uint64_t x2607;
+  fiat_p1024_uint1 x2608;
+  uint64_t x2609;
+  fiat_p1024_uint1 x2610;
+  uint64_t x2611;
+  fiat_p1024_uint1 x2612;
+  uint64_t x2613;
+  fiat_p1024_uint1 x2614;
+  uint64_t x2615;
+  fiat_p1024_uint1 x2616;
+  uint64_t x2617;
+  fiat_p1024_uint1 x2618;
+  uint64_t x2619;
+  fiat_p1024_uint1 x2620;
+  uint64_t x2621;
+  fiat_p1024_uint1 x2622;
+  uint64_t x2623;
+  fiat_p1024_uint1 x2624;
+  uint64_t x2625;
+  fiat_p1024_uint1 x2626;
+  uint64_t x2627;
+  fiat_p1024_uint1 x2628;
+  uint64_t x2629;
+  fiat_p1024_uint1 x2630;
+  uint64_t x2631;
+  fiat_p1024_uint1 x2632;
+  uint64_t x2633;
+  fiat_p1024_uint1 x2634;
+  uint64_t x2635;
+  fiat_p1024_uint1 x2636;
+  uint64_t x2637;
+  fiat_p1024_uint1 x2638;
+  uint64_t x2639;
+  fiat_p1024_uint1 x2640;
+  uint64_t x2641;
+  fiat_p1024_uint1 x2642;
+  uint64_t x2643;
+  fiat_p1024_uint1 x2644;
+  uint64_t x2645;
+  uint64_t x2646;
+  uint64_t x2647;
+  uint64_t x2648;
+  uint64_t x2649;
+  uint64_t x2650;
+  uint64_t x2651;
+  uint64_t x2652;
+  uint64_t x2653;
+  uint64_t x2654;
+  uint64_t x2655;
+  uint64_t x2656;
+  uint64_t x2657;
+  uint64_t x2658;
+  uint64_t x2659;
+  uint64_t x2660;
+  uint64_t x2661;
+  uint64_t x2662;
+  uint64_t x2663;
+  uint64_t x2664;
+  uint64_t x2665;
+  uint64_t x2666;
+  uint64_t x2667;
+  uint64_t x2668;
+  uint64_t x2669;
+  uint64_t x2670;
+  uint64_t x2671;
+  uint64_t x2672;
+  uint64_t x2673;
+  uint64_t x2674;
+  uint64_t x2675;
+  uint64_t x2676;
+  uint64_t x2677;
+  fiat_p1024_uint1 x2678;
+  uint64_t x2679;
+  fiat_p1024_uint1 x2680;
+  uint64_t x2681;
+  fiat_p1024_uint1 x2682;
+  uint64_t x2683;
+  fiat_p1024_uint1 x2684;
+  uint64_t x2685;
+  fiat_p1024_uint1 x2686;
+  uint64_t x2687;
+  fiat_p1024_uint1 x2688;
+  uint64_t x2689;
+  fiat_p1024_uint1 x2690;
+  uint64_t x2691;
+  fiat_p1024_uint1 x2692;
+  uint64_t x2693;
+  fiat_p1024_uint1 x2694;
+  uint64_t x2695;
+  fiat_p1024_uint1 x2696;
+  uint64_t x2697;
+  fiat_p1024_uint1 x2698;
+  uint64_t x2699;
+  fiat_p1024_uint1 x2700;
+  uint64_t x2701;
+  fiat_p1024_uint1 x2702;
+  uint64_t x2703;
+  fiat_p1024_uint1 x2704;
+  uint64_t x2705;
+  fiat_p1024_uint1 x2706;
+  uint64_t x2707;
+  fiat_p1024_uint1 x2708;
+  uint64_t x2709;
+  fiat_p1024_uint1 x2710;
+  uint64_t x2711;
+  fiat_p1024_uint1 x2712;
+  uint64_t x2713;
+  fiat_p1024_uint1 x2714;
+  uint64_t x2715;
+  fiat_p1024_uint1 x2716;
+  uint64_t x2717;
+  fiat_p1024_uint1 x2718;
+  uint64_t x2719;
+  fiat_p1024_uint1 x2720;
+  uint64_t x2721;
+  fiat_p1024_uint1 x2722;
+  uint64_t x2723;
+  fiat_p1024_uint1 x2724;
+  uint64_t x2725;
+  fiat_p1024_uint1 x2726;
+  uint64_t x2727;
+  fiat_p1024_uint1 x2728;
+  uint64_t x2729;
+  fiat_p1024_uint1 x2730;
+  uint64_t x2731;
+  fiat_p1024_uint1 x2732;
+  uint64_t x2733;
+  fiat_p1024_uint1 x2734;
+  uint64_t x2735;
+  fiat_p1024_uint1 x2736;
+  uint64_t x2737;
+  fiat_p1024_uint1 x2738;
+  uint64_t x2739;
+  uint64_t x2740;
+  uint64_t x2741;
+  uint64_t x2742;
+  uint64_t x2743;
+  uint64_t x2744;
+  uint64_t x2745;
+  uint64_t x2746;
+  uint64_t x2747;
+  uint64_t x2748;
+  uint64_t x2749;
+  uint64_t x2750;
+  uint64_t x2751;
+  uint64_t x2752;
+  uint64_t x2753;
+  uint64_t x2754;
+  uint64_t x2755;
+  uint64_t x2756;
+  uint64_t x2757;
+  uint64_t x2758;
+  uint64_t x2759;
+  uint64_t x2760;
+  uint64_t x2761;
+  uint64_t x2762;
+  uint64_t x2763;
+  uint64_t x2764;
+  uint64_t x2765;
+  uint64_t x2766;
+  uint64_t x2767;
+  uint64_t x2768;
+  uint64_t x2769;
+  uint64_t x2770;
+  uint64_t x2771;
+  uint64_t x2772;
+  uint64_t x2773;
+  fiat_p1024_uint1 x2774;
+  uint64_t x2775;
+  fiat_p1024_uint1 x2776;
+  uint64_t x2777;
+  fiat_p1024_uint1 x2778;
+  uint64_t x2779;
+  fiat_p1024_uint1 x2780;
+  uint64_t x2781;
+  fiat_p1024_uint1 x2782;
+  uint64_t x2783;
+  fiat_p1024_uint1 x2784;
+  uint64_t x2785;
+  fiat_p1024_uint1 x2786;
+  uint64_t x2787;
+  fiat_p1024_uint1 x2788;
+  uint64_t x2789;
+  fiat_p1024_uint1 x2790;
+  uint64_t x2791;
+  fiat_p1024_uint1 x2792;
+  uint64_t x2793;
+  fiat_p1024_uint1 x2794;
+  uint64_t x2795;
+  fiat_p1024_uint1 x2796;
+  uint64_t x2797;
+  fiat_p1024_uint1 x2798;
+  uint64_t x2799;
+  fiat_p1024_uint1 x2800;
+  uint64_t x2801;
+  fiat_p1024_uint1 x2802;
+  uint64_t x2803;
+  fiat_p1024_uint1 x2804;
+  uint64_t x2805;
+  fiat_p1024_uint1 x2806;
+  uint64_t x2807;
+  fiat_p1024_uint1 x2808;
+  uint64_t x2809;
+  fiat_p1024_uint1 x2810;
+  uint64_t x2811;
+  fiat_p1024_uint1 x2812;
+  uint64_t x2813;
+  fiat_p1024_uint1 x2814;
+  uint64_t x2815;
+  fiat_p1024_uint1 x2816;
+  uint64_t x2817;
+  fiat_p1024_uint1 x2818;
+  uint64_t x2819;
+  fiat_p1024_uint1 x2820;
+  uint64_t x2821;
+  fiat_p1024_uint1 x2822;
+  uint64_t x2823;
+  fiat_p1024_uint1 x2824;
+  uint64_t x2825;
+  fiat_p1024_uint1 x2826;
+  uint64_t x2827;
+  fiat_p1024_uint1 x2828;
+  uint64_t x2829;
+  fiat_p1024_uint1 x2830;
+  uint64_t x2831;
+  fiat_p1024_uint1 x2832;
+  uint64_t x2833;
+  fiat_p1024_uint1 x2834;
+  uint64_t x2835;
+  uint64_t x2836;
+  uint64_t x2837;
+  uint64_t x2838;
+  uint64_t x2839;
+  uint64_t x2840;
+  uint64_t x2841;
+  uint64_t x2842;
+  uint64_t x2843;
+  uint64_t x2844;
+  uint64_t x2845;
+  uint64_t x2846;
+  uint64_t x2847;
+  uint64_t x2848;
+  uint64_t x2849;
+  uint64_t x2850;
+  uint64_t x2851;
+  uint64_t x2852;
+  uint64_t x2853;
+  uint64_t x2854;
+  uint64_t x2855;
+  uint64_t x2856;
+  uint64_t x2857;
+  uint64_t x2858;
+  uint64_t x2859;
+  uint64_t x2860;
+  uint64_t x2861;
+  uint64_t x2862;
+  uint64_t x2863;
+  uint64_t x2864;
+  uint64_t x2865;
+  uint64_t x2866;
+  uint64_t x2867;
+  fiat_p1024_uint1 x2868;
+  uint64_t x2869;
+  fiat_p1024_uint1 x2870;
+  uint64_t x2871;
+  fiat_p1024_uint1 x2872;
+  uint64_t x2873;
+  fiat_p1024_uint1 x2874;
+  uint64_t x2875;
+  fiat_p1024_uint1 x2876;
+  uint64_t x2877;
+  fiat_p1024_uint1 x2878;
+  uint64_t x2879;
+  fiat_p1024_uint1 x2880;
+  uint64_t x2881;
+  fiat_p1024_uint1 x2882;
+  uint64_t x2883;
+  fiat_p1024_uint1 x2884;
+  uint64_t x2885;
+  fiat_p1024_uint1 x2886;
+  uint64_t x2887;
+  fiat_p1024_uint1 x2888;
+  uint64_t x2889;
+  fiat_p1024_uint1 x2890;
+  uint64_t x2891;
+  fiat_p1024_uint1 x2892;
+  uint64_t x2893;
+  fiat_p1024_uint1 x2894;
+  uint64_t x2895;
+  fiat_p1024_uint1 x2896;
+  uint64_t x2897;
+  fiat_p1024_uint1 x2898;
+  uint64_t x2899;
+  fiat_p1024_uint1 x2900;
+  uint64_t x2901;
+  fiat_p1024_uint1 x2902;
+  uint64_t x2903;
+  fiat_p1024_uint1 x2904;
+  uint64_t x2905;
+  fiat_p1024_uint1 x2906;
+  uint64_t x2907;
+  fiat_p1024_uint1 x2908;
+  uint64_t x2909;
+  fiat_p1024_uint1 x2910;
+  uint64_t x2911;
+  fiat_p1024_uint1 x2912;
+  uint64_t x2913;
+  fiat_p1024_uint1 x2914;
+  uint64_t x2915;
+  fiat_p1024_uint1 x2916;
+  uint64_t x2917;
+  fiat_p1024_uint1 x2918;
+  uint64_t x2919;
+  fiat_p1024_uint1 x2920;
+  uint64_t x2921;
+  fiat_p1024_uint1 x2922;
+  uint64_t x2923;
+  fiat_p1024_uint1 x2924;
+  uint64_t x2925;
+  fiat_p1024_uint1 x2926;
+  uint64_t x2927;
+  fiat_p1024_uint1 x2928;
+  uint64_t x2929;
+  uint64_t x2930;
+  uint64_t x2931;
+  uint64_t x2932;
+  uint64_t x2933;
+  uint64_t x2934;
+  uint64_t x2935;
+  uint64_t x2936;
+  uint64_t x2937;
+  uint64_t x2938;
+  uint64_t x2939;
+  uint64_t x2940;
+  uint64_t x2941;
+  uint64_t x2942;
+  uint64_t x2943;
+  uint64_t x2944;
+  uint64_t x2945;
+  uint64_t x2946;
+  uint64_t x2947;
+  uint64_t x2948;
+  uint64_t x2949;
+  uint64_t x2950;
+  uint64_t x2951;
+  uint64_t x2952;
+  uint64_t x2953;
+  uint64_t x2954;
+  uint64_t x2955;
+  uint64_t x2956;
+  uint64_t x2957;
+  uint64_t x2958;
+  uint64_t x2959;
+  uint64_t x2960;
+  uint64_t x2961;
+  uint64_t x2962;
+  uint64_t x2963;
+  fiat_p1024_uint1 x2964;
+  uint64_t x2965;
+  fiat_p1024_uint1 x2966;
+  uint64_t x2967;
+  fiat_p1024_uint1 x2968;
+  uint64_t x2969;
+  fiat_p1024_uint1 x2970;
+  uint64_t x2971;
+  fiat_p1024_uint1 x2972;
+  uint64_t x2973;
+  fiat_p1024_uint1 x2974;
+  uint64_t x2975;
+  fiat_p1024_uint1 x2976;
+  uint64_t x2977;
+  fiat_p1024_uint1 x2978;
+  uint64_t x2979;
+  fiat_p1024_uint1 x2980;
+  uint64_t x2981;
+  fiat_p1024_uint1 x2982;
+  uint64_t x2983;
+  fiat_p1024_uint1 x2984;
+  uint64_t x2985;
+  fiat_p1024_uint1 x2986;
+  uint64_t x2987;
+  fiat_p1024_uint1 x2988;
+  uint64_t x2989;
+  fiat_p1024_uint1 x2990;
+  uint64_t x2991;
+  fiat_p1024_uint1 x2992;
+  uint64_t x2993;
+  fiat_p1024_uint1 x2994;
+  uint64_t x2995;
+  fiat_p1024_uint1 x2996;
+  uint64_t x2997;
+  fiat_p1024_uint1 x2998;
+  uint64_t x2999;
+  fiat_p1024_uint1 x3000;
+  uint64_t x3001;
+  fiat_p1024_uint1 x3002;
+  uint64_t x3003;
+  fiat_p1024_uint1 x3004;
+  uint64_t x3005;
+  fiat_p1024_uint1 x3006;
+  uint64_t x3007;
+  fiat_p1024_uint1 x3008;
+  uint64_t x3009;
+  fiat_p1024_uint1 x3010;
+  uint64_t x3011;
+  fiat_p1024_uint1 x3012;
+  uint64_t x3013;
+  fiat_p1024_uint1 x3014;
+  uint64_t x3015;
+  fiat_p1024_uint1 x3016;
+  uint64_t x3017;
+  fiat_p1024_uint1 x3018;
+  uint64_t x3019;
+  fiat_p1024_uint1 x3020;
+  uint64_t x3021;
+  fiat_p1024_uint1 x3022;
+  uint64_t x3023;
+  fiat_p1024_uint1 x3024;
+  uint64_t x3025;
+  uint64_t x3026;
+  fiat_p1024_uint1 x3027;
+  uint64_t x3028;
+  fiat_p1024_uint1 x3029;
+  uint64_t x3030;
+  fiat_p1024_uint1 x3031;
+  uint64_t x3032;
+  fiat_p1024_uint1 x3033;
+  uint64_t x3034;
+  fiat_p1024_uint1 x3035;
+  uint64_t x3036;
+  fiat_p1024_uint1 x3037;
+  uint64_t x3038;
+  fiat_p1024_uint1 x3039;
+  uint64_t x3040;
+  fiat_p1024_uint1 x3041;
+  uint64_t x3042;
+  fiat_p1024_uint1 x3043;
+  uint64_t x3044;
+  fiat_p1024_uint1 x3045;
+  uint64_t x3046;
+  fiat_p1024_uint1 x3047;
+  uint64_t x3048;
+  fiat_p1024_uint1 x3049;
+  uint64_t x3050;
+  fiat_p1024_uint1 x3051;
+  uint64_t x3052;
+  fiat_p1024_uint1 x3053;
+  uint64_t x3054;
+  fiat_p1024_uint1 x3055;
+  uint64_t x3056;
+  fiat_p1024_uint1 x3057;
+  uint64_t x3058;
+  fiat_p1024_uint1 x3059;
+  uint64_t x3060;
+  uint64_t x3061;
+  uint64_t x3062;
+  uint64_t x3063;
+  uint64_t x3064;
+  uint64_t x3065;
+  uint64_t x3066;
+  uint64_t x3067;
+  uint64_t x3068;
+  uint64_t x3069;
+  uint64_t x3070;
+  uint64_t x3071;
+  uint64_t x3072;
+  uint64_t x3073;
+  uint64_t x3074;
+  uint64_t x3075;
+  x1 = (arg1[1]);
+  x2 = (arg1[2]);
+  x3 = (arg1[3]);
+  x4 = (arg1[4]);
+  x5 = (arg1[5]);
+  x6 = (arg1[6]);
+  x7 = (arg1[7]);
+  x8 = (arg1[8]);
+  x9 = (arg1[9]);
+  x10 = (arg1[10]);
+  x11 = (arg1[11]);
+  x12 = (arg1[12]);
+  x13 = (arg1[13]);
+  x14 = (arg1[14]);
+  x15 = (arg1[15]);
+  x16 = (arg1[0]);
+  fiat_p1024_mulx_u64(&x17, &x18, x16, UINT64_C(0x57162f911ca93a3));
+  fiat_p1024_mulx_u64(&x19, &x20, x16, UINT64_C(0xe1a2eb1d838b8237));
+  fiat_p1024_mulx_u64(&x21, &x22, x16, UINT64_C(0x4f8a8344bbe56288));
+  fiat_p1024_mulx_u64(&x23, &x24, x16, UINT64_C(0xc124d930289048b5));
+  fiat_p1024_mulx_u64(&x25, &x26, x16, UINT64_C(0xa5ae047480992b2c));
+  fiat_p1024_mulx_u64(&x27, &x28, x16, UINT64_C(0x156b34c683839b9d));
+  fiat_p1024_mulx_u64(&x29, &x30, x16, UINT64_C(0x9aad9631bb760403));
+  fiat_p1024_mulx_u64(&x31, &x32, x16, UINT64_C(0x8aa6fa7e6811faa8));
+  fiat_p1024_mulx_u64(&x33, &x34, x16, UINT64_C(0xa0056a67c1de0e82));
+  fiat_p1024_mulx_u64(&x35, &x36, x16, UINT64_C(0x9365ec4fa327c9ac));
+  fiat_p1024_mulx_u64(&x37, &x38, x16, UINT64_C(0x6cdde00636c4f801));
+  fiat_p1024_mulx_u64(&x39, &x40, x16, UINT64_C(0x8c1a56e5bf96471a));
+  fiat_p1024_mulx_u64(&x41, &x42, x16, UINT64_C(0x4f2940830c1d2b35));
+  fiat_p1024_mulx_u64(&x43, &x44, x16, UINT64_C(0x1322c9b9837dca17));
+  fiat_p1024_mulx_u64(&x45, &x46, x16, UINT64_C(0x68ac5d7707ccb03a));
+  fiat_p1024_mulx_u64(&x47, &x48, x16, UINT64_C(0xd6b8f146ec5055af));
+  fiat_p1024_addcarryx_u64(&x49, &x50, 0x0, x48, x45);
+  fiat_p1024_addcarryx_u64(&x51, &x52, x50, x46, x43);
+  fiat_p1024_addcarryx_u64(&x53, &x54, x52, x44, x41);
+  fiat_p1024_addcarryx_u64(&x55, &x56, x54, x42, x39);
+  fiat_p1024_addcarryx_u64(&x57, &x58, x56, x40, x37);
+  fiat_p1024_addcarryx_u64(&x59, &x60, x58, x38, x35);
+  fiat_p1024_addcarryx_u64(&x61, &x62, x60, x36, x33);
+  fiat_p1024_addcarryx_u64(&x63, &x64, x62, x34, x31);
+  fiat_p1024_addcarryx_u64(&x65, &x66, x64, x32, x29);
+  fiat_p1024_addcarryx_u64(&x67, &x68, x66, x30, x27);
+  fiat_p1024_addcarryx_u64(&x69, &x70, x68, x28, x25);
+  fiat_p1024_addcarryx_u64(&x71, &x72, x70, x26, x23);
+  fiat_p1024_addcarryx_u64(&x73, &x74, x72, x24, x21);
+  fiat_p1024_addcarryx_u64(&x75, &x76, x74, x22, x19);
+  fiat_p1024_addcarryx_u64(&x77, &x78, x76, x20, x17);
+  fiat_p1024_mulx_u64(&x79, &x80, x47, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x81, &x82, x79, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x83, &x84, x79, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x85, &x86, x79, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x87, &x88, x79, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x89, &x90, x79, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x91, &x92, x79, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x93, &x94, x79, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x95, &x96, x79, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x97, &x98, x79, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x99, &x100, x79, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x101, &x102, x79, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x103, &x104, x79, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x105, &x106, x79, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x107, &x108, x79, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x109, &x110, x79, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x111, &x112, x79, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x113, &x114, 0x0, x112, x109);
+  fiat_p1024_addcarryx_u64(&x115, &x116, x114, x110, x107);
+  fiat_p1024_addcarryx_u64(&x117, &x118, x116, x108, x105);
+  fiat_p1024_addcarryx_u64(&x119, &x120, x118, x106, x103);
+  fiat_p1024_addcarryx_u64(&x121, &x122, x120, x104, x101);
+  fiat_p1024_addcarryx_u64(&x123, &x124, x122, x102, x99);
+  fiat_p1024_addcarryx_u64(&x125, &x126, x124, x100, x97);
+  fiat_p1024_addcarryx_u64(&x127, &x128, x126, x98, x95);
+  fiat_p1024_addcarryx_u64(&x129, &x130, x128, x96, x93);
+  fiat_p1024_addcarryx_u64(&x131, &x132, x130, x94, x91);
+  fiat_p1024_addcarryx_u64(&x133, &x134, x132, x92, x89);
+  fiat_p1024_addcarryx_u64(&x135, &x136, x134, x90, x87);
+  fiat_p1024_addcarryx_u64(&x137, &x138, x136, x88, x85);
+  fiat_p1024_addcarryx_u64(&x139, &x140, x138, x86, x83);
+  fiat_p1024_addcarryx_u64(&x141, &x142, x140, x84, x81);
+  fiat_p1024_addcarryx_u64(&x143, &x144, 0x0, x47, x111);
+  fiat_p1024_addcarryx_u64(&x145, &x146, x144, x49, x113);
+  fiat_p1024_addcarryx_u64(&x147, &x148, x146, x51, x115);
+  fiat_p1024_addcarryx_u64(&x149, &x150, x148, x53, x117);
+  fiat_p1024_addcarryx_u64(&x151, &x152, x150, x55, x119);
+  fiat_p1024_addcarryx_u64(&x153, &x154, x152, x57, x121);
+  fiat_p1024_addcarryx_u64(&x155, &x156, x154, x59, x123);
+  fiat_p1024_addcarryx_u64(&x157, &x158, x156, x61, x125);
+  fiat_p1024_addcarryx_u64(&x159, &x160, x158, x63, x127);
+  fiat_p1024_addcarryx_u64(&x161, &x162, x160, x65, x129);
+  fiat_p1024_addcarryx_u64(&x163, &x164, x162, x67, x131);
+  fiat_p1024_addcarryx_u64(&x165, &x166, x164, x69, x133);
+  fiat_p1024_addcarryx_u64(&x167, &x168, x166, x71, x135);
+  fiat_p1024_addcarryx_u64(&x169, &x170, x168, x73, x137);
+  fiat_p1024_addcarryx_u64(&x171, &x172, x170, x75, x139);
+  fiat_p1024_addcarryx_u64(&x173, &x174, x172, x77, x141);
+  fiat_p1024_mulx_u64(&x175, &x176, x1, UINT64_C(0x57162f911ca93a3));
+  fiat_p1024_mulx_u64(&x177, &x178, x1, UINT64_C(0xe1a2eb1d838b8237));
+  fiat_p1024_mulx_u64(&x179, &x180, x1, UINT64_C(0x4f8a8344bbe56288));
+  fiat_p1024_mulx_u64(&x181, &x182, x1, UINT64_C(0xc124d930289048b5));
+  fiat_p1024_mulx_u64(&x183, &x184, x1, UINT64_C(0xa5ae047480992b2c));
+  fiat_p1024_mulx_u64(&x185, &x186, x1, UINT64_C(0x156b34c683839b9d));
+  fiat_p1024_mulx_u64(&x187, &x188, x1, UINT64_C(0x9aad9631bb760403));
+  fiat_p1024_mulx_u64(&x189, &x190, x1, UINT64_C(0x8aa6fa7e6811faa8));
+  fiat_p1024_mulx_u64(&x191, &x192, x1, UINT64_C(0xa0056a67c1de0e82));
+  fiat_p1024_mulx_u64(&x193, &x194, x1, UINT64_C(0x9365ec4fa327c9ac));
+  fiat_p1024_mulx_u64(&x195, &x196, x1, UINT64_C(0x6cdde00636c4f801));
+  fiat_p1024_mulx_u64(&x197, &x198, x1, UINT64_C(0x8c1a56e5bf96471a));
+  fiat_p1024_mulx_u64(&x199, &x200, x1, UINT64_C(0x4f2940830c1d2b35));
+  fiat_p1024_mulx_u64(&x201, &x202, x1, UINT64_C(0x1322c9b9837dca17));
+  fiat_p1024_mulx_u64(&x203, &x204, x1, UINT64_C(0x68ac5d7707ccb03a));
+  fiat_p1024_mulx_u64(&x205, &x206, x1, UINT64_C(0xd6b8f146ec5055af));
+  fiat_p1024_addcarryx_u64(&x207, &x208, 0x0, x206, x203);
+  fiat_p1024_addcarryx_u64(&x209, &x210, x208, x204, x201);
+  fiat_p1024_addcarryx_u64(&x211, &x212, x210, x202, x199);
+  fiat_p1024_addcarryx_u64(&x213, &x214, x212, x200, x197);
+  fiat_p1024_addcarryx_u64(&x215, &x216, x214, x198, x195);
+  fiat_p1024_addcarryx_u64(&x217, &x218, x216, x196, x193);
+  fiat_p1024_addcarryx_u64(&x219, &x220, x218, x194, x191);
+  fiat_p1024_addcarryx_u64(&x221, &x222, x220, x192, x189);
+  fiat_p1024_addcarryx_u64(&x223, &x224, x222, x190, x187);
+  fiat_p1024_addcarryx_u64(&x225, &x226, x224, x188, x185);
+  fiat_p1024_addcarryx_u64(&x227, &x228, x226, x186, x183);
+  fiat_p1024_addcarryx_u64(&x229, &x230, x228, x184, x181);
+  fiat_p1024_addcarryx_u64(&x231, &x232, x230, x182, x179);
+  fiat_p1024_addcarryx_u64(&x233, &x234, x232, x180, x177);
+  fiat_p1024_addcarryx_u64(&x235, &x236, x234, x178, x175);
+  fiat_p1024_addcarryx_u64(&x237, &x238, 0x0, x145, x205);
+  fiat_p1024_addcarryx_u64(&x239, &x240, x238, x147, x207);
+  fiat_p1024_addcarryx_u64(&x241, &x242, x240, x149, x209);
+  fiat_p1024_addcarryx_u64(&x243, &x244, x242, x151, x211);
+  fiat_p1024_addcarryx_u64(&x245, &x246, x244, x153, x213);
+  fiat_p1024_addcarryx_u64(&x247, &x248, x246, x155, x215);
+  fiat_p1024_addcarryx_u64(&x249, &x250, x248, x157, x217);
+  fiat_p1024_addcarryx_u64(&x251, &x252, x250, x159, x219);
+  fiat_p1024_addcarryx_u64(&x253, &x254, x252, x161, x221);
+  fiat_p1024_addcarryx_u64(&x255, &x256, x254, x163, x223);
+  fiat_p1024_addcarryx_u64(&x257, &x258, x256, x165, x225);
+  fiat_p1024_addcarryx_u64(&x259, &x260, x258, x167, x227);
+  fiat_p1024_addcarryx_u64(&x261, &x262, x260, x169, x229);
+  fiat_p1024_addcarryx_u64(&x263, &x264, x262, x171, x231);
+  fiat_p1024_addcarryx_u64(&x265, &x266, x264, x173, x233);
+  fiat_p1024_addcarryx_u64(&x267, &x268, x266, ((x174 + (x78 + x18)) + (x142 + x82)), x235);
+  fiat_p1024_mulx_u64(&x269, &x270, x237, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x271, &x272, x269, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x273, &x274, x269, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x275, &x276, x269, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x277, &x278, x269, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x279, &x280, x269, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x281, &x282, x269, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x283, &x284, x269, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x285, &x286, x269, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x287, &x288, x269, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x289, &x290, x269, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x291, &x292, x269, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x293, &x294, x269, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x295, &x296, x269, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x297, &x298, x269, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x299, &x300, x269, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x301, &x302, x269, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x303, &x304, 0x0, x302, x299);
+  fiat_p1024_addcarryx_u64(&x305, &x306, x304, x300, x297);
+  fiat_p1024_addcarryx_u64(&x307, &x308, x306, x298, x295);
+  fiat_p1024_addcarryx_u64(&x309, &x310, x308, x296, x293);
+  fiat_p1024_addcarryx_u64(&x311, &x312, x310, x294, x291);
+  fiat_p1024_addcarryx_u64(&x313, &x314, x312, x292, x289);
+  fiat_p1024_addcarryx_u64(&x315, &x316, x314, x290, x287);
+  fiat_p1024_addcarryx_u64(&x317, &x318, x316, x288, x285);
+  fiat_p1024_addcarryx_u64(&x319, &x320, x318, x286, x283);
+  fiat_p1024_addcarryx_u64(&x321, &x322, x320, x284, x281);
+  fiat_p1024_addcarryx_u64(&x323, &x324, x322, x282, x279);
+  fiat_p1024_addcarryx_u64(&x325, &x326, x324, x280, x277);
+  fiat_p1024_addcarryx_u64(&x327, &x328, x326, x278, x275);
+  fiat_p1024_addcarryx_u64(&x329, &x330, x328, x276, x273);
+  fiat_p1024_addcarryx_u64(&x331, &x332, x330, x274, x271);
+  fiat_p1024_addcarryx_u64(&x333, &x334, 0x0, x237, x301);
+  fiat_p1024_addcarryx_u64(&x335, &x336, x334, x239, x303);
+  fiat_p1024_addcarryx_u64(&x337, &x338, x336, x241, x305);
+  fiat_p1024_addcarryx_u64(&x339, &x340, x338, x243, x307);
+  fiat_p1024_addcarryx_u64(&x341, &x342, x340, x245, x309);
+  fiat_p1024_addcarryx_u64(&x343, &x344, x342, x247, x311);
+  fiat_p1024_addcarryx_u64(&x345, &x346, x344, x249, x313);
+  fiat_p1024_addcarryx_u64(&x347, &x348, x346, x251, x315);
+  fiat_p1024_addcarryx_u64(&x349, &x350, x348, x253, x317);
+  fiat_p1024_addcarryx_u64(&x351, &x352, x350, x255, x319);
+  fiat_p1024_addcarryx_u64(&x353, &x354, x352, x257, x321);
+  fiat_p1024_addcarryx_u64(&x355, &x356, x354, x259, x323);
+  fiat_p1024_addcarryx_u64(&x357, &x358, x356, x261, x325);
+  fiat_p1024_addcarryx_u64(&x359, &x360, x358, x263, x327);
+  fiat_p1024_addcarryx_u64(&x361, &x362, x360, x265, x329);
+  fiat_p1024_addcarryx_u64(&x363, &x364, x362, x267, x331);
+  fiat_p1024_mulx_u64(&x365, &x366, x2, UINT64_C(0x57162f911ca93a3));
+  fiat_p1024_mulx_u64(&x367, &x368, x2, UINT64_C(0xe1a2eb1d838b8237));
+  fiat_p1024_mulx_u64(&x369, &x370, x2, UINT64_C(0x4f8a8344bbe56288));
+  fiat_p1024_mulx_u64(&x371, &x372, x2, UINT64_C(0xc124d930289048b5));
+  fiat_p1024_mulx_u64(&x373, &x374, x2, UINT64_C(0xa5ae047480992b2c));
+  fiat_p1024_mulx_u64(&x375, &x376, x2, UINT64_C(0x156b34c683839b9d));
+  fiat_p1024_mulx_u64(&x377, &x378, x2, UINT64_C(0x9aad9631bb760403));
+  fiat_p1024_mulx_u64(&x379, &x380, x2, UINT64_C(0x8aa6fa7e6811faa8));
+  fiat_p1024_mulx_u64(&x381, &x382, x2, UINT64_C(0xa0056a67c1de0e82));
+  fiat_p1024_mulx_u64(&x383, &x384, x2, UINT64_C(0x9365ec4fa327c9ac));
+  fiat_p1024_mulx_u64(&x385, &x386, x2, UINT64_C(0x6cdde00636c4f801));
+  fiat_p1024_mulx_u64(&x387, &x388, x2, UINT64_C(0x8c1a56e5bf96471a));
+  fiat_p1024_mulx_u64(&x389, &x390, x2, UINT64_C(0x4f2940830c1d2b35));
+  fiat_p1024_mulx_u64(&x391, &x392, x2, UINT64_C(0x1322c9b9837dca17));
+  fiat_p1024_mulx_u64(&x393, &x394, x2, UINT64_C(0x68ac5d7707ccb03a));
+  fiat_p1024_mulx_u64(&x395, &x396, x2, UINT64_C(0xd6b8f146ec5055af));
+  fiat_p1024_addcarryx_u64(&x397, &x398, 0x0, x396, x393);
+  fiat_p1024_addcarryx_u64(&x399, &x400, x398, x394, x391);
+  fiat_p1024_addcarryx_u64(&x401, &x402, x400, x392, x389);
+  fiat_p1024_addcarryx_u64(&x403, &x404, x402, x390, x387);
+  fiat_p1024_addcarryx_u64(&x405, &x406, x404, x388, x385);
+  fiat_p1024_addcarryx_u64(&x407, &x408, x406, x386, x383);
+  fiat_p1024_addcarryx_u64(&x409, &x410, x408, x384, x381);
+  fiat_p1024_addcarryx_u64(&x411, &x412, x410, x382, x379);
+  fiat_p1024_addcarryx_u64(&x413, &x414, x412, x380, x377);
+  fiat_p1024_addcarryx_u64(&x415, &x416, x414, x378, x375);
+  fiat_p1024_addcarryx_u64(&x417, &x418, x416, x376, x373);
+  fiat_p1024_addcarryx_u64(&x419, &x420, x418, x374, x371);
+  fiat_p1024_addcarryx_u64(&x421, &x422, x420, x372, x369);
+  fiat_p1024_addcarryx_u64(&x423, &x424, x422, x370, x367);
+  fiat_p1024_addcarryx_u64(&x425, &x426, x424, x368, x365);
+  fiat_p1024_addcarryx_u64(&x427, &x428, 0x0, x335, x395);
+  fiat_p1024_addcarryx_u64(&x429, &x430, x428, x337, x397);
+  fiat_p1024_addcarryx_u64(&x431, &x432, x430, x339, x399);
+  fiat_p1024_addcarryx_u64(&x433, &x434, x432, x341, x401);
+  fiat_p1024_addcarryx_u64(&x435, &x436, x434, x343, x403);
+  fiat_p1024_addcarryx_u64(&x437, &x438, x436, x345, x405);
+  fiat_p1024_addcarryx_u64(&x439, &x440, x438, x347, x407);
+  fiat_p1024_addcarryx_u64(&x441, &x442, x440, x349, x409);
+  fiat_p1024_addcarryx_u64(&x443, &x444, x442, x351, x411);
+  fiat_p1024_addcarryx_u64(&x445, &x446, x444, x353, x413);
+  fiat_p1024_addcarryx_u64(&x447, &x448, x446, x355, x415);
+  fiat_p1024_addcarryx_u64(&x449, &x450, x448, x357, x417);
+  fiat_p1024_addcarryx_u64(&x451, &x452, x450, x359, x419);
+  fiat_p1024_addcarryx_u64(&x453, &x454, x452, x361, x421);
+  fiat_p1024_addcarryx_u64(&x455, &x456, x454, x363, x423);
+  fiat_p1024_addcarryx_u64(&x457, &x458, x456, ((x364 + (x268 + (x236 + x176))) + (x332 + x272)), x425);
+  fiat_p1024_mulx_u64(&x459, &x460, x427, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x461, &x462, x459, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x463, &x464, x459, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x465, &x466, x459, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x467, &x468, x459, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x469, &x470, x459, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x471, &x472, x459, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x473, &x474, x459, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x475, &x476, x459, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x477, &x478, x459, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x479, &x480, x459, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x481, &x482, x459, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x483, &x484, x459, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x485, &x486, x459, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x487, &x488, x459, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x489, &x490, x459, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x491, &x492, x459, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x493, &x494, 0x0, x492, x489);
+  fiat_p1024_addcarryx_u64(&x495, &x496, x494, x490, x487);
+  fiat_p1024_addcarryx_u64(&x497, &x498, x496, x488, x485);
+  fiat_p1024_addcarryx_u64(&x499, &x500, x498, x486, x483);
+  fiat_p1024_addcarryx_u64(&x501, &x502, x500, x484, x481);
+  fiat_p1024_addcarryx_u64(&x503, &x504, x502, x482, x479);
+  fiat_p1024_addcarryx_u64(&x505, &x506, x504, x480, x477);
+  fiat_p1024_addcarryx_u64(&x507, &x508, x506, x478, x475);
+  fiat_p1024_addcarryx_u64(&x509, &x510, x508, x476, x473);
+  fiat_p1024_addcarryx_u64(&x511, &x512, x510, x474, x471);
+  fiat_p1024_addcarryx_u64(&x513, &x514, x512, x472, x469);
+  fiat_p1024_addcarryx_u64(&x515, &x516, x514, x470, x467);
+  fiat_p1024_addcarryx_u64(&x517, &x518, x516, x468, x465);
+  fiat_p1024_addcarryx_u64(&x519, &x520, x518, x466, x463);
+  fiat_p1024_addcarryx_u64(&x521, &x522, x520, x464, x461);
+  fiat_p1024_addcarryx_u64(&x523, &x524, 0x0, x427, x491);
+  fiat_p1024_addcarryx_u64(&x525, &x526, x524, x429, x493);
+  fiat_p1024_addcarryx_u64(&x527, &x528, x526, x431, x495);
+  fiat_p1024_addcarryx_u64(&x529, &x530, x528, x433, x497);
+  fiat_p1024_addcarryx_u64(&x531, &x532, x530, x435, x499);
+  fiat_p1024_addcarryx_u64(&x533, &x534, x532, x437, x501);
+  fiat_p1024_addcarryx_u64(&x535, &x536, x534, x439, x503);
+  fiat_p1024_addcarryx_u64(&x537, &x538, x536, x441, x505);
+  fiat_p1024_addcarryx_u64(&x539, &x540, x538, x443, x507);
+  fiat_p1024_addcarryx_u64(&x541, &x542, x540, x445, x509);
+  fiat_p1024_addcarryx_u64(&x543, &x544, x542, x447, x511);
+  fiat_p1024_addcarryx_u64(&x545, &x546, x544, x449, x513);
+  fiat_p1024_addcarryx_u64(&x547, &x548, x546, x451, x515);
+  fiat_p1024_addcarryx_u64(&x549, &x550, x548, x453, x517);
+  fiat_p1024_addcarryx_u64(&x551, &x552, x550, x455, x519);
+  fiat_p1024_addcarryx_u64(&x553, &x554, x552, x457, x521);
+  fiat_p1024_mulx_u64(&x555, &x556, x3, UINT64_C(0x57162f911ca93a3));
+  fiat_p1024_mulx_u64(&x557, &x558, x3, UINT64_C(0xe1a2eb1d838b8237));
+  fiat_p1024_mulx_u64(&x559, &x560, x3, UINT64_C(0x4f8a8344bbe56288));
+  fiat_p1024_mulx_u64(&x561, &x562, x3, UINT64_C(0xc124d930289048b5));
+  fiat_p1024_mulx_u64(&x563, &x564, x3, UINT64_C(0xa5ae047480992b2c));
+  fiat_p1024_mulx_u64(&x565, &x566, x3, UINT64_C(0x156b34c683839b9d));
+  fiat_p1024_mulx_u64(&x567, &x568, x3, UINT64_C(0x9aad9631bb760403));
+  fiat_p1024_mulx_u64(&x569, &x570, x3, UINT64_C(0x8aa6fa7e6811faa8));
+  fiat_p1024_mulx_u64(&x571, &x572, x3, UINT64_C(0xa0056a67c1de0e82));
+  fiat_p1024_mulx_u64(&x573, &x574, x3, UINT64_C(0x9365ec4fa327c9ac));
+  fiat_p1024_mulx_u64(&x575, &x576, x3, UINT64_C(0x6cdde00636c4f801));
+  fiat_p1024_mulx_u64(&x577, &x578, x3, UINT64_C(0x8c1a56e5bf96471a));
+  fiat_p1024_mulx_u64(&x579, &x580, x3, UINT64_C(0x4f2940830c1d2b35));
+  fiat_p1024_mulx_u64(&x581, &x582, x3, UINT64_C(0x1322c9b9837dca17));
+  fiat_p1024_mulx_u64(&x583, &x584, x3, UINT64_C(0x68ac5d7707ccb03a));
+  fiat_p1024_mulx_u64(&x585, &x586, x3, UINT64_C(0xd6b8f146ec5055af));
+  fiat_p1024_addcarryx_u64(&x587, &x588, 0x0, x586, x583);
+  fiat_p1024_addcarryx_u64(&x589, &x590, x588, x584, x581);
+  fiat_p1024_addcarryx_u64(&x591, &x592, x590, x582, x579);
+  fiat_p1024_addcarryx_u64(&x593, &x594, x592, x580, x577);
+  fiat_p1024_addcarryx_u64(&x595, &x596, x594, x578, x575);
+  fiat_p1024_addcarryx_u64(&x597, &x598, x596, x576, x573);
+  fiat_p1024_addcarryx_u64(&x599, &x600, x598, x574, x571);
+  fiat_p1024_addcarryx_u64(&x601, &x602, x600, x572, x569);
+  fiat_p1024_addcarryx_u64(&x603, &x604, x602, x570, x567);
+  fiat_p1024_addcarryx_u64(&x605, &x606, x604, x568, x565);
+  fiat_p1024_addcarryx_u64(&x607, &x608, x606, x566, x563);
+  fiat_p1024_addcarryx_u64(&x609, &x610, x608, x564, x561);
+  fiat_p1024_addcarryx_u64(&x611, &x612, x610, x562, x559);
+  fiat_p1024_addcarryx_u64(&x613, &x614, x612, x560, x557);
+  fiat_p1024_addcarryx_u64(&x615, &x616, x614, x558, x555);
+  fiat_p1024_addcarryx_u64(&x617, &x618, 0x0, x525, x585);
+  fiat_p1024_addcarryx_u64(&x619, &x620, x618, x527, x587);
+  fiat_p1024_addcarryx_u64(&x621, &x622, x620, x529, x589);
+  fiat_p1024_addcarryx_u64(&x623, &x624, x622, x531, x591);
+  fiat_p1024_addcarryx_u64(&x625, &x626, x624, x533, x593);
+  fiat_p1024_addcarryx_u64(&x627, &x628, x626, x535, x595);
+  fiat_p1024_addcarryx_u64(&x629, &x630, x628, x537, x597);
+  fiat_p1024_addcarryx_u64(&x631, &x632, x630, x539, x599);
+  fiat_p1024_addcarryx_u64(&x633, &x634, x632, x541, x601);
+  fiat_p1024_addcarryx_u64(&x635, &x636, x634, x543, x603);
+  fiat_p1024_addcarryx_u64(&x637, &x638, x636, x545, x605);
+  fiat_p1024_addcarryx_u64(&x639, &x640, x638, x547, x607);
+  fiat_p1024_addcarryx_u64(&x641, &x642, x640, x549, x609);
+  fiat_p1024_addcarryx_u64(&x643, &x644, x642, x551, x611);
+  fiat_p1024_addcarryx_u64(&x645, &x646, x644, x553, x613);
+  fiat_p1024_addcarryx_u64(&x647, &x648, x646, ((x554 + (x458 + (x426 + x366))) + (x522 + x462)), x615);
+  fiat_p1024_mulx_u64(&x649, &x650, x617, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x651, &x652, x649, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x653, &x654, x649, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x655, &x656, x649, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x657, &x658, x649, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x659, &x660, x649, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x661, &x662, x649, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x663, &x664, x649, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x665, &x666, x649, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x667, &x668, x649, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x669, &x670, x649, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x671, &x672, x649, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x673, &x674, x649, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x675, &x676, x649, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x677, &x678, x649, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x679, &x680, x649, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x681, &x682, x649, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x683, &x684, 0x0, x682, x679);
+  fiat_p1024_addcarryx_u64(&x685, &x686, x684, x680, x677);
+  fiat_p1024_addcarryx_u64(&x687, &x688, x686, x678, x675);
+  fiat_p1024_addcarryx_u64(&x689, &x690, x688, x676, x673);
+  fiat_p1024_addcarryx_u64(&x691, &x692, x690, x674, x671);
+  fiat_p1024_addcarryx_u64(&x693, &x694, x692, x672, x669);
+  fiat_p1024_addcarryx_u64(&x695, &x696, x694, x670, x667);
+  fiat_p1024_addcarryx_u64(&x697, &x698, x696, x668, x665);
+  fiat_p1024_addcarryx_u64(&x699, &x700, x698, x666, x663);
+  fiat_p1024_addcarryx_u64(&x701, &x702, x700, x664, x661);
+  fiat_p1024_addcarryx_u64(&x703, &x704, x702, x662, x659);
+  fiat_p1024_addcarryx_u64(&x705, &x706, x704, x660, x657);
+  fiat_p1024_addcarryx_u64(&x707, &x708, x706, x658, x655);
+  fiat_p1024_addcarryx_u64(&x709, &x710, x708, x656, x653);
+  fiat_p1024_addcarryx_u64(&x711, &x712, x710, x654, x651);
+  fiat_p1024_addcarryx_u64(&x713, &x714, 0x0, x617, x681);
+  fiat_p1024_addcarryx_u64(&x715, &x716, x714, x619, x683);
+  fiat_p1024_addcarryx_u64(&x717, &x718, x716, x621, x685);
+  fiat_p1024_addcarryx_u64(&x719, &x720, x718, x623, x687);
+  fiat_p1024_addcarryx_u64(&x721, &x722, x720, x625, x689);
+  fiat_p1024_addcarryx_u64(&x723, &x724, x722, x627, x691);
+  fiat_p1024_addcarryx_u64(&x725, &x726, x724, x629, x693);
+  fiat_p1024_addcarryx_u64(&x727, &x728, x726, x631, x695);
+  fiat_p1024_addcarryx_u64(&x729, &x730, x728, x633, x697);
+  fiat_p1024_addcarryx_u64(&x731, &x732, x730, x635, x699);
+  fiat_p1024_addcarryx_u64(&x733, &x734, x732, x637, x701);
+  fiat_p1024_addcarryx_u64(&x735, &x736, x734, x639, x703);
+  fiat_p1024_addcarryx_u64(&x737, &x738, x736, x641, x705);
+  fiat_p1024_addcarryx_u64(&x739, &x740, x738, x643, x707);
+  fiat_p1024_addcarryx_u64(&x741, &x742, x740, x645, x709);
+  fiat_p1024_addcarryx_u64(&x743, &x744, x742, x647, x711);
+  fiat_p1024_mulx_u64(&x745, &x746, x4, UINT64_C(0x57162f911ca93a3));
+  fiat_p1024_mulx_u64(&x747, &x748, x4, UINT64_C(0xe1a2eb1d838b8237));
+  fiat_p1024_mulx_u64(&x749, &x750, x4, UINT64_C(0x4f8a8344bbe56288));
+  fiat_p1024_mulx_u64(&x751, &x752, x4, UINT64_C(0xc124d930289048b5));
+  fiat_p1024_mulx_u64(&x753, &x754, x4, UINT64_C(0xa5ae047480992b2c));
+  fiat_p1024_mulx_u64(&x755, &x756, x4, UINT64_C(0x156b34c683839b9d));
+  fiat_p1024_mulx_u64(&x757, &x758, x4, UINT64_C(0x9aad9631bb760403));
+  fiat_p1024_mulx_u64(&x759, &x760, x4, UINT64_C(0x8aa6fa7e6811faa8));
+  fiat_p1024_mulx_u64(&x761, &x762, x4, UINT64_C(0xa0056a67c1de0e82));
+  fiat_p1024_mulx_u64(&x763, &x764, x4, UINT64_C(0x9365ec4fa327c9ac));
+  fiat_p1024_mulx_u64(&x765, &x766, x4, UINT64_C(0x6cdde00636c4f801));
+  fiat_p1024_mulx_u64(&x767, &x768, x4, UINT64_C(0x8c1a56e5bf96471a));
+  fiat_p1024_mulx_u64(&x769, &x770, x4, UINT64_C(0x4f2940830c1d2b35));
+  fiat_p1024_mulx_u64(&x771, &x772, x4, UINT64_C(0x1322c9b9837dca17));
+  fiat_p1024_mulx_u64(&x773, &x774, x4, UINT64_C(0x68ac5d7707ccb03a));
+  fiat_p1024_mulx_u64(&x775, &x776, x4, UINT64_C(0xd6b8f146ec5055af));
+  fiat_p1024_addcarryx_u64(&x777, &x778, 0x0, x776, x773);
+  fiat_p1024_addcarryx_u64(&x779, &x780, x778, x774, x771);
+  fiat_p1024_addcarryx_u64(&x781, &x782, x780, x772, x769);
+  fiat_p1024_addcarryx_u64(&x783, &x784, x782, x770, x767);
+  fiat_p1024_addcarryx_u64(&x785, &x786, x784, x768, x765);
+  fiat_p1024_addcarryx_u64(&x787, &x788, x786, x766, x763);
+  fiat_p1024_addcarryx_u64(&x789, &x790, x788, x764, x761);
+  fiat_p1024_addcarryx_u64(&x791, &x792, x790, x762, x759);
+  fiat_p1024_addcarryx_u64(&x793, &x794, x792, x760, x757);
+  fiat_p1024_addcarryx_u64(&x795, &x796, x794, x758, x755);
+  fiat_p1024_addcarryx_u64(&x797, &x798, x796, x756, x753);
+  fiat_p1024_addcarryx_u64(&x799, &x800, x798, x754, x751);
+  fiat_p1024_addcarryx_u64(&x801, &x802, x800, x752, x749);
+  fiat_p1024_addcarryx_u64(&x803, &x804, x802, x750, x747);
+  fiat_p1024_addcarryx_u64(&x805, &x806, x804, x748, x745);
+  fiat_p1024_addcarryx_u64(&x807, &x808, 0x0, x715, x775);
+  fiat_p1024_addcarryx_u64(&x809, &x810, x808, x717, x777);
+  fiat_p1024_addcarryx_u64(&x811, &x812, x810, x719, x779);
+  fiat_p1024_addcarryx_u64(&x813, &x814, x812, x721, x781);
+  fiat_p1024_addcarryx_u64(&x815, &x816, x814, x723, x783);
+  fiat_p1024_addcarryx_u64(&x817, &x818, x816, x725, x785);
+  fiat_p1024_addcarryx_u64(&x819, &x820, x818, x727, x787);
+  fiat_p1024_addcarryx_u64(&x821, &x822, x820, x729, x789);
+  fiat_p1024_addcarryx_u64(&x823, &x824, x822, x731, x791);
+  fiat_p1024_addcarryx_u64(&x825, &x826, x824, x733, x793);
+  fiat_p1024_addcarryx_u64(&x827, &x828, x826, x735, x795);
+  fiat_p1024_addcarryx_u64(&x829, &x830, x828, x737, x797);
+  fiat_p1024_addcarryx_u64(&x831, &x832, x830, x739, x799);
+  fiat_p1024_addcarryx_u64(&x833, &x834, x832, x741, x801);
+  fiat_p1024_addcarryx_u64(&x835, &x836, x834, x743, x803);
+  fiat_p1024_addcarryx_u64(&x837, &x838, x836, ((x744 + (x648 + (x616 + x556))) + (x712 + x652)), x805);
+  fiat_p1024_mulx_u64(&x839, &x840, x807, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x841, &x842, x839, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x843, &x844, x839, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x845, &x846, x839, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x847, &x848, x839, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x849, &x850, x839, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x851, &x852, x839, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x853, &x854, x839, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x855, &x856, x839, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x857, &x858, x839, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x859, &x860, x839, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x861, &x862, x839, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x863, &x864, x839, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x865, &x866, x839, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x867, &x868, x839, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x869, &x870, x839, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x871, &x872, x839, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x873, &x874, 0x0, x872, x869);
+  fiat_p1024_addcarryx_u64(&x875, &x876, x874, x870, x867);
+  fiat_p1024_addcarryx_u64(&x877, &x878, x876, x868, x865);
+  fiat_p1024_addcarryx_u64(&x879, &x880, x878, x866, x863);
+  fiat_p1024_addcarryx_u64(&x881, &x882, x880, x864, x861);
+  fiat_p1024_addcarryx_u64(&x883, &x884, x882, x862, x859);
+  fiat_p1024_addcarryx_u64(&x885, &x886, x884, x860, x857);
+  fiat_p1024_addcarryx_u64(&x887, &x888, x886, x858, x855);
+  fiat_p1024_addcarryx_u64(&x889, &x890, x888, x856, x853);
+  fiat_p1024_addcarryx_u64(&x891, &x892, x890, x854, x851);
+  fiat_p1024_addcarryx_u64(&x893, &x894, x892, x852, x849);
+  fiat_p1024_addcarryx_u64(&x895, &x896, x894, x850, x847);
+  fiat_p1024_addcarryx_u64(&x897, &x898, x896, x848, x845);
+  fiat_p1024_addcarryx_u64(&x899, &x900, x898, x846, x843);
+  fiat_p1024_addcarryx_u64(&x901, &x902, x900, x844, x841);
+  fiat_p1024_addcarryx_u64(&x903, &x904, 0x0, x807, x871);
+  fiat_p1024_addcarryx_u64(&x905, &x906, x904, x809, x873);
+  fiat_p1024_addcarryx_u64(&x907, &x908, x906, x811, x875);
+  fiat_p1024_addcarryx_u64(&x909, &x910, x908, x813, x877);
+  fiat_p1024_addcarryx_u64(&x911, &x912, x910, x815, x879);
+  fiat_p1024_addcarryx_u64(&x913, &x914, x912, x817, x881);
+  fiat_p1024_addcarryx_u64(&x915, &x916, x914, x819, x883);
+  fiat_p1024_addcarryx_u64(&x917, &x918, x916, x821, x885);
+  fiat_p1024_addcarryx_u64(&x919, &x920, x918, x823, x887);
+  fiat_p1024_addcarryx_u64(&x921, &x922, x920, x825, x889);
+  fiat_p1024_addcarryx_u64(&x923, &x924, x922, x827, x891);
+  fiat_p1024_addcarryx_u64(&x925, &x926, x924, x829, x893);
+  fiat_p1024_addcarryx_u64(&x927, &x928, x926, x831, x895);
+  fiat_p1024_addcarryx_u64(&x929, &x930, x928, x833, x897);
+  fiat_p1024_addcarryx_u64(&x931, &x932, x930, x835, x899);
+  fiat_p1024_addcarryx_u64(&x933, &x934, x932, x837, x901);
+  fiat_p1024_mulx_u64(&x935, &x936, x5, UINT64_C(0x57162f911ca93a3));
+  fiat_p1024_mulx_u64(&x937, &x938, x5, UINT64_C(0xe1a2eb1d838b8237));
+  fiat_p1024_mulx_u64(&x939, &x940, x5, UINT64_C(0x4f8a8344bbe56288));
+  fiat_p1024_mulx_u64(&x941, &x942, x5, UINT64_C(0xc124d930289048b5));
+  fiat_p1024_mulx_u64(&x943, &x944, x5, UINT64_C(0xa5ae047480992b2c));
+  fiat_p1024_mulx_u64(&x945, &x946, x5, UINT64_C(0x156b34c683839b9d));
+  fiat_p1024_mulx_u64(&x947, &x948, x5, UINT64_C(0x9aad9631bb760403));
+  fiat_p1024_mulx_u64(&x949, &x950, x5, UINT64_C(0x8aa6fa7e6811faa8));
+  fiat_p1024_mulx_u64(&x951, &x952, x5, UINT64_C(0xa0056a67c1de0e82));
+  fiat_p1024_mulx_u64(&x953, &x954, x5, UINT64_C(0x9365ec4fa327c9ac));
+  fiat_p1024_mulx_u64(&x955, &x956, x5, UINT64_C(0x6cdde00636c4f801));
+  fiat_p1024_mulx_u64(&x957, &x958, x5, UINT64_C(0x8c1a56e5bf96471a));
+  fiat_p1024_mulx_u64(&x959, &x960, x5, UINT64_C(0x4f2940830c1d2b35));
+  fiat_p1024_mulx_u64(&x961, &x962, x5, UINT64_C(0x1322c9b9837dca17));
+  fiat_p1024_mulx_u64(&x963, &x964, x5, UINT64_C(0x68ac5d7707ccb03a));
+  fiat_p1024_mulx_u64(&x965, &x966, x5, UINT64_C(0xd6b8f146ec5055af));
+  fiat_p1024_addcarryx_u64(&x967, &x968, 0x0, x966, x963);
+  fiat_p1024_addcarryx_u64(&x969, &x970, x968, x964, x961);
+  fiat_p1024_addcarryx_u64(&x971, &x972, x970, x962, x959);
+  fiat_p1024_addcarryx_u64(&x973, &x974, x972, x960, x957);
+  fiat_p1024_addcarryx_u64(&x975, &x976, x974, x958, x955);
+  fiat_p1024_addcarryx_u64(&x977, &x978, x976, x956, x953);
+  fiat_p1024_addcarryx_u64(&x979, &x980, x978, x954, x951);
+  fiat_p1024_addcarryx_u64(&x981, &x982, x980, x952, x949);
+  fiat_p1024_addcarryx_u64(&x983, &x984, x982, x950, x947);
+  fiat_p1024_addcarryx_u64(&x985, &x986, x984, x948, x945);
+  fiat_p1024_addcarryx_u64(&x987, &x988, x986, x946, x943);
+  fiat_p1024_addcarryx_u64(&x989, &x990, x988, x944, x941);
+  fiat_p1024_addcarryx_u64(&x991, &x992, x990, x942, x939);
+  fiat_p1024_addcarryx_u64(&x993, &x994, x992, x940, x937);
+  fiat_p1024_addcarryx_u64(&x995, &x996, x994, x938, x935);
+  fiat_p1024_addcarryx_u64(&x997, &x998, 0x0, x905, x965);
+  fiat_p1024_addcarryx_u64(&x999, &x1000, x998, x907, x967);
+  fiat_p1024_addcarryx_u64(&x1001, &x1002, x1000, x909, x969);
+  fiat_p1024_addcarryx_u64(&x1003, &x1004, x1002, x911, x971);
+  fiat_p1024_addcarryx_u64(&x1005, &x1006, x1004, x913, x973);
+  fiat_p1024_addcarryx_u64(&x1007, &x1008, x1006, x915, x975);
+  fiat_p1024_addcarryx_u64(&x1009, &x1010, x1008, x917, x977);
+  fiat_p1024_addcarryx_u64(&x1011, &x1012, x1010, x919, x979);
+  fiat_p1024_addcarryx_u64(&x1013, &x1014, x1012, x921, x981);
+  fiat_p1024_addcarryx_u64(&x1015, &x1016, x1014, x923, x983);
+  fiat_p1024_addcarryx_u64(&x1017, &x1018, x1016, x925, x985);
+  fiat_p1024_addcarryx_u64(&x1019, &x1020, x1018, x927, x987);
+  fiat_p1024_addcarryx_u64(&x1021, &x1022, x1020, x929, x989);
+  fiat_p1024_addcarryx_u64(&x1023, &x1024, x1022, x931, x991);
+  fiat_p1024_addcarryx_u64(&x1025, &x1026, x1024, x933, x993);
+  fiat_p1024_addcarryx_u64(&x1027, &x1028, x1026, ((x934 + (x838 + (x806 + x746))) + (x902 + x842)), x995);
+  fiat_p1024_mulx_u64(&x1029, &x1030, x997, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x1031, &x1032, x1029, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x1033, &x1034, x1029, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x1035, &x1036, x1029, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x1037, &x1038, x1029, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x1039, &x1040, x1029, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x1041, &x1042, x1029, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x1043, &x1044, x1029, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x1045, &x1046, x1029, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x1047, &x1048, x1029, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x1049, &x1050, x1029, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x1051, &x1052, x1029, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x1053, &x1054, x1029, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x1055, &x1056, x1029, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x1057, &x1058, x1029, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x1059, &x1060, x1029, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x1061, &x1062, x1029, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x1063, &x1064, 0x0, x1062, x1059);
+  fiat_p1024_addcarryx_u64(&x1065, &x1066, x1064, x1060, x1057);
+  fiat_p1024_addcarryx_u64(&x1067, &x1068, x1066, x1058, x1055);
+  fiat_p1024_addcarryx_u64(&x1069, &x1070, x1068, x1056, x1053);
+  fiat_p1024_addcarryx_u64(&x1071, &x1072, x1070, x1054, x1051);
+  fiat_p1024_addcarryx_u64(&x1073, &x1074, x1072, x1052, x1049);
+  fiat_p1024_addcarryx_u64(&x1075, &x1076, x1074, x1050, x1047);
+  fiat_p1024_addcarryx_u64(&x1077, &x1078, x1076, x1048, x1045);
+  fiat_p1024_addcarryx_u64(&x1079, &x1080, x1078, x1046, x1043);
+  fiat_p1024_addcarryx_u64(&x1081, &x1082, x1080, x1044, x1041);
+  fiat_p1024_addcarryx_u64(&x1083, &x1084, x1082, x1042, x1039);
+  fiat_p1024_addcarryx_u64(&x1085, &x1086, x1084, x1040, x1037);
+  fiat_p1024_addcarryx_u64(&x1087, &x1088, x1086, x1038, x1035);
+  fiat_p1024_addcarryx_u64(&x1089, &x1090, x1088, x1036, x1033);
+  fiat_p1024_addcarryx_u64(&x1091, &x1092, x1090, x1034, x1031);
+  fiat_p1024_addcarryx_u64(&x1093, &x1094, 0x0, x997, x1061);
+  fiat_p1024_addcarryx_u64(&x1095, &x1096, x1094, x999, x1063);
+  fiat_p1024_addcarryx_u64(&x1097, &x1098, x1096, x1001, x1065);
+  fiat_p1024_addcarryx_u64(&x1099, &x1100, x1098, x1003, x1067);
+  fiat_p1024_addcarryx_u64(&x1101, &x1102, x1100, x1005, x1069);
+  fiat_p1024_addcarryx_u64(&x1103, &x1104, x1102, x1007, x1071);
+  fiat_p1024_addcarryx_u64(&x1105, &x1106, x1104, x1009, x1073);
+  fiat_p1024_addcarryx_u64(&x1107, &x1108, x1106, x1011, x1075);
+  fiat_p1024_addcarryx_u64(&x1109, &x1110, x1108, x1013, x1077);
+  fiat_p1024_addcarryx_u64(&x1111, &x1112, x1110, x1015, x1079);
+  fiat_p1024_addcarryx_u64(&x1113, &x1114, x1112, x1017, x1081);
+  fiat_p1024_addcarryx_u64(&x1115, &x1116, x1114, x1019, x1083);
+  fiat_p1024_addcarryx_u64(&x1117, &x1118, x1116, x1021, x1085);
+  fiat_p1024_addcarryx_u64(&x1119, &x1120, x1118, x1023, x1087);
+  fiat_p1024_addcarryx_u64(&x1121, &x1122, x1120, x1025, x1089);
+  fiat_p1024_addcarryx_u64(&x1123, &x1124, x1122, x1027, x1091);
+  fiat_p1024_mulx_u64(&x1125, &x1126, x6, UINT64_C(0x57162f911ca93a3));
+  fiat_p1024_mulx_u64(&x1127, &x1128, x6, UINT64_C(0xe1a2eb1d838b8237));
+  fiat_p1024_mulx_u64(&x1129, &x1130, x6, UINT64_C(0x4f8a8344bbe56288));
+  fiat_p1024_mulx_u64(&x1131, &x1132, x6, UINT64_C(0xc124d930289048b5));
+  fiat_p1024_mulx_u64(&x1133, &x1134, x6, UINT64_C(0xa5ae047480992b2c));
+  fiat_p1024_mulx_u64(&x1135, &x1136, x6, UINT64_C(0x156b34c683839b9d));
+  fiat_p1024_mulx_u64(&x1137, &x1138, x6, UINT64_C(0x9aad9631bb760403));
+  fiat_p1024_mulx_u64(&x1139, &x1140, x6, UINT64_C(0x8aa6fa7e6811faa8));
+  fiat_p1024_mulx_u64(&x1141, &x1142, x6, UINT64_C(0xa0056a67c1de0e82));
+  fiat_p1024_mulx_u64(&x1143, &x1144, x6, UINT64_C(0x9365ec4fa327c9ac));
+  fiat_p1024_mulx_u64(&x1145, &x1146, x6, UINT64_C(0x6cdde00636c4f801));
+  fiat_p1024_mulx_u64(&x1147, &x1148, x6, UINT64_C(0x8c1a56e5bf96471a));
+  fiat_p1024_mulx_u64(&x1149, &x1150, x6, UINT64_C(0x4f2940830c1d2b35));
+  fiat_p1024_mulx_u64(&x1151, &x1152, x6, UINT64_C(0x1322c9b9837dca17));
+  fiat_p1024_mulx_u64(&x1153, &x1154, x6, UINT64_C(0x68ac5d7707ccb03a));
+  fiat_p1024_mulx_u64(&x1155, &x1156, x6, UINT64_C(0xd6b8f146ec5055af));
+  fiat_p1024_addcarryx_u64(&x1157, &x1158, 0x0, x1156, x1153);
+  fiat_p1024_addcarryx_u64(&x1159, &x1160, x1158, x1154, x1151);
+  fiat_p1024_addcarryx_u64(&x1161, &x1162, x1160, x1152, x1149);
+  fiat_p1024_addcarryx_u64(&x1163, &x1164, x1162, x1150, x1147);
+  fiat_p1024_addcarryx_u64(&x1165, &x1166, x1164, x1148, x1145);
+  fiat_p1024_addcarryx_u64(&x1167, &x1168, x1166, x1146, x1143);
+  fiat_p1024_addcarryx_u64(&x1169, &x1170, x1168, x1144, x1141);
+  fiat_p1024_addcarryx_u64(&x1171, &x1172, x1170, x1142, x1139);
+  fiat_p1024_addcarryx_u64(&x1173, &x1174, x1172, x1140, x1137);
+  fiat_p1024_addcarryx_u64(&x1175, &x1176, x1174, x1138, x1135);
+  fiat_p1024_addcarryx_u64(&x1177, &x1178, x1176, x1136, x1133);
+  fiat_p1024_addcarryx_u64(&x1179, &x1180, x1178, x1134, x1131);
+  fiat_p1024_addcarryx_u64(&x1181, &x1182, x1180, x1132, x1129);
+  fiat_p1024_addcarryx_u64(&x1183, &x1184, x1182, x1130, x1127);
+  fiat_p1024_addcarryx_u64(&x1185, &x1186, x1184, x1128, x1125);
+  fiat_p1024_addcarryx_u64(&x1187, &x1188, 0x0, x1095, x1155);
+  fiat_p1024_addcarryx_u64(&x1189, &x1190, x1188, x1097, x1157);
+  fiat_p1024_addcarryx_u64(&x1191, &x1192, x1190, x1099, x1159);
+  fiat_p1024_addcarryx_u64(&x1193, &x1194, x1192, x1101, x1161);
+  fiat_p1024_addcarryx_u64(&x1195, &x1196, x1194, x1103, x1163);
+  fiat_p1024_addcarryx_u64(&x1197, &x1198, x1196, x1105, x1165);
+  fiat_p1024_addcarryx_u64(&x1199, &x1200, x1198, x1107, x1167);
+  fiat_p1024_addcarryx_u64(&x1201, &x1202, x1200, x1109, x1169);
+  fiat_p1024_addcarryx_u64(&x1203, &x1204, x1202, x1111, x1171);
+  fiat_p1024_addcarryx_u64(&x1205, &x1206, x1204, x1113, x1173);
+  fiat_p1024_addcarryx_u64(&x1207, &x1208, x1206, x1115, x1175);
+  fiat_p1024_addcarryx_u64(&x1209, &x1210, x1208, x1117, x1177);
+  fiat_p1024_addcarryx_u64(&x1211, &x1212, x1210, x1119, x1179);
+  fiat_p1024_addcarryx_u64(&x1213, &x1214, x1212, x1121, x1181);
+  fiat_p1024_addcarryx_u64(&x1215, &x1216, x1214, x1123, x1183);
+  fiat_p1024_addcarryx_u64(&x1217, &x1218, x1216, ((x1124 + (x1028 + (x996 + x936))) + (x1092 + x1032)), x1185);
+  fiat_p1024_mulx_u64(&x1219, &x1220, x1187, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x1221, &x1222, x1219, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x1223, &x1224, x1219, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x1225, &x1226, x1219, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x1227, &x1228, x1219, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x1229, &x1230, x1219, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x1231, &x1232, x1219, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x1233, &x1234, x1219, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x1235, &x1236, x1219, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x1237, &x1238, x1219, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x1239, &x1240, x1219, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x1241, &x1242, x1219, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x1243, &x1244, x1219, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x1245, &x1246, x1219, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x1247, &x1248, x1219, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x1249, &x1250, x1219, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x1251, &x1252, x1219, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x1253, &x1254, 0x0, x1252, x1249);
+  fiat_p1024_addcarryx_u64(&x1255, &x1256, x1254, x1250, x1247);
+  fiat_p1024_addcarryx_u64(&x1257, &x1258, x1256, x1248, x1245);
+  fiat_p1024_addcarryx_u64(&x1259, &x1260, x1258, x1246, x1243);
+  fiat_p1024_addcarryx_u64(&x1261, &x1262, x1260, x1244, x1241);
+  fiat_p1024_addcarryx_u64(&x1263, &x1264, x1262, x1242, x1239);
+  fiat_p1024_addcarryx_u64(&x1265, &x1266, x1264, x1240, x1237);
+  fiat_p1024_addcarryx_u64(&x1267, &x1268, x1266, x1238, x1235);
+  fiat_p1024_addcarryx_u64(&x1269, &x1270, x1268, x1236, x1233);
+  fiat_p1024_addcarryx_u64(&x1271, &x1272, x1270, x1234, x1231);
+  fiat_p1024_addcarryx_u64(&x1273, &x1274, x1272, x1232, x1229);
+  fiat_p1024_addcarryx_u64(&x1275, &x1276, x1274, x1230, x1227);
+  fiat_p1024_addcarryx_u64(&x1277, &x1278, x1276, x1228, x1225);
+  fiat_p1024_addcarryx_u64(&x1279, &x1280, x1278, x1226, x1223);
+  fiat_p1024_addcarryx_u64(&x1281, &x1282, x1280, x1224, x1221);
+  fiat_p1024_addcarryx_u64(&x1283, &x1284, 0x0, x1187, x1251);
+  fiat_p1024_addcarryx_u64(&x1285, &x1286, x1284, x1189, x1253);
+  fiat_p1024_addcarryx_u64(&x1287, &x1288, x1286, x1191, x1255);
+  fiat_p1024_addcarryx_u64(&x1289, &x1290, x1288, x1193, x1257);
+  fiat_p1024_addcarryx_u64(&x1291, &x1292, x1290, x1195, x1259);
+  fiat_p1024_addcarryx_u64(&x1293, &x1294, x1292, x1197, x1261);
+  fiat_p1024_addcarryx_u64(&x1295, &x1296, x1294, x1199, x1263);
+  fiat_p1024_addcarryx_u64(&x1297, &x1298, x1296, x1201, x1265);
+  fiat_p1024_addcarryx_u64(&x1299, &x1300, x1298, x1203, x1267);
+  fiat_p1024_addcarryx_u64(&x1301, &x1302, x1300, x1205, x1269);
+  fiat_p1024_addcarryx_u64(&x1303, &x1304, x1302, x1207, x1271);
+  fiat_p1024_addcarryx_u64(&x1305, &x1306, x1304, x1209, x1273);
+  fiat_p1024_addcarryx_u64(&x1307, &x1308, x1306, x1211, x1275);
+  fiat_p1024_addcarryx_u64(&x1309, &x1310, x1308, x1213, x1277);
+  fiat_p1024_addcarryx_u64(&x1311, &x1312, x1310, x1215, x1279);
+  fiat_p1024_addcarryx_u64(&x1313, &x1314, x1312, x1217, x1281);
+  fiat_p1024_mulx_u64(&x1315, &x1316, x7, UINT64_C(0x57162f911ca93a3));
+  fiat_p1024_mulx_u64(&x1317, &x1318, x7, UINT64_C(0xe1a2eb1d838b8237));
+  fiat_p1024_mulx_u64(&x1319, &x1320, x7, UINT64_C(0x4f8a8344bbe56288));
+  fiat_p1024_mulx_u64(&x1321, &x1322, x7, UINT64_C(0xc124d930289048b5));
+  fiat_p1024_mulx_u64(&x1323, &x1324, x7, UINT64_C(0xa5ae047480992b2c));
+  fiat_p1024_mulx_u64(&x1325, &x1326, x7, UINT64_C(0x156b34c683839b9d));
+  fiat_p1024_mulx_u64(&x1327, &x1328, x7, UINT64_C(0x9aad9631bb760403));
+  fiat_p1024_mulx_u64(&x1329, &x1330, x7, UINT64_C(0x8aa6fa7e6811faa8));
+  fiat_p1024_mulx_u64(&x1331, &x1332, x7, UINT64_C(0xa0056a67c1de0e82));
+  fiat_p1024_mulx_u64(&x1333, &x1334, x7, UINT64_C(0x9365ec4fa327c9ac));
+  fiat_p1024_mulx_u64(&x1335, &x1336, x7, UINT64_C(0x6cdde00636c4f801));
+  fiat_p1024_mulx_u64(&x1337, &x1338, x7, UINT64_C(0x8c1a56e5bf96471a));
+  fiat_p1024_mulx_u64(&x1339, &x1340, x7, UINT64_C(0x4f2940830c1d2b35));
+  fiat_p1024_mulx_u64(&x1341, &x1342, x7, UINT64_C(0x1322c9b9837dca17));
+  fiat_p1024_mulx_u64(&x1343, &x1344, x7, UINT64_C(0x68ac5d7707ccb03a));
+  fiat_p1024_mulx_u64(&x1345, &x1346, x7, UINT64_C(0xd6b8f146ec5055af));
+  fiat_p1024_addcarryx_u64(&x1347, &x1348, 0x0, x1346, x1343);
+  fiat_p1024_addcarryx_u64(&x1349, &x1350, x1348, x1344, x1341);
+  fiat_p1024_addcarryx_u64(&x1351, &x1352, x1350, x1342, x1339);
+  fiat_p1024_addcarryx_u64(&x1353, &x1354, x1352, x1340, x1337);
+  fiat_p1024_addcarryx_u64(&x1355, &x1356, x1354, x1338, x1335);
+  fiat_p1024_addcarryx_u64(&x1357, &x1358, x1356, x1336, x1333);
+  fiat_p1024_addcarryx_u64(&x1359, &x1360, x1358, x1334, x1331);
+  fiat_p1024_addcarryx_u64(&x1361, &x1362, x1360, x1332, x1329);
+  fiat_p1024_addcarryx_u64(&x1363, &x1364, x1362, x1330, x1327);
+  fiat_p1024_addcarryx_u64(&x1365, &x1366, x1364, x1328, x1325);
+  fiat_p1024_addcarryx_u64(&x1367, &x1368, x1366, x1326, x1323);
+  fiat_p1024_addcarryx_u64(&x1369, &x1370, x1368, x1324, x1321);
+  fiat_p1024_addcarryx_u64(&x1371, &x1372, x1370, x1322, x1319);
+  fiat_p1024_addcarryx_u64(&x1373, &x1374, x1372, x1320, x1317);
+  fiat_p1024_addcarryx_u64(&x1375, &x1376, x1374, x1318, x1315);
+  fiat_p1024_addcarryx_u64(&x1377, &x1378, 0x0, x1285, x1345);
+  fiat_p1024_addcarryx_u64(&x1379, &x1380, x1378, x1287, x1347);
+  fiat_p1024_addcarryx_u64(&x1381, &x1382, x1380, x1289, x1349);
+  fiat_p1024_addcarryx_u64(&x1383, &x1384, x1382, x1291, x1351);
+  fiat_p1024_addcarryx_u64(&x1385, &x1386, x1384, x1293, x1353);
+  fiat_p1024_addcarryx_u64(&x1387, &x1388, x1386, x1295, x1355);
+  fiat_p1024_addcarryx_u64(&x1389, &x1390, x1388, x1297, x1357);
+  fiat_p1024_addcarryx_u64(&x1391, &x1392, x1390, x1299, x1359);
+  fiat_p1024_addcarryx_u64(&x1393, &x1394, x1392, x1301, x1361);
+  fiat_p1024_addcarryx_u64(&x1395, &x1396, x1394, x1303, x1363);
+  fiat_p1024_addcarryx_u64(&x1397, &x1398, x1396, x1305, x1365);
+  fiat_p1024_addcarryx_u64(&x1399, &x1400, x1398, x1307, x1367);
+  fiat_p1024_addcarryx_u64(&x1401, &x1402, x1400, x1309, x1369);
+  fiat_p1024_addcarryx_u64(&x1403, &x1404, x1402, x1311, x1371);
+  fiat_p1024_addcarryx_u64(&x1405, &x1406, x1404, x1313, x1373);
+  fiat_p1024_addcarryx_u64(&x1407, &x1408, x1406, ((x1314 + (x1218 + (x1186 + x1126))) + (x1282 + x1222)), x1375);
+  fiat_p1024_mulx_u64(&x1409, &x1410, x1377, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x1411, &x1412, x1409, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x1413, &x1414, x1409, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x1415, &x1416, x1409, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x1417, &x1418, x1409, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x1419, &x1420, x1409, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x1421, &x1422, x1409, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x1423, &x1424, x1409, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x1425, &x1426, x1409, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x1427, &x1428, x1409, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x1429, &x1430, x1409, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x1431, &x1432, x1409, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x1433, &x1434, x1409, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x1435, &x1436, x1409, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x1437, &x1438, x1409, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x1439, &x1440, x1409, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x1441, &x1442, x1409, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x1443, &x1444, 0x0, x1442, x1439);
+  fiat_p1024_addcarryx_u64(&x1445, &x1446, x1444, x1440, x1437);
+  fiat_p1024_addcarryx_u64(&x1447, &x1448, x1446, x1438, x1435);
+  fiat_p1024_addcarryx_u64(&x1449, &x1450, x1448, x1436, x1433);
+  fiat_p1024_addcarryx_u64(&x1451, &x1452, x1450, x1434, x1431);
+  fiat_p1024_addcarryx_u64(&x1453, &x1454, x1452, x1432, x1429);
+  fiat_p1024_addcarryx_u64(&x1455, &x1456, x1454, x1430, x1427);
+  fiat_p1024_addcarryx_u64(&x1457, &x1458, x1456, x1428, x1425);
+  fiat_p1024_addcarryx_u64(&x1459, &x1460, x1458, x1426, x1423);
+  fiat_p1024_addcarryx_u64(&x1461, &x1462, x1460, x1424, x1421);
+  fiat_p1024_addcarryx_u64(&x1463, &x1464, x1462, x1422, x1419);
+  fiat_p1024_addcarryx_u64(&x1465, &x1466, x1464, x1420, x1417);
+  fiat_p1024_addcarryx_u64(&x1467, &x1468, x1466, x1418, x1415);
+  fiat_p1024_addcarryx_u64(&x1469, &x1470, x1468, x1416, x1413);
+  fiat_p1024_addcarryx_u64(&x1471, &x1472, x1470, x1414, x1411);
+  fiat_p1024_addcarryx_u64(&x1473, &x1474, 0x0, x1377, x1441);
+  fiat_p1024_addcarryx_u64(&x1475, &x1476, x1474, x1379, x1443);
+  fiat_p1024_addcarryx_u64(&x1477, &x1478, x1476, x1381, x1445);
+  fiat_p1024_addcarryx_u64(&x1479, &x1480, x1478, x1383, x1447);
+  fiat_p1024_addcarryx_u64(&x1481, &x1482, x1480, x1385, x1449);
+  fiat_p1024_addcarryx_u64(&x1483, &x1484, x1482, x1387, x1451);
+  fiat_p1024_addcarryx_u64(&x1485, &x1486, x1484, x1389, x1453);
+  fiat_p1024_addcarryx_u64(&x1487, &x1488, x1486, x1391, x1455);
+  fiat_p1024_addcarryx_u64(&x1489, &x1490, x1488, x1393, x1457);
+  fiat_p1024_addcarryx_u64(&x1491, &x1492, x1490, x1395, x1459);
+  fiat_p1024_addcarryx_u64(&x1493, &x1494, x1492, x1397, x1461);
+  fiat_p1024_addcarryx_u64(&x1495, &x1496, x1494, x1399, x1463);
+  fiat_p1024_addcarryx_u64(&x1497, &x1498, x1496, x1401, x1465);
+  fiat_p1024_addcarryx_u64(&x1499, &x1500, x1498, x1403, x1467);
+  fiat_p1024_addcarryx_u64(&x1501, &x1502, x1500, x1405, x1469);
+  fiat_p1024_addcarryx_u64(&x1503, &x1504, x1502, x1407, x1471);
+  fiat_p1024_mulx_u64(&x1505, &x1506, x8, UINT64_C(0x57162f911ca93a3));
+  fiat_p1024_mulx_u64(&x1507, &x1508, x8, UINT64_C(0xe1a2eb1d838b8237));
+  fiat_p1024_mulx_u64(&x1509, &x1510, x8, UINT64_C(0x4f8a8344bbe56288));
+  fiat_p1024_mulx_u64(&x1511, &x1512, x8, UINT64_C(0xc124d930289048b5));
+  fiat_p1024_mulx_u64(&x1513, &x1514, x8, UINT64_C(0xa5ae047480992b2c));
+  fiat_p1024_mulx_u64(&x1515, &x1516, x8, UINT64_C(0x156b34c683839b9d));
+  fiat_p1024_mulx_u64(&x1517, &x1518, x8, UINT64_C(0x9aad9631bb760403));
+  fiat_p1024_mulx_u64(&x1519, &x1520, x8, UINT64_C(0x8aa6fa7e6811faa8));
+  fiat_p1024_mulx_u64(&x1521, &x1522, x8, UINT64_C(0xa0056a67c1de0e82));
+  fiat_p1024_mulx_u64(&x1523, &x1524, x8, UINT64_C(0x9365ec4fa327c9ac));
+  fiat_p1024_mulx_u64(&x1525, &x1526, x8, UINT64_C(0x6cdde00636c4f801));
+  fiat_p1024_mulx_u64(&x1527, &x1528, x8, UINT64_C(0x8c1a56e5bf96471a));
+  fiat_p1024_mulx_u64(&x1529, &x1530, x8, UINT64_C(0x4f2940830c1d2b35));
+  fiat_p1024_mulx_u64(&x1531, &x1532, x8, UINT64_C(0x1322c9b9837dca17));
+  fiat_p1024_mulx_u64(&x1533, &x1534, x8, UINT64_C(0x68ac5d7707ccb03a));
+  fiat_p1024_mulx_u64(&x1535, &x1536, x8, UINT64_C(0xd6b8f146ec5055af));
+  fiat_p1024_addcarryx_u64(&x1537, &x1538, 0x0, x1536, x1533);
+  fiat_p1024_addcarryx_u64(&x1539, &x1540, x1538, x1534, x1531);
+  fiat_p1024_addcarryx_u64(&x1541, &x1542, x1540, x1532, x1529);
+  fiat_p1024_addcarryx_u64(&x1543, &x1544, x1542, x1530, x1527);
+  fiat_p1024_addcarryx_u64(&x1545, &x1546, x1544, x1528, x1525);
+  fiat_p1024_addcarryx_u64(&x1547, &x1548, x1546, x1526, x1523);
+  fiat_p1024_addcarryx_u64(&x1549, &x1550, x1548, x1524, x1521);
+  fiat_p1024_addcarryx_u64(&x1551, &x1552, x1550, x1522, x1519);
+  fiat_p1024_addcarryx_u64(&x1553, &x1554, x1552, x1520, x1517);
+  fiat_p1024_addcarryx_u64(&x1555, &x1556, x1554, x1518, x1515);
+  fiat_p1024_addcarryx_u64(&x1557, &x1558, x1556, x1516, x1513);
+  fiat_p1024_addcarryx_u64(&x1559, &x1560, x1558, x1514, x1511);
+  fiat_p1024_addcarryx_u64(&x1561, &x1562, x1560, x1512, x1509);
+  fiat_p1024_addcarryx_u64(&x1563, &x1564, x1562, x1510, x1507);
+  fiat_p1024_addcarryx_u64(&x1565, &x1566, x1564, x1508, x1505);
+  fiat_p1024_addcarryx_u64(&x1567, &x1568, 0x0, x1475, x1535);
+  fiat_p1024_addcarryx_u64(&x1569, &x1570, x1568, x1477, x1537);
+  fiat_p1024_addcarryx_u64(&x1571, &x1572, x1570, x1479, x1539);
+  fiat_p1024_addcarryx_u64(&x1573, &x1574, x1572, x1481, x1541);
+  fiat_p1024_addcarryx_u64(&x1575, &x1576, x1574, x1483, x1543);
+  fiat_p1024_addcarryx_u64(&x1577, &x1578, x1576, x1485, x1545);
+  fiat_p1024_addcarryx_u64(&x1579, &x1580, x1578, x1487, x1547);
+  fiat_p1024_addcarryx_u64(&x1581, &x1582, x1580, x1489, x1549);
+  fiat_p1024_addcarryx_u64(&x1583, &x1584, x1582, x1491, x1551);
+  fiat_p1024_addcarryx_u64(&x1585, &x1586, x1584, x1493, x1553);
+  fiat_p1024_addcarryx_u64(&x1587, &x1588, x1586, x1495, x1555);
+  fiat_p1024_addcarryx_u64(&x1589, &x1590, x1588, x1497, x1557);
+  fiat_p1024_addcarryx_u64(&x1591, &x1592, x1590, x1499, x1559);
+  fiat_p1024_addcarryx_u64(&x1593, &x1594, x1592, x1501, x1561);
+  fiat_p1024_addcarryx_u64(&x1595, &x1596, x1594, x1503, x1563);
+  fiat_p1024_addcarryx_u64(&x1597, &x1598, x1596, ((x1504 + (x1408 + (x1376 + x1316))) + (x1472 + x1412)), x1565);
+  fiat_p1024_mulx_u64(&x1599, &x1600, x1567, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x1601, &x1602, x1599, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x1603, &x1604, x1599, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x1605, &x1606, x1599, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x1607, &x1608, x1599, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x1609, &x1610, x1599, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x1611, &x1612, x1599, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x1613, &x1614, x1599, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x1615, &x1616, x1599, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x1617, &x1618, x1599, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x1619, &x1620, x1599, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x1621, &x1622, x1599, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x1623, &x1624, x1599, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x1625, &x1626, x1599, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x1627, &x1628, x1599, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x1629, &x1630, x1599, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x1631, &x1632, x1599, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x1633, &x1634, 0x0, x1632, x1629);
+  fiat_p1024_addcarryx_u64(&x1635, &x1636, x1634, x1630, x1627);
+  fiat_p1024_addcarryx_u64(&x1637, &x1638, x1636, x1628, x1625);
+  fiat_p1024_addcarryx_u64(&x1639, &x1640, x1638, x1626, x1623);
+  fiat_p1024_addcarryx_u64(&x1641, &x1642, x1640, x1624, x1621);
+  fiat_p1024_addcarryx_u64(&x1643, &x1644, x1642, x1622, x1619);
+  fiat_p1024_addcarryx_u64(&x1645, &x1646, x1644, x1620, x1617);
+  fiat_p1024_addcarryx_u64(&x1647, &x1648, x1646, x1618, x1615);
+  fiat_p1024_addcarryx_u64(&x1649, &x1650, x1648, x1616, x1613);
+  fiat_p1024_addcarryx_u64(&x1651, &x1652, x1650, x1614, x1611);
+  fiat_p1024_addcarryx_u64(&x1653, &x1654, x1652, x1612, x1609);
+  fiat_p1024_addcarryx_u64(&x1655, &x1656, x1654, x1610, x1607);
+  fiat_p1024_addcarryx_u64(&x1657, &x1658, x1656, x1608, x1605);
+  fiat_p1024_addcarryx_u64(&x1659, &x1660, x1658, x1606, x1603);
+  fiat_p1024_addcarryx_u64(&x1661, &x1662, x1660, x1604, x1601);
+  fiat_p1024_addcarryx_u64(&x1663, &x1664, 0x0, x1567, x1631);
+  fiat_p1024_addcarryx_u64(&x1665, &x1666, x1664, x1569, x1633);
+  fiat_p1024_addcarryx_u64(&x1667, &x1668, x1666, x1571, x1635);
+  fiat_p1024_addcarryx_u64(&x1669, &x1670, x1668, x1573, x1637);
+  fiat_p1024_addcarryx_u64(&x1671, &x1672, x1670, x1575, x1639);
+  fiat_p1024_addcarryx_u64(&x1673, &x1674, x1672, x1577, x1641);
+  fiat_p1024_addcarryx_u64(&x1675, &x1676, x1674, x1579, x1643);
+  fiat_p1024_addcarryx_u64(&x1677, &x1678, x1676, x1581, x1645);
+  fiat_p1024_addcarryx_u64(&x1679, &x1680, x1678, x1583, x1647);
+  fiat_p1024_addcarryx_u64(&x1681, &x1682, x1680, x1585, x1649);
+  fiat_p1024_addcarryx_u64(&x1683, &x1684, x1682, x1587, x1651);
+  fiat_p1024_addcarryx_u64(&x1685, &x1686, x1684, x1589, x1653);
+  fiat_p1024_addcarryx_u64(&x1687, &x1688, x1686, x1591, x1655);
+  fiat_p1024_addcarryx_u64(&x1689, &x1690, x1688, x1593, x1657);
+  fiat_p1024_addcarryx_u64(&x1691, &x1692, x1690, x1595, x1659);
+  fiat_p1024_addcarryx_u64(&x1693, &x1694, x1692, x1597, x1661);
+  fiat_p1024_mulx_u64(&x1695, &x1696, x9, UINT64_C(0x57162f911ca93a3));
+  fiat_p1024_mulx_u64(&x1697, &x1698, x9, UINT64_C(0xe1a2eb1d838b8237));
+  fiat_p1024_mulx_u64(&x1699, &x1700, x9, UINT64_C(0x4f8a8344bbe56288));
+  fiat_p1024_mulx_u64(&x1701, &x1702, x9, UINT64_C(0xc124d930289048b5));
+  fiat_p1024_mulx_u64(&x1703, &x1704, x9, UINT64_C(0xa5ae047480992b2c));
+  fiat_p1024_mulx_u64(&x1705, &x1706, x9, UINT64_C(0x156b34c683839b9d));
+  fiat_p1024_mulx_u64(&x1707, &x1708, x9, UINT64_C(0x9aad9631bb760403));
+  fiat_p1024_mulx_u64(&x1709, &x1710, x9, UINT64_C(0x8aa6fa7e6811faa8));
+  fiat_p1024_mulx_u64(&x1711, &x1712, x9, UINT64_C(0xa0056a67c1de0e82));
+  fiat_p1024_mulx_u64(&x1713, &x1714, x9, UINT64_C(0x9365ec4fa327c9ac));
+  fiat_p1024_mulx_u64(&x1715, &x1716, x9, UINT64_C(0x6cdde00636c4f801));
+  fiat_p1024_mulx_u64(&x1717, &x1718, x9, UINT64_C(0x8c1a56e5bf96471a));
+  fiat_p1024_mulx_u64(&x1719, &x1720, x9, UINT64_C(0x4f2940830c1d2b35));
+  fiat_p1024_mulx_u64(&x1721, &x1722, x9, UINT64_C(0x1322c9b9837dca17));
+  fiat_p1024_mulx_u64(&x1723, &x1724, x9, UINT64_C(0x68ac5d7707ccb03a));
+  fiat_p1024_mulx_u64(&x1725, &x1726, x9, UINT64_C(0xd6b8f146ec5055af));
+  fiat_p1024_addcarryx_u64(&x1727, &x1728, 0x0, x1726, x1723);
+  fiat_p1024_addcarryx_u64(&x1729, &x1730, x1728, x1724, x1721);
+  fiat_p1024_addcarryx_u64(&x1731, &x1732, x1730, x1722, x1719);
+  fiat_p1024_addcarryx_u64(&x1733, &x1734, x1732, x1720, x1717);
+  fiat_p1024_addcarryx_u64(&x1735, &x1736, x1734, x1718, x1715);
+  fiat_p1024_addcarryx_u64(&x1737, &x1738, x1736, x1716, x1713);
+  fiat_p1024_addcarryx_u64(&x1739, &x1740, x1738, x1714, x1711);
+  fiat_p1024_addcarryx_u64(&x1741, &x1742, x1740, x1712, x1709);
+  fiat_p1024_addcarryx_u64(&x1743, &x1744, x1742, x1710, x1707);
+  fiat_p1024_addcarryx_u64(&x1745, &x1746, x1744, x1708, x1705);
+  fiat_p1024_addcarryx_u64(&x1747, &x1748, x1746, x1706, x1703);
+  fiat_p1024_addcarryx_u64(&x1749, &x1750, x1748, x1704, x1701);
+  fiat_p1024_addcarryx_u64(&x1751, &x1752, x1750, x1702, x1699);
+  fiat_p1024_addcarryx_u64(&x1753, &x1754, x1752, x1700, x1697);
+  fiat_p1024_addcarryx_u64(&x1755, &x1756, x1754, x1698, x1695);
+  fiat_p1024_addcarryx_u64(&x1757, &x1758, 0x0, x1665, x1725);
+  fiat_p1024_addcarryx_u64(&x1759, &x1760, x1758, x1667, x1727);
+  fiat_p1024_addcarryx_u64(&x1761, &x1762, x1760, x1669, x1729);
+  fiat_p1024_addcarryx_u64(&x1763, &x1764, x1762, x1671, x1731);
+  fiat_p1024_addcarryx_u64(&x1765, &x1766, x1764, x1673, x1733);
+  fiat_p1024_addcarryx_u64(&x1767, &x1768, x1766, x1675, x1735);
+  fiat_p1024_addcarryx_u64(&x1769, &x1770, x1768, x1677, x1737);
+  fiat_p1024_addcarryx_u64(&x1771, &x1772, x1770, x1679, x1739);
+  fiat_p1024_addcarryx_u64(&x1773, &x1774, x1772, x1681, x1741);
+  fiat_p1024_addcarryx_u64(&x1775, &x1776, x1774, x1683, x1743);
+  fiat_p1024_addcarryx_u64(&x1777, &x1778, x1776, x1685, x1745);
+  fiat_p1024_addcarryx_u64(&x1779, &x1780, x1778, x1687, x1747);
+  fiat_p1024_addcarryx_u64(&x1781, &x1782, x1780, x1689, x1749);
+  fiat_p1024_addcarryx_u64(&x1783, &x1784, x1782, x1691, x1751);
+  fiat_p1024_addcarryx_u64(&x1785, &x1786, x1784, x1693, x1753);
+  fiat_p1024_addcarryx_u64(&x1787, &x1788, x1786, ((x1694 + (x1598 + (x1566 + x1506))) + (x1662 + x1602)), x1755);
+  fiat_p1024_mulx_u64(&x1789, &x1790, x1757, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x1791, &x1792, x1789, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x1793, &x1794, x1789, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x1795, &x1796, x1789, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x1797, &x1798, x1789, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x1799, &x1800, x1789, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x1801, &x1802, x1789, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x1803, &x1804, x1789, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x1805, &x1806, x1789, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x1807, &x1808, x1789, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x1809, &x1810, x1789, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x1811, &x1812, x1789, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x1813, &x1814, x1789, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x1815, &x1816, x1789, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x1817, &x1818, x1789, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x1819, &x1820, x1789, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x1821, &x1822, x1789, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x1823, &x1824, 0x0, x1822, x1819);
+  fiat_p1024_addcarryx_u64(&x1825, &x1826, x1824, x1820, x1817);
+  fiat_p1024_addcarryx_u64(&x1827, &x1828, x1826, x1818, x1815);
+  fiat_p1024_addcarryx_u64(&x1829, &x1830, x1828, x1816, x1813);
+  fiat_p1024_addcarryx_u64(&x1831, &x1832, x1830, x1814, x1811);
+  fiat_p1024_addcarryx_u64(&x1833, &x1834, x1832, x1812, x1809);
+  fiat_p1024_addcarryx_u64(&x1835, &x1836, x1834, x1810, x1807);
+  fiat_p1024_addcarryx_u64(&x1837, &x1838, x1836, x1808, x1805);
+  fiat_p1024_addcarryx_u64(&x1839, &x1840, x1838, x1806, x1803);
+  fiat_p1024_addcarryx_u64(&x1841, &x1842, x1840, x1804, x1801);
+  fiat_p1024_addcarryx_u64(&x1843, &x1844, x1842, x1802, x1799);
+  fiat_p1024_addcarryx_u64(&x1845, &x1846, x1844, x1800, x1797);
+  fiat_p1024_addcarryx_u64(&x1847, &x1848, x1846, x1798, x1795);
+  fiat_p1024_addcarryx_u64(&x1849, &x1850, x1848, x1796, x1793);
+  fiat_p1024_addcarryx_u64(&x1851, &x1852, x1850, x1794, x1791);
+  fiat_p1024_addcarryx_u64(&x1853, &x1854, 0x0, x1757, x1821);
+  fiat_p1024_addcarryx_u64(&x1855, &x1856, x1854, x1759, x1823);
+  fiat_p1024_addcarryx_u64(&x1857, &x1858, x1856, x1761, x1825);
+  fiat_p1024_addcarryx_u64(&x1859, &x1860, x1858, x1763, x1827);
+  fiat_p1024_addcarryx_u64(&x1861, &x1862, x1860, x1765, x1829);
+  fiat_p1024_addcarryx_u64(&x1863, &x1864, x1862, x1767, x1831);
+  fiat_p1024_addcarryx_u64(&x1865, &x1866, x1864, x1769, x1833);
+  fiat_p1024_addcarryx_u64(&x1867, &x1868, x1866, x1771, x1835);
+  fiat_p1024_addcarryx_u64(&x1869, &x1870, x1868, x1773, x1837);
+  fiat_p1024_addcarryx_u64(&x1871, &x1872, x1870, x1775, x1839);
+  fiat_p1024_addcarryx_u64(&x1873, &x1874, x1872, x1777, x1841);
+  fiat_p1024_addcarryx_u64(&x1875, &x1876, x1874, x1779, x1843);
+  fiat_p1024_addcarryx_u64(&x1877, &x1878, x1876, x1781, x1845);
+  fiat_p1024_addcarryx_u64(&x1879, &x1880, x1878, x1783, x1847);
+  fiat_p1024_addcarryx_u64(&x1881, &x1882, x1880, x1785, x1849);
+  fiat_p1024_addcarryx_u64(&x1883, &x1884, x1882, x1787, x1851);
+  fiat_p1024_mulx_u64(&x1885, &x1886, x10, UINT64_C(0x57162f911ca93a3));
+  fiat_p1024_mulx_u64(&x1887, &x1888, x10, UINT64_C(0xe1a2eb1d838b8237));
+  fiat_p1024_mulx_u64(&x1889, &x1890, x10, UINT64_C(0x4f8a8344bbe56288));
+  fiat_p1024_mulx_u64(&x1891, &x1892, x10, UINT64_C(0xc124d930289048b5));
+  fiat_p1024_mulx_u64(&x1893, &x1894, x10, UINT64_C(0xa5ae047480992b2c));
+  fiat_p1024_mulx_u64(&x1895, &x1896, x10, UINT64_C(0x156b34c683839b9d));
+  fiat_p1024_mulx_u64(&x1897, &x1898, x10, UINT64_C(0x9aad9631bb760403));
+  fiat_p1024_mulx_u64(&x1899, &x1900, x10, UINT64_C(0x8aa6fa7e6811faa8));
+  fiat_p1024_mulx_u64(&x1901, &x1902, x10, UINT64_C(0xa0056a67c1de0e82));
+  fiat_p1024_mulx_u64(&x1903, &x1904, x10, UINT64_C(0x9365ec4fa327c9ac));
+  fiat_p1024_mulx_u64(&x1905, &x1906, x10, UINT64_C(0x6cdde00636c4f801));
+  fiat_p1024_mulx_u64(&x1907, &x1908, x10, UINT64_C(0x8c1a56e5bf96471a));
+  fiat_p1024_mulx_u64(&x1909, &x1910, x10, UINT64_C(0x4f2940830c1d2b35));
+  fiat_p1024_mulx_u64(&x1911, &x1912, x10, UINT64_C(0x1322c9b9837dca17));
+  fiat_p1024_mulx_u64(&x1913, &x1914, x10, UINT64_C(0x68ac5d7707ccb03a));
+  fiat_p1024_mulx_u64(&x1915, &x1916, x10, UINT64_C(0xd6b8f146ec5055af));
+  fiat_p1024_addcarryx_u64(&x1917, &x1918, 0x0, x1916, x1913);
+  fiat_p1024_addcarryx_u64(&x1919, &x1920, x1918, x1914, x1911);
+  fiat_p1024_addcarryx_u64(&x1921, &x1922, x1920, x1912, x1909);
+  fiat_p1024_addcarryx_u64(&x1923, &x1924, x1922, x1910, x1907);
+  fiat_p1024_addcarryx_u64(&x1925, &x1926, x1924, x1908, x1905);
+  fiat_p1024_addcarryx_u64(&x1927, &x1928, x1926, x1906, x1903);
+  fiat_p1024_addcarryx_u64(&x1929, &x1930, x1928, x1904, x1901);
+  fiat_p1024_addcarryx_u64(&x1931, &x1932, x1930, x1902, x1899);
+  fiat_p1024_addcarryx_u64(&x1933, &x1934, x1932, x1900, x1897);
+  fiat_p1024_addcarryx_u64(&x1935, &x1936, x1934, x1898, x1895);
+  fiat_p1024_addcarryx_u64(&x1937, &x1938, x1936, x1896, x1893);
+  fiat_p1024_addcarryx_u64(&x1939, &x1940, x1938, x1894, x1891);
+  fiat_p1024_addcarryx_u64(&x1941, &x1942, x1940, x1892, x1889);
+  fiat_p1024_addcarryx_u64(&x1943, &x1944, x1942, x1890, x1887);
+  fiat_p1024_addcarryx_u64(&x1945, &x1946, x1944, x1888, x1885);
+  fiat_p1024_addcarryx_u64(&x1947, &x1948, 0x0, x1855, x1915);
+  fiat_p1024_addcarryx_u64(&x1949, &x1950, x1948, x1857, x1917);
+  fiat_p1024_addcarryx_u64(&x1951, &x1952, x1950, x1859, x1919);
+  fiat_p1024_addcarryx_u64(&x1953, &x1954, x1952, x1861, x1921);
+  fiat_p1024_addcarryx_u64(&x1955, &x1956, x1954, x1863, x1923);
+  fiat_p1024_addcarryx_u64(&x1957, &x1958, x1956, x1865, x1925);
+  fiat_p1024_addcarryx_u64(&x1959, &x1960, x1958, x1867, x1927);
+  fiat_p1024_addcarryx_u64(&x1961, &x1962, x1960, x1869, x1929);
+  fiat_p1024_addcarryx_u64(&x1963, &x1964, x1962, x1871, x1931);
+  fiat_p1024_addcarryx_u64(&x1965, &x1966, x1964, x1873, x1933);
+  fiat_p1024_addcarryx_u64(&x1967, &x1968, x1966, x1875, x1935);
+  fiat_p1024_addcarryx_u64(&x1969, &x1970, x1968, x1877, x1937);
+  fiat_p1024_addcarryx_u64(&x1971, &x1972, x1970, x1879, x1939);
+  fiat_p1024_addcarryx_u64(&x1973, &x1974, x1972, x1881, x1941);
+  fiat_p1024_addcarryx_u64(&x1975, &x1976, x1974, x1883, x1943);
+  fiat_p1024_addcarryx_u64(&x1977, &x1978, x1976, ((x1884 + (x1788 + (x1756 + x1696))) + (x1852 + x1792)), x1945);
+  fiat_p1024_mulx_u64(&x1979, &x1980, x1947, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x1981, &x1982, x1979, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x1983, &x1984, x1979, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x1985, &x1986, x1979, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x1987, &x1988, x1979, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x1989, &x1990, x1979, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x1991, &x1992, x1979, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x1993, &x1994, x1979, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x1995, &x1996, x1979, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x1997, &x1998, x1979, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x1999, &x2000, x1979, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x2001, &x2002, x1979, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x2003, &x2004, x1979, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x2005, &x2006, x1979, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x2007, &x2008, x1979, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x2009, &x2010, x1979, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x2011, &x2012, x1979, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x2013, &x2014, 0x0, x2012, x2009);
+  fiat_p1024_addcarryx_u64(&x2015, &x2016, x2014, x2010, x2007);
+  fiat_p1024_addcarryx_u64(&x2017, &x2018, x2016, x2008, x2005);
+  fiat_p1024_addcarryx_u64(&x2019, &x2020, x2018, x2006, x2003);
+  fiat_p1024_addcarryx_u64(&x2021, &x2022, x2020, x2004, x2001);
+  fiat_p1024_addcarryx_u64(&x2023, &x2024, x2022, x2002, x1999);
+  fiat_p1024_addcarryx_u64(&x2025, &x2026, x2024, x2000, x1997);
+  fiat_p1024_addcarryx_u64(&x2027, &x2028, x2026, x1998, x1995);
+  fiat_p1024_addcarryx_u64(&x2029, &x2030, x2028, x1996, x1993);
+  fiat_p1024_addcarryx_u64(&x2031, &x2032, x2030, x1994, x1991);
+  fiat_p1024_addcarryx_u64(&x2033, &x2034, x2032, x1992, x1989);
+  fiat_p1024_addcarryx_u64(&x2035, &x2036, x2034, x1990, x1987);
+  fiat_p1024_addcarryx_u64(&x2037, &x2038, x2036, x1988, x1985);
+  fiat_p1024_addcarryx_u64(&x2039, &x2040, x2038, x1986, x1983);
+  fiat_p1024_addcarryx_u64(&x2041, &x2042, x2040, x1984, x1981);
+  fiat_p1024_addcarryx_u64(&x2043, &x2044, 0x0, x1947, x2011);
+  fiat_p1024_addcarryx_u64(&x2045, &x2046, x2044, x1949, x2013);
+  fiat_p1024_addcarryx_u64(&x2047, &x2048, x2046, x1951, x2015);
+  fiat_p1024_addcarryx_u64(&x2049, &x2050, x2048, x1953, x2017);
+  fiat_p1024_addcarryx_u64(&x2051, &x2052, x2050, x1955, x2019);
+  fiat_p1024_addcarryx_u64(&x2053, &x2054, x2052, x1957, x2021);
+  fiat_p1024_addcarryx_u64(&x2055, &x2056, x2054, x1959, x2023);
+  fiat_p1024_addcarryx_u64(&x2057, &x2058, x2056, x1961, x2025);
+  fiat_p1024_addcarryx_u64(&x2059, &x2060, x2058, x1963, x2027);
+  fiat_p1024_addcarryx_u64(&x2061, &x2062, x2060, x1965, x2029);
+  fiat_p1024_addcarryx_u64(&x2063, &x2064, x2062, x1967, x2031);
+  fiat_p1024_addcarryx_u64(&x2065, &x2066, x2064, x1969, x2033);
+  fiat_p1024_addcarryx_u64(&x2067, &x2068, x2066, x1971, x2035);
+  fiat_p1024_addcarryx_u64(&x2069, &x2070, x2068, x1973, x2037);
+  fiat_p1024_addcarryx_u64(&x2071, &x2072, x2070, x1975, x2039);
+  fiat_p1024_addcarryx_u64(&x2073, &x2074, x2072, x1977, x2041);
+  fiat_p1024_mulx_u64(&x2075, &x2076, x11, UINT64_C(0x57162f911ca93a3));
+  fiat_p1024_mulx_u64(&x2077, &x2078, x11, UINT64_C(0xe1a2eb1d838b8237));
+  fiat_p1024_mulx_u64(&x2079, &x2080, x11, UINT64_C(0x4f8a8344bbe56288));
+  fiat_p1024_mulx_u64(&x2081, &x2082, x11, UINT64_C(0xc124d930289048b5));
+  fiat_p1024_mulx_u64(&x2083, &x2084, x11, UINT64_C(0xa5ae047480992b2c));
+  fiat_p1024_mulx_u64(&x2085, &x2086, x11, UINT64_C(0x156b34c683839b9d));
+  fiat_p1024_mulx_u64(&x2087, &x2088, x11, UINT64_C(0x9aad9631bb760403));
+  fiat_p1024_mulx_u64(&x2089, &x2090, x11, UINT64_C(0x8aa6fa7e6811faa8));
+  fiat_p1024_mulx_u64(&x2091, &x2092, x11, UINT64_C(0xa0056a67c1de0e82));
+  fiat_p1024_mulx_u64(&x2093, &x2094, x11, UINT64_C(0x9365ec4fa327c9ac));
+  fiat_p1024_mulx_u64(&x2095, &x2096, x11, UINT64_C(0x6cdde00636c4f801));
+  fiat_p1024_mulx_u64(&x2097, &x2098, x11, UINT64_C(0x8c1a56e5bf96471a));
+  fiat_p1024_mulx_u64(&x2099, &x2100, x11, UINT64_C(0x4f2940830c1d2b35));
+  fiat_p1024_mulx_u64(&x2101, &x2102, x11, UINT64_C(0x1322c9b9837dca17));
+  fiat_p1024_mulx_u64(&x2103, &x2104, x11, UINT64_C(0x68ac5d7707ccb03a));
+  fiat_p1024_mulx_u64(&x2105, &x2106, x11, UINT64_C(0xd6b8f146ec5055af));
+  fiat_p1024_addcarryx_u64(&x2107, &x2108, 0x0, x2106, x2103);
+  fiat_p1024_addcarryx_u64(&x2109, &x2110, x2108, x2104, x2101);
+  fiat_p1024_addcarryx_u64(&x2111, &x2112, x2110, x2102, x2099);
+  fiat_p1024_addcarryx_u64(&x2113, &x2114, x2112, x2100, x2097);
+  fiat_p1024_addcarryx_u64(&x2115, &x2116, x2114, x2098, x2095);
+  fiat_p1024_addcarryx_u64(&x2117, &x2118, x2116, x2096, x2093);
+  fiat_p1024_addcarryx_u64(&x2119, &x2120, x2118, x2094, x2091);
+  fiat_p1024_addcarryx_u64(&x2121, &x2122, x2120, x2092, x2089);
+  fiat_p1024_addcarryx_u64(&x2123, &x2124, x2122, x2090, x2087);
+  fiat_p1024_addcarryx_u64(&x2125, &x2126, x2124, x2088, x2085);
+  fiat_p1024_addcarryx_u64(&x2127, &x2128, x2126, x2086, x2083);
+  fiat_p1024_addcarryx_u64(&x2129, &x2130, x2128, x2084, x2081);
+  fiat_p1024_addcarryx_u64(&x2131, &x2132, x2130, x2082, x2079);
+  fiat_p1024_addcarryx_u64(&x2133, &x2134, x2132, x2080, x2077);
+  fiat_p1024_addcarryx_u64(&x2135, &x2136, x2134, x2078, x2075);
+  fiat_p1024_addcarryx_u64(&x2137, &x2138, 0x0, x2045, x2105);
+  fiat_p1024_addcarryx_u64(&x2139, &x2140, x2138, x2047, x2107);
+  fiat_p1024_addcarryx_u64(&x2141, &x2142, x2140, x2049, x2109);
+  fiat_p1024_addcarryx_u64(&x2143, &x2144, x2142, x2051, x2111);
+  fiat_p1024_addcarryx_u64(&x2145, &x2146, x2144, x2053, x2113);
+  fiat_p1024_addcarryx_u64(&x2147, &x2148, x2146, x2055, x2115);
+  fiat_p1024_addcarryx_u64(&x2149, &x2150, x2148, x2057, x2117);
+  fiat_p1024_addcarryx_u64(&x2151, &x2152, x2150, x2059, x2119);
+  fiat_p1024_addcarryx_u64(&x2153, &x2154, x2152, x2061, x2121);
+  fiat_p1024_addcarryx_u64(&x2155, &x2156, x2154, x2063, x2123);
+  fiat_p1024_addcarryx_u64(&x2157, &x2158, x2156, x2065, x2125);
+  fiat_p1024_addcarryx_u64(&x2159, &x2160, x2158, x2067, x2127);
+  fiat_p1024_addcarryx_u64(&x2161, &x2162, x2160, x2069, x2129);
+  fiat_p1024_addcarryx_u64(&x2163, &x2164, x2162, x2071, x2131);
+  fiat_p1024_addcarryx_u64(&x2165, &x2166, x2164, x2073, x2133);
+  fiat_p1024_addcarryx_u64(&x2167, &x2168, x2166, ((x2074 + (x1978 + (x1946 + x1886))) + (x2042 + x1982)), x2135);
+  fiat_p1024_mulx_u64(&x2169, &x2170, x2137, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x2171, &x2172, x2169, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x2173, &x2174, x2169, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x2175, &x2176, x2169, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x2177, &x2178, x2169, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x2179, &x2180, x2169, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x2181, &x2182, x2169, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x2183, &x2184, x2169, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x2185, &x2186, x2169, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x2187, &x2188, x2169, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x2189, &x2190, x2169, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x2191, &x2192, x2169, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x2193, &x2194, x2169, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x2195, &x2196, x2169, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x2197, &x2198, x2169, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x2199, &x2200, x2169, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x2201, &x2202, x2169, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x2203, &x2204, 0x0, x2202, x2199);
+  fiat_p1024_addcarryx_u64(&x2205, &x2206, x2204, x2200, x2197);
+  fiat_p1024_addcarryx_u64(&x2207, &x2208, x2206, x2198, x2195);
+  fiat_p1024_addcarryx_u64(&x2209, &x2210, x2208, x2196, x2193);
+  fiat_p1024_addcarryx_u64(&x2211, &x2212, x2210, x2194, x2191);
+  fiat_p1024_addcarryx_u64(&x2213, &x2214, x2212, x2192, x2189);
+  fiat_p1024_addcarryx_u64(&x2215, &x2216, x2214, x2190, x2187);
+  fiat_p1024_addcarryx_u64(&x2217, &x2218, x2216, x2188, x2185);
+  fiat_p1024_addcarryx_u64(&x2219, &x2220, x2218, x2186, x2183);
+  fiat_p1024_addcarryx_u64(&x2221, &x2222, x2220, x2184, x2181);
+  fiat_p1024_addcarryx_u64(&x2223, &x2224, x2222, x2182, x2179);
+  fiat_p1024_addcarryx_u64(&x2225, &x2226, x2224, x2180, x2177);
+  fiat_p1024_addcarryx_u64(&x2227, &x2228, x2226, x2178, x2175);
+  fiat_p1024_addcarryx_u64(&x2229, &x2230, x2228, x2176, x2173);
+  fiat_p1024_addcarryx_u64(&x2231, &x2232, x2230, x2174, x2171);
+  fiat_p1024_addcarryx_u64(&x2233, &x2234, 0x0, x2137, x2201);
+  fiat_p1024_addcarryx_u64(&x2235, &x2236, x2234, x2139, x2203);
+  fiat_p1024_addcarryx_u64(&x2237, &x2238, x2236, x2141, x2205);
+  fiat_p1024_addcarryx_u64(&x2239, &x2240, x2238, x2143, x2207);
+  fiat_p1024_addcarryx_u64(&x2241, &x2242, x2240, x2145, x2209);
+  fiat_p1024_addcarryx_u64(&x2243, &x2244, x2242, x2147, x2211);
+  fiat_p1024_addcarryx_u64(&x2245, &x2246, x2244, x2149, x2213);
+  fiat_p1024_addcarryx_u64(&x2247, &x2248, x2246, x2151, x2215);
+  fiat_p1024_addcarryx_u64(&x2249, &x2250, x2248, x2153, x2217);
+  fiat_p1024_addcarryx_u64(&x2251, &x2252, x2250, x2155, x2219);
+  fiat_p1024_addcarryx_u64(&x2253, &x2254, x2252, x2157, x2221);
+  fiat_p1024_addcarryx_u64(&x2255, &x2256, x2254, x2159, x2223);
+  fiat_p1024_addcarryx_u64(&x2257, &x2258, x2256, x2161, x2225);
+  fiat_p1024_addcarryx_u64(&x2259, &x2260, x2258, x2163, x2227);
+  fiat_p1024_addcarryx_u64(&x2261, &x2262, x2260, x2165, x2229);
+  fiat_p1024_addcarryx_u64(&x2263, &x2264, x2262, x2167, x2231);
+  fiat_p1024_mulx_u64(&x2265, &x2266, x12, UINT64_C(0x57162f911ca93a3));
+  fiat_p1024_mulx_u64(&x2267, &x2268, x12, UINT64_C(0xe1a2eb1d838b8237));
+  fiat_p1024_mulx_u64(&x2269, &x2270, x12, UINT64_C(0x4f8a8344bbe56288));
+  fiat_p1024_mulx_u64(&x2271, &x2272, x12, UINT64_C(0xc124d930289048b5));
+  fiat_p1024_mulx_u64(&x2273, &x2274, x12, UINT64_C(0xa5ae047480992b2c));
+  fiat_p1024_mulx_u64(&x2275, &x2276, x12, UINT64_C(0x156b34c683839b9d));
+  fiat_p1024_mulx_u64(&x2277, &x2278, x12, UINT64_C(0x9aad9631bb760403));
+  fiat_p1024_mulx_u64(&x2279, &x2280, x12, UINT64_C(0x8aa6fa7e6811faa8));
+  fiat_p1024_mulx_u64(&x2281, &x2282, x12, UINT64_C(0xa0056a67c1de0e82));
+  fiat_p1024_mulx_u64(&x2283, &x2284, x12, UINT64_C(0x9365ec4fa327c9ac));
+  fiat_p1024_mulx_u64(&x2285, &x2286, x12, UINT64_C(0x6cdde00636c4f801));
+  fiat_p1024_mulx_u64(&x2287, &x2288, x12, UINT64_C(0x8c1a56e5bf96471a));
+  fiat_p1024_mulx_u64(&x2289, &x2290, x12, UINT64_C(0x4f2940830c1d2b35));
+  fiat_p1024_mulx_u64(&x2291, &x2292, x12, UINT64_C(0x1322c9b9837dca17));
+  fiat_p1024_mulx_u64(&x2293, &x2294, x12, UINT64_C(0x68ac5d7707ccb03a));
+  fiat_p1024_mulx_u64(&x2295, &x2296, x12, UINT64_C(0xd6b8f146ec5055af));
+  fiat_p1024_addcarryx_u64(&x2297, &x2298, 0x0, x2296, x2293);
+  fiat_p1024_addcarryx_u64(&x2299, &x2300, x2298, x2294, x2291);
+  fiat_p1024_addcarryx_u64(&x2301, &x2302, x2300, x2292, x2289);
+  fiat_p1024_addcarryx_u64(&x2303, &x2304, x2302, x2290, x2287);
+  fiat_p1024_addcarryx_u64(&x2305, &x2306, x2304, x2288, x2285);
+  fiat_p1024_addcarryx_u64(&x2307, &x2308, x2306, x2286, x2283);
+  fiat_p1024_addcarryx_u64(&x2309, &x2310, x2308, x2284, x2281);
+  fiat_p1024_addcarryx_u64(&x2311, &x2312, x2310, x2282, x2279);
+  fiat_p1024_addcarryx_u64(&x2313, &x2314, x2312, x2280, x2277);
+  fiat_p1024_addcarryx_u64(&x2315, &x2316, x2314, x2278, x2275);
+  fiat_p1024_addcarryx_u64(&x2317, &x2318, x2316, x2276, x2273);
+  fiat_p1024_addcarryx_u64(&x2319, &x2320, x2318, x2274, x2271);
+  fiat_p1024_addcarryx_u64(&x2321, &x2322, x2320, x2272, x2269);
+  fiat_p1024_addcarryx_u64(&x2323, &x2324, x2322, x2270, x2267);
+  fiat_p1024_addcarryx_u64(&x2325, &x2326, x2324, x2268, x2265);
+  fiat_p1024_addcarryx_u64(&x2327, &x2328, 0x0, x2235, x2295);
+  fiat_p1024_addcarryx_u64(&x2329, &x2330, x2328, x2237, x2297);
+  fiat_p1024_addcarryx_u64(&x2331, &x2332, x2330, x2239, x2299);
+  fiat_p1024_addcarryx_u64(&x2333, &x2334, x2332, x2241, x2301);
+  fiat_p1024_addcarryx_u64(&x2335, &x2336, x2334, x2243, x2303);
+  fiat_p1024_addcarryx_u64(&x2337, &x2338, x2336, x2245, x2305);
+  fiat_p1024_addcarryx_u64(&x2339, &x2340, x2338, x2247, x2307);
+  fiat_p1024_addcarryx_u64(&x2341, &x2342, x2340, x2249, x2309);
+  fiat_p1024_addcarryx_u64(&x2343, &x2344, x2342, x2251, x2311);
+  fiat_p1024_addcarryx_u64(&x2345, &x2346, x2344, x2253, x2313);
+  fiat_p1024_addcarryx_u64(&x2347, &x2348, x2346, x2255, x2315);
+  fiat_p1024_addcarryx_u64(&x2349, &x2350, x2348, x2257, x2317);
+  fiat_p1024_addcarryx_u64(&x2351, &x2352, x2350, x2259, x2319);
+  fiat_p1024_addcarryx_u64(&x2353, &x2354, x2352, x2261, x2321);
+  fiat_p1024_addcarryx_u64(&x2355, &x2356, x2354, x2263, x2323);
+  fiat_p1024_addcarryx_u64(&x2357, &x2358, x2356, ((x2264 + (x2168 + (x2136 + x2076))) + (x2232 + x2172)), x2325);
+  fiat_p1024_mulx_u64(&x2359, &x2360, x2327, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x2361, &x2362, x2359, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x2363, &x2364, x2359, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x2365, &x2366, x2359, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x2367, &x2368, x2359, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x2369, &x2370, x2359, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x2371, &x2372, x2359, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x2373, &x2374, x2359, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x2375, &x2376, x2359, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x2377, &x2378, x2359, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x2379, &x2380, x2359, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x2381, &x2382, x2359, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x2383, &x2384, x2359, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x2385, &x2386, x2359, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x2387, &x2388, x2359, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x2389, &x2390, x2359, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x2391, &x2392, x2359, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x2393, &x2394, 0x0, x2392, x2389);
+  fiat_p1024_addcarryx_u64(&x2395, &x2396, x2394, x2390, x2387);
+  fiat_p1024_addcarryx_u64(&x2397, &x2398, x2396, x2388, x2385);
+  fiat_p1024_addcarryx_u64(&x2399, &x2400, x2398, x2386, x2383);
+  fiat_p1024_addcarryx_u64(&x2401, &x2402, x2400, x2384, x2381);
+  fiat_p1024_addcarryx_u64(&x2403, &x2404, x2402, x2382, x2379);
+  fiat_p1024_addcarryx_u64(&x2405, &x2406, x2404, x2380, x2377);
+  fiat_p1024_addcarryx_u64(&x2407, &x2408, x2406, x2378, x2375);
+  fiat_p1024_addcarryx_u64(&x2409, &x2410, x2408, x2376, x2373);
+  fiat_p1024_addcarryx_u64(&x2411, &x2412, x2410, x2374, x2371);
+  fiat_p1024_addcarryx_u64(&x2413, &x2414, x2412, x2372, x2369);
+  fiat_p1024_addcarryx_u64(&x2415, &x2416, x2414, x2370, x2367);
+  fiat_p1024_addcarryx_u64(&x2417, &x2418, x2416, x2368, x2365);
+  fiat_p1024_addcarryx_u64(&x2419, &x2420, x2418, x2366, x2363);
+  fiat_p1024_addcarryx_u64(&x2421, &x2422, x2420, x2364, x2361);
+  fiat_p1024_addcarryx_u64(&x2423, &x2424, 0x0, x2327, x2391);
+  fiat_p1024_addcarryx_u64(&x2425, &x2426, x2424, x2329, x2393);
+  fiat_p1024_addcarryx_u64(&x2427, &x2428, x2426, x2331, x2395);
+  fiat_p1024_addcarryx_u64(&x2429, &x2430, x2428, x2333, x2397);
+  fiat_p1024_addcarryx_u64(&x2431, &x2432, x2430, x2335, x2399);
+  fiat_p1024_addcarryx_u64(&x2433, &x2434, x2432, x2337, x2401);
+  fiat_p1024_addcarryx_u64(&x2435, &x2436, x2434, x2339, x2403);
+  fiat_p1024_addcarryx_u64(&x2437, &x2438, x2436, x2341, x2405);
+  fiat_p1024_addcarryx_u64(&x2439, &x2440, x2438, x2343, x2407);
+  fiat_p1024_addcarryx_u64(&x2441, &x2442, x2440, x2345, x2409);
+  fiat_p1024_addcarryx_u64(&x2443, &x2444, x2442, x2347, x2411);
+  fiat_p1024_addcarryx_u64(&x2445, &x2446, x2444, x2349, x2413);
+  fiat_p1024_addcarryx_u64(&x2447, &x2448, x2446, x2351, x2415);
+  fiat_p1024_addcarryx_u64(&x2449, &x2450, x2448, x2353, x2417);
+  fiat_p1024_addcarryx_u64(&x2451, &x2452, x2450, x2355, x2419);
+  fiat_p1024_addcarryx_u64(&x2453, &x2454, x2452, x2357, x2421);
+  fiat_p1024_mulx_u64(&x2455, &x2456, x13, UINT64_C(0x57162f911ca93a3));
+  fiat_p1024_mulx_u64(&x2457, &x2458, x13, UINT64_C(0xe1a2eb1d838b8237));
+  fiat_p1024_mulx_u64(&x2459, &x2460, x13, UINT64_C(0x4f8a8344bbe56288));
+  fiat_p1024_mulx_u64(&x2461, &x2462, x13, UINT64_C(0xc124d930289048b5));
+  fiat_p1024_mulx_u64(&x2463, &x2464, x13, UINT64_C(0xa5ae047480992b2c));
+  fiat_p1024_mulx_u64(&x2465, &x2466, x13, UINT64_C(0x156b34c683839b9d));
+  fiat_p1024_mulx_u64(&x2467, &x2468, x13, UINT64_C(0x9aad9631bb760403));
+  fiat_p1024_mulx_u64(&x2469, &x2470, x13, UINT64_C(0x8aa6fa7e6811faa8));
+  fiat_p1024_mulx_u64(&x2471, &x2472, x13, UINT64_C(0xa0056a67c1de0e82));
+  fiat_p1024_mulx_u64(&x2473, &x2474, x13, UINT64_C(0x9365ec4fa327c9ac));
+  fiat_p1024_mulx_u64(&x2475, &x2476, x13, UINT64_C(0x6cdde00636c4f801));
+  fiat_p1024_mulx_u64(&x2477, &x2478, x13, UINT64_C(0x8c1a56e5bf96471a));
+  fiat_p1024_mulx_u64(&x2479, &x2480, x13, UINT64_C(0x4f2940830c1d2b35));
+  fiat_p1024_mulx_u64(&x2481, &x2482, x13, UINT64_C(0x1322c9b9837dca17));
+  fiat_p1024_mulx_u64(&x2483, &x2484, x13, UINT64_C(0x68ac5d7707ccb03a));
+  fiat_p1024_mulx_u64(&x2485, &x2486, x13, UINT64_C(0xd6b8f146ec5055af));
+  fiat_p1024_addcarryx_u64(&x2487, &x2488, 0x0, x2486, x2483);
+  fiat_p1024_addcarryx_u64(&x2489, &x2490, x2488, x2484, x2481);
+  fiat_p1024_addcarryx_u64(&x2491, &x2492, x2490, x2482, x2479);
+  fiat_p1024_addcarryx_u64(&x2493, &x2494, x2492, x2480, x2477);
+  fiat_p1024_addcarryx_u64(&x2495, &x2496, x2494, x2478, x2475);
+  fiat_p1024_addcarryx_u64(&x2497, &x2498, x2496, x2476, x2473);
+  fiat_p1024_addcarryx_u64(&x2499, &x2500, x2498, x2474, x2471);
+  fiat_p1024_addcarryx_u64(&x2501, &x2502, x2500, x2472, x2469);
+  fiat_p1024_addcarryx_u64(&x2503, &x2504, x2502, x2470, x2467);
+  fiat_p1024_addcarryx_u64(&x2505, &x2506, x2504, x2468, x2465);
+  fiat_p1024_addcarryx_u64(&x2507, &x2508, x2506, x2466, x2463);
+  fiat_p1024_addcarryx_u64(&x2509, &x2510, x2508, x2464, x2461);
+  fiat_p1024_addcarryx_u64(&x2511, &x2512, x2510, x2462, x2459);
+  fiat_p1024_addcarryx_u64(&x2513, &x2514, x2512, x2460, x2457);
+  fiat_p1024_addcarryx_u64(&x2515, &x2516, x2514, x2458, x2455);
+  fiat_p1024_addcarryx_u64(&x2517, &x2518, 0x0, x2425, x2485);
+  fiat_p1024_addcarryx_u64(&x2519, &x2520, x2518, x2427, x2487);
+  fiat_p1024_addcarryx_u64(&x2521, &x2522, x2520, x2429, x2489);
+  fiat_p1024_addcarryx_u64(&x2523, &x2524, x2522, x2431, x2491);
+  fiat_p1024_addcarryx_u64(&x2525, &x2526, x2524, x2433, x2493);
+  fiat_p1024_addcarryx_u64(&x2527, &x2528, x2526, x2435, x2495);
+  fiat_p1024_addcarryx_u64(&x2529, &x2530, x2528, x2437, x2497);
+  fiat_p1024_addcarryx_u64(&x2531, &x2532, x2530, x2439, x2499);
+  fiat_p1024_addcarryx_u64(&x2533, &x2534, x2532, x2441, x2501);
+  fiat_p1024_addcarryx_u64(&x2535, &x2536, x2534, x2443, x2503);
+  fiat_p1024_addcarryx_u64(&x2537, &x2538, x2536, x2445, x2505);
+  fiat_p1024_addcarryx_u64(&x2539, &x2540, x2538, x2447, x2507);
+  fiat_p1024_addcarryx_u64(&x2541, &x2542, x2540, x2449, x2509);
+  fiat_p1024_addcarryx_u64(&x2543, &x2544, x2542, x2451, x2511);
+  fiat_p1024_addcarryx_u64(&x2545, &x2546, x2544, x2453, x2513);
+  fiat_p1024_addcarryx_u64(&x2547, &x2548, x2546, ((x2454 + (x2358 + (x2326 + x2266))) + (x2422 + x2362)), x2515);
+  fiat_p1024_mulx_u64(&x2549, &x2550, x2517, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x2551, &x2552, x2549, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x2553, &x2554, x2549, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x2555, &x2556, x2549, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x2557, &x2558, x2549, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x2559, &x2560, x2549, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x2561, &x2562, x2549, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x2563, &x2564, x2549, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x2565, &x2566, x2549, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x2567, &x2568, x2549, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x2569, &x2570, x2549, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x2571, &x2572, x2549, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x2573, &x2574, x2549, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x2575, &x2576, x2549, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x2577, &x2578, x2549, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x2579, &x2580, x2549, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x2581, &x2582, x2549, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x2583, &x2584, 0x0, x2582, x2579);
+  fiat_p1024_addcarryx_u64(&x2585, &x2586, x2584, x2580, x2577);
+  fiat_p1024_addcarryx_u64(&x2587, &x2588, x2586, x2578, x2575);
+  fiat_p1024_addcarryx_u64(&x2589, &x2590, x2588, x2576, x2573);
+  fiat_p1024_addcarryx_u64(&x2591, &x2592, x2590, x2574, x2571);
+  fiat_p1024_addcarryx_u64(&x2593, &x2594, x2592, x2572, x2569);
+  fiat_p1024_addcarryx_u64(&x2595, &x2596, x2594, x2570, x2567);
+  fiat_p1024_addcarryx_u64(&x2597, &x2598, x2596, x2568, x2565);
+  fiat_p1024_addcarryx_u64(&x2599, &x2600, x2598, x2566, x2563);
+  fiat_p1024_addcarryx_u64(&x2601, &x2602, x2600, x2564, x2561);
+  fiat_p1024_addcarryx_u64(&x2603, &x2604, x2602, x2562, x2559);
+  fiat_p1024_addcarryx_u64(&x2605, &x2606, x2604, x2560, x2557);
+  fiat_p1024_addcarryx_u64(&x2607, &x2608, x2606, x2558, x2555);
+  fiat_p1024_addcarryx_u64(&x2609, &x2610, x2608, x2556, x2553);
+  fiat_p1024_addcarryx_u64(&x2611, &x2612, x2610, x2554, x2551);
+  fiat_p1024_addcarryx_u64(&x2613, &x2614, 0x0, x2517, x2581);
+  fiat_p1024_addcarryx_u64(&x2615, &x2616, x2614, x2519, x2583);
+  fiat_p1024_addcarryx_u64(&x2617, &x2618, x2616, x2521, x2585);
+  fiat_p1024_addcarryx_u64(&x2619, &x2620, x2618, x2523, x2587);
+  fiat_p1024_addcarryx_u64(&x2621, &x2622, x2620, x2525, x2589);
+  fiat_p1024_addcarryx_u64(&x2623, &x2624, x2622, x2527, x2591);
+  fiat_p1024_addcarryx_u64(&x2625, &x2626, x2624, x2529, x2593);
+  fiat_p1024_addcarryx_u64(&x2627, &x2628, x2626, x2531, x2595);
+  fiat_p1024_addcarryx_u64(&x2629, &x2630, x2628, x2533, x2597);
+  fiat_p1024_addcarryx_u64(&x2631, &x2632, x2630, x2535, x2599);
+  fiat_p1024_addcarryx_u64(&x2633, &x2634, x2632, x2537, x2601);
+  fiat_p1024_addcarryx_u64(&x2635, &x2636, x2634, x2539, x2603);
+  fiat_p1024_addcarryx_u64(&x2637, &x2638, x2636, x2541, x2605);
+  fiat_p1024_addcarryx_u64(&x2639, &x2640, x2638, x2543, x2607);
+  fiat_p1024_addcarryx_u64(&x2641, &x2642, x2640, x2545, x2609);
+  fiat_p1024_addcarryx_u64(&x2643, &x2644, x2642, x2547, x2611);
+  fiat_p1024_mulx_u64(&x2645, &x2646, x14, UINT64_C(0x57162f911ca93a3));
+  fiat_p1024_mulx_u64(&x2647, &x2648, x14, UINT64_C(0xe1a2eb1d838b8237));
+  fiat_p1024_mulx_u64(&x2649, &x2650, x14, UINT64_C(0x4f8a8344bbe56288));
+  fiat_p1024_mulx_u64(&x2651, &x2652, x14, UINT64_C(0xc124d930289048b5));
+  fiat_p1024_mulx_u64(&x2653, &x2654, x14, UINT64_C(0xa5ae047480992b2c));
+  fiat_p1024_mulx_u64(&x2655, &x2656, x14, UINT64_C(0x156b34c683839b9d));
+  fiat_p1024_mulx_u64(&x2657, &x2658, x14, UINT64_C(0x9aad9631bb760403));
+  fiat_p1024_mulx_u64(&x2659, &x2660, x14, UINT64_C(0x8aa6fa7e6811faa8));
+  fiat_p1024_mulx_u64(&x2661, &x2662, x14, UINT64_C(0xa0056a67c1de0e82));
+  fiat_p1024_mulx_u64(&x2663, &x2664, x14, UINT64_C(0x9365ec4fa327c9ac));
+  fiat_p1024_mulx_u64(&x2665, &x2666, x14, UINT64_C(0x6cdde00636c4f801));
+  fiat_p1024_mulx_u64(&x2667, &x2668, x14, UINT64_C(0x8c1a56e5bf96471a));
+  fiat_p1024_mulx_u64(&x2669, &x2670, x14, UINT64_C(0x4f2940830c1d2b35));
+  fiat_p1024_mulx_u64(&x2671, &x2672, x14, UINT64_C(0x1322c9b9837dca17));
+  fiat_p1024_mulx_u64(&x2673, &x2674, x14, UINT64_C(0x68ac5d7707ccb03a));
+  fiat_p1024_mulx_u64(&x2675, &x2676, x14, UINT64_C(0xd6b8f146ec5055af));
+  fiat_p1024_addcarryx_u64(&x2677, &x2678, 0x0, x2676, x2673);
+  fiat_p1024_addcarryx_u64(&x2679, &x2680, x2678, x2674, x2671);
+  fiat_p1024_addcarryx_u64(&x2681, &x2682, x2680, x2672, x2669);
+  fiat_p1024_addcarryx_u64(&x2683, &x2684, x2682, x2670, x2667);
+  fiat_p1024_addcarryx_u64(&x2685, &x2686, x2684, x2668, x2665);
+  fiat_p1024_addcarryx_u64(&x2687, &x2688, x2686, x2666, x2663);
+  fiat_p1024_addcarryx_u64(&x2689, &x2690, x2688, x2664, x2661);
+  fiat_p1024_addcarryx_u64(&x2691, &x2692, x2690, x2662, x2659);
+  fiat_p1024_addcarryx_u64(&x2693, &x2694, x2692, x2660, x2657);
+  fiat_p1024_addcarryx_u64(&x2695, &x2696, x2694, x2658, x2655);
+  fiat_p1024_addcarryx_u64(&x2697, &x2698, x2696, x2656, x2653);
+  fiat_p1024_addcarryx_u64(&x2699, &x2700, x2698, x2654, x2651);
+  fiat_p1024_addcarryx_u64(&x2701, &x2702, x2700, x2652, x2649);
+  fiat_p1024_addcarryx_u64(&x2703, &x2704, x2702, x2650, x2647);
+  fiat_p1024_addcarryx_u64(&x2705, &x2706, x2704, x2648, x2645);
+  fiat_p1024_addcarryx_u64(&x2707, &x2708, 0x0, x2615, x2675);
+  fiat_p1024_addcarryx_u64(&x2709, &x2710, x2708, x2617, x2677);
+  fiat_p1024_addcarryx_u64(&x2711, &x2712, x2710, x2619, x2679);
+  fiat_p1024_addcarryx_u64(&x2713, &x2714, x2712, x2621, x2681);
+  fiat_p1024_addcarryx_u64(&x2715, &x2716, x2714, x2623, x2683);
+  fiat_p1024_addcarryx_u64(&x2717, &x2718, x2716, x2625, x2685);
+  fiat_p1024_addcarryx_u64(&x2719, &x2720, x2718, x2627, x2687);
+  fiat_p1024_addcarryx_u64(&x2721, &x2722, x2720, x2629, x2689);
+  fiat_p1024_addcarryx_u64(&x2723, &x2724, x2722, x2631, x2691);
+  fiat_p1024_addcarryx_u64(&x2725, &x2726, x2724, x2633, x2693);
+  fiat_p1024_addcarryx_u64(&x2727, &x2728, x2726, x2635, x2695);
+  fiat_p1024_addcarryx_u64(&x2729, &x2730, x2728, x2637, x2697);
+  fiat_p1024_addcarryx_u64(&x2731, &x2732, x2730, x2639, x2699);
+  fiat_p1024_addcarryx_u64(&x2733, &x2734, x2732, x2641, x2701);
+  fiat_p1024_addcarryx_u64(&x2735, &x2736, x2734, x2643, x2703);
+  fiat_p1024_addcarryx_u64(&x2737, &x2738, x2736, ((x2644 + (x2548 + (x2516 + x2456))) + (x2612 + x2552)), x2705);
+  fiat_p1024_mulx_u64(&x2739, &x2740, x2707, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x2741, &x2742, x2739, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x2743, &x2744, x2739, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x2745, &x2746, x2739, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x2747, &x2748, x2739, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x2749, &x2750, x2739, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x2751, &x2752, x2739, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x2753, &x2754, x2739, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x2755, &x2756, x2739, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x2757, &x2758, x2739, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x2759, &x2760, x2739, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x2761, &x2762, x2739, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x2763, &x2764, x2739, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x2765, &x2766, x2739, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x2767, &x2768, x2739, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x2769, &x2770, x2739, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x2771, &x2772, x2739, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x2773, &x2774, 0x0, x2772, x2769);
+  fiat_p1024_addcarryx_u64(&x2775, &x2776, x2774, x2770, x2767);
+  fiat_p1024_addcarryx_u64(&x2777, &x2778, x2776, x2768, x2765);
+  fiat_p1024_addcarryx_u64(&x2779, &x2780, x2778, x2766, x2763);
+  fiat_p1024_addcarryx_u64(&x2781, &x2782, x2780, x2764, x2761);
+  fiat_p1024_addcarryx_u64(&x2783, &x2784, x2782, x2762, x2759);
+  fiat_p1024_addcarryx_u64(&x2785, &x2786, x2784, x2760, x2757);
+  fiat_p1024_addcarryx_u64(&x2787, &x2788, x2786, x2758, x2755);
+  fiat_p1024_addcarryx_u64(&x2789, &x2790, x2788, x2756, x2753);
+  fiat_p1024_addcarryx_u64(&x2791, &x2792, x2790, x2754, x2751);
+  fiat_p1024_addcarryx_u64(&x2793, &x2794, x2792, x2752, x2749);
+  fiat_p1024_addcarryx_u64(&x2795, &x2796, x2794, x2750, x2747);
+  fiat_p1024_addcarryx_u64(&x2797, &x2798, x2796, x2748, x2745);
+  fiat_p1024_addcarryx_u64(&x2799, &x2800, x2798, x2746, x2743);
+  fiat_p1024_addcarryx_u64(&x2801, &x2802, x2800, x2744, x2741);
+  fiat_p1024_addcarryx_u64(&x2803, &x2804, 0x0, x2707, x2771);
+  fiat_p1024_addcarryx_u64(&x2805, &x2806, x2804, x2709, x2773);
+  fiat_p1024_addcarryx_u64(&x2807, &x2808, x2806, x2711, x2775);
+  fiat_p1024_addcarryx_u64(&x2809, &x2810, x2808, x2713, x2777);
+  fiat_p1024_addcarryx_u64(&x2811, &x2812, x2810, x2715, x2779);
+  fiat_p1024_addcarryx_u64(&x2813, &x2814, x2812, x2717, x2781);
+  fiat_p1024_addcarryx_u64(&x2815, &x2816, x2814, x2719, x2783);
+  fiat_p1024_addcarryx_u64(&x2817, &x2818, x2816, x2721, x2785);
+  fiat_p1024_addcarryx_u64(&x2819, &x2820, x2818, x2723, x2787);
+  fiat_p1024_addcarryx_u64(&x2821, &x2822, x2820, x2725, x2789);
+  fiat_p1024_addcarryx_u64(&x2823, &x2824, x2822, x2727, x2791);
+  fiat_p1024_addcarryx_u64(&x2825, &x2826, x2824, x2729, x2793);
+  fiat_p1024_addcarryx_u64(&x2827, &x2828, x2826, x2731, x2795);
+  fiat_p1024_addcarryx_u64(&x2829, &x2830, x2828, x2733, x2797);
+  fiat_p1024_addcarryx_u64(&x2831, &x2832, x2830, x2735, x2799);
+  fiat_p1024_addcarryx_u64(&x2833, &x2834, x2832, x2737, x2801);
+  fiat_p1024_mulx_u64(&x2835, &x2836, x15, UINT64_C(0x57162f911ca93a3));
+  fiat_p1024_mulx_u64(&x2837, &x2838, x15, UINT64_C(0xe1a2eb1d838b8237));
+  fiat_p1024_mulx_u64(&x2839, &x2840, x15, UINT64_C(0x4f8a8344bbe56288));
+  fiat_p1024_mulx_u64(&x2841, &x2842, x15, UINT64_C(0xc124d930289048b5));
+  fiat_p1024_mulx_u64(&x2843, &x2844, x15, UINT64_C(0xa5ae047480992b2c));
+  fiat_p1024_mulx_u64(&x2845, &x2846, x15, UINT64_C(0x156b34c683839b9d));
+  fiat_p1024_mulx_u64(&x2847, &x2848, x15, UINT64_C(0x9aad9631bb760403));
+  fiat_p1024_mulx_u64(&x2849, &x2850, x15, UINT64_C(0x8aa6fa7e6811faa8));
+  fiat_p1024_mulx_u64(&x2851, &x2852, x15, UINT64_C(0xa0056a67c1de0e82));
+  fiat_p1024_mulx_u64(&x2853, &x2854, x15, UINT64_C(0x9365ec4fa327c9ac));
+  fiat_p1024_mulx_u64(&x2855, &x2856, x15, UINT64_C(0x6cdde00636c4f801));
+  fiat_p1024_mulx_u64(&x2857, &x2858, x15, UINT64_C(0x8c1a56e5bf96471a));
+  fiat_p1024_mulx_u64(&x2859, &x2860, x15, UINT64_C(0x4f2940830c1d2b35));
+  fiat_p1024_mulx_u64(&x2861, &x2862, x15, UINT64_C(0x1322c9b9837dca17));
+  fiat_p1024_mulx_u64(&x2863, &x2864, x15, UINT64_C(0x68ac5d7707ccb03a));
+  fiat_p1024_mulx_u64(&x2865, &x2866, x15, UINT64_C(0xd6b8f146ec5055af));
+  fiat_p1024_addcarryx_u64(&x2867, &x2868, 0x0, x2866, x2863);
+  fiat_p1024_addcarryx_u64(&x2869, &x2870, x2868, x2864, x2861);
+  fiat_p1024_addcarryx_u64(&x2871, &x2872, x2870, x2862, x2859);
+  fiat_p1024_addcarryx_u64(&x2873, &x2874, x2872, x2860, x2857);
+  fiat_p1024_addcarryx_u64(&x2875, &x2876, x2874, x2858, x2855);
+  fiat_p1024_addcarryx_u64(&x2877, &x2878, x2876, x2856, x2853);
+  fiat_p1024_addcarryx_u64(&x2879, &x2880, x2878, x2854, x2851);
+  fiat_p1024_addcarryx_u64(&x2881, &x2882, x2880, x2852, x2849);
+  fiat_p1024_addcarryx_u64(&x2883, &x2884, x2882, x2850, x2847);
+  fiat_p1024_addcarryx_u64(&x2885, &x2886, x2884, x2848, x2845);
+  fiat_p1024_addcarryx_u64(&x2887, &x2888, x2886, x2846, x2843);
+  fiat_p1024_addcarryx_u64(&x2889, &x2890, x2888, x2844, x2841);
+  fiat_p1024_addcarryx_u64(&x2891, &x2892, x2890, x2842, x2839);
+  fiat_p1024_addcarryx_u64(&x2893, &x2894, x2892, x2840, x2837);
+  fiat_p1024_addcarryx_u64(&x2895, &x2896, x2894, x2838, x2835);
+  fiat_p1024_addcarryx_u64(&x2897, &x2898, 0x0, x2805, x2865);
+  fiat_p1024_addcarryx_u64(&x2899, &x2900, x2898, x2807, x2867);
+  fiat_p1024_addcarryx_u64(&x2901, &x2902, x2900, x2809, x2869);
+  fiat_p1024_addcarryx_u64(&x2903, &x2904, x2902, x2811, x2871);
+  fiat_p1024_addcarryx_u64(&x2905, &x2906, x2904, x2813, x2873);
+  fiat_p1024_addcarryx_u64(&x2907, &x2908, x2906, x2815, x2875);
+  fiat_p1024_addcarryx_u64(&x2909, &x2910, x2908, x2817, x2877);
+  fiat_p1024_addcarryx_u64(&x2911, &x2912, x2910, x2819, x2879);
+  fiat_p1024_addcarryx_u64(&x2913, &x2914, x2912, x2821, x2881);
+  fiat_p1024_addcarryx_u64(&x2915, &x2916, x2914, x2823, x2883);
+  fiat_p1024_addcarryx_u64(&x2917, &x2918, x2916, x2825, x2885);
+  fiat_p1024_addcarryx_u64(&x2919, &x2920, x2918, x2827, x2887);
+  fiat_p1024_addcarryx_u64(&x2921, &x2922, x2920, x2829, x2889);
+  fiat_p1024_addcarryx_u64(&x2923, &x2924, x2922, x2831, x2891);
+  fiat_p1024_addcarryx_u64(&x2925, &x2926, x2924, x2833, x2893);
+  fiat_p1024_addcarryx_u64(&x2927, &x2928, x2926, ((x2834 + (x2738 + (x2706 + x2646))) + (x2802 + x2742)), x2895);
+  fiat_p1024_mulx_u64(&x2929, &x2930, x2897, UINT64_C(0xd2c2c24160038025));
+  fiat_p1024_mulx_u64(&x2931, &x2932, x2929, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_mulx_u64(&x2933, &x2934, x2929, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_mulx_u64(&x2935, &x2936, x2929, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_mulx_u64(&x2937, &x2938, x2929, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_mulx_u64(&x2939, &x2940, x2929, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_mulx_u64(&x2941, &x2942, x2929, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_mulx_u64(&x2943, &x2944, x2929, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_mulx_u64(&x2945, &x2946, x2929, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_mulx_u64(&x2947, &x2948, x2929, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_mulx_u64(&x2949, &x2950, x2929, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_mulx_u64(&x2951, &x2952, x2929, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_mulx_u64(&x2953, &x2954, x2929, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_mulx_u64(&x2955, &x2956, x2929, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_mulx_u64(&x2957, &x2958, x2929, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_mulx_u64(&x2959, &x2960, x2929, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_mulx_u64(&x2961, &x2962, x2929, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_addcarryx_u64(&x2963, &x2964, 0x0, x2962, x2959);
+  fiat_p1024_addcarryx_u64(&x2965, &x2966, x2964, x2960, x2957);
+  fiat_p1024_addcarryx_u64(&x2967, &x2968, x2966, x2958, x2955);
+  fiat_p1024_addcarryx_u64(&x2969, &x2970, x2968, x2956, x2953);
+  fiat_p1024_addcarryx_u64(&x2971, &x2972, x2970, x2954, x2951);
+  fiat_p1024_addcarryx_u64(&x2973, &x2974, x2972, x2952, x2949);
+  fiat_p1024_addcarryx_u64(&x2975, &x2976, x2974, x2950, x2947);
+  fiat_p1024_addcarryx_u64(&x2977, &x2978, x2976, x2948, x2945);
+  fiat_p1024_addcarryx_u64(&x2979, &x2980, x2978, x2946, x2943);
+  fiat_p1024_addcarryx_u64(&x2981, &x2982, x2980, x2944, x2941);
+  fiat_p1024_addcarryx_u64(&x2983, &x2984, x2982, x2942, x2939);
+  fiat_p1024_addcarryx_u64(&x2985, &x2986, x2984, x2940, x2937);
+  fiat_p1024_addcarryx_u64(&x2987, &x2988, x2986, x2938, x2935);
+  fiat_p1024_addcarryx_u64(&x2989, &x2990, x2988, x2936, x2933);
+  fiat_p1024_addcarryx_u64(&x2991, &x2992, x2990, x2934, x2931);
+  fiat_p1024_addcarryx_u64(&x2993, &x2994, 0x0, x2897, x2961);
+  fiat_p1024_addcarryx_u64(&x2995, &x2996, x2994, x2899, x2963);
+  fiat_p1024_addcarryx_u64(&x2997, &x2998, x2996, x2901, x2965);
+  fiat_p1024_addcarryx_u64(&x2999, &x3000, x2998, x2903, x2967);
+  fiat_p1024_addcarryx_u64(&x3001, &x3002, x3000, x2905, x2969);
+  fiat_p1024_addcarryx_u64(&x3003, &x3004, x3002, x2907, x2971);
+  fiat_p1024_addcarryx_u64(&x3005, &x3006, x3004, x2909, x2973);
+  fiat_p1024_addcarryx_u64(&x3007, &x3008, x3006, x2911, x2975);
+  fiat_p1024_addcarryx_u64(&x3009, &x3010, x3008, x2913, x2977);
+  fiat_p1024_addcarryx_u64(&x3011, &x3012, x3010, x2915, x2979);
+  fiat_p1024_addcarryx_u64(&x3013, &x3014, x3012, x2917, x2981);
+  fiat_p1024_addcarryx_u64(&x3015, &x3016, x3014, x2919, x2983);
+  fiat_p1024_addcarryx_u64(&x3017, &x3018, x3016, x2921, x2985);
+  fiat_p1024_addcarryx_u64(&x3019, &x3020, x3018, x2923, x2987);
+  fiat_p1024_addcarryx_u64(&x3021, &x3022, x3020, x2925, x2989);
+  fiat_p1024_addcarryx_u64(&x3023, &x3024, x3022, x2927, x2991);
+  x3025 = ((x3024 + (x2928 + (x2896 + x2836))) + (x2992 + x2932));
+  fiat_p1024_subborrowx_u64(&x3026, &x3027, 0x0, x2995, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_subborrowx_u64(&x3028, &x3029, x3027, x2997, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_subborrowx_u64(&x3030, &x3031, x3029, x2999, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_subborrowx_u64(&x3032, &x3033, x3031, x3001, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_subborrowx_u64(&x3034, &x3035, x3033, x3003, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_subborrowx_u64(&x3036, &x3037, x3035, x3005, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_subborrowx_u64(&x3038, &x3039, x3037, x3007, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_subborrowx_u64(&x3040, &x3041, x3039, x3009, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_subborrowx_u64(&x3042, &x3043, x3041, x3011, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_subborrowx_u64(&x3044, &x3045, x3043, x3013, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_subborrowx_u64(&x3046, &x3047, x3045, x3015, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_subborrowx_u64(&x3048, &x3049, x3047, x3017, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_subborrowx_u64(&x3050, &x3051, x3049, x3019, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_subborrowx_u64(&x3052, &x3053, x3051, x3021, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_subborrowx_u64(&x3054, &x3055, x3053, x3023, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_subborrowx_u64(&x3056, &x3057, x3055, x3025, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_subborrowx_u64(&x3058, &x3059, x3057, 0x0, 0x0);
+  fiat_p1024_cmovznz_u64(&x3060, x3059, x3026, x2995);
+  fiat_p1024_cmovznz_u64(&x3061, x3059, x3028, x2997);
+  fiat_p1024_cmovznz_u64(&x3062, x3059, x3030, x2999);
+  fiat_p1024_cmovznz_u64(&x3063, x3059, x3032, x3001);
+  fiat_p1024_cmovznz_u64(&x3064, x3059, x3034, x3003);
+  fiat_p1024_cmovznz_u64(&x3065, x3059, x3036, x3005);
+  fiat_p1024_cmovznz_u64(&x3066, x3059, x3038, x3007);
+  fiat_p1024_cmovznz_u64(&x3067, x3059, x3040, x3009);
+  fiat_p1024_cmovznz_u64(&x3068, x3059, x3042, x3011);
+  fiat_p1024_cmovznz_u64(&x3069, x3059, x3044, x3013);
+  fiat_p1024_cmovznz_u64(&x3070, x3059, x3046, x3015);
+  fiat_p1024_cmovznz_u64(&x3071, x3059, x3048, x3017);
+  fiat_p1024_cmovznz_u64(&x3072, x3059, x3050, x3019);
+  fiat_p1024_cmovznz_u64(&x3073, x3059, x3052, x3021);
+  fiat_p1024_cmovznz_u64(&x3074, x3059, x3054, x3023);
+  fiat_p1024_cmovznz_u64(&x3075, x3059, x3056, x3025);
+  out1[0] = x3060;
+  out1[1] = x3061;
+  out1[2] = x3062;
+  out1[3] = x3063;
+  out1[4] = x3064;
+  out1[5] = x3065;
+  out1[6] = x3066;
+  out1[7] = x3067;
+  out1[8] = x3068;
+  out1[9] = x3069;
+  out1[10] = x3070;
+  out1[11] = x3071;
+  out1[12] = x3072;
+  out1[13] = x3073;
+  out1[14] = x3074;
+  out1[15] = x3075;
+}
+
+/*
+ * The function fiat_p1024_nonzero outputs a single non-zero word if the input is non-zero and zero otherwise.
+ *
+ * Preconditions:
+ *   0 ≤ eval arg1 < m
+ * Postconditions:
+ *   out1 = 0 ↔ eval (from_montgomery arg1) mod m = 0
+ *
+ * Input Bounds:
+ *   arg1: [[0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff]]
+ * Output Bounds:
+ *   out1: [0x0 ~> 0xffffffffffffffff]
+ */
+void fiat_p1024_nonzero(uint64_t* out1, const uint64_t arg1[16]) {
+  uint64_t x1;
+  x1 = ((arg1[0]) | ((arg1[1]) | ((arg1[2]) | ((arg1[3]) | ((arg1[4]) | ((arg1[5]) | ((arg1[6]) | ((arg1[7]) | ((arg1[8]) | ((arg1[9]) | ((arg1[10]) | ((arg1[11]) | ((arg1[12]) | ((arg1[13]) | ((arg1[14]) | (arg1[15]))))))))))))))));
+  *out1 = x1;
+}
+
+/*
+ * The function fiat_p1024_selectznz is a multi-limb conditional select.
+ *
+ * Postconditions:
+ *   out1 = (if arg1 = 0 then arg2 else arg3)
+ *
+ * Input Bounds:
+ *   arg1: [0x0 ~> 0x1]
+ *   arg2: [[0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff]]
+ *   arg3: [[0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff]]
+ * Output Bounds:
+ *   out1: [[0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff]]
+ */
+
+/*
+ * The function fiat_p1024_to_bytes serializes a field element NOT in the Montgomery domain to bytes in little-endian order.
+ *
+ * Preconditions:
+ *   0 ≤ eval arg1 < m
+ * Postconditions:
+ *   out1 = map (λ x, ⌊((eval arg1 mod m) mod 2^(8 * (x + 1))) / 2^(8 * x)⌋) [0..127]
+ *
+ * Input Bounds:
+ *   arg1: [[0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xfffffffffffffff]]
+ * Output Bounds:
+ *   out1: [[0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xf]]
+ */
+void fiat_p1024_to_bytes(uint64_t out1[128], const uint64_t arg1[16]) {
+  uint64_t x1;
+  uint64_t x2;
+  uint64_t x3;
+  uint64_t x4;
+  uint64_t x5;
+  uint64_t x6;
+  uint64_t x7;
+  uint64_t x8;
+  uint64_t x9;
+  uint64_t x10;
+  uint64_t x11;
+  uint64_t x12;
+  uint64_t x13;
+  uint64_t x14;
+  uint64_t x15;
+  uint64_t x16;
+  uint64_t x17;
+  uint64_t x18;
+  uint64_t x19;
+  uint64_t x20;
+  uint64_t x21;
+  uint64_t x22;
+  uint64_t x23;
+  uint64_t x24;
+  uint64_t x25;
+  uint64_t x26;
+  uint64_t x27;
+  uint64_t x28;
+  uint64_t x29;
+  uint64_t x30;
+  uint64_t x31;
+  uint64_t x32;
+  uint64_t x33;
+  uint64_t x34;
+  uint64_t x35;
+  uint64_t x36;
+  uint64_t x37;
+  uint64_t x38;
+  uint64_t x39;
+  uint64_t x40;
+  uint64_t x41;
+  uint64_t x42;
+  uint64_t x43;
+  uint64_t x44;
+  uint64_t x45;
+  uint64_t x46;
+  uint64_t x47;
+  uint64_t x48;
+  uint64_t x49;
+  uint64_t x50;
+  uint64_t x51;
+  uint64_t x52;
+  uint64_t x53;
+  uint64_t x54;
+  uint64_t x55;
+  uint64_t x56;
+  uint64_t x57;
+  uint64_t x58;
+  uint64_t x59;
+  uint64_t x60;
+  uint64_t x61;
+  uint64_t x62;
+  uint64_t x63;
+  uint64_t x64;
+  uint64_t x65;
+  uint64_t x66;
+  uint64_t x67;
+  uint64_t x68;
+  uint64_t x69;
+  uint64_t x70;
+  uint64_t x71;
+  uint64_t x72;
+  uint64_t x73;
+  uint64_t x74;
+  uint64_t x75;
+  uint64_t x76;
+  uint64_t x77;
+  uint64_t x78;
+  uint64_t x79;
+  uint64_t x80;
+  uint64_t x81;
+  uint64_t x82;
+  uint64_t x83;
+  uint64_t x84;
+  uint64_t x85;
+  uint64_t x86;
+  uint64_t x87;
+  uint64_t x88;
+  uint64_t x89;
+  uint64_t x90;
+  uint64_t x91;
+  uint64_t x92;
+  uint64_t x93;
+  uint64_t x94;
+  uint64_t x95;
+  uint64_t x96;
+  uint64_t x97;
+  uint64_t x98;
+  uint64_t x99;
+  uint64_t x100;
+  uint64_t x101;
+  uint64_t x102;
+  uint64_t x103;
+  uint64_t x104;
+  uint64_t x105;
+  uint64_t x106;
+  uint64_t x107;
+  uint64_t x108;
+  uint64_t x109;
+  uint64_t x110;
+  uint64_t x111;
+  uint64_t x112;
+  uint64_t x113;
+  uint64_t x114;
+  uint64_t x115;
+  uint64_t x116;
+  uint64_t x117;
+  uint64_t x118;
+  uint64_t x119;
+  uint64_t x120;
+  uint64_t x121;
+  uint64_t x122;
+  uint64_t x123;
+  uint64_t x124;
+  uint64_t x125;
+  uint64_t x126;
+  uint64_t x127;
+  uint64_t x128;
+  uint64_t x129;
+  uint64_t x130;
+  uint64_t x131;
+  uint64_t x132;
+  uint64_t x133;
+  uint64_t x134;
+  uint64_t x135;
+  uint64_t x136;
+  uint64_t x137;
+  uint64_t x138;
+  uint64_t x139;
+  uint64_t x140;
+  uint64_t x141;
+  uint64_t x142;
+  uint64_t x143;
+  uint64_t x144;
+  uint64_t x145;
+  uint64_t x146;
+  uint64_t x147;
+  uint64_t x148;
+  uint64_t x149;
+  uint64_t x150;
+  uint64_t x151;
+  uint64_t x152;
+  uint64_t x153;
+  uint64_t x154;
+  uint64_t x155;
+  uint64_t x156;
+  uint64_t x157;
+  uint64_t x158;
+  uint64_t x159;
+  uint64_t x160;
+  uint64_t x161;
+  uint64_t x162;
+  uint64_t x163;
+  uint64_t x164;
+  uint64_t x165;
+  uint64_t x166;
+  uint64_t x167;
+  uint64_t x168;
+  uint64_t x169;
+  uint64_t x170;
+  uint64_t x171;
+  uint64_t x172;
+  uint64_t x173;
+  uint64_t x174;
+  uint64_t x175;
+  uint64_t x176;
+  uint64_t x177;
+  uint64_t x178;
+  uint64_t x179;
+  uint64_t x180;
+  uint64_t x181;
+  uint64_t x182;
+  uint64_t x183;
+  uint64_t x184;
+  uint64_t x185;
+  uint64_t x186;
+  uint64_t x187;
+  uint64_t x188;
+  uint64_t x189;
+  uint64_t x190;
+  uint64_t x191;
+  uint64_t x192;
+  uint64_t x193;
+  uint64_t x194;
+  uint64_t x195;
+  uint64_t x196;
+  uint64_t x197;
+  uint64_t x198;
+  uint64_t x199;
+  uint64_t x200;
+  uint64_t x201;
+  uint64_t x202;
+  uint64_t x203;
+  uint64_t x204;
+  uint64_t x205;
+  uint64_t x206;
+  uint64_t x207;
+  uint64_t x208;
+  uint64_t x209;
+  uint64_t x210;
+  uint64_t x211;
+  uint64_t x212;
+  uint64_t x213;
+  uint64_t x214;
+  uint64_t x215;
+  uint64_t x216;
+  uint64_t x217;
+  uint64_t x218;
+  uint64_t x219;
+  uint64_t x220;
+  uint64_t x221;
+  uint64_t x222;
+  uint64_t x223;
+  uint64_t x224;
+  uint64_t x225;
+  uint64_t x226;
+  uint64_t x227;
+  uint64_t x228;
+  uint64_t x229;
+  uint64_t x230;
+  uint64_t x231;
+  uint64_t x232;
+  uint64_t x233;
+  uint64_t x234;
+  uint64_t x235;
+  uint64_t x236;
+  uint64_t x237;
+  uint64_t x238;
+  uint64_t x239;
+  uint64_t x240;
+  x1 = (arg1[15]);
+  x2 = (arg1[14]);
+  x3 = (arg1[13]);
+  x4 = (arg1[12]);
+  x5 = (arg1[11]);
+  x6 = (arg1[10]);
+  x7 = (arg1[9]);
+  x8 = (arg1[8]);
+  x9 = (arg1[7]);
+  x10 = (arg1[6]);
+  x11 = (arg1[5]);
+  x12 = (arg1[4]);
+  x13 = (arg1[3]);
+  x14 = (arg1[2]);
+  x15 = (arg1[1]);
+  x16 = (arg1[0]);
+  x17 = (x16 & UINT8_C(0xff));
+  x18 = (x16 >> 8);
+  x19 = (x18 & UINT8_C(0xff));
+  x20 = (x18 >> 8);
+  x21 = (x20 & UINT8_C(0xff));
+  x22 = (x20 >> 8);
+  x23 = (x22 & UINT8_C(0xff));
+  x24 = (x22 >> 8);
+  x25 = (x24 & UINT8_C(0xff));
+  x26 = (x24 >> 8);
+  x27 = (x26 & UINT8_C(0xff));
+  x28 = (x26 >> 8);
+  x29 = (x28 & UINT8_C(0xff));
+  x30 = (x28 >> 8);
+  x31 = (x15 & UINT8_C(0xff));
+  x32 = (x15 >> 8);
+  x33 = (x32 & UINT8_C(0xff));
+  x34 = (x32 >> 8);
+  x35 = (x34 & UINT8_C(0xff));
+  x36 = (x34 >> 8);
+  x37 = (x36 & UINT8_C(0xff));
+  x38 = (x36 >> 8);
+  x39 = (x38 & UINT8_C(0xff));
+  x40 = (x38 >> 8);
+  x41 = (x40 & UINT8_C(0xff));
+  x42 = (x40 >> 8);
+  x43 = (x42 & UINT8_C(0xff));
+  x44 = (x42 >> 8);
+  x45 = (x14 & UINT8_C(0xff));
+  x46 = (x14 >> 8);
+  x47 = (x46 & UINT8_C(0xff));
+  x48 = (x46 >> 8);
+  x49 = (x48 & UINT8_C(0xff));
+  x50 = (x48 >> 8);
+  x51 = (x50 & UINT8_C(0xff));
+  x52 = (x50 >> 8);
+  x53 = (x52 & UINT8_C(0xff));
+  x54 = (x52 >> 8);
+  x55 = (x54 & UINT8_C(0xff));
+  x56 = (x54 >> 8);
+  x57 = (x56 & UINT8_C(0xff));
+  x58 = (x56 >> 8);
+  x59 = (x13 & UINT8_C(0xff));
+  x60 = (x13 >> 8);
+  x61 = (x60 & UINT8_C(0xff));
+  x62 = (x60 >> 8);
+  x63 = (x62 & UINT8_C(0xff));
+  x64 = (x62 >> 8);
+  x65 = (x64 & UINT8_C(0xff));
+  x66 = (x64 >> 8);
+  x67 = (x66 & UINT8_C(0xff));
+  x68 = (x66 >> 8);
+  x69 = (x68 & UINT8_C(0xff));
+  x70 = (x68 >> 8);
+  x71 = (x70 & UINT8_C(0xff));
+  x72 = (x70 >> 8);
+  x73 = (x12 & UINT8_C(0xff));
+  x74 = (x12 >> 8);
+  x75 = (x74 & UINT8_C(0xff));
+  x76 = (x74 >> 8);
+  x77 = (x76 & UINT8_C(0xff));
+  x78 = (x76 >> 8);
+  x79 = (x78 & UINT8_C(0xff));
+  x80 = (x78 >> 8);
+  x81 = (x80 & UINT8_C(0xff));
+  x82 = (x80 >> 8);
+  x83 = (x82 & UINT8_C(0xff));
+  x84 = (x82 >> 8);
+  x85 = (x84 & UINT8_C(0xff));
+  x86 = (x84 >> 8);
+  x87 = (x11 & UINT8_C(0xff));
+  x88 = (x11 >> 8);
+  x89 = (x88 & UINT8_C(0xff));
+  x90 = (x88 >> 8);
+  x91 = (x90 & UINT8_C(0xff));
+  x92 = (x90 >> 8);
+  x93 = (x92 & UINT8_C(0xff));
+  x94 = (x92 >> 8);
+  x95 = (x94 & UINT8_C(0xff));
+  x96 = (x94 >> 8);
+  x97 = (x96 & UINT8_C(0xff));
+  x98 = (x96 >> 8);
+  x99 = (x98 & UINT8_C(0xff));
+  x100 = (x98 >> 8);
+  x101 = (x10 & UINT8_C(0xff));
+  x102 = (x10 >> 8);
+  x103 = (x102 & UINT8_C(0xff));
+  x104 = (x102 >> 8);
+  x105 = (x104 & UINT8_C(0xff));
+  x106 = (x104 >> 8);
+  x107 = (x106 & UINT8_C(0xff));
+  x108 = (x106 >> 8);
+  x109 = (x108 & UINT8_C(0xff));
+  x110 = (x108 >> 8);
+  x111 = (x110 & UINT8_C(0xff));
+  x112 = (x110 >> 8);
+  x113 = (x112 & UINT8_C(0xff));
+  x114 = (x112 >> 8);
+  x115 = (x9 & UINT8_C(0xff));
+  x116 = (x9 >> 8);
+  x117 = (x116 & UINT8_C(0xff));
+  x118 = (x116 >> 8);
+  x119 = (x118 & UINT8_C(0xff));
+  x120 = (x118 >> 8);
+  x121 = (x120 & UINT8_C(0xff));
+  x122 = (x120 >> 8);
+  x123 = (x122 & UINT8_C(0xff));
+  x124 = (x122 >> 8);
+  x125 = (x124 & UINT8_C(0xff));
+  x126 = (x124 >> 8);
+  x127 = (x126 & UINT8_C(0xff));
+  x128 = (x126 >> 8);
+  x129 = (x8 & UINT8_C(0xff));
+  x130 = (x8 >> 8);
+  x131 = (x130 & UINT8_C(0xff));
+  x132 = (x130 >> 8);
+  x133 = (x132 & UINT8_C(0xff));
+  x134 = (x132 >> 8);
+  x135 = (x134 & UINT8_C(0xff));
+  x136 = (x134 >> 8);
+  x137 = (x136 & UINT8_C(0xff));
+  x138 = (x136 >> 8);
+  x139 = (x138 & UINT8_C(0xff));
+  x140 = (x138 >> 8);
+  x141 = (x140 & UINT8_C(0xff));
+  x142 = (x140 >> 8);
+  x143 = (x7 & UINT8_C(0xff));
+  x144 = (x7 >> 8);
+  x145 = (x144 & UINT8_C(0xff));
+  x146 = (x144 >> 8);
+  x147 = (x146 & UINT8_C(0xff));
+  x148 = (x146 >> 8);
+  x149 = (x148 & UINT8_C(0xff));
+  x150 = (x148 >> 8);
+  x151 = (x150 & UINT8_C(0xff));
+  x152 = (x150 >> 8);
+  x153 = (x152 & UINT8_C(0xff));
+  x154 = (x152 >> 8);
+  x155 = (x154 & UINT8_C(0xff));
+  x156 = (x154 >> 8);
+  x157 = (x6 & UINT8_C(0xff));
+  x158 = (x6 >> 8);
+  x159 = (x158 & UINT8_C(0xff));
+  x160 = (x158 >> 8);
+  x161 = (x160 & UINT8_C(0xff));
+  x162 = (x160 >> 8);
+  x163 = (x162 & UINT8_C(0xff));
+  x164 = (x162 >> 8);
+  x165 = (x164 & UINT8_C(0xff));
+  x166 = (x164 >> 8);
+  x167 = (x166 & UINT8_C(0xff));
+  x168 = (x166 >> 8);
+  x169 = (x168 & UINT8_C(0xff));
+  x170 = (x168 >> 8);
+  x171 = (x5 & UINT8_C(0xff));
+  x172 = (x5 >> 8);
+  x173 = (x172 & UINT8_C(0xff));
+  x174 = (x172 >> 8);
+  x175 = (x174 & UINT8_C(0xff));
+  x176 = (x174 >> 8);
+  x177 = (x176 & UINT8_C(0xff));
+  x178 = (x176 >> 8);
+  x179 = (x178 & UINT8_C(0xff));
+  x180 = (x178 >> 8);
+  x181 = (x180 & UINT8_C(0xff));
+  x182 = (x180 >> 8);
+  x183 = (x182 & UINT8_C(0xff));
+  x184 = (x182 >> 8);
+  x185 = (x4 & UINT8_C(0xff));
+  x186 = (x4 >> 8);
+  x187 = (x186 & UINT8_C(0xff));
+  x188 = (x186 >> 8);
+  x189 = (x188 & UINT8_C(0xff));
+  x190 = (x188 >> 8);
+  x191 = (x190 & UINT8_C(0xff));
+  x192 = (x190 >> 8);
+  x193 = (x192 & UINT8_C(0xff));
+  x194 = (x192 >> 8);
+  x195 = (x194 & UINT8_C(0xff));
+  x196 = (x194 >> 8);
+  x197 = (x196 & UINT8_C(0xff));
+  x198 = (x196 >> 8);
+  x199 = (x3 & UINT8_C(0xff));
+  x200 = (x3 >> 8);
+  x201 = (x200 & UINT8_C(0xff));
+  x202 = (x200 >> 8);
+  x203 = (x202 & UINT8_C(0xff));
+  x204 = (x202 >> 8);
+  x205 = (x204 & UINT8_C(0xff));
+  x206 = (x204 >> 8);
+  x207 = (x206 & UINT8_C(0xff));
+  x208 = (x206 >> 8);
+  x209 = (x208 & UINT8_C(0xff));
+  x210 = (x208 >> 8);
+  x211 = (x210 & UINT8_C(0xff));
+  x212 = (x210 >> 8);
+  x213 = (x2 & UINT8_C(0xff));
+  x214 = (x2 >> 8);
+  x215 = (x214 & UINT8_C(0xff));
+  x216 = (x214 >> 8);
+  x217 = (x216 & UINT8_C(0xff));
+  x218 = (x216 >> 8);
+  x219 = (x218 & UINT8_C(0xff));
+  x220 = (x218 >> 8);
+  x221 = (x220 & UINT8_C(0xff));
+  x222 = (x220 >> 8);
+  x223 = (x222 & UINT8_C(0xff));
+  x224 = (x222 >> 8);
+  x225 = (x224 & UINT8_C(0xff));
+  x226 = (x224 >> 8);
+  x227 = (x1 & UINT8_C(0xff));
+  x228 = (x1 >> 8);
+  x229 = (x228 & UINT8_C(0xff));
+  x230 = (x228 >> 8);
+  x231 = (x230 & UINT8_C(0xff));
+  x232 = (x230 >> 8);
+  x233 = (x232 & UINT8_C(0xff));
+  x234 = (x232 >> 8);
+  x235 = (x234 & UINT8_C(0xff));
+  x236 = (x234 >> 8);
+  x237 = (x236 & UINT8_C(0xff));
+  x238 = (x236 >> 8);
+  x239 = (x238 & UINT8_C(0xff));
+  x240 = (x238 >> 8);
+  out1[0] = x17;
+  out1[1] = x19;
+  out1[2] = x21;
+  out1[3] = x23;
+  out1[4] = x25;
+  out1[5] = x27;
+  out1[6] = x29;
+  out1[7] = x30;
+  out1[8] = x31;
+  out1[9] = x33;
+  out1[10] = x35;
+  out1[11] = x37;
+  out1[12] = x39;
+  out1[13] = x41;
+  out1[14] = x43;
+  out1[15] = x44;
+  out1[16] = x45;
+  out1[17] = x47;
+  out1[18] = x49;
+  out1[19] = x51;
+  out1[20] = x53;
+  out1[21] = x55;
+  out1[22] = x57;
+  out1[23] = x58;
+  out1[24] = x59;
+  out1[25] = x61;
+  out1[26] = x63;
+  out1[27] = x65;
+  out1[28] = x67;
+  out1[29] = x69;
+  out1[30] = x71;
+  out1[31] = x72;
+  out1[32] = x73;
+  out1[33] = x75;
+  out1[34] = x77;
+  out1[35] = x79;
+  out1[36] = x81;
+  out1[37] = x83;
+  out1[38] = x85;
+  out1[39] = x86;
+  out1[40] = x87;
+  out1[41] = x89;
+  out1[42] = x91;
+  out1[43] = x93;
+  out1[44] = x95;
+  out1[45] = x97;
+  out1[46] = x99;
+  out1[47] = x100;
+  out1[48] = x101;
+  out1[49] = x103;
+  out1[50] = x105;
+  out1[51] = x107;
+  out1[52] = x109;
+  out1[53] = x111;
+  out1[54] = x113;
+  out1[55] = x114;
+  out1[56] = x115;
+  out1[57] = x117;
+  out1[58] = x119;
+  out1[59] = x121;
+  out1[60] = x123;
+  out1[61] = x125;
+  out1[62] = x127;
+  out1[63] = x128;
+  out1[64] = x129;
+  out1[65] = x131;
+  out1[66] = x133;
+  out1[67] = x135;
+  out1[68] = x137;
+  out1[69] = x139;
+  out1[70] = x141;
+  out1[71] = x142;
+  out1[72] = x143;
+  out1[73] = x145;
+  out1[74] = x147;
+  out1[75] = x149;
+  out1[76] = x151;
+  out1[77] = x153;
+  out1[78] = x155;
+  out1[79] = x156;
+  out1[80] = x157;
+  out1[81] = x159;
+  out1[82] = x161;
+  out1[83] = x163;
+  out1[84] = x165;
+  out1[85] = x167;
+  out1[86] = x169;
+  out1[87] = x170;
+  out1[88] = x171;
+  out1[89] = x173;
+  out1[90] = x175;
+  out1[91] = x177;
+  out1[92] = x179;
+  out1[93] = x181;
+  out1[94] = x183;
+  out1[95] = x184;
+  out1[96] = x185;
+  out1[97] = x187;
+  out1[98] = x189;
+  out1[99] = x191;
+  out1[100] = x193;
+  out1[101] = x195;
+  out1[102] = x197;
+  out1[103] = x198;
+  out1[104] = x199;
+  out1[105] = x201;
+  out1[106] = x203;
+  out1[107] = x205;
+  out1[108] = x207;
+  out1[109] = x209;
+  out1[110] = x211;
+  out1[111] = x212;
+  out1[112] = x213;
+  out1[113] = x215;
+  out1[114] = x217;
+  out1[115] = x219;
+  out1[116] = x221;
+  out1[117] = x223;
+  out1[118] = x225;
+  out1[119] = x226;
+  out1[120] = x227;
+  out1[121] = x229;
+  out1[122] = x231;
+  out1[123] = x233;
+  out1[124] = x235;
+  out1[125] = x237;
+  out1[126] = x239;
+  out1[127] = x240;
+}
+
+/*
+ * The function fiat_p1024_from_bytes deserializes a field element NOT in the Montgomery domain from bytes in little-endian order.
+ *
+ * Preconditions:
+ *   0 ≤ bytes_eval arg1 < m
+ * Postconditions:
+ *   eval out1 mod m = bytes_eval arg1 mod m
+ *   0 ≤ eval out1 < m
+ *
+ * Input Bounds:
+ *   arg1: [[0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xff], [0x0 ~> 0xf]]
+ * Output Bounds:
+ *   out1: [[0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xfffffffffffffff]]
+ */
+void fiat_p1024_from_bytes(uint64_t out1[16], const uint64_t arg1[128]) {
+  uint64_t x1;
+  uint64_t x2;
+  uint64_t x3;
+  uint64_t x4;
+  uint64_t x5;
+  uint64_t x6;
+  uint64_t x7;
+  uint64_t x8;
+  uint64_t x9;
+  uint64_t x10;
+  uint64_t x11;
+  uint64_t x12;
+  uint64_t x13;
+  uint64_t x14;
+  uint64_t x15;
+  uint64_t x16;
+  uint64_t x17;
+  uint64_t x18;
+  uint64_t x19;
+  uint64_t x20;
+  uint64_t x21;
+  uint64_t x22;
+  uint64_t x23;
+  uint64_t x24;
+  uint64_t x25;
+  uint64_t x26;
+  uint64_t x27;
+  uint64_t x28;
+  uint64_t x29;
+  uint64_t x30;
+  uint64_t x31;
+  uint64_t x32;
+  uint64_t x33;
+  uint64_t x34;
+  uint64_t x35;
+  uint64_t x36;
+  uint64_t x37;
+  uint64_t x38;
+  uint64_t x39;
+  uint64_t x40;
+  uint64_t x41;
+  uint64_t x42;
+  uint64_t x43;
+  uint64_t x44;
+  uint64_t x45;
+  uint64_t x46;
+  uint64_t x47;
+  uint64_t x48;
+  uint64_t x49;
+  uint64_t x50;
+  uint64_t x51;
+  uint64_t x52;
+  uint64_t x53;
+  uint64_t x54;
+  uint64_t x55;
+  uint64_t x56;
+  uint64_t x57;
+  uint64_t x58;
+  uint64_t x59;
+  uint64_t x60;
+  uint64_t x61;
+  uint64_t x62;
+  uint64_t x63;
+  uint64_t x64;
+  uint64_t x65;
+  uint64_t x66;
+  uint64_t x67;
+  uint64_t x68;
+  uint64_t x69;
+  uint64_t x70;
+  uint64_t x71;
+  uint64_t x72;
+  uint64_t x73;
+  uint64_t x74;
+  uint64_t x75;
+  uint64_t x76;
+  uint64_t x77;
+  uint64_t x78;
+  uint64_t x79;
+  uint64_t x80;
+  uint64_t x81;
+  uint64_t x82;
+  uint64_t x83;
+  uint64_t x84;
+  uint64_t x85;
+  uint64_t x86;
+  uint64_t x87;
+  uint64_t x88;
+  uint64_t x89;
+  uint64_t x90;
+  uint64_t x91;
+  uint64_t x92;
+  uint64_t x93;
+  uint64_t x94;
+  uint64_t x95;
+  uint64_t x96;
+  uint64_t x97;
+  uint64_t x98;
+  uint64_t x99;
+  uint64_t x100;
+  uint64_t x101;
+  uint64_t x102;
+  uint64_t x103;
+  uint64_t x104;
+  uint64_t x105;
+  uint64_t x106;
+  uint64_t x107;
+  uint64_t x108;
+  uint64_t x109;
+  uint64_t x110;
+  uint64_t x111;
+  uint64_t x112;
+  uint64_t x113;
+  uint64_t x114;
+  uint64_t x115;
+  uint64_t x116;
+  uint64_t x117;
+  uint64_t x118;
+  uint64_t x119;
+  uint64_t x120;
+  uint64_t x121;
+  uint64_t x122;
+  uint64_t x123;
+  uint64_t x124;
+  uint64_t x125;
+  uint64_t x126;
+  uint64_t x127;
+  uint64_t x128;
+  uint64_t x129;
+  uint64_t x130;
+  uint64_t x131;
+  uint64_t x132;
+  uint64_t x133;
+  uint64_t x134;
+  uint64_t x135;
+  uint64_t x136;
+  uint64_t x137;
+  uint64_t x138;
+  uint64_t x139;
+  uint64_t x140;
+  uint64_t x141;
+  uint64_t x142;
+  uint64_t x143;
+  uint64_t x144;
+  uint64_t x145;
+  uint64_t x146;
+  uint64_t x147;
+  uint64_t x148;
+  uint64_t x149;
+  uint64_t x150;
+  uint64_t x151;
+  uint64_t x152;
+  uint64_t x153;
+  uint64_t x154;
+  uint64_t x155;
+  uint64_t x156;
+  uint64_t x157;
+  uint64_t x158;
+  uint64_t x159;
+  uint64_t x160;
+  uint64_t x161;
+  uint64_t x162;
+  uint64_t x163;
+  uint64_t x164;
+  uint64_t x165;
+  uint64_t x166;
+  uint64_t x167;
+  uint64_t x168;
+  uint64_t x169;
+  uint64_t x170;
+  uint64_t x171;
+  uint64_t x172;
+  uint64_t x173;
+  uint64_t x174;
+  uint64_t x175;
+  uint64_t x176;
+  uint64_t x177;
+  uint64_t x178;
+  uint64_t x179;
+  uint64_t x180;
+  uint64_t x181;
+  uint64_t x182;
+  uint64_t x183;
+  uint64_t x184;
+  uint64_t x185;
+  uint64_t x186;
+  uint64_t x187;
+  uint64_t x188;
+  uint64_t x189;
+  uint64_t x190;
+  uint64_t x191;
+  uint64_t x192;
+  uint64_t x193;
+  uint64_t x194;
+  uint64_t x195;
+  uint64_t x196;
+  uint64_t x197;
+  uint64_t x198;
+  uint64_t x199;
+  uint64_t x200;
+  uint64_t x201;
+  uint64_t x202;
+  uint64_t x203;
+  uint64_t x204;
+  uint64_t x205;
+  uint64_t x206;
+  uint64_t x207;
+  uint64_t x208;
+  uint64_t x209;
+  uint64_t x210;
+  uint64_t x211;
+  uint64_t x212;
+  uint64_t x213;
+  uint64_t x214;
+  uint64_t x215;
+  uint64_t x216;
+  uint64_t x217;
+  uint64_t x218;
+  uint64_t x219;
+  uint64_t x220;
+  uint64_t x221;
+  uint64_t x222;
+  uint64_t x223;
+  uint64_t x224;
+  uint64_t x225;
+  uint64_t x226;
+  uint64_t x227;
+  uint64_t x228;
+  uint64_t x229;
+  uint64_t x230;
+  uint64_t x231;
+  uint64_t x232;
+  uint64_t x233;
+  uint64_t x234;
+  uint64_t x235;
+  uint64_t x236;
+  uint64_t x237;
+  uint64_t x238;
+  uint64_t x239;
+  uint64_t x240;
+  x1 = ((arg1[127]) << 56);
+  x2 = ((arg1[126]) << 48);
+  x3 = ((arg1[125]) << 40);
+  x4 = ((arg1[124]) << 32);
+  x5 = ((arg1[123]) << 24);
+  x6 = ((arg1[122]) << 16);
+  x7 = ((arg1[121]) << 8);
+  x8 = (arg1[120]);
+  x9 = ((arg1[119]) << 56);
+  x10 = ((arg1[118]) << 48);
+  x11 = ((arg1[117]) << 40);
+  x12 = ((arg1[116]) << 32);
+  x13 = ((arg1[115]) << 24);
+  x14 = ((arg1[114]) << 16);
+  x15 = ((arg1[113]) << 8);
+  x16 = (arg1[112]);
+  x17 = ((arg1[111]) << 56);
+  x18 = ((arg1[110]) << 48);
+  x19 = ((arg1[109]) << 40);
+  x20 = ((arg1[108]) << 32);
+  x21 = ((arg1[107]) << 24);
+  x22 = ((arg1[106]) << 16);
+  x23 = ((arg1[105]) << 8);
+  x24 = (arg1[104]);
+  x25 = ((arg1[103]) << 56);
+  x26 = ((arg1[102]) << 48);
+  x27 = ((arg1[101]) << 40);
+  x28 = ((arg1[100]) << 32);
+  x29 = ((arg1[99]) << 24);
+  x30 = ((arg1[98]) << 16);
+  x31 = ((arg1[97]) << 8);
+  x32 = (arg1[96]);
+  x33 = ((arg1[95]) << 56);
+  x34 = ((arg1[94]) << 48);
+  x35 = ((arg1[93]) << 40);
+  x36 = ((arg1[92]) << 32);
+  x37 = ((arg1[91]) << 24);
+  x38 = ((arg1[90]) << 16);
+  x39 = ((arg1[89]) << 8);
+  x40 = (arg1[88]);
+  x41 = ((arg1[87]) << 56);
+  x42 = ((arg1[86]) << 48);
+  x43 = ((arg1[85]) << 40);
+  x44 = ((arg1[84]) << 32);
+  x45 = ((arg1[83]) << 24);
+  x46 = ((arg1[82]) << 16);
+  x47 = ((arg1[81]) << 8);
+  x48 = (arg1[80]);
+  x49 = ((arg1[79]) << 56);
+  x50 = ((arg1[78]) << 48);
+  x51 = ((arg1[77]) << 40);
+  x52 = ((arg1[76]) << 32);
+  x53 = ((arg1[75]) << 24);
+  x54 = ((arg1[74]) << 16);
+  x55 = ((arg1[73]) << 8);
+  x56 = (arg1[72]);
+  x57 = ((arg1[71]) << 56);
+  x58 = ((arg1[70]) << 48);
+  x59 = ((arg1[69]) << 40);
+  x60 = ((arg1[68]) << 32);
+  x61 = ((arg1[67]) << 24);
+  x62 = ((arg1[66]) << 16);
+  x63 = ((arg1[65]) << 8);
+  x64 = (arg1[64]);
+  x65 = ((arg1[63]) << 56);
+  x66 = ((arg1[62]) << 48);
+  x67 = ((arg1[61]) << 40);
+  x68 = ((arg1[60]) << 32);
+  x69 = ((arg1[59]) << 24);
+  x70 = ((arg1[58]) << 16);
+  x71 = ((arg1[57]) << 8);
+  x72 = (arg1[56]);
+  x73 = ((arg1[55]) << 56);
+  x74 = ((arg1[54]) << 48);
+  x75 = ((arg1[53]) << 40);
+  x76 = ((arg1[52]) << 32);
+  x77 = ((arg1[51]) << 24);
+  x78 = ((arg1[50]) << 16);
+  x79 = ((arg1[49]) << 8);
+  x80 = (arg1[48]);
+  x81 = ((arg1[47]) << 56);
+  x82 = ((arg1[46]) << 48);
+  x83 = ((arg1[45]) << 40);
+  x84 = ((arg1[44]) << 32);
+  x85 = ((arg1[43]) << 24);
+  x86 = ((arg1[42]) << 16);
+  x87 = ((arg1[41]) << 8);
+  x88 = (arg1[40]);
+  x89 = ((arg1[39]) << 56);
+  x90 = ((arg1[38]) << 48);
+  x91 = ((arg1[37]) << 40);
+  x92 = ((arg1[36]) << 32);
+  x93 = ((arg1[35]) << 24);
+  x94 = ((arg1[34]) << 16);
+  x95 = ((arg1[33]) << 8);
+  x96 = (arg1[32]);
+  x97 = ((arg1[31]) << 56);
+  x98 = ((arg1[30]) << 48);
+  x99 = ((arg1[29]) << 40);
+  x100 = ((arg1[28]) << 32);
+  x101 = ((arg1[27]) << 24);
+  x102 = ((arg1[26]) << 16);
+  x103 = ((arg1[25]) << 8);
+  x104 = (arg1[24]);
+  x105 = ((arg1[23]) << 56);
+  x106 = ((arg1[22]) << 48);
+  x107 = ((arg1[21]) << 40);
+  x108 = ((arg1[20]) << 32);
+  x109 = ((arg1[19]) << 24);
+  x110 = ((arg1[18]) << 16);
+  x111 = ((arg1[17]) << 8);
+  x112 = (arg1[16]);
+  x113 = ((arg1[15]) << 56);
+  x114 = ((arg1[14]) << 48);
+  x115 = ((arg1[13]) << 40);
+  x116 = ((arg1[12]) << 32);
+  x117 = ((arg1[11]) << 24);
+  x118 = ((arg1[10]) << 16);
+  x119 = ((arg1[9]) << 8);
+  x120 = (arg1[8]);
+  x121 = ((arg1[7]) << 56);
+  x122 = ((arg1[6]) << 48);
+  x123 = ((arg1[5]) << 40);
+  x124 = ((arg1[4]) << 32);
+  x125 = ((arg1[3]) << 24);
+  x126 = ((arg1[2]) << 16);
+  x127 = ((arg1[1]) << 8);
+  x128 = (arg1[0]);
+  x129 = (x127 + x128);
+  x130 = (x126 + x129);
+  x131 = (x125 + x130);
+  x132 = (x124 + x131);
+  x133 = (x123 + x132);
+  x134 = (x122 + x133);
+  x135 = (x121 + x134);
+  x136 = (x119 + x120);
+  x137 = (x118 + x136);
+  x138 = (x117 + x137);
+  x139 = (x116 + x138);
+  x140 = (x115 + x139);
+  x141 = (x114 + x140);
+  x142 = (x113 + x141);
+  x143 = (x111 + x112);
+  x144 = (x110 + x143);
+  x145 = (x109 + x144);
+  x146 = (x108 + x145);
+  x147 = (x107 + x146);
+  x148 = (x106 + x147);
+  x149 = (x105 + x148);
+  x150 = (x103 + x104);
+  x151 = (x102 + x150);
+  x152 = (x101 + x151);
+  x153 = (x100 + x152);
+  x154 = (x99 + x153);
+  x155 = (x98 + x154);
+  x156 = (x97 + x155);
+  x157 = (x95 + x96);
+  x158 = (x94 + x157);
+  x159 = (x93 + x158);
+  x160 = (x92 + x159);
+  x161 = (x91 + x160);
+  x162 = (x90 + x161);
+  x163 = (x89 + x162);
+  x164 = (x87 + x88);
+  x165 = (x86 + x164);
+  x166 = (x85 + x165);
+  x167 = (x84 + x166);
+  x168 = (x83 + x167);
+  x169 = (x82 + x168);
+  x170 = (x81 + x169);
+  x171 = (x79 + x80);
+  x172 = (x78 + x171);
+  x173 = (x77 + x172);
+  x174 = (x76 + x173);
+  x175 = (x75 + x174);
+  x176 = (x74 + x175);
+  x177 = (x73 + x176);
+  x178 = (x71 + x72);
+  x179 = (x70 + x178);
+  x180 = (x69 + x179);
+  x181 = (x68 + x180);
+  x182 = (x67 + x181);
+  x183 = (x66 + x182);
+  x184 = (x65 + x183);
+  x185 = (x63 + x64);
+  x186 = (x62 + x185);
+  x187 = (x61 + x186);
+  x188 = (x60 + x187);
+  x189 = (x59 + x188);
+  x190 = (x58 + x189);
+  x191 = (x57 + x190);
+  x192 = (x55 + x56);
+  x193 = (x54 + x192);
+  x194 = (x53 + x193);
+  x195 = (x52 + x194);
+  x196 = (x51 + x195);
+  x197 = (x50 + x196);
+  x198 = (x49 + x197);
+  x199 = (x47 + x48);
+  x200 = (x46 + x199);
+  x201 = (x45 + x200);
+  x202 = (x44 + x201);
+  x203 = (x43 + x202);
+  x204 = (x42 + x203);
+  x205 = (x41 + x204);
+  x206 = (x39 + x40);
+  x207 = (x38 + x206);
+  x208 = (x37 + x207);
+  x209 = (x36 + x208);
+  x210 = (x35 + x209);
+  x211 = (x34 + x210);
+  x212 = (x33 + x211);
+  x213 = (x31 + x32);
+  x214 = (x30 + x213);
+  x215 = (x29 + x214);
+  x216 = (x28 + x215);
+  x217 = (x27 + x216);
+  x218 = (x26 + x217);
+  x219 = (x25 + x218);
+  x220 = (x23 + x24);
+  x221 = (x22 + x220);
+  x222 = (x21 + x221);
+  x223 = (x20 + x222);
+  x224 = (x19 + x223);
+  x225 = (x18 + x224);
+  x226 = (x17 + x225);
+  x227 = (x15 + x16);
+  x228 = (x14 + x227);
+  x229 = (x13 + x228);
+  x230 = (x12 + x229);
+  x231 = (x11 + x230);
+  x232 = (x10 + x231);
+  x233 = (x9 + x232);
+  x234 = (x7 + x8);
+  x235 = (x6 + x234);
+  x236 = (x5 + x235);
+  x237 = (x4 + x236);
+  x238 = (x3 + x237);
+  x239 = (x2 + x238);
+  x240 = (x1 + x239);
+  out1[0] = x135;
+  out1[1] = x142;
+  out1[2] = x149;
+  out1[3] = x156;
+  out1[4] = x163;
+  out1[5] = x170;
+  out1[6] = x177;
+  out1[7] = x184;
+  out1[8] = x191;
+  out1[9] = x198;
+  out1[10] = x205;
+  out1[11] = x212;
+  out1[12] = x219;
+  out1[13] = x226;
+  out1[14] = x233;
+  out1[15] = x240;
+}
+
+/*
+ * The function fiat_p1024_set_one returns the field element one in the Montgomery domain.
+ *
+ * Postconditions:
+ *   eval (from_montgomery out1) mod m = 1 mod m
+ *   0 ≤ eval out1 < m
+ *
+ */
+void fiat_p1024_set_one(fiat_p1024_montgomery_domain_field_element out1) {
+  out1[0] = UINT64_C(0x65e7ee6590e6567d);
+  out1[1] = UINT64_C(0x40a5f2587fef86d4);
+  out1[2] = UINT64_C(0x99f9e607b99d62f2);
+  out1[3] = UINT64_C(0x1089df50f4f8f26d);
+  out1[4] = UINT64_C(0x592890dd02bb585a);
+  out1[5] = UINT64_C(0xe1b6be68b969ecb9);
+  out1[6] = UINT64_C(0xaebe3c10395f33c3);
+  out1[7] = UINT64_C(0x5ef9652396531f1b);
+  out1[8] = UINT64_C(0x28d37db76b7a1b7f);
+  out1[9] = UINT64_C(0x86d089fa474b4a3f);
+  out1[10] = UINT64_C(0xdbce120cc7a4fff2);
+  out1[11] = UINT64_C(0x8b3f947137340ac);
+  out1[12] = UINT64_C(0x913f3e7c71b37ce5);
+  out1[13] = UINT64_C(0xc7d1b17b09ec4577);
+  out1[14] = UINT64_C(0x9d834aff6f7956b6);
+  out1[15] = UINT64_C(0x44c4b3e968ec2b8);
+}
+
+/*
+ * The function fiat_p1024_msat returns the saturated representation of the prime modulus.
+ *
+ * Postconditions:
+ *   twos_complement_eval out1 = m
+ *   0 ≤ eval out1 < m
+ *
+ * Output Bounds:
+ *   out1: [[0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff]]
+ */
+void fiat_p1024_msat(uint64_t out1[17]) {
+  out1[0] = UINT64_C(0xdbe34c5460e36453);
+  out1[1] = UINT64_C(0xa1d81eebbc3d344d);
+  out1[2] = UINT64_C(0x514ba72cb8d89fd3);
+  out1[3] = UINT64_C(0xc2cab6a0e287f1bd);
+  out1[4] = UINT64_C(0x642aca4d5a313709);
+  out1[5] = UINT64_C(0x6b317c5431541f40);
+  out1[6] = UINT64_C(0xb97c56d1de81ede5);
+  out1[7] = UINT64_C(0x978dbeed90a2b58);
+  out1[8] = UINT64_C(0x7611ad4f90441c80);
+  out1[9] = UINT64_C(0xf811d9c419ec8329);
+  out1[10] = UINT64_C(0x4d6c594a8ad82d2d);
+  out1[11] = UINT64_C(0xf06de2471cf9386e);
+  out1[12] = UINT64_C(0x683cf25db31ad5b);
+  out1[13] = UINT64_C(0x216c22bc86f21a08);
+  out1[14] = UINT64_C(0xd89dec879007ebd7);
+  out1[15] = UINT64_C(0xece55ed427012a9);
+  out1[16] = 0x0;
+}
+
+/*
+ * The function fiat_p1024_divstep_precomp returns the precomputed value for Bernstein-Yang-inversion (in montgomery form).
+ *
+ * Postconditions:
+ *   eval (from_montgomery out1) = ⌊(m - 1) / 2⌋^(if ⌊log2 m⌋ + 1 < 46 then ⌊(49 * (⌊log2 m⌋ + 1) + 80) / 17⌋ else ⌊(49 * (⌊log2 m⌋ + 1) + 57) / 17⌋)
+ *   0 ≤ eval out1 < m
+ *
+ * Output Bounds:
+ *   out1: [[0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff]]
+ */
+void fiat_p1024_divstep_precomp(uint64_t out1[16]) {
+  out1[0] = UINT64_C(0xd3214967893400a7);
+  out1[1] = UINT64_C(0x5f3bfe4b82131cef);
+  out1[2] = UINT64_C(0x3086e2053a02e7fd);
+  out1[3] = UINT64_C(0x467cf688f9cb1dda);
+  out1[4] = UINT64_C(0x76d0bcbfe9932b02);
+  out1[5] = UINT64_C(0x1d86ec0d40cc75c9);
+  out1[6] = UINT64_C(0x87d7c1cfda375028);
+  out1[7] = UINT64_C(0x135b5f79449a9443);
+  out1[8] = UINT64_C(0x8fa821942e4035fc);
+  out1[9] = UINT64_C(0x44d1c68733cbc99);
+  out1[10] = UINT64_C(0x9421cdbae5c2a113);
+  out1[11] = UINT64_C(0xff9643372fa1dbf5);
+  out1[12] = UINT64_C(0x30921804cc31cae8);
+  out1[13] = UINT64_C(0x2072135a06dff243);
+  out1[14] = UINT64_C(0x5ce6b82c1e51ff09);
+  out1[15] = UINT64_C(0xa736b763d20d04);
+}
+
+/*
+ * The function fiat_p1024_divstep computes a divstep.
+ *
+ * Preconditions:
+ *   0 ≤ eval arg4 < m
+ *   0 ≤ eval arg5 < m
+ * Postconditions:
+ *   out1 = (if 0 < arg1 ∧ (twos_complement_eval arg3) is odd then 1 - arg1 else 1 + arg1)
+ *   twos_complement_eval out2 = (if 0 < arg1 ∧ (twos_complement_eval arg3) is odd then twos_complement_eval arg3 else twos_complement_eval arg2)
+ *   twos_complement_eval out3 = (if 0 < arg1 ∧ (twos_complement_eval arg3) is odd then ⌊(twos_complement_eval arg3 - twos_complement_eval arg2) / 2⌋ else ⌊(twos_complement_eval arg3 + (twos_complement_eval arg3 mod 2) * twos_complement_eval arg2) / 2⌋)
+ *   eval (from_montgomery out4) mod m = (if 0 < arg1 ∧ (twos_complement_eval arg3) is odd then (2 * eval (from_montgomery arg5)) mod m else (2 * eval (from_montgomery arg4)) mod m)
+ *   eval (from_montgomery out5) mod m = (if 0 < arg1 ∧ (twos_complement_eval arg3) is odd then (eval (from_montgomery arg4) - eval (from_montgomery arg4)) mod m else (eval (from_montgomery arg5) + (twos_complement_eval arg3 mod 2) * eval (from_montgomery arg4)) mod m)
+ *   0 ≤ eval out5 < m
+ *   0 ≤ eval out5 < m
+ *   0 ≤ eval out2 < m
+ *   0 ≤ eval out3 < m
+ *
+ * Input Bounds:
+ *   arg1: [0x0 ~> 0xffffffffffffffff]
+ *   arg2: [[0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff]]
+ *   arg3: [[0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff]]
+ *   arg4: [[0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff]]
+ *   arg5: [[0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff]]
+ * Output Bounds:
+ *   out1: [0x0 ~> 0xffffffffffffffff]
+ *   out2: [[0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff]]
+ *   out3: [[0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff]]
+ *   out4: [[0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff]]
+ *   out5: [[0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff], [0x0 ~> 0xffffffffffffffff]]
+ */
+void fiat_p1024_divstep(uint64_t* out1, uint64_t out2[17], uint64_t out3[17], uint64_t out4[16], uint64_t out5[16], uint64_t arg1, const uint64_t arg2[17], const uint64_t arg3[17], const uint64_t arg4[16], const uint64_t arg5[16]) {
+  uint64_t x1;
+  fiat_p1024_uint1 x2;
+  fiat_p1024_uint1 x3;
+  uint64_t x4;
+  fiat_p1024_uint1 x5;
+  uint64_t x6;
+  uint64_t x7;
+  uint64_t x8;
+  uint64_t x9;
+  uint64_t x10;
+  uint64_t x11;
+  uint64_t x12;
+  uint64_t x13;
+  uint64_t x14;
+  uint64_t x15;
+  uint64_t x16;
+  uint64_t x17;
+  uint64_t x18;
+  uint64_t x19;
+  uint64_t x20;
+  uint64_t x21;
+  uint64_t x22;
+  uint64_t x23;
+  uint64_t x24;
+  fiat_p1024_uint1 x25;
+  uint64_t x26;
+  fiat_p1024_uint1 x27;
+  uint64_t x28;
+  fiat_p1024_uint1 x29;
+  uint64_t x30;
+  fiat_p1024_uint1 x31;
+  uint64_t x32;
+  fiat_p1024_uint1 x33;
+  uint64_t x34;
+  fiat_p1024_uint1 x35;
+  uint64_t x36;
+  fiat_p1024_uint1 x37;
+  uint64_t x38;
+  fiat_p1024_uint1 x39;
+  uint64_t x40;
+  fiat_p1024_uint1 x41;
+  uint64_t x42;
+  fiat_p1024_uint1 x43;
+  uint64_t x44;
+  fiat_p1024_uint1 x45;
+  uint64_t x46;
+  fiat_p1024_uint1 x47;
+  uint64_t x48;
+  fiat_p1024_uint1 x49;
+  uint64_t x50;
+  fiat_p1024_uint1 x51;
+  uint64_t x52;
+  fiat_p1024_uint1 x53;
+  uint64_t x54;
+  fiat_p1024_uint1 x55;
+  uint64_t x56;
+  fiat_p1024_uint1 x57;
+  uint64_t x58;
+  uint64_t x59;
+  uint64_t x60;
+  uint64_t x61;
+  uint64_t x62;
+  uint64_t x63;
+  uint64_t x64;
+  uint64_t x65;
+  uint64_t x66;
+  uint64_t x67;
+  uint64_t x68;
+  uint64_t x69;
+  uint64_t x70;
+  uint64_t x71;
+  uint64_t x72;
+  uint64_t x73;
+  uint64_t x74;
+  uint64_t x75;
+  uint64_t x76;
+  uint64_t x77;
+  uint64_t x78;
+  uint64_t x79;
+  uint64_t x80;
+  uint64_t x81;
+  uint64_t x82;
+  uint64_t x83;
+  uint64_t x84;
+  uint64_t x85;
+  uint64_t x86;
+  uint64_t x87;
+  uint64_t x88;
+  uint64_t x89;
+  uint64_t x90;
+  uint64_t x91;
+  fiat_p1024_uint1 x92;
+  uint64_t x93;
+  fiat_p1024_uint1 x94;
+  uint64_t x95;
+  fiat_p1024_uint1 x96;
+  uint64_t x97;
+  fiat_p1024_uint1 x98;
+  uint64_t x99;
+  fiat_p1024_uint1 x100;
+  uint64_t x101;
+  fiat_p1024_uint1 x102;
+  uint64_t x103;
+  fiat_p1024_uint1 x104;
+  uint64_t x105;
+  fiat_p1024_uint1 x106;
+  uint64_t x107;
+  fiat_p1024_uint1 x108;
+  uint64_t x109;
+  fiat_p1024_uint1 x110;
+  uint64_t x111;
+  fiat_p1024_uint1 x112;
+  uint64_t x113;
+  fiat_p1024_uint1 x114;
+  uint64_t x115;
+  fiat_p1024_uint1 x116;
+  uint64_t x117;
+  fiat_p1024_uint1 x118;
+  uint64_t x119;
+  fiat_p1024_uint1 x120;
+  uint64_t x121;
+  fiat_p1024_uint1 x122;
+  uint64_t x123;
+  fiat_p1024_uint1 x124;
+  uint64_t x125;
+  fiat_p1024_uint1 x126;
+  uint64_t x127;
+  fiat_p1024_uint1 x128;
+  uint64_t x129;
+  fiat_p1024_uint1 x130;
+  uint64_t x131;
+  fiat_p1024_uint1 x132;
+  uint64_t x133;
+  fiat_p1024_uint1 x134;
+  uint64_t x135;
+  fiat_p1024_uint1 x136;
+  uint64_t x137;
+  fiat_p1024_uint1 x138;
+  uint64_t x139;
+  fiat_p1024_uint1 x140;
+  uint64_t x141;
+  fiat_p1024_uint1 x142;
+  uint64_t x143;
+  fiat_p1024_uint1 x144;
+  uint64_t x145;
+  fiat_p1024_uint1 x146;
+  uint64_t x147;
+  fiat_p1024_uint1 x148;
+  uint64_t x149;
+  fiat_p1024_uint1 x150;
+  uint64_t x151;
+  fiat_p1024_uint1 x152;
+  uint64_t x153;
+  fiat_p1024_uint1 x154;
+  uint64_t x155;
+  fiat_p1024_uint1 x156;
+  uint64_t x157;
+  uint64_t x158;
+  uint64_t x159;
+  uint64_t x160;
+  uint64_t x161;
+  uint64_t x162;
+  uint64_t x163;
+  uint64_t x164;
+  uint64_t x165;
+  uint64_t x166;
+  uint64_t x167;
+  uint64_t x168;
+  uint64_t x169;
+  uint64_t x170;
+  uint64_t x171;
+  uint64_t x172;
+  uint64_t x173;
+  fiat_p1024_uint1 x174;
+  uint64_t x175;
+  fiat_p1024_uint1 x176;
+  uint64_t x177;
+  fiat_p1024_uint1 x178;
+  uint64_t x179;
+  fiat_p1024_uint1 x180;
+  uint64_t x181;
+  fiat_p1024_uint1 x182;
+  uint64_t x183;
+  fiat_p1024_uint1 x184;
+  uint64_t x185;
+  fiat_p1024_uint1 x186;
+  uint64_t x187;
+  fiat_p1024_uint1 x188;
+  uint64_t x189;
+  fiat_p1024_uint1 x190;
+  uint64_t x191;
+  fiat_p1024_uint1 x192;
+  uint64_t x193;
+  fiat_p1024_uint1 x194;
+  uint64_t x195;
+  fiat_p1024_uint1 x196;
+  uint64_t x197;
+  fiat_p1024_uint1 x198;
+  uint64_t x199;
+  fiat_p1024_uint1 x200;
+  uint64_t x201;
+  fiat_p1024_uint1 x202;
+  uint64_t x203;
+  fiat_p1024_uint1 x204;
+  uint64_t x205;
+  uint64_t x206;
+  fiat_p1024_uint1 x207;
+  uint64_t x208;
+  fiat_p1024_uint1 x209;
+  uint64_t x210;
+  fiat_p1024_uint1 x211;
+  uint64_t x212;
+  fiat_p1024_uint1 x213;
+  uint64_t x214;
+  fiat_p1024_uint1 x215;
+  uint64_t x216;
+  fiat_p1024_uint1 x217;
+  uint64_t x218;
+  fiat_p1024_uint1 x219;
+  uint64_t x220;
+  fiat_p1024_uint1 x221;
+  uint64_t x222;
+  fiat_p1024_uint1 x223;
+  uint64_t x224;
+  fiat_p1024_uint1 x225;
+  uint64_t x226;
+  fiat_p1024_uint1 x227;
+  uint64_t x228;
+  fiat_p1024_uint1 x229;
+  uint64_t x230;
+  fiat_p1024_uint1 x231;
+  uint64_t x232;
+  fiat_p1024_uint1 x233;
+  uint64_t x234;
+  fiat_p1024_uint1 x235;
+  uint64_t x236;
+  fiat_p1024_uint1 x237;
+  uint64_t x238;
+  uint64_t x239;
+  uint64_t x240;
+  uint64_t x241;
+  uint64_t x242;
+  uint64_t x243;
+  uint64_t x244;
+  uint64_t x245;
+  uint64_t x246;
+  uint64_t x247;
+  uint64_t x248;
+  uint64_t x249;
+  uint64_t x250;
+  uint64_t x251;
+  uint64_t x252;
+  uint64_t x253;
+  fiat_p1024_uint1 x254;
+  uint64_t x255;
+  uint64_t x256;
+  uint64_t x257;
+  uint64_t x258;
+  uint64_t x259;
+  uint64_t x260;
+  uint64_t x261;
+  uint64_t x262;
+  uint64_t x263;
+  uint64_t x264;
+  uint64_t x265;
+  uint64_t x266;
+  uint64_t x267;
+  uint64_t x268;
+  uint64_t x269;
+  uint64_t x270;
+  uint64_t x271;
+  uint64_t x272;
+  fiat_p1024_uint1 x273;
+  uint64_t x274;
+  fiat_p1024_uint1 x275;
+  uint64_t x276;
+  fiat_p1024_uint1 x277;
+  uint64_t x278;
+  fiat_p1024_uint1 x279;
+  uint64_t x280;
+  fiat_p1024_uint1 x281;
+  uint64_t x282;
+  fiat_p1024_uint1 x283;
+  uint64_t x284;
+  fiat_p1024_uint1 x285;
+  uint64_t x286;
+  fiat_p1024_uint1 x287;
+  uint64_t x288;
+  fiat_p1024_uint1 x289;
+  uint64_t x290;
+  fiat_p1024_uint1 x291;
+  uint64_t x292;
+  fiat_p1024_uint1 x293;
+  uint64_t x294;
+  fiat_p1024_uint1 x295;
+  uint64_t x296;
+  fiat_p1024_uint1 x297;
+  uint64_t x298;
+  fiat_p1024_uint1 x299;
+  uint64_t x300;
+  fiat_p1024_uint1 x301;
+  uint64_t x302;
+  fiat_p1024_uint1 x303;
+  uint64_t x304;
+  fiat_p1024_uint1 x305;
+  uint64_t x306;
+  uint64_t x307;
+  uint64_t x308;
+  uint64_t x309;
+  uint64_t x310;
+  uint64_t x311;
+  uint64_t x312;
+  uint64_t x313;
+  uint64_t x314;
+  uint64_t x315;
+  uint64_t x316;
+  uint64_t x317;
+  uint64_t x318;
+  uint64_t x319;
+  uint64_t x320;
+  uint64_t x321;
+  uint64_t x322;
+  fiat_p1024_uint1 x323;
+  uint64_t x324;
+  fiat_p1024_uint1 x325;
+  uint64_t x326;
+  fiat_p1024_uint1 x327;
+  uint64_t x328;
+  fiat_p1024_uint1 x329;
+  uint64_t x330;
+  fiat_p1024_uint1 x331;
+  uint64_t x332;
+  fiat_p1024_uint1 x333;
+  uint64_t x334;
+  fiat_p1024_uint1 x335;
+  uint64_t x336;
+  fiat_p1024_uint1 x337;
+  uint64_t x338;
+  fiat_p1024_uint1 x339;
+  uint64_t x340;
+  fiat_p1024_uint1 x341;
+  uint64_t x342;
+  fiat_p1024_uint1 x343;
+  uint64_t x344;
+  fiat_p1024_uint1 x345;
+  uint64_t x346;
+  fiat_p1024_uint1 x347;
+  uint64_t x348;
+  fiat_p1024_uint1 x349;
+  uint64_t x350;
+  fiat_p1024_uint1 x351;
+  uint64_t x352;
+  fiat_p1024_uint1 x353;
+  uint64_t x354;
+  fiat_p1024_uint1 x355;
+  uint64_t x356;
+  fiat_p1024_uint1 x357;
+  uint64_t x358;
+  fiat_p1024_uint1 x359;
+  uint64_t x360;
+  fiat_p1024_uint1 x361;
+  uint64_t x362;
+  fiat_p1024_uint1 x363;
+  uint64_t x364;
+  fiat_p1024_uint1 x365;
+  uint64_t x366;
+  fiat_p1024_uint1 x367;
+  uint64_t x368;
+  fiat_p1024_uint1 x369;
+  uint64_t x370;
+  fiat_p1024_uint1 x371;
+  uint64_t x372;
+  fiat_p1024_uint1 x373;
+  uint64_t x374;
+  fiat_p1024_uint1 x375;
+  uint64_t x376;
+  fiat_p1024_uint1 x377;
+  uint64_t x378;
+  fiat_p1024_uint1 x379;
+  uint64_t x380;
+  fiat_p1024_uint1 x381;
+  uint64_t x382;
+  fiat_p1024_uint1 x383;
+  uint64_t x384;
+  fiat_p1024_uint1 x385;
+  uint64_t x386;
+  fiat_p1024_uint1 x387;
+  uint64_t x388;
+  fiat_p1024_uint1 x389;
+  uint64_t x390;
+  uint64_t x391;
+  uint64_t x392;
+  uint64_t x393;
+  uint64_t x394;
+  uint64_t x395;
+  uint64_t x396;
+  uint64_t x397;
+  uint64_t x398;
+  uint64_t x399;
+  uint64_t x400;
+  uint64_t x401;
+  uint64_t x402;
+  uint64_t x403;
+  uint64_t x404;
+  uint64_t x405;
+  uint64_t x406;
+  uint64_t x407;
+  uint64_t x408;
+  uint64_t x409;
+  uint64_t x410;
+  uint64_t x411;
+  uint64_t x412;
+  uint64_t x413;
+  uint64_t x414;
+  uint64_t x415;
+  uint64_t x416;
+  uint64_t x417;
+  uint64_t x418;
+  uint64_t x419;
+  uint64_t x420;
+  uint64_t x421;
+  uint64_t x422;
+  uint64_t x423;
+  uint64_t x424;
+  uint64_t x425;
+  uint64_t x426;
+  uint64_t x427;
+  uint64_t x428;
+  uint64_t x429;
+  uint64_t x430;
+  uint64_t x431;
+  uint64_t x432;
+  uint64_t x433;
+  uint64_t x434;
+  uint64_t x435;
+  uint64_t x436;
+  uint64_t x437;
+  uint64_t x438;
+  fiat_p1024_addcarryx_u64(&x1, &x2, 0x0, (~arg1), 0x1);
+  x3 = (fiat_p1024_uint1)((fiat_p1024_uint1)(x1 >> 63) & (fiat_p1024_uint1)((arg3[0]) & 0x1));
+  fiat_p1024_addcarryx_u64(&x4, &x5, 0x0, (~arg1), 0x1);
+  fiat_p1024_cmovznz_u64(&x6, x3, arg1, x4);
+  fiat_p1024_cmovznz_u64(&x7, x3, (arg2[0]), (arg3[0]));
+  fiat_p1024_cmovznz_u64(&x8, x3, (arg2[1]), (arg3[1]));
+  fiat_p1024_cmovznz_u64(&x9, x3, (arg2[2]), (arg3[2]));
+  fiat_p1024_cmovznz_u64(&x10, x3, (arg2[3]), (arg3[3]));
+  fiat_p1024_cmovznz_u64(&x11, x3, (arg2[4]), (arg3[4]));
+  fiat_p1024_cmovznz_u64(&x12, x3, (arg2[5]), (arg3[5]));
+  fiat_p1024_cmovznz_u64(&x13, x3, (arg2[6]), (arg3[6]));
+  fiat_p1024_cmovznz_u64(&x14, x3, (arg2[7]), (arg3[7]));
+  fiat_p1024_cmovznz_u64(&x15, x3, (arg2[8]), (arg3[8]));
+  fiat_p1024_cmovznz_u64(&x16, x3, (arg2[9]), (arg3[9]));
+  fiat_p1024_cmovznz_u64(&x17, x3, (arg2[10]), (arg3[10]));
+  fiat_p1024_cmovznz_u64(&x18, x3, (arg2[11]), (arg3[11]));
+  fiat_p1024_cmovznz_u64(&x19, x3, (arg2[12]), (arg3[12]));
+  fiat_p1024_cmovznz_u64(&x20, x3, (arg2[13]), (arg3[13]));
+  fiat_p1024_cmovznz_u64(&x21, x3, (arg2[14]), (arg3[14]));
+  fiat_p1024_cmovznz_u64(&x22, x3, (arg2[15]), (arg3[15]));
+  fiat_p1024_cmovznz_u64(&x23, x3, (arg2[16]), (arg3[16]));
+  fiat_p1024_addcarryx_u64(&x24, &x25, 0x0, 0x1, (~(arg2[0])));
+  fiat_p1024_addcarryx_u64(&x26, &x27, x25, 0x0, (~(arg2[1])));
+  fiat_p1024_addcarryx_u64(&x28, &x29, x27, 0x0, (~(arg2[2])));
+  fiat_p1024_addcarryx_u64(&x30, &x31, x29, 0x0, (~(arg2[3])));
+  fiat_p1024_addcarryx_u64(&x32, &x33, x31, 0x0, (~(arg2[4])));
+  fiat_p1024_addcarryx_u64(&x34, &x35, x33, 0x0, (~(arg2[5])));
+  fiat_p1024_addcarryx_u64(&x36, &x37, x35, 0x0, (~(arg2[6])));
+  fiat_p1024_addcarryx_u64(&x38, &x39, x37, 0x0, (~(arg2[7])));
+  fiat_p1024_addcarryx_u64(&x40, &x41, x39, 0x0, (~(arg2[8])));
+  fiat_p1024_addcarryx_u64(&x42, &x43, x41, 0x0, (~(arg2[9])));
+  fiat_p1024_addcarryx_u64(&x44, &x45, x43, 0x0, (~(arg2[10])));
+  fiat_p1024_addcarryx_u64(&x46, &x47, x45, 0x0, (~(arg2[11])));
+  fiat_p1024_addcarryx_u64(&x48, &x49, x47, 0x0, (~(arg2[12])));
+  fiat_p1024_addcarryx_u64(&x50, &x51, x49, 0x0, (~(arg2[13])));
+  fiat_p1024_addcarryx_u64(&x52, &x53, x51, 0x0, (~(arg2[14])));
+  fiat_p1024_addcarryx_u64(&x54, &x55, x53, 0x0, (~(arg2[15])));
+  fiat_p1024_addcarryx_u64(&x56, &x57, x55, 0x0, (~(arg2[16])));
+  fiat_p1024_cmovznz_u64(&x58, x3, (arg3[0]), x24);
+  fiat_p1024_cmovznz_u64(&x59, x3, (arg3[1]), x26);
+  fiat_p1024_cmovznz_u64(&x60, x3, (arg3[2]), x28);
+  fiat_p1024_cmovznz_u64(&x61, x3, (arg3[3]), x30);
+  fiat_p1024_cmovznz_u64(&x62, x3, (arg3[4]), x32);
+  fiat_p1024_cmovznz_u64(&x63, x3, (arg3[5]), x34);
+  fiat_p1024_cmovznz_u64(&x64, x3, (arg3[6]), x36);
+  fiat_p1024_cmovznz_u64(&x65, x3, (arg3[7]), x38);
+  fiat_p1024_cmovznz_u64(&x66, x3, (arg3[8]), x40);
+  fiat_p1024_cmovznz_u64(&x67, x3, (arg3[9]), x42);
+  fiat_p1024_cmovznz_u64(&x68, x3, (arg3[10]), x44);
+  fiat_p1024_cmovznz_u64(&x69, x3, (arg3[11]), x46);
+  fiat_p1024_cmovznz_u64(&x70, x3, (arg3[12]), x48);
+  fiat_p1024_cmovznz_u64(&x71, x3, (arg3[13]), x50);
+  fiat_p1024_cmovznz_u64(&x72, x3, (arg3[14]), x52);
+  fiat_p1024_cmovznz_u64(&x73, x3, (arg3[15]), x54);
+  fiat_p1024_cmovznz_u64(&x74, x3, (arg3[16]), x56);
+  fiat_p1024_cmovznz_u64(&x75, x3, (arg4[0]), (arg5[0]));
+  fiat_p1024_cmovznz_u64(&x76, x3, (arg4[1]), (arg5[1]));
+  fiat_p1024_cmovznz_u64(&x77, x3, (arg4[2]), (arg5[2]));
+  fiat_p1024_cmovznz_u64(&x78, x3, (arg4[3]), (arg5[3]));
+  fiat_p1024_cmovznz_u64(&x79, x3, (arg4[4]), (arg5[4]));
+  fiat_p1024_cmovznz_u64(&x80, x3, (arg4[5]), (arg5[5]));
+  fiat_p1024_cmovznz_u64(&x81, x3, (arg4[6]), (arg5[6]));
+  fiat_p1024_cmovznz_u64(&x82, x3, (arg4[7]), (arg5[7]));
+  fiat_p1024_cmovznz_u64(&x83, x3, (arg4[8]), (arg5[8]));
+  fiat_p1024_cmovznz_u64(&x84, x3, (arg4[9]), (arg5[9]));
+  fiat_p1024_cmovznz_u64(&x85, x3, (arg4[10]), (arg5[10]));
+  fiat_p1024_cmovznz_u64(&x86, x3, (arg4[11]), (arg5[11]));
+  fiat_p1024_cmovznz_u64(&x87, x3, (arg4[12]), (arg5[12]));
+  fiat_p1024_cmovznz_u64(&x88, x3, (arg4[13]), (arg5[13]));
+  fiat_p1024_cmovznz_u64(&x89, x3, (arg4[14]), (arg5[14]));
+  fiat_p1024_cmovznz_u64(&x90, x3, (arg4[15]), (arg5[15]));
+  fiat_p1024_addcarryx_u64(&x91, &x92, 0x0, x75, x75);
+  fiat_p1024_addcarryx_u64(&x93, &x94, x92, x76, x76);
+  fiat_p1024_addcarryx_u64(&x95, &x96, x94, x77, x77);
+  fiat_p1024_addcarryx_u64(&x97, &x98, x96, x78, x78);
+  fiat_p1024_addcarryx_u64(&x99, &x100, x98, x79, x79);
+  fiat_p1024_addcarryx_u64(&x101, &x102, x100, x80, x80);
+  fiat_p1024_addcarryx_u64(&x103, &x104, x102, x81, x81);
+  fiat_p1024_addcarryx_u64(&x105, &x106, x104, x82, x82);
+  fiat_p1024_addcarryx_u64(&x107, &x108, x106, x83, x83);
+  fiat_p1024_addcarryx_u64(&x109, &x110, x108, x84, x84);
+  fiat_p1024_addcarryx_u64(&x111, &x112, x110, x85, x85);
+  fiat_p1024_addcarryx_u64(&x113, &x114, x112, x86, x86);
+  fiat_p1024_addcarryx_u64(&x115, &x116, x114, x87, x87);
+  fiat_p1024_addcarryx_u64(&x117, &x118, x116, x88, x88);
+  fiat_p1024_addcarryx_u64(&x119, &x120, x118, x89, x89);
+  fiat_p1024_addcarryx_u64(&x121, &x122, x120, x90, x90);
+  fiat_p1024_subborrowx_u64(&x123, &x124, 0x0, x91, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_subborrowx_u64(&x125, &x126, x124, x93, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_subborrowx_u64(&x127, &x128, x126, x95, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_subborrowx_u64(&x129, &x130, x128, x97, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_subborrowx_u64(&x131, &x132, x130, x99, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_subborrowx_u64(&x133, &x134, x132, x101, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_subborrowx_u64(&x135, &x136, x134, x103, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_subborrowx_u64(&x137, &x138, x136, x105, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_subborrowx_u64(&x139, &x140, x138, x107, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_subborrowx_u64(&x141, &x142, x140, x109, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_subborrowx_u64(&x143, &x144, x142, x111, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_subborrowx_u64(&x145, &x146, x144, x113, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_subborrowx_u64(&x147, &x148, x146, x115, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_subborrowx_u64(&x149, &x150, x148, x117, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_subborrowx_u64(&x151, &x152, x150, x119, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_subborrowx_u64(&x153, &x154, x152, x121, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_subborrowx_u64(&x155, &x156, x154, x122, 0x0);
+  x157 = (arg4[15]);
+  x158 = (arg4[14]);
+  x159 = (arg4[13]);
+  x160 = (arg4[12]);
+  x161 = (arg4[11]);
+  x162 = (arg4[10]);
+  x163 = (arg4[9]);
+  x164 = (arg4[8]);
+  x165 = (arg4[7]);
+  x166 = (arg4[6]);
+  x167 = (arg4[5]);
+  x168 = (arg4[4]);
+  x169 = (arg4[3]);
+  x170 = (arg4[2]);
+  x171 = (arg4[1]);
+  x172 = (arg4[0]);
+  fiat_p1024_subborrowx_u64(&x173, &x174, 0x0, 0x0, x172);
+  fiat_p1024_subborrowx_u64(&x175, &x176, x174, 0x0, x171);
+  fiat_p1024_subborrowx_u64(&x177, &x178, x176, 0x0, x170);
+  fiat_p1024_subborrowx_u64(&x179, &x180, x178, 0x0, x169);
+  fiat_p1024_subborrowx_u64(&x181, &x182, x180, 0x0, x168);
+  fiat_p1024_subborrowx_u64(&x183, &x184, x182, 0x0, x167);
+  fiat_p1024_subborrowx_u64(&x185, &x186, x184, 0x0, x166);
+  fiat_p1024_subborrowx_u64(&x187, &x188, x186, 0x0, x165);
+  fiat_p1024_subborrowx_u64(&x189, &x190, x188, 0x0, x164);
+  fiat_p1024_subborrowx_u64(&x191, &x192, x190, 0x0, x163);
+  fiat_p1024_subborrowx_u64(&x193, &x194, x192, 0x0, x162);
+  fiat_p1024_subborrowx_u64(&x195, &x196, x194, 0x0, x161);
+  fiat_p1024_subborrowx_u64(&x197, &x198, x196, 0x0, x160);
+  fiat_p1024_subborrowx_u64(&x199, &x200, x198, 0x0, x159);
+  fiat_p1024_subborrowx_u64(&x201, &x202, x200, 0x0, x158);
+  fiat_p1024_subborrowx_u64(&x203, &x204, x202, 0x0, x157);
+  fiat_p1024_cmovznz_u64(&x205, x204, 0x0, UINT64_C(0xffffffffffffffff));
+  fiat_p1024_addcarryx_u64(&x206, &x207, 0x0, x173, (x205 & UINT64_C(0xdbe34c5460e36453)));
+  fiat_p1024_addcarryx_u64(&x208, &x209, x207, x175, (x205 & UINT64_C(0xa1d81eebbc3d344d)));
+  fiat_p1024_addcarryx_u64(&x210, &x211, x209, x177, (x205 & UINT64_C(0x514ba72cb8d89fd3)));
+  fiat_p1024_addcarryx_u64(&x212, &x213, x211, x179, (x205 & UINT64_C(0xc2cab6a0e287f1bd)));
+  fiat_p1024_addcarryx_u64(&x214, &x215, x213, x181, (x205 & UINT64_C(0x642aca4d5a313709)));
+  fiat_p1024_addcarryx_u64(&x216, &x217, x215, x183, (x205 & UINT64_C(0x6b317c5431541f40)));
+  fiat_p1024_addcarryx_u64(&x218, &x219, x217, x185, (x205 & UINT64_C(0xb97c56d1de81ede5)));
+  fiat_p1024_addcarryx_u64(&x220, &x221, x219, x187, (x205 & UINT64_C(0x978dbeed90a2b58)));
+  fiat_p1024_addcarryx_u64(&x222, &x223, x221, x189, (x205 & UINT64_C(0x7611ad4f90441c80)));
+  fiat_p1024_addcarryx_u64(&x224, &x225, x223, x191, (x205 & UINT64_C(0xf811d9c419ec8329)));
+  fiat_p1024_addcarryx_u64(&x226, &x227, x225, x193, (x205 & UINT64_C(0x4d6c594a8ad82d2d)));
+  fiat_p1024_addcarryx_u64(&x228, &x229, x227, x195, (x205 & UINT64_C(0xf06de2471cf9386e)));
+  fiat_p1024_addcarryx_u64(&x230, &x231, x229, x197, (x205 & UINT64_C(0x683cf25db31ad5b)));
+  fiat_p1024_addcarryx_u64(&x232, &x233, x231, x199, (x205 & UINT64_C(0x216c22bc86f21a08)));
+  fiat_p1024_addcarryx_u64(&x234, &x235, x233, x201, (x205 & UINT64_C(0xd89dec879007ebd7)));
+  fiat_p1024_addcarryx_u64(&x236, &x237, x235, x203, (x205 & UINT64_C(0xece55ed427012a9)));
+  fiat_p1024_cmovznz_u64(&x238, x3, (arg5[0]), x206);
+  fiat_p1024_cmovznz_u64(&x239, x3, (arg5[1]), x208);
+  fiat_p1024_cmovznz_u64(&x240, x3, (arg5[2]), x210);
+  fiat_p1024_cmovznz_u64(&x241, x3, (arg5[3]), x212);
+  fiat_p1024_cmovznz_u64(&x242, x3, (arg5[4]), x214);
+  fiat_p1024_cmovznz_u64(&x243, x3, (arg5[5]), x216);
+  fiat_p1024_cmovznz_u64(&x244, x3, (arg5[6]), x218);
+  fiat_p1024_cmovznz_u64(&x245, x3, (arg5[7]), x220);
+  fiat_p1024_cmovznz_u64(&x246, x3, (arg5[8]), x222);
+  fiat_p1024_cmovznz_u64(&x247, x3, (arg5[9]), x224);
+  fiat_p1024_cmovznz_u64(&x248, x3, (arg5[10]), x226);
+  fiat_p1024_cmovznz_u64(&x249, x3, (arg5[11]), x228);
+  fiat_p1024_cmovznz_u64(&x250, x3, (arg5[12]), x230);
+  fiat_p1024_cmovznz_u64(&x251, x3, (arg5[13]), x232);
+  fiat_p1024_cmovznz_u64(&x252, x3, (arg5[14]), x234);
+  fiat_p1024_cmovznz_u64(&x253, x3, (arg5[15]), x236);
+  x254 = (fiat_p1024_uint1)(x58 & 0x1);
+  fiat_p1024_cmovznz_u64(&x255, x254, 0x0, x7);
+  fiat_p1024_cmovznz_u64(&x256, x254, 0x0, x8);
+  fiat_p1024_cmovznz_u64(&x257, x254, 0x0, x9);
+  fiat_p1024_cmovznz_u64(&x258, x254, 0x0, x10);
+  fiat_p1024_cmovznz_u64(&x259, x254, 0x0, x11);
+  fiat_p1024_cmovznz_u64(&x260, x254, 0x0, x12);
+  fiat_p1024_cmovznz_u64(&x261, x254, 0x0, x13);
+  fiat_p1024_cmovznz_u64(&x262, x254, 0x0, x14);
+  fiat_p1024_cmovznz_u64(&x263, x254, 0x0, x15);
+  fiat_p1024_cmovznz_u64(&x264, x254, 0x0, x16);
+  fiat_p1024_cmovznz_u64(&x265, x254, 0x0, x17);
+  fiat_p1024_cmovznz_u64(&x266, x254, 0x0, x18);
+  fiat_p1024_cmovznz_u64(&x267, x254, 0x0, x19);
+  fiat_p1024_cmovznz_u64(&x268, x254, 0x0, x20);
+  fiat_p1024_cmovznz_u64(&x269, x254, 0x0, x21);
+  fiat_p1024_cmovznz_u64(&x270, x254, 0x0, x22);
+  fiat_p1024_cmovznz_u64(&x271, x254, 0x0, x23);
+  fiat_p1024_addcarryx_u64(&x272, &x273, 0x0, x58, x255);
+  fiat_p1024_addcarryx_u64(&x274, &x275, x273, x59, x256);
+  fiat_p1024_addcarryx_u64(&x276, &x277, x275, x60, x257);
+  fiat_p1024_addcarryx_u64(&x278, &x279, x277, x61, x258);
+  fiat_p1024_addcarryx_u64(&x280, &x281, x279, x62, x259);
+  fiat_p1024_addcarryx_u64(&x282, &x283, x281, x63, x260);
+  fiat_p1024_addcarryx_u64(&x284, &x285, x283, x64, x261);
+  fiat_p1024_addcarryx_u64(&x286, &x287, x285, x65, x262);
+  fiat_p1024_addcarryx_u64(&x288, &x289, x287, x66, x263);
+  fiat_p1024_addcarryx_u64(&x290, &x291, x289, x67, x264);
+  fiat_p1024_addcarryx_u64(&x292, &x293, x291, x68, x265);
+  fiat_p1024_addcarryx_u64(&x294, &x295, x293, x69, x266);
+  fiat_p1024_addcarryx_u64(&x296, &x297, x295, x70, x267);
+  fiat_p1024_addcarryx_u64(&x298, &x299, x297, x71, x268);
+  fiat_p1024_addcarryx_u64(&x300, &x301, x299, x72, x269);
+  fiat_p1024_addcarryx_u64(&x302, &x303, x301, x73, x270);
+  fiat_p1024_addcarryx_u64(&x304, &x305, x303, x74, x271);
+  fiat_p1024_cmovznz_u64(&x306, x254, 0x0, x75);
+  fiat_p1024_cmovznz_u64(&x307, x254, 0x0, x76);
+  fiat_p1024_cmovznz_u64(&x308, x254, 0x0, x77);
+  fiat_p1024_cmovznz_u64(&x309, x254, 0x0, x78);
+  fiat_p1024_cmovznz_u64(&x310, x254, 0x0, x79);
+  fiat_p1024_cmovznz_u64(&x311, x254, 0x0, x80);
+  fiat_p1024_cmovznz_u64(&x312, x254, 0x0, x81);
+  fiat_p1024_cmovznz_u64(&x313, x254, 0x0, x82);
+  fiat_p1024_cmovznz_u64(&x314, x254, 0x0, x83);
+  fiat_p1024_cmovznz_u64(&x315, x254, 0x0, x84);
+  fiat_p1024_cmovznz_u64(&x316, x254, 0x0, x85);
+  fiat_p1024_cmovznz_u64(&x317, x254, 0x0, x86);
+  fiat_p1024_cmovznz_u64(&x318, x254, 0x0, x87);
+  fiat_p1024_cmovznz_u64(&x319, x254, 0x0, x88);
+  fiat_p1024_cmovznz_u64(&x320, x254, 0x0, x89);
+  fiat_p1024_cmovznz_u64(&x321, x254, 0x0, x90);
+  fiat_p1024_addcarryx_u64(&x322, &x323, 0x0, x238, x306);
+  fiat_p1024_addcarryx_u64(&x324, &x325, x323, x239, x307);
+  fiat_p1024_addcarryx_u64(&x326, &x327, x325, x240, x308);
+  fiat_p1024_addcarryx_u64(&x328, &x329, x327, x241, x309);
+  fiat_p1024_addcarryx_u64(&x330, &x331, x329, x242, x310);
+  fiat_p1024_addcarryx_u64(&x332, &x333, x331, x243, x311);
+  fiat_p1024_addcarryx_u64(&x334, &x335, x333, x244, x312);
+  fiat_p1024_addcarryx_u64(&x336, &x337, x335, x245, x313);
+  fiat_p1024_addcarryx_u64(&x338, &x339, x337, x246, x314);
+  fiat_p1024_addcarryx_u64(&x340, &x341, x339, x247, x315);
+  fiat_p1024_addcarryx_u64(&x342, &x343, x341, x248, x316);
+  fiat_p1024_addcarryx_u64(&x344, &x345, x343, x249, x317);
+  fiat_p1024_addcarryx_u64(&x346, &x347, x345, x250, x318);
+  fiat_p1024_addcarryx_u64(&x348, &x349, x347, x251, x319);
+  fiat_p1024_addcarryx_u64(&x350, &x351, x349, x252, x320);
+  fiat_p1024_addcarryx_u64(&x352, &x353, x351, x253, x321);
+  fiat_p1024_subborrowx_u64(&x354, &x355, 0x0, x322, UINT64_C(0xdbe34c5460e36453));
+  fiat_p1024_subborrowx_u64(&x356, &x357, x355, x324, UINT64_C(0xa1d81eebbc3d344d));
+  fiat_p1024_subborrowx_u64(&x358, &x359, x357, x326, UINT64_C(0x514ba72cb8d89fd3));
+  fiat_p1024_subborrowx_u64(&x360, &x361, x359, x328, UINT64_C(0xc2cab6a0e287f1bd));
+  fiat_p1024_subborrowx_u64(&x362, &x363, x361, x330, UINT64_C(0x642aca4d5a313709));
+  fiat_p1024_subborrowx_u64(&x364, &x365, x363, x332, UINT64_C(0x6b317c5431541f40));
+  fiat_p1024_subborrowx_u64(&x366, &x367, x365, x334, UINT64_C(0xb97c56d1de81ede5));
+  fiat_p1024_subborrowx_u64(&x368, &x369, x367, x336, UINT64_C(0x978dbeed90a2b58));
+  fiat_p1024_subborrowx_u64(&x370, &x371, x369, x338, UINT64_C(0x7611ad4f90441c80));
+  fiat_p1024_subborrowx_u64(&x372, &x373, x371, x340, UINT64_C(0xf811d9c419ec8329));
+  fiat_p1024_subborrowx_u64(&x374, &x375, x373, x342, UINT64_C(0x4d6c594a8ad82d2d));
+  fiat_p1024_subborrowx_u64(&x376, &x377, x375, x344, UINT64_C(0xf06de2471cf9386e));
+  fiat_p1024_subborrowx_u64(&x378, &x379, x377, x346, UINT64_C(0x683cf25db31ad5b));
+  fiat_p1024_subborrowx_u64(&x380, &x381, x379, x348, UINT64_C(0x216c22bc86f21a08));
+  fiat_p1024_subborrowx_u64(&x382, &x383, x381, x350, UINT64_C(0xd89dec879007ebd7));
+  fiat_p1024_subborrowx_u64(&x384, &x385, x383, x352, UINT64_C(0xece55ed427012a9));
+  fiat_p1024_subborrowx_u64(&x386, &x387, x385, x353, 0x0);
+  fiat_p1024_addcarryx_u64(&x388, &x389, 0x0, x6, 0x1);
+  x390 = ((x272 >> 1) | ((x274 << 63) & UINT64_C(0xffffffffffffffff)));
+  x391 = ((x274 >> 1) | ((x276 << 63) & UINT64_C(0xffffffffffffffff)));
+  x392 = ((x276 >> 1) | ((x278 << 63) & UINT64_C(0xffffffffffffffff)));
+  x393 = ((x278 >> 1) | ((x280 << 63) & UINT64_C(0xffffffffffffffff)));
+  x394 = ((x280 >> 1) | ((x282 << 63) & UINT64_C(0xffffffffffffffff)));
+  x395 = ((x282 >> 1) | ((x284 << 63) & UINT64_C(0xffffffffffffffff)));
+  x396 = ((x284 >> 1) | ((x286 << 63) & UINT64_C(0xffffffffffffffff)));
+  x397 = ((x286 >> 1) | ((x288 << 63) & UINT64_C(0xffffffffffffffff)));
+  x398 = ((x288 >> 1) | ((x290 << 63) & UINT64_C(0xffffffffffffffff)));
+  x399 = ((x290 >> 1) | ((x292 << 63) & UINT64_C(0xffffffffffffffff)));
+  x400 = ((x292 >> 1) | ((x294 << 63) & UINT64_C(0xffffffffffffffff)));
+  x401 = ((x294 >> 1) | ((x296 << 63) & UINT64_C(0xffffffffffffffff)));
+  x402 = ((x296 >> 1) | ((x298 << 63) & UINT64_C(0xffffffffffffffff)));
+  x403 = ((x298 >> 1) | ((x300 << 63) & UINT64_C(0xffffffffffffffff)));
+  x404 = ((x300 >> 1) | ((x302 << 63) & UINT64_C(0xffffffffffffffff)));
+  x405 = ((x302 >> 1) | ((x304 << 63) & UINT64_C(0xffffffffffffffff)));
+  x406 = ((x304 & UINT64_C(0x8000000000000000)) | (x304 >> 1));
+  fiat_p1024_cmovznz_u64(&x407, x156, x123, x91);
+  fiat_p1024_cmovznz_u64(&x408, x156, x125, x93);
+  fiat_p1024_cmovznz_u64(&x409, x156, x127, x95);
+  fiat_p1024_cmovznz_u64(&x410, x156, x129, x97);
+  fiat_p1024_cmovznz_u64(&x411, x156, x131, x99);
+  fiat_p1024_cmovznz_u64(&x412, x156, x133, x101);
+  fiat_p1024_cmovznz_u64(&x413, x156, x135, x103);
+  fiat_p1024_cmovznz_u64(&x414, x156, x137, x105);
+  fiat_p1024_cmovznz_u64(&x415, x156, x139, x107);
+  fiat_p1024_cmovznz_u64(&x416, x156, x141, x109);
+  fiat_p1024_cmovznz_u64(&x417, x156, x143, x111);
+  fiat_p1024_cmovznz_u64(&x418, x156, x145, x113);
+  fiat_p1024_cmovznz_u64(&x419, x156, x147, x115);
+  fiat_p1024_cmovznz_u64(&x420, x156, x149, x117);
+  fiat_p1024_cmovznz_u64(&x421, x156, x151, x119);
+  fiat_p1024_cmovznz_u64(&x422, x156, x153, x121);
+  fiat_p1024_cmovznz_u64(&x423, x387, x354, x322);
+  fiat_p1024_cmovznz_u64(&x424, x387, x356, x324);
+  fiat_p1024_cmovznz_u64(&x425, x387, x358, x326);
+  fiat_p1024_cmovznz_u64(&x426, x387, x360, x328);
+  fiat_p1024_cmovznz_u64(&x427, x387, x362, x330);
+  fiat_p1024_cmovznz_u64(&x428, x387, x364, x332);
+  fiat_p1024_cmovznz_u64(&x429, x387, x366, x334);
+  fiat_p1024_cmovznz_u64(&x430, x387, x368, x336);
+  fiat_p1024_cmovznz_u64(&x431, x387, x370, x338);
+  fiat_p1024_cmovznz_u64(&x432, x387, x372, x340);
+  fiat_p1024_cmovznz_u64(&x433, x387, x374, x342);
+  fiat_p1024_cmovznz_u64(&x434, x387, x376, x344);
+  fiat_p1024_cmovznz_u64(&x435, x387, x378, x346);
+  fiat_p1024_cmovznz_u64(&x436, x387, x380, x348);
+  fiat_p1024_cmovznz_u64(&x437, x387, x382, x350);
+  fiat_p1024_cmovznz_u64(&x438, x387, x384, x352);
+  *out1 = x388;
+  out2[0] = x7;
+  out2[1] = x8;
+  out2[2] = x9;
+  out2[3] = x10;
+  out2[4] = x11;
+  out2[5] = x12;
+  out2[6] = x13;
+  out2[7] = x14;
+  out2[8] = x15;
+  out2[9] = x16;
+  out2[10] = x17;
+  out2[11] = x18;
+  out2[12] = x19;
+  out2[13] = x20;
+  out2[14] = x21;
+  out2[15] = x22;
+  out2[16] = x23;
+  out3[0] = x390;
+  out3[1] = x391;
+  out3[2] = x392;
+  out3[3] = x393;
+  out3[4] = x394;
+  out3[5] = x395;
+  out3[6] = x396;
+  out3[7] = x397;
+  out3[8] = x398;
+  out3[9] = x399;
+  out3[10] = x400;
+  out3[11] = x401;
+  out3[12] = x402;
+  out3[13] = x403;
+  out3[14] = x404;
+  out3[15] = x405;
+  out3[16] = x406;
+  out4[0] = x407;
+  out4[1] = x408;
+  out4[2] = x409;
+  out4[3] = x410;
+  out4[4] = x411;
+  out4[5] = x412;
+  out4[6] = x413;
+  out4[7] = x414;
+  out4[8] = x415;
+  out4[9] = x416;
+  out4[10] = x417;
+  out4[11] = x418;
+  out4[12] = x419;
+  out4[13] = x420;
+  out4[14] = x421;
+  out4[15] = x422;
+  out5[0] = x423;
+  out5[1] = x424;
+  out5[2] = x425;
+  out5[3] = x426;
+  out5[4] = x427;
+  out5[5] = x428;
+  out5[6] = x429;
+  out5[7] = x430;
+  out5[8] = x431;
+  out5[9] = x432;
+  out5[10] = x433;
+  out5[11] = x434;
+  out5[12] = x435;
+  out5[13] = x436;
+  out5[14] = x437;
+  out5[15] = x438;
+}
+#endif
@@ -0,0 +1,12 @@
+#ifdef CGONUTS
+#include "cgo.h"
+#endif // CGONUTS
+
+#define primes NAMESPACEBITS(primes)
+#define primes_batchbound NAMESPACEBITS(primes_batchbound)
+#define primes_batchmaxdaclen NAMESPACEBITS(primes_batchmaxdaclen)
+#define primes_batchsize NAMESPACEBITS(primes_batchsize)
+#define primes_batchstart NAMESPACEBITS(primes_batchstart)
+#define primes_batchstop NAMESPACEBITS(primes_batchstop)
+#define primes_dac NAMESPACEBITS(primes_dac)
+#define primes_daclen NAMESPACEBITS(primes_daclen)
@@ -0,0 +1 @@
+#define crypto_classify NAMESPACEBITS(crypto_classify)
@@ -0,0 +1,5 @@
+#if defined(CGONUTS)
+#define steps NAMESPACEBITS(steps)
+#define steps_guess NAMESPACEBITS(steps_guess)
+#define steps_override NAMESPACEBITS(steps_override)
+#endif
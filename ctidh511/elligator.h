@@ -0,0 +1,9 @@
+#ifndef ELLIGATOR_H
+#define ELLIGATOR_H
+
+#include "proj.h"
+#include "elligator_namespace.h"
+
+extern void elligator(proj *plus,proj *minus,const proj *A);
+
+#endif
@@ -0,0 +1,34 @@
+// fp*.S:
+#define fp_0 NAMESPACEBITS(fp_0)
+#define fp_1 NAMESPACEBITS(fp_1)
+#define fp_2 NAMESPACEBITS(fp_2)
+#define fp_add2 NAMESPACEBITS(fp_add2)
+#define fp_add3 NAMESPACEBITS(fp_add3)
+#define fp_addsub_count NAMESPACEBITS(fp_addsub_count)
+#define fp_cmov NAMESPACEBITS(fp_cmov)
+#define fp_copy NAMESPACEBITS(fp_copy)
+#define fp_cswap NAMESPACEBITS(fp_cswap)
+#define fp_mul2 NAMESPACEBITS(fp_mul2)
+#define fp_mul3 NAMESPACEBITS(fp_mul3)
+#define fp_mulsq_count NAMESPACEBITS(fp_mulsq_count)
+#define fp_sq1 NAMESPACEBITS(fp_sq1)
+#define fp_sq2 NAMESPACEBITS(fp_sq2)
+#define fp_sq_count NAMESPACEBITS(fp_sq_count)
+#define fp_sub2 NAMESPACEBITS(fp_sub2)
+#define fp_sub3 NAMESPACEBITS(fp_sub3)
+#define fp_neg1 NAMESPACEBITS(fp_neg1)
+#define fp_neg2 NAMESPACEBITS(fp_neg2)
+#define fp_sq1_rep NAMESPACEBITS(fp_sq1_rep)
+#define fp_random NAMESPACEBITS(fp_random)
+#define fp_isequal NAMESPACEBITS(fp_isequal)
+#define fp_iszero NAMESPACEBITS(fp_iszero)
+#define fp_double1 NAMESPACEBITS(fp_double1)
+#define fp_double2 NAMESPACEBITS(fp_double2)
+#define fp_quadruple1 NAMESPACEBITS(fp_quadruple1)
+#define fp_quadruple2 NAMESPACEBITS(fp_quadruple2)
+#define fp NAMESPACEBITS(fp)
+
+// fp_inv*.c:
+#define fp_inv NAMESPACEBITS(fp_inv)
+// fp_sqrt*.c:
+#define fp_sqrt NAMESPACEBITS(fp_sqrt)
@@ -0,0 +1,18 @@
+#define powpow8mod NAMESPACEBITS(powpow8mod)
+#define exp_by_squaring_ NAMESPACEBITS(exp_by_squaring_)
+#define biquad_precompute_curve NAMESPACEBITS(biquad_precompute_curve)
+#define biquad_postcompute_curve NAMESPACEBITS(biquad_postcompute_curve)
+#define biquad_precompute_point NAMESPACEBITS(biquad_precompute_point)
+#define biquad_postcompute_point NAMESPACEBITS(biquad_postcompute_point)
+#define x2 NAMESPACEBITS(x2)
+#define x2DBL NAMESPACEBITS(x2DBL)
+#define xA24 NAMESPACEBITS(xA24)
+#define xADD NAMESPACEBITS(xADD)
+#define xDBL NAMESPACEBITS(xDBL)
+#define xDBLADD NAMESPACEBITS(xDBLADD)
+#define xISOG NAMESPACEBITS(xISOG)
+#define xISOG_matryoshka NAMESPACEBITS(xISOG_matryoshka)
+#define xISOG_old NAMESPACEBITS(xISOG_old)
+#define xMUL NAMESPACEBITS(xMUL)
+#define xMUL_dac NAMESPACEBITS(xMUL_dac)
+#define xMUL_vartime NAMESPACEBITS(xMUL_vartime)
@@ -0,0 +1,7 @@
+#if defined(CGONUTS)
+#define random_boundedl1 NAMESPACEBITS(random_boundedl1)
+#define random_coin NAMESPACEBITS(random_coin)
+#define random_bytes NAMESPACEBITS(random_bytes)
+#define ctidh_fillrandom_default NAMESPACEBITS(ctidh_fillrandom_default)
+#define randombytes NAMESPACEBITS(randombytes)
+#endif
@@ -0,0 +1,11 @@
+#define action NAMESPACEBITS(action)
+#define base NAMESPACEBITS(base)
+#define public_key_from_bytes NAMESPACEBITS(public_key_from_bytes)
+#define public_key_to_bytes NAMESPACEBITS(public_key_to_bytes)
+#define csidh NAMESPACEBITS(csidh)
+#define csidh_private NAMESPACEBITS(csidh_private)
+#define csidh_private_withrng NAMESPACEBITS(csidh_private_withrng)
+#define csidh_statsucceeded NAMESPACEBITS(csidh_statsucceeded)
+#define csidh_stattried NAMESPACEBITS(csidh_stattried)
+#define validate NAMESPACEBITS(validate)
+#define validate_cutofforder_v2 NAMESPACEBITS(validate_cutofforder_v2)
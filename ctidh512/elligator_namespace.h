@@ -0,0 +1 @@
+#define elligator NAMESPACEBITS(elligator)
@@ -0,0 +1,28 @@
+#define poly_eval NAMESPACEBITS(poly_eval)
+#define poly_eval_postcompute NAMESPACEBITS(poly_eval_postcompute)
+#define poly_eval_precompute NAMESPACEBITS(poly_eval_precompute)
+#define poly_eval_precomputesize NAMESPACEBITS(poly_eval_precomputesize)
+#define poly_mul NAMESPACEBITS(poly_mul)
+#define poly_mul_high NAMESPACEBITS(poly_mul_high)
+#define poly_mul_low NAMESPACEBITS(poly_mul_low)
+#define poly_mul_mid NAMESPACEBITS(poly_mul_mid)
+#define poly_mul_selfreciprocal NAMESPACEBITS(poly_mul_selfreciprocal)
+#define poly_multieval NAMESPACEBITS(poly_multieval)
+#define poly_multieval_chooseunscaled NAMESPACEBITS(poly_multieval_chooseunscaled)
+#define poly_multieval_postcompute NAMESPACEBITS(poly_multieval_postcompute)
+#define poly_multieval_precompute NAMESPACEBITS(poly_multieval_precompute)
+#define poly_multieval_precomputesize NAMESPACEBITS(poly_multieval_precomputesize)
+#define poly_multieval_scaled NAMESPACEBITS(poly_multieval_scaled)
+#define poly_multieval_unscaled NAMESPACEBITS(poly_multieval_unscaled)
+#define poly_multieval_unscaled_postcompute NAMESPACEBITS(poly_multieval_unscaled_postcompute)
+#define poly_multieval_unscaled_precompute NAMESPACEBITS(poly_multieval_unscaled_precompute)
+#define poly_multieval_unscaled_precomputesize NAMESPACEBITS(poly_multieval_unscaled_precomputesize)
+#define poly_multiprod2 NAMESPACEBITS(poly_multiprod2)
+#define poly_multiprod2_selfreciprocal NAMESPACEBITS(poly_multiprod2_selfreciprocal)
+#define poly_pseudoreciprocal NAMESPACEBITS(poly_pseudoreciprocal)
+#define poly_pseudoremainder NAMESPACEBITS(poly_pseudoremainder)
+#define poly_pseudoremainder_postcompute NAMESPACEBITS(poly_pseudoremainder_postcompute)
+#define poly_pseudoremainder_precompute NAMESPACEBITS(poly_pseudoremainder_precompute)
+#define poly_pseudoremainder_precomputesize NAMESPACEBITS(poly_pseudoremainder_precomputesize)
+#define poly_tree1 NAMESPACEBITS(poly_tree1)
+#define poly_tree1size NAMESPACEBITS(poly_tree1size)
@@ -0,0 +1,18 @@
+#ifdef CGONUTS
+#include "cgo.h"
+#endif // CGONUTS
+
+#define uintbig_p NAMESPACEBITS(uintbig_p)
+#define uintbig_1 NAMESPACEBITS(uintbig_1)
+#define uintbig_bit NAMESPACEBITS(uintbig_bit)
+#define uintbig_set NAMESPACEBITS(uintbig_set)
+#define uintbig_setbit NAMESPACEBITS(uintbig_setbit)
+#define uintbig_add3 NAMESPACEBITS(uintbig_add3)
+#define uintbig_sub3 NAMESPACEBITS(uintbig_sub3)
+#define uintbig_mul3_64 NAMESPACEBITS(uintbig_mul3_64)
+#define uintbig_four_sqrt_p NAMESPACEBITS(uintbig_four_sqrt_p)
+#define uintbig NAMESPACEBITS(uintbig)
+#define uintbig_bits_vartime NAMESPACEBITS(uintbig_bits_vartime)
+#define uintbig_uint64_iszero NAMESPACEBITS(uintbig_uint64_iszero)
+#define uintbig_isequal NAMESPACEBITS(uintbig_isequal)
+#define uintbig_iszero NAMESPACEBITS(uintbig_iszero)
@@ -0,0 +1,350 @@
+// Package envelope encrypts files and streams to several public keys
+// of a NIKE at once, in the manner of age.
+//
+// A random file key encrypts the body. The header carries the file key
+// wrapped for every recipient: for each one a fresh ephemeral key pair
+// is generated, and the file key is sealed with ChaCha20-Poly1305
+// under a key derived from DeriveSecret of the ephemeral private key
+// and the recipient's public key. Entries are labelled with the
+// fingerprint of the recipient's key, so that a reader finds its own
+// without trying them all. The header ends with a MAC under the file
+// key, so that no recipient can change it for the others.
+//
+// The body is encrypted in 64 KiB chunks with the STREAM construction,
+// which detects truncation, reordering and appended chunks. A reader
+// returns data only once its chunk is authenticated, and io.EOF only
+// once the whole body is, so a caller must not act on the data before
+// it has read to io.EOF without error.
+//
+// The format is
+//
+//	magic "CTIDHENV" || version 1 || name length || scheme name ||
+//	recipient count (2 bytes, big-endian) ||
+//	recipient count * (fingerprint || ephemeral public key || wrapped file key) ||
+//	payload nonce (16 bytes) || header MAC (32 bytes) ||
+//	body chunks
+package envelope
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+
+	"git.xx.network/elixxir/ctidh_cgo/internal/stream"
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+)
+
+const (
+	// FingerprintSize is the size in bytes of a key fingerprint.
+	FingerprintSize = 16
+
+	// MaxRecipients is the largest number of recipients of a file.
+	MaxRecipients = 1<<16 - 1
+
+	fileKeySize      = 16
+	payloadNonceSize = 16
+	macSize          = sha256.Size
+	tagSize          = 16
+	version          = 1
+)
+
+var magic = []byte("CTIDHENV")
+
+var (
+	// ErrHeader indicates a malformed header.
+	ErrHeader = errors.New("envelope: malformed header")
+
+	// ErrScheme indicates a file encrypted to keys of another
+	// scheme.
+	ErrScheme = errors.New("envelope: file is for another scheme")
+
+	// ErrRecipients indicates a number of recipients which is zero
+	// or more than MaxRecipients.
+	ErrRecipients = errors.New("envelope: invalid number of recipients")
+
+	// ErrNoIdentity indicates that none of the identities is a
+	// recipient of the file.
+	ErrNoIdentity = errors.New("envelope: no identity matches a recipient")
+
+	// ErrHeaderMAC indicates a header which was modified.
+	ErrHeaderMAC = errors.New("envelope: header MAC mismatch")
+
+	// ErrTruncated indicates a body which ends early.
+	ErrTruncated = stream.ErrTruncated
+
+	// ErrTrailingData indicates a body which goes on after its end.
+	ErrTrailingData = stream.ErrTrailingData
+
+	// ErrDecrypt indicates a body chunk which failed to
+	// authenticate, or which is out of place.
+	ErrDecrypt = stream.ErrDecrypt
+)
+
+// Fingerprint identifies a public key.
+type Fingerprint [FingerprintSize]byte
+
+// String returns the fingerprint in hexadecimal.
+func (f Fingerprint) String() string {
+	return hex.EncodeToString(f[:])
+}
+
+// Identity is the key pair of a recipient.
+type Identity struct {
+	PrivateKey nike.PrivateKey
+	PublicKey  nike.PublicKey
+}
+
+// Envelope encrypts and decrypts files for a NIKE.
+type Envelope struct {
+	scheme nike.Scheme
+}
+
+// New returns an Envelope for scheme.
+func New(scheme nike.Scheme) *Envelope {
+	return &Envelope{scheme: scheme}
+}
+
+// Fingerprint returns the fingerprint of publicKey, the first 16
+// bytes of its SHA-256 hash together with the scheme name.
+func (e *Envelope) Fingerprint(publicKey nike.PublicKey) Fingerprint {
+	h := sha256.New()
+	h.Write([]byte("ctidh envelope fingerprint\x00" + e.scheme.Name() + "\x00"))
+	h.Write(publicKey.Bytes())
+	var f Fingerprint
+	copy(f[:], h.Sum(nil))
+	return f
+}
+
+// entrySize returns the size of the header entry of a recipient.
+func (e *Envelope) entrySize() int {
+	return FingerprintSize + e.scheme.PublicKeySize() + fileKeySize + tagSize
+}
+
+// wrapAEAD returns the AEAD which wraps the file key for the recipient
+// publicKey, given the ephemeral public key and the shared secret.
+func (e *Envelope) wrapAEAD(secret []byte, ephemeralPublicKey, publicKey nike.PublicKey) (cipher.AEAD, error) {
+	salt := append(ephemeralPublicKey.Bytes(), publicKey.Bytes()...)
+	key := make([]byte, chacha20poly1305.KeySize)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, secret, salt, []byte("ctidh envelope wrap")), key); err != nil {
+		return nil, err
+	}
+	return chacha20poly1305.New(key)
+}
+
+// headerMAC returns the MAC of the header under the file key.
+func headerMAC(fileKey, header []byte) []byte {
+	key := make([]byte, sha256.Size)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, fileKey, nil, []byte("header")), key); err != nil {
+		panic(err)
+	}
+	h := hmac.New(sha256.New, key)
+	h.Write(header)
+	return h.Sum(nil)
+}
+
+// payloadAEAD returns the AEAD of the body.
+func payloadAEAD(fileKey, nonce []byte) (cipher.AEAD, error) {
+	key := make([]byte, chacha20poly1305.KeySize)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, fileKey, nonce, []byte("payload")), key); err != nil {
+		return nil, err
+	}
+	return chacha20poly1305.New(key)
+}
+
+// Encrypt writes the header for recipients to dst and returns a writer
+// which encrypts the body to dst. The file is only complete once the
+// writer is closed. The file key and the ephemeral keys are drawn from
+// rng.
+func (e *Envelope) Encrypt(rng io.Reader, dst io.Writer, recipients ...nike.PublicKey) (io.WriteCloser, error) {
+	if len(recipients) == 0 || len(recipients) > MaxRecipients {
+		return nil, ErrRecipients
+	}
+	fileKey := make([]byte, fileKeySize)
+	if _, err := io.ReadFull(rng, fileKey); err != nil {
+		return nil, err
+	}
+
+	header := append([]byte{}, magic...)
+	header = append(header, version, byte(len(e.scheme.Name())))
+	header = append(header, e.scheme.Name()...)
+	header = binary.BigEndian.AppendUint16(header, uint16(len(recipients)))
+	for _, publicKey := range recipients {
+		ephemeralPrivate, ephemeralPublic, err := e.scheme.GenerateKeyPair(rng)
+		if err != nil {
+			return nil, err
+		}
+		secret, err := e.scheme.DeriveSecret(ephemeralPrivate, publicKey)
+		ephemeralPrivate.Reset()
+		if err != nil {
+			return nil, err
+		}
+		aead, err := e.wrapAEAD(secret, ephemeralPublic, publicKey)
+		if err != nil {
+			return nil, err
+		}
+		fingerprint := e.Fingerprint(publicKey)
+		header = append(header, fingerprint[:]...)
+		header = append(header, ephemeralPublic.Bytes()...)
+		// Every wrapping key is used once.
+		header = aead.Seal(header, make([]byte, aead.NonceSize()), fileKey, nil)
+	}
+
+	nonce := make([]byte, payloadNonceSize)
+	if _, err := io.ReadFull(rng, nonce); err != nil {
+		return nil, err
+	}
+	header = append(header, nonce...)
+	header = append(header, headerMAC(fileKey, header)...)
+	if _, err := dst.Write(header); err != nil {
+		return nil, err
+	}
+
+	aead, err := payloadAEAD(fileKey, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return stream.NewWriter(aead, nil, dst), nil
+}
+
+// entry is a recipient's entry in a header.
+type entry struct {
+	fingerprint        Fingerprint
+	ephemeralPublicKey []byte
+	wrappedKey         []byte
+}
+
+// Header is a parsed header.
+type Header struct {
+	envelope *Envelope
+	raw      []byte
+	entries  []entry
+	nonce    []byte
+	mac      []byte
+}
+
+// ReadHeader reads the header of a file from src, leaving src at the
+// start of the body.
+func (e *Envelope) ReadHeader(src io.Reader) (*Header, error) {
+	prefix := make([]byte, len(magic)+2)
+	if _, err := io.ReadFull(src, prefix); err != nil {
+		return nil, headerError(err)
+	}
+	if !bytes.Equal(prefix[:len(magic)], magic) || prefix[len(magic)] != version {
+		return nil, ErrHeader
+	}
+	name := make([]byte, int(prefix[len(magic)+1])+2)
+	if _, err := io.ReadFull(src, name); err != nil {
+		return nil, headerError(err)
+	}
+	if string(name[:len(name)-2]) != e.scheme.Name() {
+		return nil, ErrScheme
+	}
+	n := int(binary.BigEndian.Uint16(name[len(name)-2:]))
+	if n == 0 {
+		return nil, ErrHeader
+	}
+	rest := make([]byte, n*e.entrySize()+payloadNonceSize+macSize)
+	if _, err := io.ReadFull(src, rest); err != nil {
+		return nil, headerError(err)
+	}
+
+	h := &Header{
+		envelope: e,
+		raw:      append(append(prefix, name...), rest[:len(rest)-macSize]...),
+		entries:  make([]entry, n),
+		mac:      rest[len(rest)-macSize:],
+	}
+	pkSize := e.scheme.PublicKeySize()
+	for i := range h.entries {
+		copy(h.entries[i].fingerprint[:], rest)
+		h.entries[i].ephemeralPublicKey = rest[FingerprintSize : FingerprintSize+pkSize]
+		h.entries[i].wrappedKey = rest[FingerprintSize+pkSize : e.entrySize()]
+		rest = rest[e.entrySize():]
+	}
+	h.nonce = rest[:payloadNonceSize]
+	return h, nil
+}
+
+func headerError(err error) error {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return ErrHeader
+	}
+	return err
+}
+
+// Recipients returns the fingerprints of the recipients' keys.
+func (h *Header) Recipients() []Fingerprint {
+	fingerprints := make([]Fingerprint, len(h.entries))
+	for i := range h.entries {
+		fingerprints[i] = h.entries[i].fingerprint
+	}
+	return fingerprints
+}
+
+// fileKey returns the file key unwrapped with the first identity which
+// is a recipient.
+func (h *Header) fileKey(identities []*Identity) ([]byte, error) {
+	e := h.envelope
+	for _, identity := range identities {
+		fingerprint := e.Fingerprint(identity.PublicKey)
+		for _, entry := range h.entries {
+			if entry.fingerprint != fingerprint {
+				continue
+			}
+			ephemeralPublic, err := e.scheme.UnmarshalBinaryPublicKey(entry.ephemeralPublicKey)
+			if err != nil {
+				continue
+			}
+			secret, err := e.scheme.DeriveSecret(identity.PrivateKey, ephemeralPublic)
+			if err != nil {
+				return nil, err
+			}
+			aead, err := e.wrapAEAD(secret, ephemeralPublic, identity.PublicKey)
+			if err != nil {
+				return nil, err
+			}
+			fileKey, err := aead.Open(nil, make([]byte, aead.NonceSize()), entry.wrappedKey, nil)
+			if err == nil {
+				return fileKey, nil
+			}
+		}
+	}
+	return nil, ErrNoIdentity
+}
+
+// Open returns a reader which decrypts the body from src, which must
+// be positioned just after the header, with the first of identities
+// which is a recipient.
+func (h *Header) Open(src io.Reader, identities ...*Identity) (io.Reader, error) {
+	fileKey, err := h.fileKey(identities)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(headerMAC(fileKey, h.raw), h.mac) {
+		return nil, ErrHeaderMAC
+	}
+	aead, err := payloadAEAD(fileKey, h.nonce)
+	if err != nil {
+		return nil, err
+	}
+	return stream.NewReader(aead, nil, src), nil
+}
+
+// Decrypt reads the header from src and returns a reader which
+// decrypts the body, with the first of identities which is a
+// recipient.
+func (e *Envelope) Decrypt(src io.Reader, identities ...*Identity) (io.Reader, error) {
+	h, err := e.ReadHeader(src)
+	if err != nil {
+		return nil, err
+	}
+	return h.Open(src, identities...)
+}
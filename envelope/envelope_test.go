@@ -0,0 +1,243 @@
+package envelope
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/chacha20"
+
+	"git.xx.network/elixxir/ctidh_cgo/ctidh511"
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+	"git.xx.network/elixxir/ctidh_cgo/nike/x25519"
+)
+
+const chunkSize = 64 * 1024
+
+func newTestIdentities(t *testing.T, scheme nike.Scheme, n int) []*Identity {
+	identities := make([]*Identity, n)
+	for i := range identities {
+		privateKey, publicKey, err := scheme.GenerateKeyPair(rand.Reader)
+		require.NoError(t, err)
+		identities[i] = &Identity{PrivateKey: privateKey, PublicKey: publicKey}
+	}
+	return identities
+}
+
+func publicKeys(identities []*Identity) []nike.PublicKey {
+	keys := make([]nike.PublicKey, len(identities))
+	for i, identity := range identities {
+		keys[i] = identity.PublicKey
+	}
+	return keys
+}
+
+func encrypt(t *testing.T, e *Envelope, plaintext []byte, recipients []*Identity) []byte {
+	var buf bytes.Buffer
+	w, err := e.Encrypt(rand.Reader, &buf, publicKeys(recipients)...)
+	require.NoError(t, err)
+	_, err = w.Write(plaintext)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func decrypt(e *Envelope, file []byte, identities ...*Identity) ([]byte, error) {
+	r, err := e.Decrypt(bytes.NewReader(file), identities...)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+func TestRecipients(t *testing.T) {
+	for _, scheme := range []nike.Scheme{ctidh511.Scheme(), x25519.Scheme()} {
+		t.Run(scheme.Name(), func(t *testing.T) {
+			e := New(scheme)
+			identities := newTestIdentities(t, scheme, 4)
+			recipients, outsider := identities[:3], identities[3]
+			plaintext := []byte("backup of the week")
+			file := encrypt(t, e, plaintext, recipients)
+
+			h, err := e.ReadHeader(bytes.NewReader(file))
+			require.NoError(t, err)
+			require.Len(t, h.Recipients(), len(recipients))
+			for i, recipient := range recipients {
+				require.Equal(t, e.Fingerprint(recipient.PublicKey), h.Recipients()[i])
+			}
+
+			for _, recipient := range recipients {
+				got, err := decrypt(e, file, recipient)
+				require.NoError(t, err)
+				require.Equal(t, plaintext, got)
+			}
+
+			// The first matching identity of several is used.
+			got, err := decrypt(e, file, outsider, recipients[2])
+			require.NoError(t, err)
+			require.Equal(t, plaintext, got)
+
+			_, err = decrypt(e, file, outsider)
+			require.ErrorIs(t, err, ErrNoIdentity)
+		})
+	}
+}
+
+// testSource is a reproducible stream of pseudorandom bytes.
+type testSource struct {
+	cipher *chacha20.Cipher
+	left   int64
+}
+
+func newTestSource(t *testing.T, size int64) *testSource {
+	c, err := chacha20.NewUnauthenticatedCipher(make([]byte, chacha20.KeySize), make([]byte, chacha20.NonceSize))
+	require.NoError(t, err)
+	return &testSource{cipher: c, left: size}
+}
+
+func (s *testSource) Read(p []byte) (int, error) {
+	if s.left == 0 {
+		return 0, io.EOF
+	}
+	if int64(len(p)) > s.left {
+		p = p[:s.left]
+	}
+	for i := range p {
+		p[i] = 0
+	}
+	s.cipher.XORKeyStream(p, p)
+	s.left -= int64(len(p))
+	return len(p), nil
+}
+
+func TestLargeFile(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping large file in short mode")
+	}
+	const size = 256<<20 + 12345
+	scheme := x25519.Scheme()
+	e := New(scheme)
+	identities := newTestIdentities(t, scheme, 2)
+
+	// The file streams from the writer to the reader through a pipe,
+	// and is never held in memory as a whole.
+	pr, pw := io.Pipe()
+	go func() {
+		w, err := e.Encrypt(rand.Reader, pw, publicKeys(identities)...)
+		if err == nil {
+			_, err = io.Copy(w, newTestSource(t, size))
+		}
+		if err == nil {
+			err = w.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	r, err := e.Decrypt(pr, identities[1])
+	require.NoError(t, err)
+	got := sha256.New()
+	n, err := io.Copy(got, r)
+	require.NoError(t, err)
+	require.EqualValues(t, size, n)
+
+	want := sha256.New()
+	_, err = io.Copy(want, newTestSource(t, size))
+	require.NoError(t, err)
+	require.Equal(t, want.Sum(nil), got.Sum(nil))
+}
+
+func TestBodyTampering(t *testing.T) {
+	scheme := x25519.Scheme()
+	e := New(scheme)
+	identities := newTestIdentities(t, scheme, 1)
+	plaintext := make([]byte, 3*chunkSize+100)
+	file := encrypt(t, e, plaintext, identities)
+
+	h, err := e.ReadHeader(bytes.NewReader(file))
+	require.NoError(t, err)
+	headerSize := len(h.raw) + macSize
+	header, body := file[:headerSize], file[headerSize:]
+	encChunkSize := chunkSize + tagSize
+	chunk := func(i int) []byte {
+		end := (i + 1) * encChunkSize
+		if end > len(body) {
+			end = len(body)
+		}
+		return body[i*encChunkSize : end]
+	}
+
+	for _, test := range []struct {
+		name string
+		body [][]byte
+		err  error
+	}{
+		{"truncated at a chunk boundary", [][]byte{chunk(0), chunk(1), chunk(2)}, ErrTruncated},
+		{"truncated in a chunk", [][]byte{body[:len(body)-1]}, ErrDecrypt},
+		{"body missing", nil, ErrTruncated},
+		{"reordered", [][]byte{chunk(0), chunk(2), chunk(1), chunk(3)}, ErrDecrypt},
+		{"chunk dropped", [][]byte{chunk(0), chunk(1), chunk(3)}, ErrDecrypt},
+		{"bit flipped", [][]byte{chunk(0), chunk(1), chunk(2), {chunk(3)[0] ^ 1}, chunk(3)[1:]}, ErrDecrypt},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			tampered := append([]byte{}, header...)
+			for _, part := range test.body {
+				tampered = append(tampered, part...)
+			}
+			_, err := decrypt(e, tampered, identities[0])
+			require.ErrorIs(t, err, test.err)
+		})
+	}
+
+	// A whole file appended to one which ends at a chunk boundary is
+	// a chunk after the last.
+	full := encrypt(t, e, plaintext[:chunkSize], identities)
+	_, err = decrypt(e, append(full, full[len(full)-encChunkSize:]...), identities[0])
+	require.ErrorIs(t, err, ErrTrailingData)
+}
+
+func TestHeaderTampering(t *testing.T) {
+	scheme := ctidh511.Scheme()
+	e := New(scheme)
+	identities := newTestIdentities(t, scheme, 2)
+	file := encrypt(t, e, []byte("hello"), identities)
+	h, err := e.ReadHeader(bytes.NewReader(file))
+	require.NoError(t, err)
+	headerSize := len(h.raw) + macSize
+	firstEntry := len(magic) + 2 + len(scheme.Name()) + 2
+	secondEntry := firstEntry + e.entrySize()
+
+	for _, test := range []struct {
+		name   string
+		offset int
+		err    error
+	}{
+		{"magic", 0, ErrHeader},
+		{"version", len(magic), ErrHeader},
+		{"scheme", len(magic) + 2, ErrScheme},
+		// The first recipient no longer finds its entry.
+		{"fingerprint", firstEntry, ErrNoIdentity},
+		{"wrapped key", secondEntry - 1, ErrNoIdentity},
+		// A change to the entry of the other recipient, or to the
+		// nonce, is caught by the MAC.
+		{"other entry", secondEntry + FingerprintSize, ErrHeaderMAC},
+		{"nonce", headerSize - macSize - 1, ErrHeaderMAC},
+		{"MAC", headerSize - 1, ErrHeaderMAC},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			tampered := append([]byte{}, file...)
+			tampered[test.offset] ^= 1
+			_, err := decrypt(e, tampered, identities[0])
+			require.ErrorIs(t, err, test.err)
+		})
+	}
+
+	_, err = decrypt(e, file[:headerSize-1], identities[0])
+	require.ErrorIs(t, err, ErrHeader)
+	_, err = decrypt(New(x25519.Scheme()), file, identities[0])
+	require.ErrorIs(t, err, ErrScheme)
+	_, err = e.Encrypt(rand.Reader, io.Discard)
+	require.ErrorIs(t, err, ErrRecipients)
+}
@@ -0,0 +1,85 @@
+package ctidh
+
+import (
+	"fmt"
+)
+
+var (
+	// ErrBlindDataSizeInvalid indicates that the blinding data size was invalid.
+	ErrBlindDataSizeInvalid error = fmt.Errorf("%s: blinding data size invalid", Name())
+
+	// ErrPublicKeyValidation indicates a public key validation failure.
+	ErrPublicKeyValidation error = fmt.Errorf("%s: public key validation failure", Name())
+
+	// ErrPublicKeySize indicates the raw data is not the correct size for a public key.
+	ErrPublicKeySize error = fmt.Errorf("%s: raw public key data size is wrong", Name())
+
+	// ErrRepresentativeSize indicates the raw data is not the correct size for a public key representative.
+	ErrRepresentativeSize error = fmt.Errorf("%s: public key representative size is wrong", Name())
+
+	// ErrPrivateKeySize indicates the raw data is not the correct size for a private key.
+	ErrPrivateKeySize error = fmt.Errorf("%s: raw private key data size is wrong", Name())
+
+	// ErrPrivateKeyValidation indicates that a private key has
+	// exponents outside of the CTIDH batch bounds.
+	ErrPrivateKeyValidation error = fmt.Errorf("%s: private key validation failure", Name())
+
+	// ErrCTIDH indicates a group action failure.
+	ErrCTIDH error = fmt.Errorf("%s: group action failure", Name())
+
+	// ErrX25519 indicates an X25519 failure in the hybrid of CTIDH
+	// and X25519, such as a low order public key.
+	ErrX25519 error = fmt.Errorf("%s: X25519 failure", HybridName())
+
+	// ErrSeedSize indicates that a private key seed is too short.
+	ErrSeedSize error = fmt.Errorf("%s: seed is shorter than %d bytes", Name(), MinSeedSize)
+
+	// ErrKeyGeneration indicates that the randomness for a new
+	// private key could not be read.
+	ErrKeyGeneration error = fmt.Errorf("%s: key generation failure", Name())
+)
+
+// ErrPEMKeyTypeMismatch returns an error indicating that we tried
+// to decode a PEM file containing a differing key type than the one
+// we expected.
+func ErrPEMKeyTypeMismatch(gotType, wantType string) error {
+	return fmt.Errorf("%s: Attempted to decode a PEM bytes of type %s"+
+		" which differs from the type we want %s",
+		Name(),
+		gotType,
+		wantType)
+}
+
+// Error is returned by the operations which can fail for reasons
+// other than malformed arguments, such as DeriveSecret and
+// GenerateKeyPair. errors.Is matches an Error against both its Kind
+// and its underlying Err, so for example a group action on an
+// invalid public key matches ErrCTIDH and ErrPublicKeyValidation.
+type Error struct {
+	// Op is the failed operation, such as "DeriveSecret".
+	Op string
+
+	// Kind is the class of failure, such as ErrCTIDH.
+	Kind error
+
+	// Err is the underlying cause, such as ErrPublicKeyValidation.
+	// It may be nil.
+	Err error
+}
+
+func (e *Error) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("%v in %s", e.Kind, e.Op)
+	}
+	return fmt.Sprintf("%v in %s: %v", e.Kind, e.Op, e.Err)
+}
+
+// Unwrap returns the underlying cause of the error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is the Kind of the error.
+func (e *Error) Is(target error) bool {
+	return target == e.Kind
+}
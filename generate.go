@@ -0,0 +1,3 @@
+package ctidh
+
+//go:generate go run ./internal/gen
@@ -1,5 +1,15 @@
 module git.xx.network/elixxir/ctidh_cgo
 
-go 1.16
+go 1.20
 
-require github.com/stretchr/testify v1.8.0
+require (
+	github.com/stretchr/testify v1.8.0
+	golang.org/x/crypto v0.0.0-20210322153248-0c34fe9e7dc2
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/sys v0.0.0-20201119102817-f84b799fce68 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
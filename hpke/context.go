@@ -0,0 +1,219 @@
+package hpke
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"math"
+
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+)
+
+// context is the encryption context shared by Sender and Receiver,
+// as produced by the key schedule of section 5.1 of RFC 9180.
+type context struct {
+	suite *Suite
+
+	aead           cipher.AEAD
+	key            []byte
+	baseNonce      []byte
+	seq            uint64
+	exporterSecret []byte
+}
+
+// Sender is the sender's encryption context.
+type Sender struct {
+	context
+}
+
+// Receiver is the recipient's encryption context.
+type Receiver struct {
+	context
+}
+
+func verifyPSKInputs(mode Mode, psk, pskID []byte) error {
+	gotPSK := len(psk) != 0
+	gotPSKID := len(pskID) != 0
+	if gotPSK != gotPSKID {
+		return ErrPSK
+	}
+	if gotPSK && (mode == ModeBase || mode == ModeAuth) {
+		return ErrPSK
+	}
+	if !gotPSK && (mode == ModePSK || mode == ModeAuthPSK) {
+		return ErrPSK
+	}
+	return nil
+}
+
+// keyScheduleSecrets returns the key_schedule_context and secret of
+// the key schedule.
+func (s *Suite) keyScheduleSecrets(mode Mode, sharedSecret, info, psk, pskID []byte) (keyScheduleContext, secret []byte) {
+	pskIDHash := s.kdf.labeledExtract(nil, "psk_id_hash", pskID)
+	infoHash := s.kdf.labeledExtract(nil, "info_hash", info)
+	keyScheduleContext = append([]byte{byte(mode)}, pskIDHash...)
+	keyScheduleContext = append(keyScheduleContext, infoHash...)
+
+	secret = s.kdf.labeledExtract(sharedSecret, "secret", psk)
+	return keyScheduleContext, secret
+}
+
+func (s *Suite) keySchedule(mode Mode, sharedSecret, info, psk, pskID []byte) (*context, error) {
+	if err := verifyPSKInputs(mode, psk, pskID); err != nil {
+		return nil, err
+	}
+
+	keyScheduleContext, secret := s.keyScheduleSecrets(mode, sharedSecret, info, psk, pskID)
+
+	keySize, nonceSize, err := aeadParams(s.aeadID)
+	if err != nil {
+		return nil, err
+	}
+	c := &context{suite: s}
+	c.key = s.kdf.labeledExpand(secret, "key", keyScheduleContext, keySize)
+	c.baseNonce = s.kdf.labeledExpand(secret, "base_nonce", keyScheduleContext, nonceSize)
+	c.aead, err = newAEAD(s.aeadID, c.key)
+	if err != nil {
+		return nil, err
+	}
+	c.exporterSecret = s.kdf.labeledExpand(secret, "exp", keyScheduleContext, s.kdf.size())
+	return c, nil
+}
+
+// setupS sets up the sender's context in the given mode, with the
+// ephemeral key pair skE, pkE.
+func (s *Suite) setupS(mode Mode, pkR nike.PublicKey, info, psk, pskID []byte, skS *PrivateKey, skE nike.PrivateKey, pkE nike.PublicKey) ([]byte, *Sender, error) {
+	if err := verifyPSKInputs(mode, psk, pskID); err != nil {
+		return nil, nil, err
+	}
+	sharedSecret, enc, err := s.kem.encap(pkR, skS, skE, pkE)
+	if err != nil {
+		return nil, nil, err
+	}
+	c, err := s.keySchedule(mode, sharedSecret, info, psk, pskID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return enc, &Sender{context: *c}, nil
+}
+
+func (s *Suite) setupSRandom(mode Mode, pkR nike.PublicKey, info, psk, pskID []byte, skS *PrivateKey) ([]byte, *Sender, error) {
+	skE, pkE, err := s.kem.scheme.GenerateKeyPair(rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer skE.Reset()
+	return s.setupS(mode, pkR, info, psk, pskID, skS, skE, pkE)
+}
+
+// setupR sets up the recipient's context in the given mode.
+func (s *Suite) setupR(mode Mode, enc []byte, skR *PrivateKey, info, psk, pskID []byte, pkS nike.PublicKey) (*Receiver, error) {
+	if err := verifyPSKInputs(mode, psk, pskID); err != nil {
+		return nil, err
+	}
+	sharedSecret, err := s.kem.decap(enc, skR, pkS)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.keySchedule(mode, sharedSecret, info, psk, pskID)
+	if err != nil {
+		return nil, err
+	}
+	return &Receiver{context: *c}, nil
+}
+
+// SetupBaseS sets up a context for encrypting to the owner of pkR,
+// and returns it along with the encapsulated key to send them.
+func (s *Suite) SetupBaseS(pkR nike.PublicKey, info []byte) ([]byte, *Sender, error) {
+	return s.setupSRandom(ModeBase, pkR, info, nil, nil, nil)
+}
+
+// SetupBaseR sets up the recipient's context for the encapsulated
+// key enc.
+func (s *Suite) SetupBaseR(enc []byte, skR *PrivateKey, info []byte) (*Receiver, error) {
+	return s.setupR(ModeBase, enc, skR, info, nil, nil, nil)
+}
+
+// SetupPSKS is like SetupBaseS, but also authenticates the sender as
+// a holder of the pre-shared key psk, identified by pskID.
+func (s *Suite) SetupPSKS(pkR nike.PublicKey, info, psk, pskID []byte) ([]byte, *Sender, error) {
+	return s.setupSRandom(ModePSK, pkR, info, psk, pskID, nil)
+}
+
+// SetupPSKR is the recipient's side of SetupPSKS.
+func (s *Suite) SetupPSKR(enc []byte, skR *PrivateKey, info, psk, pskID []byte) (*Receiver, error) {
+	return s.setupR(ModePSK, enc, skR, info, psk, pskID, nil)
+}
+
+// SetupAuthS is like SetupBaseS, but also authenticates the sender as
+// the owner of the private key skS, using the static-static key
+// exchange of skS and pkR.
+func (s *Suite) SetupAuthS(pkR nike.PublicKey, info []byte, skS *PrivateKey) ([]byte, *Sender, error) {
+	return s.setupSRandom(ModeAuth, pkR, info, nil, nil, skS)
+}
+
+// SetupAuthR is the recipient's side of SetupAuthS, for a sender with
+// the public key pkS.
+func (s *Suite) SetupAuthR(enc []byte, skR *PrivateKey, info []byte, pkS nike.PublicKey) (*Receiver, error) {
+	return s.setupR(ModeAuth, enc, skR, info, nil, nil, pkS)
+}
+
+// SetupAuthPSKS combines SetupAuthS and SetupPSKS.
+func (s *Suite) SetupAuthPSKS(pkR nike.PublicKey, info, psk, pskID []byte, skS *PrivateKey) ([]byte, *Sender, error) {
+	return s.setupSRandom(ModeAuthPSK, pkR, info, psk, pskID, skS)
+}
+
+// SetupAuthPSKR is the recipient's side of SetupAuthPSKS.
+func (s *Suite) SetupAuthPSKR(enc []byte, skR *PrivateKey, info, psk, pskID []byte, pkS nike.PublicKey) (*Receiver, error) {
+	return s.setupR(ModeAuthPSK, enc, skR, info, psk, pskID, pkS)
+}
+
+func (c *context) computeNonce() []byte {
+	nonce := make([]byte, len(c.baseNonce))
+	binary.BigEndian.PutUint64(nonce[len(nonce)-8:], c.seq)
+	for i := range nonce {
+		nonce[i] ^= c.baseNonce[i]
+	}
+	return nonce
+}
+
+// Export derives a secret of length bytes from the context and
+// exporterContext.
+func (c *context) Export(exporterContext []byte, length int) ([]byte, error) {
+	if length < 0 || length > 255*c.suite.kdf.size() {
+		return nil, ErrExportLength
+	}
+	return c.suite.kdf.labeledExpand(c.exporterSecret, "sec", exporterContext, length), nil
+}
+
+// Seal encrypts and authenticates plaintext and authenticates aad.
+// Messages must be opened in the order in which they are sealed.
+func (c *Sender) Seal(aad, plaintext []byte) ([]byte, error) {
+	if c.aead == nil {
+		return nil, ErrExportOnly
+	}
+	if c.seq == math.MaxUint64 {
+		return nil, ErrMessageLimit
+	}
+	ciphertext := c.aead.Seal(nil, c.computeNonce(), plaintext, aad)
+	c.seq++
+	return ciphertext, nil
+}
+
+// Open decrypts ciphertext and checks the authenticity of it and of
+// aad. A ciphertext which fails to open does not use up a sequence
+// number.
+func (c *Receiver) Open(aad, ciphertext []byte) ([]byte, error) {
+	if c.aead == nil {
+		return nil, ErrExportOnly
+	}
+	if c.seq == math.MaxUint64 {
+		return nil, ErrMessageLimit
+	}
+	plaintext, err := c.aead.Open(nil, c.computeNonce(), ciphertext, aad)
+	if err != nil {
+		return nil, ErrOpen
+	}
+	c.seq++
+	return plaintext, nil
+}
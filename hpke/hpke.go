@@ -0,0 +1,215 @@
+// Package hpke implements Hybrid Public Key Encryption (HPKE) as
+// specified in RFC 9180, with DHKEMs built on the CTIDH NIKE.
+//
+// RFC 9180 defines the DHKEM construction for any Diffie-Hellman
+// group; this package instantiates it with the CTIDH parameter sets,
+// whose static-static key exchange also gives the Auth and AuthPSK
+// modes. The CTIDH KEM identifiers are private ones which are not
+// registered with IANA. DHKEM(X25519, HKDF-SHA256) is supported as
+// well and interoperates with other RFC 9180 implementations.
+//
+//	kem, err := hpke.NewKEM(ctidh1024.Scheme())
+//	...
+//	suite, err := hpke.NewSuite(kem, hpke.KDFHKDFSHA256, hpke.AEADChaCha20Poly1305)
+//	...
+//	enc, sender, err := suite.SetupBaseS(recipientPublicKey, info)
+//	ciphertext, err := sender.Seal(aad, plaintext)
+//	...
+//	receiver, err := suite.SetupBaseR(enc, recipientPrivateKey, info)
+//	plaintext, err := receiver.Open(aad, ciphertext)
+package hpke
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"hash"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+)
+
+// KDFID identifies a key derivation function.
+type KDFID uint16
+
+// The supported KDFs.
+const (
+	KDFHKDFSHA256 KDFID = 0x0001
+	KDFHKDFSHA512 KDFID = 0x0003
+)
+
+// AEADID identifies an authenticated encryption algorithm.
+type AEADID uint16
+
+// The supported AEADs. AEADExportOnly only allows the use of Export.
+const (
+	AEADAES128GCM        AEADID = 0x0001
+	AEADAES256GCM        AEADID = 0x0002
+	AEADChaCha20Poly1305 AEADID = 0x0003
+	AEADExportOnly       AEADID = 0xffff
+)
+
+// Mode is an HPKE mode.
+type Mode uint8
+
+// The HPKE modes.
+const (
+	ModeBase    Mode = 0x00
+	ModePSK     Mode = 0x01
+	ModeAuth    Mode = 0x02
+	ModeAuthPSK Mode = 0x03
+)
+
+var (
+	// ErrKDF indicates an unsupported KDF.
+	ErrKDF = errors.New("hpke: unsupported KDF")
+
+	// ErrAEAD indicates an unsupported AEAD.
+	ErrAEAD = errors.New("hpke: unsupported AEAD")
+
+	// ErrKEM indicates an unsupported KEM.
+	ErrKEM = errors.New("hpke: unsupported KEM")
+
+	// ErrPSK indicates that the PSK inputs are inconsistent with
+	// each other or with the mode.
+	ErrPSK = errors.New("hpke: inconsistent PSK inputs")
+
+	// ErrOpen indicates that a ciphertext failed to authenticate.
+	ErrOpen = errors.New("hpke: message authentication failed")
+
+	// ErrExportOnly indicates an attempt to Seal or Open with the
+	// export only AEAD.
+	ErrExportOnly = errors.New("hpke: export only context")
+
+	// ErrMessageLimit indicates that the sequence number of a context
+	// is exhausted.
+	ErrMessageLimit = errors.New("hpke: message limit reached")
+
+	// ErrExportLength indicates an invalid length for Export.
+	ErrExportLength = errors.New("hpke: invalid export length")
+)
+
+const versionLabel = "HPKE-v1"
+
+// kdf is HKDF with one of the supported hash functions, bound to a
+// suite_id as in section 4 of RFC 9180.
+type kdf struct {
+	hash    func() hash.Hash
+	suiteID []byte
+}
+
+func newKDF(id KDFID, suiteID []byte) (*kdf, error) {
+	switch id {
+	case KDFHKDFSHA256:
+		return &kdf{hash: sha256.New, suiteID: suiteID}, nil
+	case KDFHKDFSHA512:
+		return &kdf{hash: sha512.New, suiteID: suiteID}, nil
+	}
+	return nil, ErrKDF
+}
+
+// size returns Nh.
+func (k *kdf) size() int {
+	return k.hash().Size()
+}
+
+func (k *kdf) labeledExtract(salt []byte, label string, ikm []byte) []byte {
+	labeledIKM := make([]byte, 0, len(versionLabel)+len(k.suiteID)+len(label)+len(ikm))
+	labeledIKM = append(labeledIKM, versionLabel...)
+	labeledIKM = append(labeledIKM, k.suiteID...)
+	labeledIKM = append(labeledIKM, label...)
+	labeledIKM = append(labeledIKM, ikm...)
+	return hkdf.Extract(k.hash, labeledIKM, salt)
+}
+
+func (k *kdf) labeledExpand(prk []byte, label string, info []byte, length int) []byte {
+	labeledInfo := make([]byte, 2, 2+len(versionLabel)+len(k.suiteID)+len(label)+len(info))
+	binary.BigEndian.PutUint16(labeledInfo, uint16(length))
+	labeledInfo = append(labeledInfo, versionLabel...)
+	labeledInfo = append(labeledInfo, k.suiteID...)
+	labeledInfo = append(labeledInfo, label...)
+	labeledInfo = append(labeledInfo, info...)
+
+	out := make([]byte, length)
+	// The callers never ask for more than 255*Nh bytes, the only
+	// case in which HKDF-Expand fails.
+	if _, err := hkdf.Expand(k.hash, prk, labeledInfo).Read(out); err != nil {
+		panic(err)
+	}
+	return out
+}
+
+// aeadParams returns Nk and Nn of an AEAD.
+func aeadParams(id AEADID) (keySize, nonceSize int, err error) {
+	switch id {
+	case AEADAES128GCM:
+		return 16, 12, nil
+	case AEADAES256GCM:
+		return 32, 12, nil
+	case AEADChaCha20Poly1305:
+		return chacha20poly1305.KeySize, chacha20poly1305.NonceSize, nil
+	case AEADExportOnly:
+		return 0, 0, nil
+	}
+	return 0, 0, ErrAEAD
+}
+
+func newAEAD(id AEADID, key []byte) (cipher.AEAD, error) {
+	switch id {
+	case AEADAES128GCM, AEADAES256GCM:
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		return cipher.NewGCM(block)
+	case AEADChaCha20Poly1305:
+		return chacha20poly1305.New(key)
+	case AEADExportOnly:
+		return nil, nil
+	}
+	return nil, ErrAEAD
+}
+
+// Suite is an HPKE ciphersuite: a KEM, a KDF and an AEAD.
+type Suite struct {
+	kem    *KEM
+	kdfID  KDFID
+	aeadID AEADID
+	kdf    *kdf
+}
+
+// NewSuite returns the ciphersuite of kem, kdfID and aeadID.
+func NewSuite(kem *KEM, kdfID KDFID, aeadID AEADID) (*Suite, error) {
+	suiteID := make([]byte, 10)
+	copy(suiteID, "HPKE")
+	binary.BigEndian.PutUint16(suiteID[4:], uint16(kem.id))
+	binary.BigEndian.PutUint16(suiteID[6:], uint16(kdfID))
+	binary.BigEndian.PutUint16(suiteID[8:], uint16(aeadID))
+
+	k, err := newKDF(kdfID, suiteID)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := aeadParams(aeadID); err != nil {
+		return nil, err
+	}
+	return &Suite{kem: kem, kdfID: kdfID, aeadID: aeadID, kdf: k}, nil
+}
+
+// KEM returns the KEM of the suite.
+func (s *Suite) KEM() *KEM {
+	return s.kem
+}
+
+// KDF returns the KDF of the suite.
+func (s *Suite) KDF() KDFID {
+	return s.kdfID
+}
+
+// AEAD returns the AEAD of the suite.
+func (s *Suite) AEAD() AEADID {
+	return s.aeadID
+}
@@ -0,0 +1,228 @@
+package hpke
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"git.xx.network/elixxir/ctidh_cgo/ctidh511"
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+	"git.xx.network/elixxir/ctidh_cgo/nike/x25519"
+)
+
+func newTestSuite(t *testing.T, aeadID AEADID) *Suite {
+	kem, err := NewKEM(ctidh511.Scheme())
+	require.NoError(t, err)
+	suite, err := NewSuite(kem, KDFHKDFSHA256, aeadID)
+	require.NoError(t, err)
+	return suite
+}
+
+func TestModes(t *testing.T) {
+	suite := newTestSuite(t, AEADChaCha20Poly1305)
+	kem := suite.KEM()
+	skR, pkR, err := kem.GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+	skS, pkS, err := kem.GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+	info := []byte("info")
+	psk := []byte("0123456789abcdef0123456789abcdef")
+	pskID := []byte("psk id")
+
+	setups := []struct {
+		name  string
+		setup func() ([]byte, *Sender, *Receiver, error)
+	}{
+		{"Base", func() ([]byte, *Sender, *Receiver, error) {
+			enc, sender, err := suite.SetupBaseS(pkR, info)
+			require.NoError(t, err)
+			receiver, err := suite.SetupBaseR(enc, skR, info)
+			return enc, sender, receiver, err
+		}},
+		{"PSK", func() ([]byte, *Sender, *Receiver, error) {
+			enc, sender, err := suite.SetupPSKS(pkR, info, psk, pskID)
+			require.NoError(t, err)
+			receiver, err := suite.SetupPSKR(enc, skR, info, psk, pskID)
+			return enc, sender, receiver, err
+		}},
+		{"Auth", func() ([]byte, *Sender, *Receiver, error) {
+			enc, sender, err := suite.SetupAuthS(pkR, info, skS)
+			require.NoError(t, err)
+			receiver, err := suite.SetupAuthR(enc, skR, info, pkS)
+			return enc, sender, receiver, err
+		}},
+		{"AuthPSK", func() ([]byte, *Sender, *Receiver, error) {
+			enc, sender, err := suite.SetupAuthPSKS(pkR, info, psk, pskID, skS)
+			require.NoError(t, err)
+			receiver, err := suite.SetupAuthPSKR(enc, skR, info, psk, pskID, pkS)
+			return enc, sender, receiver, err
+		}},
+	}
+
+	for _, s := range setups {
+		t.Run(s.name, func(t *testing.T) {
+			enc, sender, receiver, err := s.setup()
+			require.NoError(t, err)
+			require.Len(t, enc, kem.EncapsulatedKeySize())
+
+			for _, msg := range []string{"first", "second", ""} {
+				ct, err := sender.Seal([]byte("aad"), []byte(msg))
+				require.NoError(t, err)
+				pt, err := receiver.Open([]byte("aad"), ct)
+				require.NoError(t, err)
+				require.Equal(t, msg, string(pt))
+			}
+
+			// A forgery does not use up a sequence number.
+			ct, err := sender.Seal(nil, []byte("message"))
+			require.NoError(t, err)
+			_, err = receiver.Open([]byte("other aad"), ct)
+			require.ErrorIs(t, err, ErrOpen)
+			pt, err := receiver.Open(nil, ct)
+			require.NoError(t, err)
+			require.Equal(t, "message", string(pt))
+
+			exported1, err := sender.Export([]byte("context"), 42)
+			require.NoError(t, err)
+			exported2, err := receiver.Export([]byte("context"), 42)
+			require.NoError(t, err)
+			require.Equal(t, exported1, exported2)
+			_, err = sender.Export(nil, 255*32+1)
+			require.ErrorIs(t, err, ErrExportLength)
+		})
+	}
+}
+
+func TestAuthWrongSender(t *testing.T) {
+	suite := newTestSuite(t, AEADAES128GCM)
+	kem := suite.KEM()
+	skR, pkR, err := kem.GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+	skS, _, err := kem.GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+	_, pkOther, err := kem.GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+
+	enc, sender, err := suite.SetupAuthS(pkR, nil, skS)
+	require.NoError(t, err)
+	ct, err := sender.Seal(nil, []byte("message"))
+	require.NoError(t, err)
+
+	receiver, err := suite.SetupAuthR(enc, skR, nil, pkOther)
+	require.NoError(t, err)
+	_, err = receiver.Open(nil, ct)
+	require.ErrorIs(t, err, ErrOpen)
+}
+
+func TestKEM(t *testing.T) {
+	kem := newTestSuite(t, AEADAES128GCM).KEM()
+	skR, pkR, err := kem.GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+	skS, pkS, err := kem.GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+	require.Equal(t, pkR.Bytes(), skR.PublicKey().Bytes())
+
+	// A loaded private key has its public key derived again.
+	loaded, err := kem.UnmarshalBinaryPrivateKey(skR.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, pkR.Bytes(), loaded.PublicKey().Bytes())
+
+	sharedSecret, enc, err := kem.Encap(pkR)
+	require.NoError(t, err)
+	decapsulated, err := kem.Decap(enc, loaded)
+	require.NoError(t, err)
+	require.Equal(t, sharedSecret, decapsulated)
+
+	sharedSecret, enc, err = kem.AuthEncap(pkR, skS)
+	require.NoError(t, err)
+	decapsulated, err = kem.AuthDecap(enc, skR, pkS)
+	require.NoError(t, err)
+	require.Equal(t, sharedSecret, decapsulated)
+	decapsulated, err = kem.AuthDecap(enc, skR, pkR)
+	require.NoError(t, err)
+	require.NotEqual(t, sharedSecret, decapsulated)
+}
+
+func TestKEMReset(t *testing.T) {
+	kem := newTestSuite(t, AEADAES128GCM).KEM()
+	x25519KEM, err := NewKEM(x25519.Scheme())
+	require.NoError(t, err)
+	generate := func() (*PrivateKey, nike.PublicKey, error) {
+		return kem.GenerateKeyPair(rand.Reader)
+	}
+	derive := func() (*PrivateKey, nike.PublicKey, error) {
+		return x25519KEM.DeriveKeyPair([]byte("input keying material"))
+	}
+
+	// Resetting a private key leaves the returned public key intact.
+	for _, newKeyPair := range []func() (*PrivateKey, nike.PublicKey, error){generate, derive} {
+		privateKey, publicKey, err := newKeyPair()
+		require.NoError(t, err)
+		publicKeyBytes := publicKey.Bytes()
+		privateKey.Reset()
+		require.Equal(t, publicKeyBytes, publicKey.Bytes())
+		require.Equal(t, publicKeyBytes, privateKey.PublicKey().Bytes())
+		require.Equal(t, make([]byte, len(privateKey.Bytes())), privateKey.Bytes())
+	}
+}
+
+func TestPSKInputs(t *testing.T) {
+	suite := newTestSuite(t, AEADAES128GCM)
+	_, pkR, err := suite.KEM().GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+
+	_, _, err = suite.SetupPSKS(pkR, nil, nil, nil)
+	require.ErrorIs(t, err, ErrPSK)
+	_, _, err = suite.SetupPSKS(pkR, nil, []byte("psk"), nil)
+	require.ErrorIs(t, err, ErrPSK)
+	_, _, err = suite.SetupPSKS(pkR, nil, nil, []byte("psk id"))
+	require.ErrorIs(t, err, ErrPSK)
+}
+
+func TestInvalidEncapsulatedKey(t *testing.T) {
+	suite := newTestSuite(t, AEADAES128GCM)
+	skR, _, err := suite.KEM().GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+
+	enc := make([]byte, suite.KEM().EncapsulatedKeySize())
+	enc[0] = 2
+	_, err = suite.SetupBaseR(enc, skR, nil)
+	require.ErrorIs(t, err, ctidh511.ErrPublicKeyValidation)
+	_, err = suite.SetupBaseR(enc[1:], skR, nil)
+	require.ErrorIs(t, err, ctidh511.ErrPublicKeySize)
+}
+
+func TestExportOnly(t *testing.T) {
+	suite := newTestSuite(t, AEADExportOnly)
+	skR, pkR, err := suite.KEM().GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+
+	enc, sender, err := suite.SetupBaseS(pkR, nil)
+	require.NoError(t, err)
+	receiver, err := suite.SetupBaseR(enc, skR, nil)
+	require.NoError(t, err)
+
+	_, err = sender.Seal(nil, []byte("message"))
+	require.ErrorIs(t, err, ErrExportOnly)
+	_, err = receiver.Open(nil, []byte("message"))
+	require.ErrorIs(t, err, ErrExportOnly)
+
+	exported1, err := sender.Export(nil, 32)
+	require.NoError(t, err)
+	exported2, err := receiver.Export(nil, 32)
+	require.NoError(t, err)
+	require.Equal(t, exported1, exported2)
+}
+
+func TestUnsupported(t *testing.T) {
+	_, err := NewKEM(ctidh511.HybridScheme())
+	require.ErrorIs(t, err, ErrKEM)
+
+	kem, err := NewKEM(ctidh511.Scheme())
+	require.NoError(t, err)
+	_, err = NewSuite(kem, 0x0002, AEADAES128GCM)
+	require.ErrorIs(t, err, ErrKDF)
+	_, err = NewSuite(kem, KDFHKDFSHA256, 0x0004)
+	require.ErrorIs(t, err, ErrAEAD)
+}
@@ -0,0 +1,237 @@
+package hpke
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+
+	"golang.org/x/crypto/sha3"
+
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+)
+
+// KEMID identifies a key encapsulation mechanism.
+type KEMID uint16
+
+// The supported KEMs. The CTIDH identifiers are private ones, taken
+// from the top of the range to stay clear of the registered KEMs.
+const (
+	KEMX25519HKDFSHA256    KEMID = 0x0020
+	KEMCTIDH511HKDFSHA256  KEMID = 0xff11
+	KEMCTIDH512HKDFSHA256  KEMID = 0xff12
+	KEMCTIDH1024HKDFSHA256 KEMID = 0xff13
+	KEMCTIDH2048HKDFSHA256 KEMID = 0xff14
+)
+
+// kemIDs maps the names of the supported NIKEs to their KEMs.
+var kemIDs = map[string]KEMID{
+	"X25519":     KEMX25519HKDFSHA256,
+	"CTIDH-511":  KEMCTIDH511HKDFSHA256,
+	"CTIDH-512":  KEMCTIDH512HKDFSHA256,
+	"CTIDH-1024": KEMCTIDH1024HKDFSHA256,
+	"CTIDH-2048": KEMCTIDH2048HKDFSHA256,
+}
+
+// KEM is DHKEM(Group, HKDF-SHA256) of section 4.1 of RFC 9180 for a
+// NIKE, where the Diffie-Hellman function is DeriveSecret and public
+// keys are serialized with Bytes.
+type KEM struct {
+	id     KEMID
+	scheme nike.Scheme
+	kdf    *kdf
+}
+
+// NewKEM returns the DHKEM of scheme, which must be X25519 or one of
+// the CTIDH parameter sets.
+func NewKEM(scheme nike.Scheme) (*KEM, error) {
+	id, ok := kemIDs[scheme.Name()]
+	if !ok {
+		return nil, ErrKEM
+	}
+	suiteID := make([]byte, 5)
+	copy(suiteID, "KEM")
+	binary.BigEndian.PutUint16(suiteID[3:], uint16(id))
+	k, err := newKDF(KDFHKDFSHA256, suiteID)
+	if err != nil {
+		return nil, err
+	}
+	return &KEM{id: id, scheme: scheme, kdf: k}, nil
+}
+
+// ID returns the identifier of the KEM.
+func (k *KEM) ID() KEMID {
+	return k.id
+}
+
+// Scheme returns the NIKE of the KEM.
+func (k *KEM) Scheme() nike.Scheme {
+	return k.scheme
+}
+
+// SharedSecretSize returns Nsecret, the size in bytes of the KEM
+// shared secrets.
+func (k *KEM) SharedSecretSize() int {
+	return k.kdf.size()
+}
+
+// EncapsulatedKeySize returns Nenc, the size in bytes of an
+// encapsulated key.
+func (k *KEM) EncapsulatedKeySize() int {
+	return k.scheme.PublicKeySize()
+}
+
+// PrivateKey is a KEM private key. The Auth modes and decapsulation
+// need the public key as well, so it is kept along with the NIKE
+// private key rather than computed again with a group action on each
+// use.
+type PrivateKey struct {
+	privateKey nike.PrivateKey
+	publicKey  nike.PublicKey
+}
+
+// PublicKey returns the public key of the private key.
+func (p *PrivateKey) PublicKey() nike.PublicKey {
+	return p.publicKey
+}
+
+// Bytes serializes the NIKE private key, as SerializePrivateKey.
+func (p *PrivateKey) Bytes() []byte {
+	return p.privateKey.Bytes()
+}
+
+// Reset resets the NIKE private key to all zeros. The public key is
+// left alone, since it is the one GenerateKeyPair or DeriveKeyPair
+// returned and is not secret.
+func (p *PrivateKey) Reset() {
+	p.privateKey.Reset()
+}
+
+// NewPrivateKey returns the KEM private key of a NIKE private key,
+// deriving its public key once.
+func (k *KEM) NewPrivateKey(privateKey nike.PrivateKey) (*PrivateKey, error) {
+	publicKey, err := k.scheme.DerivePublicKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return &PrivateKey{privateKey: privateKey, publicKey: publicKey}, nil
+}
+
+// UnmarshalBinaryPrivateKey loads a private key serialized by Bytes,
+// as DeserializePrivateKey.
+func (k *KEM) UnmarshalBinaryPrivateKey(data []byte) (*PrivateKey, error) {
+	privateKey, err := k.scheme.UnmarshalBinaryPrivateKey(data)
+	if err != nil {
+		return nil, err
+	}
+	return k.NewPrivateKey(privateKey)
+}
+
+// GenerateKeyPair returns a new key pair drawn from rng.
+func (k *KEM) GenerateKeyPair(rng io.Reader) (*PrivateKey, nike.PublicKey, error) {
+	privateKey, publicKey, err := k.scheme.GenerateKeyPair(rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &PrivateKey{privateKey: privateKey, publicKey: publicKey}, publicKey, nil
+}
+
+// DeriveKeyPair deterministically derives a key pair from ikm. For
+// X25519 this is the derivation of section 7.1.3 of RFC 9180. CTIDH
+// private keys are not uniform strings, so for CTIDH the output of
+// LabeledExpand(dkp_prk, "sk", "", 32) seeds SHAKE256 instead, from
+// which GenerateKeyPair draws the key pair.
+func (k *KEM) DeriveKeyPair(ikm []byte) (*PrivateKey, nike.PublicKey, error) {
+	dkpPRK := k.kdf.labeledExtract(nil, "dkp_prk", ikm)
+	if k.id == KEMX25519HKDFSHA256 {
+		sk := k.kdf.labeledExpand(dkpPRK, "sk", nil, k.scheme.PrivateKeySize())
+		privateKey, err := k.UnmarshalBinaryPrivateKey(sk)
+		if err != nil {
+			return nil, nil, err
+		}
+		return privateKey, privateKey.publicKey, nil
+	}
+
+	seed := k.kdf.labeledExpand(dkpPRK, "sk", nil, 32)
+	xof := sha3.NewShake256()
+	xof.Write(seed)
+	return k.GenerateKeyPair(xof)
+}
+
+// Encap returns a shared secret for the owner of pkR and its
+// encapsulation.
+func (k *KEM) Encap(pkR nike.PublicKey) (sharedSecret, enc []byte, err error) {
+	return k.AuthEncap(pkR, nil)
+}
+
+// Decap returns the shared secret encapsulated in enc.
+func (k *KEM) Decap(enc []byte, skR *PrivateKey) ([]byte, error) {
+	return k.decap(enc, skR, nil)
+}
+
+// AuthEncap is like Encap, but also authenticates the shared secret
+// with the sender's private key skS.
+func (k *KEM) AuthEncap(pkR nike.PublicKey, skS *PrivateKey) (sharedSecret, enc []byte, err error) {
+	skE, pkE, err := k.scheme.GenerateKeyPair(rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer skE.Reset()
+	return k.encap(pkR, skS, skE, pkE)
+}
+
+// AuthDecap is like Decap, but also checks that the shared secret
+// was encapsulated by the owner of pkS.
+func (k *KEM) AuthDecap(enc []byte, skR *PrivateKey, pkS nike.PublicKey) ([]byte, error) {
+	return k.decap(enc, skR, pkS)
+}
+
+// encap implements Encap and, if skS is not nil, AuthEncap with the
+// ephemeral key pair skE, pkE.
+func (k *KEM) encap(pkR nike.PublicKey, skS *PrivateKey, skE nike.PrivateKey, pkE nike.PublicKey) (sharedSecret, enc []byte, err error) {
+	dh, err := k.scheme.DeriveSecret(skE, pkR)
+	if err != nil {
+		return nil, nil, err
+	}
+	enc = pkE.Bytes()
+	kemContext := append(append([]byte{}, enc...), pkR.Bytes()...)
+
+	if skS != nil {
+		dhS, err := k.scheme.DeriveSecret(skS.privateKey, pkR)
+		if err != nil {
+			return nil, nil, err
+		}
+		dh = append(dh, dhS...)
+		kemContext = append(kemContext, skS.publicKey.Bytes()...)
+	}
+
+	return k.extractAndExpand(dh, kemContext), enc, nil
+}
+
+// decap implements Decap and, if pkS is not nil, AuthDecap.
+func (k *KEM) decap(enc []byte, skR *PrivateKey, pkS nike.PublicKey) ([]byte, error) {
+	pkE, err := k.scheme.UnmarshalBinaryPublicKey(enc)
+	if err != nil {
+		return nil, err
+	}
+	dh, err := k.scheme.DeriveSecret(skR.privateKey, pkE)
+	if err != nil {
+		return nil, err
+	}
+	kemContext := append(append([]byte{}, enc...), skR.publicKey.Bytes()...)
+
+	if pkS != nil {
+		dhS, err := k.scheme.DeriveSecret(skR.privateKey, pkS)
+		if err != nil {
+			return nil, err
+		}
+		dh = append(dh, dhS...)
+		kemContext = append(kemContext, pkS.Bytes()...)
+	}
+
+	return k.extractAndExpand(dh, kemContext), nil
+}
+
+func (k *KEM) extractAndExpand(dh, kemContext []byte) []byte {
+	eaePRK := k.kdf.labeledExtract(nil, "eae_prk", dh)
+	return k.kdf.labeledExpand(eaePRK, "shared_secret", kemContext, k.SharedSecretSize())
+}
@@ -0,0 +1,309 @@
+package hpke
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+
+	"git.xx.network/elixxir/ctidh_cgo/ctidh1024"
+	"git.xx.network/elixxir/ctidh_cgo/ctidh2048"
+	"git.xx.network/elixxir/ctidh_cgo/ctidh511"
+	"git.xx.network/elixxir/ctidh_cgo/ctidh512"
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+	"git.xx.network/elixxir/ctidh_cgo/nike/x25519"
+)
+
+var update = flag.Bool("update", false, "regenerate testdata/test-vectors.json")
+
+var vectorsFile = filepath.Join("testdata", "test-vectors.json")
+
+var schemes = map[KEMID]nike.Scheme{
+	KEMX25519HKDFSHA256:    x25519.Scheme(),
+	KEMCTIDH511HKDFSHA256:  ctidh511.Scheme(),
+	KEMCTIDH512HKDFSHA256:  ctidh512.Scheme(),
+	KEMCTIDH1024HKDFSHA256: ctidh1024.Scheme(),
+	KEMCTIDH2048HKDFSHA256: ctidh2048.Scheme(),
+}
+
+// hexBytes is a byte slice encoded in JSON as a hex string.
+type hexBytes []byte
+
+func (h hexBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hex.EncodeToString(h))
+}
+
+func (h *hexBytes) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	b, err := hex.DecodeString(s)
+	*h = b
+	return err
+}
+
+// vector follows the layout of the RFC 9180 test vectors.
+type vector struct {
+	Mode               Mode               `json:"mode"`
+	KEMID              KEMID              `json:"kem_id"`
+	KDFID              KDFID              `json:"kdf_id"`
+	AEADID             AEADID             `json:"aead_id"`
+	Info               hexBytes           `json:"info"`
+	IKMR               hexBytes           `json:"ikmR"`
+	IKMS               hexBytes           `json:"ikmS,omitempty"`
+	IKME               hexBytes           `json:"ikmE"`
+	SKRm               hexBytes           `json:"skRm"`
+	SKSm               hexBytes           `json:"skSm,omitempty"`
+	SKEm               hexBytes           `json:"skEm"`
+	PSK                hexBytes           `json:"psk,omitempty"`
+	PSKID              hexBytes           `json:"psk_id,omitempty"`
+	PKRm               hexBytes           `json:"pkRm"`
+	PKSm               hexBytes           `json:"pkSm,omitempty"`
+	PKEm               hexBytes           `json:"pkEm"`
+	Enc                hexBytes           `json:"enc"`
+	SharedSecret       hexBytes           `json:"shared_secret"`
+	KeyScheduleContext hexBytes           `json:"key_schedule_context"`
+	Secret             hexBytes           `json:"secret"`
+	Key                hexBytes           `json:"key"`
+	BaseNonce          hexBytes           `json:"base_nonce"`
+	ExporterSecret     hexBytes           `json:"exporter_secret"`
+	Encryptions        []encryptionVector `json:"encryptions"`
+	Exports            []exportVector     `json:"exports"`
+}
+
+type encryptionVector struct {
+	AAD   hexBytes `json:"aad"`
+	CT    hexBytes `json:"ct"`
+	Nonce hexBytes `json:"nonce"`
+	PT    hexBytes `json:"pt"`
+}
+
+type exportVector struct {
+	ExporterContext hexBytes `json:"exporter_context"`
+	L               int      `json:"L"`
+	ExportedValue   hexBytes `json:"exported_value"`
+}
+
+// vectorSuites are the suites and modes of the generated vectors.
+var vectorSuites = []struct {
+	kem  KEMID
+	kdf  KDFID
+	aead AEADID
+	mode Mode
+}{
+	{KEMCTIDH511HKDFSHA256, KDFHKDFSHA256, AEADAES128GCM, ModeBase},
+	{KEMCTIDH511HKDFSHA256, KDFHKDFSHA256, AEADAES128GCM, ModePSK},
+	{KEMCTIDH511HKDFSHA256, KDFHKDFSHA256, AEADAES128GCM, ModeAuth},
+	{KEMCTIDH511HKDFSHA256, KDFHKDFSHA256, AEADAES128GCM, ModeAuthPSK},
+	{KEMCTIDH512HKDFSHA256, KDFHKDFSHA256, AEADAES128GCM, ModeBase},
+	{KEMCTIDH512HKDFSHA256, KDFHKDFSHA256, AEADAES128GCM, ModePSK},
+	{KEMCTIDH512HKDFSHA256, KDFHKDFSHA256, AEADAES128GCM, ModeAuth},
+	{KEMCTIDH512HKDFSHA256, KDFHKDFSHA256, AEADAES128GCM, ModeAuthPSK},
+	{KEMCTIDH512HKDFSHA256, KDFHKDFSHA512, AEADAES256GCM, ModeBase},
+	{KEMCTIDH512HKDFSHA256, KDFHKDFSHA512, AEADChaCha20Poly1305, ModeBase},
+	{KEMCTIDH512HKDFSHA256, KDFHKDFSHA512, AEADChaCha20Poly1305, ModeAuthPSK},
+	{KEMCTIDH512HKDFSHA256, KDFHKDFSHA256, AEADExportOnly, ModeBase},
+	{KEMCTIDH1024HKDFSHA256, KDFHKDFSHA256, AEADChaCha20Poly1305, ModeBase},
+	{KEMCTIDH1024HKDFSHA256, KDFHKDFSHA256, AEADChaCha20Poly1305, ModePSK},
+	{KEMCTIDH1024HKDFSHA256, KDFHKDFSHA512, AEADAES256GCM, ModeAuth},
+	{KEMCTIDH1024HKDFSHA256, KDFHKDFSHA512, AEADAES256GCM, ModeAuthPSK},
+	{KEMCTIDH2048HKDFSHA256, KDFHKDFSHA512, AEADChaCha20Poly1305, ModeBase},
+	{KEMCTIDH2048HKDFSHA256, KDFHKDFSHA512, AEADChaCha20Poly1305, ModeAuth},
+}
+
+// The inputs are those of the RFC 9180 test vectors.
+var (
+	vectorInfo  = []byte("Ode on a Grecian Urn")
+	vectorPSK   = mustHex("0247fd33b913760fa1fa51e1892d9f307fbe65eb171e8132c2af18555a738b82")
+	vectorPSKID = []byte("Ennyn Durin aran Moria")
+	vectorPT    = []byte("Beauty is truth, truth beauty")
+)
+
+func mustHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// vectorIKM returns the input keying material of the vectors.
+func vectorIKM(i int, name string) []byte {
+	ikm := make([]byte, 32)
+	sha3.ShakeSum256(ikm, []byte(fmt.Sprintf("HPKE CTIDH test vector %d %s", i, name)))
+	return ikm
+}
+
+func newVectorSuite(t *testing.T, kemID KEMID, kdfID KDFID, aeadID AEADID) *Suite {
+	kem, err := NewKEM(schemes[kemID])
+	require.NoError(t, err)
+	require.Equal(t, kemID, kem.ID())
+	suite, err := NewSuite(kem, kdfID, aeadID)
+	require.NoError(t, err)
+	return suite
+}
+
+// runVector computes the vector from its inputs: the mode, suite,
+// info, ikms, PSK, and the aad, pt and contexts of the encryptions
+// and exports.
+func runVector(t *testing.T, in *vector) *vector {
+	suite := newVectorSuite(t, in.KEMID, in.KDFID, in.AEADID)
+	kem := suite.KEM()
+	out := *in
+
+	skE, pkE, err := kem.DeriveKeyPair(in.IKME)
+	require.NoError(t, err)
+	skR, pkR, err := kem.DeriveKeyPair(in.IKMR)
+	require.NoError(t, err)
+	out.SKEm, out.PKEm = skE.Bytes(), pkE.Bytes()
+	out.SKRm, out.PKRm = skR.Bytes(), pkR.Bytes()
+
+	var skS *PrivateKey
+	var pkS nike.PublicKey
+	if in.Mode == ModeAuth || in.Mode == ModeAuthPSK {
+		skS, pkS, err = kem.DeriveKeyPair(in.IKMS)
+		require.NoError(t, err)
+		out.SKSm, out.PKSm = skS.Bytes(), pkS.Bytes()
+	}
+
+	sharedSecret, enc, err := kem.encap(pkR, skS, skE.privateKey, pkE)
+	require.NoError(t, err)
+	out.Enc, out.SharedSecret = enc, sharedSecret
+	out.KeyScheduleContext, out.Secret = suite.keyScheduleSecrets(in.Mode, sharedSecret, in.Info, in.PSK, in.PSKID)
+
+	enc, sender, err := suite.setupS(in.Mode, pkR, in.Info, in.PSK, in.PSKID, skS, skE.privateKey, pkE)
+	require.NoError(t, err)
+	require.Equal(t, []byte(out.Enc), enc)
+	receiver, err := suite.setupR(in.Mode, enc, skR, in.Info, in.PSK, in.PSKID, pkS)
+	require.NoError(t, err)
+	out.Key, out.BaseNonce, out.ExporterSecret = sender.key, sender.baseNonce, sender.exporterSecret
+
+	out.Encryptions = nil
+	for _, e := range in.Encryptions {
+		nonce := sender.computeNonce()
+		ct, err := sender.Seal(e.AAD, e.PT)
+		require.NoError(t, err)
+		pt, err := receiver.Open(e.AAD, ct)
+		require.NoError(t, err)
+		require.Equal(t, []byte(e.PT), pt)
+		out.Encryptions = append(out.Encryptions, encryptionVector{AAD: e.AAD, CT: ct, Nonce: nonce, PT: e.PT})
+	}
+
+	out.Exports = nil
+	for _, e := range in.Exports {
+		value, err := sender.Export(e.ExporterContext, e.L)
+		require.NoError(t, err)
+		receiverValue, err := receiver.Export(e.ExporterContext, e.L)
+		require.NoError(t, err)
+		require.Equal(t, value, receiverValue)
+		out.Exports = append(out.Exports, exportVector{ExporterContext: e.ExporterContext, L: e.L, ExportedValue: value})
+	}
+	return &out
+}
+
+func generateVectors(t *testing.T) []*vector {
+	var vectors []*vector
+	for i, s := range vectorSuites {
+		in := &vector{
+			Mode:   s.mode,
+			KEMID:  s.kem,
+			KDFID:  s.kdf,
+			AEADID: s.aead,
+			Info:   vectorInfo,
+			IKME:   vectorIKM(i, "ikmE"),
+			IKMR:   vectorIKM(i, "ikmR"),
+		}
+		if s.mode == ModeAuth || s.mode == ModeAuthPSK {
+			in.IKMS = vectorIKM(i, "ikmS")
+		}
+		if s.mode == ModePSK || s.mode == ModeAuthPSK {
+			in.PSK, in.PSKID = vectorPSK, vectorPSKID
+		}
+		if s.aead != AEADExportOnly {
+			for seq := 0; seq < 2; seq++ {
+				in.Encryptions = append(in.Encryptions, encryptionVector{
+					AAD: []byte(fmt.Sprintf("Count-%d", seq)),
+					PT:  vectorPT,
+				})
+			}
+		}
+		for _, exporterContext := range []string{"", "\x00", "TestContext"} {
+			in.Exports = append(in.Exports, exportVector{ExporterContext: []byte(exporterContext), L: 32})
+		}
+		vectors = append(vectors, runVector(t, in))
+	}
+	return vectors
+}
+
+func TestVectors(t *testing.T) {
+	if *update {
+		data, err := json.MarshalIndent(generateVectors(t), "", "  ")
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(vectorsFile, append(data, '\n'), 0644))
+	}
+
+	data, err := ioutil.ReadFile(vectorsFile)
+	require.NoError(t, err)
+	var vectors []*vector
+	require.NoError(t, json.Unmarshal(data, &vectors))
+	require.NotEmpty(t, vectors)
+
+	for i, want := range vectors {
+		name := fmt.Sprintf("%d/%s/%d/%d/%d", i, schemes[want.KEMID].Name(), want.KDFID, want.AEADID, want.Mode)
+		t.Run(name, func(t *testing.T) {
+			if testing.Short() && want.KEMID == KEMCTIDH2048HKDFSHA256 {
+				t.Skip("CTIDH-2048 is slow")
+			}
+			got := runVector(t, want)
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+// TestRFC9180Vector checks the first test vector of RFC 9180, in
+// section A.1.1, for DHKEM(X25519, HKDF-SHA256), HKDF-SHA256 and
+// AES-128-GCM in base mode.
+func TestRFC9180Vector(t *testing.T) {
+	want := &vector{
+		Mode:           ModeBase,
+		KEMID:          KEMX25519HKDFSHA256,
+		KDFID:          KDFHKDFSHA256,
+		AEADID:         AEADAES128GCM,
+		Info:           mustHex("4f6465206f6e2061204772656369616e2055726e"),
+		IKMR:           mustHex("6db9df30aa07dd42ee5e8181afdb977e538f5e1fec8a06223f33f7013e525037"),
+		IKME:           mustHex("7268600d403fce431561aef583ee1613527cff655c1343f29812e66706df3234"),
+		SKRm:           mustHex("4612c550263fc8ad58375df3f557aac531d26850903e55a9f23f21d8534e8ac8"),
+		SKEm:           mustHex("52c4a758a802cd8b936eceea314432798d5baf2d7e9235dc084ab1b9cfa2f736"),
+		PKRm:           mustHex("3948cfe0ad1ddb695d780e59077195da6c56506b027329794ab02bca80815c4d"),
+		PKEm:           mustHex("37fda3567bdbd628e88668c3c8d7e97d1d1253b6d4ea6d44c150f741f1bf4431"),
+		Enc:            mustHex("37fda3567bdbd628e88668c3c8d7e97d1d1253b6d4ea6d44c150f741f1bf4431"),
+		SharedSecret:   mustHex("fe0e18c9f024ce43799ae393c7e8fe8fce9d218875e8227b0187c04e7d2ea1fc"),
+		Key:            mustHex("4531685d41d65f03dc48f6b8302c05b0"),
+		BaseNonce:      mustHex("56d890e5accaaf011cff4b7d"),
+		ExporterSecret: mustHex("45ff1c2e220db587171952c0592d5f5ebe103f1561a2614e38f2ffd47e99e3f8"),
+		Encryptions: []encryptionVector{{
+			AAD:   mustHex("436f756e742d30"),
+			CT:    mustHex("f938558b5d72f1a23810b4be2ab4f84331acc02fc97babc53a52ae8218a355a96d8770ac83d07bea87e13c512a"),
+			Nonce: mustHex("56d890e5accaaf011cff4b7d"),
+			PT:    mustHex("4265617574792069732074727574682c20747275746820626561757479"),
+		}},
+		Exports: []exportVector{{
+			ExporterContext: []byte{},
+			L:               32,
+			ExportedValue:   mustHex("3853fe2b4035195a573ffc53856e77058e15d9ea064de3e59f4961d0095250ee"),
+		}},
+	}
+
+	got := runVector(t, want)
+	// The RFC also lists these intermediate values, which are
+	// covered by the ones checked above.
+	got.KeyScheduleContext, got.Secret = nil, nil
+	require.Equal(t, want, got)
+}
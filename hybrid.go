@@ -0,0 +1,616 @@
+package ctidh
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/hmac"
+	"crypto/rand"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"golang.org/x/crypto/sha3"
+
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+)
+
+const (
+	// x25519KeySize is the size in bytes of the X25519 half of the
+	// hybrid keys.
+	x25519KeySize = 32
+
+	// HybridSharedSecretSize is the size in bytes of the secrets
+	// derived by DeriveHybridSecret.
+	HybridSharedSecretSize = 32
+)
+
+// HybridName returns the name of the hybrid of this CTIDH parameter
+// set and X25519, for example CTIDH-1024-X25519.
+func HybridName() string {
+	return Name() + "-X25519"
+}
+
+// HybridPublicKey is a public key of the hybrid of CTIDH and X25519.
+// It is encoded as the CTIDH public key followed by the X25519 public
+// key.
+type HybridPublicKey struct {
+	publicKey PublicKey
+	x25519    [x25519KeySize]byte
+}
+
+// NewEmptyHybridPublicKey returns an uninitialized
+// HybridPublicKey which is suitable to be loaded
+// via some serialization format via FromBytes
+// or FromPEMFile methods.
+func NewEmptyHybridPublicKey() *HybridPublicKey {
+	return new(HybridPublicKey)
+}
+
+// NewHybridPublicKey creates a new hybrid public key from
+// the given key material or returns an error if
+// the key data is not a valid public key.
+func NewHybridPublicKey(key []byte) (*HybridPublicKey, error) {
+	k := new(HybridPublicKey)
+	err := k.FromBytes(key)
+	if err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// MustNewHybridPublicKey is like NewHybridPublicKey
+// but panics if the key data is invalid.
+func MustNewHybridPublicKey(key []byte) *HybridPublicKey {
+	k, err := NewHybridPublicKey(key)
+	if err != nil {
+		panic(err)
+	}
+	return k
+}
+
+// Bytes returns the HybridPublicKey as a byte slice.
+func (p *HybridPublicKey) Bytes() []byte {
+	return append(p.publicKey.Bytes(), p.x25519[:]...)
+}
+
+// FromBytes loads a HybridPublicKey from the given byte slice.
+func (p *HybridPublicKey) FromBytes(data []byte) error {
+	if len(data) != PublicKeySize+x25519KeySize {
+		return ErrPublicKeySize
+	}
+	if err := p.publicKey.FromBytes(data[:PublicKeySize]); err != nil {
+		return err
+	}
+	copy(p.x25519[:], data[PublicKeySize:])
+	return nil
+}
+
+// String returns a string identifying
+// this type as a hybrid public key.
+func (p *HybridPublicKey) String() string {
+	return HybridName() + "_PublicKey"
+}
+
+// ToPEM writes out the HybridPublicKey to a PEM block and returns it
+func (p *HybridPublicKey) ToPEM() (*pem.Block, error) {
+	keyType := HybridName() + " PUBLIC KEY"
+
+	zeros := make([]byte, PublicKeySize+x25519KeySize)
+	if bytes.Equal(p.Bytes(), zeros) {
+		return nil, fmt.Errorf("%s: attemted to serialize scrubbed key",
+			HybridName())
+	}
+	blk := &pem.Block{
+		Type:  keyType,
+		Bytes: p.Bytes(),
+	}
+	return blk, nil
+}
+
+// ToPEMFile writes out the HybridPublicKey to a PEM file at path f.
+func (p *HybridPublicKey) ToPEMFile(f string) error {
+	blk, err := p.ToPEM()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(f, pem.EncodeToMemory(blk), 0600)
+}
+
+// FromPEM reads the HybridPublicKey from a PEM encoded byte slice.
+func (p *HybridPublicKey) FromPEM(pemBytes []byte) error {
+	keyType := HybridName() + " PUBLIC KEY"
+
+	blk, _ := pem.Decode(pemBytes)
+	if blk == nil {
+		return fmt.Errorf("%s: failed to decode PEM", HybridName())
+	}
+	if blk.Type != keyType {
+		return ErrPEMKeyTypeMismatch(blk.Type, keyType)
+	}
+	return p.FromBytes(blk.Bytes)
+}
+
+// FromPEMFile reads the HybridPublicKey from a PEM file at path f.
+func (p *HybridPublicKey) FromPEMFile(f string) error {
+	buf, err := ioutil.ReadFile(f)
+	if err != nil {
+		return err
+	}
+	err = p.FromPEM(buf)
+	if err != nil {
+		return fmt.Errorf("%w in file %s", err, f)
+	}
+	return nil
+}
+
+// Reset resets the HybridPublicKey to all zeros.
+func (p *HybridPublicKey) Reset() {
+	*p = HybridPublicKey{}
+}
+
+// Equal is a constant time comparison of the two public keys.
+func (p *HybridPublicKey) Equal(publicKey *HybridPublicKey) bool {
+	return hmac.Equal(p.Bytes(), publicKey.Bytes())
+}
+
+// MarshalBinary is an implementation of a method on the
+// BinaryMarshaler interface defined in https://golang.org/pkg/encoding/
+func (p *HybridPublicKey) MarshalBinary() ([]byte, error) {
+	return p.Bytes(), nil
+}
+
+// UnmarshalBinary is an implementation of a method on the
+// BinaryUnmarshaler interface defined in https://golang.org/pkg/encoding/
+func (p *HybridPublicKey) UnmarshalBinary(data []byte) error {
+	return p.FromBytes(data)
+}
+
+// Blind performs a blinding operation
+// and mutates the public key.
+// See BlindHybrid.
+func (p *HybridPublicKey) Blind(blindingFactor []byte) error {
+	blinded, err := BlindHybrid(blindingFactor, p)
+	if err != nil {
+		return err
+	}
+	*p = *blinded
+	return nil
+}
+
+// BlindLegacy performs the blinding operation of
+// earlier releases on the CTIDH half and mutates
+// the public key. See BlindHybridLegacy.
+//
+// Deprecated: Use Blind.
+func (p *HybridPublicKey) BlindLegacy(blindingFactor []byte) error {
+	blinded, err := BlindHybridLegacy(blindingFactor, p)
+	if err != nil {
+		return err
+	}
+	*p = *blinded
+	return nil
+}
+
+// HybridPrivateKey is a private key of the hybrid of CTIDH and
+// X25519. It is encoded as the CTIDH private key followed by the
+// X25519 private key.
+type HybridPrivateKey struct {
+	privateKey PrivateKey
+	x25519     [x25519KeySize]byte
+
+	// publicKey caches the public key, which DeriveHybridSecret
+	// binds into the shared secret. It is nil for an empty key.
+	publicKey *HybridPublicKey
+}
+
+// NewEmptyHybridPrivateKey returns an uninitialized
+// HybridPrivateKey which is suitable to be loaded
+// via some serialization format via FromBytes
+// or FromPEMFile methods.
+func NewEmptyHybridPrivateKey() *HybridPrivateKey {
+	return new(HybridPrivateKey)
+}
+
+// Bytes serializes HybridPrivateKey into a byte slice.
+func (p *HybridPrivateKey) Bytes() []byte {
+	return append(p.privateKey.Bytes(), p.x25519[:]...)
+}
+
+// FromBytes loads a HybridPrivateKey from the given byte slice,
+// validating the CTIDH half like PrivateKey.FromBytes. It computes
+// the public key, which costs one group action.
+func (p *HybridPrivateKey) FromBytes(data []byte) error {
+	return p.fromBytes(data, (*PrivateKey).FromBytes)
+}
+
+// FromBytesUnchecked loads a HybridPrivateKey from the given byte
+// slice without checking the exponent bounds of the CTIDH half, like
+// PrivateKey.FromBytesUnchecked.
+func (p *HybridPrivateKey) FromBytesUnchecked(data []byte) error {
+	return p.fromBytes(data, (*PrivateKey).FromBytesUnchecked)
+}
+
+func (p *HybridPrivateKey) fromBytes(data []byte, fromBytes func(*PrivateKey, []byte) error) error {
+	if len(data) != PrivateKeySize+x25519KeySize {
+		return ErrPrivateKeySize
+	}
+	privKey := new(HybridPrivateKey)
+	if err := fromBytes(&privKey.privateKey, data[:PrivateKeySize]); err != nil {
+		return err
+	}
+	copy(privKey.x25519[:], data[PrivateKeySize:])
+	pubKey, err := DeriveHybridPublicKey(privKey)
+	if err != nil {
+		return err
+	}
+	privKey.publicKey = pubKey
+	*p = *privKey
+	return nil
+}
+
+// String returns a string identifying
+// this type as a hybrid private key.
+func (p *HybridPrivateKey) String() string {
+	return HybridName() + "_PrivateKey"
+}
+
+// Reset resets the HybridPrivateKey to all zeros.
+func (p *HybridPrivateKey) Reset() {
+	*p = HybridPrivateKey{}
+}
+
+// Equal is a constant time comparison of the two private keys.
+func (p *HybridPrivateKey) Equal(privateKey *HybridPrivateKey) bool {
+	return hmac.Equal(p.Bytes(), privateKey.Bytes())
+}
+
+// MarshalBinary is an implementation of a method on the
+// BinaryMarshaler interface defined in https://golang.org/pkg/encoding/
+func (p *HybridPrivateKey) MarshalBinary() ([]byte, error) {
+	return p.Bytes(), nil
+}
+
+// UnmarshalBinary is an implementation of a method on the
+// BinaryUnmarshaler interface defined in https://golang.org/pkg/encoding/
+func (p *HybridPrivateKey) UnmarshalBinary(data []byte) error {
+	return p.FromBytes(data)
+}
+
+// ToPEM writes out the HybridPrivateKey to a PEM block.
+func (p *HybridPrivateKey) ToPEM() (*pem.Block, error) {
+	keyType := HybridName() + " PRIVATE KEY"
+
+	zeros := make([]byte, PrivateKeySize+x25519KeySize)
+	if bytes.Equal(p.Bytes(), zeros) {
+		return nil, fmt.Errorf("%s: attemted to serialize scrubbed key",
+			HybridName())
+	}
+	blk := &pem.Block{
+		Type:  keyType,
+		Bytes: p.Bytes(),
+	}
+	return blk, nil
+}
+
+// ToPEMFile writes out the HybridPrivateKey to a PEM file at path f.
+func (p *HybridPrivateKey) ToPEMFile(f string) error {
+	blk, err := p.ToPEM()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(f, pem.EncodeToMemory(blk), 0600)
+}
+
+// FromPEM reads the HybridPrivateKey from a PEM byte slice,
+// validating it like FromBytes.
+func (p *HybridPrivateKey) FromPEM(pemBytes []byte) error {
+	return p.fromPEM(pemBytes, p.FromBytes)
+}
+
+// FromPEMUnchecked reads the HybridPrivateKey from a PEM byte slice
+// without checking the exponent bounds, like FromBytesUnchecked.
+func (p *HybridPrivateKey) FromPEMUnchecked(pemBytes []byte) error {
+	return p.fromPEM(pemBytes, p.FromBytesUnchecked)
+}
+
+func (p *HybridPrivateKey) fromPEM(pemBytes []byte, fromBytes func([]byte) error) error {
+	keyType := HybridName() + " PRIVATE KEY"
+
+	blk, _ := pem.Decode(pemBytes)
+	if blk == nil {
+		return fmt.Errorf("%s: failed to decode PEM bytes", HybridName())
+	}
+	if blk.Type != keyType {
+		return ErrPEMKeyTypeMismatch(blk.Type, keyType)
+	}
+	return fromBytes(blk.Bytes)
+}
+
+// FromPEMFile reads the HybridPrivateKey from a PEM file at path f.
+func (p *HybridPrivateKey) FromPEMFile(f string) error {
+	buf, err := ioutil.ReadFile(f)
+	if err != nil {
+		return err
+	}
+	err = p.FromPEM(buf)
+	if err != nil {
+		return fmt.Errorf("%w in file %s", err, f)
+	}
+	return nil
+}
+
+// PublicKey returns the public key associated
+// with the given private key.
+func (p *HybridPrivateKey) PublicKey() (*HybridPublicKey, error) {
+	if p.publicKey != nil {
+		pubKey := *p.publicKey
+		return &pubKey, nil
+	}
+	return DeriveHybridPublicKey(p)
+}
+
+// DeriveSecret derives a shared secret.
+func (p *HybridPrivateKey) DeriveSecret(publicKey *HybridPublicKey) ([]byte, error) {
+	return DeriveHybridSecret(p, publicKey)
+}
+
+// DeriveHybridPublicKey derives a hybrid public key given a hybrid
+// private key.
+func DeriveHybridPublicKey(privKey *HybridPrivateKey) (*HybridPublicKey, error) {
+	pubKey, err := DerivePublicKey(&privKey.privateKey)
+	if err != nil {
+		return nil, err
+	}
+	x25519Key, err := ecdh.X25519().NewPrivateKey(privKey.x25519[:])
+	if err != nil {
+		return nil, &Error{Op: "DeriveHybridPublicKey", Kind: ErrX25519, Err: err}
+	}
+	hybridKey := &HybridPublicKey{publicKey: *pubKey}
+	copy(hybridKey.x25519[:], x25519Key.PublicKey().Bytes())
+	return hybridKey, nil
+}
+
+// GenerateHybridKeyPair generates a new hybrid private and then
+// attempts to compute the hybrid public key.
+func GenerateHybridKeyPair() (*HybridPrivateKey, *HybridPublicKey, error) {
+	return generateHybridKeyPair("GenerateHybridKeyPair", rand.Reader)
+}
+
+// GenerateHybridKeyPairWithReader is like GenerateHybridKeyPair but
+// draws the randomness for both halves of the key from rng.
+func GenerateHybridKeyPairWithReader(rng io.Reader) (*HybridPrivateKey, *HybridPublicKey, error) {
+	return generateHybridKeyPair("GenerateHybridKeyPairWithReader", rng)
+}
+
+func generateHybridKeyPair(op string, rng io.Reader) (*HybridPrivateKey, *HybridPublicKey, error) {
+	privKey, pubKey, err := generateKeyPair(op, rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	// The X25519 key is read from rng directly, since
+	// ecdh.Curve.GenerateKey does not promise to use all of it.
+	hybridPrivKey := &HybridPrivateKey{privateKey: *privKey}
+	privKey.Reset()
+	if _, err := io.ReadFull(rng, hybridPrivKey.x25519[:]); err != nil {
+		hybridPrivKey.Reset()
+		return nil, nil, &Error{Op: op, Kind: ErrKeyGeneration, Err: err}
+	}
+	x25519Key, err := ecdh.X25519().NewPrivateKey(hybridPrivKey.x25519[:])
+	if err != nil {
+		hybridPrivKey.Reset()
+		return nil, nil, &Error{Op: op, Kind: ErrKeyGeneration, Err: err}
+	}
+
+	hybridPubKey := &HybridPublicKey{publicKey: *pubKey}
+	copy(hybridPubKey.x25519[:], x25519Key.PublicKey().Bytes())
+	hybridPrivKey.publicKey = hybridPubKey
+
+	pubKeyCopy := *hybridPubKey
+	return hybridPrivKey, &pubKeyCopy, nil
+}
+
+// DeriveHybridSecret derives a shared secret from the CTIDH and the
+// X25519 shared secrets. The combiner hashes both of them together
+// with the two public keys, sorted so that both sides agree on the
+// order, so the secret is bound to the whole exchange and is secure
+// as long as either CTIDH or X25519 is.
+func DeriveHybridSecret(privateKey *HybridPrivateKey, publicKey *HybridPublicKey) ([]byte, error) {
+	ctidhSecret, err := DeriveSecret(&privateKey.privateKey, &publicKey.publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	x25519Key, err := ecdh.X25519().NewPrivateKey(privateKey.x25519[:])
+	if err != nil {
+		return nil, &Error{Op: "DeriveHybridSecret", Kind: ErrX25519, Err: err}
+	}
+	x25519PubKey, err := ecdh.X25519().NewPublicKey(publicKey.x25519[:])
+	if err != nil {
+		return nil, &Error{Op: "DeriveHybridSecret", Kind: ErrX25519, Err: err}
+	}
+	x25519Secret, err := x25519Key.ECDH(x25519PubKey)
+	if err != nil {
+		return nil, &Error{Op: "DeriveHybridSecret", Kind: ErrX25519, Err: err}
+	}
+
+	ownPubKey, err := privateKey.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	return combineHybridSecrets(ctidhSecret, x25519Secret, ownPubKey, publicKey), nil
+}
+
+func combineHybridSecrets(ctidhSecret, x25519Secret []byte, publicKey1, publicKey2 *HybridPublicKey) []byte {
+	pub1, pub2 := publicKey1.Bytes(), publicKey2.Bytes()
+	if bytes.Compare(pub1, pub2) > 0 {
+		pub1, pub2 = pub2, pub1
+	}
+
+	h := sha3.NewShake256()
+	h.Write([]byte(HybridName() + " shared secret\x00"))
+	h.Write(ctidhSecret)
+	h.Write(x25519Secret)
+	h.Write(pub1)
+	h.Write(pub2)
+	secret := make([]byte, HybridSharedSecretSize)
+	h.Read(secret)
+	return secret
+}
+
+// BlindHybrid blinds both halves of a hybrid public key: the CTIDH
+// half like Blind, and the X25519 half by multiplying it with a
+// scalar derived from the blinding factor, which must be at least
+// MinSeedSize bytes long.
+//
+// Each half commutes with its key exchange, but DeriveHybridSecret
+// binds the public keys it is given into the secret, so unlike for
+// the plain CTIDH keys DeriveHybridSecret(a, BlindHybrid(f, B)) and
+// DeriveHybridSecret(b, BlindHybrid(f, A)) differ.
+func BlindHybrid(blindingFactor []byte, publicKey *HybridPublicKey) (*HybridPublicKey, error) {
+	if len(blindingFactor) < MinSeedSize {
+		return nil, ErrBlindDataSizeInvalid
+	}
+	blinded, err := Blind(blindingFactor, &publicKey.publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return blindX25519("BlindHybrid", blindingFactor, blinded, publicKey)
+}
+
+// BlindHybridLegacy is like BlindHybrid, but blinds the CTIDH half
+// with BlindLegacy, so the blinding factor must be PrivateKeySize
+// bytes long. It is kept only for keys blinded that way.
+//
+// Deprecated: Use BlindHybrid.
+func BlindHybridLegacy(blindingFactor []byte, publicKey *HybridPublicKey) (*HybridPublicKey, error) {
+	blinded, err := BlindLegacy(blindingFactor, &publicKey.publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return blindX25519("BlindHybridLegacy", blindingFactor, blinded, publicKey)
+}
+
+// blindX25519 blinds the X25519 half of publicKey and pairs it with
+// the already blinded CTIDH half.
+func blindX25519(op string, blindingFactor []byte, blinded *PublicKey, publicKey *HybridPublicKey) (*HybridPublicKey, error) {
+	scalar := make([]byte, x25519KeySize)
+	xof := sha3.NewShake256()
+	xof.Write([]byte(HybridName() + " X25519 blinding factor\x00"))
+	xof.Write(blindingFactor)
+	xof.Read(scalar)
+
+	x25519Key, err := ecdh.X25519().NewPrivateKey(scalar)
+	if err != nil {
+		return nil, &Error{Op: op, Kind: ErrX25519, Err: err}
+	}
+	x25519PubKey, err := ecdh.X25519().NewPublicKey(publicKey.x25519[:])
+	if err != nil {
+		return nil, &Error{Op: op, Kind: ErrX25519, Err: err}
+	}
+	x25519Blinded, err := x25519Key.ECDH(x25519PubKey)
+	if err != nil {
+		return nil, &Error{Op: op, Kind: ErrX25519, Err: err}
+	}
+
+	hybridKey := &HybridPublicKey{publicKey: *blinded}
+	copy(hybridKey.x25519[:], x25519Blinded)
+	return hybridKey, nil
+}
+
+// hybridScheme is the nike.Scheme of the hybrid of this CTIDH
+// parameter set and X25519.
+type hybridScheme struct{}
+
+var _ nike.Scheme = hybridScheme{}
+
+// HybridScheme returns the hybrid of this CTIDH parameter set and
+// X25519 as a nike.Scheme, whose keys are the *HybridPublicKey and
+// *HybridPrivateKey of this package.
+func HybridScheme() nike.Scheme {
+	return hybridScheme{}
+}
+
+func (hybridScheme) Name() string {
+	return HybridName()
+}
+
+func (hybridScheme) PublicKeySize() int {
+	return PublicKeySize + x25519KeySize
+}
+
+func (hybridScheme) PrivateKeySize() int {
+	return PrivateKeySize + x25519KeySize
+}
+
+func (hybridScheme) BlindingFactorSize() int {
+	return MinSeedSize
+}
+
+func (hybridScheme) GenerateKeyPair(rng io.Reader) (nike.PrivateKey, nike.PublicKey, error) {
+	privKey, pubKey, err := generateHybridKeyPair("GenerateHybridKeyPair", rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	return privKey, pubKey, nil
+}
+
+func (hybridScheme) NewEmptyPublicKey() nike.PublicKey {
+	return NewEmptyHybridPublicKey()
+}
+
+func (hybridScheme) NewEmptyPrivateKey() nike.PrivateKey {
+	return NewEmptyHybridPrivateKey()
+}
+
+func (hybridScheme) UnmarshalBinaryPublicKey(data []byte) (nike.PublicKey, error) {
+	pubKey, err := NewHybridPublicKey(data)
+	if err != nil {
+		return nil, err
+	}
+	return pubKey, nil
+}
+
+func (hybridScheme) UnmarshalBinaryPrivateKey(data []byte) (nike.PrivateKey, error) {
+	privKey := NewEmptyHybridPrivateKey()
+	if err := privKey.FromBytes(data); err != nil {
+		return nil, err
+	}
+	return privKey, nil
+}
+
+func (hybridScheme) DerivePublicKey(privateKey nike.PrivateKey) (nike.PublicKey, error) {
+	privKey, ok := privateKey.(*HybridPrivateKey)
+	if !ok {
+		return nil, nike.ErrKeyType
+	}
+	pubKey, err := privKey.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	return pubKey, nil
+}
+
+func (hybridScheme) DeriveSecret(privateKey nike.PrivateKey, publicKey nike.PublicKey) ([]byte, error) {
+	privKey, ok := privateKey.(*HybridPrivateKey)
+	if !ok {
+		return nil, nike.ErrKeyType
+	}
+	pubKey, ok := publicKey.(*HybridPublicKey)
+	if !ok {
+		return nil, nike.ErrKeyType
+	}
+	return DeriveHybridSecret(privKey, pubKey)
+}
+
+func (hybridScheme) Blind(blindingFactor []byte, publicKey nike.PublicKey) (nike.PublicKey, error) {
+	pubKey, ok := publicKey.(*HybridPublicKey)
+	if !ok {
+		return nil, nike.ErrKeyType
+	}
+	blinded, err := BlindHybrid(blindingFactor, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return blinded, nil
+}
@@ -0,0 +1,189 @@
+package ctidh
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/pem"
+	mrand "math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHybridNIKE(t *testing.T) {
+	alicePrivate, alicePublic, err := GenerateHybridKeyPair()
+	require.NoError(t, err)
+	bobPrivate, bobPublic, err := GenerateHybridKeyPair()
+	require.NoError(t, err)
+
+	aliceSecret, err := alicePrivate.DeriveSecret(bobPublic)
+	require.NoError(t, err)
+	bobSecret, err := bobPrivate.DeriveSecret(alicePublic)
+	require.NoError(t, err)
+	require.Equal(t, aliceSecret, bobSecret)
+	require.Len(t, aliceSecret, HybridSharedSecretSize)
+
+	// A key loaded from bytes computes its public key.
+	alicePrivate2 := NewEmptyHybridPrivateKey()
+	require.NoError(t, alicePrivate2.FromBytes(alicePrivate.Bytes()))
+	alicePublic2, err := alicePrivate2.PublicKey()
+	require.NoError(t, err)
+	require.True(t, alicePublic.Equal(alicePublic2))
+	aliceSecret2, err := DeriveHybridSecret(alicePrivate2, bobPublic)
+	require.NoError(t, err)
+	require.Equal(t, aliceSecret, aliceSecret2)
+
+	// The secret is bound to the public keys: a third party gets a
+	// different one, and so does the empty key.
+	_, carolPublic, err := GenerateHybridKeyPair()
+	require.NoError(t, err)
+	carolSecret, err := alicePrivate.DeriveSecret(carolPublic)
+	require.NoError(t, err)
+	require.NotEqual(t, aliceSecret, carolSecret)
+}
+
+func TestHybridKeyEncoding(t *testing.T) {
+	privateKey, publicKey, err := GenerateHybridKeyPair()
+	require.NoError(t, err)
+	require.Len(t, publicKey.Bytes(), PublicKeySize+32)
+	require.Len(t, privateKey.Bytes(), PrivateKeySize+32)
+
+	blk, err := publicKey.ToPEM()
+	require.NoError(t, err)
+	require.Equal(t, Name()+"-X25519 PUBLIC KEY", blk.Type)
+	blk, err = privateKey.ToPEM()
+	require.NoError(t, err)
+	require.Equal(t, Name()+"-X25519 PRIVATE KEY", blk.Type)
+
+	tmpdir := t.TempDir()
+	publicKeyFile := filepath.Join(tmpdir, "hybrid_public_key.pem")
+	require.NoError(t, publicKey.ToPEMFile(publicKeyFile))
+	publicKey2 := NewEmptyHybridPublicKey()
+	require.NoError(t, publicKey2.FromPEMFile(publicKeyFile))
+	require.True(t, publicKey.Equal(publicKey2))
+
+	privateKeyFile := filepath.Join(tmpdir, "hybrid_private_key.pem")
+	require.NoError(t, privateKey.ToPEMFile(privateKeyFile))
+	privateKey2 := NewEmptyHybridPrivateKey()
+	require.NoError(t, privateKey2.FromPEMFile(privateKeyFile))
+	require.True(t, privateKey.Equal(privateKey2))
+
+	// The plain CTIDH types don't accept hybrid PEM files.
+	err = NewEmptyPublicKey().FromPEMFile(publicKeyFile)
+	require.Error(t, err)
+
+	_, err = NewHybridPublicKey(publicKey.Bytes()[:PublicKeySize])
+	require.ErrorIs(t, err, ErrPublicKeySize)
+	require.Panics(t, func() { MustNewHybridPublicKey(publicKey.Bytes()[:PublicKeySize]) })
+	err = NewEmptyHybridPrivateKey().FromBytes(privateKey.Bytes()[:PrivateKeySize])
+	require.ErrorIs(t, err, ErrPrivateKeySize)
+
+	privateKey.Reset()
+	_, err = privateKey.ToPEM()
+	require.Error(t, err)
+}
+
+func TestHybridLowOrderX25519(t *testing.T) {
+	alicePrivate, _, err := GenerateHybridKeyPair()
+	require.NoError(t, err)
+	_, bobPublic, err := GenerateHybridKeyPair()
+	require.NoError(t, err)
+
+	lowOrder := bobPublic.Bytes()
+	copy(lowOrder[PublicKeySize:], make([]byte, 32))
+	_, err = alicePrivate.DeriveSecret(MustNewHybridPublicKey(lowOrder))
+	require.ErrorIs(t, err, ErrX25519)
+}
+
+func TestHybridBlinding(t *testing.T) {
+	_, publicKey, err := GenerateHybridKeyPair()
+	require.NoError(t, err)
+
+	factor := make([]byte, MinSeedSize)
+	_, err = rand.Read(factor)
+	require.NoError(t, err)
+
+	blinded, err := BlindHybrid(factor, publicKey)
+	require.NoError(t, err)
+	blindedCTIDH, err := Blind(factor, &publicKey.publicKey)
+	require.NoError(t, err)
+	require.Equal(t, blindedCTIDH.Bytes(), blinded.Bytes()[:PublicKeySize])
+	require.NotEqual(t, publicKey.Bytes()[PublicKeySize:], blinded.Bytes()[PublicKeySize:])
+
+	require.NoError(t, publicKey.Blind(factor))
+	require.True(t, publicKey.Equal(blinded))
+
+	_, err = BlindHybrid(factor[:MinSeedSize-1], publicKey)
+	require.ErrorIs(t, err, ErrBlindDataSizeInvalid)
+
+	// The legacy blinding differs only in the CTIDH half.
+	legacyFactor := make([]byte, PrivateKeySize)
+	_, err = rand.Read(legacyFactor)
+	require.NoError(t, err)
+	legacyCTIDH, err := BlindLegacy(legacyFactor, &publicKey.publicKey)
+	require.NoError(t, err)
+	legacyX25519, err := BlindHybrid(legacyFactor, publicKey)
+	require.NoError(t, err)
+	require.NoError(t, publicKey.BlindLegacy(legacyFactor))
+	require.Equal(t, legacyCTIDH.Bytes(), publicKey.Bytes()[:PublicKeySize])
+	require.Equal(t, legacyX25519.Bytes()[PublicKeySize:], publicKey.Bytes()[PublicKeySize:])
+	require.ErrorIs(t, publicKey.BlindLegacy(factor), ErrBlindDataSizeInvalid)
+}
+
+func TestHybridPrivateKeyValidation(t *testing.T) {
+	privateKey, _, err := GenerateHybridKeyPair()
+	require.NoError(t, err)
+	invalid := privateKey.Bytes()
+	copy(invalid, bytes.Repeat([]byte{127}, PrivateKeySize))
+
+	err = NewEmptyHybridPrivateKey().FromBytes(invalid)
+	require.ErrorIs(t, err, ErrPrivateKeyValidation)
+
+	// Legacy keys can still be loaded explicitly.
+	err = privateKey.FromBytesUnchecked(invalid)
+	require.NoError(t, err)
+	require.Equal(t, invalid, privateKey.Bytes())
+
+	blk, err := privateKey.ToPEM()
+	require.NoError(t, err)
+	pemBytes := pem.EncodeToMemory(blk)
+	privateKey2 := NewEmptyHybridPrivateKey()
+	err = privateKey2.FromPEM(pemBytes)
+	require.ErrorIs(t, err, ErrPrivateKeyValidation)
+	err = privateKey2.FromPEMUnchecked(pemBytes)
+	require.NoError(t, err)
+	require.True(t, privateKey.Equal(privateKey2))
+}
+
+func TestGenerateHybridKeyPairWithReader(t *testing.T) {
+	privateKey1, publicKey1, err := GenerateHybridKeyPairWithReader(mrand.New(mrand.NewSource(1)))
+	require.NoError(t, err)
+	privateKey2, publicKey2, err := GenerateHybridKeyPairWithReader(mrand.New(mrand.NewSource(1)))
+	require.NoError(t, err)
+	require.True(t, privateKey1.Equal(privateKey2))
+	require.True(t, publicKey1.Equal(publicKey2))
+}
+
+func TestHybridScheme(t *testing.T) {
+	scheme := HybridScheme()
+	require.Equal(t, Name()+"-X25519", scheme.Name())
+
+	alicePrivate, alicePublic, err := scheme.GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+	require.IsType(t, &HybridPrivateKey{}, alicePrivate)
+	require.Len(t, alicePublic.Bytes(), scheme.PublicKeySize())
+	require.Len(t, alicePrivate.Bytes(), scheme.PrivateKeySize())
+
+	bobPrivate, bobPublic, err := scheme.GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+	aliceSecret, err := scheme.DeriveSecret(alicePrivate, bobPublic)
+	require.NoError(t, err)
+	bobSecret, err := scheme.DeriveSecret(bobPrivate, alicePublic)
+	require.NoError(t, err)
+	require.Equal(t, aliceSecret, bobSecret)
+
+	_, plainPublic := MustGenerateKeyPair()
+	_, err = scheme.DeriveSecret(alicePrivate, plainPublic)
+	require.Error(t, err)
+}
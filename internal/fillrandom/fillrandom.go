@@ -0,0 +1,100 @@
+// Package fillrandom lets the vendored high-ctidh key generation
+// read its randomness from a Go io.Reader.
+//
+// It exports the C function
+//
+//	void ctidh_go_fillrandom(void *out, size_t outsz, uintptr_t context);
+//
+// which matches high-ctidh's ctidh_fillrandom callback type and
+// fills out from the reader registered under context. The callback
+// lives in this package so that it is linked exactly once, however
+// many of the ctidh packages are in a binary.
+package fillrandom
+
+// #include <stddef.h>
+// #include <stdint.h>
+import "C"
+
+import (
+	"encoding/binary"
+	"io"
+	"sync"
+	"unsafe"
+)
+
+type reader struct {
+	r   io.Reader
+	err error
+}
+
+var (
+	mu      sync.Mutex
+	next    uintptr
+	readers = make(map[uintptr]*reader)
+)
+
+// Register makes r available to ctidh_go_fillrandom and returns the
+// context to pass alongside it.
+func Register(r io.Reader) uintptr {
+	mu.Lock()
+	defer mu.Unlock()
+	next++
+	readers[next] = &reader{r: r}
+	return next
+}
+
+// Unregister forgets the reader registered under context and
+// returns the first error it returned, if any. When a read fails
+// the randomness handed to C is garbage, so the caller must discard
+// whatever it generated.
+func Unregister(context uintptr) error {
+	mu.Lock()
+	defer mu.Unlock()
+	rd := readers[context]
+	delete(readers, context)
+	if rd == nil {
+		return io.ErrClosedPipe
+	}
+	return rd.err
+}
+
+func lookup(context uintptr) *reader {
+	mu.Lock()
+	defer mu.Unlock()
+	return readers[context]
+}
+
+//export ctidh_go_fillrandom
+func ctidh_go_fillrandom(out unsafe.Pointer, outsz C.size_t, context C.uintptr_t) {
+	n := int(outsz)
+	if n == 0 {
+		return
+	}
+	buf := make([]byte, n)
+
+	rd := lookup(uintptr(context))
+	if rd != nil && rd.err == nil {
+		_, rd.err = io.ReadFull(rd.r, buf)
+	}
+	if rd == nil || rd.err != nil {
+		// The C sampler cannot be told to stop and would spin
+		// forever on constant input, so keep it going with
+		// distinct counter values rather than leaving any of
+		// the reader's bytes behind. The key is discarded.
+		for i := range buf {
+			buf[i] = 0
+		}
+		for i := 0; i+4 <= n; i += 4 {
+			binary.LittleEndian.PutUint32(buf[i:], uint32(i))
+		}
+	}
+
+	// high-ctidh consumes its randomness as native endian int32
+	// values; reading the stream as little endian ones makes keys
+	// reproducible across platforms and with the pure Go code.
+	dst := unsafe.Slice((*byte)(out), n)
+	for i := 0; i+4 <= n; i += 4 {
+		*(*uint32)(unsafe.Pointer(&dst[i])) = binary.LittleEndian.Uint32(buf[i:])
+	}
+	copy(dst[n&^3:], buf[n&^3:])
+}
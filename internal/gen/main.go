@@ -0,0 +1,150 @@
+// Command gen writes out the per parameter set subpackages
+// ctidh511, ctidh512, ctidh1024 and ctidh2048.
+//
+// Each subpackage is a copy of the root package's sources and tests
+// with the package clause rewritten and the parameter set build
+// constraints dropped, plus a bits.go fixing the parameter set and a
+// cgo.go carrying the #cgo directives which compile the high-ctidh
+// sources vendored alongside it. The root package remains the single
+// source of truth; run `go generate` from the repository root after
+// changing it.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+const header = "// Code generated by internal/gen; DO NOT EDIT.\n\n"
+
+var bitSizes = []int{511, 512, 1024, 2048}
+
+// sources are copied verbatim into every subpackage.
+var sources = []string{
+	"ctidh.go",
+	"errors.go",
+	"binding.go",
+	"purego.go",
+	"hybrid.go",
+	"scheme.go",
+	"representative.go",
+	"binding_test.go",
+	"binding_bench_test.go",
+	"blinding_test.go",
+	"scheme_test.go",
+	"hybrid_test.go",
+	"representative_test.go",
+}
+
+var bitsTemplate = template.Must(template.New("bits").Parse(header +
+	`package ctidh{{.Bits}}
+
+// bits is the CTIDH parameter set of this package.
+const bits = {{.Bits}}
+`))
+
+var cgoTemplate = template.Must(template.New("cgo").Parse(header +
+	`package ctidh{{.Bits}}
+
+// The high-ctidh sources for this parameter set are vendored into
+// this directory and compiled by cgo. amd64 uses the generated
+// assembly and AVX2 sorting network, which require a CPU with AVX2,
+// BMI2 and ADX; every other platform, or any build with the
+// ctidh_portable tag, uses the fiat-crypto field arithmetic instead.
+
+// #cgo CFLAGS: -DBITS={{.Bits}} -DCGONUTS -DGETRANDOM -I${SRCDIR}/..
+// #cgo CFLAGS: -O2 -fPIC -Wformat -Werror=format-security -D_FORTIFY_SOURCE=2 -fstack-protector-all
+// #cgo LDFLAGS: -Wl,-z,noexecstack -Wl,-z,relro
+// #cgo amd64,!ctidh_portable CFLAGS: -mavx2
+// #cgo !amd64 CFLAGS: -DHIGHCTIDH_PORTABLE
+// #cgo ctidh_portable CFLAGS: -DHIGHCTIDH_PORTABLE
+import "C"
+`))
+
+func main() {
+	for _, bits := range bitSizes {
+		if err := generate(bits); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+func generate(bits int) error {
+	pkg := fmt.Sprintf("ctidh%d", bits)
+	if err := os.MkdirAll(pkg, 0755); err != nil {
+		return err
+	}
+
+	for _, name := range sources {
+		if err := copySource(bits, name, name); err != nil {
+			return err
+		}
+	}
+	vectors := fmt.Sprintf("vectors%d_test.go", bits)
+	if err := copySource(bits, vectors, vectors); err != nil {
+		return err
+	}
+
+	if err := execute(bits, bitsTemplate, "bits.go"); err != nil {
+		return err
+	}
+	return execute(bits, cgoTemplate, "cgo.go")
+}
+
+func execute(bits int, tmpl *template.Template, dst string) error {
+	buf := new(bytes.Buffer)
+	err := tmpl.Execute(buf, struct{ Bits int }{bits})
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(fmt.Sprintf("ctidh%d", bits), dst)
+	return ioutil.WriteFile(path, buf.Bytes(), 0644)
+}
+
+func copySource(bits int, src, dst string) error {
+	raw, err := ioutil.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	out, err := rewrite(bits, string(raw))
+	if err != nil {
+		return fmt.Errorf("%s: %v", src, err)
+	}
+	path := filepath.Join(fmt.Sprintf("ctidh%d", bits), dst)
+	return ioutil.WriteFile(path, []byte(out), 0644)
+}
+
+// rewrite drops the build constraints selecting a parameter set,
+// keeping any others such as cgo, and renames the package.
+func rewrite(bits int, src string) (string, error) {
+	lines := strings.Split(src, "\n")
+	var constraints []string
+	for len(lines) > 0 && lines[0] != "package ctidh" {
+		line := lines[0]
+		lines = lines[1:]
+		switch {
+		case line == "":
+		case strings.HasPrefix(line, "//go:build"),
+			strings.HasPrefix(line, "// +build"):
+			if !strings.Contains(line, "bits") {
+				constraints = append(constraints, line)
+			}
+		default:
+			return "", fmt.Errorf("expected package clause")
+		}
+	}
+	if len(lines) == 0 {
+		return "", fmt.Errorf("expected package clause")
+	}
+	lines[0] = fmt.Sprintf("package ctidh%d", bits)
+	if len(constraints) > 0 {
+		lines = append(append(constraints, ""), lines...)
+	}
+	return header + strings.Join(lines, "\n"), nil
+}
@@ -0,0 +1,45 @@
+// Package multi_test checks that every parameter set
+// can be linked into and used from a single binary.
+package multi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"git.xx.network/elixxir/ctidh_cgo/ctidh1024"
+	"git.xx.network/elixxir/ctidh_cgo/ctidh2048"
+	"git.xx.network/elixxir/ctidh_cgo/ctidh511"
+	"git.xx.network/elixxir/ctidh_cgo/ctidh512"
+)
+
+func TestNames(t *testing.T) {
+	require.Equal(t, "CTIDH-511", ctidh511.Name())
+	require.Equal(t, "CTIDH-512", ctidh512.Name())
+	require.Equal(t, "CTIDH-1024", ctidh1024.Name())
+	require.Equal(t, "CTIDH-2048", ctidh2048.Name())
+}
+
+func TestKeySizes(t *testing.T) {
+	require.Equal(t, 64, ctidh511.PublicKeySize)
+	require.Equal(t, 64, ctidh512.PublicKeySize)
+	require.Equal(t, 128, ctidh1024.PublicKeySize)
+	require.Equal(t, 256, ctidh2048.PublicKeySize)
+}
+
+func TestMixedNIKE(t *testing.T) {
+	alice512Private, alice512Public := ctidh512.MustGenerateKeyPair()
+	bob512Private, bob512Public := ctidh512.MustGenerateKeyPair()
+	alice1024Private, alice1024Public := ctidh1024.MustGenerateKeyPair()
+	bob1024Private, bob1024Public := ctidh1024.MustGenerateKeyPair()
+
+	require.Equal(t,
+		ctidh512.MustDeriveSecret(alice512Private, bob512Public),
+		ctidh512.MustDeriveSecret(bob512Private, alice512Public))
+	require.Equal(t,
+		ctidh1024.MustDeriveSecret(alice1024Private, bob1024Public),
+		ctidh1024.MustDeriveSecret(bob1024Private, alice1024Public))
+
+	err := ctidh512.NewEmptyPublicKey().FromBytes(alice1024Public.Bytes())
+	require.Equal(t, ctidh512.ErrPublicKeySize, err)
+}
@@ -0,0 +1,22 @@
+package pipe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipe(t *testing.T) {
+	a, b := New()
+	message := []byte("message")
+	require.NoError(t, a.Send(message))
+	message[0] = 'M'
+	received, err := b.Receive()
+	require.NoError(t, err)
+	require.Equal(t, []byte("message"), received)
+
+	require.NoError(t, b.Send([]byte("reply")))
+	received, err = a.Receive()
+	require.NoError(t, err)
+	require.Equal(t, []byte("reply"), received)
+}
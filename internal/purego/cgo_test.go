@@ -0,0 +1,153 @@
+//go:build cgo
+// +build cgo
+
+package purego_test
+
+import (
+	"crypto/rand"
+	"io"
+	mrand "math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"git.xx.network/elixxir/ctidh_cgo/ctidh1024"
+	"git.xx.network/elixxir/ctidh_cgo/ctidh511"
+	"git.xx.network/elixxir/ctidh_cgo/ctidh512"
+	"git.xx.network/elixxir/ctidh_cgo/internal/purego"
+)
+
+// cgoNIKE is the subset of the cgo subpackages needed to compare them
+// against the pure Go implementation.
+type cgoNIKE struct {
+	params          *purego.Params
+	generateKeyPair func() (privateKey, publicKey []byte)
+	generateWithRNG func(rng io.Reader) (privateKey []byte)
+	deriveSecret    func(privateKey, publicKey []byte) []byte
+	validate        func(publicKey []byte) bool
+}
+
+var cgoNIKEs = []cgoNIKE{
+	{
+		params: purego.CTIDH511,
+		generateKeyPair: func() ([]byte, []byte) {
+			priv, pub := ctidh511.MustGenerateKeyPair()
+			return priv.Bytes(), pub.Bytes()
+		},
+		generateWithRNG: func(rng io.Reader) []byte {
+			priv, _, err := ctidh511.GenerateKeyPairWithReader(rng)
+			if err != nil {
+				panic(err)
+			}
+			return priv.Bytes()
+		},
+		deriveSecret: func(priv, pub []byte) []byte {
+			privKey := ctidh511.NewEmptyPrivateKey()
+			if err := privKey.FromBytes(priv); err != nil {
+				panic(err)
+			}
+			return ctidh511.MustDeriveSecret(privKey, ctidh511.MustNewPublicKey(pub))
+		},
+		validate: func(pub []byte) bool {
+			return ctidh511.NewEmptyPublicKey().FromBytes(pub) == nil
+		},
+	},
+	{
+		params: purego.CTIDH512,
+		generateKeyPair: func() ([]byte, []byte) {
+			priv, pub := ctidh512.MustGenerateKeyPair()
+			return priv.Bytes(), pub.Bytes()
+		},
+		generateWithRNG: func(rng io.Reader) []byte {
+			priv, _, err := ctidh512.GenerateKeyPairWithReader(rng)
+			if err != nil {
+				panic(err)
+			}
+			return priv.Bytes()
+		},
+		deriveSecret: func(priv, pub []byte) []byte {
+			privKey := ctidh512.NewEmptyPrivateKey()
+			if err := privKey.FromBytes(priv); err != nil {
+				panic(err)
+			}
+			return ctidh512.MustDeriveSecret(privKey, ctidh512.MustNewPublicKey(pub))
+		},
+		validate: func(pub []byte) bool {
+			return ctidh512.NewEmptyPublicKey().FromBytes(pub) == nil
+		},
+	},
+	{
+		params: purego.CTIDH1024,
+		generateKeyPair: func() ([]byte, []byte) {
+			priv, pub := ctidh1024.MustGenerateKeyPair()
+			return priv.Bytes(), pub.Bytes()
+		},
+		generateWithRNG: func(rng io.Reader) []byte {
+			priv, _, err := ctidh1024.GenerateKeyPairWithReader(rng)
+			if err != nil {
+				panic(err)
+			}
+			return priv.Bytes()
+		},
+		deriveSecret: func(priv, pub []byte) []byte {
+			privKey := ctidh1024.NewEmptyPrivateKey()
+			if err := privKey.FromBytes(priv); err != nil {
+				panic(err)
+			}
+			return ctidh1024.MustDeriveSecret(privKey, ctidh1024.MustNewPublicKey(pub))
+		},
+		validate: func(pub []byte) bool {
+			return ctidh1024.NewEmptyPublicKey().FromBytes(pub) == nil
+		},
+	},
+}
+
+func toExponents(b []byte) []int8 {
+	e := make([]int8, len(b))
+	for i := range b {
+		e[i] = int8(b[i])
+	}
+	return e
+}
+
+func fromExponents(e []int8) []byte {
+	b := make([]byte, len(e))
+	for i := range e {
+		b[i] = byte(e[i])
+	}
+	return b
+}
+
+func TestMatchesCgo(t *testing.T) {
+	for _, nike := range cgoNIKEs {
+		pr := nike.params
+
+		// Keys from the C library work with the Go implementation.
+		alicePrivate, alicePublic := nike.generateKeyPair()
+		require.True(t, pr.Validate(alicePublic))
+		derived := make([]byte, pr.PublicKeySize())
+		require.NoError(t, pr.CSIDH(derived, make([]byte, pr.PublicKeySize()), toExponents(alicePrivate)))
+		require.Equal(t, alicePublic, derived)
+
+		// Keys from the Go implementation work with the C library.
+		bob := make([]int8, pr.PrivateKeySize())
+		require.NoError(t, pr.GeneratePrivateKey(bob, rand.Reader))
+		bobPublic := make([]byte, pr.PublicKeySize())
+		pr.Action(bobPublic, make([]byte, pr.PublicKeySize()), bob)
+		require.True(t, nike.validate(bobPublic))
+
+		shared := make([]byte, pr.PublicKeySize())
+		require.NoError(t, pr.CSIDH(shared, alicePublic, bob))
+		require.Equal(t, nike.deriveSecret(alicePrivate, bobPublic), shared)
+		require.Equal(t, nike.deriveSecret(fromExponents(bob), alicePublic), shared)
+	}
+}
+
+func TestGeneratePrivateKeyMatchesCgo(t *testing.T) {
+	for _, nike := range cgoNIKEs {
+		pr := nike.params
+		e := make([]int8, pr.PrivateKeySize())
+		require.NoError(t, pr.GeneratePrivateKey(e, mrand.New(mrand.NewSource(1))))
+		require.Equal(t, nike.generateWithRNG(mrand.New(mrand.NewSource(1))), fromExponents(e))
+	}
+}
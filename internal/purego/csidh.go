@@ -0,0 +1,389 @@
+package purego
+
+import (
+	"math/big"
+)
+
+// randomFp returns a uniformly random field element.
+func (pr *Params) randomFp() fp {
+	f := pr.f
+	buf := make([]byte, 8*f.n)
+	for {
+		randomBytes(buf)
+		var x fp
+		pr.load(&x, buf)
+		if f.lessThanP(&x) == 1 {
+			return x
+		}
+	}
+}
+
+// elligator returns a random point plus on the curve a and a random
+// point minus on its twist.
+func (pr *Params) elligator(plus, minus *proj, a *proj) {
+	f := pr.f
+	for {
+		u := pr.randomFp()
+		if f.isZero(&u) == 1 {
+			continue // bad RNG outputs 0
+		}
+
+		var u2, d fp
+		f.sq(&u2, &u)
+		f.sub(&d, &u2, &f.one)
+		if f.isZero(&d) == 1 {
+			continue // bad RNG outputs +-1
+		}
+
+		var m, t fp
+		f.mul(&m, &a.x, &u2) // M = u^2 A.x
+		f.mul(&t, &a.x, &m)  // T = u^2 A.x^2
+
+		control := f.isZero(&a.x)
+		p := a.x
+		f.cmov(&p, &f.one, control) // P = 1 if A.x = 0 else A.x
+		f.cmov(&m, &f.one, control) // M = 1 if A.x = 0 else u^2 A.x
+		f.cmov(&t, &f.one, control) // T = 1 if A.x = 0
+
+		f.mul(&d, &d, &a.z) // D = (u^2-1) A.z
+
+		var d2 fp
+		f.sq(&d2, &d)
+		f.add(&t, &t, &d2)
+		f.mul(&t, &t, &d)
+		f.mul(&t, &t, &p)
+		// T = Az^4 (1-u^2)^4 ((P/D)^3+A(P/D)^2+(P/D)), so its
+		// squareness says whether P/D is on the curve, and
+		// -M/D = -P/D-A is then on the twist.
+
+		plus.x = p
+		f.neg(&minus.x, &m)
+		f.cswap(&plus.x, &minus.x, 1-f.isSquare(&t))
+
+		plus.z = d
+		minus.z = d
+		return
+	}
+}
+
+// clearPublicPrimes multiplies p by 4 and by every prime outside
+// the batches being processed.
+func (pr *Params) clearPublicPrimes(p, a24 *proj, outsideblock []bool) {
+	f := pr.f
+	f.xDBL(p, p, a24, false)
+	f.xDBL(p, p, a24, false)
+
+	nb := len(pr.batchsize)
+	for j := pr.batchstop[nb-1]; j < int64(len(pr.primes)); j++ {
+		f.xMULdac(p, a24, false, p, pr.dac[j], pr.daclen[j], pr.daclen[j])
+	}
+
+	for i := 0; i < nb; i++ {
+		if outsideblock[i] {
+			for j := pr.batchstart[i]; j < pr.batchstop[i]; j++ {
+				f.xMULdac(p, a24, false, p, pr.dac[j], pr.daclen[j], pr.daclen[j])
+			}
+		}
+	}
+}
+
+// action returns the coefficient of the curve reached from in by the
+// group action of the exponent vector e. It follows action in
+// csidh.c step by step.
+func (pr *Params) action(in *fp, e []int8) fp {
+	f := pr.f
+	nb := len(pr.batchsize)
+	np := len(pr.primes)
+
+	a := proj{x: *in, z: f.one}
+	var a24 proj
+	f.xA24(&a24, &a)
+
+	batchtodo := make([]int64, nb)
+	var batchtodosum int64
+	for i := 0; i < nb; i++ {
+		batchtodo[i] = pr.batchbound[i]
+		batchtodosum += batchtodo[i]
+	}
+
+	todonegativemask := make([]int64, np) // -1 for negative exponent, else 0
+	todo := make([]int64, np)             // absolute value of exponent
+	for i := 0; i < np; i++ {
+		ei := int64(e[i])
+		todonegativemask[i] = int64MaskNegative(ei)
+		ei ^= todonegativemask[i] & (ei ^ -ei)
+		todo[i] = ei
+	}
+
+	target := make([]int64, nb)
+	targetstart := make([]int64, nb)
+	targetstop := make([]int64, nb)
+	targetmaxdaclen := make([]int64, nb)
+	targetmask := make([]int64, nb)
+	targetindex := make([]int64, nb)
+	targetprime := make([]int64, nb)
+	targetnegative := make([]int64, nb)
+	targetdac := make([]int64, nb)
+	targetdaclen := make([]int64, nb)
+	shuffleprimedac := make([]int64, np)
+	shuffleprimedaclen := make([]int64, np)
+	outsideblock := make([]bool, nb)
+
+	for batchtodosum > 0 {
+		// each target is a batch with batchtodo>0
+		targetlen := 0
+		for b := 0; b < nb; b++ {
+			if batchtodo[b] != 0 {
+				target[targetlen] = int64(b)
+				targetlen++
+			}
+		}
+
+		// trying to optimize order of targets
+		if targetlen > 3 {
+			for i := 0; i < targetlen-2; i++ {
+				j := targetlen - 3 - i
+				if i < j {
+					target[i], target[j] = target[j], target[i]
+				}
+			}
+			// order now looks like 5 4 3 2 1 0 6 7
+			target[0], target[targetlen-2] = target[targetlen-2], target[0]
+			// order now looks like 6 4 3 2 1 0 5 7
+		}
+
+		for i := 0; i < targetlen; i++ {
+			b := target[i]
+			targetstart[i] = pr.batchstart[b]
+			targetstop[i] = pr.batchstop[b]
+			targetmaxdaclen[i] = pr.batchmaxdaclen[b]
+		}
+
+		// targetmask[i],targetindex[i],targetprime[i],targetnegative[i] are
+		// 0,primes[targetstart[i]],1,0 if all todo[j] in target i are 0
+		// -1,j,primes[j],0 if first nonzero todo[j] is positive
+		// -1,j,primes[j],-1 if first nonzero todo[j] is negative
+		for i := 0; i < targetlen; i++ {
+			s := targetstart[i]
+			targetmask[i] = 0
+			targetindex[i] = s
+			targetprime[i] = pr.primes[s]
+			targetdac[i] = pr.dac[s]
+			targetdaclen[i] = pr.daclen[s]
+			targetnegative[i] = 0
+			for j := s; j < targetstop[i]; j++ {
+				updatemask := int64MaskNonzero(todo[j])
+				updatemask &= ^targetmask[i]
+				targetnegative[i] ^= updatemask & todonegativemask[j]
+				targetindex[i] ^= updatemask & (targetindex[i] ^ j)
+				targetprime[i] ^= updatemask & (targetprime[i] ^ pr.primes[j])
+				targetdac[i] ^= updatemask & (targetdac[i] ^ pr.dac[j])
+				targetdaclen[i] ^= updatemask & (targetdaclen[i] ^ pr.daclen[j])
+				targetmask[i] ^= updatemask
+			}
+		}
+
+		// shuffle means: selected prime is at beginning of each batch
+		for i := 0; i < targetlen; i++ {
+			for j := targetstart[i] + 1; j < targetstop[i]; j++ {
+				moveright := ^int64MaskNegative(targetindex[i] - j)
+				shuffleprimedac[j] = pr.dac[j] ^ (moveright & (pr.dac[j] ^ pr.dac[j-1]))
+				shuffleprimedaclen[j] = pr.daclen[j] ^ (moveright & (pr.daclen[j] ^ pr.daclen[j-1]))
+			}
+			shuffleprimedac[targetstart[i]] = targetdac[i]
+			shuffleprimedaclen[targetstart[i]] = targetdaclen[i]
+		}
+
+		// batchtodo[i] will change while block is processed
+		for i := 0; i < nb; i++ {
+			outsideblock[i] = batchtodo[i] == 0
+		}
+
+		var p [2]proj
+		pr.elligator(&p[0], &p[1], &a)
+
+		for i := 0; i < targetlen; i++ {
+			primelowerbound := pr.primes[targetstart[i]]
+
+			// p[0] on curve, p[1] on twist
+			f.projCswap(&p[0], &p[1], uint64(-targetnegative[i]))
+			if i == 0 {
+				// p[0] just came out of elligator; clear irrelevant primes
+				pr.clearPublicPrimes(&p[0], &a24, outsideblock)
+				for t := 0; t < targetlen; t++ {
+					for j := targetstart[t] + 1; j < targetstop[t]; j++ {
+						f.xMULdac(&p[0], &a24, false, &p[0], shuffleprimedac[j], shuffleprimedaclen[j], targetmaxdaclen[t])
+					}
+				}
+				// will replace p[1] before it is used so skip it here
+			}
+
+			k := p[0]
+			for j := i + 1; j < targetlen; j++ {
+				f.xMULdac(&k, &a24, false, &k, targetdac[j], targetdaclen[j], targetmaxdaclen[j])
+			}
+
+			// maskrightorder is 1 with probability 1-1/targetprime[i],
+			// the coin brings it down to 1-1/primelowerbound
+			maskrightorder := 1 ^ f.isZero(&k.z)
+			maskrightorder &= randomCoin(
+				uint64(targetprime[i]*(primelowerbound-1)),
+				uint64(primelowerbound*(targetprime[i]-1)))
+
+			maskisogeny := maskrightorder & uint64(-targetmask[i])
+
+			if i == targetlen-2 && targetlen > 2 {
+				// push only one point through second-to-last isogeny
+				// namely the one with sign matching the last isogeny
+				f.projCmov(&p[0], &p[1], uint64(-(targetnegative[i+1] ^ targetnegative[i])))
+			}
+
+			if maskrightorder != 0 {
+				anew := a
+				pnew := [2]proj{p[0], p[1]}
+				var pnewlen int
+				switch {
+				case i == targetlen-1:
+					pnewlen = 0 // skip pushing points through last isogeny
+				case i == 0:
+					pnewlen = 1 // will replace second point
+				default:
+					pnewlen = 2
+				}
+				if i == targetlen-2 && targetlen > 2 {
+					pnewlen = 1
+				}
+
+				f.xISOGmatryoshka(&anew, pnew[:pnewlen], &k, targetprime[i], pr.primes[targetstop[i]-1])
+
+				f.projCmov(&a, &anew, maskisogeny)
+				f.xA24(&a24, &a)
+				if pnewlen > 0 {
+					f.projCmov(&p[0], &pnew[0], maskisogeny)
+				}
+				if pnewlen > 1 {
+					f.projCmov(&p[1], &pnew[1], maskisogeny)
+				}
+			}
+
+			if i == 0 {
+				// generate independent point on second curve
+				// or on twist, opposite of first
+				var plus proj
+				pr.elligator(&plus, &p[1], &a)
+				f.projCswap(&plus, &p[1], uint64(-targetnegative[i]))
+				pr.clearPublicPrimes(&p[1], &a24, outsideblock)
+				for t := 0; t < targetlen; t++ {
+					for j := targetstart[t] + 1; j < targetstop[t]; j++ {
+						f.xMULdac(&p[1], &a24, false, &p[1], shuffleprimedac[j], shuffleprimedaclen[j], targetmaxdaclen[t])
+					}
+				}
+			}
+
+			if i == targetlen-2 && targetlen > 2 {
+				f.xMULdac(&p[0], &a24, false, &p[0], targetdac[i], targetdaclen[i], targetmaxdaclen[i])
+				p[1] = p[0]
+			} else if i < targetlen-1 {
+				f.projCswap(&p[0], &p[1], uint64(-targetnegative[i]))
+				// now back to: p[0] on curve, p[1] on twist
+				f.xMULdac(&p[0], &a24, false, &p[0], targetdac[i], targetdaclen[i], targetmaxdaclen[i])
+				f.xMULdac(&p[1], &a24, false, &p[1], targetdac[i], targetdaclen[i], targetmaxdaclen[i])
+			}
+
+			for j := targetstart[i]; j < targetstop[i]; j++ {
+				todo[j] += int64(-maskisogeny) & int64MaskEqual(j, targetindex[i])
+			}
+
+			batchtodo[target[i]] -= int64(maskrightorder)
+			batchtodosum -= int64(maskrightorder)
+		}
+	}
+
+	f.invert(&a.z, &a.z)
+	f.mul(&a.x, &a.x, &a.z)
+	return a.x
+}
+
+// validateRec is validate_rec from validate.c. It returns 1 once
+// order exceeds 4 sqrt(p), -1 if the curve is shown to be ordinary
+// and 0 if the point p did not have large enough order to decide.
+func (pr *Params) validateRec(p, a *proj, lower, upper int, order *big.Int, criticaltestdone *bool) int {
+	f := pr.f
+	var q, a24 proj
+	f.xA24(&a24, a)
+
+	if upper-lower == 1 {
+		// now p is [(p+1) / l_lower] times the original random point
+		if f.isZero(&p.z) == 1 {
+			return 0
+		}
+
+		if !*criticaltestdone {
+			// is original point times p+1 the identity? test this via first l that we see
+			f.xMULdac(&q, &a24, true, p, pr.dac[lower], pr.daclen[lower], pr.daclen[lower])
+			if f.isZero(&q.z) == 0 {
+				return -1
+			}
+			*criticaltestdone = true
+		}
+
+		order.Mul(order, big.NewInt(pr.primes[lower]))
+		if order.Cmp(f.fourSqrtP) > 0 {
+			return 1
+		}
+		return 0
+	}
+
+	mid := lower + (upper-lower+1)/2
+
+	q = *p
+	for i := lower; i < mid; i++ {
+		f.xMULdac(&q, &a24, true, &q, pr.dac[i], pr.daclen[i], pr.daclen[i])
+	}
+	if result := pr.validateRec(&q, a, mid, upper, order, criticaltestdone); result != 0 {
+		return result
+	}
+
+	q = *p
+	for i := mid; i < upper; i++ {
+		f.xMULdac(&q, &a24, true, &q, pr.dac[i], pr.daclen[i], pr.daclen[i])
+	}
+	return pr.validateRec(&q, a, lower, mid, order, criticaltestdone)
+}
+
+// validate reports whether a is the coefficient of a supersingular
+// curve, following validate in validate.c.
+func (pr *Params) validate(a *fp) bool {
+	f := pr.f
+	if f.lessThanP(a) == 0 {
+		return false // A >= p
+	}
+	if f.isEqual(a, &f.two) == 1 {
+		return false // A = 2
+	}
+	var t fp
+	f.add(&t, &f.two, a)
+	if f.isZero(&t) == 1 {
+		return false // A = -2
+	}
+
+	aproj := proj{x: *a, z: f.one}
+	var a24 proj
+	f.xA24(&a24, &aproj)
+	for {
+		p := proj{x: pr.randomFp(), z: f.one}
+		criticaltestdone := false
+
+		// maximal 2-power in p+1
+		f.xDBL(&p, &p, &a24, true)
+		f.xDBL(&p, &p, &a24, true)
+
+		switch pr.validateRec(&p, &aproj, 0, len(pr.primes), big.NewInt(1), &criticaltestdone) {
+		case 1:
+			return true
+		case -1:
+			return false
+		}
+		// p didn't have big enough order to prove supersingularity
+	}
+}
@@ -0,0 +1,252 @@
+package purego
+
+import (
+	"encoding/binary"
+	"math/big"
+	"math/bits"
+)
+
+// maxLimbs is the number of 64-bit limbs of the largest
+// supported field, CTIDH-2048.
+const maxLimbs = 32
+
+// fp is an element of the prime field in Montgomery form. Only
+// the first field.n limbs are used, the rest are always zero.
+type fp [maxLimbs]uint64
+
+// field holds the constants for arithmetic modulo p, mirroring the
+// fp_* and uintbig_* constants of the C sources.
+type field struct {
+	n int // limbs in use
+
+	p   fp
+	inv uint64 // -p^-1 mod 2^64
+
+	zero fp
+	one  fp // R mod p
+	two  fp // 2R mod p
+
+	invExp  []uint64 // p-2
+	sqrtExp []uint64 // (p+1)/4
+
+	fourSqrtP *big.Int // floor(sqrt(16p)), aka uintbig_four_sqrt_p
+}
+
+func newField(p *big.Int, n int) *field {
+	f := &field{n: n}
+	toFp(&f.p, p)
+
+	// Newton iteration for p^-1 mod 2^64.
+	inv := uint64(1)
+	for i := 0; i < 6; i++ {
+		inv *= 2 - f.p[0]*inv
+	}
+	f.inv = -inv
+
+	r := new(big.Int).Lsh(big.NewInt(1), uint(64*n))
+	toFp(&f.one, new(big.Int).Mod(r, p))
+	toFp(&f.two, new(big.Int).Mod(new(big.Int).Lsh(r, 1), p))
+
+	f.invExp = limbs(new(big.Int).Sub(p, big.NewInt(2)), n)
+	f.sqrtExp = limbs(new(big.Int).Rsh(new(big.Int).Add(p, big.NewInt(1)), 2), n)
+	f.fourSqrtP = new(big.Int).Sqrt(new(big.Int).Lsh(p, 4))
+	return f
+}
+
+// limbs returns the n little-endian 64-bit limbs of x.
+func limbs(x *big.Int, n int) []uint64 {
+	buf := x.FillBytes(make([]byte, 8*n))
+	out := make([]uint64, n)
+	for i := range out {
+		out[i] = binary.BigEndian.Uint64(buf[len(buf)-8*(i+1):])
+	}
+	return out
+}
+
+// toFp writes the little-endian limbs of x into z.
+func toFp(z *fp, x *big.Int) {
+	*z = fp{}
+	copy(z[:], limbs(x, maxLimbs))
+}
+
+// fromFp returns the first n limbs of x as an integer.
+func fromFp(x *fp, n int) *big.Int {
+	buf := make([]byte, 8*n)
+	for i := 0; i < n; i++ {
+		binary.BigEndian.PutUint64(buf[len(buf)-8*(i+1):], x[i])
+	}
+	return new(big.Int).SetBytes(buf)
+}
+
+// mask returns all ones if b is 1 and zero if b is 0.
+func mask(b uint64) uint64 {
+	return -b
+}
+
+// cmov sets z to x if b is 1 and leaves it alone if b is 0.
+func (f *field) cmov(z, x *fp, b uint64) {
+	m := mask(b)
+	for i := 0; i < f.n; i++ {
+		z[i] ^= m & (z[i] ^ x[i])
+	}
+}
+
+// cswap swaps x and y if b is 1 and leaves them alone if b is 0.
+func (f *field) cswap(x, y *fp, b uint64) {
+	m := mask(b)
+	for i := 0; i < f.n; i++ {
+		t := m & (x[i] ^ y[i])
+		x[i] ^= t
+		y[i] ^= t
+	}
+}
+
+// isZero returns 1 if x is zero and 0 otherwise.
+func (f *field) isZero(x *fp) uint64 {
+	var acc uint64
+	for i := 0; i < f.n; i++ {
+		acc |= x[i]
+	}
+	return 1 ^ ((acc | -acc) >> 63)
+}
+
+// isEqual returns 1 if x equals y and 0 otherwise.
+func (f *field) isEqual(x, y *fp) uint64 {
+	var acc uint64
+	for i := 0; i < f.n; i++ {
+		acc |= x[i] ^ y[i]
+	}
+	return 1 ^ ((acc | -acc) >> 63)
+}
+
+// lessThanP returns 1 if the integer x is less than p and 0
+// otherwise.
+func (f *field) lessThanP(x *fp) uint64 {
+	var borrow uint64
+	for i := 0; i < f.n; i++ {
+		_, borrow = bits.Sub64(x[i], f.p[i], borrow)
+	}
+	return borrow
+}
+
+// add sets z = x + y.
+func (f *field) add(z, x, y *fp) {
+	var t fp
+	var carry, borrow uint64
+	for i := 0; i < f.n; i++ {
+		t[i], carry = bits.Add64(x[i], y[i], carry)
+	}
+	var d fp
+	for i := 0; i < f.n; i++ {
+		d[i], borrow = bits.Sub64(t[i], f.p[i], borrow)
+	}
+	// Keep t if x + y < p, that is if the subtraction borrowed
+	// beyond the carry out of the addition.
+	_, borrow = bits.Sub64(carry, 0, borrow)
+	f.cmov(&d, &t, borrow)
+	*z = d
+}
+
+// sub sets z = x - y.
+func (f *field) sub(z, x, y *fp) {
+	var t fp
+	var borrow, carry uint64
+	for i := 0; i < f.n; i++ {
+		t[i], borrow = bits.Sub64(x[i], y[i], borrow)
+	}
+	m := mask(borrow)
+	for i := 0; i < f.n; i++ {
+		t[i], carry = bits.Add64(t[i], f.p[i]&m, carry)
+	}
+	*z = t
+}
+
+// neg sets z = -x.
+func (f *field) neg(z, x *fp) {
+	f.sub(z, &f.zero, x)
+}
+
+// mul sets z = x * y / R using word-by-word Montgomery reduction.
+func (f *field) mul(z, x, y *fp) {
+	n := f.n
+	var tt [maxLimbs + 2]uint64
+	t := tt[:n+2]
+	xs, ys, ps := x[:n], y[:n], f.p[:n]
+	for _, xi := range xs {
+		var c, hi, lo, cc uint64
+		for j, yj := range ys {
+			hi, lo = bits.Mul64(xi, yj)
+			lo, cc = bits.Add64(lo, t[j], 0)
+			hi += cc
+			lo, cc = bits.Add64(lo, c, 0)
+			hi += cc
+			t[j] = lo
+			c = hi
+		}
+		t[n], cc = bits.Add64(t[n], c, 0)
+		t[n+1] = cc
+
+		m := t[0] * f.inv
+		hi, lo = bits.Mul64(m, ps[0])
+		_, cc = bits.Add64(lo, t[0], 0)
+		c = hi + cc
+		for j := 1; j < n; j++ {
+			hi, lo = bits.Mul64(m, ps[j])
+			lo, cc = bits.Add64(lo, t[j], 0)
+			hi += cc
+			lo, cc = bits.Add64(lo, c, 0)
+			hi += cc
+			t[j-1] = lo
+			c = hi
+		}
+		t[n-1], cc = bits.Add64(t[n], c, 0)
+		t[n] = t[n+1] + cc
+	}
+
+	// t < 2p, subtract p once if needed.
+	var d fp
+	var borrow uint64
+	for i, pi := range ps {
+		d[i], borrow = bits.Sub64(t[i], pi, borrow)
+	}
+	_, borrow = bits.Sub64(t[n], 0, borrow)
+	m := mask(borrow)
+	zs := z[:n]
+	for i := range zs {
+		zs[i] = d[i] ^ (m & (d[i] ^ t[i]))
+	}
+}
+
+// sq sets z = x^2.
+func (f *field) sq(z, x *fp) {
+	f.mul(z, x, x)
+}
+
+// exp sets z = x^e for a public exponent e.
+func (f *field) exp(z, x *fp, e []uint64) {
+	base := *x
+	r := f.one
+	for i := len(e) - 1; i >= 0; i-- {
+		for j := 63; j >= 0; j-- {
+			f.sq(&r, &r)
+			if (e[i]>>uint(j))&1 == 1 {
+				f.mul(&r, &r, &base)
+			}
+		}
+	}
+	*z = r
+}
+
+// invert sets z = 1/x, or zero if x is zero.
+func (f *field) invert(z, x *fp) {
+	f.exp(z, x, f.invExp)
+}
+
+// isSquare returns 1 if x is a square, including zero, and 0
+// otherwise, like the return value of fp_sqrt.
+func (f *field) isSquare(x *fp) uint64 {
+	var r fp
+	f.exp(&r, x, f.sqrtExp)
+	f.sq(&r, &r)
+	return f.isEqual(&r, x)
+}
@@ -0,0 +1,91 @@
+package purego
+
+import (
+	"crypto/rand"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func randomBig(t *testing.T, p *big.Int) *big.Int {
+	x, err := rand.Int(rand.Reader, p)
+	require.NoError(t, err)
+	return x
+}
+
+func TestFieldArithmetic(t *testing.T) {
+	for _, pr := range []*Params{CTIDH511, CTIDH512, CTIDH1024, CTIDH2048} {
+		f := pr.f
+		p := fromFp(&f.p, f.n)
+		r := new(big.Int).Lsh(big.NewInt(1), uint(64*f.n))
+		rInv := new(big.Int).ModInverse(r, p)
+
+		for i := 0; i < 20; i++ {
+			a, b := randomBig(t, p), randomBig(t, p)
+			var x, y, z fp
+			toFp(&x, a)
+			toFp(&y, b)
+
+			f.add(&z, &x, &y)
+			want := new(big.Int).Add(a, b)
+			require.Equal(t, want.Mod(want, p), fromFp(&z, f.n))
+
+			f.sub(&z, &x, &y)
+			want = new(big.Int).Sub(a, b)
+			require.Equal(t, want.Mod(want, p), fromFp(&z, f.n))
+
+			f.mul(&z, &x, &y)
+			want = new(big.Int).Mul(a, b)
+			want.Mul(want, rInv)
+			require.Equal(t, want.Mod(want, p), fromFp(&z, f.n))
+
+			// Montgomery inverse: (aR)^-1 R^2 = a^-1 R.
+			f.invert(&z, &x)
+			f.mul(&z, &z, &x)
+			require.Equal(t, f.one, z)
+
+			f.sq(&z, &x)
+			require.Equal(t, uint64(1), f.isSquare(&z))
+		}
+
+		// -1 is not a square since p = 3 mod 4.
+		var minusOne fp
+		f.neg(&minusOne, &f.one)
+		require.Equal(t, uint64(0), f.isSquare(&minusOne))
+		require.Equal(t, uint64(1), f.isSquare(&f.zero))
+	}
+}
+
+func TestFieldReducesNearP(t *testing.T) {
+	f := CTIDH2048.f
+	p := fromFp(&f.p, f.n)
+	pm1 := new(big.Int).Sub(p, big.NewInt(1))
+
+	var x, z fp
+	toFp(&x, pm1)
+	f.add(&z, &x, &x)
+	require.Equal(t, new(big.Int).Sub(p, big.NewInt(2)), fromFp(&z, f.n))
+	f.add(&z, &x, &f.one)
+	require.Equal(t, new(big.Int).Sub(fromFp(&f.one, f.n), big.NewInt(1)), fromFp(&z, f.n))
+	f.mul(&z, &x, &x)
+	require.Equal(t, uint64(1), f.lessThanP(&z))
+}
+
+func TestInt32Sort(t *testing.T) {
+	buf := make([]byte, 4*254)
+	for n := 0; n <= 254; n += 23 {
+		_, err := rand.Read(buf)
+		require.NoError(t, err)
+		x := make([]int32, n)
+		for i := range x {
+			x[i] = int32(uint32(buf[4*i]) | uint32(buf[4*i+1])<<8 |
+				uint32(buf[4*i+2])<<16 | uint32(buf[4*i+3])<<24)
+		}
+		want := append([]int32{}, x...)
+		sort.Slice(want, func(i, j int) bool { return want[i] < want[j] })
+		int32Sort(x)
+		require.Equal(t, want, x)
+	}
+}
@@ -0,0 +1,241 @@
+package purego
+
+// proj is a projective x-coordinate (X:Z) on a Montgomery curve, or
+// a projective curve coefficient (A:C).
+type proj struct {
+	x, z fp
+}
+
+func (f *field) projCmov(p, q *proj, b uint64) {
+	f.cmov(&p.x, &q.x, b)
+	f.cmov(&p.z, &q.z, b)
+}
+
+func (f *field) projCswap(p, q *proj, b uint64) {
+	f.cswap(&p.x, &q.x, b)
+	f.cswap(&p.z, &q.z, b)
+}
+
+// xA24 precomputes A24.x = A.x+2*A.z, A24.z = 4*A.z.
+func (f *field) xA24(a24, a *proj) {
+	var t proj
+	f.add(&t.x, &a.z, &a.z)
+	f.add(&t.z, &t.x, &t.x)
+	f.add(&t.x, &t.x, &a.x)
+	*a24 = t
+}
+
+func (f *field) x2(p2, p *proj) {
+	var t proj
+	f.add(&t.x, &p.x, &p.z)
+	f.sq(&t.x, &t.x)
+	f.sub(&t.z, &p.x, &p.z)
+	f.sq(&t.z, &t.z)
+	*p2 = t
+}
+
+func (f *field) x2DBL(q, p2, a24 *proj, affine bool) {
+	var a, b, c fp
+	f.sub(&c, &p2.x, &p2.z)
+	if affine {
+		f.add(&b, &p2.z, &p2.z)
+		f.add(&b, &b, &b)
+	} else {
+		f.mul(&b, &a24.z, &p2.z)
+	}
+	f.mul(&q.x, &p2.x, &b)
+	f.mul(&a, &c, &a24.x)
+	f.add(&a, &a, &b)
+	f.mul(&q.z, &a, &c)
+}
+
+func (f *field) xDBL(q, p, a24 *proj, affine bool) {
+	var p2 proj
+	f.x2(&p2, p)
+	f.x2DBL(q, &p2, a24, affine)
+}
+
+func (f *field) xADD(s, p, q, pq *proj) {
+	var a, b, c, d fp
+	f.add(&a, &p.x, &p.z)
+	f.sub(&b, &p.x, &p.z)
+	f.add(&c, &q.x, &q.z)
+	f.sub(&d, &q.x, &q.z)
+	f.mul(&a, &a, &d)
+	f.mul(&b, &b, &c)
+	f.add(&c, &a, &b)
+	f.sub(&d, &a, &b)
+	f.sq(&c, &c)
+	f.sq(&d, &d)
+	pqx, pqz := pq.x, pq.z
+	f.mul(&s.x, &pqz, &c)
+	f.mul(&s.z, &pqx, &d)
+}
+
+// xMULdac sets q to a point having the same order as l*p, where the
+// prime l has the differential addition chain dac of length daclen
+// and 0 <= daclen <= maxdaclen. The running time depends on maxdaclen
+// but not on dac or daclen. q may alias p.
+func (f *field) xMULdac(q, a24 *proj, affine bool, p *proj, dac, daclen, maxdaclen int64) {
+	pinput := *p
+	p1 := pinput
+	var p2, p3 proj
+	f.xDBL(&p2, &p1, a24, affine)
+	f.xADD(&p3, &p2, &p1, &p1)
+	collision := f.isZero(&pinput.z)
+
+	for {
+		want := uint64(1 + int64MaskNegative(daclen))
+		f.projCmov(q, &p3, want)
+		if maxdaclen <= 0 {
+			break
+		}
+
+		// invariant: p1+p2 = p3
+		// odd dac: replace p1,p2,p3 with p1,p3,p1+p3
+		// even dac: replace p1,p2,p3 with p2,p3,p2+p3
+		f.projCswap(&p1, &p2, uint64(1-(dac&1)))
+		collision |= want & f.isZero(&p2.z)
+
+		var next proj
+		f.xADD(&next, &p3, &p1, &p2)
+		p2 = p3
+		p3 = next
+
+		maxdaclen--
+		daclen--
+		dac >>= 1
+	}
+
+	// In case of collision the input has the right order.
+	f.projCmov(q, &pinput, collision)
+}
+
+// powpow8mod replaces x with x^k y^8 using the constant time 2-bit
+// window algorithm of the C sources, whose timing depends on kupper
+// but not on k.
+func (f *field) powpow8mod(x, y *fp, k, kupper uint64) {
+	nbits := int64(5)
+	kupper >>= 5
+	for kupper != 0 {
+		nbits++
+		kupper >>= 1
+	}
+
+	x1 := *x
+	var x2, x3 fp
+	f.sq(&x2, x)
+	f.mul(&x3, &x2, x)
+
+	written := false // otherwise x is implicitly 1
+	for i := (nbits - 1) &^ 1; i >= 0; i -= 2 {
+		if written {
+			f.sq(x, x)
+			if i == 2 {
+				f.mul(x, x, y)
+			}
+			f.sq(x, x)
+		}
+		ram0 := f.one
+		ram1 := x1
+		control1 := 1 & (k >> uint(i+1))
+		f.cmov(&ram0, &x2, control1)
+		f.cmov(&ram1, &x3, control1)
+		control0 := 1 & (k >> uint(i))
+		f.cmov(&ram0, &ram1, control0)
+
+		if written {
+			f.mul(x, x, &ram0)
+		} else {
+			*x = ram0
+		}
+		written = true
+	}
+}
+
+// xISOGmatryoshka computes the isogeny with kernel generated by the
+// point k of odd prime order l, klower <= l <= kupper. It replaces a
+// with the image curve and each point in p with its image. Timing
+// depends on kupper but not on l.
+//
+// Only the traditional Vélu formulas are implemented; the C sources
+// switch to √élu for the larger primes, which computes the same
+// isogeny faster.
+func (f *field) xISOGmatryoshka(a *proj, p []proj, k *proj, l, kupper int64) {
+	var aed, a24 proj // twisted Edwards curve coefficients
+	f.add(&aed.z, &a.z, &a.z)
+	f.add(&aed.x, &a.x, &aed.z)
+	a24.x = aed.x
+	f.add(&a24.z, &aed.z, &aed.z)
+	f.sub(&aed.z, &a.x, &aed.z)
+
+	half := (kupper - 1) / 2
+	m := make([]proj, half+1)
+	m[1] = *k
+	if half >= 2 {
+		f.xDBL(&m[2], k, &a24, false)
+	}
+	for i := int64(3); i <= half; i++ {
+		f.xADD(&m[i], &m[i-1], k, &m[i-2])
+	}
+
+	psum := make([]fp, len(p))
+	pdif := make([]fp, len(p))
+	for h := range p {
+		f.add(&psum[h], &p[h].x, &p[h].z)
+		f.sub(&pdif[h], &p[h].x, &p[h].z)
+	}
+
+	var tmp0, tmp1, tmp2, tmp3, tmp4 fp
+	var abatch proj
+	qbatch := make([]proj, len(p))
+
+	f.sub(&tmp4, &m[1].x, &m[1].z)
+	f.add(&tmp3, &m[1].x, &m[1].z)
+	abatch.x = tmp4
+	abatch.z = tmp3
+	for h := range p {
+		f.mul(&tmp1, &tmp4, &psum[h])
+		f.mul(&tmp0, &tmp3, &pdif[h])
+		f.add(&qbatch[h].x, &tmp0, &tmp1)
+		f.sub(&qbatch[h].z, &tmp0, &tmp1)
+	}
+
+	ignore := (l + 1) / 2 // skip i >= ignore
+	for i := int64(2); i <= half; i++ {
+		want := uint64(-((i - ignore) >> 61))
+
+		f.sub(&tmp4, &m[i].x, &m[i].z)
+		f.add(&tmp3, &m[i].x, &m[i].z)
+		f.mul(&tmp2, &abatch.x, &tmp4)
+		f.cmov(&abatch.x, &tmp2, want)
+		f.mul(&tmp2, &abatch.z, &tmp3)
+		f.cmov(&abatch.z, &tmp2, want)
+		for h := range p {
+			f.mul(&tmp1, &tmp4, &psum[h])
+			f.mul(&tmp0, &tmp3, &pdif[h])
+			f.add(&tmp2, &tmp0, &tmp1)
+			f.mul(&tmp2, &tmp2, &qbatch[h].x)
+			f.cmov(&qbatch[h].x, &tmp2, want)
+			f.sub(&tmp2, &tmp0, &tmp1)
+			f.mul(&tmp2, &tmp2, &qbatch[h].z)
+			f.cmov(&qbatch[h].z, &tmp2, want)
+		}
+	}
+
+	// point evaluation
+	for h := range p {
+		f.sq(&qbatch[h].x, &qbatch[h].x)
+		f.sq(&qbatch[h].z, &qbatch[h].z)
+		f.mul(&p[h].x, &p[h].x, &qbatch[h].x)
+		f.mul(&p[h].z, &p[h].z, &qbatch[h].z)
+	}
+
+	f.powpow8mod(&aed.x, &abatch.z, uint64(l), uint64(kupper))
+	f.powpow8mod(&aed.z, &abatch.x, uint64(l), uint64(kupper))
+
+	// compute Montgomery parameters
+	f.add(&a.x, &aed.x, &aed.z)
+	f.sub(&a.z, &aed.x, &aed.z)
+	f.add(&a.x, &a.x, &a.x)
+}
@@ -0,0 +1,180 @@
+// Package purego is a pure Go port of the high-ctidh CTIDH
+// implementation vendored by the ctidh packages. It is used in place
+// of the C library when cgo is not available.
+//
+// Keys are encoded exactly as by the C library: a public key is the
+// little-endian Montgomery form of the curve coefficient A, and a
+// private key is one signed exponent byte per prime. Key generation
+// reads its randomness the same way csidh_private_withrng does, so
+// both produce the same key from the same random stream.
+//
+// Like the C sources this code is written to run in constant time,
+// but unlike them it has not been audited for that, and the Go
+// compiler gives no guarantees about it.
+package purego
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+)
+
+// Maximum sizes over all of the parameter sets.
+const (
+	MaxPublicKeySize  = 8 * maxLimbs
+	MaxPrivateKeySize = 231
+)
+
+// ErrPublicKeyValidation indicates a public key validation failure.
+var ErrPublicKeyValidation = errors.New("ctidh: public key validation failure")
+
+// Params is a CTIDH parameter set.
+type Params struct {
+	bits int
+
+	primes []int64
+	dac    []int64
+	daclen []int64
+
+	batchsize      []int64
+	batchstart     []int64
+	batchstop      []int64
+	batchmaxdaclen []int64
+	batchbound     []int64
+
+	f *field
+}
+
+// The supported parameter sets.
+var (
+	CTIDH511  = params511
+	CTIDH512  = params512
+	CTIDH1024 = params1024
+	CTIDH2048 = params2048
+)
+
+// ParamsForBits returns the parameter set of the given size, or nil
+// if there is none.
+func ParamsForBits(bits int) *Params {
+	for _, p := range []*Params{CTIDH511, CTIDH512, CTIDH1024, CTIDH2048} {
+		if p.bits == bits {
+			return p
+		}
+	}
+	return nil
+}
+
+// Bits returns the nominal size of the parameter set.
+func (pr *Params) Bits() int {
+	return pr.bits
+}
+
+// Modulus returns the prime p of the parameter set.
+func (pr *Params) Modulus() *big.Int {
+	return fromFp(&pr.f.p, pr.f.n)
+}
+
+// PublicKeySize returns the size in bytes of a public key.
+func (pr *Params) PublicKeySize() int {
+	return 8 * pr.f.n
+}
+
+// PrivateKeySize returns the size in bytes of a private key.
+func (pr *Params) PrivateKeySize() int {
+	return len(pr.primes)
+}
+
+// GeneratePrivateKey fills the exponent vector e, which must be
+// PrivateKeySize long, with a new private key drawn from rng.
+func (pr *Params) GeneratePrivateKey(e []int8, rng io.Reader) error {
+	for i := range e {
+		e[i] = 0
+	}
+	pos := 0
+	for b := range pr.batchsize {
+		w := int(pr.batchsize[b])
+		s := int(pr.batchbound[b])
+		if err := randomBoundedL1(e[pos:pos+w], w, s, rng); err != nil {
+			return err
+		}
+		pos += w
+	}
+	return nil
+}
+
+// ValidatePrivateKey reports whether the exponent vector e, which
+// must be PrivateKeySize long, is within the bounds the group action
+// is computed for: the L1 norm of the exponents of every batch must
+// not exceed the batch bound. It runs in constant time.
+func (pr *Params) ValidatePrivateKey(e []int8) bool {
+	var bad int64
+	for b := range pr.batchsize {
+		var l1 int64
+		for j := pr.batchstart[b]; j < pr.batchstop[b]; j++ {
+			x := int64(e[j])
+			m := x >> 63
+			l1 += (x ^ m) - m
+		}
+		bad |= pr.batchbound[b] - l1
+	}
+	return int64MaskNegative(bad) == 0
+}
+
+// Validate reports whether the public key pk is valid.
+func (pr *Params) Validate(pk []byte) bool {
+	var a fp
+	pr.load(&a, pk)
+	return pr.validate(&a)
+}
+
+// Action writes to out the public key reached from the public key
+// in by the group action of the private key e. in is not validated.
+func (pr *Params) Action(out, in []byte, e []int8) {
+	var a fp
+	pr.load(&a, in)
+	a = pr.action(&a, e)
+	pr.store(out, &a)
+}
+
+// CSIDH validates the public key in and then writes the group
+// action of e on it to out, like csidh does. If in is invalid out
+// is filled with a random field element and ErrPublicKeyValidation
+// is returned.
+func (pr *Params) CSIDH(out, in []byte, e []int8) error {
+	var a fp
+	pr.load(&a, in)
+	if !pr.validate(&a) {
+		r := pr.randomFp()
+		pr.store(out, &r)
+		return ErrPublicKeyValidation
+	}
+	a = pr.action(&a, e)
+	pr.store(out, &a)
+	return nil
+}
+
+func (pr *Params) load(x *fp, b []byte) {
+	*x = fp{}
+	for i := 0; i < pr.f.n; i++ {
+		x[i] = binary.LittleEndian.Uint64(b[8*i:])
+	}
+}
+
+func (pr *Params) store(b []byte, x *fp) {
+	for i := 0; i < pr.f.n; i++ {
+		binary.LittleEndian.PutUint64(b[8*i:], x[i])
+	}
+}
+
+func init() {
+	for _, pr := range []*Params{params511, params512, params1024, params2048} {
+		// p = 4 * primes[0] * ... * primes[n-1] - 1
+		p := big.NewInt(4)
+		for _, l := range pr.primes {
+			p.Mul(p, big.NewInt(l))
+		}
+		p.Sub(p, big.NewInt(1))
+		pr.f = newField(p, (pr.bits+63)/64)
+	}
+}
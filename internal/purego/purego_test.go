@@ -0,0 +1,133 @@
+package purego
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsForBits(t *testing.T) {
+	for _, bits := range []int{511, 512, 1024, 2048} {
+		pr := ParamsForBits(bits)
+		require.NotNil(t, pr)
+		require.Equal(t, bits, pr.Bits())
+		require.LessOrEqual(t, pr.PublicKeySize(), MaxPublicKeySize)
+		require.LessOrEqual(t, pr.PrivateKeySize(), MaxPrivateKeySize)
+		require.True(t, pr.Modulus().ProbablyPrime(20))
+		require.LessOrEqual(t, pr.Modulus().BitLen(), 8*pr.PublicKeySize())
+	}
+	require.Nil(t, ParamsForBits(256))
+}
+
+func TestGeneratePrivateKeyBounds(t *testing.T) {
+	for _, pr := range []*Params{CTIDH511, CTIDH512, CTIDH1024, CTIDH2048} {
+		e := make([]int8, pr.PrivateKeySize())
+		err := pr.GeneratePrivateKey(e, rand.Reader)
+		require.NoError(t, err)
+
+		for b := range pr.batchsize {
+			var l1 int64
+			for j := pr.batchstart[b]; j < pr.batchstop[b]; j++ {
+				if e[j] < 0 {
+					l1 -= int64(e[j])
+				} else {
+					l1 += int64(e[j])
+				}
+			}
+			require.LessOrEqual(t, l1, pr.batchbound[b])
+		}
+	}
+}
+
+func TestGeneratePrivateKeyShortReader(t *testing.T) {
+	pr := CTIDH512
+	e := make([]int8, pr.PrivateKeySize())
+	err := pr.GeneratePrivateKey(e, bytes.NewReader(make([]byte, 10)))
+	require.Error(t, err)
+}
+
+func TestNIKE(t *testing.T) {
+	pr := CTIDH511
+	base := make([]byte, pr.PublicKeySize())
+
+	alice := make([]int8, pr.PrivateKeySize())
+	require.NoError(t, pr.GeneratePrivateKey(alice, rand.Reader))
+	bob := make([]int8, pr.PrivateKeySize())
+	require.NoError(t, pr.GeneratePrivateKey(bob, rand.Reader))
+
+	alicePublic := make([]byte, pr.PublicKeySize())
+	require.NoError(t, pr.CSIDH(alicePublic, base, alice))
+	bobPublic := make([]byte, pr.PublicKeySize())
+	require.NoError(t, pr.CSIDH(bobPublic, base, bob))
+	require.True(t, pr.Validate(alicePublic))
+	require.True(t, pr.Validate(bobPublic))
+
+	aliceShared := make([]byte, pr.PublicKeySize())
+	require.NoError(t, pr.CSIDH(aliceShared, bobPublic, alice))
+	bobShared := make([]byte, pr.PublicKeySize())
+	require.NoError(t, pr.CSIDH(bobShared, alicePublic, bob))
+	require.Equal(t, aliceShared, bobShared)
+}
+
+func TestValidateRejects(t *testing.T) {
+	pr := CTIDH512
+	f := pr.f
+
+	two := make([]byte, pr.PublicKeySize())
+	pr.store(two, &f.two)
+	require.False(t, pr.Validate(two))
+
+	var minusTwo fp
+	f.neg(&minusTwo, &f.two)
+	buf := make([]byte, pr.PublicKeySize())
+	pr.store(buf, &minusTwo)
+	require.False(t, pr.Validate(buf))
+
+	pr.store(buf, &f.p)
+	require.False(t, pr.Validate(buf))
+
+	// A random coefficient is almost certainly an ordinary curve.
+	r := pr.randomFp()
+	pr.store(buf, &r)
+	require.False(t, pr.Validate(buf))
+
+	e := make([]int8, pr.PrivateKeySize())
+	out := make([]byte, pr.PublicKeySize())
+	require.Equal(t, ErrPublicKeyValidation, pr.CSIDH(out, buf, e))
+}
+
+func TestValidatePrivateKey(t *testing.T) {
+	for _, pr := range []*Params{CTIDH511, CTIDH512, CTIDH1024, CTIDH2048} {
+		e := make([]int8, pr.PrivateKeySize())
+		require.True(t, pr.ValidatePrivateKey(e))
+
+		// Exhaust the bound of every batch, alternating signs.
+		for b := range pr.batchsize {
+			j := pr.batchstart[b]
+			if b%2 == 1 {
+				e[j] = -int8(pr.batchbound[b])
+			} else {
+				e[j] = int8(pr.batchbound[b])
+			}
+		}
+		require.True(t, pr.ValidatePrivateKey(e))
+
+		// One more in any batch is too many.
+		for b := range pr.batchsize {
+			j := pr.batchstart[b]
+			old := e[j]
+			if old < 0 {
+				e[j]--
+			} else {
+				e[j]++
+			}
+			require.False(t, pr.ValidatePrivateKey(e))
+			e[j] = old
+		}
+
+		require.NoError(t, pr.GeneratePrivateKey(e, rand.Reader))
+		require.True(t, pr.ValidatePrivateKey(e))
+	}
+}
@@ -0,0 +1,202 @@
+package purego
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+)
+
+// masks are -1 if the condition holds, 0 if not
+
+func int64MaskNegative(x int64) int64 {
+	return x >> 63
+}
+
+func int64MaskNonzero(x int64) int64 {
+	return int64MaskNegative(x) | int64MaskNegative(-x)
+}
+
+func int64MaskEqual(x, y int64) int64 {
+	return ^int64MaskNonzero(x ^ y)
+}
+
+func int32MaskNegative(x int32) int32 {
+	return x >> 31
+}
+
+func int32MaskNonzero(x int32) int32 {
+	return int32MaskNegative(x) | int32MaskNegative(-x)
+}
+
+func int32MaskZero(x int32) int32 {
+	return ^int32MaskNonzero(x)
+}
+
+func int32MaskEqual(x, y int32) int32 {
+	return int32MaskZero(x ^ y)
+}
+
+// randomBytes fills b from the system random number generator. Like
+// randombytes in the C sources it does not return on failure.
+func randomBytes(b []byte) {
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		panic("ctidh: system random number generator failed: " + err.Error())
+	}
+}
+
+// randomBoundedL1 fills e with w uniformly random exponents whose
+// absolute values sum to at most s, reading its randomness from rng
+// exactly as random_boundedl1 does from its callback.
+func randomBoundedL1(e []int8, w, s int, rng io.Reader) error {
+	if w == 0 {
+		return nil
+	}
+
+	rnum := s + w
+	buf := make([]byte, 4*rnum)
+	r := make([]int32, rnum)
+
+	for { // rejection-sampling loop
+		if _, err := io.ReadFull(rng, buf); err != nil {
+			return err
+		}
+		for j := range r {
+			r[j] = int32(binary.LittleEndian.Uint32(buf[4*j:]))
+		}
+		for j := 0; j < rnum; j++ {
+			r[j] &^= 1
+		}
+		for j := 0; j < w; j++ {
+			r[j] |= 1
+		}
+		int32Sort(r)
+		var collision int32
+		for j := 1; j < w; j++ {
+			collision |= int32MaskZero((r[j] ^ r[j-1]) &^ 1)
+		}
+		if collision != 0 {
+			continue
+		}
+
+		for j := 0; j < rnum; j++ {
+			r[j] &= 1
+		}
+		// now r has Hamming weight w
+
+		for j := 1; j < rnum; j++ {
+			r[j] += r[j-1]
+		}
+		// now r has >=0 copies of 0,
+		// >=1 copies of 1, >=1 copies of 2, ..., >=1 copies of w
+
+		for i := 0; i < w; i++ {
+			var numi int32
+			for j := 0; j < rnum; j++ {
+				numi -= int32MaskEqual(r[j], int32(i))
+			}
+			e[i] = int8(numi)
+		}
+		for i := 1; i < w; i++ {
+			e[i]--
+		}
+		// now e[0]>=0, ..., e[w-1]>=0 with sum <= s
+
+		// Keep e with probability 2^zmin/2^z where z is the
+		// number of zeros in e and zmin its minimum possible
+		// value, so that negating gives a uniform distribution.
+		counter := int32(w - s)
+
+		coins := buf[:4*((w+31)/32)]
+		if _, err := io.ReadFull(rng, coins); err != nil {
+			return err
+		}
+		for j := range coins[:len(coins)/4] {
+			r[j] = int32(binary.LittleEndian.Uint32(coins[4*j:]))
+		}
+		var reject int32
+		for i := 0; i < w; i++ {
+			rbit := 1 & (r[i/32] >> uint(i&31))
+			eizeromask := int32MaskZero(int32(e[i]))
+			counter += eizeromask
+			reject |= int32MaskNegative(counter) & eizeromask & rbit
+		}
+		if reject != 0 {
+			continue
+		}
+
+		// Reusing the randomness is fine: the bits used here are
+		// for e[i] nonzero, the bits used above for e[i] zero.
+		for i := 0; i < w; i++ {
+			rbit := int8(1 & (r[i/32] >> uint(i&31)))
+			e[i] ^= -rbit
+			e[i] += rbit
+		}
+		return nil
+	}
+}
+
+// uint64MaskLessThan returns -1 if x < y, else 0.
+func uint64MaskLessThan(x, y uint64) uint64 {
+	xy := x ^ y
+	c := x - y
+	const flip = uint64(1) << 63
+	c ^= xy & (c ^ x ^ flip)
+	return uint64(int64(c) >> 63)
+}
+
+// randomCoin returns 1 with probability num/den and 0 otherwise.
+func randomCoin(num, den uint64) uint64 {
+	var buf [32]byte
+	randomBytes(buf[:])
+
+	var r uint64
+	for i := 0; i < 256; i++ {
+		bit := uint64(1 & (buf[i/8] >> uint(i&7)))
+		r <<= 1
+		r += bit
+		r ^= ^uint64MaskLessThan(r, den) & (r ^ (r - den))
+	}
+	return 1 & uint64MaskLessThan(r, num)
+}
+
+func int32MinMax(a, b *int32) {
+	ab := *b ^ *a
+	c := int32(int64(*b) - int64(*a))
+	c ^= ab & (c ^ *b)
+	c >>= 31
+	c &= ab
+	*a ^= c
+	*b ^= c
+}
+
+// int32Sort sorts x in place with the djbsort sorting network.
+func int32Sort(x []int32) {
+	n := len(x)
+	if n < 2 {
+		return
+	}
+	top := 1
+	for top < n-top {
+		top += top
+	}
+
+	for p := top; p > 0; p >>= 1 {
+		for i := 0; i < n-p; i++ {
+			if i&p == 0 {
+				int32MinMax(&x[i], &x[i+p])
+			}
+		}
+		i := 0
+		for q := top; q > p; q >>= 1 {
+			for ; i < n-q; i++ {
+				if i&p == 0 {
+					a := x[i+p]
+					for r := q; r > p; r >>= 1 {
+						int32MinMax(&a, &x[i+r])
+					}
+					x[i+p] = a
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package purego
+
+// The tables below mirror the autogen output in the vendored
+// ctidhN/primesN.c files.
+
+var params511 = &Params{
+	bits: 511,
+	primes: []int64{
+		3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41, 43, 47, 53, 59, 61, 67,
+		71, 73, 79, 83, 89, 97, 101, 103, 107, 109, 113, 127, 131, 137, 139,
+		149, 151, 157, 163, 167, 173, 179, 181, 191, 193, 197, 199, 211, 223,
+		227, 229, 233, 239, 241, 251, 257, 263, 269, 271, 277, 281, 283, 293,
+		307, 311, 313, 317, 331, 337, 347, 349, 353, 359, 367, 373, 587,
+	},
+	dac: []int64{
+		0, 0, 2, 4, 0, 10, 4, 24, 16, 8, 48, 42, 34, 32, 106, 88, 81, 72, 80,
+		20, 16, 210, 0, 192, 164, 48, 96, 132, 464, 417, 64, 424, 388, 416,
+		180, 384, 296, 266, 192, 272, 258, 136, 130, 785, 256, 788, 708, 776,
+		784, 0, 682, 832, 554, 641, 592, 552, 648, 672, 328, 576, 514, 264,
+		160, 80, 320, 1704, 32, 1384, 1448, 1424, 1600, 1410, 1354, 2832,
+	},
+	daclen: []int64{
+		0, 1, 2, 3, 3, 4, 4, 5, 5, 5, 6, 6, 6, 6, 7, 7, 7, 7, 7, 7, 7, 8, 7,
+		8, 8, 8, 8, 8, 9, 9, 8, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 10, 9,
+		10, 10, 10, 10, 9, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10,
+		10, 10, 10, 11, 10, 11, 11, 11, 11, 11, 11, 12,
+	},
+	batchsize: []int64{
+		2, 3, 4, 4, 5, 5, 5, 5, 5, 7, 7, 8, 7, 6, 1,
+	},
+	batchstart: []int64{
+		0, 2, 5, 9, 13, 18, 23, 28, 33, 38, 45, 52, 60, 67, 73,
+	},
+	batchstop: []int64{
+		2, 5, 9, 13, 18, 23, 28, 33, 38, 45, 52, 60, 67, 73, 74,
+	},
+	batchmaxdaclen: []int64{
+		1, 3, 5, 6, 7, 8, 8, 9, 9, 10, 10, 10, 11, 11, 12,
+	},
+	batchbound: []int64{
+		6, 9, 11, 11, 12, 12, 12, 12, 12, 12, 12, 12, 8, 6, 1,
+	},
+}
+
+var params512 = &Params{
+	bits: 512,
+	primes: []int64{
+		3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41, 43, 47, 53, 59, 61, 67,
+		71, 73, 79, 83, 89, 97, 101, 103, 107, 109, 113, 127, 131, 137, 139,
+		149, 151, 157, 163, 167, 173, 179, 181, 191, 193, 197, 199, 211, 223,
+		227, 229, 233, 239, 241, 251, 257, 263, 269, 271, 277, 281, 283, 293,
+		307, 311, 313, 317, 331, 337, 347, 349, 353, 359, 367, 373, 587,
+	},
+	dac: []int64{
+		0, 0, 2, 4, 0, 10, 4, 24, 16, 8, 48, 42, 34, 32, 106, 88, 81, 72, 80,
+		20, 16, 210, 0, 192, 164, 48, 96, 132, 464, 417, 64, 424, 388, 416,
+		180, 384, 296, 266, 192, 272, 258, 136, 130, 785, 256, 788, 708, 776,
+		784, 0, 682, 832, 554, 641, 592, 552, 648, 672, 328, 576, 514, 264,
+		160, 80, 320, 1704, 32, 1384, 1448, 1424, 1600, 1410, 1354, 2832,
+	},
+	daclen: []int64{
+		0, 1, 2, 3, 3, 4, 4, 5, 5, 5, 6, 6, 6, 6, 7, 7, 7, 7, 7, 7, 7, 8, 7,
+		8, 8, 8, 8, 8, 9, 9, 8, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 10, 9,
+		10, 10, 10, 10, 9, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10,
+		10, 10, 10, 11, 10, 11, 11, 11, 11, 11, 11, 12,
+	},
+	batchsize: []int64{
+		2, 3, 4, 4, 5, 5, 6, 7, 7, 8, 8, 6, 8, 1,
+	},
+	batchstart: []int64{
+		0, 2, 5, 9, 13, 18, 23, 29, 36, 43, 51, 59, 65, 73,
+	},
+	batchstop: []int64{
+		2, 5, 9, 13, 18, 23, 29, 36, 43, 51, 59, 65, 73, 74,
+	},
+	batchmaxdaclen: []int64{
+		1, 3, 5, 6, 7, 8, 9, 9, 9, 10, 10, 10, 11, 12,
+	},
+	batchbound: []int64{
+		10, 14, 16, 17, 17, 17, 18, 18, 18, 18, 18, 13, 13, 1,
+	},
+}
+
+var params1024 = &Params{
+	bits: 1024,
+	primes: []int64{
+		3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41, 43, 47, 53, 59, 61, 67,
+		71, 73, 79, 83, 89, 97, 101, 103, 107, 109, 113, 127, 131, 137, 139,
+		149, 151, 157, 163, 167, 173, 179, 181, 191, 193, 197, 199, 211, 223,
+		227, 229, 233, 239, 241, 251, 257, 263, 269, 271, 277, 281, 283, 293,
+		307, 311, 313, 317, 331, 337, 347, 349, 353, 359, 367, 373, 379, 383,
+		389, 397, 401, 409, 419, 421, 431, 433, 439, 443, 449, 457, 461, 463,
+		467, 479, 487, 491, 499, 503, 509, 521, 523, 541, 547, 557, 563, 569,
+		571, 577, 587, 593, 599, 601, 607, 613, 617, 619, 631, 641, 643, 647,
+		653, 659, 661, 673, 677, 683, 691, 701, 709, 719, 727, 733, 983,
+	},
+	dac: []int64{
+		0, 0, 2, 4, 0, 10, 4, 24, 16, 8, 48, 42, 34, 32, 106, 88, 81, 72, 80,
+		20, 16, 210, 0, 192, 164, 48, 96, 132, 464, 417, 64, 424, 388, 416,
+		180, 384, 296, 266, 192, 272, 258, 136, 130, 785, 256, 788, 708, 776,
+		784, 0, 682, 832, 554, 641, 592, 552, 648, 672, 328, 576, 514, 264,
+		160, 80, 320, 1704, 32, 1384, 1448, 1424, 1600, 1410, 1354, 1440,
+		1345, 1285, 1352, 1320, 1290, 1092, 3594, 1312, 1284, 3744, 1282,
+		324, 532, 3472, 1028, 1032, 3712, 1280, 3210, 544, 320, 3346, 1024,
+		2850, 3232, 128, 2882, 2896, 2400, 2584, 2730, 2832, 3104, 2322,
+		2194, 1304, 2880, 2212, 2689, 3328, 2186, 2692, 2640, 2696, 2308,
+		2084, 2561, 2066, 2114, 2178, 2088, 2058, 656, 328, 1536, 5442,
+	},
+	daclen: []int64{
+		0, 1, 2, 3, 3, 4, 4, 5, 5, 5, 6, 6, 6, 6, 7, 7, 7, 7, 7, 7, 7, 8, 7,
+		8, 8, 8, 8, 8, 9, 9, 8, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 10, 9,
+		10, 10, 10, 10, 9, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10,
+		10, 10, 10, 11, 10, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
+		11, 12, 11, 11, 12, 11, 11, 11, 12, 11, 11, 12, 11, 12, 11, 11, 12,
+		11, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 13,
+	},
+	batchsize: []int64{
+		2, 3, 5, 4, 6, 6, 6, 6, 6, 7, 7, 7, 6, 7, 7, 5, 6, 5, 10, 3, 10, 5,
+		1,
+	},
+	batchstart: []int64{
+		0, 2, 5, 10, 14, 20, 26, 32, 38, 44, 51, 58, 65, 71, 78, 85, 90, 96,
+		101, 111, 114, 124, 129,
+	},
+	batchstop: []int64{
+		2, 5, 10, 14, 20, 26, 32, 38, 44, 51, 58, 65, 71, 78, 85, 90, 96,
+		101, 111, 114, 124, 129, 130,
+	},
+	batchmaxdaclen: []int64{
+		1, 3, 5, 6, 7, 8, 9, 9, 10, 10, 10, 10, 11, 11, 12, 12, 12, 12, 12,
+		12, 12, 12, 13,
+	},
+	batchbound: []int64{
+		2, 4, 5, 5, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 5, 5, 3, 6, 2, 6, 2, 0,
+	},
+}
+
+var params2048 = &Params{
+	bits: 2048,
+	primes: []int64{
+		3, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41, 43, 47, 53, 59, 61, 67, 71,
+		73, 79, 83, 89, 97, 101, 103, 107, 109, 113, 127, 131, 137, 139, 149,
+		151, 157, 163, 167, 173, 179, 181, 191, 193, 197, 199, 211, 223, 227,
+		229, 233, 239, 241, 251, 257, 263, 269, 271, 277, 281, 283, 293, 307,
+		311, 313, 317, 331, 337, 347, 349, 353, 359, 367, 373, 379, 383, 389,
+		397, 401, 409, 419, 421, 431, 433, 439, 443, 449, 457, 461, 463, 467,
+		479, 487, 491, 499, 503, 509, 521, 523, 541, 547, 557, 563, 569, 571,
+		577, 587, 593, 599, 601, 607, 613, 617, 619, 631, 641, 643, 647, 653,
+		659, 661, 673, 677, 683, 691, 701, 709, 719, 727, 733, 739, 743, 751,
+		757, 761, 769, 773, 787, 797, 809, 811, 821, 823, 827, 829, 839, 853,
+		857, 859, 863, 877, 881, 883, 887, 907, 911, 919, 929, 937, 941, 947,
+		953, 967, 971, 977, 983, 991, 997, 1009, 1013, 1019, 1021, 1031,
+		1033, 1039, 1049, 1051, 1061, 1063, 1069, 1087, 1091, 1093, 1097,
+		1103, 1109, 1117, 1123, 1129, 1151, 1153, 1163, 1171, 1181, 1187,
+		1193, 1201, 1213, 1217, 1223, 1229, 1231, 1237, 1249, 1259, 1277,
+		1279, 1283, 1289, 1291, 1297, 1301, 1303, 1307, 1319, 1321, 1327,
+		1361, 1367, 1373, 1381, 1399, 1409, 1423, 1427, 1429, 1433, 1439,
+		1447, 1451, 1453, 1459, 3413,
+	},
+	dac: []int64{
+		0, 2, 4, 0, 10, 4, 24, 16, 8, 48, 42, 34, 32, 106, 88, 81, 72, 80,
+		20, 16, 210, 0, 192, 164, 48, 96, 132, 464, 417, 64, 424, 388, 416,
+		180, 384, 296, 266, 192, 272, 258, 136, 130, 785, 256, 788, 708, 776,
+		784, 0, 682, 832, 554, 641, 592, 552, 648, 672, 328, 576, 514, 264,
+		160, 80, 320, 1704, 32, 1384, 1448, 1424, 1600, 1410, 1354, 1440,
+		1345, 1285, 1352, 1320, 1290, 1092, 3594, 1312, 1284, 3744, 1282,
+		324, 532, 3472, 1028, 1032, 3712, 1280, 3210, 544, 320, 3346, 1024,
+		2850, 3232, 128, 2882, 2896, 2400, 2584, 2730, 2832, 3104, 2322,
+		2194, 1304, 2880, 2212, 2689, 3328, 2186, 2692, 2640, 2696, 2308,
+		2084, 2561, 2066, 2114, 2178, 2088, 2058, 656, 328, 1536, 6484, 1288,
+		6730, 6916, 6920, 6740, 576, 520, 6218, 7168, 5780, 6722, 5824, 6800,
+		6273, 6736, 6786, 6212, 6666, 5296, 512, 6178, 64, 6164, 6660, 6672,
+		6145, 6658, 6784, 4746, 5640, 5258, 5288, 6272, 4108, 5442, 4386,
+		5456, 4618, 5192, 4178, 6656, 4362, 4418, 4225, 4353, 2096, 3088,
+		3080, 4624, 13860, 4610, 2704, 4232, 4368, 4162, 4354, 13904, 4256,
+		2192, 2320, 1184, 1284, 1104, 2624, 2564, 13336, 1344, 4224, 4104,
+		12628, 10945, 13394, 13636, 288, 528, 13354, 2304, 13648, 2052, 2112,
+		2080, 2056, 12297, 13448, 12610, 640, 13584, 12432, 13392, 10800,
+		13632, 11284, 12322, 256, 128, 8388, 10944, 8752, 9378, 2048, 9506,
+		51840,
+	},
+	daclen: []int64{
+		0, 2, 3, 3, 4, 4, 5, 5, 5, 6, 6, 6, 6, 7, 7, 7, 7, 7, 7, 7, 8, 7, 8,
+		8, 8, 8, 8, 9, 9, 8, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 10, 9, 10,
+		10, 10, 10, 9, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10,
+		10, 10, 11, 10, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
+		12, 11, 11, 12, 11, 11, 11, 12, 11, 11, 12, 11, 12, 11, 11, 12, 11,
+		12, 12, 11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 13, 12,
+		13, 13, 13, 13, 12, 12, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13,
+		13, 12, 13, 12, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13,
+		13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 14, 13, 13,
+		13, 13, 13, 13, 14, 13, 13, 13, 13, 13, 13, 13, 13, 14, 13, 13, 13,
+		14, 14, 14, 14, 13, 13, 14, 13, 14, 13, 13, 13, 13, 14, 14, 14, 13,
+		14, 14, 14, 14, 14, 14, 14, 13, 13, 14, 14, 14, 14, 13, 14, 16,
+	},
+	batchsize: []int64{
+		9, 10, 8, 8, 7, 10, 12, 11, 10, 15, 10, 9, 8, 6, 10, 13, 10, 9, 12,
+		13, 10, 10, 10, 1,
+	},
+	batchstart: []int64{
+		0, 9, 19, 27, 35, 42, 52, 64, 75, 85, 100, 110, 119, 127, 133, 143,
+		156, 166, 175, 187, 200, 210, 220, 230,
+	},
+	batchstop: []int64{
+		9, 19, 27, 35, 42, 52, 64, 75, 85, 100, 110, 119, 127, 133, 143, 156,
+		166, 175, 187, 200, 210, 220, 230, 231,
+	},
+	batchmaxdaclen: []int64{
+		5, 7, 8, 9, 9, 10, 10, 11, 12, 12, 12, 12, 12, 13, 13, 13, 13, 13,
+		14, 14, 14, 14, 14, 16,
+	},
+	batchbound: []int64{
+		1, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 2, 0, 2,
+		0,
+	},
+}
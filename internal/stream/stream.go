@@ -0,0 +1,242 @@
+// Package stream implements the STREAM construction of Hoang, Reyhanitabar,
+// Rogaway and Vizár for encrypting a stream with an AEAD in chunks.
+//
+// Each chunk of at most ChunkSize bytes is sealed with the nonce
+//
+//	prefix || big-endian chunk counter || last chunk flag
+//
+// where the flag is 1 for the last chunk and 0 otherwise, so that
+// reordering, dropping, truncating at a chunk boundary and appending
+// chunks are all detected. Only the last chunk may be shorter than
+// ChunkSize, and it is empty only when the whole stream is.
+package stream
+
+import (
+	"crypto/cipher"
+	"errors"
+	"io"
+)
+
+// ChunkSize is the size of the plaintext of every chunk but the last.
+const ChunkSize = 64 * 1024
+
+// minCounterSize is the smallest number of nonce bytes left for the
+// chunk counter by a prefix.
+const minCounterSize = 4
+
+var (
+	// ErrDecrypt indicates a chunk which failed to authenticate, or
+	// which is out of place.
+	ErrDecrypt = errors.New("stream: chunk authentication failed")
+
+	// ErrTruncated indicates a stream which ends before its last
+	// chunk.
+	ErrTruncated = errors.New("stream: truncated stream")
+
+	// ErrTrailingData indicates a chunk after the last chunk.
+	ErrTrailingData = errors.New("stream: chunk after last chunk")
+
+	// ErrTooLong indicates a stream with more chunks than the
+	// counter can number.
+	ErrTooLong = errors.New("stream: too many chunks")
+
+	errClosed = errors.New("stream: write after close")
+)
+
+// nonce is the nonce of the next chunk.
+type nonce struct {
+	buf    []byte
+	prefix int
+}
+
+func newNonce(aead cipher.AEAD, prefix []byte) nonce {
+	if len(prefix) > aead.NonceSize()-1-minCounterSize {
+		panic("stream: nonce prefix too long")
+	}
+	buf := make([]byte, aead.NonceSize())
+	copy(buf, prefix)
+	return nonce{buf: buf, prefix: len(prefix)}
+}
+
+// get returns the nonce of the current chunk.
+func (n *nonce) get(last bool) []byte {
+	if last {
+		n.buf[len(n.buf)-1] = 1
+	} else {
+		n.buf[len(n.buf)-1] = 0
+	}
+	return n.buf
+}
+
+// next increments the chunk counter.
+func (n *nonce) next() error {
+	counter := n.buf[n.prefix : len(n.buf)-1]
+	for i := len(counter) - 1; i >= 0; i-- {
+		counter[i]++
+		if counter[i] != 0 {
+			return nil
+		}
+	}
+	return ErrTooLong
+}
+
+// Writer encrypts a stream. The last chunk is written by Close.
+type Writer struct {
+	aead  cipher.AEAD
+	nonce nonce
+	w     io.Writer
+	buf   []byte
+	err   error
+}
+
+// NewWriter returns a Writer which encrypts to w with aead, under the
+// nonce prefix, which must leave at least five bytes of the nonce for
+// the counter and flag. The key must not be used for another stream
+// with the same prefix.
+func NewWriter(aead cipher.AEAD, prefix []byte, w io.Writer) *Writer {
+	return &Writer{
+		aead:  aead,
+		nonce: newNonce(aead, prefix),
+		w:     w,
+		buf:   make([]byte, 0, ChunkSize+aead.Overhead()),
+	}
+}
+
+// Write encrypts p. A full chunk is only written once more data
+// follows, since until then it might be the last.
+func (w *Writer) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	n := 0
+	for len(p) > 0 {
+		if len(w.buf) == ChunkSize {
+			if w.err = w.flush(false); w.err != nil {
+				return n, w.err
+			}
+		}
+		m := copy(w.buf[len(w.buf):ChunkSize], p)
+		w.buf = w.buf[:len(w.buf)+m]
+		p = p[m:]
+		n += m
+	}
+	return n, nil
+}
+
+// Close writes the last chunk. It does not close the underlying
+// writer.
+func (w *Writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	w.err = w.flush(true)
+	if w.err == nil {
+		w.err = errClosed
+		return nil
+	}
+	return w.err
+}
+
+func (w *Writer) flush(last bool) error {
+	chunk := w.aead.Seal(w.buf[:0], w.nonce.get(last), w.buf, nil)
+	if _, err := w.w.Write(chunk); err != nil {
+		return err
+	}
+	w.buf = w.buf[:0]
+	return w.nonce.next()
+}
+
+// Reader decrypts a stream written by a Writer.
+type Reader struct {
+	aead    cipher.AEAD
+	nonce   nonce
+	r       io.Reader
+	buf     []byte
+	pending int
+	out     []byte
+	first   bool
+	done    bool
+	err     error
+}
+
+// NewReader returns a Reader which decrypts from r with aead, under
+// the same nonce prefix as the Writer.
+func NewReader(aead cipher.AEAD, prefix []byte, r io.Reader) *Reader {
+	return &Reader{
+		aead:  aead,
+		nonce: newNonce(aead, prefix),
+		r:     r,
+		buf:   make([]byte, ChunkSize+aead.Overhead()+1),
+		first: true,
+	}
+}
+
+// Read returns decrypted data. Data is only returned once its chunk
+// authenticated, and io.EOF only once the last chunk did.
+func (r *Reader) Read(p []byte) (int, error) {
+	for len(r.out) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		if r.done {
+			return 0, io.EOF
+		}
+		r.out, r.err = r.readChunk()
+	}
+	n := copy(p, r.out)
+	r.out = r.out[n:]
+	return n, nil
+}
+
+// readChunk reads and opens the next chunk. One byte past the chunk
+// is read ahead to know whether it is the last one.
+func (r *Reader) readChunk() ([]byte, error) {
+	encChunkSize := ChunkSize + r.aead.Overhead()
+	// The byte read ahead for the previous chunk starts this one.
+	if r.pending == 1 {
+		r.buf[0] = r.buf[encChunkSize]
+	}
+	n, err := io.ReadFull(r.r, r.buf[r.pending:])
+	n += r.pending
+	r.pending = 0
+	last := false
+	switch err {
+	case nil:
+		r.pending = 1
+	case io.EOF, io.ErrUnexpectedEOF:
+		last = true
+	default:
+		return nil, err
+	}
+	chunk := r.buf[:n]
+	if !last {
+		chunk = r.buf[:encChunkSize]
+	}
+	if len(chunk) < r.aead.Overhead() {
+		return nil, ErrTruncated
+	}
+
+	plaintext, err := r.aead.Open(nil, r.nonce.get(last), chunk, nil)
+	if err != nil {
+		// Opening with the other flag tells truncation at a
+		// chunk boundary, and data after the last chunk, from
+		// other failures.
+		if _, err := r.aead.Open(nil, r.nonce.get(!last), chunk, nil); err == nil {
+			if last {
+				return nil, ErrTruncated
+			}
+			return nil, ErrTrailingData
+		}
+		return nil, ErrDecrypt
+	}
+	if last && len(plaintext) == 0 && !r.first {
+		return nil, ErrDecrypt
+	}
+	r.first = false
+	if last {
+		r.done = true
+	} else if err := r.nonce.next(); err != nil {
+		return nil, err
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,100 @@
+package stream
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+var testPrefix = []byte("stream test")
+
+const tagSize = 16
+
+func encrypt(t *testing.T, plaintext []byte) []byte {
+	aead, err := chacha20poly1305.NewX(make([]byte, chacha20poly1305.KeySize))
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	w := NewWriter(aead, testPrefix, &buf)
+	// Odd sized writes cross the chunk boundaries.
+	for p := plaintext; len(p) > 0; {
+		n := 1000
+		if n > len(p) {
+			n = len(p)
+		}
+		_, err := w.Write(p[:n])
+		require.NoError(t, err)
+		p = p[n:]
+	}
+	require.NoError(t, w.Close())
+	_, err = w.Write([]byte{0})
+	require.Error(t, err)
+	return buf.Bytes()
+}
+
+func decrypt(ciphertext []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.NewX(make([]byte, chacha20poly1305.KeySize))
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(NewReader(aead, testPrefix, bytes.NewReader(ciphertext)))
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, size := range []int{0, 1, ChunkSize - 1, ChunkSize, ChunkSize + 1, 3 * ChunkSize, 3*ChunkSize + 17} {
+		plaintext := make([]byte, size)
+		_, err := rand.Read(plaintext)
+		require.NoError(t, err)
+		ciphertext := encrypt(t, plaintext)
+		chunks := (size + ChunkSize - 1) / ChunkSize
+		if chunks == 0 {
+			chunks = 1
+		}
+		require.Len(t, ciphertext, size+chunks*tagSize, "size %d", size)
+
+		got, err := decrypt(ciphertext)
+		require.NoError(t, err, "size %d", size)
+		require.Equal(t, plaintext, got)
+	}
+}
+
+func TestTampering(t *testing.T) {
+	plaintext := make([]byte, 3*ChunkSize+100)
+	ciphertext := encrypt(t, plaintext)
+	full := encrypt(t, plaintext[:ChunkSize])
+	encChunkSize := ChunkSize + tagSize
+	chunk := func(i int) []byte {
+		end := (i + 1) * encChunkSize
+		if end > len(ciphertext) {
+			end = len(ciphertext)
+		}
+		return ciphertext[i*encChunkSize : end]
+	}
+	join := func(parts ...[]byte) []byte {
+		return bytes.Join(parts, nil)
+	}
+
+	for _, test := range []struct {
+		name       string
+		ciphertext []byte
+		err        error
+	}{
+		{"truncated at a chunk boundary", join(chunk(0), chunk(1)), ErrTruncated},
+		{"truncated in a chunk", ciphertext[:len(ciphertext)-1], ErrDecrypt},
+		{"truncated to nothing", nil, ErrTruncated},
+		{"reordered", join(chunk(1), chunk(0), chunk(2), chunk(3)), ErrDecrypt},
+		{"chunk dropped", join(chunk(0), chunk(2), chunk(3)), ErrDecrypt},
+		{"chunk repeated", join(chunk(0), chunk(0), chunk(1), chunk(2), chunk(3)), ErrDecrypt},
+		{"trailing data", join(ciphertext, []byte{0}), ErrDecrypt},
+		{"chunk after the last", join(full, full[:100]), ErrTrailingData},
+		{"bit flipped", join(chunk(0), chunk(1), []byte{chunk(2)[0] ^ 1}, chunk(2)[1:], chunk(3)), ErrDecrypt},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := decrypt(test.ciphertext)
+			require.ErrorIs(t, err, test.err)
+		})
+	}
+}
@@ -0,0 +1,225 @@
+// Package kem turns a NIKE, such as one of the CTIDH parameter sets,
+// into a key encapsulation mechanism (KEM).
+//
+// Encapsulation generates an ephemeral key pair, sends the ephemeral
+// public key as the ciphertext and derives the shared secret by
+// hashing the NIKE shared secret together with the ciphertext and the
+// recipient's public key. This is the hashed ElGamal construction,
+// which is IND-CCA secure in the random oracle model as long as the
+// NIKE shared secrets stay hard to compute even for an adversary who
+// can check candidate secrets for the recipient's key (the strong
+// Diffie-Hellman assumption). Ciphertexts are validated public keys,
+// so they are exactly CiphertextSize bytes long.
+//
+//	scheme := kem.New(ctidh1024.Scheme())
+//	privateKey, publicKey, err := scheme.GenerateKeyPair(rand.Reader)
+//	...
+//	ciphertext, sharedSecret, err := scheme.Encapsulate(publicKey)
+//	...
+//	sharedSecret, err = scheme.Decapsulate(privateKey, ciphertext)
+package kem
+
+import (
+	"crypto/rand"
+	"errors"
+	"io"
+
+	"golang.org/x/crypto/sha3"
+
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+)
+
+const (
+	// SharedSecretSize is the size in bytes of the shared secrets.
+	SharedSecretSize = 32
+
+	// SeedSize is the size in bytes of the seeds accepted by
+	// DeriveKeyPair and EncapsulateDeterministically.
+	SeedSize = 32
+)
+
+var (
+	// ErrSeedSize indicates that a seed is not SeedSize bytes long.
+	ErrSeedSize = errors.New("kem: seed size is wrong")
+
+	// ErrPrivateKeySize indicates the raw data is not the correct size for a private key.
+	ErrPrivateKeySize = errors.New("kem: raw private key data size is wrong")
+)
+
+// Scheme is the KEM built on a NIKE.
+type Scheme struct {
+	nike nike.Scheme
+}
+
+// New returns the KEM built on the NIKE scheme.
+func New(scheme nike.Scheme) *Scheme {
+	return &Scheme{nike: scheme}
+}
+
+// NIKE returns the underlying NIKE.
+func (s *Scheme) NIKE() nike.Scheme {
+	return s.nike
+}
+
+// Name returns the name of the KEM, for example CTIDH-1024-KEM.
+func (s *Scheme) Name() string {
+	return s.nike.Name() + "-KEM"
+}
+
+// PublicKeySize returns the size in bytes of a public key.
+func (s *Scheme) PublicKeySize() int {
+	return s.nike.PublicKeySize()
+}
+
+// PrivateKeySize returns the size in bytes of a private key, which
+// includes its public key.
+func (s *Scheme) PrivateKeySize() int {
+	return s.nike.PrivateKeySize() + s.nike.PublicKeySize()
+}
+
+// CiphertextSize returns the size in bytes of a ciphertext.
+func (s *Scheme) CiphertextSize() int {
+	return s.nike.PublicKeySize()
+}
+
+// PrivateKey is a KEM private key. Decapsulation needs the public key
+// as well, so it is kept along with the NIKE private key rather than
+// computed again each time.
+type PrivateKey struct {
+	privateKey nike.PrivateKey
+	publicKey  nike.PublicKey
+}
+
+// PublicKey returns the public key of the private key.
+func (p *PrivateKey) PublicKey() nike.PublicKey {
+	return p.publicKey
+}
+
+// Bytes serializes the private key as the NIKE private key followed
+// by the NIKE public key.
+func (p *PrivateKey) Bytes() []byte {
+	return append(p.privateKey.Bytes(), p.publicKey.Bytes()...)
+}
+
+// Reset resets the NIKE private key to all zeros. The public key is
+// left alone, since it is the one GenerateKeyPair returned and is not
+// secret.
+func (p *PrivateKey) Reset() {
+	p.privateKey.Reset()
+}
+
+// NewPrivateKey returns the KEM private key of a NIKE private key.
+func (s *Scheme) NewPrivateKey(privateKey nike.PrivateKey) (*PrivateKey, error) {
+	publicKey, err := s.nike.DerivePublicKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return &PrivateKey{privateKey: privateKey, publicKey: publicKey}, nil
+}
+
+// UnmarshalBinaryPrivateKey loads a private key serialized by Bytes.
+// It does not check that the public key belongs to the private key,
+// which would cost a group action; if it does not, decapsulation
+// simply produces the wrong secrets.
+func (s *Scheme) UnmarshalBinaryPrivateKey(data []byte) (*PrivateKey, error) {
+	if len(data) != s.PrivateKeySize() {
+		return nil, ErrPrivateKeySize
+	}
+	privateKey, err := s.nike.UnmarshalBinaryPrivateKey(data[:s.nike.PrivateKeySize()])
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := s.nike.UnmarshalBinaryPublicKey(data[s.nike.PrivateKeySize():])
+	if err != nil {
+		return nil, err
+	}
+	return &PrivateKey{privateKey: privateKey, publicKey: publicKey}, nil
+}
+
+// UnmarshalBinaryPublicKey loads and validates a public key.
+func (s *Scheme) UnmarshalBinaryPublicKey(data []byte) (nike.PublicKey, error) {
+	return s.nike.UnmarshalBinaryPublicKey(data)
+}
+
+// GenerateKeyPair returns a new key pair drawn from rng.
+func (s *Scheme) GenerateKeyPair(rng io.Reader) (*PrivateKey, nike.PublicKey, error) {
+	privateKey, publicKey, err := s.nike.GenerateKeyPair(rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &PrivateKey{privateKey: privateKey, publicKey: publicKey}, publicKey, nil
+}
+
+// DeriveKeyPair deterministically derives a key pair from a seed of
+// SeedSize bytes.
+func (s *Scheme) DeriveKeyPair(seed []byte) (*PrivateKey, nike.PublicKey, error) {
+	if len(seed) != SeedSize {
+		return nil, nil, ErrSeedSize
+	}
+	return s.GenerateKeyPair(s.xof("key pair", seed))
+}
+
+// Encapsulate generates a shared secret for the owner of publicKey
+// and the ciphertext to send them.
+func (s *Scheme) Encapsulate(publicKey nike.PublicKey) (ciphertext, sharedSecret []byte, err error) {
+	seed := make([]byte, SeedSize)
+	if _, err := io.ReadFull(rand.Reader, seed); err != nil {
+		return nil, nil, err
+	}
+	return s.EncapsulateDeterministically(publicKey, seed)
+}
+
+// EncapsulateDeterministically is like Encapsulate, but derives the
+// ephemeral key pair from a seed of SeedSize bytes. It is meant for
+// tests; reusing a seed reuses the shared secret.
+func (s *Scheme) EncapsulateDeterministically(publicKey nike.PublicKey, seed []byte) (ciphertext, sharedSecret []byte, err error) {
+	if len(seed) != SeedSize {
+		return nil, nil, ErrSeedSize
+	}
+	ephemeralPrivate, ephemeralPublic, err := s.nike.GenerateKeyPair(s.xof("encapsulation", seed))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer ephemeralPrivate.Reset()
+
+	secret, err := s.nike.DeriveSecret(ephemeralPrivate, publicKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	ciphertext = ephemeralPublic.Bytes()
+	return ciphertext, s.kdf(secret, ciphertext, publicKey.Bytes()), nil
+}
+
+// Decapsulate returns the shared secret of the ciphertext. It fails if
+// the ciphertext is not a valid public key.
+func (s *Scheme) Decapsulate(privateKey *PrivateKey, ciphertext []byte) ([]byte, error) {
+	ephemeralPublic, err := s.nike.UnmarshalBinaryPublicKey(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := s.nike.DeriveSecret(privateKey.privateKey, ephemeralPublic)
+	if err != nil {
+		return nil, err
+	}
+	return s.kdf(secret, ciphertext, privateKey.publicKey.Bytes()), nil
+}
+
+// kdf hashes the NIKE shared secret with the ciphertext and the
+// recipient's public key.
+func (s *Scheme) kdf(secret, ciphertext, publicKey []byte) []byte {
+	h := s.xof("shared secret", secret)
+	h.Write(ciphertext)
+	h.Write(publicKey)
+	sharedSecret := make([]byte, SharedSecretSize)
+	h.Read(sharedSecret)
+	return sharedSecret
+}
+
+// xof returns SHAKE256 keyed with the name of the KEM, the purpose
+// and data.
+func (s *Scheme) xof(purpose string, data []byte) sha3.ShakeHash {
+	h := sha3.NewShake256()
+	h.Write([]byte(s.Name() + " " + purpose + "\x00"))
+	h.Write(data)
+	return h
+}
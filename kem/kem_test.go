@@ -0,0 +1,103 @@
+package kem
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"git.xx.network/elixxir/ctidh_cgo/ctidh511"
+	"git.xx.network/elixxir/ctidh_cgo/ctidh512"
+	"git.xx.network/elixxir/ctidh_cgo/nike/x25519"
+)
+
+func TestKEM(t *testing.T) {
+	scheme := New(ctidh512.Scheme())
+	require.Equal(t, "CTIDH-512-KEM", scheme.Name())
+	require.Equal(t, ctidh512.PublicKeySize, scheme.CiphertextSize())
+
+	privateKey, publicKey, err := scheme.GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+
+	ciphertext1, sharedSecret1, err := scheme.Encapsulate(publicKey)
+	require.NoError(t, err)
+	require.Len(t, ciphertext1, scheme.CiphertextSize())
+	require.Len(t, sharedSecret1, SharedSecretSize)
+	ciphertext2, sharedSecret2, err := scheme.Encapsulate(publicKey)
+	require.NoError(t, err)
+	require.NotEqual(t, ciphertext1, ciphertext2)
+	require.NotEqual(t, sharedSecret1, sharedSecret2)
+
+	sharedSecret, err := scheme.Decapsulate(privateKey, ciphertext1)
+	require.NoError(t, err)
+	require.Equal(t, sharedSecret1, sharedSecret)
+
+	// The private key survives serialization.
+	privateKey2, err := scheme.UnmarshalBinaryPrivateKey(privateKey.Bytes())
+	require.NoError(t, err)
+	sharedSecret, err = scheme.Decapsulate(privateKey2, ciphertext2)
+	require.NoError(t, err)
+	require.Equal(t, sharedSecret2, sharedSecret)
+
+	// So does a private key built from a NIKE key.
+	nikePrivate, _ := ctidh512.MustGenerateKeyPair()
+	privateKey3, err := scheme.NewPrivateKey(nikePrivate)
+	require.NoError(t, err)
+	ciphertext, sharedSecret3, err := scheme.Encapsulate(privateKey3.PublicKey())
+	require.NoError(t, err)
+	sharedSecret, err = scheme.Decapsulate(privateKey3, ciphertext)
+	require.NoError(t, err)
+	require.Equal(t, sharedSecret3, sharedSecret)
+}
+
+func TestKEMReset(t *testing.T) {
+	scheme := New(x25519.Scheme())
+	privateKey, publicKey, err := scheme.GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+	publicKeyBytes := publicKey.Bytes()
+
+	// Resetting the private key leaves the returned public key intact.
+	privateKey.Reset()
+	require.Equal(t, publicKeyBytes, publicKey.Bytes())
+	require.Equal(t, publicKeyBytes, privateKey.PublicKey().Bytes())
+	require.Equal(t, make([]byte, scheme.nike.PrivateKeySize()), privateKey.Bytes()[:scheme.nike.PrivateKeySize()])
+}
+
+func TestKEMInvalidCiphertext(t *testing.T) {
+	scheme := New(ctidh511.Scheme())
+	privateKey, _, err := scheme.GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+
+	_, err = scheme.Decapsulate(privateKey, make([]byte, scheme.CiphertextSize()-1))
+	require.ErrorIs(t, err, ctidh511.ErrPublicKeySize)
+
+	invalid := make([]byte, scheme.CiphertextSize())
+	invalid[0] = 2
+	_, err = scheme.Decapsulate(privateKey, invalid)
+	require.ErrorIs(t, err, ctidh511.ErrPublicKeyValidation)
+}
+
+func TestKEMSeeds(t *testing.T) {
+	scheme := New(x25519.Scheme())
+	seed := make([]byte, SeedSize)
+
+	privateKey1, publicKey1, err := scheme.DeriveKeyPair(seed)
+	require.NoError(t, err)
+	privateKey2, _, err := scheme.DeriveKeyPair(seed)
+	require.NoError(t, err)
+	require.Equal(t, privateKey1.Bytes(), privateKey2.Bytes())
+
+	ciphertext1, sharedSecret1, err := scheme.EncapsulateDeterministically(publicKey1, seed)
+	require.NoError(t, err)
+	ciphertext2, sharedSecret2, err := scheme.EncapsulateDeterministically(publicKey1, seed)
+	require.NoError(t, err)
+	require.Equal(t, ciphertext1, ciphertext2)
+	require.Equal(t, sharedSecret1, sharedSecret2)
+
+	_, _, err = scheme.DeriveKeyPair(seed[1:])
+	require.ErrorIs(t, err, ErrSeedSize)
+	_, _, err = scheme.EncapsulateDeterministically(publicKey1, seed[1:])
+	require.ErrorIs(t, err, ErrSeedSize)
+	_, err = scheme.UnmarshalBinaryPrivateKey(seed)
+	require.ErrorIs(t, err, ErrPrivateKeySize)
+}
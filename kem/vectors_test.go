@@ -0,0 +1,76 @@
+package kem
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"git.xx.network/elixxir/ctidh_cgo/ctidh1024"
+	"git.xx.network/elixxir/ctidh_cgo/ctidh2048"
+	"git.xx.network/elixxir/ctidh_cgo/ctidh511"
+	"git.xx.network/elixxir/ctidh_cgo/ctidh512"
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+)
+
+// The known answer tests derive the key pair from the seed
+// 000102...1f and encapsulate with the seed 202122...3f.
+var vectors = []struct {
+	scheme       nike.Scheme
+	publicKey    string
+	ciphertext   string
+	sharedSecret string
+}{
+	{
+		scheme:       ctidh511.Scheme(),
+		publicKey:    "fad73e6bf710bfc04c12be7d740fd0669f23d873aab5732025a23889cd36a7e181cb9bcf2d88623931d5b3de51c33af97fbcd85e134e61843a8d46563d56ff61",
+		ciphertext:   "db8da41f9ccd38d1d8e2a0dfe917f1d9be08d47e42c09296db5c8fe299c09e610a316b807c694d5c4edde7b4f3778bd46704d59340a472342182fca71b867954",
+		sharedSecret: "ea20646b6430b038d83b4887222e10e67f6c4a48d59086940dc97169e41e4356",
+	},
+	{
+		scheme:       ctidh512.Scheme(),
+		publicKey:    "8bcb8ebd11aa89b187d31a52489d0f1891510d7dd7f8b32571c1f5bdb4aad4c1bd9252f78c254b8d5f298b98f4734e7beb8ad6731dc4ca654b479a38ab3f6660",
+		ciphertext:   "7a0a2631d5e5343997fc71d7df42df3cf4eb5b5099dd995a761a936e89b06c1d9cd2a36f3edb97facb1af817b22b9d61ea1f96b4ddbbdd31004a89bbd71af517",
+		sharedSecret: "d39f7639e47c1607476a7ef7ab74a2d53a9a4bb27172ac5a914f54a5fcf99602",
+	},
+	{
+		scheme:       ctidh1024.Scheme(),
+		publicKey:    "3e265e6e0e3691cbd6a40226a9a3ef05f6fc82b79afec17e304fee0ab1eb87632afc67fa140bd7bddc539953d7234b2da5601b62671934aba78d6d99e2e5e492b60626888c2e3508be2e4cb91f41affc51f652e1a013f7317484642f6f957304db9e12761b6bb42181565734737a52643cb16f5136a49321bbcf90ba24286d04",
+		ciphertext:   "f040be145c65500e040071cf529e6c01628af7c8a3ecbd788dd7766b9a539d79a97bcb3c46cc71c1392b889ab5b68efcd6ad83a5540449170beda76f2f4660763ec6abf7f0bc51694e49beac26b9601ee613ae4ed0c2c5963a5c9427e35c98251bc97297948df96f6a1ac7289d8427435ebc3160de851841f3097d4e93a32408",
+		sharedSecret: "6149a997da250878c60c5bdd93e17bf4269ef4f6d79032410144bb5a03cca2ff",
+	},
+	{
+		scheme:       ctidh2048.Scheme(),
+		publicKey:    "25417d37fb0193938f826cf05b6948a1ab0db436a0e6912820f1dccc17e1a3256fc24d85147d4cd1fb4baf4e7b0784e52320f1766b41a3f6389e96d9bfe06f7efb340d0d8529d44a448e4a371e6ee152848cac9aee1c2b072e29b4678d8c6153854da94e405eda120f193f62ba09c5d011155c91c3b03f5034dcf3ff9763df6fd2b43945b8af9828db08c923d90729ca4e4b4560b765986cc902097aa2f3c80eb91fa8264af5a78cf2bafbaec1d27e5280667b9325fccece1bb657d7b7aefebe0526d5321c7bf96fe5d5f18649f069aeed493fe310f8ca8e8a4afa980f743a1590adea40ebab832187b0c0086871b8b32e22bd7411b74948d6a16a0a70de7b12",
+		ciphertext:   "b715efc5793092223953538b25d10410aec602d17872346d596a5b27dcbf991763f8c3e613543fb5e8534efa99b79babce27049e55efc88244eb7ebdc39dfbde9a3adebf9a3af549b848b168c0aed09f5f41d54c43164e18127cfd727d2cc853aa7cdf1d4917c59af8de262906b19807384668cb7f3d3fd6467d2f33ab3334b6925dc27e7f5120a6ae9ee13b561d78cab142a37ca291beb046ca5c47528faa01a1a60b759d685bd0393fe1272cf12fb14cfbf956378ae13a9c6a8f14b3955aa66117b0f22e26906f1b38b73dcc6462d05dd95f0744c615a60dfd8e4f7eceb0e59457f3517857ffba57a4d51dceb47642428170228614fb925f4883954777c215",
+		sharedSecret: "35c9ee99a71eb8e125e03cfbc65c3333f5d8d842e7a5a8a42f54b0f5cc3a7d77",
+	},
+}
+
+func TestVectors(t *testing.T) {
+	keySeed := make([]byte, SeedSize)
+	encapsulationSeed := make([]byte, SeedSize)
+	for i := range keySeed {
+		keySeed[i] = byte(i)
+		encapsulationSeed[i] = byte(SeedSize + i)
+	}
+
+	for _, vector := range vectors {
+		scheme := New(vector.scheme)
+		t.Run(scheme.Name(), func(t *testing.T) {
+			privateKey, publicKey, err := scheme.DeriveKeyPair(keySeed)
+			require.NoError(t, err)
+			require.Equal(t, vector.publicKey, hex.EncodeToString(publicKey.Bytes()))
+
+			ciphertext, sharedSecret, err := scheme.EncapsulateDeterministically(publicKey, encapsulationSeed)
+			require.NoError(t, err)
+			require.Len(t, ciphertext, scheme.CiphertextSize())
+			require.Equal(t, vector.ciphertext, hex.EncodeToString(ciphertext))
+			require.Equal(t, vector.sharedSecret, hex.EncodeToString(sharedSecret))
+
+			sharedSecret, err = scheme.Decapsulate(privateKey, ciphertext)
+			require.NoError(t, err)
+			require.Equal(t, vector.sharedSecret, hex.EncodeToString(sharedSecret))
+		})
+	}
+}
@@ -0,0 +1,8 @@
+//go:build (bits511 && bits512) || (bits511 && bits1024) || (bits511 && bits2048) || (bits512 && bits1024) || (bits512 && bits2048) || (bits1024 && bits2048)
+// +build bits511,bits512 bits511,bits1024 bits511,bits2048 bits512,bits1024 bits512,bits2048 bits1024,bits2048
+
+package ctidh
+
+// This file is only built when more than one parameter set build
+// tag is given and fails the build with the message below.
+var _ = ctidh_build_allows_only_one_of_the_tags_bits511_bits512_bits1024_bits2048
@@ -0,0 +1,100 @@
+// Package nike defines the interface shared by the non-interactive
+// key exchanges (NIKEs) in this module, in the spirit of crypto/ecdh.
+// Code written against Scheme can switch between the CTIDH parameter
+// sets, or between CTIDH and X25519, without changes:
+//
+//	var scheme nike.Scheme = ctidh1024.Scheme()
+//	alicePrivate, alicePublic, err := scheme.GenerateKeyPair(rand.Reader)
+//	...
+//	secret, err := scheme.DeriveSecret(alicePrivate, bobPublic)
+package nike
+
+import (
+	"encoding"
+	"errors"
+	"io"
+)
+
+// ErrKeyType indicates that a key passed to a Scheme was created by a
+// different Scheme.
+var ErrKeyType = errors.New("nike: key belongs to a different scheme")
+
+// PublicKey is a NIKE public key.
+type PublicKey interface {
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+
+	// Bytes returns the encoded public key.
+	Bytes() []byte
+
+	// FromBytes loads the public key from its encoding,
+	// validating it.
+	FromBytes(data []byte) error
+
+	// Reset resets the key to all zeros.
+	Reset()
+}
+
+// PrivateKey is a NIKE private key.
+type PrivateKey interface {
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+
+	// Bytes returns the encoded private key.
+	Bytes() []byte
+
+	// FromBytes loads the private key from its encoding,
+	// validating it.
+	FromBytes(data []byte) error
+
+	// Reset resets the key to all zeros.
+	Reset()
+}
+
+// Scheme is a non-interactive key exchange.
+type Scheme interface {
+	// Name returns the name of the scheme, such as "CTIDH-1024".
+	Name() string
+
+	// PublicKeySize returns the size in bytes of a public key.
+	PublicKeySize() int
+
+	// PrivateKeySize returns the size in bytes of a private key.
+	PrivateKeySize() int
+
+	// BlindingFactorSize returns the size in bytes of the
+	// blinding factors accepted by Blind.
+	BlindingFactorSize() int
+
+	// GenerateKeyPair returns a new key pair drawn from rng.
+	GenerateKeyPair(rng io.Reader) (PrivateKey, PublicKey, error)
+
+	// NewEmptyPublicKey returns a zero public key, suitable to be
+	// loaded with FromBytes or UnmarshalBinary.
+	NewEmptyPublicKey() PublicKey
+
+	// NewEmptyPrivateKey returns a zero private key, suitable to be
+	// loaded with FromBytes or UnmarshalBinary.
+	NewEmptyPrivateKey() PrivateKey
+
+	// UnmarshalBinaryPublicKey decodes and validates a public key.
+	UnmarshalBinaryPublicKey(data []byte) (PublicKey, error)
+
+	// UnmarshalBinaryPrivateKey decodes and validates a private key.
+	UnmarshalBinaryPrivateKey(data []byte) (PrivateKey, error)
+
+	// DerivePublicKey returns the public key of privateKey.
+	DerivePublicKey(privateKey PrivateKey) (PublicKey, error)
+
+	// DeriveSecret returns the secret shared by privateKey and
+	// the owner of the private key of publicKey.
+	DeriveSecret(privateKey PrivateKey, publicKey PublicKey) ([]byte, error)
+
+	// Blind returns publicKey blinded by blindingFactor, which
+	// must be BlindingFactorSize bytes. For the CTIDH and X25519
+	// schemes blinding commutes with DeriveSecret:
+	// DeriveSecret(a, Blind(f, B)) equals DeriveSecret(b, Blind(f, A)).
+	// The hybrid schemes bind the public keys into the secret, so
+	// for them it does not.
+	Blind(blindingFactor []byte, publicKey PublicKey) (PublicKey, error)
+}
@@ -0,0 +1,97 @@
+package nike_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"git.xx.network/elixxir/ctidh_cgo/ctidh1024"
+	"git.xx.network/elixxir/ctidh_cgo/ctidh511"
+	"git.xx.network/elixxir/ctidh_cgo/ctidh512"
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+	"git.xx.network/elixxir/ctidh_cgo/nike/x25519"
+)
+
+var schemes = []nike.Scheme{
+	ctidh511.Scheme(),
+	ctidh512.Scheme(),
+	ctidh1024.Scheme(),
+	x25519.Scheme(),
+}
+
+// testScheme uses nothing but the nike interfaces.
+func testScheme(t *testing.T, scheme nike.Scheme) {
+	alicePrivate, alicePublic, err := scheme.GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+	bobPrivate, bobPublic, err := scheme.GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+	require.Len(t, alicePublic.Bytes(), scheme.PublicKeySize())
+	require.Len(t, alicePrivate.Bytes(), scheme.PrivateKeySize())
+
+	aliceSecret, err := scheme.DeriveSecret(alicePrivate, bobPublic)
+	require.NoError(t, err)
+	bobSecret, err := scheme.DeriveSecret(bobPrivate, alicePublic)
+	require.NoError(t, err)
+	require.Equal(t, aliceSecret, bobSecret)
+
+	derived, err := scheme.DerivePublicKey(alicePrivate)
+	require.NoError(t, err)
+	require.Equal(t, alicePublic.Bytes(), derived.Bytes())
+
+	blob, err := alicePublic.MarshalBinary()
+	require.NoError(t, err)
+	alicePublic2, err := scheme.UnmarshalBinaryPublicKey(blob)
+	require.NoError(t, err)
+	require.Equal(t, blob, alicePublic2.Bytes())
+	alicePublic3 := scheme.NewEmptyPublicKey()
+	require.NoError(t, alicePublic3.UnmarshalBinary(blob))
+	require.Equal(t, blob, alicePublic3.Bytes())
+
+	blob, err = alicePrivate.MarshalBinary()
+	require.NoError(t, err)
+	alicePrivate2, err := scheme.UnmarshalBinaryPrivateKey(blob)
+	require.NoError(t, err)
+	require.Equal(t, blob, alicePrivate2.Bytes())
+	alicePrivate3 := scheme.NewEmptyPrivateKey()
+	require.NoError(t, alicePrivate3.FromBytes(blob))
+	require.Equal(t, blob, alicePrivate3.Bytes())
+
+	factor := make([]byte, scheme.BlindingFactorSize())
+	_, err = rand.Read(factor)
+	require.NoError(t, err)
+	blindedAlice, err := scheme.Blind(factor, alicePublic)
+	require.NoError(t, err)
+	blindedBob, err := scheme.Blind(factor, bobPublic)
+	require.NoError(t, err)
+	aliceSecret, err = scheme.DeriveSecret(alicePrivate, blindedBob)
+	require.NoError(t, err)
+	bobSecret, err = scheme.DeriveSecret(bobPrivate, blindedAlice)
+	require.NoError(t, err)
+	require.Equal(t, aliceSecret, bobSecret)
+
+	alicePrivate.Reset()
+	require.Equal(t, make([]byte, scheme.PrivateKeySize()), alicePrivate.Bytes())
+}
+
+func TestSchemes(t *testing.T) {
+	for _, scheme := range schemes {
+		t.Run(scheme.Name(), func(t *testing.T) {
+			testScheme(t, scheme)
+		})
+	}
+}
+
+func TestSchemeKeyMismatch(t *testing.T) {
+	ctidhPrivate, ctidhPublic, err := ctidh512.Scheme().GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+	x25519Private, x25519Public, err := x25519.Scheme().GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+
+	_, err = ctidh512.Scheme().DeriveSecret(ctidhPrivate, x25519Public)
+	require.ErrorIs(t, err, nike.ErrKeyType)
+	_, err = x25519.Scheme().DeriveSecret(x25519Private, ctidhPublic)
+	require.ErrorIs(t, err, nike.ErrKeyType)
+	_, err = ctidh1024.Scheme().DeriveSecret(ctidhPrivate, ctidhPublic)
+	require.ErrorIs(t, err, nike.ErrKeyType)
+}
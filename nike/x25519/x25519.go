@@ -0,0 +1,226 @@
+// Package x25519 provides X25519 as a nike.Scheme, so that it can be
+// used interchangeably with the CTIDH parameter sets.
+package x25519
+
+import (
+	"crypto/hmac"
+	"errors"
+	"io"
+
+	"golang.org/x/crypto/curve25519"
+
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+)
+
+const (
+	// PublicKeySize is the size in bytes of a public key.
+	PublicKeySize = curve25519.PointSize
+
+	// PrivateKeySize is the size in bytes of a private key.
+	PrivateKeySize = curve25519.ScalarSize
+
+	// BlindingFactorSize is the size in bytes of a blinding factor.
+	BlindingFactorSize = curve25519.ScalarSize
+)
+
+var (
+	// ErrPublicKeySize indicates the raw data is not the correct size for a public key.
+	ErrPublicKeySize = errors.New("X25519: raw public key data size is wrong")
+
+	// ErrPrivateKeySize indicates the raw data is not the correct size for a private key.
+	ErrPrivateKeySize = errors.New("X25519: raw private key data size is wrong")
+
+	// ErrBlindDataSizeInvalid indicates that the blinding data size was invalid.
+	ErrBlindDataSizeInvalid = errors.New("X25519: blinding data size invalid")
+)
+
+// PublicKey is an X25519 public key.
+type PublicKey struct {
+	publicKey [PublicKeySize]byte
+}
+
+// Bytes returns the PublicKey as a byte slice.
+func (p *PublicKey) Bytes() []byte {
+	return append([]byte{}, p.publicKey[:]...)
+}
+
+// FromBytes loads a PublicKey from the given byte slice.
+func (p *PublicKey) FromBytes(data []byte) error {
+	if len(data) != PublicKeySize {
+		return ErrPublicKeySize
+	}
+	copy(p.publicKey[:], data)
+	return nil
+}
+
+// MarshalBinary is an implementation of a method on the
+// BinaryMarshaler interface defined in https://golang.org/pkg/encoding/
+func (p *PublicKey) MarshalBinary() ([]byte, error) {
+	return p.Bytes(), nil
+}
+
+// UnmarshalBinary is an implementation of a method on the
+// BinaryUnmarshaler interface defined in https://golang.org/pkg/encoding/
+func (p *PublicKey) UnmarshalBinary(data []byte) error {
+	return p.FromBytes(data)
+}
+
+// Reset resets the PublicKey to all zeros.
+func (p *PublicKey) Reset() {
+	*p = PublicKey{}
+}
+
+// Equal is a constant time comparison of the two public keys.
+func (p *PublicKey) Equal(publicKey *PublicKey) bool {
+	return hmac.Equal(p.publicKey[:], publicKey.publicKey[:])
+}
+
+// PrivateKey is an X25519 private key.
+type PrivateKey struct {
+	privateKey [PrivateKeySize]byte
+}
+
+// Bytes serializes PrivateKey into a byte slice.
+func (p *PrivateKey) Bytes() []byte {
+	return append([]byte{}, p.privateKey[:]...)
+}
+
+// FromBytes loads a PrivateKey from the given byte slice.
+func (p *PrivateKey) FromBytes(data []byte) error {
+	if len(data) != PrivateKeySize {
+		return ErrPrivateKeySize
+	}
+	copy(p.privateKey[:], data)
+	return nil
+}
+
+// MarshalBinary is an implementation of a method on the
+// BinaryMarshaler interface defined in https://golang.org/pkg/encoding/
+func (p *PrivateKey) MarshalBinary() ([]byte, error) {
+	return p.Bytes(), nil
+}
+
+// UnmarshalBinary is an implementation of a method on the
+// BinaryUnmarshaler interface defined in https://golang.org/pkg/encoding/
+func (p *PrivateKey) UnmarshalBinary(data []byte) error {
+	return p.FromBytes(data)
+}
+
+// Reset resets the PrivateKey to all zeros.
+func (p *PrivateKey) Reset() {
+	*p = PrivateKey{}
+}
+
+// Equal is a constant time comparison of the two private keys.
+func (p *PrivateKey) Equal(privateKey *PrivateKey) bool {
+	return hmac.Equal(p.privateKey[:], privateKey.privateKey[:])
+}
+
+// scheme is the nike.Scheme of X25519.
+type scheme struct{}
+
+var _ nike.Scheme = scheme{}
+
+// Scheme returns X25519 as a nike.Scheme, whose keys are the
+// *PublicKey and *PrivateKey of this package.
+func Scheme() nike.Scheme {
+	return scheme{}
+}
+
+func (scheme) Name() string {
+	return "X25519"
+}
+
+func (scheme) PublicKeySize() int {
+	return PublicKeySize
+}
+
+func (scheme) PrivateKeySize() int {
+	return PrivateKeySize
+}
+
+func (scheme) BlindingFactorSize() int {
+	return BlindingFactorSize
+}
+
+func (s scheme) GenerateKeyPair(rng io.Reader) (nike.PrivateKey, nike.PublicKey, error) {
+	privKey := new(PrivateKey)
+	if _, err := io.ReadFull(rng, privKey.privateKey[:]); err != nil {
+		return nil, nil, err
+	}
+	pubKey, err := s.DerivePublicKey(privKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return privKey, pubKey, nil
+}
+
+func (scheme) NewEmptyPublicKey() nike.PublicKey {
+	return new(PublicKey)
+}
+
+func (scheme) NewEmptyPrivateKey() nike.PrivateKey {
+	return new(PrivateKey)
+}
+
+func (scheme) UnmarshalBinaryPublicKey(data []byte) (nike.PublicKey, error) {
+	pubKey := new(PublicKey)
+	if err := pubKey.FromBytes(data); err != nil {
+		return nil, err
+	}
+	return pubKey, nil
+}
+
+func (scheme) UnmarshalBinaryPrivateKey(data []byte) (nike.PrivateKey, error) {
+	privKey := new(PrivateKey)
+	if err := privKey.FromBytes(data); err != nil {
+		return nil, err
+	}
+	return privKey, nil
+}
+
+func (scheme) DerivePublicKey(privateKey nike.PrivateKey) (nike.PublicKey, error) {
+	privKey, ok := privateKey.(*PrivateKey)
+	if !ok {
+		return nil, nike.ErrKeyType
+	}
+	pubKey := new(PublicKey)
+	out, err := curve25519.X25519(privKey.privateKey[:], curve25519.Basepoint)
+	if err != nil {
+		return nil, err
+	}
+	copy(pubKey.publicKey[:], out)
+	return pubKey, nil
+}
+
+// DeriveSecret returns the X25519 shared secret. It fails if the
+// public key is of low order, so that the secret would be all zeros.
+func (scheme) DeriveSecret(privateKey nike.PrivateKey, publicKey nike.PublicKey) ([]byte, error) {
+	privKey, ok := privateKey.(*PrivateKey)
+	if !ok {
+		return nil, nike.ErrKeyType
+	}
+	pubKey, ok := publicKey.(*PublicKey)
+	if !ok {
+		return nil, nike.ErrKeyType
+	}
+	return curve25519.X25519(privKey.privateKey[:], pubKey.publicKey[:])
+}
+
+// Blind multiplies the public key by the clamped blinding factor.
+func (scheme) Blind(blindingFactor []byte, publicKey nike.PublicKey) (nike.PublicKey, error) {
+	if len(blindingFactor) != BlindingFactorSize {
+		return nil, ErrBlindDataSizeInvalid
+	}
+	pubKey, ok := publicKey.(*PublicKey)
+	if !ok {
+		return nil, nike.ErrKeyType
+	}
+	out, err := curve25519.X25519(blindingFactor, pubKey.publicKey[:])
+	if err != nil {
+		return nil, err
+	}
+	blinded := new(PublicKey)
+	copy(blinded.publicKey[:], out)
+	return blinded, nil
+}
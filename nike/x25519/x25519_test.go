@@ -0,0 +1,54 @@
+package x25519
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestRFC7748 checks the Diffie-Hellman example of RFC 7748, section 6.1.
+func TestRFC7748(t *testing.T) {
+	scheme := Scheme()
+
+	alicePrivate, err := scheme.UnmarshalBinaryPrivateKey(mustHex("77076d0a7318a57d3c16c17251b26645df4c2f87ebc0992ab177fba51db92c2a"))
+	require.NoError(t, err)
+	bobPrivate, err := scheme.UnmarshalBinaryPrivateKey(mustHex("5dab087e624a8a4b79e17f8b83800ee66f3bb1292618b6fd1c2f8b27ff88e0eb"))
+	require.NoError(t, err)
+
+	alicePublic, err := scheme.DerivePublicKey(alicePrivate)
+	require.NoError(t, err)
+	require.Equal(t, mustHex("8520f0098930a754748b7ddcb43ef75a0dbf3a0d26381af4eba4a98eaa9b4e6a"), alicePublic.Bytes())
+	bobPublic, err := scheme.DerivePublicKey(bobPrivate)
+	require.NoError(t, err)
+	require.Equal(t, mustHex("de9edb7d7b7dc1b4d35b61c2ece435373f8343c85b78674dadfc7e146f882b4f"), bobPublic.Bytes())
+
+	secret, err := scheme.DeriveSecret(alicePrivate, bobPublic)
+	require.NoError(t, err)
+	require.Equal(t, mustHex("4a5d9d5ba4ce2de1728e3bf480350f25e07e21c947d19e3376f09b3c1e161742"), secret)
+}
+
+func TestLowOrderPublicKey(t *testing.T) {
+	scheme := Scheme()
+	privateKey, _, err := scheme.GenerateKeyPair(zeroReader{})
+	require.NoError(t, err)
+	_, err = scheme.DeriveSecret(privateKey, new(PublicKey))
+	require.Error(t, err)
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
+
+func mustHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
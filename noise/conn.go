@@ -0,0 +1,232 @@
+package noise
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+)
+
+// frameHeaderSize is the size of the big-endian length prefix of the
+// messages sent by Conn.
+const frameHeaderSize = 2
+
+// Conn is a net.Conn secured by a Noise handshake, with an empty
+// payload in every handshake message. Handshake and transport
+// messages are each prefixed by their length as a 2 byte big-endian
+// integer. Writes longer than a transport message are split over
+// several.
+type Conn struct {
+	conn   net.Conn
+	config Config
+
+	handshakeMutex sync.Mutex
+	handshakeErr   error
+	hs             *HandshakeState
+
+	in      *CipherState
+	inMutex sync.Mutex
+	inErr   error
+	input   []byte
+
+	out      *CipherState
+	outMutex sync.Mutex
+	outErr   error
+}
+
+// Client returns a Conn which runs the handshake over conn as the
+// initiator. config.Initiator is ignored.
+func Client(conn net.Conn, config Config) *Conn {
+	config.Initiator = true
+	return &Conn{conn: conn, config: config}
+}
+
+// Server returns a Conn which runs the handshake over conn as the
+// responder. config.Initiator is ignored.
+func Server(conn net.Conn, config Config) *Conn {
+	config.Initiator = false
+	return &Conn{conn: conn, config: config}
+}
+
+// Handshake runs the handshake if it has not yet been run. Read and
+// Write call it automatically.
+func (c *Conn) Handshake() error {
+	c.handshakeMutex.Lock()
+	defer c.handshakeMutex.Unlock()
+	if c.hs != nil || c.handshakeErr != nil {
+		return c.handshakeErr
+	}
+	c.handshakeErr = c.handshake()
+	return c.handshakeErr
+}
+
+func (c *Conn) handshake() error {
+	hs, err := NewHandshakeState(c.config)
+	if err != nil {
+		return err
+	}
+
+	var message []byte
+	var cs1, cs2 *CipherState
+	for !hs.Complete() {
+		if hs.myTurn() {
+			message, cs1, cs2, err = hs.WriteMessage(nil, nil)
+			if err == nil {
+				err = c.writeFrame(message)
+			}
+		} else {
+			message, err = c.readFrame()
+			if err == nil {
+				_, cs1, cs2, err = hs.ReadMessage(nil, message)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	c.hs = hs
+	c.in, c.out = cs1, cs2
+	if c.config.Initiator {
+		c.in, c.out = cs2, cs1
+	}
+	return nil
+}
+
+func (c *Conn) writeFrame(message []byte) error {
+	frame := make([]byte, frameHeaderSize, frameHeaderSize+len(message))
+	binary.BigEndian.PutUint16(frame, uint16(len(message)))
+	_, err := c.conn.Write(append(frame, message...))
+	return err
+}
+
+func (c *Conn) readFrame() ([]byte, error) {
+	var header [frameHeaderSize]byte
+	if _, err := io.ReadFull(c.conn, header[:]); err != nil {
+		return nil, err
+	}
+	message := make([]byte, binary.BigEndian.Uint16(header[:]))
+	if _, err := io.ReadFull(c.conn, message); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return message, nil
+}
+
+// Read reads decrypted data from the connection. A transport message
+// which fails to authenticate makes this and all further reads fail.
+func (c *Conn) Read(b []byte) (int, error) {
+	if err := c.Handshake(); err != nil {
+		return 0, err
+	}
+	c.inMutex.Lock()
+	defer c.inMutex.Unlock()
+
+	for len(c.input) == 0 {
+		if c.inErr != nil {
+			return 0, c.inErr
+		}
+		message, err := c.readFrame()
+		if err != nil {
+			c.inErr = err
+			continue
+		}
+		c.input, c.inErr = c.in.Decrypt(message[:0], nil, message)
+	}
+	n := copy(b, c.input)
+	c.input = c.input[n:]
+	return n, nil
+}
+
+// Write encrypts b and writes it to the connection.
+func (c *Conn) Write(b []byte) (int, error) {
+	if err := c.Handshake(); err != nil {
+		return 0, err
+	}
+	c.outMutex.Lock()
+	defer c.outMutex.Unlock()
+
+	if c.outErr != nil {
+		return 0, c.outErr
+	}
+	maxPayload := MaxMessageSize - c.out.aead.Overhead()
+	n := 0
+	for len(b) > 0 {
+		chunk := b
+		if len(chunk) > maxPayload {
+			chunk = chunk[:maxPayload]
+		}
+		message, err := c.out.Encrypt(nil, nil, chunk)
+		if err == nil {
+			err = c.writeFrame(message)
+		}
+		if err != nil {
+			c.outErr = err
+			return n, err
+		}
+		n += len(chunk)
+		b = b[len(chunk):]
+	}
+	return n, nil
+}
+
+// Close closes the underlying connection.
+func (c *Conn) Close() error {
+	return c.conn.Close()
+}
+
+// LocalAddr returns the local network address.
+func (c *Conn) LocalAddr() net.Addr {
+	return c.conn.LocalAddr()
+}
+
+// RemoteAddr returns the remote network address.
+func (c *Conn) RemoteAddr() net.Addr {
+	return c.conn.RemoteAddr()
+}
+
+// SetDeadline sets the read and write deadlines of the underlying
+// connection.
+func (c *Conn) SetDeadline(t time.Time) error {
+	return c.conn.SetDeadline(t)
+}
+
+// SetReadDeadline sets the read deadline of the underlying
+// connection.
+func (c *Conn) SetReadDeadline(t time.Time) error {
+	return c.conn.SetReadDeadline(t)
+}
+
+// SetWriteDeadline sets the write deadline of the underlying
+// connection.
+func (c *Conn) SetWriteDeadline(t time.Time) error {
+	return c.conn.SetWriteDeadline(t)
+}
+
+// PeerStatic returns the remote static public key authenticated by
+// the handshake, or nil if the handshake is not complete or the
+// pattern does not authenticate the peer.
+func (c *Conn) PeerStatic() nike.PublicKey {
+	c.handshakeMutex.Lock()
+	defer c.handshakeMutex.Unlock()
+	if c.hs == nil {
+		return nil
+	}
+	return c.hs.PeerStatic()
+}
+
+// HandshakeHash returns the handshake hash, or nil if the handshake
+// is not complete.
+func (c *Conn) HandshakeHash() []byte {
+	c.handshakeMutex.Lock()
+	defer c.handshakeMutex.Unlock()
+	if c.hs == nil {
+		return nil
+	}
+	return c.hs.HandshakeHash()
+}
@@ -0,0 +1,255 @@
+// Package noise implements handshakes of the Noise Protocol Framework,
+// revision 34, with the CTIDH NIKE as the DH function.
+//
+// Any nike.Scheme of this module can be used as DH: the CTIDH parameter
+// sets, X25519, and the hybrids of CTIDH with X25519, which give
+// post-quantum variants of every pattern that stay as strong as X25519
+// if CTIDH is broken. Their names in protocol names are CTIDH511,
+// CTIDH512, CTIDH1024, CTIDH2048, 25519 and, for the hybrids, for
+// example CTIDH1024+25519:
+//
+//	suite, err := noise.NewCipherSuite(ctidh1024.Scheme(), noise.CipherChaChaPoly, noise.HashBLAKE2b)
+//	...
+//	hs, err := noise.NewHandshakeState(noise.Config{
+//		CipherSuite:   suite,
+//		Pattern:       noise.HandshakeXX,
+//		Initiator:     true,
+//		StaticKeypair: staticKeypair,
+//	})
+//
+// is the protocol Noise_XX_CTIDH1024_ChaChaPoly_BLAKE2b. Client and
+// Server wrap a net.Conn in a Conn, which runs the handshake and then
+// encrypts the stream in length prefixed transport messages.
+//
+// GENERATE_KEYPAIR is the GenerateKeyPair of the scheme, DH is its
+// DeriveSecret, and public keys are sent as encoded by Bytes and
+// received with FromBytes, which validates them. DHLEN is the public
+// key size; the DH output is the whole CTIDH shared secret, which
+// unlike the outputs of the DH functions of the specification may be
+// longer than HASHLEN. That makes no difference to MixKey, which feeds
+// it to HKDF. CTIDH public keys are not uniformly random strings, so
+// handshake messages can be told apart from random data.
+package noise
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"hash"
+	"strings"
+
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/blake2s"
+	"golang.org/x/crypto/chacha20poly1305"
+
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+)
+
+// MaxMessageSize is the maximum size in bytes of a Noise message.
+const MaxMessageSize = 65535
+
+var (
+	// ErrDH indicates an unsupported DH function.
+	ErrDH = errors.New("noise: unsupported DH function")
+
+	// ErrProtocolName indicates a malformed or unsupported protocol
+	// name.
+	ErrProtocolName = errors.New("noise: unsupported protocol name")
+
+	// ErrMissingKey indicates that a key required by the handshake
+	// pattern was not configured.
+	ErrMissingKey = errors.New("noise: missing key required by the handshake pattern")
+
+	// ErrOutOfTurn indicates a handshake message written or read
+	// out of turn, or after the handshake is complete.
+	ErrOutOfTurn = errors.New("noise: handshake message out of turn")
+
+	// ErrShortMessage indicates a handshake message which is too
+	// short for its tokens.
+	ErrShortMessage = errors.New("noise: message is too short")
+
+	// ErrMessageSize indicates a message longer than MaxMessageSize.
+	ErrMessageSize = errors.New("noise: message is too long")
+
+	// ErrDecrypt indicates that a ciphertext failed to authenticate.
+	ErrDecrypt = errors.New("noise: message authentication failed")
+
+	// ErrNonce indicates that the nonce of a CipherState is
+	// exhausted.
+	ErrNonce = errors.New("noise: nonce exhausted")
+)
+
+// Cipher is a Noise cipher function.
+type Cipher struct {
+	name       string
+	newAEAD    func(key []byte) (cipher.AEAD, error)
+	putCounter func(nonce []byte, n uint64)
+}
+
+// The supported cipher functions.
+var (
+	CipherChaChaPoly = &Cipher{
+		name:    "ChaChaPoly",
+		newAEAD: chacha20poly1305.New,
+		putCounter: func(nonce []byte, n uint64) {
+			binary.LittleEndian.PutUint64(nonce[4:], n)
+		},
+	}
+	CipherAESGCM = &Cipher{
+		name: "AESGCM",
+		newAEAD: func(key []byte) (cipher.AEAD, error) {
+			block, err := aes.NewCipher(key)
+			if err != nil {
+				return nil, err
+			}
+			return cipher.NewGCM(block)
+		},
+		putCounter: func(nonce []byte, n uint64) {
+			binary.BigEndian.PutUint64(nonce[4:], n)
+		},
+	}
+)
+
+// Name returns the name of the cipher in protocol names.
+func (c *Cipher) Name() string {
+	return c.name
+}
+
+// Hash is a Noise hash function.
+type Hash struct {
+	name string
+	new  func() hash.Hash
+}
+
+// The supported hash functions.
+var (
+	HashSHA256  = &Hash{name: "SHA256", new: sha256.New}
+	HashSHA512  = &Hash{name: "SHA512", new: sha512.New}
+	HashBLAKE2s = &Hash{name: "BLAKE2s", new: func() hash.Hash {
+		h, _ := blake2s.New256(nil)
+		return h
+	}}
+	HashBLAKE2b = &Hash{name: "BLAKE2b", new: func() hash.Hash {
+		h, _ := blake2b.New512(nil)
+		return h
+	}}
+)
+
+// Name returns the name of the hash in protocol names.
+func (h *Hash) Name() string {
+	return h.name
+}
+
+// Size returns HASHLEN.
+func (h *Hash) Size() int {
+	return h.new().Size()
+}
+
+// dhNames maps the names of the supported NIKEs to their names in
+// Noise protocol names.
+var dhNames = map[string]string{
+	"X25519":            "25519",
+	"CTIDH-511":         "CTIDH511",
+	"CTIDH-512":         "CTIDH512",
+	"CTIDH-1024":        "CTIDH1024",
+	"CTIDH-2048":        "CTIDH2048",
+	"CTIDH-511-X25519":  "CTIDH511+25519",
+	"CTIDH-512-X25519":  "CTIDH512+25519",
+	"CTIDH-1024-X25519": "CTIDH1024+25519",
+	"CTIDH-2048-X25519": "CTIDH2048+25519",
+}
+
+// CipherSuite is a combination of a DH function, a cipher function
+// and a hash function.
+type CipherSuite struct {
+	dh     nike.Scheme
+	dhName string
+	cipher *Cipher
+	hash   *Hash
+}
+
+// NewCipherSuite returns the cipher suite of the DH function dh, which
+// must be X25519, a CTIDH parameter set or a CTIDH hybrid with X25519,
+// the cipher function c and the hash function h.
+func NewCipherSuite(dh nike.Scheme, c *Cipher, h *Hash) (*CipherSuite, error) {
+	dhName, ok := dhNames[dh.Name()]
+	if !ok {
+		return nil, ErrDH
+	}
+	return &CipherSuite{dh: dh, dhName: dhName, cipher: c, hash: h}, nil
+}
+
+// Name returns the name of the cipher suite in protocol names, such
+// as "CTIDH1024_ChaChaPoly_BLAKE2b".
+func (s *CipherSuite) Name() string {
+	return s.dhName + "_" + s.cipher.name + "_" + s.hash.name
+}
+
+// DH returns the DH function of the cipher suite.
+func (s *CipherSuite) DH() nike.Scheme {
+	return s.dh
+}
+
+// Cipher returns the cipher function of the cipher suite.
+func (s *CipherSuite) Cipher() *Cipher {
+	return s.cipher
+}
+
+// Hash returns the hash function of the cipher suite.
+func (s *CipherSuite) Hash() *Hash {
+	return s.hash
+}
+
+// ProtocolName returns the name of the Noise protocol of pattern with
+// the cipher suite, such as "Noise_XX_CTIDH1024_ChaChaPoly_BLAKE2b".
+func (s *CipherSuite) ProtocolName(pattern HandshakePattern) string {
+	return "Noise_" + pattern.Name + "_" + s.Name()
+}
+
+// ParseProtocolName returns the cipher suite and handshake pattern of
+// a protocol name such as "Noise_XX_CTIDH1024_ChaChaPoly_BLAKE2b". The
+// DH function is looked up among schemes, so that only the NIKEs an
+// application uses need to be linked into it.
+func ParseProtocolName(name string, schemes ...nike.Scheme) (*CipherSuite, HandshakePattern, error) {
+	parts := strings.Split(name, "_")
+	if len(parts) != 5 || parts[0] != "Noise" {
+		return nil, HandshakePattern{}, ErrProtocolName
+	}
+
+	var pattern HandshakePattern
+	for _, p := range patterns {
+		if p.Name == parts[1] {
+			pattern = p
+		}
+	}
+	var dh nike.Scheme
+	for _, scheme := range schemes {
+		if dhNames[scheme.Name()] == parts[2] {
+			dh = scheme
+		}
+	}
+	var c *Cipher
+	for _, cipher := range []*Cipher{CipherChaChaPoly, CipherAESGCM} {
+		if cipher.name == parts[3] {
+			c = cipher
+		}
+	}
+	var h *Hash
+	for _, hash := range []*Hash{HashSHA256, HashSHA512, HashBLAKE2s, HashBLAKE2b} {
+		if hash.name == parts[4] {
+			h = hash
+		}
+	}
+	if pattern.Name == "" || dh == nil || c == nil || h == nil {
+		return nil, HandshakePattern{}, ErrProtocolName
+	}
+
+	suite, err := NewCipherSuite(dh, c, h)
+	if err != nil {
+		return nil, HandshakePattern{}, err
+	}
+	return suite, pattern, nil
+}
@@ -0,0 +1,382 @@
+package noise
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"math"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"git.xx.network/elixxir/ctidh_cgo/ctidh511"
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+	"git.xx.network/elixxir/ctidh_cgo/nike/x25519"
+)
+
+func newTestSuite(t *testing.T, dh nike.Scheme) *CipherSuite {
+	suite, err := NewCipherSuite(dh, CipherChaChaPoly, HashBLAKE2b)
+	require.NoError(t, err)
+	return suite
+}
+
+func newTestKey(t *testing.T, dh nike.Scheme) *DHKey {
+	privateKey, publicKey, err := dh.GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+	return &DHKey{Private: privateKey, Public: publicKey}
+}
+
+// newTestHandshake returns the handshake states of an initiator and a
+// responder of pattern with static keys as the pattern requires.
+func newTestHandshake(t *testing.T, suite *CipherSuite, pattern HandshakePattern) (*HandshakeState, *HandshakeState) {
+	initiatorKey := newTestKey(t, suite.DH())
+	responderKey := newTestKey(t, suite.DH())
+	initiatorConfig := Config{
+		CipherSuite:   suite,
+		Pattern:       pattern,
+		Initiator:     true,
+		Prologue:      []byte("prologue"),
+		StaticKeypair: initiatorKey,
+	}
+	responderConfig := Config{
+		CipherSuite:   suite,
+		Pattern:       pattern,
+		Prologue:      []byte("prologue"),
+		StaticKeypair: responderKey,
+	}
+	if hasToken(pattern.ResponderPreMessages, TokenS) {
+		initiatorConfig.PeerStatic = responderKey.Public
+	}
+	if hasToken(pattern.InitiatorPreMessages, TokenS) {
+		responderConfig.PeerStatic = initiatorKey.Public
+	}
+	initiator, err := NewHandshakeState(initiatorConfig)
+	require.NoError(t, err)
+	responder, err := NewHandshakeState(responderConfig)
+	require.NoError(t, err)
+	return initiator, responder
+}
+
+// runHandshake runs the handshake between initiator and responder and
+// returns the CipherStates of the initiator followed by those of the
+// responder.
+func runHandshake(t *testing.T, initiator, responder *HandshakeState) [4]*CipherState {
+	var cs [4]*CipherState
+	writer, reader := initiator, responder
+	for i := 0; !initiator.Complete(); i++ {
+		payload := []byte{byte(i), 'p', 'a', 'y'}
+		message, cs1, cs2, err := writer.WriteMessage(nil, payload)
+		require.NoError(t, err)
+		got, cs3, cs4, err := reader.ReadMessage(nil, message)
+		require.NoError(t, err)
+		require.Equal(t, payload, got)
+		if writer == initiator {
+			cs = [4]*CipherState{cs1, cs2, cs3, cs4}
+		} else {
+			cs = [4]*CipherState{cs3, cs4, cs1, cs2}
+		}
+		writer, reader = reader, writer
+	}
+	require.True(t, responder.Complete())
+	return cs
+}
+
+func TestHandshakePatterns(t *testing.T) {
+	schemes := []nike.Scheme{ctidh511.Scheme(), ctidh511.HybridScheme(), x25519.Scheme()}
+	for _, scheme := range schemes {
+		suite := newTestSuite(t, scheme)
+		for _, pattern := range patterns {
+			t.Run(suite.ProtocolName(pattern), func(t *testing.T) {
+				initiator, responder := newTestHandshake(t, suite, pattern)
+				cs := runHandshake(t, initiator, responder)
+				require.Equal(t, initiator.HandshakeHash(), responder.HandshakeHash())
+
+				if pattern.Name == "NN" {
+					require.Nil(t, initiator.PeerStatic())
+				} else {
+					require.Equal(t, responder.s.Public.Bytes(), initiator.PeerStatic().Bytes())
+				}
+				if pattern.Name == "NN" || pattern.Name == "NK" {
+					require.Nil(t, responder.PeerStatic())
+				} else {
+					require.Equal(t, initiator.s.Public.Bytes(), responder.PeerStatic().Bytes())
+				}
+
+				ciphertext, err := cs[0].Encrypt(nil, nil, []byte("to the responder"))
+				require.NoError(t, err)
+				plaintext, err := cs[2].Decrypt(nil, nil, ciphertext)
+				require.NoError(t, err)
+				require.Equal(t, []byte("to the responder"), plaintext)
+
+				ciphertext, err = cs[3].Encrypt(nil, nil, []byte("to the initiator"))
+				require.NoError(t, err)
+				plaintext, err = cs[1].Decrypt(nil, nil, ciphertext)
+				require.NoError(t, err)
+				require.Equal(t, []byte("to the initiator"), plaintext)
+			})
+		}
+	}
+}
+
+func TestCipherSuites(t *testing.T) {
+	for _, c := range []*Cipher{CipherChaChaPoly, CipherAESGCM} {
+		for _, h := range []*Hash{HashSHA256, HashSHA512, HashBLAKE2s, HashBLAKE2b} {
+			suite, err := NewCipherSuite(x25519.Scheme(), c, h)
+			require.NoError(t, err)
+			t.Run(suite.Name(), func(t *testing.T) {
+				initiator, responder := newTestHandshake(t, suite, HandshakeXX)
+				cs := runHandshake(t, initiator, responder)
+				require.Equal(t, initiator.HandshakeHash(), responder.HandshakeHash())
+				require.Len(t, initiator.HandshakeHash(), h.Size())
+
+				ciphertext, err := cs[0].Encrypt(nil, []byte("ad"), []byte("message"))
+				require.NoError(t, err)
+				plaintext, err := cs[2].Decrypt(nil, []byte("ad"), ciphertext)
+				require.NoError(t, err)
+				require.Equal(t, []byte("message"), plaintext)
+			})
+		}
+	}
+}
+
+func TestProtocolName(t *testing.T) {
+	schemes := []nike.Scheme{ctidh511.Scheme(), ctidh511.HybridScheme(), x25519.Scheme()}
+
+	suite := newTestSuite(t, ctidh511.Scheme())
+	require.Equal(t, "Noise_XX_CTIDH511_ChaChaPoly_BLAKE2b", suite.ProtocolName(HandshakeXX))
+	suite = newTestSuite(t, ctidh511.HybridScheme())
+	require.Equal(t, "Noise_IK_CTIDH511+25519_ChaChaPoly_BLAKE2b", suite.ProtocolName(HandshakeIK))
+
+	for _, name := range []string{
+		"Noise_NN_25519_AESGCM_SHA256",
+		"Noise_KK_CTIDH511_ChaChaPoly_SHA512",
+		"Noise_XX_CTIDH511+25519_AESGCM_BLAKE2s",
+	} {
+		suite, pattern, err := ParseProtocolName(name, schemes...)
+		require.NoError(t, err)
+		require.Equal(t, name, suite.ProtocolName(pattern))
+	}
+
+	for _, name := range []string{
+		"Noise_XX_CTIDH1024_ChaChaPoly_BLAKE2b",
+		"Noise_XY_25519_ChaChaPoly_BLAKE2b",
+		"Noise_XX_25519_ChaChaPoly_MD5",
+		"Noise_XX_25519_DES_BLAKE2b",
+		"Noise_XX_25519_ChaChaPoly",
+		"Noisy_XX_25519_ChaChaPoly_BLAKE2b",
+	} {
+		_, _, err := ParseProtocolName(name, schemes...)
+		require.ErrorIs(t, err, ErrProtocolName)
+	}
+}
+
+type otherScheme struct {
+	nike.Scheme
+}
+
+func (otherScheme) Name() string {
+	return "X448"
+}
+
+func TestUnsupportedDH(t *testing.T) {
+	_, err := NewCipherSuite(otherScheme{x25519.Scheme()}, CipherChaChaPoly, HashBLAKE2b)
+	require.ErrorIs(t, err, ErrDH)
+}
+
+func TestMissingKey(t *testing.T) {
+	suite := newTestSuite(t, x25519.Scheme())
+	key := newTestKey(t, x25519.Scheme())
+
+	_, err := NewHandshakeState(Config{CipherSuite: suite, Pattern: HandshakeNN, Initiator: true})
+	require.NoError(t, err)
+	_, err = NewHandshakeState(Config{CipherSuite: suite, Pattern: HandshakeNK, Initiator: true})
+	require.ErrorIs(t, err, ErrMissingKey)
+	_, err = NewHandshakeState(Config{CipherSuite: suite, Pattern: HandshakeNK})
+	require.ErrorIs(t, err, ErrMissingKey)
+	_, err = NewHandshakeState(Config{CipherSuite: suite, Pattern: HandshakeNK, Initiator: true, PeerStatic: key.Public})
+	require.NoError(t, err)
+	_, err = NewHandshakeState(Config{CipherSuite: suite, Pattern: HandshakeXX})
+	require.ErrorIs(t, err, ErrMissingKey)
+	_, err = NewHandshakeState(Config{CipherSuite: suite, Pattern: HandshakeKK, StaticKeypair: key})
+	require.ErrorIs(t, err, ErrMissingKey)
+}
+
+func TestOutOfTurn(t *testing.T) {
+	suite := newTestSuite(t, x25519.Scheme())
+	initiator, responder := newTestHandshake(t, suite, HandshakeNN)
+
+	_, _, _, err := responder.WriteMessage(nil, nil)
+	require.ErrorIs(t, err, ErrOutOfTurn)
+	_, _, _, err = initiator.ReadMessage(nil, nil)
+	require.ErrorIs(t, err, ErrOutOfTurn)
+
+	runHandshake(t, initiator, responder)
+	_, _, _, err = initiator.WriteMessage(nil, nil)
+	require.ErrorIs(t, err, ErrOutOfTurn)
+	_, _, _, err = responder.ReadMessage(nil, nil)
+	require.ErrorIs(t, err, ErrOutOfTurn)
+}
+
+func TestHandshakeFailures(t *testing.T) {
+	suite := newTestSuite(t, ctidh511.Scheme())
+
+	// A different prologue fails the first authenticated payload.
+	initiator, _ := newTestHandshake(t, suite, HandshakeNN)
+	responder, err := NewHandshakeState(Config{CipherSuite: suite, Pattern: HandshakeNN, Prologue: []byte("other")})
+	require.NoError(t, err)
+	message, _, _, err := initiator.WriteMessage(nil, nil)
+	require.NoError(t, err)
+	_, _, _, err = responder.ReadMessage(nil, message)
+	require.NoError(t, err)
+	message, _, _, err = responder.WriteMessage(nil, nil)
+	require.NoError(t, err)
+	_, _, _, err = initiator.ReadMessage(nil, message)
+	require.ErrorIs(t, err, ErrDecrypt)
+
+	// So does a wrong responder static key.
+	initiator, responder = newTestHandshake(t, suite, HandshakeNK)
+	initiator.rs = newTestKey(t, suite.DH()).Public
+	message, _, _, err = initiator.WriteMessage(nil, nil)
+	require.NoError(t, err)
+	_, _, _, err = responder.ReadMessage(nil, message)
+	require.ErrorIs(t, err, ErrDecrypt)
+
+	// Truncated messages and invalid ephemeral keys are rejected.
+	initiator, responder = newTestHandshake(t, suite, HandshakeXX)
+	message, _, _, err = initiator.WriteMessage(nil, nil)
+	require.NoError(t, err)
+	_, _, _, err = responder.ReadMessage(nil, message[:len(message)-1])
+	require.ErrorIs(t, err, ErrShortMessage)
+	message[0] ^= 1
+	_, _, _, err = responder.ReadMessage(nil, message)
+	require.ErrorIs(t, err, ctidh511.ErrPublicKeyValidation)
+
+	_, _, _, err = initiator.WriteMessage(nil, make([]byte, MaxMessageSize+1))
+	require.ErrorIs(t, err, ErrOutOfTurn)
+	initiator, _ = newTestHandshake(t, suite, HandshakeXX)
+	_, _, _, err = initiator.WriteMessage(nil, make([]byte, MaxMessageSize+1))
+	require.ErrorIs(t, err, ErrMessageSize)
+}
+
+func TestCipherState(t *testing.T) {
+	for _, c := range []*Cipher{CipherChaChaPoly, CipherAESGCM} {
+		t.Run(c.Name(), func(t *testing.T) {
+			cs1, cs2 := newCipherState(c), newCipherState(c)
+			require.False(t, cs1.HasKey())
+			out, err := cs1.Encrypt(nil, nil, []byte("plaintext"))
+			require.NoError(t, err)
+			require.Equal(t, []byte("plaintext"), out)
+
+			key := bytes.Repeat([]byte{7}, keySize)
+			cs1.initializeKey(key)
+			cs2.initializeKey(key)
+			ciphertext, err := cs1.Encrypt(nil, []byte("ad"), []byte("plaintext"))
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), cs1.Nonce())
+
+			_, err = cs2.Decrypt(nil, []byte("other"), ciphertext)
+			require.ErrorIs(t, err, ErrDecrypt)
+			require.Equal(t, uint64(0), cs2.Nonce())
+			plaintext, err := cs2.Decrypt(nil, []byte("ad"), ciphertext)
+			require.NoError(t, err)
+			require.Equal(t, []byte("plaintext"), plaintext)
+
+			// Replayed messages fail with the next nonce.
+			_, err = cs2.Decrypt(nil, []byte("ad"), ciphertext)
+			require.ErrorIs(t, err, ErrDecrypt)
+
+			cs1.Rekey()
+			cs2.Rekey()
+			require.Equal(t, uint64(1), cs1.Nonce())
+			require.NotEqual(t, key, cs1.k[:])
+			ciphertext, err = cs1.Encrypt(nil, nil, []byte("rekeyed"))
+			require.NoError(t, err)
+			plaintext, err = cs2.Decrypt(nil, nil, ciphertext)
+			require.NoError(t, err)
+			require.Equal(t, []byte("rekeyed"), plaintext)
+
+			cs1.SetNonce(math.MaxUint64)
+			_, err = cs1.Encrypt(nil, nil, nil)
+			require.ErrorIs(t, err, ErrNonce)
+		})
+	}
+}
+
+func TestConn(t *testing.T) {
+	scheme := ctidh511.Scheme()
+	suite := newTestSuite(t, scheme)
+	clientKey := newTestKey(t, scheme)
+	serverKey := newTestKey(t, scheme)
+
+	c1, c2 := net.Pipe()
+	client := Client(c1, Config{CipherSuite: suite, Pattern: HandshakeXX, StaticKeypair: clientKey})
+	server := Server(c2, Config{CipherSuite: suite, Pattern: HandshakeXX, StaticKeypair: serverKey})
+	defer client.Close()
+	defer server.Close()
+	require.Nil(t, client.PeerStatic())
+	require.Nil(t, client.HandshakeHash())
+
+	// Longer than a single transport message.
+	data := make([]byte, 3*MaxMessageSize)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := client.Write(data)
+		errCh <- err
+	}()
+	got := make([]byte, len(data))
+	_, err = io.ReadFull(server, got)
+	require.NoError(t, err)
+	require.NoError(t, <-errCh)
+	require.Equal(t, data, got)
+
+	go func() {
+		_, err := server.Write([]byte("reply"))
+		errCh <- err
+	}()
+	reply := make([]byte, 5)
+	_, err = io.ReadFull(client, reply)
+	require.NoError(t, err)
+	require.NoError(t, <-errCh)
+	require.Equal(t, []byte("reply"), reply)
+
+	require.Equal(t, serverKey.Public.Bytes(), client.PeerStatic().Bytes())
+	require.Equal(t, clientKey.Public.Bytes(), server.PeerStatic().Bytes())
+	require.Equal(t, client.HandshakeHash(), server.HandshakeHash())
+}
+
+func TestConnTampering(t *testing.T) {
+	suite := newTestSuite(t, x25519.Scheme())
+	serverKey := newTestKey(t, x25519.Scheme())
+
+	c1, c2 := net.Pipe()
+	client := Client(c1, Config{CipherSuite: suite, Pattern: HandshakeNK, PeerStatic: serverKey.Public})
+	server := Server(c2, Config{CipherSuite: suite, Pattern: HandshakeNK, StaticKeypair: serverKey})
+	defer client.Close()
+	defer server.Close()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- client.Handshake()
+	}()
+	require.NoError(t, server.Handshake())
+	require.NoError(t, <-errCh)
+
+	// A forged transport message breaks the connection for good.
+	go func() {
+		message, err := client.out.Encrypt(nil, nil, []byte("message"))
+		if err == nil {
+			message[0] ^= 1
+			err = client.writeFrame(message)
+		}
+		errCh <- err
+	}()
+	_, err := server.Read(make([]byte, 16))
+	require.ErrorIs(t, err, ErrDecrypt)
+	require.NoError(t, <-errCh)
+	_, err = server.Read(make([]byte, 16))
+	require.ErrorIs(t, err, ErrDecrypt)
+}
@@ -0,0 +1,126 @@
+package noise
+
+// Token is a handshake message token.
+type Token uint8
+
+// The handshake message tokens.
+const (
+	TokenE Token = iota + 1
+	TokenS
+	TokenEE
+	TokenES
+	TokenSE
+	TokenSS
+)
+
+// String returns the name of the token in handshake patterns.
+func (t Token) String() string {
+	switch t {
+	case TokenE:
+		return "e"
+	case TokenS:
+		return "s"
+	case TokenEE:
+		return "ee"
+	case TokenES:
+		return "es"
+	case TokenSE:
+		return "se"
+	case TokenSS:
+		return "ss"
+	}
+	return "unknown"
+}
+
+// HandshakePattern is a Noise handshake pattern. The messages
+// alternate between the initiator, who sends the first one, and the
+// responder.
+type HandshakePattern struct {
+	Name                 string
+	InitiatorPreMessages []Token
+	ResponderPreMessages []Token
+	Messages             [][]Token
+}
+
+// The supported handshake patterns.
+var (
+	// HandshakeNN is unauthenticated.
+	//
+	//	-> e
+	//	<- e, ee
+	HandshakeNN = HandshakePattern{
+		Name: "NN",
+		Messages: [][]Token{
+			{TokenE},
+			{TokenE, TokenEE},
+		},
+	}
+
+	// HandshakeNK authenticates the responder, whose static public
+	// key the initiator knows in advance.
+	//
+	//	<- s
+	//	...
+	//	-> e, es
+	//	<- e, ee
+	HandshakeNK = HandshakePattern{
+		Name:                 "NK",
+		ResponderPreMessages: []Token{TokenS},
+		Messages: [][]Token{
+			{TokenE, TokenES},
+			{TokenE, TokenEE},
+		},
+	}
+
+	// HandshakeXX mutually authenticates the parties, which
+	// transmit their static public keys.
+	//
+	//	-> e
+	//	<- e, ee, s, es
+	//	-> s, se
+	HandshakeXX = HandshakePattern{
+		Name: "XX",
+		Messages: [][]Token{
+			{TokenE},
+			{TokenE, TokenEE, TokenS, TokenES},
+			{TokenS, TokenSE},
+		},
+	}
+
+	// HandshakeIK mutually authenticates the parties, where the
+	// initiator knows the responder's static public key in advance
+	// and sends its own in the first message.
+	//
+	//	<- s
+	//	...
+	//	-> e, es, s, ss
+	//	<- e, ee, se
+	HandshakeIK = HandshakePattern{
+		Name:                 "IK",
+		ResponderPreMessages: []Token{TokenS},
+		Messages: [][]Token{
+			{TokenE, TokenES, TokenS, TokenSS},
+			{TokenE, TokenEE, TokenSE},
+		},
+	}
+
+	// HandshakeKK mutually authenticates the parties, which know
+	// each other's static public keys in advance.
+	//
+	//	-> s
+	//	<- s
+	//	...
+	//	-> e, es, ss
+	//	<- e, ee, se
+	HandshakeKK = HandshakePattern{
+		Name:                 "KK",
+		InitiatorPreMessages: []Token{TokenS},
+		ResponderPreMessages: []Token{TokenS},
+		Messages: [][]Token{
+			{TokenE, TokenES, TokenSS},
+			{TokenE, TokenEE, TokenSE},
+		},
+	}
+)
+
+var patterns = []HandshakePattern{HandshakeNN, HandshakeNK, HandshakeXX, HandshakeIK, HandshakeKK}
@@ -0,0 +1,474 @@
+package noise
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"io"
+	"math"
+
+	"golang.org/x/crypto/hkdf"
+
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+)
+
+// keySize is the size in bytes of cipher keys.
+const keySize = 32
+
+// CipherState encrypts and decrypts with a cipher key k and a nonce n
+// as in section 5.1 of the specification. Without a key, Encrypt and
+// Decrypt return their input unchanged.
+type CipherState struct {
+	cipher *Cipher
+	aead   cipher.AEAD
+	k      [keySize]byte
+	n      uint64
+}
+
+func newCipherState(c *Cipher) *CipherState {
+	return &CipherState{cipher: c}
+}
+
+// initializeKey sets the key to k and resets the nonce.
+func (cs *CipherState) initializeKey(k []byte) {
+	copy(cs.k[:], k)
+	aead, err := cs.cipher.newAEAD(cs.k[:])
+	if err != nil {
+		// The key size is always valid.
+		panic(err)
+	}
+	cs.aead = aead
+	cs.n = 0
+}
+
+// HasKey reports whether the CipherState has a key.
+func (cs *CipherState) HasKey() bool {
+	return cs.aead != nil
+}
+
+// Nonce returns the nonce which the next message will use.
+func (cs *CipherState) Nonce() uint64 {
+	return cs.n
+}
+
+// SetNonce sets the nonce, for transports which deliver messages
+// out of order.
+func (cs *CipherState) SetNonce(n uint64) {
+	cs.n = n
+}
+
+func (cs *CipherState) nonce(n uint64) []byte {
+	nonce := make([]byte, cs.aead.NonceSize())
+	cs.cipher.putCounter(nonce, n)
+	return nonce
+}
+
+// Encrypt appends the encryption of plaintext with the associated
+// data ad to out and increments the nonce.
+func (cs *CipherState) Encrypt(out, ad, plaintext []byte) ([]byte, error) {
+	if !cs.HasKey() {
+		return append(out, plaintext...), nil
+	}
+	if cs.n == math.MaxUint64 {
+		return nil, ErrNonce
+	}
+	out = cs.aead.Seal(out, cs.nonce(cs.n), plaintext, ad)
+	cs.n++
+	return out, nil
+}
+
+// Decrypt appends the decryption of ciphertext with the associated
+// data ad to out and increments the nonce. If the ciphertext fails to
+// authenticate, ErrDecrypt is returned and the nonce is unchanged.
+func (cs *CipherState) Decrypt(out, ad, ciphertext []byte) ([]byte, error) {
+	if !cs.HasKey() {
+		return append(out, ciphertext...), nil
+	}
+	if cs.n == math.MaxUint64 {
+		return nil, ErrNonce
+	}
+	out, err := cs.aead.Open(out, cs.nonce(cs.n), ciphertext, ad)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	cs.n++
+	return out, nil
+}
+
+// Rekey replaces the key with the first 32 bytes of the encryption of
+// 32 zero bytes under the reserved nonce 2^64-1, leaving the nonce
+// unchanged.
+func (cs *CipherState) Rekey() {
+	if !cs.HasKey() {
+		return
+	}
+	k := cs.aead.Seal(nil, cs.nonce(math.MaxUint64), make([]byte, keySize), nil)
+	n := cs.n
+	cs.initializeKey(k[:keySize])
+	cs.n = n
+}
+
+// SymmetricState holds the chaining key ck and the handshake hash h
+// of a handshake, as in section 5.2 of the specification.
+type SymmetricState struct {
+	cs   *CipherState
+	hash *Hash
+	ck   []byte
+	h    []byte
+}
+
+func newSymmetricState(suite *CipherSuite, protocolName string) *SymmetricState {
+	s := &SymmetricState{
+		cs:   newCipherState(suite.cipher),
+		hash: suite.hash,
+	}
+	if len(protocolName) <= suite.hash.Size() {
+		s.h = make([]byte, suite.hash.Size())
+		copy(s.h, protocolName)
+	} else {
+		s.h = s.sum([]byte(protocolName))
+	}
+	s.ck = append([]byte{}, s.h...)
+	return s
+}
+
+func (s *SymmetricState) sum(data ...[]byte) []byte {
+	h := s.hash.new()
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
+
+// hkdf returns the first two outputs of the HKDF function of section
+// 4.3 of the specification, which is HKDF of RFC 5869 with the
+// chaining key as the salt and no info.
+func (s *SymmetricState) hkdf(ikm []byte) ([]byte, []byte) {
+	size := s.hash.Size()
+	out := make([]byte, 2*size)
+	if _, err := io.ReadFull(hkdf.New(s.hash.new, ikm, s.ck, nil), out); err != nil {
+		panic(err)
+	}
+	return out[:size], out[size:]
+}
+
+// MixKey mixes the DH output ikm into the chaining key and the cipher
+// key.
+func (s *SymmetricState) MixKey(ikm []byte) {
+	ck, tempK := s.hkdf(ikm)
+	s.ck = ck
+	s.cs.initializeKey(tempK[:keySize])
+}
+
+// MixHash mixes data into the handshake hash.
+func (s *SymmetricState) MixHash(data []byte) {
+	s.h = s.sum(s.h, data)
+}
+
+// HandshakeHash returns the handshake hash h.
+func (s *SymmetricState) HandshakeHash() []byte {
+	return append([]byte{}, s.h...)
+}
+
+// EncryptAndHash appends the encryption of plaintext, with the
+// handshake hash as associated data, to out and mixes the ciphertext
+// into the handshake hash.
+func (s *SymmetricState) EncryptAndHash(out, plaintext []byte) ([]byte, error) {
+	start := len(out)
+	out, err := s.cs.Encrypt(out, s.h, plaintext)
+	if err != nil {
+		return nil, err
+	}
+	s.MixHash(out[start:])
+	return out, nil
+}
+
+// DecryptAndHash is the inverse of EncryptAndHash.
+func (s *SymmetricState) DecryptAndHash(out, ciphertext []byte) ([]byte, error) {
+	out, err := s.cs.Decrypt(out, s.h, ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	s.MixHash(ciphertext)
+	return out, nil
+}
+
+// Split returns the two CipherStates of the transport phase: the
+// first encrypts messages from the initiator to the responder, the
+// second those in the other direction.
+func (s *SymmetricState) Split() (*CipherState, *CipherState) {
+	tempK1, tempK2 := s.hkdf(nil)
+	c1 := newCipherState(s.cs.cipher)
+	c1.initializeKey(tempK1[:keySize])
+	c2 := newCipherState(s.cs.cipher)
+	c2.initializeKey(tempK2[:keySize])
+	return c1, c2
+}
+
+// DHKey is a key pair of the DH function.
+type DHKey struct {
+	Private nike.PrivateKey
+	Public  nike.PublicKey
+}
+
+// Config configures a HandshakeState.
+type Config struct {
+	// CipherSuite is the cipher suite of the protocol.
+	CipherSuite *CipherSuite
+
+	// Pattern is the handshake pattern of the protocol.
+	Pattern HandshakePattern
+
+	// Initiator is true for the party which sends the first
+	// handshake message.
+	Initiator bool
+
+	// Prologue is data which both parties must agree on for the
+	// handshake to succeed.
+	Prologue []byte
+
+	// StaticKeypair is the local static key pair s, if the pattern
+	// uses it.
+	StaticKeypair *DHKey
+
+	// PeerStatic is the remote static public key rs, if the
+	// pattern requires it to be known in advance.
+	PeerStatic nike.PublicKey
+
+	// Random is the source of the ephemeral keys. If it is nil,
+	// crypto/rand.Reader is used.
+	Random io.Reader
+}
+
+// HandshakeState runs a handshake as in section 5.3 of the
+// specification. After a WriteMessage or ReadMessage fails the
+// handshake must be abandoned.
+type HandshakeState struct {
+	ss        *SymmetricState
+	dh        nike.Scheme
+	pattern   HandshakePattern
+	initiator bool
+	rng       io.Reader
+
+	s  *DHKey
+	e  *DHKey
+	rs nike.PublicKey
+	re nike.PublicKey
+
+	msgIdx int
+}
+
+// NewHandshakeState initializes a handshake as configured by config.
+func NewHandshakeState(config Config) (*HandshakeState, error) {
+	suite := config.CipherSuite
+	hs := &HandshakeState{
+		ss:        newSymmetricState(suite, suite.ProtocolName(config.Pattern)),
+		dh:        suite.dh,
+		pattern:   config.Pattern,
+		initiator: config.Initiator,
+		rng:       config.Random,
+		s:         config.StaticKeypair,
+		rs:        config.PeerStatic,
+	}
+	if hs.rng == nil {
+		hs.rng = rand.Reader
+	}
+
+	localPre, remotePre := config.Pattern.InitiatorPreMessages, config.Pattern.ResponderPreMessages
+	if !config.Initiator {
+		localPre, remotePre = remotePre, localPre
+	}
+	needS := hasToken(localPre, TokenS)
+	for i, message := range config.Pattern.Messages {
+		if (i%2 == 0) == config.Initiator && hasToken(message, TokenS) {
+			needS = true
+		}
+	}
+	if (needS && hs.s == nil) || (hasToken(remotePre, TokenS) && hs.rs == nil) {
+		return nil, ErrMissingKey
+	}
+
+	hs.ss.MixHash(config.Prologue)
+	initiatorS, responderS := hs.s, &DHKey{Public: hs.rs}
+	if !config.Initiator {
+		initiatorS, responderS = responderS, initiatorS
+	}
+	if hasToken(config.Pattern.InitiatorPreMessages, TokenS) {
+		hs.ss.MixHash(initiatorS.Public.Bytes())
+	}
+	if hasToken(config.Pattern.ResponderPreMessages, TokenS) {
+		hs.ss.MixHash(responderS.Public.Bytes())
+	}
+	return hs, nil
+}
+
+func hasToken(tokens []Token, token Token) bool {
+	for _, t := range tokens {
+		if t == token {
+			return true
+		}
+	}
+	return false
+}
+
+// PeerStatic returns the remote static public key, once it is known.
+func (hs *HandshakeState) PeerStatic() nike.PublicKey {
+	return hs.rs
+}
+
+// HandshakeHash returns the handshake hash, which after the last
+// message uniquely identifies the session and may be used for channel
+// binding.
+func (hs *HandshakeState) HandshakeHash() []byte {
+	return hs.ss.HandshakeHash()
+}
+
+// myTurn reports whether the next handshake message is to be written
+// by this party.
+func (hs *HandshakeState) myTurn() bool {
+	return (hs.msgIdx%2 == 0) == hs.initiator
+}
+
+// MessageIndex returns the index of the next handshake message in the
+// pattern.
+func (hs *HandshakeState) MessageIndex() int {
+	return hs.msgIdx
+}
+
+// Complete reports whether all handshake messages have been processed.
+func (hs *HandshakeState) Complete() bool {
+	return hs.msgIdx >= len(hs.pattern.Messages)
+}
+
+// mixDH mixes the shared secret of privateKey and publicKey into the
+// chaining key.
+func (hs *HandshakeState) mixDH(privateKey nike.PrivateKey, publicKey nike.PublicKey) error {
+	secret, err := hs.dh.DeriveSecret(privateKey, publicKey)
+	if err != nil {
+		return err
+	}
+	hs.ss.MixKey(secret)
+	return nil
+}
+
+// mixToken mixes the DH of a token other than e and s.
+func (hs *HandshakeState) mixToken(token Token) error {
+	switch {
+	case token == TokenEE:
+		return hs.mixDH(hs.e.Private, hs.re)
+	case token == TokenSS:
+		return hs.mixDH(hs.s.Private, hs.rs)
+	case token == TokenES && hs.initiator, token == TokenSE && !hs.initiator:
+		return hs.mixDH(hs.e.Private, hs.rs)
+	default:
+		return hs.mixDH(hs.s.Private, hs.re)
+	}
+}
+
+// WriteMessage appends the next handshake message, carrying payload,
+// to out. After the last message it also returns the CipherStates of
+// Split.
+func (hs *HandshakeState) WriteMessage(out, payload []byte) ([]byte, *CipherState, *CipherState, error) {
+	if hs.Complete() || !hs.myTurn() {
+		return nil, nil, nil, ErrOutOfTurn
+	}
+	if len(payload) > MaxMessageSize {
+		return nil, nil, nil, ErrMessageSize
+	}
+	start := len(out)
+
+	var err error
+	for _, token := range hs.pattern.Messages[hs.msgIdx] {
+		switch token {
+		case TokenE:
+			privateKey, publicKey, err := hs.dh.GenerateKeyPair(hs.rng)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			hs.e = &DHKey{Private: privateKey, Public: publicKey}
+			pub := publicKey.Bytes()
+			out = append(out, pub...)
+			hs.ss.MixHash(pub)
+		case TokenS:
+			out, err = hs.ss.EncryptAndHash(out, hs.s.Public.Bytes())
+		default:
+			err = hs.mixToken(token)
+		}
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	out, err = hs.ss.EncryptAndHash(out, payload)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(out)-start > MaxMessageSize {
+		return nil, nil, nil, ErrMessageSize
+	}
+	hs.msgIdx++
+	if hs.Complete() {
+		cs1, cs2 := hs.ss.Split()
+		return out, cs1, cs2, nil
+	}
+	return out, nil, nil, nil
+}
+
+// ReadMessage processes the next handshake message and appends its
+// payload to out. After the last message it also returns the
+// CipherStates of Split.
+func (hs *HandshakeState) ReadMessage(out, message []byte) ([]byte, *CipherState, *CipherState, error) {
+	if hs.Complete() || hs.myTurn() {
+		return nil, nil, nil, ErrOutOfTurn
+	}
+	if len(message) > MaxMessageSize {
+		return nil, nil, nil, ErrMessageSize
+	}
+
+	dhLen := hs.dh.PublicKeySize()
+	var err error
+	for _, token := range hs.pattern.Messages[hs.msgIdx] {
+		switch token {
+		case TokenE:
+			if len(message) < dhLen {
+				return nil, nil, nil, ErrShortMessage
+			}
+			hs.re = hs.dh.NewEmptyPublicKey()
+			if err := hs.re.FromBytes(message[:dhLen]); err != nil {
+				return nil, nil, nil, err
+			}
+			hs.ss.MixHash(message[:dhLen])
+			message = message[dhLen:]
+		case TokenS:
+			n := dhLen
+			if hs.ss.cs.HasKey() {
+				n += hs.ss.cs.aead.Overhead()
+			}
+			if len(message) < n {
+				return nil, nil, nil, ErrShortMessage
+			}
+			var pub []byte
+			pub, err = hs.ss.DecryptAndHash(nil, message[:n])
+			if err == nil {
+				hs.rs = hs.dh.NewEmptyPublicKey()
+				err = hs.rs.FromBytes(pub)
+			}
+			message = message[n:]
+		default:
+			err = hs.mixToken(token)
+		}
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	out, err = hs.ss.DecryptAndHash(out, message)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	hs.msgIdx++
+	if hs.Complete() {
+		cs1, cs2 := hs.ss.Split()
+		return out, cs1, cs2, nil
+	}
+	return out, nil, nil, nil
+}
@@ -0,0 +1,259 @@
+// Package ntor implements a one-way authenticated key exchange in the
+// style of the ntor handshake of Tor, with the CTIDH NIKE in place of
+// Curve25519.
+//
+// A client which knows the identity ID and the long term public key B
+// of a relay sends the relay an ephemeral public key X in one message,
+// and the relay answers with its own ephemeral public key Y and an
+// authenticator. Both then compute, with H(x, t) = HMAC-SHA256(t, x),
+//
+//	secret_input = DH(x, Y) | DH(x, B) | ID | B | X | Y | PROTOID
+//	KEY_SEED = H(secret_input, t_key)
+//	verify = H(secret_input, t_verify)
+//	auth_input = verify | ID | B | Y | X | PROTOID | "Server"
+//	AUTH = H(auth_input, t_mac)
+//
+// and expand KEY_SEED into key material with HKDF-SHA256. The client
+// checks AUTH, so it knows that only the holder of the private key of
+// B can compute the keys; the client itself stays anonymous. PROTOID
+// is "ntor-" followed by the scheme name and "-sha256-1", and the
+// tweaks t_key, t_verify and t_mac and the HKDF info are PROTOID
+// followed by ":key_extract", ":verify", ":mac" and ":key_expand".
+package ntor
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+)
+
+const (
+	// IDSize is the size in bytes of relay identities.
+	IDSize = 32
+
+	// AuthSize is the size in bytes of the authenticator.
+	AuthSize = sha256.Size
+)
+
+var (
+	// ErrMessageSize indicates a handshake message of the wrong size.
+	ErrMessageSize = errors.New("ntor: invalid handshake message size")
+
+	// ErrUnknownKey indicates a client handshake for another relay
+	// identity or key.
+	ErrUnknownKey = errors.New("ntor: handshake is for another relay")
+
+	// ErrAuth indicates a server handshake which failed to
+	// authenticate.
+	ErrAuth = errors.New("ntor: server authentication failed")
+
+	// ErrHandshakeComplete indicates a client state which was used
+	// before.
+	ErrHandshakeComplete = errors.New("ntor: handshake already completed")
+)
+
+// Protocol is the ntor handshake for a NIKE.
+type Protocol struct {
+	scheme  nike.Scheme
+	protoID string
+}
+
+// New returns the ntor handshake for scheme.
+func New(scheme nike.Scheme) *Protocol {
+	return &Protocol{
+		scheme:  scheme,
+		protoID: "ntor-" + scheme.Name() + "-sha256-1",
+	}
+}
+
+// ProtocolID returns PROTOID.
+func (p *Protocol) ProtocolID() string {
+	return p.protoID
+}
+
+// ClientHandshakeSize returns the size in bytes of the client
+// handshake message ID | B | X.
+func (p *Protocol) ClientHandshakeSize() int {
+	return IDSize + 2*p.scheme.PublicKeySize()
+}
+
+// ServerHandshakeSize returns the size in bytes of the server
+// handshake message Y | AUTH.
+func (p *Protocol) ServerHandshakeSize() int {
+	return p.scheme.PublicKeySize() + AuthSize
+}
+
+func (p *Protocol) tweak(t string) []byte {
+	return []byte(p.protoID + t)
+}
+
+func h(key []byte, data ...[]byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	for _, d := range data {
+		mac.Write(d)
+	}
+	return mac.Sum(nil)
+}
+
+// keys returns the key material and AUTH of a handshake.
+func (p *Protocol) keys(dhEphemeral, dhStatic []byte, id *[IDSize]byte, b, x, y []byte, keySize int) ([]byte, []byte, error) {
+	protoID := []byte(p.protoID)
+	secretInput := [][]byte{dhEphemeral, dhStatic, id[:], b, x, y, protoID}
+	keySeed := h(p.tweak(":key_extract"), secretInput...)
+	verify := h(p.tweak(":verify"), secretInput...)
+	auth := h(p.tweak(":mac"), verify, id[:], b, y, x, protoID, []byte("Server"))
+
+	keys := make([]byte, keySize)
+	if _, err := io.ReadFull(hkdf.Expand(sha256.New, keySeed, p.tweak(":key_expand")), keys); err != nil {
+		return nil, nil, err
+	}
+	return keys, auth, nil
+}
+
+// Client is the state of the client side of a handshake.
+type Client struct {
+	protocol   *Protocol
+	id         [IDSize]byte
+	relayKey   []byte
+	privateKey nike.PrivateKey
+	publicKey  []byte
+}
+
+// NewClient starts a handshake with the relay of identity id and long
+// term public key relayKey, with an ephemeral key drawn from rng. It
+// returns the client state and the message to send to the relay.
+func (p *Protocol) NewClient(rng io.Reader, id *[IDSize]byte, relayKey nike.PublicKey) (*Client, []byte, error) {
+	privateKey, publicKey, err := p.scheme.GenerateKeyPair(rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	c := &Client{
+		protocol:   p,
+		id:         *id,
+		relayKey:   relayKey.Bytes(),
+		privateKey: privateKey,
+		publicKey:  publicKey.Bytes(),
+	}
+
+	message := make([]byte, 0, p.ClientHandshakeSize())
+	message = append(message, id[:]...)
+	message = append(message, c.relayKey...)
+	message = append(message, c.publicKey...)
+	return c, message, nil
+}
+
+// Complete processes the relay's reply and returns keySize bytes of
+// key material. The client state can only be completed once.
+func (c *Client) Complete(reply []byte, keySize int) ([]byte, error) {
+	p := c.protocol
+	if c.privateKey == nil {
+		return nil, ErrHandshakeComplete
+	}
+	defer func() {
+		c.privateKey.Reset()
+		c.privateKey = nil
+	}()
+	if len(reply) != p.ServerHandshakeSize() {
+		return nil, ErrMessageSize
+	}
+	y, auth := reply[:p.scheme.PublicKeySize()], reply[p.scheme.PublicKeySize():]
+
+	serverEphemeral, err := p.scheme.UnmarshalBinaryPublicKey(y)
+	if err != nil {
+		return nil, err
+	}
+	relayKey, err := p.scheme.UnmarshalBinaryPublicKey(c.relayKey)
+	if err != nil {
+		return nil, err
+	}
+	dhEphemeral, err := p.scheme.DeriveSecret(c.privateKey, serverEphemeral)
+	if err != nil {
+		return nil, err
+	}
+	dhStatic, err := p.scheme.DeriveSecret(c.privateKey, relayKey)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, expectedAuth, err := p.keys(dhEphemeral, dhStatic, &c.id, c.relayKey, c.publicKey, y, keySize)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(auth, expectedAuth) {
+		return nil, ErrAuth
+	}
+	return keys, nil
+}
+
+// Server is a relay which answers handshakes for its identity and
+// long term key.
+type Server struct {
+	protocol   *Protocol
+	id         [IDSize]byte
+	privateKey nike.PrivateKey
+	publicKey  []byte
+}
+
+// NewServer returns the server side of the handshake for the relay of
+// identity id and long term private key privateKey.
+func (p *Protocol) NewServer(id *[IDSize]byte, privateKey nike.PrivateKey) (*Server, error) {
+	publicKey, err := p.scheme.DerivePublicKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		protocol:   p,
+		id:         *id,
+		privateKey: privateKey,
+		publicKey:  publicKey.Bytes(),
+	}, nil
+}
+
+// Respond processes a client handshake message, with an ephemeral key
+// drawn from rng, and returns the reply to send to the client and
+// keySize bytes of key material.
+func (s *Server) Respond(rng io.Reader, message []byte, keySize int) (reply, keys []byte, err error) {
+	p := s.protocol
+	if len(message) != p.ClientHandshakeSize() {
+		return nil, nil, ErrMessageSize
+	}
+	id := message[:IDSize]
+	b := message[IDSize : IDSize+p.scheme.PublicKeySize()]
+	x := message[IDSize+p.scheme.PublicKeySize():]
+	if !hmac.Equal(id, s.id[:]) || !hmac.Equal(b, s.publicKey) {
+		return nil, nil, ErrUnknownKey
+	}
+
+	clientEphemeral, err := p.scheme.UnmarshalBinaryPublicKey(x)
+	if err != nil {
+		return nil, nil, err
+	}
+	privateKey, publicKey, err := p.scheme.GenerateKeyPair(rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer privateKey.Reset()
+	dhEphemeral, err := p.scheme.DeriveSecret(privateKey, clientEphemeral)
+	if err != nil {
+		return nil, nil, err
+	}
+	dhStatic, err := p.scheme.DeriveSecret(s.privateKey, clientEphemeral)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	y := publicKey.Bytes()
+	keys, auth, err := p.keys(dhEphemeral, dhStatic, &s.id, s.publicKey, x, y, keySize)
+	if err != nil {
+		return nil, nil, err
+	}
+	reply = make([]byte, 0, p.ServerHandshakeSize())
+	reply = append(reply, y...)
+	reply = append(reply, auth...)
+	return reply, keys, nil
+}
@@ -0,0 +1,124 @@
+package ntor
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"git.xx.network/elixxir/ctidh_cgo/ctidh511"
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+	"git.xx.network/elixxir/ctidh_cgo/nike/x25519"
+)
+
+const testKeySize = 72
+
+func newTestServer(t *testing.T, p *Protocol) (*Server, *[IDSize]byte, nike.PublicKey) {
+	privateKey, publicKey, err := p.scheme.GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+	id := &[IDSize]byte{'r', 'e', 'l', 'a', 'y'}
+	server, err := p.NewServer(id, privateKey)
+	require.NoError(t, err)
+	return server, id, publicKey
+}
+
+func TestHandshake(t *testing.T) {
+	for _, scheme := range []nike.Scheme{ctidh511.Scheme(), x25519.Scheme()} {
+		t.Run(scheme.Name(), func(t *testing.T) {
+			p := New(scheme)
+			require.Equal(t, "ntor-"+scheme.Name()+"-sha256-1", p.ProtocolID())
+			server, id, relayKey := newTestServer(t, p)
+
+			client, message, err := p.NewClient(rand.Reader, id, relayKey)
+			require.NoError(t, err)
+			require.Len(t, message, p.ClientHandshakeSize())
+
+			reply, serverKeys, err := server.Respond(rand.Reader, message, testKeySize)
+			require.NoError(t, err)
+			require.Len(t, reply, p.ServerHandshakeSize())
+			require.Len(t, serverKeys, testKeySize)
+
+			clientKeys, err := client.Complete(reply, testKeySize)
+			require.NoError(t, err)
+			require.Equal(t, serverKeys, clientKeys)
+
+			_, err = client.Complete(reply, testKeySize)
+			require.ErrorIs(t, err, ErrHandshakeComplete)
+
+			// Every handshake gives fresh keys.
+			client, message, err = p.NewClient(rand.Reader, id, relayKey)
+			require.NoError(t, err)
+			_, serverKeys2, err := server.Respond(rand.Reader, message, testKeySize)
+			require.NoError(t, err)
+			require.NotEqual(t, serverKeys, serverKeys2)
+		})
+	}
+}
+
+func TestTamperedClientHandshake(t *testing.T) {
+	p := New(ctidh511.Scheme())
+	server, id, relayKey := newTestServer(t, p)
+	client, message, err := p.NewClient(rand.Reader, id, relayKey)
+	require.NoError(t, err)
+
+	// A handshake for another identity or key is refused.
+	for _, i := range []int{0, IDSize} {
+		tampered := append([]byte{}, message...)
+		tampered[i] ^= 1
+		_, _, err = server.Respond(rand.Reader, tampered, testKeySize)
+		require.ErrorIs(t, err, ErrUnknownKey)
+	}
+
+	// A modified ephemeral key is either invalid or leads to keys
+	// the client does not share.
+	tampered := append([]byte{}, message...)
+	tampered[len(tampered)-1] ^= 1
+	reply, _, err := server.Respond(rand.Reader, tampered, testKeySize)
+	if err == nil {
+		_, err = client.Complete(reply, testKeySize)
+		require.ErrorIs(t, err, ErrAuth)
+	} else {
+		require.ErrorIs(t, err, ctidh511.ErrPublicKeyValidation)
+	}
+
+	_, _, err = server.Respond(rand.Reader, message[1:], testKeySize)
+	require.ErrorIs(t, err, ErrMessageSize)
+}
+
+func TestTamperedServerHandshake(t *testing.T) {
+	p := New(x25519.Scheme())
+	server, id, relayKey := newTestServer(t, p)
+
+	for _, i := range []int{0, x25519.PublicKeySize, p.ServerHandshakeSize() - 1} {
+		client, message, err := p.NewClient(rand.Reader, id, relayKey)
+		require.NoError(t, err)
+		reply, _, err := server.Respond(rand.Reader, message, testKeySize)
+		require.NoError(t, err)
+		reply[i] ^= 1
+		_, err = client.Complete(reply, testKeySize)
+		require.ErrorIs(t, err, ErrAuth)
+	}
+
+	client, message, err := p.NewClient(rand.Reader, id, relayKey)
+	require.NoError(t, err)
+	reply, _, err := server.Respond(rand.Reader, message, testKeySize)
+	require.NoError(t, err)
+	_, err = client.Complete(reply[1:], testKeySize)
+	require.ErrorIs(t, err, ErrMessageSize)
+}
+
+func TestImpersonation(t *testing.T) {
+	// A relay without the private key of B cannot complete the
+	// handshake, even when it answers for B's identity and key.
+	p := New(ctidh511.Scheme())
+	_, id, relayKey := newTestServer(t, p)
+	impostor, _, _ := newTestServer(t, p)
+	impostor.publicKey = relayKey.Bytes()
+
+	client, message, err := p.NewClient(rand.Reader, id, relayKey)
+	require.NoError(t, err)
+	reply, _, err := impostor.Respond(rand.Reader, message, testKeySize)
+	require.NoError(t, err)
+	_, err = client.Complete(reply, testKeySize)
+	require.ErrorIs(t, err, ErrAuth)
+}
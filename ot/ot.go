@@ -0,0 +1,425 @@
+// Package ot implements 1-out-of-2 and 1-out-of-n oblivious transfer
+// from the CSIDH or CTIDH group action and the quadratic twist.
+//
+// The sender generates two key pairs with private keys t and s, and
+// sends the public keys T = [t]E0 and S = [s]T, discarding t. For a
+// choice bit b the receiver generates a key pair with private key r
+// and sends R = [r]T if b is 0, or the twist of it if b is 1. The
+// twist inverts the class group element, so R is [r+t]E0 or
+// [-r-t]E0. The sender derives the keys K0 = DeriveSecret(s, R) and
+// K1 = DeriveSecret(s, twist(R)), one of which is [s+r+t]E0, and
+// the receiver derives that one as DeriveSecret(r, S). The other is
+// [s-r-t]E0, which would take [s-t]E0, and only T and S are known.
+// Each message is encrypted with ChaCha20-Poly1305 under a key
+// derived from its K and the transcript.
+//
+// A 1-out-of-n transfer runs one such transfer for each bit of the
+// index of a message, and encrypts message j under the keys selected
+// by the bits of j, so the receiver can decrypt only the message it
+// chose. A batch runs many transfers with the same setup.
+//
+// Security: the protocol is only secure against semi-honest parties,
+// which follow it but try to learn more from what they see. The
+// receiver's choice is hidden as long as the public keys of
+// GenerateKeyPair cannot be told apart from random curves, which
+// CSIDH assumes too; the sender, who could keep t, sees [r+t]E0 or
+// [-r-t]E0. The unchosen messages stay hidden as long as [s-t]E0
+// cannot be computed from [t]E0 and [s+t]E0. Nothing stops a
+// malicious receiver from sending other curves, and while no attack
+// is known no proof covers it either. The lengths of all of the
+// messages are visible to the receiver, so pad them if they matter.
+package ot
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/bits"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+
+	"git.xx.network/elixxir/ctidh_cgo/internal/pipe"
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+)
+
+const (
+	// MaxMessages is the largest number of messages of a transfer.
+	MaxMessages = 1 << 16
+
+	// MaxTransfers is the largest number of transfers in a batch.
+	MaxTransfers = 1 << 16
+)
+
+// Message types of the wire format.
+const (
+	messageSetup    = 1
+	messageRequest  = 2
+	messageResponse = 3
+)
+
+var (
+	// ErrScheme indicates a scheme whose public keys have no twist.
+	ErrScheme = errors.New("ot: scheme does not implement Twister")
+
+	// ErrMessage indicates a malformed message.
+	ErrMessage = errors.New("ot: malformed message")
+
+	// ErrChoice indicates a choice outside of the messages of a
+	// transfer, or a number of messages outside of [2, MaxMessages].
+	ErrChoice = errors.New("ot: invalid choice")
+
+	// ErrMessages indicates a number of transfers or of messages
+	// which does not match the request.
+	ErrMessages = errors.New("ot: wrong number of messages")
+
+	// ErrDecrypt indicates a chosen message which failed to
+	// authenticate.
+	ErrDecrypt = errors.New("ot: message authentication failed")
+)
+
+// Twister is implemented by the schemes whose public keys have
+// quadratic twists which invert their class group elements, such as
+// those of the ctidh packages.
+type Twister interface {
+	Twist(publicKey nike.PublicKey) (nike.PublicKey, error)
+}
+
+// Protocol is oblivious transfer for a NIKE with twists.
+type Protocol struct {
+	scheme nike.Scheme
+	twist  func(nike.PublicKey) (nike.PublicKey, error)
+}
+
+// New returns oblivious transfer for scheme, which must implement
+// Twister.
+func New(scheme nike.Scheme) (*Protocol, error) {
+	twister, ok := scheme.(Twister)
+	if !ok {
+		return nil, ErrScheme
+	}
+	return &Protocol{scheme: scheme, twist: twister.Twist}, nil
+}
+
+// levels returns the number of 1-out-of-2 transfers a 1-out-of-n
+// transfer takes.
+func levels(n int) int {
+	return bits.Len(uint(n - 1))
+}
+
+// messageKey derives the key of message j of transfer i from the keys
+// selected by the bits of j.
+func (p *Protocol) messageKey(transcript []byte, i, j int, secrets [][]byte) ([]byte, error) {
+	info := []byte("ot " + p.scheme.Name())
+	info = binary.BigEndian.AppendUint32(info, uint32(i))
+	info = binary.BigEndian.AppendUint32(info, uint32(j))
+	key := make([]byte, chacha20poly1305.KeySize)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, bytes.Join(secrets, nil), transcript, info), key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// transcript hashes the setup and the request.
+func transcript(setup, request []byte) []byte {
+	h := sha256.New()
+	h.Write(setup)
+	h.Write(request)
+	return h.Sum(nil)
+}
+
+// Sender is the party which offers the messages.
+type Sender struct {
+	protocol *Protocol
+	s        nike.PrivateKey
+	setup    []byte
+}
+
+// NewSender returns a sender with keys generated from rng, and its
+// setup message.
+func (p *Protocol) NewSender(rng io.Reader) (*Sender, []byte, error) {
+	t, tPublic, err := p.scheme.GenerateKeyPair(rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	t.Reset()
+	s, _, err := p.scheme.GenerateKeyPair(rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	sPublic, err := p.scheme.DeriveSecret(s, tPublic)
+	if err != nil {
+		return nil, nil, err
+	}
+	setup := append([]byte{messageSetup}, tPublic.Bytes()...)
+	setup = append(setup, sPublic...)
+	return &Sender{protocol: p, s: s, setup: setup}, setup, nil
+}
+
+// Respond returns the response to a receiver's request, which offers
+// messages[i] in transfer i. Every transfer must offer as many
+// messages as the request asks for. A sender may answer any number of
+// requests.
+func (s *Sender) Respond(request []byte, messages [][][]byte) ([]byte, error) {
+	p := s.protocol
+	n, count, keys, err := p.parseRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(messages) != count {
+		return nil, ErrMessages
+	}
+	for _, m := range messages {
+		if len(m) != n {
+			return nil, ErrMessages
+		}
+	}
+
+	transcript := transcript(s.setup, request)
+	l := levels(n)
+	response := []byte{messageResponse}
+	for i, m := range messages {
+		// secrets[b][level] is the key for bit b at that level.
+		secrets := [2][][]byte{make([][]byte, l), make([][]byte, l)}
+		for level := 0; level < l; level++ {
+			r := keys[i*l+level]
+			if secrets[0][level], err = p.scheme.DeriveSecret(s.s, r); err != nil {
+				return nil, err
+			}
+			twist, err := p.twist(r)
+			if err != nil {
+				return nil, err
+			}
+			if secrets[1][level], err = p.scheme.DeriveSecret(s.s, twist); err != nil {
+				return nil, err
+			}
+		}
+		selected := make([][]byte, l)
+		for j, message := range m {
+			for level := range selected {
+				selected[level] = secrets[j>>level&1][level]
+			}
+			key, err := p.messageKey(transcript, i, j, selected)
+			if err != nil {
+				return nil, err
+			}
+			aead, err := chacha20poly1305.New(key)
+			if err != nil {
+				return nil, err
+			}
+			// Every key encrypts a single message, so the nonce is
+			// zero.
+			ciphertext := aead.Seal(nil, make([]byte, aead.NonceSize()), message, nil)
+			response = binary.BigEndian.AppendUint32(response, uint32(len(ciphertext)))
+			response = append(response, ciphertext...)
+		}
+	}
+	return response, nil
+}
+
+// Receiver is the party which chooses a message of every transfer.
+type Receiver struct {
+	protocol   *Protocol
+	n          int
+	choices    []int
+	secrets    [][]byte
+	transcript []byte
+}
+
+// NewReceiver returns a receiver which chooses message choices[i] out
+// of the n messages of transfer i, with keys generated from rng, and
+// its request in reply to the sender's setup.
+func (p *Protocol) NewReceiver(rng io.Reader, setup []byte, n int, choices []int) (*Receiver, []byte, error) {
+	if n < 2 || n > MaxMessages {
+		return nil, nil, ErrChoice
+	}
+	if len(choices) > MaxTransfers {
+		return nil, nil, ErrMessages
+	}
+	for _, c := range choices {
+		if c < 0 || c >= n {
+			return nil, nil, ErrChoice
+		}
+	}
+	pkSize := p.scheme.PublicKeySize()
+	if len(setup) != 1+2*pkSize || setup[0] != messageSetup {
+		return nil, nil, ErrMessage
+	}
+	t, err := p.scheme.UnmarshalBinaryPublicKey(setup[1 : 1+pkSize])
+	if err != nil {
+		return nil, nil, err
+	}
+	s, err := p.scheme.UnmarshalBinaryPublicKey(setup[1+pkSize:])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l := levels(n)
+	request := []byte{messageRequest}
+	request = binary.BigEndian.AppendUint32(request, uint32(n))
+	request = binary.BigEndian.AppendUint32(request, uint32(len(choices)))
+	secrets := make([][]byte, 0, len(choices)*l)
+	for _, c := range choices {
+		for level := 0; level < l; level++ {
+			r, _, err := p.scheme.GenerateKeyPair(rng)
+			if err != nil {
+				return nil, nil, err
+			}
+			rt, err := p.scheme.DeriveSecret(r, t)
+			if err != nil {
+				return nil, nil, err
+			}
+			if c>>level&1 == 1 {
+				rtPublic, err := p.scheme.UnmarshalBinaryPublicKey(rt)
+				if err != nil {
+					return nil, nil, err
+				}
+				twist, err := p.twist(rtPublic)
+				if err != nil {
+					return nil, nil, err
+				}
+				rt = twist.Bytes()
+			}
+			request = append(request, rt...)
+			secret, err := p.scheme.DeriveSecret(r, s)
+			if err != nil {
+				return nil, nil, err
+			}
+			secrets = append(secrets, secret)
+			r.Reset()
+		}
+	}
+	return &Receiver{
+		protocol:   p,
+		n:          n,
+		choices:    choices,
+		secrets:    secrets,
+		transcript: transcript(setup, request),
+	}, request, nil
+}
+
+// Finish returns the chosen message of every transfer from the
+// sender's response.
+func (r *Receiver) Finish(response []byte) ([][]byte, error) {
+	p := r.protocol
+	if len(response) < 1 || response[0] != messageResponse {
+		return nil, ErrMessage
+	}
+	response = response[1:]
+	l := levels(r.n)
+	chosen := make([][]byte, len(r.choices))
+	for i, c := range r.choices {
+		for j := 0; j < r.n; j++ {
+			if len(response) < 4 {
+				return nil, ErrMessage
+			}
+			size := binary.BigEndian.Uint32(response)
+			response = response[4:]
+			if uint64(len(response)) < uint64(size) {
+				return nil, ErrMessage
+			}
+			ciphertext := response[:size]
+			response = response[size:]
+			if j != c {
+				continue
+			}
+
+			key, err := p.messageKey(r.transcript, i, j, r.secrets[i*l:(i+1)*l])
+			if err != nil {
+				return nil, err
+			}
+			aead, err := chacha20poly1305.New(key)
+			if err != nil {
+				return nil, err
+			}
+			if chosen[i], err = aead.Open(nil, make([]byte, aead.NonceSize()), ciphertext, nil); err != nil {
+				return nil, ErrDecrypt
+			}
+		}
+	}
+	if len(response) != 0 {
+		return nil, ErrMessage
+	}
+	return chosen, nil
+}
+
+// parseRequest returns the number of messages per transfer, the
+// number of transfers and the receiver's public keys of a request.
+func (p *Protocol) parseRequest(request []byte) (int, int, []nike.PublicKey, error) {
+	if len(request) < 9 || request[0] != messageRequest {
+		return 0, 0, nil, ErrMessage
+	}
+	n := binary.BigEndian.Uint32(request[1:])
+	count := binary.BigEndian.Uint32(request[5:])
+	if n < 2 || n > MaxMessages || count > MaxTransfers {
+		return 0, 0, nil, ErrMessage
+	}
+	request = request[9:]
+	pkSize := p.scheme.PublicKeySize()
+	if uint64(len(request)) != uint64(count)*uint64(levels(int(n)))*uint64(pkSize) {
+		return 0, 0, nil, ErrMessage
+	}
+	keys := make([]nike.PublicKey, len(request)/pkSize)
+	for i := range keys {
+		k, err := p.scheme.UnmarshalBinaryPublicKey(request[i*pkSize : (i+1)*pkSize])
+		if err != nil {
+			return 0, 0, nil, err
+		}
+		keys[i] = k
+	}
+	return int(n), int(count), keys, nil
+}
+
+// Transport carries the messages of the protocol.
+type Transport interface {
+	Send(message []byte) error
+	Receive() ([]byte, error)
+}
+
+// Run sends the setup over t, and answers one request received over
+// it with messages, as Respond does.
+func (s *Sender) Run(t Transport, setup []byte, messages [][][]byte) error {
+	if err := t.Send(setup); err != nil {
+		return err
+	}
+	request, err := t.Receive()
+	if err != nil {
+		return err
+	}
+	response, err := s.Respond(request, messages)
+	if err != nil {
+		return err
+	}
+	return t.Send(response)
+}
+
+// Receive receives the sender's setup over t, sends the request for
+// choices out of n messages per transfer and returns the chosen
+// messages from the response.
+func (p *Protocol) Receive(t Transport, rng io.Reader, n int, choices []int) ([][]byte, error) {
+	setup, err := t.Receive()
+	if err != nil {
+		return nil, err
+	}
+	receiver, request, err := p.NewReceiver(rng, setup, n, choices)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.Send(request); err != nil {
+		return nil, err
+	}
+	response, err := t.Receive()
+	if err != nil {
+		return nil, err
+	}
+	return receiver.Finish(response)
+}
+
+// Pipe returns the two ends of an in-process transport. Messages are
+// copied, and each end buffers one message.
+func Pipe() (Transport, Transport) {
+	a, b := pipe.New()
+	return a, b
+}
@@ -0,0 +1,147 @@
+package ot
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"git.xx.network/elixxir/ctidh_cgo/ctidh511"
+	"git.xx.network/elixxir/ctidh_cgo/nike/x25519"
+)
+
+func newProtocol(t *testing.T) *Protocol {
+	p, err := New(ctidh511.Scheme())
+	require.NoError(t, err)
+	return p
+}
+
+// offer returns count transfers of n distinct messages each, of
+// differing lengths.
+func offer(count, n int) [][][]byte {
+	messages := make([][][]byte, count)
+	for i := range messages {
+		for j := 0; j < n; j++ {
+			messages[i] = append(messages[i], []byte(fmt.Sprintf("message %d of transfer %d%s", j, i, make([]byte, j))))
+		}
+	}
+	return messages
+}
+
+// transfer runs a batch of transfers over an in-process transport.
+func transfer(t *testing.T, p *Protocol, n int, choices []int) [][]byte {
+	sender, setup, err := p.NewSender(rand.Reader)
+	require.NoError(t, err)
+	senderEnd, receiverEnd := Pipe()
+	errs := make(chan error, 1)
+	go func() { errs <- sender.Run(senderEnd, setup, offer(len(choices), n)) }()
+
+	chosen, err := p.Receive(receiverEnd, rand.Reader, n, choices)
+	require.NoError(t, err)
+	require.NoError(t, <-errs)
+	return chosen
+}
+
+func TestTransfer(t *testing.T) {
+	p := newProtocol(t)
+	for _, test := range []struct {
+		n       int
+		choices []int
+	}{
+		{2, []int{0}},
+		{2, []int{1, 0, 1}},
+		{4, []int{3, 1}},
+		{5, []int{4, 0, 2}},
+	} {
+		t.Run(fmt.Sprint(test.n, test.choices), func(t *testing.T) {
+			chosen := transfer(t, p, test.n, test.choices)
+			messages := offer(len(test.choices), test.n)
+			require.Len(t, chosen, len(test.choices))
+			for i, c := range test.choices {
+				require.Equal(t, messages[i][c], chosen[i])
+			}
+		})
+	}
+}
+
+func TestUnchosenMessages(t *testing.T) {
+	p := newProtocol(t)
+	sender, setup, err := p.NewSender(rand.Reader)
+	require.NoError(t, err)
+	receiver, request, err := p.NewReceiver(rand.Reader, setup, 3, []int{1, 2})
+	require.NoError(t, err)
+	response, err := sender.Respond(request, offer(2, 3))
+	require.NoError(t, err)
+
+	// The receiver's keys open none of the other messages.
+	for _, choices := range [][]int{{0, 2}, {2, 2}, {1, 0}, {1, 1}} {
+		receiver.choices = choices
+		_, err = receiver.Finish(response)
+		require.ErrorIs(t, err, ErrDecrypt, "%v", choices)
+	}
+	receiver.choices = []int{1, 2}
+	chosen, err := receiver.Finish(response)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{offer(2, 3)[0][1], offer(2, 3)[1][2]}, chosen)
+
+	// Both choices make requests of the same form.
+	_, request0, err := p.NewReceiver(rand.Reader, setup, 2, []int{0})
+	require.NoError(t, err)
+	_, request1, err := p.NewReceiver(rand.Reader, setup, 2, []int{1})
+	require.NoError(t, err)
+	require.Equal(t, len(request0), len(request1))
+	require.Equal(t, request0[:9], request1[:9])
+}
+
+func TestErrors(t *testing.T) {
+	_, err := New(x25519.Scheme())
+	require.ErrorIs(t, err, ErrScheme)
+
+	p := newProtocol(t)
+	sender, setup, err := p.NewSender(rand.Reader)
+	require.NoError(t, err)
+
+	for _, n := range []int{0, 1, MaxMessages + 1} {
+		_, _, err = p.NewReceiver(rand.Reader, setup, n, nil)
+		require.ErrorIs(t, err, ErrChoice)
+	}
+	for _, c := range []int{-1, 2} {
+		_, _, err = p.NewReceiver(rand.Reader, setup, 2, []int{0, c})
+		require.ErrorIs(t, err, ErrChoice)
+	}
+	for _, s := range [][]byte{nil, setup[:len(setup)-1], append([]byte{messageRequest}, setup[1:]...)} {
+		_, _, err = p.NewReceiver(rand.Reader, s, 2, []int{0})
+		require.ErrorIs(t, err, ErrMessage)
+	}
+
+	receiver, request, err := p.NewReceiver(rand.Reader, setup, 2, []int{1})
+	require.NoError(t, err)
+	for _, messages := range [][][][]byte{nil, offer(2, 2), offer(1, 3)} {
+		_, err = sender.Respond(request, messages)
+		require.ErrorIs(t, err, ErrMessages)
+	}
+	for _, r := range [][]byte{nil, setup, request[:len(request)-1], append(append([]byte{}, request...), 0)} {
+		_, err = sender.Respond(r, offer(1, 2))
+		require.ErrorIs(t, err, ErrMessage)
+	}
+
+	response, err := sender.Respond(request, offer(1, 2))
+	require.NoError(t, err)
+	for _, r := range [][]byte{nil, request, response[:len(response)-1], append(append([]byte{}, response...), 0)} {
+		_, err = receiver.Finish(r)
+		require.ErrorIs(t, err, ErrMessage)
+	}
+	tampered := append([]byte{}, response...)
+	tampered[len(tampered)-1] ^= 1
+	_, err = receiver.Finish(tampered)
+	require.ErrorIs(t, err, ErrDecrypt)
+
+	// A response to another request does not open.
+	_, otherRequest, err := p.NewReceiver(rand.Reader, setup, 2, []int{1})
+	require.NoError(t, err)
+	otherResponse, err := sender.Respond(otherRequest, offer(1, 2))
+	require.NoError(t, err)
+	_, err = receiver.Finish(otherResponse)
+	require.ErrorIs(t, err, ErrDecrypt)
+}
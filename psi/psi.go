@@ -0,0 +1,363 @@
+// Package psi implements two-party private set intersection from a
+// commutative group action, in the style of Diffie-Hellman PSI.
+//
+// Items are hashed to public keys. The client blinds the hashes of
+// its items with its secret factor c and sends them; the server
+// blinds those again with its secret factor s, blinds the hashes of
+// its own items with s, and returns both, the latter shuffled. The
+// client blinds the server's values with c, and the items whose
+// values c and s blinded in either order match are the intersection.
+// Blind commutes for the CTIDH and X25519 schemes, and for the
+// hybrids, which blind both of their parts. In the cardinality mode
+// the server also shuffles the client's values, so that the client
+// learns how many items are shared but not which.
+//
+// Security: DH PSI needs a hash to the group whose outputs have no
+// known discrete logarithm, and the caller must supply it. If the
+// logarithm h(x) of the hash of an item x were known, the server's
+// blinded value of x would be the action of s + h(x), and a client
+// which knows or guesses a single item of the server's set could
+// remove h(x) and evaluate s on any item it likes, offline, and so
+// recover the server's set by a dictionary attack; the same holds the
+// other way round. No such hash is known for CSIDH or CTIDH: hashing
+// to a private key and acting with it, as DeterministicPublicKey of
+// the ctidh packages does, gives keys whose logarithms anyone can
+// compute. Until one is, this package is usable with CTIDH only for
+// experiments, and with X25519 only with a proper hash to the curve.
+package psi
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+
+	"git.xx.network/elixxir/ctidh_cgo/internal/pipe"
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+)
+
+// MaxItems is the largest number of items of a party.
+const MaxItems = 1 << 20
+
+// Mode selects what the client learns.
+type Mode byte
+
+const (
+	// ModeIntersection lets the client learn the intersection.
+	ModeIntersection Mode = 1
+
+	// ModeCardinality lets the client learn only the size of the
+	// intersection.
+	ModeCardinality Mode = 2
+)
+
+// Message types of the wire format.
+const (
+	messageRequest  = 1
+	messageResponse = 2
+)
+
+var (
+	// ErrMessage indicates a malformed message.
+	ErrMessage = errors.New("psi: malformed message")
+
+	// ErrMode indicates an unknown mode, or a response for another
+	// mode than that of the request.
+	ErrMode = errors.New("psi: invalid mode")
+
+	// ErrHash indicates a missing hash function.
+	ErrHash = errors.New("psi: no hash function")
+
+	// ErrTooManyItems indicates more than MaxItems items.
+	ErrTooManyItems = errors.New("psi: too many items")
+)
+
+// HashFunc maps an item to a public key. Its outputs must have no
+// discrete logarithm known to either party.
+type HashFunc func(item []byte) (nike.PublicKey, error)
+
+// Protocol is PSI for a NIKE whose Blind commutes.
+type Protocol struct {
+	scheme nike.Scheme
+	hash   HashFunc
+}
+
+// New returns PSI for scheme, with items hashed by hash, which both
+// parties must share. It returns ErrHash if hash is nil.
+func New(scheme nike.Scheme, hash HashFunc) (*Protocol, error) {
+	if hash == nil {
+		return nil, ErrHash
+	}
+	return &Protocol{scheme: scheme, hash: hash}, nil
+}
+
+// newFactor returns a secret blinding factor drawn from rng.
+func (p *Protocol) newFactor(rng io.Reader) ([]byte, error) {
+	factor := make([]byte, p.scheme.BlindingFactorSize())
+	if _, err := io.ReadFull(rng, factor); err != nil {
+		return nil, err
+	}
+	return factor, nil
+}
+
+// blindItems returns the hashes of items blinded by factor.
+func (p *Protocol) blindItems(factor []byte, items [][]byte) ([]nike.PublicKey, error) {
+	blinded := make([]nike.PublicKey, len(items))
+	for i, item := range items {
+		h, err := p.hash(item)
+		if err != nil {
+			return nil, err
+		}
+		if blinded[i], err = p.scheme.Blind(factor, h); err != nil {
+			return nil, err
+		}
+	}
+	return blinded, nil
+}
+
+// dedup returns items without repetitions, in their first order.
+func dedup(items [][]byte) [][]byte {
+	seen := make(map[string]bool, len(items))
+	out := make([][]byte, 0, len(items))
+	for _, item := range items {
+		if !seen[string(item)] {
+			seen[string(item)] = true
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// shuffle shuffles keys with randomness from rng.
+func shuffle(rng io.Reader, keys []nike.PublicKey) error {
+	for i := len(keys) - 1; i > 0; i-- {
+		j, err := rand.Int(rng, big.NewInt(int64(i+1)))
+		if err != nil {
+			return err
+		}
+		keys[i], keys[j.Int64()] = keys[j.Int64()], keys[i]
+	}
+	return nil
+}
+
+// Client is the party which learns the result.
+type Client struct {
+	protocol *Protocol
+	mode     Mode
+	factor   []byte
+	items    [][]byte
+}
+
+// Result is what the client learns.
+type Result struct {
+	// Intersection holds the shared items, in the client's order.
+	// It is nil in the cardinality mode.
+	Intersection [][]byte
+
+	// Cardinality is the number of shared items.
+	Cardinality int
+}
+
+// NewClient returns a client for items in mode, with a secret factor
+// drawn from rng, and its request. Repeated items count once.
+func (p *Protocol) NewClient(rng io.Reader, items [][]byte, mode Mode) (*Client, []byte, error) {
+	if mode != ModeIntersection && mode != ModeCardinality {
+		return nil, nil, ErrMode
+	}
+	items = dedup(items)
+	if len(items) > MaxItems {
+		return nil, nil, ErrTooManyItems
+	}
+	factor, err := p.newFactor(rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	blinded, err := p.blindItems(factor, items)
+	if err != nil {
+		return nil, nil, err
+	}
+	request := []byte{messageRequest, byte(mode)}
+	request = p.appendKeys(request, blinded)
+	return &Client{protocol: p, mode: mode, factor: factor, items: items}, request, nil
+}
+
+// Finish returns the result from the server's response.
+func (c *Client) Finish(response []byte) (*Result, error) {
+	p := c.protocol
+	if len(response) < 2 || response[0] != messageResponse {
+		return nil, ErrMessage
+	}
+	if Mode(response[1]) != c.mode {
+		return nil, ErrMode
+	}
+	doubled, rest, err := p.parseKeys(response[2:])
+	if err != nil {
+		return nil, err
+	}
+	serverBlinded, rest, err := p.parseKeys(rest)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 || len(doubled) != len(c.items) {
+		return nil, ErrMessage
+	}
+
+	serverSet := make(map[string]bool, len(serverBlinded))
+	for _, k := range serverBlinded {
+		b, err := p.scheme.Blind(c.factor, k)
+		if err != nil {
+			return nil, err
+		}
+		serverSet[string(b.Bytes())] = true
+	}
+	result := new(Result)
+	for i, k := range doubled {
+		if serverSet[string(k.Bytes())] {
+			result.Cardinality++
+			if c.mode == ModeIntersection {
+				result.Intersection = append(result.Intersection, c.items[i])
+			}
+		}
+	}
+	return result, nil
+}
+
+// Server is the party which answers requests.
+type Server struct {
+	protocol *Protocol
+	rng      io.Reader
+	factor   []byte
+	blinded  []nike.PublicKey
+}
+
+// NewServer returns a server for items, with a secret factor and
+// shuffles drawn from rng. The items are hashed and blinded once, for
+// all of the requests the server answers.
+func (p *Protocol) NewServer(rng io.Reader, items [][]byte) (*Server, error) {
+	items = dedup(items)
+	if len(items) > MaxItems {
+		return nil, ErrTooManyItems
+	}
+	factor, err := p.newFactor(rng)
+	if err != nil {
+		return nil, err
+	}
+	blinded, err := p.blindItems(factor, items)
+	if err != nil {
+		return nil, err
+	}
+	return &Server{protocol: p, rng: rng, factor: factor, blinded: blinded}, nil
+}
+
+// Respond returns the response to a client's request.
+func (s *Server) Respond(request []byte) ([]byte, error) {
+	p := s.protocol
+	if len(request) < 2 || request[0] != messageRequest {
+		return nil, ErrMessage
+	}
+	mode := Mode(request[1])
+	if mode != ModeIntersection && mode != ModeCardinality {
+		return nil, ErrMode
+	}
+	clientBlinded, rest, err := p.parseKeys(request[2:])
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, ErrMessage
+	}
+
+	doubled := make([]nike.PublicKey, len(clientBlinded))
+	for i, k := range clientBlinded {
+		if doubled[i], err = p.scheme.Blind(s.factor, k); err != nil {
+			return nil, err
+		}
+	}
+	if mode == ModeCardinality {
+		if err := shuffle(s.rng, doubled); err != nil {
+			return nil, err
+		}
+	}
+	blinded := append([]nike.PublicKey{}, s.blinded...)
+	if err := shuffle(s.rng, blinded); err != nil {
+		return nil, err
+	}
+
+	response := []byte{messageResponse, byte(mode)}
+	response = p.appendKeys(response, doubled)
+	return p.appendKeys(response, blinded), nil
+}
+
+// appendKeys appends a list of public keys to a message: the number
+// of keys, big-endian in four bytes, and the keys.
+func (p *Protocol) appendKeys(m []byte, keys []nike.PublicKey) []byte {
+	m = binary.BigEndian.AppendUint32(m, uint32(len(keys)))
+	for _, k := range keys {
+		m = append(m, k.Bytes()...)
+	}
+	return m
+}
+
+// parseKeys parses a list of public keys, and returns the rest of the
+// message.
+func (p *Protocol) parseKeys(m []byte) ([]nike.PublicKey, []byte, error) {
+	if len(m) < 4 {
+		return nil, nil, ErrMessage
+	}
+	n := binary.BigEndian.Uint32(m)
+	m = m[4:]
+	pkSize := p.scheme.PublicKeySize()
+	if n > MaxItems || uint64(len(m)) < uint64(n)*uint64(pkSize) {
+		return nil, nil, ErrMessage
+	}
+	keys := make([]nike.PublicKey, n)
+	for i := range keys {
+		k, err := p.scheme.UnmarshalBinaryPublicKey(m[:pkSize])
+		if err != nil {
+			return nil, nil, err
+		}
+		keys[i] = k
+		m = m[pkSize:]
+	}
+	return keys, m, nil
+}
+
+// Transport carries the messages of the protocol.
+type Transport interface {
+	Send(message []byte) error
+	Receive() ([]byte, error)
+}
+
+// Run sends the client's request over t and returns the result from
+// the response.
+func (c *Client) Run(t Transport, request []byte) (*Result, error) {
+	if err := t.Send(request); err != nil {
+		return nil, err
+	}
+	response, err := t.Receive()
+	if err != nil {
+		return nil, err
+	}
+	return c.Finish(response)
+}
+
+// Serve answers one request received over t.
+func (s *Server) Serve(t Transport) error {
+	request, err := t.Receive()
+	if err != nil {
+		return err
+	}
+	response, err := s.Respond(request)
+	if err != nil {
+		return err
+	}
+	return t.Send(response)
+}
+
+// Pipe returns the two ends of an in-process transport. Messages are
+// copied, and each end buffers one message.
+func Pipe() (Transport, Transport) {
+	a, b := pipe.New()
+	return a, b
+}
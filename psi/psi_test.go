@@ -0,0 +1,170 @@
+package psi
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+
+	"git.xx.network/elixxir/ctidh_cgo/ctidh511"
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+	"git.xx.network/elixxir/ctidh_cgo/nike/x25519"
+)
+
+// testHash maps an item to the public key of a private key generated
+// from SHAKE256 of the domain and the item. Its outputs have known
+// discrete logarithms, so it is only good for testing.
+func testHash(scheme nike.Scheme, domain string) HashFunc {
+	return func(item []byte) (nike.PublicKey, error) {
+		xof := sha3.NewShake256()
+		xof.Write([]byte(domain + "\x00"))
+		xof.Write(item)
+		privateKey, publicKey, err := scheme.GenerateKeyPair(xof)
+		if err != nil {
+			return nil, err
+		}
+		privateKey.Reset()
+		return publicKey, nil
+	}
+}
+
+func newProtocol(t *testing.T, scheme nike.Scheme, domain string) *Protocol {
+	p, err := New(scheme, testHash(scheme, domain))
+	require.NoError(t, err)
+	return p
+}
+
+func items(names ...string) [][]byte {
+	out := make([][]byte, len(names))
+	for i, name := range names {
+		out[i] = []byte(name)
+	}
+	return out
+}
+
+// intersect runs the protocol over an in-process transport.
+func intersect(t *testing.T, p *Protocol, clientItems, serverItems [][]byte, mode Mode) *Result {
+	server, err := p.NewServer(rand.Reader, serverItems)
+	require.NoError(t, err)
+	clientEnd, serverEnd := Pipe()
+	errs := make(chan error, 1)
+	go func() { errs <- server.Serve(serverEnd) }()
+
+	client, request, err := p.NewClient(rand.Reader, clientItems, mode)
+	require.NoError(t, err)
+	result, err := client.Run(clientEnd, request)
+	require.NoError(t, err)
+	require.NoError(t, <-errs)
+	return result
+}
+
+func TestIntersection(t *testing.T) {
+	for _, scheme := range []nike.Scheme{ctidh511.Scheme(), ctidh511.HybridScheme(), x25519.Scheme()} {
+		t.Run(scheme.Name(), func(t *testing.T) {
+			p := newProtocol(t, scheme, "psi test")
+			clientItems := items("alice", "bob", "carol", "dave")
+			serverItems := items("erin", "dave", "bob", "frank", "bob")
+
+			result := intersect(t, p, clientItems, serverItems, ModeIntersection)
+			require.Equal(t, items("bob", "dave"), result.Intersection)
+			require.Equal(t, 2, result.Cardinality)
+
+			result = intersect(t, p, clientItems, serverItems, ModeCardinality)
+			require.Nil(t, result.Intersection)
+			require.Equal(t, 2, result.Cardinality)
+		})
+	}
+}
+
+func TestEdgeCases(t *testing.T) {
+	_, err := New(x25519.Scheme(), nil)
+	require.ErrorIs(t, err, ErrHash)
+
+	p := newProtocol(t, x25519.Scheme(), "psi test")
+
+	result := intersect(t, p, nil, items("a"), ModeIntersection)
+	require.Equal(t, 0, result.Cardinality)
+	result = intersect(t, p, items("a", "a"), nil, ModeIntersection)
+	require.Equal(t, 0, result.Cardinality)
+
+	// Repeated client items count once.
+	result = intersect(t, p, items("a", "b", "a"), items("a"), ModeCardinality)
+	require.Equal(t, 1, result.Cardinality)
+
+	// Hashes of different domains do not meet.
+	other := newProtocol(t, x25519.Scheme(), "other")
+	server, err := other.NewServer(rand.Reader, items("a"))
+	require.NoError(t, err)
+	client, request, err := p.NewClient(rand.Reader, items("a"), ModeIntersection)
+	require.NoError(t, err)
+	response, err := server.Respond(request)
+	require.NoError(t, err)
+	result, err = client.Finish(response)
+	require.NoError(t, err)
+	require.Equal(t, 0, result.Cardinality)
+}
+
+func TestShuffling(t *testing.T) {
+	p := newProtocol(t, x25519.Scheme(), "psi test")
+	var names []string
+	for i := 0; i < 32; i++ {
+		names = append(names, fmt.Sprint("item ", i))
+	}
+	server, err := p.NewServer(rand.Reader, items(names...))
+	require.NoError(t, err)
+
+	// The server's values, and in the cardinality mode the client's
+	// values, come in a new order every time.
+	for _, mode := range []Mode{ModeIntersection, ModeCardinality} {
+		_, request, err := p.NewClient(rand.Reader, items(names...), mode)
+		require.NoError(t, err)
+		first, err := server.Respond(request)
+		require.NoError(t, err)
+		second, err := server.Respond(request)
+		require.NoError(t, err)
+		n := 32 * x25519.PublicKeySize
+		clientValues, serverValues := func(m []byte) []byte { return m[6 : 6+n] }, func(m []byte) []byte { return m[6+n+4:] }
+		require.NotEqual(t, serverValues(first), serverValues(second))
+		if mode == ModeCardinality {
+			require.NotEqual(t, clientValues(first), clientValues(second))
+		} else {
+			require.Equal(t, clientValues(first), clientValues(second))
+		}
+	}
+}
+
+func TestMalformedMessages(t *testing.T) {
+	p := newProtocol(t, ctidh511.Scheme(), "psi test")
+	server, err := p.NewServer(rand.Reader, items("a"))
+	require.NoError(t, err)
+	client, request, err := p.NewClient(rand.Reader, items("a", "b"), ModeIntersection)
+	require.NoError(t, err)
+	response, err := server.Respond(request)
+	require.NoError(t, err)
+
+	_, _, err = p.NewClient(rand.Reader, nil, 3)
+	require.ErrorIs(t, err, ErrMode)
+	for _, m := range [][]byte{nil, {messageResponse}, request[:len(request)-1], append(append([]byte{}, request...), 0)} {
+		_, err = server.Respond(m)
+		require.ErrorIs(t, err, ErrMessage)
+	}
+	badMode := append([]byte{}, request...)
+	badMode[1] = 0
+	_, err = server.Respond(badMode)
+	require.ErrorIs(t, err, ErrMode)
+
+	for _, m := range [][]byte{nil, request, response[:len(response)-1], append(append([]byte{}, response...), 0)} {
+		_, err = client.Finish(m)
+		require.ErrorIs(t, err, ErrMessage)
+	}
+	otherMode := append([]byte{}, response...)
+	otherMode[1] = byte(ModeCardinality)
+	_, err = client.Finish(otherMode)
+	require.ErrorIs(t, err, ErrMode)
+
+	result, err := client.Finish(response)
+	require.NoError(t, err)
+	require.Equal(t, items("a"), result.Intersection)
+}
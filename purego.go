@@ -0,0 +1,106 @@
+//go:build !cgo
+// +build !cgo
+
+package ctidh
+
+import (
+	"io"
+
+	"git.xx.network/elixxir/ctidh_cgo/internal/purego"
+)
+
+// params is the pure Go implementation of the selected parameter
+// set, used when the package is built without cgo.
+var params = purego.ParamsForBits(bits)
+
+// PublicKey is a public CTIDH key.
+type PublicKey struct {
+	publicKey [purego.MaxPublicKeySize]byte
+}
+
+// Bytes returns the PublicKey as a byte slice.
+func (p *PublicKey) Bytes() []byte {
+	return append([]byte{}, p.publicKey[:PublicKeySize]...)
+}
+
+// FromBytes loads a PublicKey from the given byte slice.
+func (p *PublicKey) FromBytes(data []byte) error {
+	if len(data) != PublicKeySize {
+		return ErrPublicKeySize
+	}
+
+	copy(p.publicKey[:], data)
+	if !params.Validate(data) {
+		return ErrPublicKeyValidation
+	}
+
+	return nil
+}
+
+// PrivateKey is a private CTIDH key.
+type PrivateKey struct {
+	privateKey [purego.MaxPrivateKeySize]int8
+}
+
+// Bytes serializes PrivateKey into a byte slice.
+func (p *PrivateKey) Bytes() []byte {
+	out := make([]byte, PrivateKeySize)
+	for i := range out {
+		out[i] = byte(p.privateKey[i])
+	}
+	return out
+}
+
+// FromBytes loads a PrivateKey from the given byte slice, and
+// returns ErrPrivateKeyValidation if the exponents are outside of the
+// CTIDH batch bounds.
+func (p *PrivateKey) FromBytes(data []byte) error {
+	if err := p.FromBytesUnchecked(data); err != nil {
+		return err
+	}
+	if !params.ValidatePrivateKey(p.privateKey[:PrivateKeySize]) {
+		p.Reset()
+		return ErrPrivateKeyValidation
+	}
+	return nil
+}
+
+// FromBytesUnchecked loads a PrivateKey from the given byte slice
+// without checking the exponent bounds. It is only meant for loading
+// keys stored by earlier releases, which did not validate them; the
+// group action of such a key is neither correct nor constant time.
+func (p *PrivateKey) FromBytesUnchecked(data []byte) error {
+	if len(data) != PrivateKeySize {
+		return ErrPrivateKeySize
+	}
+
+	for i, b := range data {
+		p.privateKey[i] = int8(b)
+	}
+	return nil
+}
+
+func generatePrivateKey(rng io.Reader) (*PrivateKey, error) {
+	privKey := new(PrivateKey)
+	err := params.GeneratePrivateKey(privKey.privateKey[:PrivateKeySize], rng)
+	if err != nil {
+		privKey.Reset()
+		return nil, err
+	}
+	return privKey, nil
+}
+
+func groupAction(privateKey *PrivateKey, publicKey *PublicKey) (*PublicKey, error) {
+	sharedKey := new(PublicKey)
+	err := params.CSIDH(sharedKey.publicKey[:PublicKeySize],
+		publicKey.publicKey[:PublicKeySize], privateKey.privateKey[:PrivateKeySize])
+	if err != nil {
+		return nil, ErrPublicKeyValidation
+	}
+	return sharedKey, nil
+}
+
+func init() {
+	PrivateKeySize = params.PrivateKeySize()
+	PublicKeySize = params.PublicKeySize()
+}
@@ -0,0 +1,503 @@
+// Package ratchet implements the Double Ratchet algorithm of Signal,
+// with header encryption, with the CTIDH NIKE for the DH ratchet.
+//
+// It follows the specification's functions with header encryption
+// (section 4), with these choices for the external functions:
+//
+//	KDF_RK: HKDF-SHA256 with the root key as salt, giving the next
+//	        root key, chain key and next header key
+//	KDF_CK: HMAC-SHA256 of the chain key with the bytes 1 and 2
+//	ENCRYPT: ChaCha20-Poly1305 under the message key, which is only
+//	         used once, with a zero nonce
+//	HENCRYPT: XChaCha20-Poly1305 under the header key with a random
+//	          nonce
+//
+// CTIDH key generation takes milliseconds, so the DH ratchet step is
+// split in two. The receiving half, which derives the new receiving
+// chain, is taken by Decrypt and needs no new key. The sending half,
+// which generates the next ratchet key pair and derives the new
+// sending chain, is taken by the next Encrypt. With Config.Prefetch
+// the key pair is generated in the background as soon as Decrypt
+// knows that it will be needed, so that Encrypt does not wait for it.
+//
+// Decrypt only changes the session once a message authenticates, and
+// skipped message keys are bounded both per chain and in total.
+package ratchet
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+)
+
+// Default limits for skipped message keys.
+const (
+	DefaultMaxSkip        = 1000
+	DefaultMaxSkippedKeys = 2000
+)
+
+// keySize is the size of root, chain, message and header keys.
+const keySize = 32
+
+var (
+	// ErrSharedKeySize indicates a shared key which is not 32 bytes.
+	ErrSharedKeySize = errors.New("ratchet: invalid shared key size")
+
+	// ErrNoSendingChain indicates an Encrypt by the responder before
+	// it received the first message of the initiator.
+	ErrNoSendingChain = errors.New("ratchet: no sending chain yet")
+
+	// ErrMessage indicates a message which is too short.
+	ErrMessage = errors.New("ratchet: malformed message")
+
+	// ErrDecrypt indicates a message which failed to authenticate.
+	ErrDecrypt = errors.New("ratchet: message authentication failed")
+
+	// ErrTooManySkipped indicates a message which would skip more
+	// than MaxSkip message keys of a chain.
+	ErrTooManySkipped = errors.New("ratchet: too many skipped messages")
+
+	// ErrSessionData indicates malformed session state.
+	ErrSessionData = errors.New("ratchet: malformed session state")
+)
+
+// Config configures a session.
+type Config struct {
+	// Scheme is the NIKE of the ratchet keys.
+	Scheme nike.Scheme
+
+	// Info identifies the application in the KDFs.
+	Info string
+
+	// MaxSkip is the maximum number of message keys skipped in a
+	// single chain. If it is zero, DefaultMaxSkip is used.
+	MaxSkip int
+
+	// MaxSkippedKeys is the maximum number of skipped message keys
+	// stored; the oldest are dropped first. If it is zero,
+	// DefaultMaxSkippedKeys is used.
+	MaxSkippedKeys int
+
+	// Prefetch makes the session generate its next ratchet key
+	// pair in the background as soon as it is known to be needed.
+	Prefetch bool
+
+	// Random is the source of ratchet keys and header nonces. If
+	// it is nil, crypto/rand.Reader is used. With Prefetch it is
+	// read from another goroutine, so it must be safe for that.
+	Random io.Reader
+}
+
+func (c *Config) maxSkip() int {
+	if c.MaxSkip == 0 {
+		return DefaultMaxSkip
+	}
+	return c.MaxSkip
+}
+
+func (c *Config) maxSkippedKeys() int {
+	if c.MaxSkippedKeys == 0 {
+		return DefaultMaxSkippedKeys
+	}
+	return c.MaxSkippedKeys
+}
+
+func (c *Config) rng() io.Reader {
+	if c.Random == nil {
+		return rand.Reader
+	}
+	return c.Random
+}
+
+type key = [keySize]byte
+
+// skippedKey is a stored message key with the header key and number
+// of its message.
+type skippedKey struct {
+	hk key
+	n  uint32
+	mk key
+}
+
+type keyPair struct {
+	privateKey nike.PrivateKey
+	publicKey  nike.PublicKey
+	err        error
+}
+
+// Session is one party's state of a conversation. It is not safe for
+// concurrent use.
+type Session struct {
+	config *Config
+
+	dhs     *keyPair
+	dhr     nike.PublicKey
+	rk      key
+	cks     *key
+	ckr     *key
+	ns      uint32
+	nr      uint32
+	pn      uint32
+	hks     *key
+	hkr     *key
+	nhks    key
+	nhkr    key
+	skipped []skippedKey
+
+	// sendRatchet is set when the sending half of a DH ratchet
+	// step is due.
+	sendRatchet bool
+
+	prefetch chan *keyPair
+}
+
+// initialKeys derives the root key and the two shared header keys
+// from the shared key of the key agreement.
+func initialKeys(config *Config, sharedKey []byte) (rk, hka, nhkb key, err error) {
+	if len(sharedKey) != keySize {
+		return rk, hka, nhkb, ErrSharedKeySize
+	}
+	r := hkdf.New(sha256.New, sharedKey, nil, []byte(config.Info+" ratchet init"))
+	for _, k := range []*key{&rk, &hka, &nhkb} {
+		if _, err := io.ReadFull(r, k[:]); err != nil {
+			return rk, hka, nhkb, err
+		}
+	}
+	return rk, hka, nhkb, nil
+}
+
+// NewInitiator returns the session of the party which sends the first
+// message, given the shared key of the key agreement, such as the SK
+// of X3DH, and the responder's ratchet public key, such as its signed
+// prekey.
+func NewInitiator(config *Config, sharedKey []byte, peerRatchetKey nike.PublicKey) (*Session, error) {
+	rk, hka, nhkb, err := initialKeys(config, sharedKey)
+	if err != nil {
+		return nil, err
+	}
+	s := &Session{
+		config:      config,
+		dhr:         peerRatchetKey,
+		rk:          rk,
+		hks:         &hka,
+		nhkr:        nhkb,
+		sendRatchet: true,
+	}
+	if config.Prefetch {
+		s.Prefetch()
+	}
+	return s, nil
+}
+
+// NewResponder returns the session of the party which receives the
+// first message, given the shared key of the key agreement and its
+// ratchet key pair.
+func NewResponder(config *Config, sharedKey []byte, ratchetPrivateKey nike.PrivateKey, ratchetPublicKey nike.PublicKey) (*Session, error) {
+	rk, hka, nhkb, err := initialKeys(config, sharedKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Session{
+		config: config,
+		dhs:    &keyPair{privateKey: ratchetPrivateKey, publicKey: ratchetPublicKey},
+		rk:     rk,
+		nhks:   nhkb,
+		nhkr:   hka,
+	}, nil
+}
+
+// Prefetch starts generating the next ratchet key pair in the
+// background, unless that is already under way.
+func (s *Session) Prefetch() {
+	if s.prefetch != nil {
+		return
+	}
+	ch := make(chan *keyPair, 1)
+	s.prefetch = ch
+	scheme, rng := s.config.Scheme, s.config.rng()
+	go func() {
+		privateKey, publicKey, err := scheme.GenerateKeyPair(rng)
+		ch <- &keyPair{privateKey: privateKey, publicKey: publicKey, err: err}
+	}()
+}
+
+// nextKeyPair returns the prefetched key pair, or a new one.
+func (s *Session) nextKeyPair() (*keyPair, error) {
+	if s.prefetch != nil {
+		kp := <-s.prefetch
+		s.prefetch = nil
+		if kp.err == nil {
+			return kp, nil
+		}
+	}
+	privateKey, publicKey, err := s.config.Scheme.GenerateKeyPair(s.config.rng())
+	if err != nil {
+		return nil, err
+	}
+	return &keyPair{privateKey: privateKey, publicKey: publicKey}, nil
+}
+
+// kdfRK returns the next root key, chain key and next header key.
+func (s *Session) kdfRK(rk *key, dhOut []byte) (rkOut, ck, nhk key) {
+	r := hkdf.New(sha256.New, dhOut, rk[:], []byte(s.config.Info+" ratchet root"))
+	for _, k := range []*key{&rkOut, &ck, &nhk} {
+		if _, err := io.ReadFull(r, k[:]); err != nil {
+			panic(err)
+		}
+	}
+	return rkOut, ck, nhk
+}
+
+// kdfCK returns the next chain key and the message key.
+func kdfCK(ck *key) (ckOut, mk key) {
+	for i, k := range []*key{&mk, &ckOut} {
+		h := hmac.New(sha256.New, ck[:])
+		h.Write([]byte{byte(i + 1)})
+		h.Sum(k[:0])
+	}
+	return ckOut, mk
+}
+
+// headerSize returns the size of a plaintext header: the ratchet
+// public key, PN and N.
+func (s *Session) headerSize() int {
+	return s.config.Scheme.PublicKeySize() + 8
+}
+
+// EncryptedHeaderSize returns the size in bytes of the encrypted
+// header which starts every message.
+func (s *Session) EncryptedHeaderSize() int {
+	return chacha20poly1305.NonceSizeX + s.headerSize() + tagSize
+}
+
+// tagSize is the size of the Poly1305 authentication tags.
+const tagSize = 16
+
+// ratchetSend takes the sending half of a DH ratchet step.
+func (s *Session) ratchetSend() error {
+	kp, err := s.nextKeyPair()
+	if err != nil {
+		return err
+	}
+	dhOut, err := s.config.Scheme.DeriveSecret(kp.privateKey, s.dhr)
+	if err != nil {
+		return err
+	}
+	rk, cks, nhks := s.kdfRK(&s.rk, dhOut)
+	if s.dhs != nil {
+		s.dhs.privateKey.Reset()
+	}
+	s.dhs = kp
+	s.rk, s.cks, s.nhks = rk, &cks, nhks
+	s.sendRatchet = false
+	return nil
+}
+
+// Encrypt returns the message carrying plaintext, with the associated
+// data ad, which is typically the AD of X3DH. The message is the
+// encrypted header followed by the encrypted plaintext.
+func (s *Session) Encrypt(plaintext, ad []byte) ([]byte, error) {
+	if s.sendRatchet {
+		if err := s.ratchetSend(); err != nil {
+			return nil, err
+		}
+	}
+	if s.cks == nil || s.hks == nil {
+		return nil, ErrNoSendingChain
+	}
+
+	header := make([]byte, 0, s.headerSize())
+	header = append(header, s.dhs.publicKey.Bytes()...)
+	header = binary.BigEndian.AppendUint32(header, s.pn)
+	header = binary.BigEndian.AppendUint32(header, s.ns)
+
+	nonce := make([]byte, chacha20poly1305.NonceSizeX, s.EncryptedHeaderSize()+len(plaintext)+tagSize)
+	if _, err := io.ReadFull(s.config.rng(), nonce); err != nil {
+		return nil, err
+	}
+	headerAEAD, err := chacha20poly1305.NewX(s.hks[:])
+	if err != nil {
+		return nil, err
+	}
+	message := headerAEAD.Seal(nonce, nonce, header, nil)
+
+	cks, mk := kdfCK(s.cks)
+	messageAEAD, err := chacha20poly1305.New(mk[:])
+	if err != nil {
+		return nil, err
+	}
+	message = messageAEAD.Seal(message, make([]byte, chacha20poly1305.NonceSize), plaintext, append(append([]byte{}, ad...), message...))
+	s.cks = &cks
+	s.ns++
+	return message, nil
+}
+
+// decryptHeader decrypts an encrypted header with the header key hk.
+func (s *Session) decryptHeader(hk *key, encryptedHeader []byte) (dh []byte, pn, n uint32, ok bool) {
+	aead, err := chacha20poly1305.NewX(hk[:])
+	if err != nil {
+		return nil, 0, 0, false
+	}
+	nonce, ciphertext := encryptedHeader[:chacha20poly1305.NonceSizeX], encryptedHeader[chacha20poly1305.NonceSizeX:]
+	header, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, 0, 0, false
+	}
+	pkSize := s.config.Scheme.PublicKeySize()
+	return header[:pkSize], binary.BigEndian.Uint32(header[pkSize:]), binary.BigEndian.Uint32(header[pkSize+4:]), true
+}
+
+func decryptBody(mk *key, message []byte, headerSize int, ad []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.New(mk[:])
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := aead.Open(nil, make([]byte, chacha20poly1305.NonceSize), message[headerSize:], append(append([]byte{}, ad...), message[:headerSize]...))
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plaintext, nil
+}
+
+// trySkippedKeys decrypts a message with a stored skipped message key.
+func (s *Session) trySkippedKeys(message, ad []byte) ([]byte, bool) {
+	headerSize := s.EncryptedHeaderSize()
+	tried := make(map[key]bool)
+	for _, sk := range s.skipped {
+		if tried[sk.hk] {
+			continue
+		}
+		tried[sk.hk] = true
+		_, _, n, ok := s.decryptHeader(&sk.hk, message[:headerSize])
+		if !ok {
+			continue
+		}
+		for i, sk2 := range s.skipped {
+			if sk2.hk != sk.hk || sk2.n != n {
+				continue
+			}
+			plaintext, err := decryptBody(&sk2.mk, message, headerSize, ad)
+			if err != nil {
+				return nil, false
+			}
+			s.skipped = append(s.skipped[:i], s.skipped[i+1:]...)
+			return plaintext, true
+		}
+		return nil, false
+	}
+	return nil, false
+}
+
+// skipMessageKeys stores the message keys of the receiving chain up to
+// message number until.
+func (s *Session) skipMessageKeys(until uint32) error {
+	if uint64(s.nr)+uint64(s.config.maxSkip()) < uint64(until) {
+		return ErrTooManySkipped
+	}
+	if s.ckr == nil {
+		return nil
+	}
+	for s.nr < until {
+		ckr, mk := kdfCK(s.ckr)
+		s.ckr = &ckr
+		s.skipped = append(s.skipped, skippedKey{hk: *s.hkr, n: s.nr, mk: mk})
+		s.nr++
+	}
+	if excess := len(s.skipped) - s.config.maxSkippedKeys(); excess > 0 {
+		s.skipped = append([]skippedKey{}, s.skipped[excess:]...)
+	}
+	return nil
+}
+
+// Decrypt returns the plaintext of message, which must have been
+// encrypted with the same associated data ad. If it fails the session
+// is unchanged.
+func (s *Session) Decrypt(message, ad []byte) ([]byte, error) {
+	headerSize := s.EncryptedHeaderSize()
+	if len(message) < headerSize+tagSize {
+		return nil, ErrMessage
+	}
+	if plaintext, ok := s.trySkippedKeys(message, ad); ok {
+		return plaintext, nil
+	}
+
+	// Work on a copy, which replaces the session only if the
+	// message authenticates.
+	next := *s
+	next.skipped = append([]skippedKey{}, s.skipped...)
+
+	var dh []byte
+	var pn, n uint32
+	ok := false
+	if next.hkr != nil {
+		dh, pn, n, ok = next.decryptHeader(next.hkr, message[:headerSize])
+	}
+	if !ok {
+		dh, pn, n, ok = next.decryptHeader(&next.nhkr, message[:headerSize])
+		if !ok {
+			return nil, ErrDecrypt
+		}
+		if err := next.ratchetReceive(dh, pn); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := next.skipMessageKeys(n); err != nil {
+		return nil, err
+	}
+	if next.ckr == nil {
+		return nil, ErrDecrypt
+	}
+	ckr, mk := kdfCK(next.ckr)
+	next.ckr = &ckr
+	next.nr++
+	plaintext, err := decryptBody(&mk, message, headerSize, ad)
+	if err != nil {
+		return nil, err
+	}
+
+	*s = next
+	if s.sendRatchet && s.config.Prefetch {
+		s.Prefetch()
+	}
+	return plaintext, nil
+}
+
+// ratchetReceive takes the receiving half of a DH ratchet step for the
+// new ratchet public key dh.
+func (s *Session) ratchetReceive(dh []byte, pn uint32) error {
+	if s.dhs == nil {
+		return ErrDecrypt
+	}
+	if err := s.skipMessageKeys(pn); err != nil {
+		return err
+	}
+	dhr, err := s.config.Scheme.UnmarshalBinaryPublicKey(dh)
+	if err != nil {
+		return err
+	}
+	dhOut, err := s.config.Scheme.DeriveSecret(s.dhs.privateKey, dhr)
+	if err != nil {
+		return err
+	}
+
+	s.pn = s.ns
+	s.ns = 0
+	s.nr = 0
+	hks, hkr := s.nhks, s.nhkr
+	s.hks, s.hkr = &hks, &hkr
+	s.dhr = dhr
+	rk, ckr, nhkr := s.kdfRK(&s.rk, dhOut)
+	s.rk, s.ckr, s.nhkr = rk, &ckr, nhkr
+	s.cks = nil
+	s.sendRatchet = true
+	return nil
+}
@@ -0,0 +1,193 @@
+package ratchet
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"git.xx.network/elixxir/ctidh_cgo/ctidh511"
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+	"git.xx.network/elixxir/ctidh_cgo/nike/x25519"
+)
+
+var testAD = []byte("alice and bob")
+
+func newTestSessions(t *testing.T, config *Config) (*Session, *Session) {
+	sharedKey := make([]byte, keySize)
+	_, err := rand.Read(sharedKey)
+	require.NoError(t, err)
+	bobPrivate, bobPublic, err := config.Scheme.GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+	alice, err := NewInitiator(config, sharedKey, bobPublic)
+	require.NoError(t, err)
+	bob, err := NewResponder(config, sharedKey, bobPrivate, bobPublic)
+	require.NoError(t, err)
+	return alice, bob
+}
+
+func send(t *testing.T, from *Session, plaintext string) []byte {
+	message, err := from.Encrypt([]byte(plaintext), testAD)
+	require.NoError(t, err)
+	require.Len(t, message, from.EncryptedHeaderSize()+len(plaintext)+tagSize)
+	return message
+}
+
+func receive(t *testing.T, to *Session, message []byte, plaintext string) {
+	got, err := to.Decrypt(message, testAD)
+	require.NoError(t, err)
+	require.Equal(t, plaintext, string(got))
+}
+
+func TestConversation(t *testing.T) {
+	for _, scheme := range []nike.Scheme{ctidh511.Scheme(), x25519.Scheme()} {
+		for _, prefetch := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s/prefetch=%v", scheme.Name(), prefetch), func(t *testing.T) {
+				alice, bob := newTestSessions(t, &Config{Scheme: scheme, Info: "test", Prefetch: prefetch})
+
+				_, err := bob.Encrypt([]byte("too early"), testAD)
+				require.ErrorIs(t, err, ErrNoSendingChain)
+
+				// Turns of several messages each way, so that
+				// every turn is a DH ratchet step.
+				for turn := 0; turn < 4; turn++ {
+					from, to := alice, bob
+					if turn%2 == 1 {
+						from, to = bob, alice
+					}
+					for i := 0; i < 3; i++ {
+						plaintext := fmt.Sprintf("turn %d message %d", turn, i)
+						receive(t, to, send(t, from, plaintext), plaintext)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestOutOfOrder(t *testing.T) {
+	alice, bob := newTestSessions(t, &Config{Scheme: ctidh511.Scheme()})
+
+	a0 := send(t, alice, "a0")
+	a1 := send(t, alice, "a1")
+	a2 := send(t, alice, "a2")
+	receive(t, bob, a1, "a1")
+	b0 := send(t, bob, "b0")
+
+	// a3 is sent in the next chain of alice, after she received b0,
+	// while a0 and a2 of the previous chain are still on their way.
+	receive(t, alice, b0, "b0")
+	a3 := send(t, alice, "a3")
+	receive(t, bob, a3, "a3")
+	require.Len(t, bob.skipped, 2)
+	receive(t, bob, a2, "a2")
+	receive(t, bob, a0, "a0")
+	require.Empty(t, bob.skipped)
+
+	// A message is only accepted once.
+	_, err := bob.Decrypt(a0, testAD)
+	require.ErrorIs(t, err, ErrDecrypt)
+	_, err = bob.Decrypt(a3, testAD)
+	require.ErrorIs(t, err, ErrDecrypt)
+}
+
+func TestSkipLimits(t *testing.T) {
+	alice, bob := newTestSessions(t, &Config{Scheme: x25519.Scheme(), MaxSkip: 5, MaxSkippedKeys: 8})
+
+	var messages [][]byte
+	for i := 0; i < 7; i++ {
+		messages = append(messages, send(t, alice, fmt.Sprint(i)))
+	}
+	_, err := bob.Decrypt(messages[6], testAD)
+	require.ErrorIs(t, err, ErrTooManySkipped)
+	receive(t, bob, messages[5], "5")
+	require.Len(t, bob.skipped, 5)
+	receive(t, bob, messages[6], "6")
+
+	// Skipping 5 more keeps only the 8 most recent skipped keys.
+	for i := 7; i < 13; i++ {
+		messages = append(messages, send(t, alice, fmt.Sprint(i)))
+	}
+	receive(t, bob, messages[12], "12")
+	require.Len(t, bob.skipped, 8)
+	_, err = bob.Decrypt(messages[1], testAD)
+	require.ErrorIs(t, err, ErrDecrypt)
+	receive(t, bob, messages[2], "2")
+	receive(t, bob, messages[11], "11")
+}
+
+func TestTamperedMessage(t *testing.T) {
+	alice, bob := newTestSessions(t, &Config{Scheme: ctidh511.Scheme()})
+	receive(t, bob, send(t, alice, "hello"), "hello")
+	receive(t, alice, send(t, bob, "hi"), "hi")
+
+	message := send(t, alice, "how are you")
+	state, err := bob.MarshalBinary()
+	require.NoError(t, err)
+
+	// Failed messages, including ones which would start a DH ratchet
+	// step, leave the session as it was.
+	for _, i := range []int{0, bob.EncryptedHeaderSize() - 1, bob.EncryptedHeaderSize(), len(message) - 1} {
+		tampered := append([]byte{}, message...)
+		tampered[i] ^= 1
+		_, err := bob.Decrypt(tampered, testAD)
+		require.ErrorIs(t, err, ErrDecrypt)
+		after, err := bob.MarshalBinary()
+		require.NoError(t, err)
+		require.Equal(t, state, after)
+	}
+	_, err = bob.Decrypt(message, []byte("other AD"))
+	require.ErrorIs(t, err, ErrDecrypt)
+	_, err = bob.Decrypt(message[:bob.EncryptedHeaderSize()], testAD)
+	require.ErrorIs(t, err, ErrMessage)
+
+	receive(t, bob, message, "how are you")
+}
+
+func TestSerialization(t *testing.T) {
+	config := &Config{Scheme: ctidh511.Scheme(), Info: "test"}
+	alice, bob := newTestSessions(t, config)
+
+	restore := func(s *Session) *Session {
+		data, err := s.MarshalBinary()
+		require.NoError(t, err)
+		require.Len(t, data, stateSize(config, len(s.skipped)))
+		restored, err := UnmarshalSession(config, data)
+		require.NoError(t, err)
+		again, err := restored.MarshalBinary()
+		require.NoError(t, err)
+		require.Equal(t, data, again)
+		return restored
+	}
+
+	// Both sessions are restored at every stage of the conversation,
+	// including with skipped keys and pending DH ratchet steps.
+	alice, bob = restore(alice), restore(bob)
+	a0 := send(t, alice, "a0")
+	a1 := send(t, alice, "a1")
+	alice, bob = restore(alice), restore(bob)
+	receive(t, bob, a1, "a1")
+	alice, bob = restore(alice), restore(bob)
+	require.Len(t, bob.skipped, 1)
+	receive(t, alice, send(t, bob, "b0"), "b0")
+	alice, bob = restore(alice), restore(bob)
+	receive(t, bob, a0, "a0")
+	receive(t, bob, send(t, alice, "a2"), "a2")
+
+	data, err := bob.MarshalBinary()
+	require.NoError(t, err)
+	_, err = UnmarshalSession(config, data[1:])
+	require.ErrorIs(t, err, ErrSessionData)
+	data[0] = 2
+	_, err = UnmarshalSession(config, data)
+	require.ErrorIs(t, err, ErrSessionData)
+}
+
+func TestSharedKeySize(t *testing.T) {
+	config := &Config{Scheme: x25519.Scheme()}
+	_, publicKey, err := config.Scheme.GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+	_, err = NewInitiator(config, make([]byte, keySize-1), publicKey)
+	require.ErrorIs(t, err, ErrSharedKeySize)
+}
@@ -0,0 +1,181 @@
+package ratchet
+
+import (
+	"encoding/binary"
+)
+
+// stateVersion is the version of the session state encoding.
+const stateVersion = 1
+
+// Flags of the session state encoding, for the parts of the state
+// which may be absent.
+const (
+	flagDHs = 1 << iota
+	flagDHr
+	flagCKs
+	flagCKr
+	flagHKs
+	flagHKr
+	flagSendRatchet
+)
+
+// skippedKeySize is the size of an encoded skipped message key.
+const skippedKeySize = keySize + 4 + keySize
+
+// stateSize returns the size of the session state encoding with n
+// skipped message keys.
+func stateSize(config *Config, n int) int {
+	pkSize := config.Scheme.PublicKeySize()
+	return 2 + config.Scheme.PrivateKeySize() + 2*pkSize + 7*keySize + 3*4 + 4 + n*skippedKeySize
+}
+
+// MarshalBinary encodes the session state, so that it can be stored
+// and restored with UnmarshalSession. The ratchet key pair and the
+// peer's ratchet public key are stored in their usual encodings. The
+// encoding holds every key of the session, so it must be protected
+// like a private key. A prefetched key pair is not part of it.
+func (s *Session) MarshalBinary() ([]byte, error) {
+	var flags byte
+	data := make([]byte, 2, stateSize(s.config, len(s.skipped)))
+	data[0] = stateVersion
+
+	privateKey := make([]byte, s.config.Scheme.PrivateKeySize())
+	publicKey := make([]byte, s.config.Scheme.PublicKeySize())
+	if s.dhs != nil {
+		flags |= flagDHs
+		b, err := s.dhs.privateKey.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		copy(privateKey, b)
+		if b, err = s.dhs.publicKey.MarshalBinary(); err != nil {
+			return nil, err
+		}
+		copy(publicKey, b)
+	}
+	data = append(append(data, privateKey...), publicKey...)
+
+	peerKey := make([]byte, s.config.Scheme.PublicKeySize())
+	if s.dhr != nil {
+		flags |= flagDHr
+		b, err := s.dhr.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		copy(peerKey, b)
+	}
+	data = append(data, peerKey...)
+
+	for _, k := range []struct {
+		key  *key
+		flag byte
+	}{
+		{&s.rk, 0},
+		{s.cks, flagCKs},
+		{s.ckr, flagCKr},
+		{s.hks, flagHKs},
+		{s.hkr, flagHKr},
+		{&s.nhks, 0},
+		{&s.nhkr, 0},
+	} {
+		if k.key == nil {
+			data = append(data, make([]byte, keySize)...)
+			continue
+		}
+		flags |= k.flag
+		data = append(data, k.key[:]...)
+	}
+	if s.sendRatchet {
+		flags |= flagSendRatchet
+	}
+	data[1] = flags
+
+	data = binary.BigEndian.AppendUint32(data, s.ns)
+	data = binary.BigEndian.AppendUint32(data, s.nr)
+	data = binary.BigEndian.AppendUint32(data, s.pn)
+	data = binary.BigEndian.AppendUint32(data, uint32(len(s.skipped)))
+	for _, sk := range s.skipped {
+		data = append(data, sk.hk[:]...)
+		data = binary.BigEndian.AppendUint32(data, sk.n)
+		data = append(data, sk.mk[:]...)
+	}
+	return data, nil
+}
+
+// UnmarshalSession restores a session encoded by MarshalBinary. The
+// config must have the same Scheme and Info as that of the session.
+func UnmarshalSession(config *Config, data []byte) (*Session, error) {
+	if len(data) < stateSize(config, 0) || data[0] != stateVersion {
+		return nil, ErrSessionData
+	}
+	n := binary.BigEndian.Uint32(data[stateSize(config, 0)-4:])
+	if uint64(len(data)) != uint64(stateSize(config, 0))+uint64(n)*skippedKeySize {
+		return nil, ErrSessionData
+	}
+	flags := data[1]
+	data = data[2:]
+
+	s := &Session{config: config}
+	privSize, pkSize := config.Scheme.PrivateKeySize(), config.Scheme.PublicKeySize()
+	if flags&flagDHs != 0 {
+		privateKey, err := config.Scheme.UnmarshalBinaryPrivateKey(data[:privSize])
+		if err != nil {
+			return nil, err
+		}
+		publicKey, err := config.Scheme.UnmarshalBinaryPublicKey(data[privSize : privSize+pkSize])
+		if err != nil {
+			return nil, err
+		}
+		s.dhs = &keyPair{privateKey: privateKey, publicKey: publicKey}
+	}
+	data = data[privSize+pkSize:]
+	if flags&flagDHr != 0 {
+		publicKey, err := config.Scheme.UnmarshalBinaryPublicKey(data[:pkSize])
+		if err != nil {
+			return nil, err
+		}
+		s.dhr = publicKey
+	}
+	data = data[pkSize:]
+
+	for _, k := range []struct {
+		key  **key
+		flag byte
+	}{
+		{nil, 0},
+		{&s.cks, flagCKs},
+		{&s.ckr, flagCKr},
+		{&s.hks, flagHKs},
+		{&s.hkr, flagHKr},
+	} {
+		switch {
+		case k.key == nil:
+			copy(s.rk[:], data)
+		case flags&k.flag != 0:
+			*k.key = new(key)
+			copy((*k.key)[:], data)
+		}
+		data = data[keySize:]
+	}
+	copy(s.nhks[:], data)
+	copy(s.nhkr[:], data[keySize:])
+	data = data[2*keySize:]
+	s.sendRatchet = flags&flagSendRatchet != 0
+
+	s.ns = binary.BigEndian.Uint32(data)
+	s.nr = binary.BigEndian.Uint32(data[4:])
+	s.pn = binary.BigEndian.Uint32(data[8:])
+	data = data[16:]
+	s.skipped = make([]skippedKey, n)
+	for i := range s.skipped {
+		copy(s.skipped[i].hk[:], data)
+		s.skipped[i].n = binary.BigEndian.Uint32(data[keySize:])
+		copy(s.skipped[i].mk[:], data[keySize+4:])
+		data = data[skippedKeySize:]
+	}
+
+	if s.sendRatchet && config.Prefetch {
+		s.Prefetch()
+	}
+	return s, nil
+}
@@ -0,0 +1,90 @@
+package ctidh
+
+import (
+	"crypto/rand"
+	"io"
+	"math/big"
+)
+
+// Representatives hide the structure of the public key encoding,
+// which is a field element below p and so, for instance, never sets
+// the top bit of a 511-bit key.
+//
+// A representative of the public key A is the integer A + k*p, for a
+// k drawn uniformly from [0, K) with K = floor(2^n / p), written
+// little-endian in n = 8*RepresentativeSize bits. Were A a uniformly
+// random field element, the representative would be uniformly random
+// in [0, K*p), within 2^-128 of uniformly random n-bit strings. Every
+// public key has representatives, so unlike Elligator 2 for X25519
+// key generation never needs to retry.
+//
+// Representatives are not indistinguishable from random strings by
+// anyone who knows how they are made: reducing one modulo p yields a
+// public key which passes validation, while a random string reduces
+// to a valid key with probability about 1/sqrt(p). No encoding can do
+// better, since the valid keys are that sparse in the field and
+// validation is efficient. They only defeat distinguishers which do
+// not run the validation, such as byte patterns and bit statistics.
+// A handshake which must withstand a censor who runs it, as obfs4
+// does, has to encrypt the representative under a key distributed
+// out of band with the server's address.
+
+// representativeSlack is the number of bytes by which a
+// representative exceeds a public key.
+const representativeSlack = 16
+
+var (
+	// RepresentativeSize is the size in bytes of a public key
+	// representative.
+	RepresentativeSize = 8*(bits+63)/64 + representativeSlack
+
+	// representativeBound is K = floor(2^n / p), the number of
+	// multiples of p a public key may be shifted by.
+	representativeBound = new(big.Int).Div(
+		new(big.Int).Lsh(big.NewInt(1), uint(8*RepresentativeSize)), modulus)
+)
+
+// Representative returns a representative of the public key drawn
+// with randomness read from rng, so that the representatives sent for
+// the same key are not linkable by their bytes either.
+func (p *PublicKey) Representative(rng io.Reader) ([]byte, error) {
+	k, err := rand.Int(rng, representativeBound)
+	if err != nil {
+		return nil, err
+	}
+	r := k.Mul(k, modulus)
+	r.Add(r, new(big.Int).SetBytes(reverse(p.Bytes())))
+	return reverse(r.FillBytes(make([]byte, RepresentativeSize))), nil
+}
+
+// FromRepresentative loads the PublicKey from one of its
+// representatives, and returns ErrPublicKeyValidation if it does not
+// represent a valid public key.
+func (p *PublicKey) FromRepresentative(representative []byte) error {
+	if len(representative) != RepresentativeSize {
+		return ErrRepresentativeSize
+	}
+	r := new(big.Int).SetBytes(reverse(representative))
+	r.Mod(r, modulus)
+	return p.FromBytes(reverse(r.FillBytes(make([]byte, PublicKeySize))))
+}
+
+// NewPublicKeyFromRepresentative is like NewPublicKey but loads the
+// key from a representative.
+func NewPublicKeyFromRepresentative(representative []byte) (*PublicKey, error) {
+	k := new(PublicKey)
+	if err := k.FromRepresentative(representative); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// reverse returns b with its bytes in reverse order, converting
+// between the little-endian key encoding and big.Int.
+func reverse(b []byte) []byte {
+	out := make([]byte, len(b))
+	for i, c := range b {
+		out[len(b)-1-i] = c
+	}
+	return out
+}
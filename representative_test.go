@@ -0,0 +1,108 @@
+package ctidh
+
+import (
+	"bytes"
+	"crypto/rand"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepresentativeRoundTrip(t *testing.T) {
+	for i := 0; i < 4; i++ {
+		_, publicKey := MustGenerateKeyPair()
+		seen := make(map[string]bool)
+		for j := 0; j < 8; j++ {
+			representative, err := publicKey.Representative(rand.Reader)
+			require.NoError(t, err)
+			require.Len(t, representative, RepresentativeSize)
+			require.False(t, seen[string(representative)])
+			seen[string(representative)] = true
+
+			decoded, err := NewPublicKeyFromRepresentative(representative)
+			require.NoError(t, err)
+			require.Equal(t, publicKey.Bytes(), decoded.Bytes())
+		}
+	}
+
+	// The base curve has representatives too.
+	representative, err := new(PublicKey).Representative(rand.Reader)
+	require.NoError(t, err)
+	decoded, err := NewPublicKeyFromRepresentative(representative)
+	require.NoError(t, err)
+	require.Equal(t, make([]byte, PublicKeySize), decoded.Bytes())
+
+	_, err = NewPublicKeyFromRepresentative(representative[1:])
+	require.ErrorIs(t, err, ErrRepresentativeSize)
+	_, err = new(PublicKey).Representative(bytes.NewReader(nil))
+	require.Error(t, err)
+}
+
+func TestRepresentativeOfRandomBytes(t *testing.T) {
+	// Random strings almost never represent a valid public key,
+	// which is what distinguishes representatives from them.
+	for i := 0; i < 16; i++ {
+		representative := make([]byte, RepresentativeSize)
+		_, err := rand.Read(representative)
+		require.NoError(t, err)
+		_, err = NewPublicKeyFromRepresentative(representative)
+		require.ErrorIs(t, err, ErrPublicKeyValidation)
+	}
+}
+
+func TestRepresentativeStatistics(t *testing.T) {
+	const samples = 4096
+	publicKeys := make([]*PublicKey, 4)
+	for i := range publicKeys {
+		_, publicKeys[i] = MustGenerateKeyPair()
+	}
+	representatives := make([][]byte, samples)
+	for i := range representatives {
+		var err error
+		representatives[i], err = publicKeys[i%len(publicKeys)].Representative(rand.Reader)
+		require.NoError(t, err)
+	}
+
+	// Every bit is set in about half of the samples, unlike the top
+	// bits of public keys, which are bounded by p.
+	sigma := math.Sqrt(samples) / 2
+	for bit := 0; bit < 8*RepresentativeSize; bit++ {
+		ones := 0
+		for _, r := range representatives {
+			ones += int(r[bit/8]>>(bit%8)) & 1
+		}
+		require.InDelta(t, samples/2, ones, 6*sigma, "bit %d", bit)
+	}
+
+	// Byte values are uniform, both pooled and in the most
+	// significant byte alone.
+	chiSquare := func(counts []int, n int) float64 {
+		expected := float64(n) / float64(len(counts))
+		var x float64
+		for _, c := range counts {
+			x += (float64(c) - expected) * (float64(c) - expected) / expected
+		}
+		return x
+	}
+	pooled, top := make([]int, 256), make([]int, 256)
+	for _, r := range representatives {
+		for _, b := range r {
+			pooled[b]++
+		}
+		top[r[len(r)-1]]++
+	}
+	bound := 255 + 6*math.Sqrt(2*255)
+	require.Less(t, chiSquare(pooled, samples*RepresentativeSize), bound)
+	require.Less(t, chiSquare(top, samples), bound)
+
+	// Most representatives exceed p, which no public key does.
+	above := 0
+	for _, r := range representatives {
+		if new(big.Int).SetBytes(reverse(r)).Cmp(modulus) >= 0 {
+			above++
+		}
+	}
+	require.Greater(t, above, samples*9/10)
+}
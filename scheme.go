@@ -0,0 +1,112 @@
+package ctidh
+
+import (
+	"io"
+
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+)
+
+// scheme is the nike.Scheme of this CTIDH parameter set.
+type scheme struct{}
+
+var _ nike.Scheme = scheme{}
+
+// Scheme returns the nike.Scheme of this CTIDH parameter set, whose
+// keys are the *PublicKey and *PrivateKey of this package.
+func Scheme() nike.Scheme {
+	return scheme{}
+}
+
+func (scheme) Name() string {
+	return Name()
+}
+
+func (scheme) PublicKeySize() int {
+	return PublicKeySize
+}
+
+func (scheme) PrivateKeySize() int {
+	return PrivateKeySize
+}
+
+func (scheme) BlindingFactorSize() int {
+	return MinSeedSize
+}
+
+func (scheme) GenerateKeyPair(rng io.Reader) (nike.PrivateKey, nike.PublicKey, error) {
+	privKey, pubKey, err := generateKeyPair("GenerateKeyPair", rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	return privKey, pubKey, nil
+}
+
+func (scheme) NewEmptyPublicKey() nike.PublicKey {
+	return NewEmptyPublicKey()
+}
+
+func (scheme) NewEmptyPrivateKey() nike.PrivateKey {
+	return NewEmptyPrivateKey()
+}
+
+func (scheme) UnmarshalBinaryPublicKey(data []byte) (nike.PublicKey, error) {
+	pubKey, err := NewPublicKey(data)
+	if err != nil {
+		return nil, err
+	}
+	return pubKey, nil
+}
+
+func (scheme) UnmarshalBinaryPrivateKey(data []byte) (nike.PrivateKey, error) {
+	privKey := NewEmptyPrivateKey()
+	if err := privKey.FromBytes(data); err != nil {
+		return nil, err
+	}
+	return privKey, nil
+}
+
+func (scheme) DerivePublicKey(privateKey nike.PrivateKey) (nike.PublicKey, error) {
+	privKey, ok := privateKey.(*PrivateKey)
+	if !ok {
+		return nil, nike.ErrKeyType
+	}
+	pubKey, err := DerivePublicKey(privKey)
+	if err != nil {
+		return nil, err
+	}
+	return pubKey, nil
+}
+
+func (scheme) DeriveSecret(privateKey nike.PrivateKey, publicKey nike.PublicKey) ([]byte, error) {
+	privKey, ok := privateKey.(*PrivateKey)
+	if !ok {
+		return nil, nike.ErrKeyType
+	}
+	pubKey, ok := publicKey.(*PublicKey)
+	if !ok {
+		return nil, nike.ErrKeyType
+	}
+	return DeriveSecret(privKey, pubKey)
+}
+
+func (scheme) Blind(blindingFactor []byte, publicKey nike.PublicKey) (nike.PublicKey, error) {
+	pubKey, ok := publicKey.(*PublicKey)
+	if !ok {
+		return nil, nike.ErrKeyType
+	}
+	blinded, err := Blind(blindingFactor, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return blinded, nil
+}
+
+// Twist returns the quadratic twist of a public key of this scheme,
+// see PublicKey.Twist.
+func (scheme) Twist(publicKey nike.PublicKey) (nike.PublicKey, error) {
+	pubKey, ok := publicKey.(*PublicKey)
+	if !ok {
+		return nil, nike.ErrKeyType
+	}
+	return pubKey.Twist(), nil
+}
@@ -0,0 +1,66 @@
+package ctidh
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+)
+
+// otherPublicKey and otherPrivateKey are the keys of some other NIKE.
+type otherPublicKey struct{ nike.PublicKey }
+type otherPrivateKey struct{ nike.PrivateKey }
+
+func TestScheme(t *testing.T) {
+	scheme := Scheme()
+	require.Equal(t, Name(), scheme.Name())
+	require.Equal(t, PublicKeySize, scheme.PublicKeySize())
+	require.Equal(t, PrivateKeySize, scheme.PrivateKeySize())
+
+	alicePrivate, alicePublic, err := scheme.GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+	require.IsType(t, &PrivateKey{}, alicePrivate)
+	require.IsType(t, &PublicKey{}, alicePublic)
+	bobPrivate, bobPublic := MustGenerateKeyPair()
+
+	secret, err := scheme.DeriveSecret(alicePrivate, bobPublic)
+	require.NoError(t, err)
+	require.Equal(t, MustDeriveSecret(bobPrivate, alicePublic.(*PublicKey)), secret)
+
+	derived, err := scheme.DerivePublicKey(alicePrivate)
+	require.NoError(t, err)
+	require.Equal(t, alicePublic.Bytes(), derived.Bytes())
+
+	blob, err := alicePublic.MarshalBinary()
+	require.NoError(t, err)
+	pub, err := scheme.UnmarshalBinaryPublicKey(blob)
+	require.NoError(t, err)
+	require.True(t, alicePublic.(*PublicKey).Equal(pub.(*PublicKey)))
+
+	blob, err = alicePrivate.MarshalBinary()
+	require.NoError(t, err)
+	priv, err := scheme.UnmarshalBinaryPrivateKey(blob)
+	require.NoError(t, err)
+	require.True(t, alicePrivate.(*PrivateKey).Equal(priv.(*PrivateKey)))
+
+	_, err = scheme.UnmarshalBinaryPublicKey(blob)
+	require.ErrorIs(t, err, ErrPublicKeySize)
+	_, err = scheme.UnmarshalBinaryPrivateKey(make([]byte, PrivateKeySize+1))
+	require.ErrorIs(t, err, ErrPrivateKeySize)
+}
+
+func TestSchemeKeyType(t *testing.T) {
+	scheme := Scheme()
+	privateKey, publicKey := MustGenerateKeyPair()
+
+	_, err := scheme.DeriveSecret(otherPrivateKey{}, publicKey)
+	require.ErrorIs(t, err, nike.ErrKeyType)
+	_, err = scheme.DeriveSecret(privateKey, otherPublicKey{})
+	require.ErrorIs(t, err, nike.ErrKeyType)
+	_, err = scheme.DerivePublicKey(otherPrivateKey{})
+	require.ErrorIs(t, err, nike.ErrKeyType)
+	_, err = scheme.Blind(make([]byte, scheme.BlindingFactorSize()), otherPublicKey{})
+	require.ErrorIs(t, err, nike.ErrKeyType)
+}
@@ -0,0 +1,140 @@
+package sphinx
+
+import (
+	"encoding/binary"
+)
+
+// Sizes of the command fields.
+const (
+	NodeIDSize      = 32
+	RecipientIDSize = 32
+	SURBIDSize      = 16
+)
+
+// The routing command types. Commands are encoded as their type
+// followed by their fixed size fields; a zero byte, or the end of the
+// routing information, ends the list of commands of a hop.
+const (
+	commandNull        = 0x00
+	commandNextNodeHop = 0x01
+	commandRecipient   = 0x02
+	commandSURBReply   = 0x03
+	commandNodeDelay   = 0x80
+
+	nextNodeHopLength = 1 + NodeIDSize
+	recipientLength   = 1 + RecipientIDSize
+	surbReplyLength   = 1 + SURBIDSize
+	nodeDelayLength   = 1 + 4
+)
+
+// DefaultRoutingInfoSize is a routing information size which fits
+// the longest list of commands a hop needs: a Recipient, a SURBReply
+// and a NodeDelay.
+const DefaultRoutingInfoSize = recipientLength + surbReplyLength + nodeDelayLength
+
+// Command is a routing command for a hop.
+type Command interface {
+	// appendTo appends the encoding of the command to b.
+	appendTo(b []byte) []byte
+}
+
+// NextNodeHop tells a hop which node to forward the packet to. It is
+// added by the packet constructors and must not be included in the
+// commands of a PathHop.
+type NextNodeHop struct {
+	ID [NodeIDSize]byte
+}
+
+func (c *NextNodeHop) appendTo(b []byte) []byte {
+	b = append(b, commandNextNodeHop)
+	return append(b, c.ID[:]...)
+}
+
+// Recipient tells the last hop to which local recipient to deliver
+// the payload.
+type Recipient struct {
+	ID [RecipientIDSize]byte
+}
+
+func (c *Recipient) appendTo(b []byte) []byte {
+	b = append(b, commandRecipient)
+	return append(b, c.ID[:]...)
+}
+
+// SURBReply tells the last hop that the payload is a reply sent with
+// the SURB of the given ID, which only the creator of the SURB can
+// decrypt.
+type SURBReply struct {
+	ID [SURBIDSize]byte
+}
+
+func (c *SURBReply) appendTo(b []byte) []byte {
+	b = append(b, commandSURBReply)
+	return append(b, c.ID[:]...)
+}
+
+// NodeDelay tells a hop how long, in milliseconds, to hold the
+// packet before forwarding it.
+type NodeDelay struct {
+	Delay uint32
+}
+
+func (c *NodeDelay) appendTo(b []byte) []byte {
+	b = append(b, commandNodeDelay)
+	return binary.BigEndian.AppendUint32(b, c.Delay)
+}
+
+// encodeCommands encodes cmds into a routing information block of
+// size bytes.
+func encodeCommands(cmds []Command, size int) ([]byte, error) {
+	b := make([]byte, 0, size)
+	for _, cmd := range cmds {
+		b = cmd.appendTo(b)
+	}
+	if len(b) > size {
+		return nil, ErrCommands
+	}
+	return b[:size], nil
+}
+
+// parseCommands decodes the commands of a routing information block.
+func parseCommands(b []byte) ([]Command, error) {
+	var cmds []Command
+	for len(b) > 0 && b[0] != commandNull {
+		var n int
+		switch b[0] {
+		case commandNextNodeHop:
+			n = nextNodeHopLength
+		case commandRecipient:
+			n = recipientLength
+		case commandSURBReply:
+			n = surbReplyLength
+		case commandNodeDelay:
+			n = nodeDelayLength
+		default:
+			return nil, ErrCommands
+		}
+		if len(b) < n {
+			return nil, ErrCommands
+		}
+
+		switch b[0] {
+		case commandNextNodeHop:
+			cmd := new(NextNodeHop)
+			copy(cmd.ID[:], b[1:n])
+			cmds = append(cmds, cmd)
+		case commandRecipient:
+			cmd := new(Recipient)
+			copy(cmd.ID[:], b[1:n])
+			cmds = append(cmds, cmd)
+		case commandSURBReply:
+			cmd := new(SURBReply)
+			copy(cmd.ID[:], b[1:n])
+			cmds = append(cmds, cmd)
+		case commandNodeDelay:
+			cmds = append(cmds, &NodeDelay{Delay: binary.BigEndian.Uint32(b[1:n])})
+		}
+		b = b[n:]
+	}
+	return cmds, nil
+}
@@ -0,0 +1,74 @@
+package sphinx
+
+import (
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/chacha20"
+	"golang.org/x/crypto/sha3"
+)
+
+// lionessKeySize is the size of the keys from which the four LIONESS
+// round keys are expanded.
+const lionessKeySize = 32
+
+// lioness is the LIONESS wide block cipher of Anderson and Biham,
+// which encrypts the payloads. Its stream cipher is ChaCha20 and its
+// hash is keyed BLAKE2b-256. Blocks must be longer than 32 bytes.
+type lioness struct {
+	k1, k2, k3, k4 [32]byte
+}
+
+func newLioness(key []byte) *lioness {
+	xof := sha3.NewShake256()
+	xof.Write([]byte("Sphinx LIONESS\x00"))
+	xof.Write(key)
+	l := new(lioness)
+	xof.Read(l.k1[:])
+	xof.Read(l.k2[:])
+	xof.Read(l.k3[:])
+	xof.Read(l.k4[:])
+	return l
+}
+
+// xorStream XORs r with the ChaCha20 keystream keyed by k XOR left.
+func xorStream(r []byte, k *[32]byte, left []byte) {
+	var key [32]byte
+	for i := range key {
+		key[i] = k[i] ^ left[i]
+	}
+	c, err := chacha20.NewUnauthenticatedCipher(key[:], make([]byte, chacha20.NonceSize))
+	if err != nil {
+		panic(err)
+	}
+	c.XORKeyStream(r, r)
+}
+
+// xorHash XORs left with the BLAKE2b-256 of r keyed by k.
+func xorHash(left []byte, k *[32]byte, r []byte) {
+	h, err := blake2b.New256(k[:])
+	if err != nil {
+		panic(err)
+	}
+	h.Write(r)
+	sum := h.Sum(nil)
+	for i := range sum {
+		left[i] ^= sum[i]
+	}
+}
+
+// encrypt encrypts block in place.
+func (l *lioness) encrypt(block []byte) {
+	left, r := block[:32], block[32:]
+	xorStream(r, &l.k1, left)
+	xorHash(left, &l.k2, r)
+	xorStream(r, &l.k3, left)
+	xorHash(left, &l.k4, r)
+}
+
+// decrypt decrypts block in place.
+func (l *lioness) decrypt(block []byte) {
+	left, r := block[:32], block[32:]
+	xorHash(left, &l.k4, r)
+	xorStream(r, &l.k3, left)
+	xorHash(left, &l.k2, r)
+	xorStream(r, &l.k1, left)
+}
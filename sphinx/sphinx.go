@@ -0,0 +1,406 @@
+// Package sphinx implements the Sphinx mix network packet format of
+// Danezis and Goldberg, with the CTIDH NIKE as the group.
+//
+// Sphinx needs a group in which a node can blind the group element
+// alpha of a packet for the next hop, while the sender computes the
+// secret it shares with every hop in advance. In CTIDH this is the
+// commutativity of the group action shown by TestBlindingOperation:
+// blinding the shared secret of the sender and a node gives the same
+// key as the node deriving the secret of the blinded public key.
+// The sender of a packet with ephemeral private key x computes the
+// secret of hop i as
+//
+//	s_i = Blind(b_{i-1}, ... Blind(b_0, DeriveSecret(x, y_i)))
+//
+// where y_i is the public key of the hop and b_j is the blinding
+// factor derived from s_j, and hop i, which receives
+//
+//	alpha_i = Blind(b_{i-1}, ... Blind(b_0, x·E_0))
+//
+// computes the same s_i as DeriveSecret(y_i, alpha_i). This costs the
+// sender a number of group actions quadratic in the path length.
+//
+// Any nike.Scheme whose Blind commutes with DeriveSecret can be used,
+// which the CTIDH and X25519 schemes of this module do and the hybrid
+// schemes do not.
+//
+// Headers are encrypted with ChaCha20 and authenticated with keyed
+// BLAKE2b-256, and payloads are encrypted with the LIONESS wide block
+// cipher so that a modified payload decrypts to garbage at the last
+// hop. Each hop also derives a replay tag from its shared secret,
+// which mix nodes must remember for as long as their key is in use
+// to drop replayed packets.
+package sphinx
+
+import (
+	"crypto/hmac"
+	"errors"
+	"io"
+
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/chacha20"
+	"golang.org/x/crypto/sha3"
+
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+)
+
+// Sizes of the fixed fields of packets.
+const (
+	// MACSize is the size in bytes of the header MACs.
+	MACSize = 32
+
+	// ReplayTagSize is the size in bytes of replay tags.
+	ReplayTagSize = 32
+
+	// PayloadTagSize is the size in bytes of the zero bytes which
+	// authenticate the payload at the last hop.
+	PayloadTagSize = 16
+)
+
+var (
+	// ErrGeometry indicates an invalid geometry.
+	ErrGeometry = errors.New("sphinx: invalid geometry")
+
+	// ErrPathLength indicates a path which is empty or longer than
+	// the maximum number of hops.
+	ErrPathLength = errors.New("sphinx: invalid path length")
+
+	// ErrPayloadSize indicates a payload of the wrong size.
+	ErrPayloadSize = errors.New("sphinx: invalid payload size")
+
+	// ErrPacketSize indicates a packet, SURB or SURB decryption
+	// token of the wrong size.
+	ErrPacketSize = errors.New("sphinx: invalid packet size")
+
+	// ErrCommands indicates routing commands which are invalid or
+	// do not fit the routing information.
+	ErrCommands = errors.New("sphinx: invalid routing commands")
+
+	// ErrMAC indicates a header which failed to authenticate.
+	ErrMAC = errors.New("sphinx: header authentication failed")
+
+	// ErrPayload indicates a payload which failed to authenticate.
+	ErrPayload = errors.New("sphinx: payload authentication failed")
+)
+
+// Geometry is the layout of the packets.
+type Geometry struct {
+	// NrHops is the maximum number of hops of a path.
+	NrHops int
+
+	// PayloadSize is the size in bytes of the payloads.
+	PayloadSize int
+
+	// RoutingInfoSize is the size in bytes of the encoded routing
+	// commands of each hop.
+	RoutingInfoSize int
+}
+
+// NewGeometry returns a geometry with the DefaultRoutingInfoSize.
+func NewGeometry(nrHops, payloadSize int) *Geometry {
+	return &Geometry{
+		NrHops:          nrHops,
+		PayloadSize:     payloadSize,
+		RoutingInfoSize: DefaultRoutingInfoSize,
+	}
+}
+
+// Validate checks that the geometry can be used.
+func (g *Geometry) Validate() error {
+	if g.NrHops < 1 || g.PayloadSize < 32 || g.RoutingInfoSize < nextNodeHopLength {
+		return ErrGeometry
+	}
+	return nil
+}
+
+// perHopSize returns the size of the routing information and the MAC
+// of a hop.
+func (g *Geometry) perHopSize() int {
+	return g.RoutingInfoSize + MACSize
+}
+
+// betaSize returns the size of the encrypted routing information.
+func (g *Geometry) betaSize() int {
+	return g.NrHops * g.perHopSize()
+}
+
+// Sphinx creates and unwraps packets of a geometry.
+type Sphinx struct {
+	scheme   nike.Scheme
+	geometry Geometry
+}
+
+// New returns a Sphinx for the packets of geometry with the group of
+// scheme.
+func New(scheme nike.Scheme, geometry *Geometry) (*Sphinx, error) {
+	if err := geometry.Validate(); err != nil {
+		return nil, err
+	}
+	return &Sphinx{scheme: scheme, geometry: *geometry}, nil
+}
+
+// Geometry returns the geometry of the packets.
+func (s *Sphinx) Geometry() Geometry {
+	return s.geometry
+}
+
+// HeaderSize returns the size in bytes of packet headers.
+func (s *Sphinx) HeaderSize() int {
+	return s.scheme.PublicKeySize() + s.geometry.betaSize() + MACSize
+}
+
+// PacketSize returns the size in bytes of packets.
+func (s *Sphinx) PacketSize() int {
+	return s.HeaderSize() + PayloadTagSize + s.geometry.PayloadSize
+}
+
+// PathHop is a hop of the path of a packet.
+type PathHop struct {
+	// ID identifies the node to the previous hop.
+	ID [NodeIDSize]byte
+
+	// PublicKey is the public key of the node.
+	PublicKey nike.PublicKey
+
+	// Commands are the routing commands for the node, apart from
+	// NextNodeHop.
+	Commands []Command
+}
+
+// hopKeys are the keys derived from the secret shared with a hop.
+type hopKeys struct {
+	headerMAC        [32]byte
+	headerEncryption [32]byte
+	payload          [lionessKeySize]byte
+	replayTag        [ReplayTagSize]byte
+	blindingFactor   []byte
+}
+
+func (s *Sphinx) deriveHopKeys(sharedSecret []byte) *hopKeys {
+	xof := sha3.NewShake256()
+	xof.Write([]byte(s.scheme.Name() + " Sphinx hop keys\x00"))
+	xof.Write(sharedSecret)
+	k := &hopKeys{blindingFactor: make([]byte, s.scheme.BlindingFactorSize())}
+	xof.Read(k.headerMAC[:])
+	xof.Read(k.headerEncryption[:])
+	xof.Read(k.payload[:])
+	xof.Read(k.replayTag[:])
+	xof.Read(k.blindingFactor)
+	return k
+}
+
+func (k *hopKeys) mac(beta []byte) []byte {
+	h, err := blake2b.New256(k.headerMAC[:])
+	if err != nil {
+		panic(err)
+	}
+	h.Write(beta)
+	return h.Sum(nil)
+}
+
+// xorStream XORs b with the header keystream of the hop.
+func (k *hopKeys) xorStream(b []byte) {
+	c, err := chacha20.NewUnauthenticatedCipher(k.headerEncryption[:], make([]byte, chacha20.NonceSize))
+	if err != nil {
+		panic(err)
+	}
+	c.XORKeyStream(b, b)
+}
+
+// createHeader returns a header for path, and the keys shared with its
+// hops.
+func (s *Sphinx) createHeader(rng io.Reader, path []*PathHop) ([]byte, []*hopKeys, error) {
+	n := len(path)
+	if n < 1 || n > s.geometry.NrHops {
+		return nil, nil, ErrPathLength
+	}
+
+	routingInfo := make([][]byte, n)
+	for i, hop := range path {
+		cmds := hop.Commands
+		if i < n-1 {
+			cmds = append([]Command{&NextNodeHop{ID: path[i+1].ID}}, cmds...)
+		}
+		for _, cmd := range hop.Commands {
+			if _, ok := cmd.(*NextNodeHop); ok {
+				return nil, nil, ErrCommands
+			}
+		}
+		var err error
+		routingInfo[i], err = encodeCommands(cmds, s.geometry.RoutingInfoSize)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	privateKey, publicKey, err := s.scheme.GenerateKeyPair(rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer privateKey.Reset()
+	keys := make([]*hopKeys, n)
+	for i, hop := range path {
+		sharedSecret, err := s.scheme.DeriveSecret(privateKey, hop.PublicKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		for j := 0; j < i; j++ {
+			sharedSecret, err = s.blind(keys[j].blindingFactor, sharedSecret)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		keys[i] = s.deriveHopKeys(sharedSecret)
+	}
+
+	// The filler is the tail of the routing information which the
+	// hops before the last shift in, so that the MAC of each hop
+	// covers what it will receive.
+	perHop := s.geometry.perHopSize()
+	betaSize := s.geometry.betaSize()
+	var filler []byte
+	for i := 0; i < n-1; i++ {
+		filler = append(filler, make([]byte, perHop)...)
+		stream := make([]byte, betaSize+perHop)
+		keys[i].xorStream(stream)
+		stream = stream[len(stream)-len(filler):]
+		for j := range filler {
+			filler[j] ^= stream[j]
+		}
+	}
+
+	beta := make([]byte, betaSize)
+	mac := make([]byte, MACSize)
+	for i := n - 1; i >= 0; i-- {
+		if i == n-1 {
+			copy(beta, routingInfo[i])
+			keys[i].xorStream(beta[:betaSize-len(filler)])
+			copy(beta[betaSize-len(filler):], filler)
+		} else {
+			copy(beta[perHop:], beta[:betaSize-perHop])
+			copy(beta, routingInfo[i])
+			copy(beta[s.geometry.RoutingInfoSize:], mac)
+			keys[i].xorStream(beta)
+		}
+		mac = keys[i].mac(beta)
+	}
+
+	header := make([]byte, 0, s.HeaderSize())
+	header = append(header, publicKey.Bytes()...)
+	header = append(header, beta...)
+	header = append(header, mac...)
+	return header, keys, nil
+}
+
+// blind returns the encoding of the public key encoded by publicKey
+// blinded by blindingFactor.
+func (s *Sphinx) blind(blindingFactor, publicKey []byte) ([]byte, error) {
+	pubKey, err := s.scheme.UnmarshalBinaryPublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	blinded, err := s.scheme.Blind(blindingFactor, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return blinded.Bytes(), nil
+}
+
+// NewPacket returns a packet carrying payload, which must be
+// PayloadSize bytes, along path. The path must not be longer than
+// NrHops, and the commands of each hop must fit RoutingInfoSize along
+// with a NextNodeHop for every hop but the last. The packet is sent to
+// the node of the first hop.
+func (s *Sphinx) NewPacket(rng io.Reader, path []*PathHop, payload []byte) ([]byte, error) {
+	if len(payload) != s.geometry.PayloadSize {
+		return nil, ErrPayloadSize
+	}
+	header, keys, err := s.createHeader(rng, path)
+	if err != nil {
+		return nil, err
+	}
+
+	packet := make([]byte, s.PacketSize())
+	copy(packet, header)
+	body := packet[len(header):]
+	copy(body[PayloadTagSize:], payload)
+	for i := len(keys) - 1; i >= 0; i-- {
+		newLioness(keys[i].payload[:]).encrypt(body)
+	}
+	return packet, nil
+}
+
+// Unwrap processes packet with the private key of a node, and returns
+// the routing commands for the node and the replay tag of the packet.
+// A node must drop packets whose replay tag it has seen before.
+//
+// If the commands contain a NextNodeHop, packet is transformed in
+// place into the packet for that node. Otherwise the node is the last
+// hop: for a packet created by NewPacket the payload is returned, and
+// for a reply to a SURB, marked by a SURBReply command, the encrypted
+// payload is returned for DecryptSURBPayload.
+func (s *Sphinx) Unwrap(privateKey nike.PrivateKey, packet []byte) (payload, replayTag []byte, cmds []Command, err error) {
+	if len(packet) != s.PacketSize() {
+		return nil, nil, nil, ErrPacketSize
+	}
+	alphaSize := s.scheme.PublicKeySize()
+	betaSize := s.geometry.betaSize()
+	alpha := packet[:alphaSize]
+	beta := packet[alphaSize : alphaSize+betaSize]
+	mac := packet[alphaSize+betaSize : s.HeaderSize()]
+	body := packet[s.HeaderSize():]
+
+	alphaKey, err := s.scheme.UnmarshalBinaryPublicKey(alpha)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	sharedSecret, err := s.scheme.DeriveSecret(privateKey, alphaKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	keys := s.deriveHopKeys(sharedSecret)
+	if !hmac.Equal(mac, keys.mac(beta)) {
+		return nil, nil, nil, ErrMAC
+	}
+
+	perHop := s.geometry.perHopSize()
+	routingInfo := make([]byte, betaSize+perHop)
+	copy(routingInfo, beta)
+	keys.xorStream(routingInfo)
+	cmds, err = parseCommands(routingInfo[:s.geometry.RoutingInfoSize])
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var next *NextNodeHop
+	var surbReply bool
+	for _, cmd := range cmds {
+		switch cmd := cmd.(type) {
+		case *NextNodeHop:
+			next = cmd
+		case *SURBReply:
+			surbReply = true
+		}
+	}
+
+	newLioness(keys.payload[:]).decrypt(body)
+	replayTag = keys.replayTag[:]
+	switch {
+	case next != nil:
+		blinded, err := s.scheme.Blind(keys.blindingFactor, alphaKey)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		copy(alpha, blinded.Bytes())
+		copy(beta, routingInfo[perHop:])
+		copy(mac, routingInfo[s.geometry.RoutingInfoSize:perHop])
+		return nil, replayTag, cmds, nil
+	case surbReply:
+		return append([]byte{}, body...), replayTag, cmds, nil
+	}
+
+	if !hmac.Equal(body[:PayloadTagSize], make([]byte, PayloadTagSize)) {
+		return nil, nil, nil, ErrPayload
+	}
+	return append([]byte{}, body[PayloadTagSize:]...), replayTag, cmds, nil
+}
@@ -0,0 +1,276 @@
+package sphinx
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"git.xx.network/elixxir/ctidh_cgo/ctidh511"
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+	"git.xx.network/elixxir/ctidh_cgo/nike/x25519"
+)
+
+// mixNode is an in-memory mix node which drops replayed packets.
+type mixNode struct {
+	id         [NodeIDSize]byte
+	privateKey nike.PrivateKey
+	publicKey  nike.PublicKey
+	replayTags map[string]bool
+}
+
+// mixNetwork is an in-memory chain of mix nodes.
+type mixNetwork struct {
+	sphinx *Sphinx
+	nodes  map[[NodeIDSize]byte]*mixNode
+}
+
+func newMixNetwork(t *testing.T, scheme nike.Scheme, geometry *Geometry, nrNodes int) *mixNetwork {
+	s, err := New(scheme, geometry)
+	require.NoError(t, err)
+	network := &mixNetwork{sphinx: s, nodes: make(map[[NodeIDSize]byte]*mixNode)}
+	for i := 0; i < nrNodes; i++ {
+		privateKey, publicKey, err := scheme.GenerateKeyPair(rand.Reader)
+		require.NoError(t, err)
+		node := &mixNode{
+			privateKey: privateKey,
+			publicKey:  publicKey,
+			replayTags: make(map[string]bool),
+		}
+		node.id[0] = byte(i)
+		network.nodes[node.id] = node
+	}
+	return network
+}
+
+// path returns a path through the nodes with the given IDs, with cmds
+// for the last hop.
+func (n *mixNetwork) path(ids []byte, cmds ...Command) []*PathHop {
+	path := make([]*PathHop, len(ids))
+	for i, id := range ids {
+		node := n.nodes[[NodeIDSize]byte{id}]
+		path[i] = &PathHop{ID: node.id, PublicKey: node.publicKey}
+	}
+	path[len(path)-1].Commands = cmds
+	return path
+}
+
+// deliver sends packet through the network starting at firstHop, and
+// returns what the last hop outputs.
+func (n *mixNetwork) deliver(t *testing.T, firstHop [NodeIDSize]byte, packet []byte) ([]byte, []Command, error) {
+	hop := firstHop
+	for {
+		node, ok := n.nodes[hop]
+		require.True(t, ok)
+		payload, replayTag, cmds, err := n.sphinx.Unwrap(node.privateKey, packet)
+		if err != nil {
+			return nil, nil, err
+		}
+		require.Len(t, replayTag, ReplayTagSize)
+		require.False(t, node.replayTags[string(replayTag)])
+		node.replayTags[string(replayTag)] = true
+
+		next, ok := cmds[0].(*NextNodeHop)
+		if !ok {
+			return payload, cmds, nil
+		}
+		require.Nil(t, payload)
+		hop = next.ID
+	}
+}
+
+func newTestPayload(t *testing.T, size int) []byte {
+	payload := make([]byte, size)
+	_, err := rand.Read(payload)
+	require.NoError(t, err)
+	return payload
+}
+
+func TestForwardPacket(t *testing.T) {
+	for _, test := range []struct {
+		scheme nike.Scheme
+		path   []byte
+	}{
+		{ctidh511.Scheme(), []byte{0, 1, 2}},
+		{x25519.Scheme(), []byte{0}},
+		{x25519.Scheme(), []byte{4, 2}},
+		{x25519.Scheme(), []byte{0, 1, 2, 3, 4}},
+	} {
+		t.Run(test.scheme.Name(), func(t *testing.T) {
+			network := newMixNetwork(t, test.scheme, NewGeometry(5, 512), 5)
+			recipient := &Recipient{ID: [RecipientIDSize]byte{'b', 'o', 'b'}}
+			delay := &NodeDelay{Delay: 1234}
+			path := network.path(test.path, recipient, delay)
+			payload := newTestPayload(t, 512)
+
+			packet, err := network.sphinx.NewPacket(rand.Reader, path, payload)
+			require.NoError(t, err)
+			require.Len(t, packet, network.sphinx.PacketSize())
+
+			got, cmds, err := network.deliver(t, path[0].ID, packet)
+			require.NoError(t, err)
+			require.Equal(t, payload, got)
+			require.Equal(t, []Command{recipient, delay}, cmds)
+		})
+	}
+}
+
+func TestSURB(t *testing.T) {
+	for _, scheme := range []nike.Scheme{ctidh511.Scheme(), x25519.Scheme()} {
+		t.Run(scheme.Name(), func(t *testing.T) {
+			network := newMixNetwork(t, scheme, NewGeometry(3, 256), 3)
+			surbReply := &SURBReply{ID: [SURBIDSize]byte{'i', 'd'}}
+			path := network.path([]byte{2, 0, 1}, surbReply)
+
+			surb, decryptionToken, err := network.sphinx.NewSURB(rand.Reader, path)
+			require.NoError(t, err)
+			require.Len(t, surb, network.sphinx.SURBSize())
+
+			reply := newTestPayload(t, 256)
+			packet, firstHop, err := network.sphinx.NewPacketFromSURB(surb, reply)
+			require.NoError(t, err)
+			require.Equal(t, path[0].ID, *firstHop)
+
+			encrypted, cmds, err := network.deliver(t, *firstHop, packet)
+			require.NoError(t, err)
+			require.Equal(t, []Command{surbReply}, cmds)
+			require.NotEqual(t, reply, encrypted[PayloadTagSize:])
+
+			got, err := network.sphinx.DecryptSURBPayload(encrypted, decryptionToken)
+			require.NoError(t, err)
+			require.Equal(t, reply, got)
+
+			// A modified reply fails to authenticate.
+			encrypted[len(encrypted)-1] ^= 1
+			_, err = network.sphinx.DecryptSURBPayload(encrypted, decryptionToken)
+			require.ErrorIs(t, err, ErrPayload)
+		})
+	}
+}
+
+func TestReplayTag(t *testing.T) {
+	network := newMixNetwork(t, x25519.Scheme(), NewGeometry(3, 64), 3)
+	path := network.path([]byte{0, 1, 2})
+	packet, err := network.sphinx.NewPacket(rand.Reader, path, make([]byte, 64))
+	require.NoError(t, err)
+
+	node := network.nodes[path[0].ID]
+	replayed := append([]byte{}, packet...)
+	_, replayTag1, _, err := network.sphinx.Unwrap(node.privateKey, packet)
+	require.NoError(t, err)
+	_, replayTag2, _, err := network.sphinx.Unwrap(node.privateKey, replayed)
+	require.NoError(t, err)
+	require.Equal(t, replayTag1, replayTag2)
+
+	// The next hop sees a different tag.
+	_, replayTag3, _, err := network.sphinx.Unwrap(network.nodes[path[1].ID].privateKey, packet)
+	require.NoError(t, err)
+	require.NotEqual(t, replayTag1, replayTag3)
+}
+
+func TestTampering(t *testing.T) {
+	network := newMixNetwork(t, ctidh511.Scheme(), NewGeometry(2, 64), 2)
+	path := network.path([]byte{0, 1})
+	packet, err := network.sphinx.NewPacket(rand.Reader, path, make([]byte, 64))
+	require.NoError(t, err)
+	alphaSize := ctidh511.PublicKeySize
+
+	// Modified routing information or MACs fail the MAC check.
+	for _, i := range []int{alphaSize, network.sphinx.HeaderSize() - 1} {
+		tampered := append([]byte{}, packet...)
+		tampered[i] ^= 1
+		_, _, err = network.deliver(t, path[0].ID, tampered)
+		require.ErrorIs(t, err, ErrMAC)
+	}
+
+	// A modified group element either fails validation or derives
+	// a different secret.
+	tampered := append([]byte{}, packet...)
+	tampered[0] ^= 1
+	_, _, err = network.deliver(t, path[0].ID, tampered)
+	require.Error(t, err)
+
+	// A modified payload is only detected by the last hop.
+	tampered = append([]byte{}, packet...)
+	tampered[len(tampered)-1] ^= 1
+	_, _, err = network.deliver(t, path[0].ID, tampered)
+	require.ErrorIs(t, err, ErrPayload)
+
+	// A packet sent to the wrong node fails the MAC check.
+	_, _, _, err = network.sphinx.Unwrap(network.nodes[path[1].ID].privateKey, packet)
+	require.ErrorIs(t, err, ErrMAC)
+
+	_, _, _, err = network.sphinx.Unwrap(network.nodes[path[0].ID].privateKey, packet[1:])
+	require.ErrorIs(t, err, ErrPacketSize)
+}
+
+func TestInvalidInputs(t *testing.T) {
+	_, err := New(x25519.Scheme(), NewGeometry(0, 64))
+	require.ErrorIs(t, err, ErrGeometry)
+	_, err = New(x25519.Scheme(), NewGeometry(3, 31))
+	require.ErrorIs(t, err, ErrGeometry)
+	_, err = New(x25519.Scheme(), &Geometry{NrHops: 3, PayloadSize: 64, RoutingInfoSize: 8})
+	require.ErrorIs(t, err, ErrGeometry)
+
+	network := newMixNetwork(t, x25519.Scheme(), NewGeometry(2, 64), 3)
+	_, err = network.sphinx.NewPacket(rand.Reader, network.path([]byte{0, 1, 2}), make([]byte, 64))
+	require.ErrorIs(t, err, ErrPathLength)
+	_, err = network.sphinx.NewPacket(rand.Reader, nil, make([]byte, 64))
+	require.ErrorIs(t, err, ErrPathLength)
+	_, err = network.sphinx.NewPacket(rand.Reader, network.path([]byte{0, 1}), make([]byte, 63))
+	require.ErrorIs(t, err, ErrPayloadSize)
+
+	path := network.path([]byte{0, 1}, &NextNodeHop{})
+	_, err = network.sphinx.NewPacket(rand.Reader, path, make([]byte, 64))
+	require.ErrorIs(t, err, ErrCommands)
+	path = network.path([]byte{0, 1}, &Recipient{}, &Recipient{})
+	_, err = network.sphinx.NewPacket(rand.Reader, path, make([]byte, 64))
+	require.ErrorIs(t, err, ErrCommands)
+
+	_, _, err = network.sphinx.NewPacketFromSURB(make([]byte, 10), make([]byte, 64))
+	require.ErrorIs(t, err, ErrPacketSize)
+	_, err = network.sphinx.DecryptSURBPayload(make([]byte, PayloadTagSize+64), make([]byte, lionessKeySize))
+	require.ErrorIs(t, err, ErrPacketSize)
+}
+
+func TestCommands(t *testing.T) {
+	cmds := []Command{
+		&NextNodeHop{ID: [NodeIDSize]byte{1, 2, 3}},
+		&NodeDelay{Delay: 0xdeadbeef},
+		&Recipient{ID: [RecipientIDSize]byte{4, 5}},
+		&SURBReply{ID: [SURBIDSize]byte{6}},
+	}
+	size := nextNodeHopLength + nodeDelayLength + recipientLength + surbReplyLength
+	encoded, err := encodeCommands(cmds, size+7)
+	require.NoError(t, err)
+	require.Len(t, encoded, size+7)
+	parsed, err := parseCommands(encoded)
+	require.NoError(t, err)
+	require.Equal(t, cmds, parsed)
+
+	_, err = encodeCommands(cmds, size-1)
+	require.ErrorIs(t, err, ErrCommands)
+	_, err = parseCommands(encoded[:size-1])
+	require.ErrorIs(t, err, ErrCommands)
+	_, err = parseCommands([]byte{0x42})
+	require.ErrorIs(t, err, ErrCommands)
+}
+
+func TestLioness(t *testing.T) {
+	key := newTestPayload(t, lionessKeySize)
+	block := newTestPayload(t, 100)
+	encrypted := append([]byte{}, block...)
+	newLioness(key).encrypt(encrypted)
+	require.NotEqual(t, block, encrypted)
+
+	// Every output byte depends on every input byte.
+	modified := append([]byte{}, block...)
+	modified[len(modified)-1] ^= 1
+	newLioness(key).encrypt(modified)
+	require.NotEqual(t, encrypted[:32], modified[:32])
+	require.NotEqual(t, encrypted[32:], modified[32:])
+
+	newLioness(key).decrypt(encrypted)
+	require.True(t, bytes.Equal(block, encrypted))
+}
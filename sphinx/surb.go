@@ -0,0 +1,84 @@
+package sphinx
+
+import (
+	"crypto/hmac"
+	"io"
+)
+
+// SURBSize returns the size in bytes of a SURB: a header, the ID of
+// the first hop and the key with which the replier encrypts the
+// payload.
+func (s *Sphinx) SURBSize() int {
+	return s.HeaderSize() + NodeIDSize + lionessKeySize
+}
+
+// NewSURB returns a single use reply block for replies along path,
+// which should end at the creator of the SURB and whose last hop
+// should carry a SURBReply command so that the reply can be matched
+// to the returned decryption token. The SURB is given to the replier,
+// and the token is kept for DecryptSURBPayload.
+func (s *Sphinx) NewSURB(rng io.Reader, path []*PathHop) (surb, decryptionToken []byte, err error) {
+	header, keys, err := s.createHeader(rng, path)
+	if err != nil {
+		return nil, nil, err
+	}
+	var key [lionessKeySize]byte
+	if _, err := io.ReadFull(rng, key[:]); err != nil {
+		return nil, nil, err
+	}
+
+	surb = make([]byte, 0, s.SURBSize())
+	surb = append(surb, header...)
+	surb = append(surb, path[0].ID[:]...)
+	surb = append(surb, key[:]...)
+
+	decryptionToken = make([]byte, 0, (len(keys)+1)*lionessKeySize)
+	decryptionToken = append(decryptionToken, key[:]...)
+	for _, k := range keys {
+		decryptionToken = append(decryptionToken, k.payload[:]...)
+	}
+	return surb, decryptionToken, nil
+}
+
+// NewPacketFromSURB returns a reply packet carrying payload, which
+// must be PayloadSize bytes, and the ID of the node to send it to.
+func (s *Sphinx) NewPacketFromSURB(surb, payload []byte) (packet []byte, firstHop *[NodeIDSize]byte, err error) {
+	if len(surb) != s.SURBSize() {
+		return nil, nil, ErrPacketSize
+	}
+	if len(payload) != s.geometry.PayloadSize {
+		return nil, nil, ErrPayloadSize
+	}
+	headerSize := s.HeaderSize()
+	firstHop = new([NodeIDSize]byte)
+	copy(firstHop[:], surb[headerSize:])
+
+	packet = make([]byte, s.PacketSize())
+	copy(packet, surb[:headerSize])
+	body := packet[headerSize:]
+	copy(body[PayloadTagSize:], payload)
+	newLioness(surb[headerSize+NodeIDSize:]).encrypt(body)
+	return packet, firstHop, nil
+}
+
+// DecryptSURBPayload decrypts the payload of a reply returned by
+// Unwrap at the last hop with the decryption token of its SURB.
+func (s *Sphinx) DecryptSURBPayload(payload, decryptionToken []byte) ([]byte, error) {
+	if len(payload) != PayloadTagSize+s.geometry.PayloadSize {
+		return nil, ErrPayloadSize
+	}
+	n := len(decryptionToken)/lionessKeySize - 1
+	if len(decryptionToken)%lionessKeySize != 0 || n < 1 || n > s.geometry.NrHops {
+		return nil, ErrPacketSize
+	}
+
+	body := append([]byte{}, payload...)
+	for i := n; i >= 1; i-- {
+		newLioness(decryptionToken[i*lionessKeySize : (i+1)*lionessKeySize]).encrypt(body)
+	}
+	newLioness(decryptionToken[:lionessKeySize]).decrypt(body)
+	if !hmac.Equal(body[:PayloadTagSize], make([]byte, PayloadTagSize)) {
+		return nil, ErrPayload
+	}
+	return body[PayloadTagSize:], nil
+}
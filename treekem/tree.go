@@ -0,0 +1,288 @@
+package treekem
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/bits"
+
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+)
+
+// The tree is a full binary tree stored in an array, as in MLS: leaf
+// i is node 2i, and a node at level k, whose index ends in k one bits,
+// has the children at levels k-1 to its left and right. The number of
+// leaves is always a power of two.
+
+// level returns the level of node x, leaves being at level 0.
+func level(x int) int {
+	return bits.TrailingZeros(^uint(x))
+}
+
+func left(x int) int {
+	return x ^ (1 << (level(x) - 1))
+}
+
+func right(x int) int {
+	return x ^ (3 << (level(x) - 1))
+}
+
+func parent(x int) int {
+	k := level(x)
+	b := (x >> (k + 1)) & 1
+	return (x | 1<<k) ^ b<<(k+1)
+}
+
+func sibling(x int) int {
+	p := parent(x)
+	if x < p {
+		return right(p)
+	}
+	return left(p)
+}
+
+// inSubtree reports whether node y is node x or below it.
+func inSubtree(x, y int) bool {
+	span := 1<<level(x) - 1
+	return x-span <= y && y <= x+span
+}
+
+// node is a non-blank node of the tree.
+type node struct {
+	publicKey nike.PublicKey
+
+	// identity is the member's identity, at leaves.
+	identity []byte
+
+	// unmerged holds the leaves added below a parent since its key
+	// was last set, which do not know its private key.
+	unmerged []int
+}
+
+// tree is the public state of the tree, with nil for blank nodes.
+type tree struct {
+	nodes []*node
+}
+
+func newTree(leaves int) *tree {
+	return &tree{nodes: make([]*node, 2*leaves-1)}
+}
+
+func (t *tree) leaves() int {
+	return (len(t.nodes) + 1) / 2
+}
+
+func (t *tree) root() int {
+	return t.leaves() - 1
+}
+
+// directPath returns the ancestors of node x, from its parent to the
+// root.
+func (t *tree) directPath(x int) []int {
+	var path []int
+	for x != t.root() {
+		x = parent(x)
+		path = append(path, x)
+	}
+	return path
+}
+
+// resolution returns the non-blank nodes which together cover the
+// leaves below x: x itself and its unmerged leaves if it is not
+// blank, or else the resolutions of its children.
+func (t *tree) resolution(x int) []int {
+	if n := t.nodes[x]; n != nil {
+		out := []int{x}
+		for _, leaf := range n.unmerged {
+			out = append(out, 2*leaf)
+		}
+		return out
+	}
+	if level(x) == 0 {
+		return nil
+	}
+	return append(t.resolution(left(x)), t.resolution(right(x))...)
+}
+
+// clone returns a copy of the tree which shares no mutable state.
+func (t *tree) clone() *tree {
+	c := &tree{nodes: make([]*node, len(t.nodes))}
+	for i, n := range t.nodes {
+		if n != nil {
+			c.nodes[i] = &node{
+				publicKey: n.publicKey,
+				identity:  n.identity,
+				unmerged:  append([]int(nil), n.unmerged...),
+			}
+		}
+	}
+	return c
+}
+
+// blankPath blanks the direct path of node x.
+func (t *tree) blankPath(x int) {
+	for _, p := range t.directPath(x) {
+		t.nodes[p] = nil
+	}
+}
+
+// addLeaf puts a member at the leftmost blank leaf, doubling the tree
+// if there is none, and returns its leaf index.
+func (t *tree) addLeaf(n *node) int {
+	leaf := 0
+	for leaf < t.leaves() && t.nodes[2*leaf] != nil {
+		leaf++
+	}
+	if leaf == t.leaves() {
+		t.nodes = append(t.nodes, make([]*node, len(t.nodes)+1)...)
+	}
+	t.nodes[2*leaf] = n
+	for _, p := range t.directPath(2 * leaf) {
+		if t.nodes[p] != nil {
+			t.nodes[p].unmerged = append(t.nodes[p].unmerged, leaf)
+		}
+	}
+	return leaf
+}
+
+// truncate halves the tree while its right half is blank.
+func (t *tree) truncate() {
+	for t.leaves() > 1 {
+		half := t.leaves() / 2
+		for leaf := half; leaf < t.leaves(); leaf++ {
+			if t.nodes[2*leaf] != nil {
+				return
+			}
+		}
+		t.nodes = t.nodes[:2*half-1]
+	}
+}
+
+// appendTree appends the tree: the number of leaves in four bytes,
+// and for every node a byte which is 1 if it is not blank, followed
+// then by its public key and either the identity of a leaf, with its
+// length in two bytes, or the unmerged leaves of a parent, with their
+// number in four bytes.
+func appendTree(b []byte, t *tree) []byte {
+	b = binary.BigEndian.AppendUint32(b, uint32(t.leaves()))
+	for x, n := range t.nodes {
+		if n == nil {
+			b = append(b, 0)
+			continue
+		}
+		b = append(b, 1)
+		b = append(b, n.publicKey.Bytes()...)
+		if level(x) == 0 {
+			b = binary.BigEndian.AppendUint16(b, uint16(len(n.identity)))
+			b = append(b, n.identity...)
+			continue
+		}
+		b = binary.BigEndian.AppendUint32(b, uint32(len(n.unmerged)))
+		for _, leaf := range n.unmerged {
+			b = binary.BigEndian.AppendUint32(b, uint32(leaf))
+		}
+	}
+	return b
+}
+
+// hash returns the hash of the tree, which binds the epoch secret to
+// the members and their keys.
+func (t *tree) hash() []byte {
+	h := sha256.Sum256(appendTree(nil, t))
+	return h[:]
+}
+
+// parseTree parses a tree appended by appendTree.
+func parseTree(scheme nike.Scheme, r *reader) (*tree, error) {
+	leaves := r.uint32()
+	if r.err != nil || leaves == 0 || leaves > MaxMembers || leaves&(leaves-1) != 0 {
+		return nil, ErrMessage
+	}
+	t := newTree(int(leaves))
+	for x := range t.nodes {
+		switch r.byte() {
+		case 0:
+			continue
+		case 1:
+		default:
+			return nil, ErrMessage
+		}
+		n := &node{publicKey: r.publicKey(scheme)}
+		if level(x) == 0 {
+			n.identity = r.bytes(int(r.uint16()))
+		} else {
+			count := r.uint32()
+			if count > leaves {
+				return nil, ErrMessage
+			}
+			for i := uint32(0); i < count; i++ {
+				leaf := r.uint32()
+				if leaf >= leaves || !inSubtree(x, 2*int(leaf)) {
+					return nil, ErrMessage
+				}
+				n.unmerged = append(n.unmerged, int(leaf))
+			}
+		}
+		if r.err != nil {
+			return nil, r.err
+		}
+		t.nodes[x] = n
+	}
+	return t, r.err
+}
+
+// reader reads the fields of a message, remembering the first error.
+type reader struct {
+	b   []byte
+	err error
+}
+
+func (r *reader) bytes(n int) []byte {
+	if r.err != nil || len(r.b) < n {
+		r.err = ErrMessage
+		return nil
+	}
+	out := r.b[:n]
+	r.b = r.b[n:]
+	return out
+}
+
+func (r *reader) byte() byte {
+	if b := r.bytes(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (r *reader) uint16() uint16 {
+	if b := r.bytes(2); b != nil {
+		return binary.BigEndian.Uint16(b)
+	}
+	return 0
+}
+
+func (r *reader) uint32() uint32 {
+	if b := r.bytes(4); b != nil {
+		return binary.BigEndian.Uint32(b)
+	}
+	return 0
+}
+
+func (r *reader) uint64() uint64 {
+	if b := r.bytes(8); b != nil {
+		return binary.BigEndian.Uint64(b)
+	}
+	return 0
+}
+
+func (r *reader) publicKey(scheme nike.Scheme) nike.PublicKey {
+	b := r.bytes(scheme.PublicKeySize())
+	if b == nil {
+		return nil
+	}
+	k, err := scheme.UnmarshalBinaryPublicKey(b)
+	if err != nil {
+		r.err = err
+		return nil
+	}
+	return k
+}
@@ -0,0 +1,821 @@
+// Package treekem implements group key agreement with a TreeKEM
+// ratchet tree, in the manner of MLS, whose node keys are key pairs
+// of a NIKE such as CTIDH.
+//
+// The members sit at the leaves of a binary tree, and every member
+// knows the private keys of the nodes above its leaf. Each epoch of
+// the group has a secret which all of the members share. A member
+// moves the group to the next epoch with a commit, which applies
+// proposals to add, remove or update members, and refreshes the
+// committer's own leaf and every node above it. The committer draws a
+// fresh leaf secret and hashes it up the tree into a path secret for
+// each of those nodes, from which the node's key pair is generated,
+// and sends each path secret to the other side of the tree below the
+// node: it is encrypted under DeriveSecret of an ephemeral key of the
+// commit and the public keys covering that side. A member decrypts
+// the one path secret meant for it, hashes it up to the root, and
+// mixes the result into the key schedule. New members receive the
+// epoch secret and their path secret in a welcome.
+//
+// A commit is authenticated by a MAC under a key of its epoch, so only
+// members can commit, but nothing shows which member did: there are
+// no signatures. The application must deliver the commits of an epoch
+// to all of the members in the same order, and all members, the
+// committer too, move to the next epoch with the first commit of it
+// which they process.
+package treekem
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+	"golang.org/x/crypto/sha3"
+
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+)
+
+const (
+	// MaxMembers is the largest number of leaves of the tree.
+	MaxMembers = 1 << 16
+
+	// MaxIdentitySize is the largest size in bytes of a member's
+	// identity.
+	MaxIdentitySize = 1<<16 - 1
+
+	// SecretSize is the size in bytes of the secrets of the tree
+	// and of the epoch secret.
+	SecretSize = 32
+
+	// tagSize is the size of a Poly1305 tag.
+	tagSize = 16
+)
+
+// Message types of the wire format.
+const (
+	messageCommit  = 1
+	messageWelcome = 2
+	messageAdd     = 3
+	messageRemove  = 4
+	messageUpdate  = 5
+)
+
+var (
+	// ErrMessage indicates a malformed message.
+	ErrMessage = errors.New("treekem: malformed message")
+
+	// ErrGroup indicates a commit for another group or epoch.
+	ErrGroup = errors.New("treekem: message for another group or epoch")
+
+	// ErrMAC indicates a message which failed to authenticate.
+	ErrMAC = errors.New("treekem: message authentication failed")
+
+	// ErrProposal indicates an invalid proposal, such as one which
+	// removes the committer.
+	ErrProposal = errors.New("treekem: invalid proposal")
+
+	// ErrRemoved indicates a commit which removes this member.
+	ErrRemoved = errors.New("treekem: removed from the group")
+
+	// ErrPathSecret indicates a path secret which failed to decrypt
+	// or which does not match the public keys of the commit.
+	ErrPathSecret = errors.New("treekem: invalid path secret")
+
+	// ErrNotWelcome indicates a welcome with no entry for the key
+	// package.
+	ErrNotWelcome = errors.New("treekem: welcome is not for this key package")
+)
+
+// Protocol is TreeKEM for a NIKE.
+type Protocol struct {
+	scheme nike.Scheme
+}
+
+// New returns TreeKEM for scheme.
+func New(scheme nike.Scheme) *Protocol {
+	return &Protocol{scheme: scheme}
+}
+
+// expand derives a secret from secret for label.
+func expand(secret []byte, label string, context ...[]byte) []byte {
+	info := append([]byte("treekem "+label), bytes.Join(context, nil)...)
+	out := make([]byte, SecretSize)
+	if _, err := io.ReadFull(hkdf.Expand(sha256.New, secret, info), out); err != nil {
+		panic(err)
+	}
+	return out
+}
+
+// nodeKeyPair generates the key pair of a node from its secret.
+func (p *Protocol) nodeKeyPair(secret []byte) (nike.PrivateKey, nike.PublicKey, error) {
+	xof := sha3.NewShake256()
+	xof.Write([]byte("treekem node " + p.scheme.Name() + "\x00"))
+	xof.Write(secret)
+	return p.scheme.GenerateKeyPair(xof)
+}
+
+// seal encrypts a secret to publicKey with the ephemeral private key
+// of a message, for the recipient node x in context.
+func (p *Protocol) seal(ephemeral nike.PrivateKey, publicKey nike.PublicKey, x int, context, secret []byte) ([]byte, error) {
+	aead, err := p.recipientAEAD(ephemeral, publicKey, x, context)
+	if err != nil {
+		return nil, err
+	}
+	return aead.Seal(nil, make([]byte, chacha20poly1305.NonceSize), secret, nil), nil
+}
+
+// open decrypts a secret sealed by seal.
+func (p *Protocol) open(privateKey nike.PrivateKey, ephemeral nike.PublicKey, x int, context, ciphertext []byte) ([]byte, error) {
+	aead, err := p.recipientAEAD(privateKey, ephemeral, x, context)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := aead.Open(nil, make([]byte, chacha20poly1305.NonceSize), ciphertext, nil)
+	if err != nil {
+		return nil, ErrPathSecret
+	}
+	return secret, nil
+}
+
+func (p *Protocol) recipientAEAD(privateKey nike.PrivateKey, publicKey nike.PublicKey, x int, context []byte) (cipher.AEAD, error) {
+	shared, err := p.scheme.DeriveSecret(privateKey, publicKey)
+	if err != nil {
+		return nil, err
+	}
+	key := expand(shared, "seal "+p.scheme.Name(), context, binary.BigEndian.AppendUint32(nil, uint32(x)))
+	return chacha20poly1305.New(key)
+}
+
+// KeyPackage is what a new member publishes so that it can be added:
+// its identity and the public key of its leaf.
+type KeyPackage struct {
+	Identity  []byte
+	PublicKey nike.PublicKey
+}
+
+// NewKeyPackage returns a key package for identity with a key pair
+// generated from rng, and its private key, which Join needs.
+func (p *Protocol) NewKeyPackage(rng io.Reader, identity []byte) (*KeyPackage, nike.PrivateKey, error) {
+	if len(identity) > MaxIdentitySize {
+		return nil, nil, ErrProposal
+	}
+	privateKey, publicKey, err := p.scheme.GenerateKeyPair(rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &KeyPackage{Identity: identity, PublicKey: publicKey}, privateKey, nil
+}
+
+// Proposal is a change to the members of the group, which takes effect
+// when a member commits it.
+type Proposal struct {
+	// Type is the kind of change.
+	Type ProposalType
+
+	// Leaf is the leaf of the member to remove or update.
+	Leaf int
+
+	// KeyPackage is the member to add, or for an update the new
+	// public key of the leaf.
+	KeyPackage *KeyPackage
+}
+
+// ProposalType is the kind of a proposal.
+type ProposalType byte
+
+const (
+	// ProposalAdd adds a member.
+	ProposalAdd ProposalType = messageAdd
+
+	// ProposalRemove removes a member.
+	ProposalRemove ProposalType = messageRemove
+
+	// ProposalUpdate replaces the key of a member's leaf, to heal
+	// after a compromise of the member.
+	ProposalUpdate ProposalType = messageUpdate
+)
+
+// AddProposal returns a proposal to add the member of a key package.
+func AddProposal(keyPackage *KeyPackage) *Proposal {
+	return &Proposal{Type: ProposalAdd, KeyPackage: keyPackage}
+}
+
+// RemoveProposal returns a proposal to remove the member at leaf.
+func RemoveProposal(leaf int) *Proposal {
+	return &Proposal{Type: ProposalRemove, Leaf: leaf}
+}
+
+// MarshalBinary encodes the proposal: its type, then for an add the
+// identity, with its length in two bytes, and the public key, for a
+// remove the leaf in four bytes, and for an update the leaf and the
+// public key.
+func (p *Proposal) MarshalBinary() ([]byte, error) {
+	return appendProposal(nil, p), nil
+}
+
+func appendProposal(b []byte, p *Proposal) []byte {
+	b = append(b, byte(p.Type))
+	switch p.Type {
+	case ProposalAdd:
+		b = binary.BigEndian.AppendUint16(b, uint16(len(p.KeyPackage.Identity)))
+		b = append(b, p.KeyPackage.Identity...)
+		b = append(b, p.KeyPackage.PublicKey.Bytes()...)
+	case ProposalRemove:
+		b = binary.BigEndian.AppendUint32(b, uint32(p.Leaf))
+	case ProposalUpdate:
+		b = binary.BigEndian.AppendUint32(b, uint32(p.Leaf))
+		b = append(b, p.KeyPackage.PublicKey.Bytes()...)
+	}
+	return b
+}
+
+// UnmarshalProposal decodes a proposal encoded by MarshalBinary.
+func (p *Protocol) UnmarshalProposal(data []byte) (*Proposal, error) {
+	r := &reader{b: data}
+	proposal := p.parseProposal(r)
+	if r.err == nil && len(r.b) != 0 {
+		return nil, ErrMessage
+	}
+	return proposal, r.err
+}
+
+func (p *Protocol) parseProposal(r *reader) *Proposal {
+	proposal := &Proposal{Type: ProposalType(r.byte())}
+	switch proposal.Type {
+	case ProposalAdd:
+		identity := r.bytes(int(r.uint16()))
+		proposal.KeyPackage = &KeyPackage{Identity: identity, PublicKey: r.publicKey(p.scheme)}
+	case ProposalRemove:
+		proposal.Leaf = int(r.uint32())
+	case ProposalUpdate:
+		proposal.Leaf = int(r.uint32())
+		proposal.KeyPackage = &KeyPackage{PublicKey: r.publicKey(p.scheme)}
+	default:
+		if r.err == nil {
+			r.err = ErrMessage
+		}
+	}
+	return proposal
+}
+
+// Member is a member of the group.
+type Member struct {
+	Leaf     int
+	Identity []byte
+}
+
+// state is a member's view of an epoch.
+type state struct {
+	epoch uint64
+	tree  *tree
+	leaf  int
+
+	// privateKeys holds the private keys of the member's leaf and
+	// of the nodes above it which it knows, by node.
+	privateKeys map[int]nike.PrivateKey
+
+	epochSecret   []byte
+	initSecret    []byte
+	membershipKey []byte
+}
+
+// clone returns a copy of the state which shares no mutable state.
+func (s *state) clone() *state {
+	c := *s
+	c.tree = s.tree.clone()
+	c.privateKeys = make(map[int]nike.PrivateKey, len(s.privateKeys))
+	for x, k := range s.privateKeys {
+		c.privateKeys[x] = k
+	}
+	return &c
+}
+
+// setEpochSecret sets the secrets of an epoch.
+func (s *state) setEpochSecret(epochSecret []byte) {
+	s.epochSecret = epochSecret
+	s.initSecret = expand(epochSecret, "init")
+	s.membershipKey = expand(epochSecret, "membership")
+}
+
+// Group is a member's view of a group. It is not safe for concurrent
+// use.
+type Group struct {
+	protocol *Protocol
+	groupID  []byte
+	state    *state
+
+	// pendingUpdates holds the private keys of the member's update
+	// proposals, by public key.
+	pendingUpdates map[string]nike.PrivateKey
+
+	// pendingCommit is the member's own commit of this epoch and
+	// the state it leads to.
+	pendingCommit []byte
+	pendingState  *state
+}
+
+// Create returns a new group with groupID, whose only member is that
+// of keyPackage, with privateKey, at epoch 0 with an epoch secret drawn
+// from rng.
+func (p *Protocol) Create(rng io.Reader, groupID []byte, keyPackage *KeyPackage, privateKey nike.PrivateKey) (*Group, error) {
+	if len(groupID) > MaxIdentitySize || len(keyPackage.Identity) > MaxIdentitySize {
+		return nil, ErrProposal
+	}
+	epochSecret := make([]byte, SecretSize)
+	if _, err := io.ReadFull(rng, epochSecret); err != nil {
+		return nil, err
+	}
+	t := newTree(1)
+	t.nodes[0] = &node{publicKey: keyPackage.PublicKey, identity: keyPackage.Identity}
+	s := &state{tree: t, privateKeys: map[int]nike.PrivateKey{0: privateKey}}
+	s.setEpochSecret(epochSecret)
+	return p.newGroup(groupID, s), nil
+}
+
+func (p *Protocol) newGroup(groupID []byte, s *state) *Group {
+	return &Group{
+		protocol:       p,
+		groupID:        append([]byte(nil), groupID...),
+		state:          s,
+		pendingUpdates: make(map[string]nike.PrivateKey),
+	}
+}
+
+// GroupID returns the identifier of the group.
+func (g *Group) GroupID() []byte {
+	return g.groupID
+}
+
+// Epoch returns the number of the current epoch.
+func (g *Group) Epoch() uint64 {
+	return g.state.epoch
+}
+
+// EpochSecret returns the secret the members share in the current
+// epoch, from which applications derive their keys.
+func (g *Group) EpochSecret() []byte {
+	return g.state.epochSecret
+}
+
+// Leaf returns the leaf of this member.
+func (g *Group) Leaf() int {
+	return g.state.leaf
+}
+
+// Members returns the members of the group, by leaf.
+func (g *Group) Members() []Member {
+	var members []Member
+	for leaf := 0; leaf < g.state.tree.leaves(); leaf++ {
+		if n := g.state.tree.nodes[2*leaf]; n != nil {
+			members = append(members, Member{Leaf: leaf, Identity: n.identity})
+		}
+	}
+	return members
+}
+
+// UpdateProposal returns a proposal to replace the key of this
+// member's leaf with a key pair generated from rng. The member keeps
+// the private key until another member commits the proposal.
+func (g *Group) UpdateProposal(rng io.Reader) (*Proposal, error) {
+	privateKey, publicKey, err := g.protocol.scheme.GenerateKeyPair(rng)
+	if err != nil {
+		return nil, err
+	}
+	g.pendingUpdates[string(publicKey.Bytes())] = privateKey
+	return &Proposal{Type: ProposalUpdate, Leaf: g.state.leaf, KeyPackage: &KeyPackage{PublicKey: publicKey}}, nil
+}
+
+// applyProposals applies the proposals of a commit by sender to t:
+// the updates, then the removes, then the adds. It returns the leaves
+// of the added members.
+func applyProposals(t *tree, sender int, proposals []*Proposal) ([]int, error) {
+	touched := make(map[int]bool)
+	for _, kind := range []ProposalType{ProposalUpdate, ProposalRemove} {
+		for _, proposal := range proposals {
+			if proposal.Type != kind {
+				continue
+			}
+			leaf := proposal.Leaf
+			if leaf == sender || leaf < 0 || leaf >= t.leaves() || t.nodes[2*leaf] == nil || touched[leaf] {
+				return nil, ErrProposal
+			}
+			touched[leaf] = true
+			t.blankPath(2 * leaf)
+			if kind == ProposalUpdate {
+				t.nodes[2*leaf].publicKey = proposal.KeyPackage.PublicKey
+			} else {
+				t.nodes[2*leaf] = nil
+			}
+		}
+	}
+	t.truncate()
+
+	var added []int
+	for _, proposal := range proposals {
+		switch proposal.Type {
+		case ProposalAdd:
+			if len(proposal.KeyPackage.Identity) > MaxIdentitySize {
+				return nil, ErrProposal
+			}
+			added = append(added, t.addLeaf(&node{
+				publicKey: proposal.KeyPackage.PublicKey,
+				identity:  proposal.KeyPackage.Identity,
+			}))
+			if t.leaves() > MaxMembers {
+				return nil, ErrProposal
+			}
+		case ProposalRemove, ProposalUpdate:
+		default:
+			return nil, ErrProposal
+		}
+	}
+	return added, nil
+}
+
+// context binds the secrets of a message to the group, its epoch and
+// the tree.
+func (g *Group) context(epoch uint64, t *tree) []byte {
+	c := binary.BigEndian.AppendUint16(nil, uint16(len(g.groupID)))
+	c = append(c, g.groupID...)
+	c = binary.BigEndian.AppendUint64(c, epoch)
+	return append(c, t.hash()...)
+}
+
+// nextEpoch sets the secrets of the epoch after s from commitSecret.
+func (g *Group) nextEpoch(s *state, commitSecret []byte) {
+	s.epoch++
+	prk := hkdf.Extract(sha256.New, commitSecret, s.initSecret)
+	s.setEpochSecret(expand(prk, "epoch", g.context(s.epoch, s.tree)))
+}
+
+// Commit returns a commit of proposals, and a welcome for the members
+// it adds or nil if there are none, with a fresh leaf secret and
+// ephemeral key drawn from rng. The member moves to the next epoch
+// when it processes the commit.
+func (g *Group) Commit(rng io.Reader, proposals ...*Proposal) (commit, welcome []byte, err error) {
+	p := g.protocol
+	s := g.state.clone()
+	added, err := applyProposals(s.tree, s.leaf, proposals)
+	if err != nil {
+		return nil, nil, err
+	}
+	joined := make(map[int]bool, len(added))
+	for _, leaf := range added {
+		joined[2*leaf] = true
+	}
+
+	ephemeral, ephemeralPublic, err := p.scheme.GenerateKeyPair(rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer ephemeral.Reset()
+	secret := make([]byte, SecretSize)
+	if _, err := io.ReadFull(rng, secret); err != nil {
+		return nil, nil, err
+	}
+
+	commit = []byte{messageCommit}
+	commit = binary.BigEndian.AppendUint16(commit, uint16(len(g.groupID)))
+	commit = append(commit, g.groupID...)
+	commit = binary.BigEndian.AppendUint64(commit, s.epoch)
+	commit = binary.BigEndian.AppendUint32(commit, uint32(s.leaf))
+	commit = binary.BigEndian.AppendUint32(commit, uint32(len(proposals)))
+	for _, proposal := range proposals {
+		commit = appendProposal(commit, proposal)
+	}
+
+	// The leaf and every node above it get key pairs generated from
+	// the hash chain of path secrets.
+	leafPrivate, leafPublic, err := p.nodeKeyPair(secret)
+	if err != nil {
+		return nil, nil, err
+	}
+	s.tree.nodes[2*s.leaf].publicKey = leafPublic
+	s.privateKeys[2*s.leaf] = leafPrivate
+	path := s.tree.directPath(2 * s.leaf)
+	pathSecrets := make(map[int][]byte, len(path))
+	for _, x := range path {
+		secret = expand(secret, "path")
+		pathSecrets[x] = secret
+		privateKey, publicKey, err := p.nodeKeyPair(secret)
+		if err != nil {
+			return nil, nil, err
+		}
+		s.tree.nodes[x] = &node{publicKey: publicKey}
+		s.privateKeys[x] = privateKey
+	}
+
+	// Each path secret goes to the nodes covering the other side of
+	// the tree below its node, except for the members this commit
+	// adds, which get theirs in the welcome.
+	context := g.context(s.epoch, s.tree)
+	commit = append(commit, leafPublic.Bytes()...)
+	commit = append(commit, ephemeralPublic.Bytes()...)
+	commit = binary.BigEndian.AppendUint32(commit, uint32(len(path)))
+	child := 2 * s.leaf
+	for _, x := range path {
+		commit = append(commit, s.tree.nodes[x].publicKey.Bytes()...)
+		var recipients []int
+		for _, y := range s.tree.resolution(sibling(child)) {
+			if !joined[y] {
+				recipients = append(recipients, y)
+			}
+		}
+		commit = binary.BigEndian.AppendUint32(commit, uint32(len(recipients)))
+		for _, y := range recipients {
+			ciphertext, err := p.seal(ephemeral, s.tree.nodes[y].publicKey, y, context, pathSecrets[x])
+			if err != nil {
+				return nil, nil, err
+			}
+			commit = binary.BigEndian.AppendUint32(commit, uint32(y))
+			commit = append(commit, ciphertext...)
+		}
+		child = x
+	}
+	commit = appendMAC(commit, g.state.membershipKey)
+	g.nextEpoch(s, expand(secret, "commit"))
+
+	if len(added) > 0 {
+		if welcome, err = g.welcome(s, ephemeral, ephemeralPublic, added, pathSecrets); err != nil {
+			return nil, nil, err
+		}
+	}
+	g.pendingCommit, g.pendingState = commit, s
+	return commit, welcome, nil
+}
+
+// welcome returns the welcome of the new state s for the added
+// leaves, which holds for each of them the epoch secret and the path
+// secret of the lowest node above both it and the committer.
+func (g *Group) welcome(s *state, ephemeral nike.PrivateKey, ephemeralPublic nike.PublicKey, added []int, pathSecrets map[int][]byte) ([]byte, error) {
+	p := g.protocol
+	welcome := []byte{messageWelcome}
+	welcome = binary.BigEndian.AppendUint16(welcome, uint16(len(g.groupID)))
+	welcome = append(welcome, g.groupID...)
+	welcome = binary.BigEndian.AppendUint64(welcome, s.epoch)
+	welcome = binary.BigEndian.AppendUint32(welcome, uint32(s.leaf))
+	welcome = appendTree(welcome, s.tree)
+	welcome = append(welcome, ephemeralPublic.Bytes()...)
+	context := g.context(s.epoch, s.tree)
+	welcome = binary.BigEndian.AppendUint32(welcome, uint32(len(added)))
+	for _, leaf := range added {
+		x := 2 * s.leaf
+		for !inSubtree(x, 2*leaf) {
+			x = parent(x)
+		}
+		ciphertext, err := p.seal(ephemeral, s.tree.nodes[2*leaf].publicKey, 2*leaf, context,
+			append(append([]byte{}, s.epochSecret...), pathSecrets[x]...))
+		if err != nil {
+			return nil, err
+		}
+		welcome = binary.BigEndian.AppendUint32(welcome, uint32(leaf))
+		welcome = append(welcome, ciphertext...)
+	}
+	return appendMAC(welcome, s.membershipKey), nil
+}
+
+// Join returns the group a welcome invites the member of keyPackage,
+// with privateKey, to.
+func (p *Protocol) Join(welcome []byte, keyPackage *KeyPackage, privateKey nike.PrivateKey) (*Group, error) {
+	if len(welcome) < sha256.Size {
+		return nil, ErrMessage
+	}
+	r := &reader{b: welcome[:len(welcome)-sha256.Size]}
+	if r.byte() != messageWelcome {
+		return nil, ErrMessage
+	}
+	groupID := r.bytes(int(r.uint16()))
+	s := &state{epoch: r.uint64()}
+	committer := int(r.uint32())
+	if r.err != nil {
+		return nil, r.err
+	}
+	t, err := parseTree(p.scheme, r)
+	if err != nil {
+		return nil, err
+	}
+	s.tree = t
+	ephemeral := r.publicKey(p.scheme)
+	count := r.uint32()
+	if r.err != nil {
+		return nil, r.err
+	}
+	g := p.newGroup(groupID, s)
+	context := g.context(s.epoch, t)
+
+	var plaintext []byte
+	for i := uint32(0); i < count; i++ {
+		leaf := int(r.uint32())
+		ciphertext := r.bytes(2*SecretSize + tagSize)
+		if r.err != nil {
+			return nil, r.err
+		}
+		if leaf >= t.leaves() || t.nodes[2*leaf] == nil || plaintext != nil ||
+			!bytes.Equal(t.nodes[2*leaf].publicKey.Bytes(), keyPackage.PublicKey.Bytes()) {
+			continue
+		}
+		if plaintext, err = p.open(privateKey, ephemeral, 2*leaf, context, ciphertext); err != nil {
+			return nil, err
+		}
+		s.leaf = leaf
+	}
+	if len(r.b) != 0 {
+		return nil, ErrMessage
+	}
+	if plaintext == nil {
+		return nil, ErrNotWelcome
+	}
+	if committer >= t.leaves() || t.nodes[2*committer] == nil || committer == s.leaf {
+		return nil, ErrMessage
+	}
+	s.setEpochSecret(plaintext[:SecretSize])
+	if !hmac.Equal(welcome[len(welcome)-sha256.Size:], mac(welcome[:len(welcome)-sha256.Size], s.membershipKey)) {
+		return nil, ErrMAC
+	}
+
+	s.privateKeys = map[int]nike.PrivateKey{2 * s.leaf: privateKey}
+	x := 2 * committer
+	for !inSubtree(x, 2*s.leaf) {
+		x = parent(x)
+	}
+	if err := p.setPathSecret(s, x, plaintext[SecretSize:]); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// setPathSecret derives the private keys of node x and the nodes above
+// it from the path secret of x, and checks them against the tree.
+func (p *Protocol) setPathSecret(s *state, x int, secret []byte) error {
+	for {
+		privateKey, publicKey, err := p.nodeKeyPair(secret)
+		if err != nil {
+			return err
+		}
+		if n := s.tree.nodes[x]; n == nil || !bytes.Equal(n.publicKey.Bytes(), publicKey.Bytes()) {
+			return ErrPathSecret
+		}
+		s.privateKeys[x] = privateKey
+		if x == s.tree.root() {
+			return nil
+		}
+		x = parent(x)
+		secret = expand(secret, "path")
+	}
+}
+
+// Process moves the group to the next epoch with a commit of the
+// current epoch, which may be the member's own. It returns ErrRemoved
+// if the commit removes the member, which then leaves the group.
+func (g *Group) Process(commit []byte) error {
+	if g.pendingCommit != nil && bytes.Equal(commit, g.pendingCommit) {
+		g.advance(g.pendingState)
+		return nil
+	}
+
+	p := g.protocol
+	if len(commit) < sha256.Size {
+		return ErrMessage
+	}
+	body := commit[:len(commit)-sha256.Size]
+	r := &reader{b: body}
+	if r.byte() != messageCommit {
+		return ErrMessage
+	}
+	groupID := r.bytes(int(r.uint16()))
+	epoch := r.uint64()
+	sender := int(r.uint32())
+	if r.err != nil {
+		return r.err
+	}
+	if !bytes.Equal(groupID, g.groupID) || epoch != g.state.epoch {
+		return ErrGroup
+	}
+	if !hmac.Equal(commit[len(body):], mac(body, g.state.membershipKey)) {
+		return ErrMAC
+	}
+
+	s := g.state.clone()
+	if sender >= s.tree.leaves() || s.tree.nodes[2*sender] == nil || sender == s.leaf {
+		return ErrMessage
+	}
+	count := r.uint32()
+	if count > 2*MaxMembers {
+		return ErrMessage
+	}
+	proposals := make([]*Proposal, count)
+	for i := range proposals {
+		proposals[i] = p.parseProposal(r)
+	}
+	if r.err != nil {
+		return r.err
+	}
+	added, err := applyProposals(s.tree, sender, proposals)
+	if err != nil {
+		return err
+	}
+	for _, proposal := range proposals {
+		if proposal.Leaf != s.leaf || proposal.Type == ProposalAdd {
+			continue
+		}
+		if proposal.Type == ProposalRemove {
+			return ErrRemoved
+		}
+		privateKey, ok := g.pendingUpdates[string(proposal.KeyPackage.PublicKey.Bytes())]
+		if !ok {
+			return ErrProposal
+		}
+		s.privateKeys[2*s.leaf] = privateKey
+	}
+	// Drop the private keys of the nodes which the proposals blanked
+	// or which are no longer in the tree.
+	for x := range s.privateKeys {
+		if x != 2*s.leaf && (x >= len(s.tree.nodes) || s.tree.nodes[x] == nil) {
+			delete(s.privateKeys, x)
+		}
+	}
+	joined := make(map[int]bool, len(added))
+	for _, leaf := range added {
+		joined[2*leaf] = true
+	}
+
+	s.tree.nodes[2*sender].publicKey = r.publicKey(p.scheme)
+	ephemeral := r.publicKey(p.scheme)
+	path := s.tree.directPath(2 * sender)
+	if r.uint32() != uint32(len(path)) && r.err == nil {
+		return ErrMessage
+	}
+
+	// The path secret for this member is that of the lowest node of
+	// the path above it, encrypted to a node whose private key it has.
+	lowest, recipient := -1, 0
+	var ciphertext []byte
+	child := 2 * sender
+	for i, x := range path {
+		s.tree.nodes[x] = &node{publicKey: r.publicKey(p.scheme)}
+		count := r.uint32()
+		if r.err != nil {
+			return r.err
+		}
+		if count > uint32(len(s.tree.nodes)) {
+			return ErrMessage
+		}
+		for j := uint32(0); j < count; j++ {
+			y := int(r.uint32())
+			c := r.bytes(SecretSize + tagSize)
+			if r.err != nil {
+				return r.err
+			}
+			if _, ok := s.privateKeys[y]; ok && lowest < 0 && !joined[y] && inSubtree(sibling(child), 2*s.leaf) {
+				lowest, recipient, ciphertext = i, y, c
+			}
+		}
+		child = x
+	}
+	if len(r.b) != 0 {
+		return ErrMessage
+	}
+	if lowest < 0 {
+		return ErrPathSecret
+	}
+
+	secret, err := p.open(s.privateKeys[recipient], ephemeral, recipient, g.context(s.epoch, s.tree), ciphertext)
+	if err != nil {
+		return err
+	}
+	if err := p.setPathSecret(s, path[lowest], secret); err != nil {
+		return err
+	}
+	for i := lowest + 1; i < len(path); i++ {
+		secret = expand(secret, "path")
+	}
+	g.nextEpoch(s, expand(secret, "commit"))
+	g.advance(s)
+	return nil
+}
+
+// advance moves the group to the epoch of s, dropping the proposals
+// and commit of the previous one.
+func (g *Group) advance(s *state) {
+	g.state = s
+	g.pendingCommit, g.pendingState = nil, nil
+	for k := range g.pendingUpdates {
+		delete(g.pendingUpdates, k)
+	}
+}
+
+func mac(message, key []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(message)
+	return h.Sum(nil)
+}
+
+func appendMAC(message, key []byte) []byte {
+	return append(message, mac(message, key)...)
+}
@@ -0,0 +1,325 @@
+package treekem
+
+import (
+	"crypto/rand"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"git.xx.network/elixxir/ctidh_cgo/ctidh511"
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+	"git.xx.network/elixxir/ctidh_cgo/nike/x25519"
+)
+
+func TestTreeMath(t *testing.T) {
+	// The tree of eight leaves:
+	//
+	//	              7
+	//	      3               11
+	//	  1       5       9       13
+	//	0   2   4   6   8   10  12  14
+	require.Equal(t, []int{0, 1, 0, 2, 0, 1, 0, 3}, []int{level(0), level(1), level(2), level(3), level(4), level(5), level(6), level(7)})
+	require.Equal(t, 3, left(7))
+	require.Equal(t, 11, right(7))
+	require.Equal(t, 1, parent(0))
+	require.Equal(t, 1, parent(2))
+	require.Equal(t, 11, parent(13))
+	require.Equal(t, 7, parent(3))
+	require.Equal(t, 5, sibling(1))
+	require.Equal(t, 10, sibling(8))
+	require.True(t, inSubtree(3, 6))
+	require.False(t, inSubtree(3, 8))
+
+	tr := newTree(8)
+	require.Equal(t, 7, tr.root())
+	require.Equal(t, []int{9, 11, 7}, tr.directPath(8))
+	require.Nil(t, tr.resolution(7))
+	for leaf := 0; leaf < 8; leaf += 3 {
+		tr.nodes[2*leaf] = &node{}
+	}
+	tr.nodes[3] = &node{unmerged: []int{2}}
+	require.Equal(t, []int{3, 4, 12}, tr.resolution(7))
+
+	tr.nodes[12] = nil
+	tr.truncate()
+	require.Equal(t, 4, tr.leaves())
+	require.Equal(t, 1, tr.addLeaf(&node{}))
+	require.Equal(t, []int{2, 1}, tr.nodes[3].unmerged)
+	tr.addLeaf(&node{})
+	require.Equal(t, 4, tr.addLeaf(&node{}))
+	require.Equal(t, 8, tr.leaves())
+}
+
+// harness runs a group, delivering every commit to every member.
+type harness struct {
+	t       *testing.T
+	p       *Protocol
+	members map[int]*Group
+	next    int
+}
+
+func newHarness(t *testing.T, scheme nike.Scheme) *harness {
+	p := New(scheme)
+	kp, priv, err := p.NewKeyPackage(rand.Reader, []byte("member 0"))
+	require.NoError(t, err)
+	g, err := p.Create(rand.Reader, []byte("test group"), kp, priv)
+	require.NoError(t, err)
+	return &harness{t: t, p: p, members: map[int]*Group{0: g}, next: 1}
+}
+
+// keyPackages returns n new key packages and their private keys.
+func (h *harness) keyPackages(n int) ([]*KeyPackage, []nike.PrivateKey) {
+	kps := make([]*KeyPackage, n)
+	privs := make([]nike.PrivateKey, n)
+	for i := range kps {
+		var err error
+		kps[i], privs[i], err = h.p.NewKeyPackage(rand.Reader, []byte(fmt.Sprint("member ", h.next+i)))
+		require.NoError(h.t, err)
+	}
+	return kps, privs
+}
+
+// commit has the member at leaf commit proposals, adds the members of
+// keyPackages, and delivers the commit to every member and the welcome
+// to the new ones, concurrently.
+func (h *harness) commit(leaf int, proposals []*Proposal, kps []*KeyPackage, privs []nike.PrivateKey, removed ...int) {
+	for _, kp := range kps {
+		proposals = append(proposals, AddProposal(kp))
+	}
+	commit, welcome, err := h.members[leaf].Commit(rand.Reader, proposals...)
+	require.NoError(h.t, err)
+	require.Equal(h.t, len(kps) > 0, welcome != nil)
+
+	gone := make(map[int]bool)
+	for _, r := range removed {
+		gone[r] = true
+	}
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		errs   []error
+		joined = make(map[int]*Group)
+	)
+	for l, g := range h.members {
+		wg.Add(1)
+		go func(l int, g *Group) {
+			defer wg.Done()
+			err := g.Process(commit)
+			if gone[l] {
+				if err != ErrRemoved {
+					err = fmt.Errorf("leaf %d: got %v, want ErrRemoved", l, err)
+				} else {
+					err = nil
+				}
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}(l, g)
+	}
+	for i := range kps {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			g, err := h.p.Join(welcome, kps[i], privs[i])
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, err)
+				return
+			}
+			joined[g.Leaf()] = g
+		}(i)
+	}
+	wg.Wait()
+	require.Empty(h.t, errs)
+
+	for l := range gone {
+		delete(h.members, l)
+	}
+	for l, g := range joined {
+		_, ok := h.members[l]
+		require.False(h.t, ok, "leaf %d reused", l)
+		h.members[l] = g
+	}
+	h.next += len(kps)
+	h.check()
+}
+
+// check checks that every member is in the same epoch with the same
+// secret and sees the same members.
+func (h *harness) check() {
+	first := h.members[h.any()]
+	require.Len(h.t, first.Members(), len(h.members))
+	for l, g := range h.members {
+		require.Equal(h.t, l, g.Leaf())
+		require.Equal(h.t, first.Epoch(), g.Epoch(), "leaf %d", l)
+		require.Equal(h.t, first.EpochSecret(), g.EpochSecret(), "leaf %d", l)
+		require.Equal(h.t, first.Members(), g.Members(), "leaf %d", l)
+	}
+}
+
+// any returns the lowest leaf of a member.
+func (h *harness) any() int {
+	lowest := -1
+	for l := range h.members {
+		if lowest < 0 || l < lowest {
+			lowest = l
+		}
+	}
+	return lowest
+}
+
+// run grows a group to size members and then updates, removes and
+// adds members, checking that all agree on every epoch.
+func run(t *testing.T, scheme nike.Scheme, size int) {
+	h := newHarness(t, scheme)
+	secrets := map[string]bool{string(h.members[0].EpochSecret()): true}
+	record := func() {
+		s := string(h.members[h.any()].EpochSecret())
+		require.False(t, secrets[s], "epoch secret repeated")
+		secrets[s] = true
+	}
+
+	// Grow in doubling batches committed by various members, and then
+	// add the rest at once: every member checks the key of every added
+	// member, so growing a large group in steps costs far more.
+	for batch := 1; len(h.members) < size; batch *= 2 {
+		if n := size - len(h.members); batch > 8 || batch > n {
+			batch = n
+		}
+		kps, privs := h.keyPackages(batch)
+		h.commit(h.any()+len(h.members)/2*(batch%3%2), nil, kps, privs)
+		record()
+	}
+	require.Len(t, h.members, size)
+
+	// A member commits an update another proposed, together with
+	// removes from the middle and the right edge of the tree.
+	update, err := h.members[3].UpdateProposal(rand.Reader)
+	require.NoError(t, err)
+	removed := []int{1, size / 2, size - 1}
+	proposals := []*Proposal{update}
+	for _, l := range removed {
+		proposals = append(proposals, RemoveProposal(l))
+	}
+	h.commit(2, proposals, nil, nil, removed...)
+	record()
+
+	// New members fill the blank leaves while another is removed.
+	kps, privs := h.keyPackages(4)
+	h.commit(size/2+1, []*Proposal{RemoveProposal(3)}, kps, privs, 3)
+	record()
+
+	// A commit without proposals only refreshes the committer's
+	// path.
+	h.commit(h.any(), nil, nil, nil)
+	record()
+}
+
+func TestGroupX25519(t *testing.T) {
+	run(t, x25519.Scheme(), 300)
+}
+
+func TestGroupCTIDH(t *testing.T) {
+	run(t, ctidh511.Scheme(), 12)
+}
+
+// TestLargeGroupCTIDH runs a group of hundreds of members with CTIDH
+// node keys. Each member spends a few group actions on every commit,
+// so it takes minutes.
+func TestLargeGroupCTIDH(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping a large CTIDH group in short mode")
+	}
+	run(t, ctidh511.Scheme(), 300)
+}
+
+func TestProposalEncoding(t *testing.T) {
+	p := New(x25519.Scheme())
+	kp, _, err := p.NewKeyPackage(rand.Reader, []byte("alice"))
+	require.NoError(t, err)
+	for _, proposal := range []*Proposal{
+		AddProposal(kp),
+		RemoveProposal(7),
+		{Type: ProposalUpdate, Leaf: 3, KeyPackage: &KeyPackage{PublicKey: kp.PublicKey}},
+	} {
+		b, err := proposal.MarshalBinary()
+		require.NoError(t, err)
+		decoded, err := p.UnmarshalProposal(b)
+		require.NoError(t, err)
+		require.Equal(t, proposal.Type, decoded.Type)
+		require.Equal(t, proposal.Leaf, decoded.Leaf)
+		if proposal.KeyPackage != nil {
+			require.Equal(t, proposal.KeyPackage.PublicKey.Bytes(), decoded.KeyPackage.PublicKey.Bytes())
+			require.Equal(t, proposal.KeyPackage.Identity, decoded.KeyPackage.Identity)
+		}
+		for _, bad := range [][]byte{nil, b[:len(b)-1], append(append([]byte{}, b...), 0)} {
+			_, err = p.UnmarshalProposal(bad)
+			require.ErrorIs(t, err, ErrMessage)
+		}
+	}
+	_, err = p.UnmarshalProposal([]byte{messageCommit})
+	require.ErrorIs(t, err, ErrMessage)
+}
+
+func TestErrors(t *testing.T) {
+	h := newHarness(t, x25519.Scheme())
+	kps, privs := h.keyPackages(3)
+	h.commit(0, nil, kps, privs)
+	alice, bob := h.members[0], h.members[1]
+
+	// Proposals which remove or update the committer, or name a
+	// blank leaf, are invalid.
+	for _, proposal := range []*Proposal{RemoveProposal(0), RemoveProposal(9), {Type: ProposalUpdate, Leaf: 0, KeyPackage: kps[0]}} {
+		_, _, err := alice.Commit(rand.Reader, proposal)
+		require.ErrorIs(t, err, ErrProposal)
+	}
+	_, _, err := alice.Commit(rand.Reader, RemoveProposal(1), RemoveProposal(1))
+	require.ErrorIs(t, err, ErrProposal)
+
+	commit, _, err := alice.Commit(rand.Reader)
+	require.NoError(t, err)
+	for _, bad := range [][]byte{nil, commit[:len(commit)-1], commit[:40]} {
+		require.Error(t, bob.Process(bad))
+	}
+	tampered := append([]byte{}, commit...)
+	tampered[len(tampered)-40] ^= 1
+	require.ErrorIs(t, bob.Process(tampered), ErrMAC)
+
+	// Another group's commit of the same epoch fails.
+	other := newHarness(t, x25519.Scheme())
+	otherCommit, _, err := other.members[0].Commit(rand.Reader)
+	require.NoError(t, err)
+	require.ErrorIs(t, bob.Process(otherCommit), ErrGroup)
+	bobCommit, _, err := bob.Commit(rand.Reader)
+	require.NoError(t, err)
+	require.NoError(t, alice.Process(bobCommit))
+
+	// The first commit of the epoch wins: bob's own is now stale.
+	require.NoError(t, bob.Process(bobCommit))
+	require.ErrorIs(t, bob.Process(commit), ErrGroup)
+	require.Equal(t, alice.EpochSecret(), bob.EpochSecret())
+
+	// A welcome opens only with its key package.
+	kp, priv, err := h.p.NewKeyPackage(rand.Reader, []byte("carol"))
+	require.NoError(t, err)
+	_, welcome, err := alice.Commit(rand.Reader, AddProposal(kp))
+	require.NoError(t, err)
+	stranger, strangerPriv, err := h.p.NewKeyPackage(rand.Reader, []byte("mallory"))
+	require.NoError(t, err)
+	_, err = h.p.Join(welcome, stranger, strangerPriv)
+	require.ErrorIs(t, err, ErrNotWelcome)
+	tampered = append([]byte{}, welcome...)
+	tampered[len(tampered)-1] ^= 1
+	_, err = h.p.Join(tampered, kp, priv)
+	require.ErrorIs(t, err, ErrMAC)
+	_, err = h.p.Join(welcome[:len(welcome)-33], kp, priv)
+	require.Error(t, err)
+	_, err = h.p.Join(welcome, kp, priv)
+	require.NoError(t, err)
+}
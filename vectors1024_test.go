@@ -10,6 +10,24 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func Test1024BitVectorPrivateKeyFromSeed(t *testing.T) {
+	seedHex := "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"
+	seed, err := hex.DecodeString(seedHex)
+	require.NoError(t, err)
+
+	privateKey, err := NewPrivateKeyFromSeed(seed)
+	require.NoError(t, err)
+
+	privateKeyHex := "01ff0300fffdff00ff000000ff03fffe01fe00000002fe0000000000000001fbfd02ff000000fffc000001000002ff000101ff01000100fffd00fe00000200ff00ffff0001fe010000ff01fffffe020000fd0000ffff0100fe010100000100ff0200ff000000ffffff00fe0000ff0000ff01fe00010002000100000000ff01000000"
+	require.Equal(t, privateKeyHex, hex.EncodeToString(privateKey.Bytes()))
+
+	publicKey, err := privateKey.PublicKey()
+	require.NoError(t, err)
+
+	publicKeyHex := "ca49c72158869f65ed15c690b202972a297e954b84e496ebe983d5fd2a2bc2aad289908889b9be75f51ebc230b9fe64b2173de5afd05c6c49abdc58d86e267377aeac6c610e2f600985f6ef610bbac9c8db0072cd3cf697ac830c01efdc490878441da438d1cb82daeb1f36f4b7bfe60eafcd482a74d1c03506202a3ac5e6003"
+	require.Equal(t, publicKeyHex, hex.EncodeToString(publicKey.Bytes()))
+}
+
 func Test1024BitVectorBlindingOperation(t *testing.T) {
 	publicKeyHex := "859070e07094657ae90acfa5e03c0342cb1909bf73cc1d0992ef48f533444bdc334846872333cbf17b0b3a763f64b4d5435c91742269d6dfcfe5347473b28f881045a55f3e10a7a7dd5a7192013a932d29703a1236eb1964563a9892ab19fffa3590a0f0a2e02a2205baf6af83d192822f24516fb311506f4cc18687a18c1e0c"
 	publicKeyBytes, err := hex.DecodeString(publicKeyHex)
@@ -22,7 +40,30 @@ func Test1024BitVectorBlindingOperation(t *testing.T) {
 	blindingFactor, err := hex.DecodeString(blindingFactorHex)
 	require.NoError(t, err)
 
-	publicKey.Blind(blindingFactor)
+	err = publicKey.Blind(blindingFactor)
+	require.NoError(t, err)
+
+	blindingOutputHex := "057a690eecd3bdc82f94620fb75dafe9e0b05c01642c05c147a16f81a456668a10e0170a64fc65b2978c5e40fbc32c404d5cd3a09a31020765782d6f59d2a6c64eaa5f8af7b7bc78601f557e543af16ec1b6dc7a1767ddfb23a038b96d328b7b2ff1104f1b02396d88c1f777cb7df52927c8cf5eb9253b17410590f37b4e9e01"
+	blindingOutputBytes, err := hex.DecodeString(blindingOutputHex)
+	require.NoError(t, err)
+
+	require.Equal(t, blindingOutputBytes, publicKey.Bytes())
+}
+
+func Test1024BitVectorLegacyBlindingOperation(t *testing.T) {
+	publicKeyHex := "859070e07094657ae90acfa5e03c0342cb1909bf73cc1d0992ef48f533444bdc334846872333cbf17b0b3a763f64b4d5435c91742269d6dfcfe5347473b28f881045a55f3e10a7a7dd5a7192013a932d29703a1236eb1964563a9892ab19fffa3590a0f0a2e02a2205baf6af83d192822f24516fb311506f4cc18687a18c1e0c"
+	publicKeyBytes, err := hex.DecodeString(publicKeyHex)
+	require.NoError(t, err)
+	publicKey := new(PublicKey)
+	err = publicKey.FromBytes(publicKeyBytes)
+	require.NoError(t, err)
+
+	blindingFactorHex := "82b843e2e941649c8e25aafde8e088e2c3406f8a1cd5803566e9204c2178bf68e7fa0febcc721ef527d514c4a79d29d549f59c876a69b18d3b7112f2b2b2b68b6acb0037a60c00981c7f6edfbaeccba1dc54df5dd85c96256b9649f3df3676dba7578163075b4fff7012c2fadb9bd03b3b9488b5577bab3918d1899b3cba0ff5b046"
+	blindingFactor, err := hex.DecodeString(blindingFactorHex)
+	require.NoError(t, err)
+
+	err = publicKey.BlindLegacy(blindingFactor)
+	require.NoError(t, err)
 
 	blindingOutputHex := "b7f1fb4cba440e61d516d4cdb6a8b542c057b76eb4b277e0114a544c943756721ee2d09136b0ce97eb099961a6b383820cf7aebec2217b6f7cb7169aec7d00788b5bf549e274a743d496258b99f3cd36d176d253cc858719f0db4027959d2c8fd8f731c5101cba9198dabe11ebf3f67191bd8210b5a5fd9387ff5892d2565200"
 	blindingOutputBytes, err := hex.DecodeString(blindingOutputHex)
@@ -31,6 +72,22 @@ func Test1024BitVectorBlindingOperation(t *testing.T) {
 	require.Equal(t, blindingOutputBytes, publicKey.Bytes())
 }
 
+func Test1024BitVectorDeterministicPublicKey(t *testing.T) {
+	for _, vector := range []struct{ domain, msg, publicKeyHex string }{
+		{"", "", "1eccd14d8b9c9dadb12fca76a450b754c14a87cc25d19ff1caf0fd26494a45df7a3d1fd9c5d4095e6444a650a823acafb375fe02bf06b268e73ff103020bd198b983868a7434d7a74df1f7304ecb4a3025dcb58fc9c719aa8df3bfb0df81ce80a451fbdefca953628a6443eb71826ada2367654d88dd3ffce5a68fd4202aa601"},
+		{"ctidh test", "message", "a1cba100accb19ef27b77f1d35d24c242323f8cdc6860035573a327a3383610cc3829ce6600ee628011697c32a3579cd28c976631f4b7ba60dd7efcfd1975833c9a286fcc9bc2fa3279c523d77fe3bbe899b6276deff3f8e47450e881425572badb8250b3e74116db95fb23da04ea7967923752bfac23099e1dc85caefed5203"},
+	} {
+		publicKeyBytes, err := hex.DecodeString(vector.publicKeyHex)
+		require.NoError(t, err)
+		publicKey := new(PublicKey)
+		err = publicKey.FromBytes(publicKeyBytes)
+		require.NoError(t, err)
+
+		derived := DeterministicPublicKey([]byte(vector.domain), []byte(vector.msg))
+		require.Equal(t, publicKeyBytes, derived.Bytes())
+	}
+}
+
 func Test1024BitVectors(t *testing.T) {
 	// Alice
 	alicePrivateKeyHex := "fe0001010000fe0100fe00ff00fc0300ffffff0000ff0100000000ff01010200000201fe01000100ff0000fdff010001ffffff03fd0000000000feff000101ff000401000100000100040000010001ff00000001000100000101ffffff010001ff00ff00ffff0000fe000100000100ff0000000002ff0000fe0000010000ff000000"
@@ -59,8 +116,8 @@ func Test1024BitVectors(t *testing.T) {
 	require.NoError(t, err)
 
 	// NIKE
-	bobSharedBytes := DeriveSecret(bobPrivateKey, alicePublicKey)
-	aliceSharedBytes := DeriveSecret(alicePrivateKey, bobPublicKey)
+	bobSharedBytes := MustDeriveSecret(bobPrivateKey, alicePublicKey)
+	aliceSharedBytes := MustDeriveSecret(alicePrivateKey, bobPublicKey)
 	require.Equal(t, bobSharedBytes, aliceSharedBytes)
 
 	sharedSecretHex := "411abafeca991f77b6f9263721ca3e2898031871e18d91b61c33c8664a9fc3fccf331729a9dd60465687e53c3d7649abfd4a3e32f4ea86e351535c9b281a76a74fa6b057d94403e55941de7e91432e2e85cc8f5b13fa28314a8dc8f09360e44c802bfc8b036451b26bc54200e133dde3976aa1f4885277a7692da9d38c09e301"
@@ -99,8 +156,8 @@ func TestPython1024BitVectors(t *testing.T) {
 	require.NoError(t, err)
 
 	// NIKE
-	bobSharedBytes := DeriveSecret(bobPrivateKey, alicePublicKey)
-	aliceSharedBytes := DeriveSecret(alicePrivateKey, bobPublicKey)
+	bobSharedBytes := MustDeriveSecret(bobPrivateKey, alicePublicKey)
+	aliceSharedBytes := MustDeriveSecret(alicePrivateKey, bobPublicKey)
 	require.Equal(t, bobSharedBytes, aliceSharedBytes)
 
 	sharedSecretHex := "b5ab3b4d9cac68c451a43d1b499e190d462788362089ca5f3e4462c1502bb06cc820fe2e46c0f9ddaf8de6fcf8c0b4238e677497ebc6f5bb622a894c3c485c9e16142579392b6af434db46b146416aab5d5bd43c3d0f1bc55755f1af93d137d20540e65fc54e7b2b564dceec6484dc2b8bdd30db2b4ea7ba86adecfcb3e7ba08"
@@ -10,6 +10,24 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func Test2048BitVectorPrivateKeyFromSeed(t *testing.T) {
+	seedHex := "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"
+	seed, err := hex.DecodeString(seedHex)
+	require.NoError(t, err)
+
+	privateKey, err := NewPrivateKeyFromSeed(seed)
+	require.NoError(t, err)
+
+	privateKeyHex := "000000000000000100fe0000000001000000000200000100000000ffff000000000000ff00000000fe00000100000000000000ff000000ff000100000000000001000000ff00ff00000000000000ffff000000ff000000000000ff0000fe00000000000000000000010001010000010000ff000000000000ff00000000ffffff00010001000000010000ff000100000100000000020000000000000000ff000200000000000000000100000101000001fe00000000000000000000000000000000000000000001ff00000001000000000001000000000000000000000000000000000000fe0000"
+	require.Equal(t, privateKeyHex, hex.EncodeToString(privateKey.Bytes()))
+
+	publicKey, err := privateKey.PublicKey()
+	require.NoError(t, err)
+
+	publicKeyHex := "e16804e39905986c27e372b65c64e8008a4ac8240a40f214214dae2874903ae5be4bd013d92e77bf87206befaa7850af36913f37a7859d9cdf54c9f1f2ef7501f4e383f7794c313a271e2fcdee2916a763f6db72117e6c049d556e6300bf408e0db7c92eedaadfd7edd2c3f6d0e01a6814493d2540e34bc414494ecb425503b89961907fc2dec23bb3880ca82b1de4616fb809a378e7f2e3faf0afda150520e851b939669815566d0b6512e82578c09f7989d899f09d51fc6c5469ffa16810cac4e4d4553f099c8ccf4e892708527ce88764f149fc026d35b5803e1f063a132a67fb0f272f2a98d0db6589b687910a032a6400e87aa396ee0ecae1f79f3f7804"
+	require.Equal(t, publicKeyHex, hex.EncodeToString(publicKey.Bytes()))
+}
+
 func Test2048BitVectorBlindingOperation(t *testing.T) {
 	publicKeyHex := "21418e787fe5ca6b7ac5c9fc4463e6e1ea5ef8d7b11bb96f6d1a7d2a70427c7a9f6ed48c32c390f573f9e6e01ef23ad84903492eea776470f68b13cadedbb1eb7cc184ff363e883d45603646301c2a477ef3c13c213503b8c21ee637ddb6b10f5f215bc7a07d3451b6bdac99cff25a1c795ade4b3b61d2a8800269d79c0b5b7d30bc917e42ed84fc55dd2cf7e26dfb024cb9af7cdde07b32ae23c2b6c47f815767e0e184b22b80acbfee433586f4d8a7e57d83dae8ed3f37553367d4e2a183c9771548ad9e25ba7f86a40bacc71911fa5b9a059b18ac9fe358e12bd5f315385f9aed96ede4ed2ff8089de64e9caf8a9b1d615b610fbc95c57f25ce9b445d7533"
 	publicKeyBytes, err := hex.DecodeString(publicKeyHex)
@@ -22,7 +40,30 @@ func Test2048BitVectorBlindingOperation(t *testing.T) {
 	blindingFactor, err := hex.DecodeString(blindingFactorHex)
 	require.NoError(t, err)
 
-	publicKey.Blind(blindingFactor)
+	err = publicKey.Blind(blindingFactor)
+	require.NoError(t, err)
+
+	blindingOutputHex := "d99795ff7476894b0d7b18b55fd2045441d528b6429b8d925f83ee62a5146c03d2c421f095859cdb2f54dc1725ca2d709c0c1291a2ae815ac4dbbddb58a870e606102b22098e128398cb2dd599da9e750d9c58c1e2c26addb64f1ac7fa373110db1029bf24c105a058173c5fd55681aee5ca178033fddc0440d640e57b604e611d4ad38e6781991c730a2386f67e31e5e19115d8ca14a1a00bee6b8c94d4e74419e7dceea6f4e1836344ae91426ae0fa95fb5f4e1851d2646df53bebcb0e79bba6fd76c88d57430cca8e553dbe1bcbd513aab1a3e1e12d6b76ef5e91f5d25e2d4562e8c0510db7b4c2bd27ab50668f16b33b50159960fa1b64e67c6db5308a12"
+	blindingOutputBytes, err := hex.DecodeString(blindingOutputHex)
+	require.NoError(t, err)
+
+	require.Equal(t, blindingOutputBytes, publicKey.Bytes())
+}
+
+func Test2048BitVectorLegacyBlindingOperation(t *testing.T) {
+	publicKeyHex := "21418e787fe5ca6b7ac5c9fc4463e6e1ea5ef8d7b11bb96f6d1a7d2a70427c7a9f6ed48c32c390f573f9e6e01ef23ad84903492eea776470f68b13cadedbb1eb7cc184ff363e883d45603646301c2a477ef3c13c213503b8c21ee637ddb6b10f5f215bc7a07d3451b6bdac99cff25a1c795ade4b3b61d2a8800269d79c0b5b7d30bc917e42ed84fc55dd2cf7e26dfb024cb9af7cdde07b32ae23c2b6c47f815767e0e184b22b80acbfee433586f4d8a7e57d83dae8ed3f37553367d4e2a183c9771548ad9e25ba7f86a40bacc71911fa5b9a059b18ac9fe358e12bd5f315385f9aed96ede4ed2ff8089de64e9caf8a9b1d615b610fbc95c57f25ce9b445d7533"
+	publicKeyBytes, err := hex.DecodeString(publicKeyHex)
+	require.NoError(t, err)
+	publicKey := new(PublicKey)
+	err = publicKey.FromBytes(publicKeyBytes)
+	require.NoError(t, err)
+
+	blindingFactorHex := "dcd05b1450127b4ce5670e5796c63686c9afb12735f155d883250aabcd98e49ddba9d9edb632181d891ee4d5e78550b45e8e7a72365fede727d5f90acad97e7a2b70cace99765e8193e3d439d55b64576af0cca5fded676daa42f00809670572f8d3e48cd02f87d0c3c051c5730f1e9e84cf6b3c0c11311ce4f4ce110336f373d1247d339341e692b060087b0816e77879282b62211a8287281c487ba4d87e336f758093763342ec2ed4c256c2572d985d7f0b5fd2bba61203a8633277930d6840ab8865189dcfc9a63b82307e99818f52cfb158cb55a93e55387e1a08976823675e0b9c0e5a47"
+	blindingFactor, err := hex.DecodeString(blindingFactorHex)
+	require.NoError(t, err)
+
+	err = publicKey.BlindLegacy(blindingFactor)
+	require.NoError(t, err)
 
 	blindingOutputHex := "337ac185e41400e58970f28a424e13e808468b0eec1e791ff0eb31924747207a77177ba87e63a21a0c13c6563a5ab0cd53edf0e58bc6a01af1df283770120831643ca1f5ba168f0e526c6e81708a78c862daa50d8a0ccb22547ab35c782fc5e732b442742fcee23897820441e2359387ff79973fb86372aa0e80097bab6066a0e12856d36803b8e811f187e74fe9092d624d43d559785f8e0ec99b4935117e7a876576999a337d7ff45f86532fdeb46799e2535b4760b24311f6888f21743a9c2f927e970df6bc525b07b1cbee786f084e096d414c60d4c2d87cd4237d127e1a826de5469bdcb9d1c63848e30e996a3a0df7a0299277a5abbaaddd4faa3a762a"
 	blindingOutputBytes, err := hex.DecodeString(blindingOutputHex)
@@ -31,6 +72,22 @@ func Test2048BitVectorBlindingOperation(t *testing.T) {
 	require.Equal(t, blindingOutputBytes, publicKey.Bytes())
 }
 
+func Test2048BitVectorDeterministicPublicKey(t *testing.T) {
+	for _, vector := range []struct{ domain, msg, publicKeyHex string }{
+		{"", "", "eee2ba597ca84be51ef2a543dea4b02e5ed6882bcb6ec26f62a750346cc8b2ea4726c4101254bcf9569df1066935382d3d0b173551341059f6ddfb2f2731289648ebe4673debdc9a9eb99bba33c827cc4729bcc9dd2ecea3d844577fad7596f2bdcf12b3cfe34a0d12290eae6f2d70d2ce7f9801422853c5e505368ebc3ae01bc9b2804ea2b8f7a7ab1a3b4524d77d99e19f72e31b048cceb9dd9ae0ddf32e3e3b272f7d6c55b50838d219186e20d3c216bc999e04796edd1a1ea3061afa152273f313db2b0624e169604ff0a5c525f2fd0897c526017d012425efa937c733a1923b6c8afef572d2bf0a05ba547d75fc8d52b963808335d7f5b88f583f0e4721"},
+		{"ctidh test", "message", "5d4b5738c1e55e5b0ca56b5431701dc45c22f468b3cf570c346809004016054f3826466869b4df92db639f2f8de80f971c8e12aca017a48ae7bdd675d7c3cef033c07f6b1c10d6be7854311b788e9e84216960e5dd3d883c6e916c75b33f0f6d342ea4f4ce81df1e99f4cf7b5cd19042221184560e28095800109be34a0a0fdac2b3e95c42271355c4eca0e524612ff1c379c3c76fb02150fb8bd9daf51994c8b78769c85a60ee45e838316c81dddc6702484a6fc5e04e04bb23160cf2c588250ea7f45c1342fd1611ead5b27ccceeb94a43bc8d206d205dd6ffcfd5e17c48e1d86a4e28069ffede0d1eb87b47d4b355ff9346ec7bc328aada51105b6b77ac27"},
+	} {
+		publicKeyBytes, err := hex.DecodeString(vector.publicKeyHex)
+		require.NoError(t, err)
+		publicKey := new(PublicKey)
+		err = publicKey.FromBytes(publicKeyBytes)
+		require.NoError(t, err)
+
+		derived := DeterministicPublicKey([]byte(vector.domain), []byte(vector.msg))
+		require.Equal(t, publicKeyBytes, derived.Bytes())
+	}
+}
+
 func Test2048BitVectors(t *testing.T) {
 	// Alice
 	alicePrivateKeyHex := "000000000000010000000000ff0000ff00010000ff00ff000000000000ff000100ff00000000fe00000100000000010000ffff000000ff00000000000100010000ff0100000000000000ff0000ff000000ff00000000000000000000000000fe0000ff000000000000000000fe0100ff00010000010000000000ff0000ffff00000101000100010000000000000101000000000000ff000001ff000001ff000100000000000000000101ff00000000000000ff01000000000000000001000101000000000000000000ff0000000000000100000000000000000000000000000000ff0000000000"
@@ -59,8 +116,8 @@ func Test2048BitVectors(t *testing.T) {
 	require.NoError(t, err)
 
 	// NIKE
-	bobSharedBytes := DeriveSecret(bobPrivateKey, alicePublicKey)
-	aliceSharedBytes := DeriveSecret(alicePrivateKey, bobPublicKey)
+	bobSharedBytes := MustDeriveSecret(bobPrivateKey, alicePublicKey)
+	aliceSharedBytes := MustDeriveSecret(alicePrivateKey, bobPublicKey)
 	require.Equal(t, bobSharedBytes, aliceSharedBytes)
 
 	sharedSecretHex := "660261a31b1ce1ba858ae7a0c17314b5587f13dc1c31a6eb7a38933037705bfe7b19cfd387d32dc0ea99de95f6fa1bfc7667066b668542358b6cd244b64e75a558130d583761c21c5d67f012acc846319e23c73cbcee02bb26a397f2c06fa7f73332d9761a1dd19ef73b9d8f3a8a235fc9f85d73da4240f7de268cf7dc2682a56d4afca6bad9fbfd899d9d3d22273b3f12e37dba810fd76e4ccacb2e1c7b7e42db692cb3b7fb7ffb3077e7674a4fec683c43eef1a92df1789e764fc08c9e02c3db0f8df04450f5f6a3f84b1380c061351feaa9e7f4d3814dd334b8100437432619abb1b874e4d93460430921d27cd8affdbca1236bea9307a91c97eeb2f0d72d"
@@ -99,8 +156,8 @@ func TestPython2048BitVectors(t *testing.T) {
 	require.NoError(t, err)
 
 	// NIKE
-	bobSharedBytes := DeriveSecret(bobPrivateKey, alicePublicKey)
-	aliceSharedBytes := DeriveSecret(alicePrivateKey, bobPublicKey)
+	bobSharedBytes := MustDeriveSecret(bobPrivateKey, alicePublicKey)
+	aliceSharedBytes := MustDeriveSecret(alicePrivateKey, bobPublicKey)
 	require.Equal(t, bobSharedBytes, aliceSharedBytes)
 
 	sharedSecretHex := "f61ebdb51cff8de704e1940b702b7359f3936f632b9ac33a18d9f58f85153875e14fdc701912cc8717f0cb4c32729bc5eb9dbfc9ef207281103ae381f2ba0553686cbc43c279d1da8897e5fbab50e2a05e38ef7b012a85b856ebb3c1ebd133dc32f710dd6d67f80093b37402e5581f350f09188ac97b2ea7a14fbaa3c5db0bb38036ac2e81e34f1a04fae0fd91b90b3bca1fa3ae5b5bd37e0edebf08d806eb4cd9ab136289c9e86aba3f8839fabec86ae0cdbd794409a6b6f81b3a5c5f9f56da5e9bdeaf8f6d802be6f987ab5772f35b3855291c9ab3b1848d654841a24e014f7a112cf7591d16bf1d33b2d46e4294fca42cacb1c2eacdbe9040ab794906353f"
@@ -10,6 +10,24 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func Test511BitVectorPrivateKeyFromSeed(t *testing.T) {
+	seedHex := "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"
+	seed, err := hex.DecodeString(seedHex)
+	require.NoError(t, err)
+
+	privateKey, err := NewPrivateKeyFromSeed(seed)
+	require.NoError(t, err)
+
+	privateKeyHex := "fa00ff0602fcfe0104ff0201050101000700fe020101fffefe05ff0004ffff05ff0100fdff00fd00ff04000101fefdff0000fffc00fdfeff0301ffff00ff000101fffdfe0000ffffff01"
+	require.Equal(t, privateKeyHex, hex.EncodeToString(privateKey.Bytes()))
+
+	publicKey, err := privateKey.PublicKey()
+	require.NoError(t, err)
+
+	publicKeyHex := "0233cf225de6d6be694526994a4316b47f3ff6bd1793e759484b591935c056446918f7096006598c6d4963482cd44722334c2d25136c8d7eb3f71af7e630b360"
+	require.Equal(t, publicKeyHex, hex.EncodeToString(publicKey.Bytes()))
+}
+
 func Test511BitVectorBlindingOperation(t *testing.T) {
 	publicKeyHex := "025c39c2f0c91a549470c497566cf2ba4114e5facd24635e8cee6088a4c5ce73d91b18ccf02609c7f5f200b4a92ae18036a56b308add0fbf5b3d96a347e49f59"
 	publicKeyBytes, err := hex.DecodeString(publicKeyHex)
@@ -22,7 +40,30 @@ func Test511BitVectorBlindingOperation(t *testing.T) {
 	blindingFactor, err := hex.DecodeString(blindingFactorHex)
 	require.NoError(t, err)
 
-	publicKey.Blind(blindingFactor)
+	err = publicKey.Blind(blindingFactor)
+	require.NoError(t, err)
+
+	blindingOutputHex := "6e3253b744a0270877a0f6375c931e5eed3da85c086e6694e3bc94a9043d486b4a6d7f9cce3b5f8a196d24057d9b3f2556826c9634f0fe5250b7fc15edced22a"
+	blindingOutputBytes, err := hex.DecodeString(blindingOutputHex)
+	require.NoError(t, err)
+
+	require.Equal(t, blindingOutputBytes, publicKey.Bytes())
+}
+
+func Test511BitVectorLegacyBlindingOperation(t *testing.T) {
+	publicKeyHex := "025c39c2f0c91a549470c497566cf2ba4114e5facd24635e8cee6088a4c5ce73d91b18ccf02609c7f5f200b4a92ae18036a56b308add0fbf5b3d96a347e49f59"
+	publicKeyBytes, err := hex.DecodeString(publicKeyHex)
+	require.NoError(t, err)
+	publicKey := new(PublicKey)
+	err = publicKey.FromBytes(publicKeyBytes)
+	require.NoError(t, err)
+
+	blindingFactorHex := "44b793fa59e54f8ebcb3e3e2f9a35707964c12b55fa0dd39eda24046fafe383fd71098144eef914d92729f0836b46f4fe3cd0a75afb1ccb1fa2b36fcf15b7489dacfacdff74d5cc53973"
+	blindingFactor, err := hex.DecodeString(blindingFactorHex)
+	require.NoError(t, err)
+
+	err = publicKey.BlindLegacy(blindingFactor)
+	require.NoError(t, err)
 
 	blindingOutputHex := "53defe8218c10d123390328c31165039854d31ab3099dce28a1fb31873a2104f16c02e59e5739078cd5dec5ec90f518178e2964569733e053c85248048361f32"
 	blindingOutputBytes, err := hex.DecodeString(blindingOutputHex)
@@ -31,6 +72,22 @@ func Test511BitVectorBlindingOperation(t *testing.T) {
 	require.Equal(t, blindingOutputBytes, publicKey.Bytes())
 }
 
+func Test511BitVectorDeterministicPublicKey(t *testing.T) {
+	for _, vector := range []struct{ domain, msg, publicKeyHex string }{
+		{"", "", "3466626133bb0e6c8ebd06e4358c3a317774b8d15903a2cf564350ced8a0b1a43684ae8bddc14ec4a633911e1f4163b1a23bdd7b0e5a4f11b00865f731b36b54"},
+		{"ctidh test", "message", "99fa79b9009178f4d43d25c92d4dcea7214b33ee32f8e9cbb815eb192a2feb1bb0b98701f7757b3006f6b4b6bd8795616a265cef9586217786d26dd135d2ff56"},
+	} {
+		publicKeyBytes, err := hex.DecodeString(vector.publicKeyHex)
+		require.NoError(t, err)
+		publicKey := new(PublicKey)
+		err = publicKey.FromBytes(publicKeyBytes)
+		require.NoError(t, err)
+
+		derived := DeterministicPublicKey([]byte(vector.domain), []byte(vector.msg))
+		require.Equal(t, publicKeyBytes, derived.Bytes())
+	}
+}
+
 func Test511BitVectors(t *testing.T) {
 	// Alice
 	alicePrivateKeyHex := "fd020202fb01ff020001fbffff0003020502ff020500fefefe02010501fb01fcfffc03010001000101fb000400fe000100fc030100000301fcfe0001ffff01ff00fe00ff000300ffff00"
@@ -59,8 +116,8 @@ func Test511BitVectors(t *testing.T) {
 	require.NoError(t, err)
 
 	// NIKE
-	bobSharedBytes := DeriveSecret(bobPrivateKey, alicePublicKey)
-	aliceSharedBytes := DeriveSecret(alicePrivateKey, bobPublicKey)
+	bobSharedBytes := MustDeriveSecret(bobPrivateKey, alicePublicKey)
+	aliceSharedBytes := MustDeriveSecret(alicePrivateKey, bobPublicKey)
 	require.Equal(t, bobSharedBytes, aliceSharedBytes)
 
 	sharedSecretHex := "74cc3560ed96ca88ad111f2feb5002240bc3a389c1b768eb588e4c4432a9ed748a5341b68618ed49bb81b3554fb6a5bc41289513c5321faa9b8230611f50f311"
@@ -99,8 +156,8 @@ func TestPython511BitVectors(t *testing.T) {
 	require.NoError(t, err)
 
 	// NIKE
-	bobSharedBytes := DeriveSecret(bobPrivateKey, alicePublicKey)
-	aliceSharedBytes := DeriveSecret(alicePrivateKey, bobPublicKey)
+	bobSharedBytes := MustDeriveSecret(bobPrivateKey, alicePublicKey)
+	aliceSharedBytes := MustDeriveSecret(alicePrivateKey, bobPublicKey)
 	require.Equal(t, bobSharedBytes, aliceSharedBytes)
 
 	sharedSecretHex := "5ecc8e5159cdb3bfac9281e183d9b3cbf2e289c28dee69f99b2fd840f141686fb133a3a40360a4e6056230a649be57b4e045b4c28c5558f80f57f85b43bbaf33"
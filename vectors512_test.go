@@ -10,6 +10,24 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func Test512BitVectorPrivateKeyFromSeed(t *testing.T) {
+	seedHex := "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"
+	seed, err := hex.DecodeString(seedHex)
+	require.NoError(t, err)
+
+	privateKey, err := NewPrivateKeyFromSeed(seed)
+	require.NoError(t, err)
+
+	privateKeyHex := "06fdfb0007fffc090103ff0601fc000105030201fc05fe0300fef902fe0000020007fff801fffb0101fffe0201faff0400fefe00fffeff000009ffff0000fa02010401ff00ff03ff0200"
+	require.Equal(t, privateKeyHex, hex.EncodeToString(privateKey.Bytes()))
+
+	publicKey, err := privateKey.PublicKey()
+	require.NoError(t, err)
+
+	publicKeyHex := "1d18479e2abf0b1f50d554960c09246f7d1982295e2842b95553a459697f5017d4e76ea7e2dd4d1d599887fe2e6c0e7b88c50ddf6d6c570a1a25e74386cf2943"
+	require.Equal(t, publicKeyHex, hex.EncodeToString(publicKey.Bytes()))
+}
+
 func Test512BitVectorBlindingOperation(t *testing.T) {
 	publicKeyHex := "928d8753b4749add1a24a1eb7f3654535cdc8137fadd54d548fd7870c2bafa7cbd02a605fa7567679a5a01914f8c8c411843ac5890fd34ba1e99f4f6bd9bf612"
 	publicKeyBytes, err := hex.DecodeString(publicKeyHex)
@@ -22,7 +40,30 @@ func Test512BitVectorBlindingOperation(t *testing.T) {
 	blindingFactor, err := hex.DecodeString(blindingFactorHex)
 	require.NoError(t, err)
 
-	publicKey.Blind(blindingFactor)
+	err = publicKey.Blind(blindingFactor)
+	require.NoError(t, err)
+
+	blindingOutputHex := "544729037d206d68f800832cd9b75b3c61e5c73ebfcf399115667466d963a541de51968505f3c780c0971e6373e86794e97f80af1ffcd6a1cde8e527886ae510"
+	blindingOutputBytes, err := hex.DecodeString(blindingOutputHex)
+	require.NoError(t, err)
+
+	require.Equal(t, blindingOutputBytes, publicKey.Bytes())
+}
+
+func Test512BitVectorLegacyBlindingOperation(t *testing.T) {
+	publicKeyHex := "928d8753b4749add1a24a1eb7f3654535cdc8137fadd54d548fd7870c2bafa7cbd02a605fa7567679a5a01914f8c8c411843ac5890fd34ba1e99f4f6bd9bf612"
+	publicKeyBytes, err := hex.DecodeString(publicKeyHex)
+	require.NoError(t, err)
+	publicKey := new(PublicKey)
+	err = publicKey.FromBytes(publicKeyBytes)
+	require.NoError(t, err)
+
+	blindingFactorHex := "4972d672d1acd58c3f3a3e3ba6d928c90e7dc4c35455fb9bdb5022de7018afd7ec09a13c8ed1892c8dfedac81d2c32956446ca9b37630879f92060e10040ea6d11ff8a9ef128a4328810"
+	blindingFactor, err := hex.DecodeString(blindingFactorHex)
+	require.NoError(t, err)
+
+	err = publicKey.BlindLegacy(blindingFactor)
+	require.NoError(t, err)
 
 	blindingOutputHex := "a34b8ccd7b4f97859f1a0d2962b31a083d363a7d671340471516bd36f58def0b0203f44af2a799028a17a8856e18a7b603190e1a63adc215c0ae53d21c45761c"
 	blindingOutputBytes, err := hex.DecodeString(blindingOutputHex)
@@ -31,6 +72,22 @@ func Test512BitVectorBlindingOperation(t *testing.T) {
 	require.Equal(t, blindingOutputBytes, publicKey.Bytes())
 }
 
+func Test512BitVectorDeterministicPublicKey(t *testing.T) {
+	for _, vector := range []struct{ domain, msg, publicKeyHex string }{
+		{"", "", "ed34c02925cbcccd88120a7fa38137188b2ed1b632ca7fac79e7969b3c22fca6ea7ae3083a1706bd2df6330bfc3dfe44a600c1cab2195e5e2ff7e881745a2258"},
+		{"ctidh test", "message", "b88c748389cd45c048109a47e3ee0fb1662266a487569a34802229a186b15a331f2885e1d135f9001cc83539b1e584aa4344140d4f2549d6b32acd52889fad56"},
+	} {
+		publicKeyBytes, err := hex.DecodeString(vector.publicKeyHex)
+		require.NoError(t, err)
+		publicKey := new(PublicKey)
+		err = publicKey.FromBytes(publicKeyBytes)
+		require.NoError(t, err)
+
+		derived := DeterministicPublicKey([]byte(vector.domain), []byte(vector.msg))
+		require.Equal(t, publicKeyBytes, derived.Bytes())
+	}
+}
+
 func Test512BitVectors(t *testing.T) {
 
 	// Alice
@@ -60,8 +117,8 @@ func Test512BitVectors(t *testing.T) {
 	require.NoError(t, err)
 
 	// NIKE
-	bobSharedBytes := DeriveSecret(bobPrivateKey, alicePublicKey)
-	aliceSharedBytes := DeriveSecret(alicePrivateKey, bobPublicKey)
+	bobSharedBytes := MustDeriveSecret(bobPrivateKey, alicePublicKey)
+	aliceSharedBytes := MustDeriveSecret(alicePrivateKey, bobPublicKey)
 	require.Equal(t, bobSharedBytes, aliceSharedBytes)
 
 	sharedSecretHex := "24081588d4f3232f788e4e65db4870a223942ad272722a70577c26533c93adcd798cd166f26bfbafa6d6e428bf502a98e753a5a17ba2669869b2082f50266932"
@@ -100,8 +157,8 @@ func TestPython512BitVectors(t *testing.T) {
 	require.NoError(t, err)
 
 	// NIKE
-	bobSharedBytes := DeriveSecret(bobPrivateKey, alicePublicKey)
-	aliceSharedBytes := DeriveSecret(alicePrivateKey, bobPublicKey)
+	bobSharedBytes := MustDeriveSecret(bobPrivateKey, alicePublicKey)
+	aliceSharedBytes := MustDeriveSecret(alicePrivateKey, bobPublicKey)
 	require.Equal(t, bobSharedBytes, aliceSharedBytes)
 
 	sharedSecretHex := "0d84960ea3c52ad6264a53915757d1ff8733629914577151140ae28bd28325bc31151ae3a1447e0d68aae42abcc63dae249072a8e729678ab73fd333b32a7a3d"
@@ -0,0 +1,218 @@
+package x3dh
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+)
+
+// SharedKeySize is the size in bytes of SK.
+const SharedKeySize = 32
+
+// tagSize is the size of the ChaCha20-Poly1305 authentication tag.
+const tagSize = 16
+
+// Session is the result of a key agreement.
+type Session struct {
+	// SharedKey is SK.
+	SharedKey []byte
+
+	// AssociatedData is AD, the public identity of the sender
+	// followed by that of the receiver.
+	AssociatedData []byte
+
+	// Peer is the public identity of the other party.
+	Peer *PublicIdentity
+}
+
+// kdf returns SK and the key of the initial message derived from the
+// DH outputs.
+func (p *Protocol) kdf(dhs ...[]byte) ([]byte, []byte) {
+	ikm := bytes.Repeat([]byte{0xff}, 32)
+	for _, dh := range dhs {
+		ikm = append(ikm, dh...)
+	}
+	out := make([]byte, SharedKeySize+chacha20poly1305.KeySize)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, ikm, make([]byte, sha256.Size), []byte(p.info)), out); err != nil {
+		panic(err)
+	}
+	return out[:SharedKeySize], out[SharedKeySize:]
+}
+
+// initialMessageHeaderSize returns the size of the header of an
+// initial message, without and with a one time prekey ID.
+func (p *Protocol) initialMessageHeaderSize(oneTimePrekey bool) int {
+	n := 2*p.scheme.PublicKeySize() + ed25519.PublicKeySize + 4 + 1
+	if oneTimePrekey {
+		n += 4
+	}
+	return n
+}
+
+// seal encrypts the initial message with the key k, authenticating
+// AD and the header.
+func seal(k, ad, header, plaintext []byte) []byte {
+	aead, err := chacha20poly1305.New(k)
+	if err != nil {
+		panic(err)
+	}
+	// The key is only ever used for one message.
+	return aead.Seal(header, make([]byte, aead.NonceSize()), plaintext, append(append([]byte{}, ad...), header...))
+}
+
+// InitiateSession agrees on a session with the owner of bundle, with
+// an ephemeral key drawn from rng, and returns the session and the
+// initial message carrying plaintext. The initial message is the
+// public identity of the sender, the ephemeral public key, the ID of
+// the signed prekey, a byte which is 1 if the ID of the one time
+// prekey follows and 0 otherwise, and the ChaCha20-Poly1305
+// encryption of plaintext.
+func (p *Protocol) InitiateSession(rng io.Reader, identity *Identity, bundle *Bundle, plaintext []byte) (*Session, []byte, error) {
+	if err := p.VerifyBundle(bundle); err != nil {
+		return nil, nil, err
+	}
+	ephemeralPrivate, ephemeralPublic, err := p.scheme.GenerateKeyPair(rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer ephemeralPrivate.Reset()
+
+	dhs := make([][]byte, 0, 4)
+	for _, dh := range []struct {
+		privateKey nike.PrivateKey
+		publicKey  nike.PublicKey
+	}{
+		{identity.dh, bundle.SignedPrekey.PublicKey},
+		{ephemeralPrivate, bundle.Identity.DH},
+		{ephemeralPrivate, bundle.SignedPrekey.PublicKey},
+	} {
+		secret, err := p.scheme.DeriveSecret(dh.privateKey, dh.publicKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		dhs = append(dhs, secret)
+	}
+
+	header := make([]byte, 0, p.initialMessageHeaderSize(bundle.OneTimePrekey != nil))
+	header = append(header, identity.public.Bytes()...)
+	header = append(header, ephemeralPublic.Bytes()...)
+	header = binary.BigEndian.AppendUint32(header, bundle.SignedPrekey.ID)
+	if bundle.OneTimePrekey != nil {
+		secret, err := p.scheme.DeriveSecret(ephemeralPrivate, bundle.OneTimePrekey.PublicKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		dhs = append(dhs, secret)
+		header = append(header, 1)
+		header = binary.BigEndian.AppendUint32(header, bundle.OneTimePrekey.ID)
+	} else {
+		header = append(header, 0)
+	}
+
+	sk, k := p.kdf(dhs...)
+	ad := append(identity.public.Bytes(), bundle.Identity.Bytes()...)
+	session := &Session{
+		SharedKey:      sk,
+		AssociatedData: ad,
+		Peer:           &bundle.Identity,
+	}
+	return session, seal(k, ad, header, plaintext), nil
+}
+
+// AcceptSession processes an initial message and returns the session
+// and the plaintext it carried. The one time prekey it used, if any,
+// is deleted, so that the same initial message cannot be accepted
+// twice.
+func (r *Receiver) AcceptSession(message []byte) (*Session, []byte, error) {
+	p := r.protocol
+	pkSize := p.scheme.PublicKeySize()
+	headerSize := p.initialMessageHeaderSize(false)
+	if len(message) < headerSize+tagSize {
+		return nil, nil, ErrMessage
+	}
+	hasOneTimePrekey := message[headerSize-1] == 1
+	if hasOneTimePrekey {
+		headerSize = p.initialMessageHeaderSize(true)
+		if len(message) < headerSize+tagSize {
+			return nil, nil, ErrMessage
+		}
+	} else if message[headerSize-1] != 0 {
+		return nil, nil, ErrMessage
+	}
+	header, ciphertext := message[:headerSize], message[headerSize:]
+
+	peerDH, err := p.scheme.UnmarshalBinaryPublicKey(header[:pkSize])
+	if err != nil {
+		return nil, nil, err
+	}
+	peer := &PublicIdentity{
+		DH:      peerDH,
+		Signing: append(ed25519.PublicKey{}, header[pkSize:pkSize+ed25519.PublicKeySize]...),
+	}
+	header = header[pkSize+ed25519.PublicKeySize:]
+	ephemeralPublic, err := p.scheme.UnmarshalBinaryPublicKey(header[:pkSize])
+	if err != nil {
+		return nil, nil, err
+	}
+	signedPrekey, ok := r.signedPrekeys[binary.BigEndian.Uint32(header[pkSize:])]
+	if !ok {
+		return nil, nil, ErrUnknownPrekey
+	}
+	var oneTimePrekeyID uint32
+	var oneTimePrekey nike.PrivateKey
+	if hasOneTimePrekey {
+		oneTimePrekeyID = binary.BigEndian.Uint32(header[pkSize+5:])
+		if oneTimePrekey, ok = r.oneTimePrekeys[oneTimePrekeyID]; !ok {
+			return nil, nil, ErrUnknownPrekey
+		}
+	}
+
+	dhs := make([][]byte, 0, 4)
+	for _, dh := range []struct {
+		privateKey nike.PrivateKey
+		publicKey  nike.PublicKey
+	}{
+		{signedPrekey, peerDH},
+		{r.identity.dh, ephemeralPublic},
+		{signedPrekey, ephemeralPublic},
+		{oneTimePrekey, ephemeralPublic},
+	} {
+		if dh.privateKey == nil {
+			break
+		}
+		secret, err := p.scheme.DeriveSecret(dh.privateKey, dh.publicKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		dhs = append(dhs, secret)
+	}
+
+	sk, k := p.kdf(dhs...)
+	ad := append(peer.Bytes(), r.identity.public.Bytes()...)
+	aead, err := chacha20poly1305.New(k)
+	if err != nil {
+		return nil, nil, err
+	}
+	plaintext, err := aead.Open(nil, make([]byte, aead.NonceSize()), ciphertext, append(append([]byte{}, ad...), message[:headerSize]...))
+	if err != nil {
+		return nil, nil, ErrDecrypt
+	}
+
+	if hasOneTimePrekey {
+		oneTimePrekey.Reset()
+		delete(r.oneTimePrekeys, oneTimePrekeyID)
+	}
+	session := &Session{
+		SharedKey:      sk,
+		AssociatedData: ad,
+		Peer:           peer,
+	}
+	return session, plaintext, nil
+}
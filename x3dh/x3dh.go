@@ -0,0 +1,293 @@
+// Package x3dh implements asynchronous session setup in the style of
+// the Extended Triple Diffie-Hellman (X3DH) key agreement of Signal,
+// with the CTIDH NIKE for all of the Diffie-Hellman keys.
+//
+// A receiver publishes a prekey bundle: its identity key, a signed
+// prekey which it rotates from time to time, and one of a set of one
+// time prekeys. A sender who fetches the bundle computes
+//
+//	DH1 = DeriveSecret(IK_A, SPK_B)
+//	DH2 = DeriveSecret(EK_A, IK_B)
+//	DH3 = DeriveSecret(EK_A, SPK_B)
+//	DH4 = DeriveSecret(EK_A, OPK_B)
+//	SK = HKDF-SHA256(F | DH1 | DH2 | DH3 | DH4)
+//
+// with a fresh ephemeral key EK_A, leaving out DH4 when the server ran
+// out of one time prekeys, and sends its identity and ephemeral public
+// keys with a first message encrypted under a key derived with SK.
+// The receiver computes the same SK without the sender being online.
+//
+// CTIDH has no signatures, so every identity also has an Ed25519 key
+// which signs the signed prekey together with the CTIDH identity key.
+// Both public identity keys are part of the associated data AD which
+// the session returns for the protocol that uses SK, such as the
+// Double Ratchet.
+package x3dh
+
+import (
+	"crypto/ed25519"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+)
+
+var (
+	// ErrSignature indicates a signed prekey whose signature is
+	// invalid.
+	ErrSignature = errors.New("x3dh: invalid signed prekey signature")
+
+	// ErrBundle indicates a malformed prekey bundle.
+	ErrBundle = errors.New("x3dh: malformed prekey bundle")
+
+	// ErrMessage indicates a malformed initial message.
+	ErrMessage = errors.New("x3dh: malformed initial message")
+
+	// ErrUnknownPrekey indicates an initial message for a signed
+	// prekey the receiver does not have, or for a one time prekey
+	// it does not have or which was used before.
+	ErrUnknownPrekey = errors.New("x3dh: unknown or used prekey")
+
+	// ErrDecrypt indicates an initial message which failed to
+	// authenticate.
+	ErrDecrypt = errors.New("x3dh: message authentication failed")
+)
+
+// Protocol is X3DH for a NIKE, with an application specific info
+// string for HKDF.
+type Protocol struct {
+	scheme nike.Scheme
+	info   string
+}
+
+// New returns X3DH for scheme. The info string identifies the
+// application, as in section 2.1 of the X3DH specification.
+func New(scheme nike.Scheme, info string) *Protocol {
+	return &Protocol{scheme: scheme, info: info}
+}
+
+// PublicIdentity is the public identity of a party: its CTIDH
+// identity key and the Ed25519 key signing its prekeys.
+type PublicIdentity struct {
+	DH      nike.PublicKey
+	Signing ed25519.PublicKey
+}
+
+// Bytes returns the encoding of the public identity, the two public
+// keys one after the other.
+func (i *PublicIdentity) Bytes() []byte {
+	return append(i.DH.Bytes(), i.Signing...)
+}
+
+// Identity is the long term identity of a party.
+type Identity struct {
+	dh      nike.PrivateKey
+	signing ed25519.PrivateKey
+	public  PublicIdentity
+}
+
+// GenerateIdentity returns a new identity drawn from rng.
+func (p *Protocol) GenerateIdentity(rng io.Reader) (*Identity, error) {
+	dhPrivate, dhPublic, err := p.scheme.GenerateKeyPair(rng)
+	if err != nil {
+		return nil, err
+	}
+	signingPublic, signingPrivate, err := ed25519.GenerateKey(rng)
+	if err != nil {
+		return nil, err
+	}
+	return &Identity{
+		dh:      dhPrivate,
+		signing: signingPrivate,
+		public:  PublicIdentity{DH: dhPublic, Signing: signingPublic},
+	}, nil
+}
+
+// Public returns the public identity.
+func (i *Identity) Public() *PublicIdentity {
+	return &i.public
+}
+
+// SignedPrekey is a public signed prekey.
+type SignedPrekey struct {
+	ID        uint32
+	PublicKey nike.PublicKey
+	Signature []byte
+}
+
+// OneTimePrekey is a public one time prekey.
+type OneTimePrekey struct {
+	ID        uint32
+	PublicKey nike.PublicKey
+}
+
+// signedPrekeyMessage returns the message signed for a signed
+// prekey, which binds it to the CTIDH identity key.
+func (p *Protocol) signedPrekeyMessage(identity nike.PublicKey, id uint32, prekey nike.PublicKey) []byte {
+	m := []byte("X3DH " + p.scheme.Name() + " signed prekey\x00")
+	m = append(m, identity.Bytes()...)
+	m = binary.BigEndian.AppendUint32(m, id)
+	return append(m, prekey.Bytes()...)
+}
+
+// Bundle is the prekey bundle of a receiver, as handed out by the
+// server. OneTimePrekey is nil when the server has none left.
+type Bundle struct {
+	Identity      PublicIdentity
+	SignedPrekey  SignedPrekey
+	OneTimePrekey *OneTimePrekey
+}
+
+// bundleSize returns the size of a bundle encoding, without and with
+// a one time prekey.
+func (p *Protocol) bundleSize(oneTimePrekey bool) int {
+	n := p.scheme.PublicKeySize() + ed25519.PublicKeySize + 4 + p.scheme.PublicKeySize() + ed25519.SignatureSize + 1
+	if oneTimePrekey {
+		n += 4 + p.scheme.PublicKeySize()
+	}
+	return n
+}
+
+// MarshalBinary encodes the bundle as the identity keys, the ID, key
+// and signature of the signed prekey, and a byte which is 1 if the
+// ID and key of a one time prekey follow and 0 otherwise. IDs are
+// big-endian.
+func (b *Bundle) MarshalBinary() ([]byte, error) {
+	if len(b.SignedPrekey.Signature) != ed25519.SignatureSize || len(b.Identity.Signing) != ed25519.PublicKeySize {
+		return nil, ErrBundle
+	}
+	data := b.Identity.Bytes()
+	data = binary.BigEndian.AppendUint32(data, b.SignedPrekey.ID)
+	data = append(data, b.SignedPrekey.PublicKey.Bytes()...)
+	data = append(data, b.SignedPrekey.Signature...)
+	if b.OneTimePrekey == nil {
+		return append(data, 0), nil
+	}
+	data = append(data, 1)
+	data = binary.BigEndian.AppendUint32(data, b.OneTimePrekey.ID)
+	return append(data, b.OneTimePrekey.PublicKey.Bytes()...), nil
+}
+
+// UnmarshalBundle decodes a bundle encoded by MarshalBinary and
+// verifies the signature of its signed prekey.
+func (p *Protocol) UnmarshalBundle(data []byte) (*Bundle, error) {
+	if len(data) != p.bundleSize(false) && len(data) != p.bundleSize(true) {
+		return nil, ErrBundle
+	}
+	pkSize := p.scheme.PublicKeySize()
+	b := new(Bundle)
+	var err error
+	if b.Identity.DH, err = p.scheme.UnmarshalBinaryPublicKey(data[:pkSize]); err != nil {
+		return nil, err
+	}
+	data = data[pkSize:]
+	b.Identity.Signing = append(ed25519.PublicKey{}, data[:ed25519.PublicKeySize]...)
+	data = data[ed25519.PublicKeySize:]
+	b.SignedPrekey.ID = binary.BigEndian.Uint32(data)
+	if b.SignedPrekey.PublicKey, err = p.scheme.UnmarshalBinaryPublicKey(data[4 : 4+pkSize]); err != nil {
+		return nil, err
+	}
+	data = data[4+pkSize:]
+	b.SignedPrekey.Signature = append([]byte{}, data[:ed25519.SignatureSize]...)
+	data = data[ed25519.SignatureSize:]
+
+	switch {
+	case data[0] == 1 && len(data) == 1+4+pkSize:
+		b.OneTimePrekey = &OneTimePrekey{ID: binary.BigEndian.Uint32(data[1:])}
+		if b.OneTimePrekey.PublicKey, err = p.scheme.UnmarshalBinaryPublicKey(data[5:]); err != nil {
+			return nil, err
+		}
+	case data[0] != 0 || len(data) != 1:
+		return nil, ErrBundle
+	}
+
+	if err := p.VerifyBundle(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// VerifyBundle checks the signature of the signed prekey of b.
+func (p *Protocol) VerifyBundle(b *Bundle) error {
+	if len(b.Identity.Signing) != ed25519.PublicKeySize {
+		return ErrSignature
+	}
+	m := p.signedPrekeyMessage(b.Identity.DH, b.SignedPrekey.ID, b.SignedPrekey.PublicKey)
+	if !ed25519.Verify(b.Identity.Signing, m, b.SignedPrekey.Signature) {
+		return ErrSignature
+	}
+	return nil
+}
+
+// Receiver holds the private prekeys of a party which accepts
+// sessions.
+type Receiver struct {
+	protocol *Protocol
+	identity *Identity
+
+	nextID         uint32
+	signedPrekeys  map[uint32]nike.PrivateKey
+	oneTimePrekeys map[uint32]nike.PrivateKey
+}
+
+// NewReceiver returns a receiver for identity, without prekeys.
+func (p *Protocol) NewReceiver(identity *Identity) *Receiver {
+	return &Receiver{
+		protocol:       p,
+		identity:       identity,
+		signedPrekeys:  make(map[uint32]nike.PrivateKey),
+		oneTimePrekeys: make(map[uint32]nike.PrivateKey),
+	}
+}
+
+// NewSignedPrekey returns a new signed prekey drawn from rng. Earlier
+// signed prekeys are kept, for messages which were sent to them
+// before the new one was published, until they are removed with
+// RemoveSignedPrekey.
+func (r *Receiver) NewSignedPrekey(rng io.Reader) (*SignedPrekey, error) {
+	p := r.protocol
+	privateKey, publicKey, err := p.scheme.GenerateKeyPair(rng)
+	if err != nil {
+		return nil, err
+	}
+	id := r.nextID
+	r.nextID++
+	r.signedPrekeys[id] = privateKey
+	m := p.signedPrekeyMessage(r.identity.public.DH, id, publicKey)
+	return &SignedPrekey{
+		ID:        id,
+		PublicKey: publicKey,
+		Signature: ed25519.Sign(r.identity.signing, m),
+	}, nil
+}
+
+// RemoveSignedPrekey removes and resets the signed prekey id.
+func (r *Receiver) RemoveSignedPrekey(id uint32) {
+	if privateKey, ok := r.signedPrekeys[id]; ok {
+		privateKey.Reset()
+		delete(r.signedPrekeys, id)
+	}
+}
+
+// NewOneTimePrekeys returns n new one time prekeys drawn from rng.
+func (r *Receiver) NewOneTimePrekeys(rng io.Reader, n int) ([]*OneTimePrekey, error) {
+	prekeys := make([]*OneTimePrekey, n)
+	for i := range prekeys {
+		privateKey, publicKey, err := r.protocol.scheme.GenerateKeyPair(rng)
+		if err != nil {
+			return nil, err
+		}
+		id := r.nextID
+		r.nextID++
+		r.oneTimePrekeys[id] = privateKey
+		prekeys[i] = &OneTimePrekey{ID: id, PublicKey: publicKey}
+	}
+	return prekeys, nil
+}
+
+// OneTimePrekeyCount returns the number of unused one time prekeys,
+// so that the receiver knows when to publish more.
+func (r *Receiver) OneTimePrekeyCount() int {
+	return len(r.oneTimePrekeys)
+}
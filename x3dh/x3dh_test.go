@@ -0,0 +1,208 @@
+package x3dh
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"git.xx.network/elixxir/ctidh_cgo/ctidh511"
+	"git.xx.network/elixxir/ctidh_cgo/nike"
+	"git.xx.network/elixxir/ctidh_cgo/nike/x25519"
+)
+
+const testInfo = "x3dh test"
+
+// memoryServer stands in for the server which stores the published
+// prekeys and hands out bundles, each with a different one time
+// prekey while they last.
+type memoryServer struct {
+	protocol *Protocol
+	users    map[string]*publishedPrekeys
+}
+
+type publishedPrekeys struct {
+	identity       PublicIdentity
+	signedPrekey   *SignedPrekey
+	oneTimePrekeys []*OneTimePrekey
+}
+
+func newMemoryServer(p *Protocol) *memoryServer {
+	return &memoryServer{protocol: p, users: make(map[string]*publishedPrekeys)}
+}
+
+func (s *memoryServer) publish(user string, identity *PublicIdentity, signedPrekey *SignedPrekey, oneTimePrekeys []*OneTimePrekey) {
+	published, ok := s.users[user]
+	if !ok {
+		published = &publishedPrekeys{identity: *identity}
+		s.users[user] = published
+	}
+	if signedPrekey != nil {
+		published.signedPrekey = signedPrekey
+	}
+	published.oneTimePrekeys = append(published.oneTimePrekeys, oneTimePrekeys...)
+}
+
+// fetch returns the serialized bundle of user.
+func (s *memoryServer) fetch(t *testing.T, user string) []byte {
+	published := s.users[user]
+	bundle := &Bundle{Identity: published.identity, SignedPrekey: *published.signedPrekey}
+	if len(published.oneTimePrekeys) > 0 {
+		bundle.OneTimePrekey = published.oneTimePrekeys[0]
+		published.oneTimePrekeys = published.oneTimePrekeys[1:]
+	}
+	data, err := bundle.MarshalBinary()
+	require.NoError(t, err)
+	return data
+}
+
+// newTestReceiver returns the identity of a receiver which published
+// a signed prekey and n one time prekeys to server as bob.
+func newTestReceiver(t *testing.T, p *Protocol, server *memoryServer, n int) *Receiver {
+	identity, err := p.GenerateIdentity(rand.Reader)
+	require.NoError(t, err)
+	receiver := p.NewReceiver(identity)
+	signedPrekey, err := receiver.NewSignedPrekey(rand.Reader)
+	require.NoError(t, err)
+	oneTimePrekeys, err := receiver.NewOneTimePrekeys(rand.Reader, n)
+	require.NoError(t, err)
+	server.publish("bob", identity.Public(), signedPrekey, oneTimePrekeys)
+	return receiver
+}
+
+func TestSession(t *testing.T) {
+	for _, scheme := range []nike.Scheme{ctidh511.Scheme(), x25519.Scheme()} {
+		t.Run(scheme.Name(), func(t *testing.T) {
+			p := New(scheme, testInfo)
+			server := newMemoryServer(p)
+			bob := newTestReceiver(t, p, server, 1)
+			alice, err := p.GenerateIdentity(rand.Reader)
+			require.NoError(t, err)
+
+			// The first bundle has a one time prekey and the
+			// second does not.
+			for _, oneTimePrekey := range []bool{true, false} {
+				data := server.fetch(t, "bob")
+				bundle, err := p.UnmarshalBundle(data)
+				require.NoError(t, err)
+				require.Equal(t, oneTimePrekey, bundle.OneTimePrekey != nil)
+
+				aliceSession, message, err := p.InitiateSession(rand.Reader, alice, bundle, []byte("hello"))
+				require.NoError(t, err)
+				bobSession, plaintext, err := bob.AcceptSession(message)
+				require.NoError(t, err)
+				require.Equal(t, []byte("hello"), plaintext)
+
+				require.Len(t, aliceSession.SharedKey, SharedKeySize)
+				require.Equal(t, aliceSession.SharedKey, bobSession.SharedKey)
+				require.Equal(t, aliceSession.AssociatedData, bobSession.AssociatedData)
+				require.Equal(t, alice.Public().Bytes(), bobSession.Peer.Bytes())
+				require.Equal(t, bob.identity.Public().Bytes(), aliceSession.Peer.Bytes())
+			}
+			require.Equal(t, 0, bob.OneTimePrekeyCount())
+		})
+	}
+}
+
+func TestOneTimePrekeyConsumption(t *testing.T) {
+	p := New(x25519.Scheme(), testInfo)
+	server := newMemoryServer(p)
+	bob := newTestReceiver(t, p, server, 3)
+	alice, err := p.GenerateIdentity(rand.Reader)
+	require.NoError(t, err)
+
+	bundle, err := p.UnmarshalBundle(server.fetch(t, "bob"))
+	require.NoError(t, err)
+	_, message, err := p.InitiateSession(rand.Reader, alice, bundle, nil)
+	require.NoError(t, err)
+
+	_, _, err = bob.AcceptSession(message)
+	require.NoError(t, err)
+	require.Equal(t, 2, bob.OneTimePrekeyCount())
+
+	// A replayed initial message finds its one time prekey used.
+	_, _, err = bob.AcceptSession(message)
+	require.ErrorIs(t, err, ErrUnknownPrekey)
+
+	// A message to a removed signed prekey is refused.
+	bundle, err = p.UnmarshalBundle(server.fetch(t, "bob"))
+	require.NoError(t, err)
+	_, message, err = p.InitiateSession(rand.Reader, alice, bundle, nil)
+	require.NoError(t, err)
+	signedPrekey, err := bob.NewSignedPrekey(rand.Reader)
+	require.NoError(t, err)
+	server.publish("bob", nil, signedPrekey, nil)
+	bob.RemoveSignedPrekey(bundle.SignedPrekey.ID)
+	_, _, err = bob.AcceptSession(message)
+	require.ErrorIs(t, err, ErrUnknownPrekey)
+	require.Equal(t, 2, bob.OneTimePrekeyCount())
+
+	// The rotated signed prekey works.
+	bundle, err = p.UnmarshalBundle(server.fetch(t, "bob"))
+	require.NoError(t, err)
+	require.Equal(t, signedPrekey.ID, bundle.SignedPrekey.ID)
+	_, message, err = p.InitiateSession(rand.Reader, alice, bundle, nil)
+	require.NoError(t, err)
+	_, _, err = bob.AcceptSession(message)
+	require.NoError(t, err)
+	require.Equal(t, 1, bob.OneTimePrekeyCount())
+}
+
+func TestBundleSignature(t *testing.T) {
+	p := New(x25519.Scheme(), testInfo)
+	server := newMemoryServer(p)
+	newTestReceiver(t, p, server, 1)
+	data := server.fetch(t, "bob")
+
+	// Any change to the signed parts invalidates the signature.
+	for _, i := range []int{0, x25519.PublicKeySize, x25519.PublicKeySize + 32 + 3, x25519.PublicKeySize + 32 + 4} {
+		tampered := append([]byte{}, data...)
+		tampered[i] ^= 1
+		_, err := p.UnmarshalBundle(tampered)
+		require.ErrorIs(t, err, ErrSignature)
+	}
+
+	_, err := p.UnmarshalBundle(data[1:])
+	require.ErrorIs(t, err, ErrBundle)
+	tampered := append([]byte{}, data...)
+	tampered[p.bundleSize(false)-1] = 2
+	_, err = p.UnmarshalBundle(tampered)
+	require.ErrorIs(t, err, ErrBundle)
+
+	// A bundle signed by another identity is rejected.
+	bundle, err := p.UnmarshalBundle(data)
+	require.NoError(t, err)
+	mallory, err := p.GenerateIdentity(rand.Reader)
+	require.NoError(t, err)
+	bundle.Identity.Signing = mallory.Public().Signing
+	_, _, err = p.InitiateSession(rand.Reader, mallory, bundle, nil)
+	require.ErrorIs(t, err, ErrSignature)
+}
+
+func TestTamperedInitialMessage(t *testing.T) {
+	p := New(ctidh511.Scheme(), testInfo)
+	server := newMemoryServer(p)
+	bob := newTestReceiver(t, p, server, 1)
+	alice, err := p.GenerateIdentity(rand.Reader)
+	require.NoError(t, err)
+	bundle, err := p.UnmarshalBundle(server.fetch(t, "bob"))
+	require.NoError(t, err)
+	_, message, err := p.InitiateSession(rand.Reader, alice, bundle, []byte("hello"))
+	require.NoError(t, err)
+
+	// Changing the signing key or the ciphertext fails the AEAD, and
+	// does not consume the one time prekey.
+	for _, i := range []int{ctidh511.PublicKeySize, len(message) - 1} {
+		tampered := append([]byte{}, message...)
+		tampered[i] ^= 1
+		_, _, err = bob.AcceptSession(tampered)
+		require.ErrorIs(t, err, ErrDecrypt)
+	}
+	require.Equal(t, 1, bob.OneTimePrekeyCount())
+
+	_, _, err = bob.AcceptSession(message[:10])
+	require.ErrorIs(t, err, ErrMessage)
+
+	_, _, err = bob.AcceptSession(message)
+	require.NoError(t, err)
+}